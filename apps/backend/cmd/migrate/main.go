@@ -0,0 +1,80 @@
+// Command migrate applies the embedded SQL migrations to the configured
+// database. Production servers refuse to boot while migrations are pending,
+// so deployments run `migrate up` before starting the server.
+//
+// Usage:
+//
+//	migrate up      apply all pending migrations
+//	migrate down    roll back the most recently applied migration
+//	migrate status  show applied and pending migrations
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"app-backend/internal/config"
+	"app-backend/internal/database"
+)
+
+func main() {
+	command := "up"
+	if len(os.Args) > 1 {
+		command = os.Args[1]
+	}
+
+	cfg, err := config.New()
+	if err != nil {
+		log.Fatal("Failed to load configuration:", err)
+	}
+
+	db, err := database.NewConnection(cfg.GetDatabaseURL())
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+
+	switch command {
+	case "up":
+		applied, err := database.Migrate(db)
+		if err != nil {
+			log.Fatal("Migration failed:", err)
+		}
+		if applied == 0 {
+			fmt.Println("Database schema is up to date")
+		} else {
+			fmt.Printf("Applied %d migration(s)\n", applied)
+		}
+
+	case "down":
+		migration, err := database.RollbackLast(db)
+		if err != nil {
+			log.Fatal("Rollback failed:", err)
+		}
+		fmt.Printf("Rolled back %06d_%s\n", migration.Version, migration.Name)
+
+	case "status":
+		applied, err := database.Applied(db)
+		if err != nil {
+			log.Fatal("Failed to read migration history:", err)
+		}
+		pending, err := database.Pending(db)
+		if err != nil {
+			log.Fatal("Failed to determine pending migrations:", err)
+		}
+
+		for _, row := range applied {
+			fmt.Printf("applied  %06d_%s  %s\n", row.Version, row.Name, row.AppliedAt.Format("2006-01-02 15:04:05"))
+		}
+		for _, migration := range pending {
+			fmt.Printf("pending  %06d_%s\n", migration.Version, migration.Name)
+		}
+		if len(applied) == 0 && len(pending) == 0 {
+			fmt.Println("No migrations found")
+		}
+
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q\nUsage: migrate [up|down|status]\n", command)
+		os.Exit(1)
+	}
+}
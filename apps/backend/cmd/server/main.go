@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
@@ -9,14 +10,14 @@ import (
 	"syscall"
 	"time"
 
+	_ "app-backend/docs" // Import generated swagger docs
 	"app-backend/internal/config"
 	"app-backend/internal/container"
 	"app-backend/internal/database"
+	_ "app-backend/internal/docs" // Import docs for swagger generation
 	"app-backend/internal/logger"
 	"app-backend/internal/middleware"
 	"app-backend/internal/routes"
-	_ "app-backend/docs" // Import generated swagger docs
-	_ "app-backend/internal/docs" // Import docs for swagger generation
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -49,11 +50,40 @@ func main() {
 	}
 	appLogger.Info("Database connected successfully")
 
-	// Auto-migrate database schemas
-	if err := database.AutoMigrate(db); err != nil {
-		appLogger.Fatal("Failed to migrate database", zap.Error(err))
+	// Apply versioned migrations outside production; production schemas only
+	// change through an explicit `migrate up`, so refuse to boot when the
+	// database is behind the binary
+	if cfg.App.Environment == "production" {
+		pending, err := database.Pending(db)
+		if err != nil {
+			appLogger.Fatal("Failed to check migration status", zap.Error(err))
+		}
+		if len(pending) > 0 {
+			names := make([]string, len(pending))
+			for i, m := range pending {
+				names[i] = fmt.Sprintf("%06d_%s", m.Version, m.Name)
+			}
+			appLogger.Fatal("Refusing to start with pending database migrations; run cmd/migrate first",
+				zap.Strings("pending", names),
+			)
+		}
+		appLogger.Info("Database schema is up to date")
+	} else {
+		applied, err := database.Migrate(db)
+		if err != nil {
+			appLogger.Fatal("Failed to migrate database", zap.Error(err))
+		}
+		appLogger.Info("Database migration completed", zap.Int("applied", applied))
+	}
+
+	// Route read-only queries to the replica once the schema is settled;
+	// migrations above always ran against the primary
+	if cfg.Database.ReplicaURL != "" {
+		if err := database.UseReplica(db, cfg.Database.ReplicaURL); err != nil {
+			appLogger.Fatal("Failed to connect to read replica", zap.Error(err))
+		}
+		appLogger.Info("Read replica connected, read queries will be routed to it")
 	}
-	appLogger.Info("Database migration completed")
 
 	// Initialize dependency container
 	appContainer := container.NewContainer(cfg, db, appLogger)
@@ -69,18 +99,47 @@ func main() {
 	// Add middleware in order
 	router.Use(middleware.RequestID())
 	router.Use(middleware.LoggingMiddleware(appLogger.Slog()))
+	// Compression wraps everything below it so error bodies written by the
+	// recovery and error-handling middleware are compressed too
+	if cfg.HTTP.GzipEnabled {
+		router.Use(middleware.Gzip())
+	}
 	router.Use(middleware.Recovery(appLogger))
 	router.Use(middleware.ErrorHandler(appLogger))
-	router.Use(middleware.CORS(cfg))
+	router.Use(appContainer.CORSMiddleware.Handler())
+	router.Use(middleware.BodyLimit(cfg.HTTP.MaxBodyBytes, cfg.HTTP.RouteMaxBodyBytes))
+	router.Use(middleware.ConcurrencyLimit(cfg.Concurrency.MaxInFlight, cfg.Concurrency.MaxWaitDuration()))
+	router.Use(appContainer.RateLimitMiddleware.Handler())
+	// Persist security events handlers mark on the request
+	router.Use(middleware.Audit(appContainer.AuditService))
 
 	// Setup all application routes
 	routeConfig := &routes.RouteConfig{
-		AuthHandler:        appContainer.AuthHandler,
-		UserHandler:        appContainer.UserHandler,
-		VideoHandler:       appContainer.VideoHandler,
-		OAuthHandler:       appContainer.OAuthHandler,
-		TranslationHandler: appContainer.TranslationHandler,
-		AuthMiddleware:     appContainer.AuthMiddleware,
+		AuthHandler:         appContainer.AuthHandler,
+		CapabilitiesHandler: appContainer.CapabilitiesHandler,
+		CorrectionHandler:   appContainer.CorrectionHandler,
+		DictionaryHandler:   appContainer.DictionaryHandler,
+		ExplainHandler:      appContainer.ExplainHandler,
+		RealtimeHandler:     appContainer.RealtimeHandler,
+		UserHandler:         appContainer.UserHandler,
+		VideoHandler:        appContainer.VideoHandler,
+		OAuthHandler:        appContainer.OAuthHandler,
+		TranslationHandler:  appContainer.TranslationHandler,
+		AnalyticsHandler:    appContainer.AnalyticsHandler,
+		APIKeyHandler:       appContainer.APIKeyHandler,
+		VocabHandler:        appContainer.VocabHandler,
+		UserVideoHandler:    appContainer.UserVideoHandler,
+		SearchHandler:       appContainer.SearchHandler,
+		AdminHandler:        appContainer.AdminHandler,
+		ImportHandler:       appContainer.ImportHandler,
+		JobsHandler:         appContainer.JobsHandler,
+		SubtitleHandler:     appContainer.SubtitleHandler,
+		PracticeHandler:     appContainer.PracticeHandler,
+		WorkspaceHandler:    appContainer.WorkspaceHandler,
+
+		AuthMiddleware:          appContainer.AuthMiddleware,
+		WorkspaceAuthMiddleware: appContainer.WorkspaceAuthMiddleware,
+		Config:                  cfg,
 	}
 	routes.SetupRoutes(router, routeConfig)
 	appLogger.Info("Routes configured successfully")
@@ -99,6 +158,29 @@ func main() {
 		}
 	}()
 
+	// Reload configuration whenever the config file changes on disk; the
+	// same path backs the admin reload endpoint and SIGHUP
+	config.Watch(func(newCfg *config.Config) {
+		appContainer.ApplyConfig(newCfg)
+		appLogger.Info("Configuration reloaded from file change")
+	}, func(err error) {
+		appLogger.Error("Config file changed but failed to load, keeping current configuration", zap.Error(err))
+	})
+
+	// Re-apply the configuration on SIGHUP as well, for operators who prefer
+	// signaling over editing the watched file in place
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			if err := appContainer.ReloadConfig(); err != nil {
+				appLogger.Error("Config reload failed, keeping current configuration", zap.Error(err))
+				continue
+			}
+			appLogger.Info("Configuration reloaded on SIGHUP")
+		}
+	}()
+
 	// Wait for interrupt signal to gracefully shutdown the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -106,6 +188,22 @@ func main() {
 
 	appLogger.Info("Shutting down server...")
 
+	// Stop background work before draining requests
+	if appContainer.TranscriptProber != nil {
+		appContainer.TranscriptProber.Stop()
+	}
+	// Stop the purge scheduler before the queue it enqueues into
+	if appContainer.PrivacyService != nil {
+		appContainer.PrivacyService.Stop()
+	}
+	// Drain the job queue before its import workers go away
+	if appContainer.JobService != nil {
+		appContainer.JobService.Stop()
+	}
+	if appContainer.ImportService != nil {
+		appContainer.ImportService.Stop()
+	}
+
 	// Give outstanding requests 30 seconds to complete
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -115,4 +213,4 @@ func main() {
 	}
 
 	appLogger.Info("Server exited")
-}
\ No newline at end of file
+}
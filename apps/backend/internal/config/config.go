@@ -3,18 +3,240 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	App          AppConfig           `mapstructure:"app"`
+	App          AppConfig          `mapstructure:"app"`
 	Database     DatabaseConfig     `mapstructure:"database"`
 	JWT          JWTConfig          `mapstructure:"jwt"`
 	CORS         CORSConfig         `mapstructure:"cors"`
 	Security     SecurityConfig     `mapstructure:"security"`
 	ExternalAPIs ExternalAPIsConfig `mapstructure:"external_apis"`
 	Transcript   TranscriptConfig   `mapstructure:"transcript"`
+	Translation  TranslationConfig  `mapstructure:"translation"`
+	Features     FeaturesConfig     `mapstructure:"features"`
+	CacheControl CacheControlConfig `mapstructure:"cache_control"`
+	Streaming    StreamingConfig    `mapstructure:"streaming"`
+	Concurrency  ConcurrencyConfig  `mapstructure:"concurrency"`
+	HTTP         HTTPConfig         `mapstructure:"http"`
+	Encryption   EncryptionConfig   `mapstructure:"encryption"`
+	BatchImport  BatchImportConfig  `mapstructure:"batch_import"`
+	Jobs         JobsConfig         `mapstructure:"jobs"`
+	Privacy      PrivacyConfig      `mapstructure:"privacy"`
+	Practice     PracticeConfig     `mapstructure:"practice"`
+	Explain      ExplainConfig      `mapstructure:"explain"`
+	Summary      SummaryConfig      `mapstructure:"summary"`
+	Share        ShareConfig        `mapstructure:"share"`
+}
+
+// PracticeConfig tunes pronunciation practice assessment
+type PracticeConfig struct {
+	// Provider names the speech-assessment backend; gemini is the only
+	// in-tree backend
+	Provider string `mapstructure:"provider"`
+	// MaxAudioBytes bounds the size of an uploaded practice clip
+	MaxAudioBytes int64 `mapstructure:"max_audio_bytes"`
+}
+
+// ExplainConfig tunes the grammar explanation endpoint
+type ExplainConfig struct {
+	// RequestsPerMinute bounds fresh model-backed breakdowns per user per
+	// minute; cached breakdowns are served without counting
+	RequestsPerMinute int `mapstructure:"requests_per_minute"`
+}
+
+// SummaryConfig tunes the transcript summary endpoint
+type SummaryConfig struct {
+	// RequestsPerMinute bounds fresh model-backed summaries per user per
+	// minute; cached summaries are served without counting
+	RequestsPerMinute int `mapstructure:"requests_per_minute"`
+}
+
+// ShareConfig tunes signed transcript share links
+type ShareConfig struct {
+	// Secret signs share tokens; empty derives the signing key from the JWT
+	// secret instead
+	Secret string `mapstructure:"secret"`
+	// DefaultTTL is how long a link lives when the creator does not pick an
+	// expiry (Go duration)
+	DefaultTTL string `mapstructure:"default_ttl"`
+	// MaxTTL caps the expiry a creator may request (Go duration)
+	MaxTTL string `mapstructure:"max_ttl"`
+}
+
+// DefaultTTLDuration returns the parsed default link lifetime, falling back
+// to 72h
+func (c *ShareConfig) DefaultTTLDuration() time.Duration {
+	if d, err := time.ParseDuration(c.DefaultTTL); err == nil && d > 0 {
+		return d
+	}
+	return 72 * time.Hour
+}
+
+// MaxTTLDuration returns the parsed expiry cap, falling back to 30 days
+func (c *ShareConfig) MaxTTLDuration() time.Duration {
+	if d, err := time.ParseDuration(c.MaxTTL); err == nil && d > 0 {
+		return d
+	}
+	return 720 * time.Hour
+}
+
+// PrivacyConfig tunes GDPR data exports and the scheduled account purge
+type PrivacyConfig struct {
+	// ExportDir is the directory user data export archives are written to
+	ExportDir string      `mapstructure:"export_dir"`
+	Purge     PurgeConfig `mapstructure:"purge"`
+}
+
+// PurgeConfig controls when soft-deleted accounts are hard-deleted
+type PurgeConfig struct {
+	// Enabled turns the scheduled purge on
+	Enabled bool `mapstructure:"enabled"`
+	// Retention is how long soft-deleted accounts are kept (Go duration)
+	Retention string `mapstructure:"retention"`
+	// Interval is how often a purge run is scheduled (Go duration)
+	Interval string `mapstructure:"interval"`
+}
+
+// RetentionDuration returns the parsed retention window; zero when unset or
+// invalid, which lets the privacy service apply its 30-day default
+func (c *PurgeConfig) RetentionDuration() time.Duration {
+	if d, err := time.ParseDuration(c.Retention); err == nil && d > 0 {
+		return d
+	}
+	return 0
+}
+
+// IntervalDuration returns the parsed purge schedule interval; zero when
+// unset or invalid, which lets the privacy service apply its daily default
+func (c *PurgeConfig) IntervalDuration() time.Duration {
+	if d, err := time.ParseDuration(c.Interval); err == nil && d > 0 {
+		return d
+	}
+	return 0
+}
+
+// JobsConfig tunes the persistent background job queue
+type JobsConfig struct {
+	// Workers is the number of concurrent job workers
+	Workers int `mapstructure:"workers"`
+	// PollInterval is how often due jobs are claimed from the database
+	PollInterval string `mapstructure:"poll_interval"`
+	// MaxAttempts is how often a job runs before failing permanently
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// RetryBackoff is the first retry delay; it doubles per attempt
+	RetryBackoff string `mapstructure:"retry_backoff"`
+	// Timeout bounds a single job attempt
+	Timeout string `mapstructure:"timeout"`
+}
+
+// PollIntervalDuration returns the parsed poll interval; zero when unset or
+// invalid, which lets the queue apply its own default
+func (c *JobsConfig) PollIntervalDuration() time.Duration {
+	if d, err := time.ParseDuration(c.PollInterval); err == nil && d > 0 {
+		return d
+	}
+	return 0
+}
+
+// RetryBackoffDuration returns the parsed first retry delay; zero when unset
+// or invalid, which lets the queue apply its own default
+func (c *JobsConfig) RetryBackoffDuration() time.Duration {
+	if d, err := time.ParseDuration(c.RetryBackoff); err == nil && d > 0 {
+		return d
+	}
+	return 0
+}
+
+// TimeoutDuration returns the parsed job attempt timeout; zero when unset or
+// invalid, which lets the queue apply its own default
+func (c *JobsConfig) TimeoutDuration() time.Duration {
+	if d, err := time.ParseDuration(c.Timeout); err == nil && d > 0 {
+		return d
+	}
+	return 0
+}
+
+// BatchImportConfig tunes playlist and channel transcript imports
+type BatchImportConfig struct {
+	// MaxVideos caps how many videos one import job may cover
+	MaxVideos int `mapstructure:"max_videos"`
+	// Workers is the number of concurrent transcript fetch workers
+	Workers int `mapstructure:"workers"`
+}
+
+// EncryptionConfig holds the key used to encrypt sensitive data (e.g. stored
+// OAuth tokens) at rest; features that need it stay disabled when empty
+type EncryptionConfig struct {
+	Key string `mapstructure:"key"`
+}
+
+// ConcurrencyConfig bounds the total number of in-flight HTTP requests
+type ConcurrencyConfig struct {
+	// MaxInFlight caps concurrent requests process-wide; zero disables the cap
+	MaxInFlight int `mapstructure:"max_in_flight"`
+	// MaxWait is how long a saturated request queues for a slot before being
+	// rejected with 503; empty or zero rejects immediately
+	MaxWait string `mapstructure:"max_wait"`
+}
+
+// MaxWaitDuration returns the parsed saturation queue timeout; zero when
+// unset or invalid
+func (c *ConcurrencyConfig) MaxWaitDuration() time.Duration {
+	if d, err := time.ParseDuration(c.MaxWait); err == nil && d > 0 {
+		return d
+	}
+	return 0
+}
+
+// HTTPConfig tunes cross-cutting HTTP middleware: response compression and
+// request body size caps
+type HTTPConfig struct {
+	// GzipEnabled compresses response bodies for clients that accept it;
+	// event streams are never compressed
+	GzipEnabled bool `mapstructure:"gzip_enabled"`
+	// MaxBodyBytes caps request body sizes; zero disables the cap
+	MaxBodyBytes int64 `mapstructure:"max_body_bytes"`
+	// RouteMaxBodyBytes raises (or lowers) the cap for route prefixes that
+	// accept bulk payloads; the longest matching prefix wins
+	RouteMaxBodyBytes map[string]int64 `mapstructure:"route_max_body_bytes"`
+}
+
+// StreamingConfig bounds long-lived streaming connections (SSE, NDJSON)
+type StreamingConfig struct {
+	// MaxConcurrent caps concurrent streaming connections process-wide
+	MaxConcurrent int `mapstructure:"max_concurrent"`
+	// MaxPerUser caps concurrent streaming connections per user
+	MaxPerUser int `mapstructure:"max_per_user"`
+}
+
+// FeaturesConfig maps feature names to an enabled flag so operators can turn
+// expensive endpoints off without a redeploy. Known features:
+//   - translation: the synchronous translation endpoint
+//   - translation_bulk: the asynchronous bulk translation pipeline
+//   - transcript: video transcript extraction
+//
+// Features absent from the map are enabled.
+type FeaturesConfig map[string]bool
+
+// CacheControlConfig maps endpoint types to the Cache-Control header value
+// served for them. Known endpoint types:
+//   - static: slow-changing lists (supported providers, languages)
+//   - dynamic: per-video and per-request responses
+//   - auth: authentication and session endpoints
+//
+// Endpoint types absent from the map use built-in defaults.
+type CacheControlConfig map[string]string
+
+// defaultCacheControl holds the fallback Cache-Control values per endpoint type
+var defaultCacheControl = map[string]string{
+	"static":  "public, max-age=86400",
+	"dynamic": "no-cache",
+	"auth":    "no-store",
 }
 
 type AppConfig struct {
@@ -35,12 +257,15 @@ type DatabaseConfig struct {
 	MaxOpenConns    int    `mapstructure:"max_open_conns"`
 	MaxIdleConns    int    `mapstructure:"max_idle_conns"`
 	ConnMaxLifetime string `mapstructure:"conn_max_lifetime"`
+	// ReplicaURL is a full DSN for an optional read replica; when set,
+	// read-only queries are routed to it while writes stay on the primary
+	ReplicaURL string `mapstructure:"replica_url"`
 }
 
 type JWTConfig struct {
-	Secret             string `mapstructure:"secret"`
-	AccessTTLMinutes   int    `mapstructure:"access_ttl_minutes"`
-	RefreshTTLHours    int    `mapstructure:"refresh_ttl_hours"`
+	Secret           string `mapstructure:"secret"`
+	AccessTTLMinutes int    `mapstructure:"access_ttl_minutes"`
+	RefreshTTLHours  int    `mapstructure:"refresh_ttl_hours"`
 }
 
 type CORSConfig struct {
@@ -49,28 +274,106 @@ type CORSConfig struct {
 	AllowedHeaders []string `mapstructure:"allowed_headers"`
 	ExposeHeaders  []string `mapstructure:"expose_headers"`
 	MaxAge         int      `mapstructure:"max_age"`
+	// ExtensionIDs lists Chrome extension IDs whose chrome-extension://<id>
+	// origins are accepted in addition to allowed_origins
+	ExtensionIDs []string `mapstructure:"extension_ids"`
+	// Groups overrides the origin policy for route prefixes; the longest
+	// matching prefix wins and unmatched paths use the global policy
+	Groups map[string]CORSGroupConfig `mapstructure:"groups"`
+}
+
+// CORSGroupConfig overrides the origin rules for one route prefix, e.g. to
+// open public video metadata endpoints to any origin while authenticated
+// user endpoints stay locked to known frontends. Methods, headers, and the
+// extension allowlist always come from the global cors section.
+type CORSGroupConfig struct {
+	// AllowAllOrigins opens the prefix to any origin
+	AllowAllOrigins bool `mapstructure:"allow_all_origins"`
+	// AllowedOrigins replaces the global origin list for the prefix; empty
+	// keeps the global list
+	AllowedOrigins []string `mapstructure:"allowed_origins"`
 }
 
 type SecurityConfig struct {
-	BcryptCost int           `mapstructure:"bcrypt_cost"`
-	RateLimit  RateLimitConfig `mapstructure:"rate_limit"`
+	BcryptCost int              `mapstructure:"bcrypt_cost"`
+	RateLimit  RateLimitConfig  `mapstructure:"rate_limit"`
+	SessionGeo SessionGeoConfig `mapstructure:"session_geo"`
+}
+
+// SessionGeoConfig configures coarse IP geolocation for login sessions
+type SessionGeoConfig struct {
+	// Enabled turns on location lookups for new sessions; device parsing
+	// from the User-Agent happens regardless
+	Enabled bool `mapstructure:"enabled"`
+	// Endpoint is an ip-api style base URL; GET {endpoint}/{ip} must return
+	// JSON with "city" and "country" fields
+	Endpoint string `mapstructure:"endpoint"`
+	// Timeout bounds one lookup, e.g. "2s"; slow lookups must never delay a
+	// login
+	Timeout string `mapstructure:"timeout"`
+	// CacheTTL is how long a resolved location is reused per IP, e.g. "24h"
+	CacheTTL string `mapstructure:"cache_ttl"`
+}
+
+// TimeoutDuration returns the parsed lookup timeout, falling back to 2s
+func (c *SessionGeoConfig) TimeoutDuration() time.Duration {
+	if d, err := time.ParseDuration(c.Timeout); err == nil && d > 0 {
+		return d
+	}
+	return 2 * time.Second
+}
+
+// CacheTTLDuration returns the parsed cache TTL, falling back to 24h
+func (c *SessionGeoConfig) CacheTTLDuration() time.Duration {
+	if d, err := time.ParseDuration(c.CacheTTL); err == nil && d > 0 {
+		return d
+	}
+	return 24 * time.Hour
 }
 
 type RateLimitConfig struct {
-	RequestsPerMinute int `mapstructure:"requests_per_minute"`
-	Burst             int `mapstructure:"burst"`
+	// Enabled turns on per-client request rate limiting
+	Enabled           bool `mapstructure:"enabled"`
+	RequestsPerMinute int  `mapstructure:"requests_per_minute"`
+	Burst             int  `mapstructure:"burst"`
+	// RedisURL stores rate counters in Redis so the limit holds across
+	// backend instances; empty keeps them in process memory
+	RedisURL string `mapstructure:"redis_url"`
 }
 
 type ExternalAPIsConfig struct {
 	YouTube YouTubeConfig `mapstructure:"youtube"`
 	Gemini  GeminiConfig  `mapstructure:"gemini"`
+	// DeepL and GoogleTranslate are optional fallback translation providers
+	DeepL           TranslationProviderConfig `mapstructure:"deepl"`
+	GoogleTranslate TranslationProviderConfig `mapstructure:"google_translate"`
+}
+
+// TranslationProviderConfig configures an optional translation provider;
+// providers that are disabled or missing an API key are not registered
+type TranslationProviderConfig struct {
+	APIKey  string `mapstructure:"api_key"`
+	APIURL  string `mapstructure:"api_url"`
+	Enabled bool   `mapstructure:"enabled"`
+	// Priority orders providers in the registry (lower number = higher
+	// priority); 0 uses the provider's default
+	Priority int `mapstructure:"priority"`
 }
 
 type YouTubeConfig struct {
-	APIKey       string      `mapstructure:"api_key"`
-	APIURL       string      `mapstructure:"api_url"`
-	RateLimit    int         `mapstructure:"rate_limit"`
-	OAuth        OAuthConfig `mapstructure:"oauth"`
+	APIKey    string      `mapstructure:"api_key"`
+	APIURL    string      `mapstructure:"api_url"`
+	RateLimit int         `mapstructure:"rate_limit"`
+	OAuth     OAuthConfig `mapstructure:"oauth"`
+	// EstimateSegmentSeconds is the assumed average caption segment length
+	// used for transcript size estimates in capabilities
+	EstimateSegmentSeconds int `mapstructure:"estimate_segment_seconds"`
+	// QuotaBudget is the daily Data API quota-unit budget to account calls
+	// against; zero disables quota tracking
+	QuotaBudget int64 `mapstructure:"quota_budget"`
+	// QuotaLowFraction is the remaining fraction of the budget below which
+	// transcript fetching prefers the scraping providers over the API
+	QuotaLowFraction float64 `mapstructure:"quota_low_fraction"`
 }
 
 type OAuthConfig struct {
@@ -84,10 +387,114 @@ type GeminiConfig struct {
 	APIKey    string `mapstructure:"api_key"`
 	APIURL    string `mapstructure:"api_url"`
 	RateLimit int    `mapstructure:"rate_limit"`
+	// Model and generation parameters. The running server re-applies them on
+	// SIGHUP, so operators can switch models or tune behavior without a
+	// restart.
+	Model       string  `mapstructure:"model"`
+	Temperature float64 `mapstructure:"temperature"`
+	// TopK, TopP, and MaxOutputTokens fall back to the model's own defaults
+	// when left at 0
+	TopK            int     `mapstructure:"top_k"`
+	TopP            float64 `mapstructure:"top_p"`
+	MaxOutputTokens int     `mapstructure:"max_output_tokens"`
+	// SafetySettings maps harm categories (harassment, hate_speech,
+	// sexually_explicit, dangerous_content) to block thresholds (block_none,
+	// block_low_and_above, block_medium_and_above, block_only_high)
+	SafetySettings map[string]string `mapstructure:"safety_settings"`
 }
 
 type TranscriptConfig struct {
 	Providers []TranscriptProviderConfig `mapstructure:"providers"`
+	Cache     TranscriptCacheConfig      `mapstructure:"cache"`
+	// Breaker configures the per-provider circuit breakers that skip
+	// repeatedly failing providers for a cooldown period
+	Breaker TranscriptBreakerConfig `mapstructure:"breaker"`
+	// Health configures the background provider prober feeding the admin
+	// health dashboard
+	Health TranscriptHealthConfig `mapstructure:"health"`
+	// Local serves transcripts from a directory of caption files named by
+	// video ID, for offline deployments and deterministic demos
+	Local TranscriptLocalConfig `mapstructure:"local"`
+	// FallbackBackoff staggers provider attempts by this duration (with
+	// jitter) so fallbacks don't hammer a shared upstream at once; empty or
+	// zero launches all providers immediately
+	FallbackBackoff string `mapstructure:"fallback_backoff"`
+	// Scoring adapts provider ordering to observed success rate, latency,
+	// and result quality instead of the static priorities alone
+	Scoring TranscriptScoringConfig `mapstructure:"scoring"`
+}
+
+// TranscriptScoringConfig tunes adaptive transcript provider ordering
+type TranscriptScoringConfig struct {
+	// Enabled turns adaptive ordering on
+	Enabled bool `mapstructure:"enabled"`
+	// Window is how many recent attempts per provider inform its score
+	Window int `mapstructure:"window"`
+	// Pinned lists provider types that keep their static priority and are
+	// ordered ahead of the scored providers
+	Pinned []string `mapstructure:"pinned"`
+}
+
+// FallbackBackoffDuration returns the parsed provider stagger delay; zero
+// when unset or invalid
+func (c *TranscriptConfig) FallbackBackoffDuration() time.Duration {
+	if d, err := time.ParseDuration(c.FallbackBackoff); err == nil && d > 0 {
+		return d
+	}
+	return 0
+}
+
+// TranscriptBreakerConfig configures the circuit breaker wrapped around each
+// transcript provider: after FailureThreshold consecutive failures the
+// provider is skipped until the cooldown elapses
+type TranscriptBreakerConfig struct {
+	Enabled          bool   `mapstructure:"enabled"`
+	FailureThreshold int    `mapstructure:"failure_threshold"`
+	Cooldown         string `mapstructure:"cooldown"`
+}
+
+// CooldownDuration returns the parsed breaker cooldown, falling back to 1m
+func (c *TranscriptBreakerConfig) CooldownDuration() time.Duration {
+	if d, err := time.ParseDuration(c.Cooldown); err == nil && d > 0 {
+		return d
+	}
+	return time.Minute
+}
+
+// TranscriptHealthConfig configures background transcript provider health
+// probing
+type TranscriptHealthConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	ProbeInterval string `mapstructure:"probe_interval"`
+}
+
+// ProbeIntervalDuration returns the parsed probe interval, falling back to 1m
+func (c *TranscriptHealthConfig) ProbeIntervalDuration() time.Duration {
+	if d, err := time.ParseDuration(c.ProbeInterval); err == nil && d > 0 {
+		return d
+	}
+	return time.Minute
+}
+
+// TranscriptLocalConfig configures the local caption-file provider. Files
+// are looked up in Directory as <videoID>.<language>.srt|vtt or
+// <videoID>.srt|vtt.
+type TranscriptLocalConfig struct {
+	Enabled   bool   `mapstructure:"enabled"`
+	Directory string `mapstructure:"directory"`
+	Priority  int    `mapstructure:"priority"`
+}
+
+type TranscriptCacheConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	MaxAge     string `mapstructure:"max_age"`
+	StaleAfter string `mapstructure:"stale_after"`
+	// Persist layers the database under the in-memory cache; reads fall back
+	// to memory when the database is unavailable
+	Persist bool `mapstructure:"persist"`
+	// ServeStaleOnError serves cached transcripts past max_age, marked stale,
+	// when all live providers fail
+	ServeStaleOnError bool `mapstructure:"serve_stale_on_error"`
 }
 
 type TranscriptProviderConfig struct {
@@ -97,6 +504,79 @@ type TranscriptProviderConfig struct {
 	Config   map[string]interface{} `mapstructure:"config"`
 }
 
+type TranslationConfig struct {
+	Detection LanguageDetectionConfig `mapstructure:"detection"`
+	Limits    TranslationLimitsConfig `mapstructure:"limits"`
+	// ChunkOverlap is how many trailing segments of a translation chunk are
+	// included as read-only context in the next chunk's prompt; 0 disables it
+	ChunkOverlap int `mapstructure:"chunk_overlap"`
+	// SegmentCacheSize is how many per-segment translations are kept in
+	// memory so repeated subtitle lines skip the Gemini call; 0 disables it
+	SegmentCacheSize int                     `mapstructure:"segment_cache_size"`
+	Quota            TranslationQuotaConfig  `mapstructure:"quota"`
+	Memory           TranslationMemoryConfig `mapstructure:"memory"`
+}
+
+// TranslationMemoryConfig tunes translation-memory matching. FuzzyThreshold
+// is the minimum similarity (0-1) a stored segment must reach to be served
+// as a fuzzy match; 0 keeps the default and a negative value disables fuzzy
+// matching entirely.
+type TranslationMemoryConfig struct {
+	FuzzyThreshold float64 `mapstructure:"fuzzy_threshold"`
+}
+
+// TranslationQuotaConfig limits how many characters an authenticated user may
+// translate per calendar day and month; 0 means unlimited
+type TranslationQuotaConfig struct {
+	DailyChars   int `mapstructure:"daily_chars"`
+	MonthlyChars int `mapstructure:"monthly_chars"`
+}
+
+type TranslationLimitsConfig struct {
+	MaxSegments int `mapstructure:"max_segments"`
+	MaxChars    int `mapstructure:"max_chars"`
+}
+
+type LanguageDetectionConfig struct {
+	SampleCount  int     `mapstructure:"sample_count"`
+	MinAgreement float64 `mapstructure:"min_agreement"`
+}
+
+// MaxAgeDuration returns the parsed max age, falling back to 24h
+func (c *TranscriptCacheConfig) MaxAgeDuration() time.Duration {
+	if d, err := time.ParseDuration(c.MaxAge); err == nil && d > 0 {
+		return d
+	}
+	return 24 * time.Hour
+}
+
+// StaleAfterDuration returns the parsed soft staleness threshold, falling back to 1h
+func (c *TranscriptCacheConfig) StaleAfterDuration() time.Duration {
+	if d, err := time.ParseDuration(c.StaleAfter); err == nil && d > 0 {
+		return d
+	}
+	return time.Hour
+}
+
+// FeatureEnabled reports whether the named feature is enabled; features not
+// listed in the config default to enabled
+func (c *Config) FeatureEnabled(name string) bool {
+	enabled, ok := c.Features[name]
+	if !ok {
+		return true
+	}
+	return enabled
+}
+
+// CacheControlValue returns the Cache-Control header value for the given
+// endpoint type, falling back to the built-in defaults when unconfigured
+func (c *Config) CacheControlValue(endpointType string) string {
+	if value, ok := c.CacheControl[endpointType]; ok {
+		return value
+	}
+	return defaultCacheControl[endpointType]
+}
+
 // GetDatabaseURL returns the formatted database connection URL
 func (c *Config) GetDatabaseURL() string {
 	return fmt.Sprintf(
@@ -147,6 +627,10 @@ func New() (*Config, error) {
 		}
 	}
 
+	// Point viper back at the base config file so Watch observes it rather
+	// than the (possibly absent) environment-specific overlay
+	viper.SetConfigName("app")
+
 	// Unmarshal configuration
 	var config Config
 	if err := viper.Unmarshal(&config); err != nil {
@@ -156,6 +640,23 @@ func New() (*Config, error) {
 	return &config, nil
 }
 
+// Watch invokes onChange with a freshly loaded configuration whenever the
+// config file changes on disk. onChange is skipped when the changed file no
+// longer parses, so a bad edit keeps the last good configuration running.
+func Watch(onChange func(*Config), onError func(error)) {
+	viper.OnConfigChange(func(fsnotify.Event) {
+		cfg, err := New()
+		if err != nil {
+			if onError != nil {
+				onError(err)
+			}
+			return
+		}
+		onChange(cfg)
+	})
+	viper.WatchConfig()
+}
+
 // setDefaults sets default configuration values
 func setDefaults() {
 	// App defaults
@@ -175,6 +676,7 @@ func setDefaults() {
 	viper.SetDefault("database.max_open_conns", 25)
 	viper.SetDefault("database.max_idle_conns", 5)
 	viper.SetDefault("database.conn_max_lifetime", "1h")
+	viper.SetDefault("database.replica_url", "")
 
 	// JWT defaults
 	viper.SetDefault("jwt.secret", "your-super-secret-jwt-key-change-in-production")
@@ -187,32 +689,150 @@ func setDefaults() {
 	viper.SetDefault("cors.allowed_headers", []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With"})
 	viper.SetDefault("cors.expose_headers", []string{"Content-Length", "Content-Type"})
 	viper.SetDefault("cors.max_age", 300)
+	viper.SetDefault("cors.extension_ids", []string{})
 
 	// Security defaults
 	viper.SetDefault("security.bcrypt_cost", 12)
+	viper.SetDefault("security.rate_limit.enabled", false)
 	viper.SetDefault("security.rate_limit.requests_per_minute", 60)
 	viper.SetDefault("security.rate_limit.burst", 10)
+	viper.SetDefault("security.rate_limit.redis_url", "")
+	viper.SetDefault("security.session_geo.enabled", false)
+	viper.SetDefault("security.session_geo.endpoint", "")
+	viper.SetDefault("security.session_geo.timeout", "2s")
+	viper.SetDefault("security.session_geo.cache_ttl", "24h")
+
+	// Encryption defaults (empty disables features that need a key)
+	viper.SetDefault("encryption.key", "")
+
+	// Transcript share link defaults
+	viper.SetDefault("share.secret", "")
+	viper.SetDefault("share.default_ttl", "72h")
+	viper.SetDefault("share.max_ttl", "720h")
+
+	// Batch import defaults
+	viper.SetDefault("batch_import.max_videos", 200)
+	viper.SetDefault("batch_import.workers", 3)
+
+	// Background job queue defaults
+	viper.SetDefault("jobs.workers", 3)
+	viper.SetDefault("jobs.poll_interval", "1s")
+	viper.SetDefault("jobs.max_attempts", 3)
+	viper.SetDefault("jobs.retry_backoff", "30s")
+	viper.SetDefault("jobs.timeout", "10m")
+
+	// Privacy defaults
+	viper.SetDefault("privacy.export_dir", "./storage/exports")
+	viper.SetDefault("privacy.purge.enabled", true)
+	viper.SetDefault("privacy.purge.retention", "720h")
+	viper.SetDefault("privacy.purge.interval", "24h")
+
+	// Pronunciation practice defaults
+	viper.SetDefault("practice.provider", "gemini")
+	viper.SetDefault("practice.max_audio_bytes", 2<<20)
+
+	// Grammar explanation defaults
+	viper.SetDefault("explain.requests_per_minute", 10)
+
+	// Transcript summary defaults
+	viper.SetDefault("summary.requests_per_minute", 5)
 
 	// External APIs defaults
 	viper.SetDefault("external_apis.youtube.api_key", "")
 	viper.SetDefault("external_apis.youtube.api_url", "https://www.googleapis.com/youtube/v3")
 	viper.SetDefault("external_apis.youtube.rate_limit", 100)
-	
+	viper.SetDefault("external_apis.youtube.estimate_segment_seconds", 4)
+	viper.SetDefault("external_apis.youtube.quota_budget", 10000)
+	viper.SetDefault("external_apis.youtube.quota_low_fraction", 0.1)
+
 	// YouTube OAuth defaults
 	viper.SetDefault("external_apis.youtube.oauth.client_id", "")
 	viper.SetDefault("external_apis.youtube.oauth.client_secret", "")
 	viper.SetDefault("external_apis.youtube.oauth.redirect_url", "http://localhost:8000/api/v1/oauth/youtube/callback")
 	viper.SetDefault("external_apis.youtube.oauth.token_storage", "./.oauth_tokens")
-	
+
 	viper.SetDefault("external_apis.gemini.api_key", "")
 	viper.SetDefault("external_apis.gemini.api_url", "https://generativelanguage.googleapis.com")
 	viper.SetDefault("external_apis.gemini.rate_limit", 60)
-	
+	viper.SetDefault("external_apis.gemini.model", "gemini-1.5-flash")
+	viper.SetDefault("external_apis.gemini.temperature", 0.1)
+	viper.SetDefault("external_apis.gemini.top_k", 1)
+	viper.SetDefault("external_apis.gemini.top_p", 0.1)
+	viper.SetDefault("external_apis.gemini.max_output_tokens", 0)
+	viper.SetDefault("external_apis.gemini.safety_settings", map[string]string{})
+	viper.SetDefault("external_apis.deepl.api_key", "")
+	viper.SetDefault("external_apis.deepl.api_url", "")
+	viper.SetDefault("external_apis.deepl.enabled", false)
+	viper.SetDefault("external_apis.deepl.priority", 0)
+	viper.SetDefault("external_apis.google_translate.api_key", "")
+	viper.SetDefault("external_apis.google_translate.api_url", "")
+	viper.SetDefault("external_apis.google_translate.enabled", false)
+	viper.SetDefault("external_apis.google_translate.priority", 0)
+
+	// Translation defaults
+	viper.SetDefault("translation.detection.sample_count", 3)
+	viper.SetDefault("translation.detection.min_agreement", 0.6)
+	viper.SetDefault("translation.limits.max_segments", 500)
+	viper.SetDefault("translation.limits.max_chars", 100000)
+	viper.SetDefault("translation.chunk_overlap", 2)
+	viper.SetDefault("translation.segment_cache_size", 10000)
+	viper.SetDefault("translation.quota.daily_chars", 0)
+	viper.SetDefault("translation.quota.monthly_chars", 0)
+	viper.SetDefault("translation.memory.fuzzy_threshold", 0.75)
+
+	// Streaming defaults
+	viper.SetDefault("streaming.max_concurrent", 100)
+	viper.SetDefault("streaming.max_per_user", 4)
+
+	// Concurrency defaults (disabled unless an in-flight cap is configured)
+	viper.SetDefault("concurrency.max_in_flight", 0)
+	viper.SetDefault("concurrency.max_wait", "0s")
+
+	// HTTP middleware defaults; bulk-payload routes get a higher body cap
+	viper.SetDefault("http.gzip_enabled", true)
+	viper.SetDefault("http.max_body_bytes", 1<<20)
+	viper.SetDefault("http.route_max_body_bytes", map[string]int64{
+		"/api/v1/translate": 5 << 20,
+		"/api/v1/subtitles": 10 << 20,
+		"/api/v1/practice":  4 << 20,
+	})
+
+	// Transcript cache defaults
+	viper.SetDefault("transcript.cache.enabled", true)
+	viper.SetDefault("transcript.cache.max_age", "24h")
+	viper.SetDefault("transcript.cache.stale_after", "1h")
+	viper.SetDefault("transcript.cache.persist", false)
+	viper.SetDefault("transcript.cache.serve_stale_on_error", true)
+
+	// Stagger between provider fallback attempts; "0s" races all providers
+	viper.SetDefault("transcript.fallback_backoff", "0s")
+
+	// Adaptive provider ordering defaults (off; static priorities apply)
+	viper.SetDefault("transcript.scoring.enabled", false)
+	viper.SetDefault("transcript.scoring.window", 50)
+	viper.SetDefault("transcript.scoring.pinned", []string{})
+
+	// Per-provider circuit breaker defaults
+	viper.SetDefault("transcript.breaker.enabled", true)
+	viper.SetDefault("transcript.breaker.failure_threshold", 3)
+	viper.SetDefault("transcript.breaker.cooldown", "1m")
+
+	// Background provider health probing defaults
+	viper.SetDefault("transcript.health.enabled", true)
+	viper.SetDefault("transcript.health.probe_interval", "1m")
+
+	// Local caption-file provider defaults
+	viper.SetDefault("transcript.local.enabled", false)
+	viper.SetDefault("transcript.local.directory", "")
+	viper.SetDefault("transcript.local.priority", 0)
+
 	// Transcript service defaults
 	viper.SetDefault("transcript.providers", []map[string]interface{}{
 		{
+			// Registration is already gated on the YouTube API key, so the
+			// flag defaults to enabled
 			"type":     "youtube_api",
-			"enabled":  false,
+			"enabled":  true,
 			"priority": 1,
 			"config": map[string]interface{}{
 				"api_key": "",
@@ -239,4 +859,4 @@ func setDefaults() {
 			},
 		},
 	})
-}
\ No newline at end of file
+}
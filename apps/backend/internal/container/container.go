@@ -2,26 +2,73 @@ package container
 
 import (
 	"app-backend/internal/config"
+	"app-backend/internal/dto"
+	"app-backend/internal/handlers/admin"
+	"app-backend/internal/handlers/analytics"
+	"app-backend/internal/handlers/apikey"
 	"app-backend/internal/handlers/auth"
+	capabilitiesHandler "app-backend/internal/handlers/capabilities"
+	correctionHandler "app-backend/internal/handlers/correction"
+	dictionaryHandler "app-backend/internal/handlers/dictionary"
+	explainHandler "app-backend/internal/handlers/explain"
+	importerHandler "app-backend/internal/handlers/importer"
+	jobsHandler "app-backend/internal/handlers/jobs"
 	"app-backend/internal/handlers/oauth"
+	practiceHandler "app-backend/internal/handlers/practice"
+	realtimeHandler "app-backend/internal/handlers/realtime"
+	searchHandler "app-backend/internal/handlers/search"
+	subtitleHandler "app-backend/internal/handlers/subtitle"
 	"app-backend/internal/handlers/translation"
 	"app-backend/internal/handlers/user"
+	"app-backend/internal/handlers/uservideo"
 	"app-backend/internal/handlers/video"
+	"app-backend/internal/handlers/vocab"
+	workspaceHandler "app-backend/internal/handlers/workspace"
 	"app-backend/internal/logger"
 	"app-backend/internal/middleware"
 	"app-backend/internal/repositories"
+	adminService "app-backend/internal/services/admin"
+	analyticsService "app-backend/internal/services/analytics"
+	apikeyService "app-backend/internal/services/apikey"
+	auditService "app-backend/internal/services/audit"
 	authService "app-backend/internal/services/auth"
+	chaptersService "app-backend/internal/services/chapters"
+	correctionService "app-backend/internal/services/correction"
+	dictionaryService "app-backend/internal/services/dictionary"
+	explainService "app-backend/internal/services/explain"
+	importerService "app-backend/internal/services/importer"
+	jobsService "app-backend/internal/services/jobs"
 	jwtService "app-backend/internal/services/jwt"
+	keyphraseService "app-backend/internal/services/keyphrase"
+	languagesService "app-backend/internal/services/languages"
 	oauthService "app-backend/internal/services/oauth"
+	privacyService "app-backend/internal/services/privacy"
+	pronunciationService "app-backend/internal/services/pronunciation"
+	quizService "app-backend/internal/services/quiz"
+	realtimeService "app-backend/internal/services/realtime"
+	searchService "app-backend/internal/services/search"
+	sessioninfoService "app-backend/internal/services/sessioninfo"
+	shareService "app-backend/internal/services/share"
+	statsService "app-backend/internal/services/stats"
+	subtitleService "app-backend/internal/services/subtitle"
+	summaryService "app-backend/internal/services/summary"
 	transcriptService "app-backend/internal/services/transcript"
+	transcriptCache "app-backend/internal/services/transcript/cache"
 	translationService "app-backend/internal/services/translation"
+	usageService "app-backend/internal/services/usage"
 	userService "app-backend/internal/services/user"
+	userVideoService "app-backend/internal/services/uservideo"
 	videoService "app-backend/internal/services/video"
+	vocabService "app-backend/internal/services/vocab"
+	workspaceService "app-backend/internal/services/workspace"
 	"app-backend/pkg/gemini"
+	"app-backend/pkg/patterns"
 	"app-backend/pkg/youtube"
 
-	"gorm.io/gorm"
+	"time"
+
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // Container holds all application dependencies
@@ -36,31 +83,100 @@ type Container struct {
 	Logger *logger.Logger
 
 	// Repositories
-	UserRepository    repositories.UserRepositoryInterface
-	SessionRepository repositories.SessionRepositoryInterface
+	UserRepository                 repositories.UserRepositoryInterface
+	SessionRepository              repositories.SessionRepositoryInterface
+	ProviderOutcomeRepository      repositories.ProviderOutcomeRepositoryInterface
+	APIKeyRepository               repositories.APIKeyRepositoryInterface
+	TranslationUsageRepository     repositories.TranslationUsageRepositoryInterface
+	VocabRepository                repositories.VocabRepositoryInterface
+	UserVideoRepository            repositories.UserVideoRepositoryInterface
+	TranscriptSearchRepository     repositories.TranscriptSearchRepositoryInterface
+	TranscriptCorrectionRepository repositories.TranscriptCorrectionRepositoryInterface
+	OAuthTokenRepository           repositories.OAuthTokenRepositoryInterface
+	JobRepository                  repositories.JobRepositoryInterface
+	PronunciationAttemptRepository repositories.PronunciationAttemptRepositoryInterface
+	QuizRepository                 repositories.QuizRepositoryInterface
+	QuizSubmissionRepository       repositories.QuizSubmissionRepositoryInterface
+	AdminAuditLogRepository        repositories.AdminAuditLogRepositoryInterface
+	AuditLogRepository             repositories.AuditLogRepositoryInterface
+	WorkspaceRepository            repositories.WorkspaceRepositoryInterface
+	WorkspaceMemberRepository      repositories.WorkspaceMemberRepositoryInterface
+	WorkspaceInviteRepository      repositories.WorkspaceInviteRepositoryInterface
+	WorkspaceVideoRepository       repositories.WorkspaceVideoRepositoryInterface
+	WorkspaceVocabRepository       repositories.WorkspaceVocabRepositoryInterface
+	DailyActivityRepository        repositories.DailyActivityRepositoryInterface
 
 	// Services
-	JWTService      jwtService.ServiceInterface
-	UserService     userService.ServiceInterface
-	AuthService     authService.ServiceInterface
-	VideoService    videoService.ServiceInterface
-	YouTubeOAuthService oauthService.ServiceInterface
-	TranscriptService   transcriptService.ServiceInterface
-	TranslationService  translationService.ServiceInterface
+	JWTService           jwtService.ServiceInterface
+	AnalyticsService     analyticsService.ServiceInterface
+	UserService          userService.ServiceInterface
+	AuthService          authService.ServiceInterface
+	APIKeyService        apikeyService.ServiceInterface
+	VideoService         videoService.ServiceInterface
+	YouTubeOAuthService  oauthService.ServiceInterface
+	TranscriptService    transcriptService.ServiceInterface
+	TranscriptProber     *transcriptService.HealthProber
+	LanguagesService     languagesService.ServiceInterface
+	SessionInfoService   sessioninfoService.ServiceInterface
+	TranslationService   translationService.ServiceInterface
+	BulkTranslator       translationService.BulkTranslatorInterface
+	BatchTranslator      translationService.BatchTranslatorInterface
+	UsageService         usageService.ServiceInterface
+	VocabService         vocabService.ServiceInterface
+	UserVideoService     userVideoService.ServiceInterface
+	CorrectionService    correctionService.ServiceInterface
+	SearchService        searchService.ServiceInterface
+	AdminService         adminService.ServiceInterface
+	AuditService         auditService.ServiceInterface
+	DictionaryService    dictionaryService.ServiceInterface
+	ExplainService       explainService.ServiceInterface
+	SummaryService       summaryService.ServiceInterface
+	KeyphraseService     keyphraseService.ServiceInterface
+	ChaptersService      chaptersService.ServiceInterface
+	RealtimeHub          *realtimeService.Hub
+	JobService           jobsService.ServiceInterface
+	ImportService        importerService.ServiceInterface
+	SubtitleService      subtitleService.ServiceInterface
+	PronunciationService pronunciationService.ServiceInterface
+	QuizService          quizService.ServiceInterface
+	PrivacyService       privacyService.ServiceInterface
+	WorkspaceService     workspaceService.ServiceInterface
+	StatsService         statsService.ServiceInterface
+	ShareService         shareService.ServiceInterface
 
 	// External Services
 	YouTubeService *youtube.Service
+	YouTubeQuota   *youtube.QuotaTracker
 	GeminiService  *gemini.Service
 
 	// Middleware
-	AuthMiddleware *middleware.AuthMiddleware
+	AuthMiddleware          *middleware.AuthMiddleware
+	WorkspaceAuthMiddleware *middleware.WorkspaceAuthMiddleware
+	RateLimitMiddleware     *middleware.Reloadable
+	CORSMiddleware          *middleware.Reloadable
 
 	// Handlers
-	AuthHandler       auth.HandlerInterface
-	UserHandler       user.HandlerInterface
-	VideoHandler      video.HandlerInterface
-	OAuthHandler      oauth.HandlerInterface
-	TranslationHandler translation.HandlerInterface
+	AuthHandler         auth.HandlerInterface
+	CapabilitiesHandler capabilitiesHandler.HandlerInterface
+	CorrectionHandler   correctionHandler.HandlerInterface
+	DictionaryHandler   dictionaryHandler.HandlerInterface
+	ExplainHandler      explainHandler.HandlerInterface
+	RealtimeHandler     realtimeHandler.HandlerInterface
+	UserHandler         user.HandlerInterface
+	VideoHandler        video.HandlerInterface
+	OAuthHandler        oauth.HandlerInterface
+	TranslationHandler  translation.HandlerInterface
+	AnalyticsHandler    analytics.HandlerInterface
+	APIKeyHandler       apikey.HandlerInterface
+	VocabHandler        vocab.HandlerInterface
+	UserVideoHandler    uservideo.HandlerInterface
+	SearchHandler       searchHandler.HandlerInterface
+	AdminHandler        admin.HandlerInterface
+	ImportHandler       importerHandler.HandlerInterface
+	JobsHandler         jobsHandler.HandlerInterface
+	SubtitleHandler     subtitleHandler.HandlerInterface
+	PracticeHandler     practiceHandler.HandlerInterface
+	WorkspaceHandler    workspaceHandler.HandlerInterface
 }
 
 // NewContainer creates and initializes all dependencies
@@ -84,76 +200,378 @@ func NewContainer(cfg *config.Config, db *gorm.DB, logger *logger.Logger) *Conta
 func (c *Container) initRepositories() {
 	c.UserRepository = repositories.NewUserRepository(c.DB)
 	c.SessionRepository = repositories.NewSessionRepository(c.DB)
+	c.ProviderOutcomeRepository = repositories.NewProviderOutcomeRepository(c.DB)
+	c.APIKeyRepository = repositories.NewAPIKeyRepository(c.DB)
+	c.TranslationUsageRepository = repositories.NewTranslationUsageRepository(c.DB)
+	c.VocabRepository = repositories.NewVocabRepository(c.DB)
+	c.UserVideoRepository = repositories.NewUserVideoRepository(c.DB)
+	c.TranscriptSearchRepository = repositories.NewTranscriptSearchRepository(c.DB)
+	c.TranscriptCorrectionRepository = repositories.NewTranscriptCorrectionRepository(c.DB)
+	c.PronunciationAttemptRepository = repositories.NewPronunciationAttemptRepository(c.DB)
+	c.QuizRepository = repositories.NewQuizRepository(c.DB)
+	c.QuizSubmissionRepository = repositories.NewQuizSubmissionRepository(c.DB)
+	c.OAuthTokenRepository = repositories.NewOAuthTokenRepository(c.DB)
+	c.JobRepository = repositories.NewJobRepository(c.DB)
+	c.AdminAuditLogRepository = repositories.NewAdminAuditLogRepository(c.DB)
+	c.AuditLogRepository = repositories.NewAuditLogRepository(c.DB)
+	c.WorkspaceRepository = repositories.NewWorkspaceRepository(c.DB)
+	c.WorkspaceMemberRepository = repositories.NewWorkspaceMemberRepository(c.DB)
+	c.WorkspaceInviteRepository = repositories.NewWorkspaceInviteRepository(c.DB)
+	c.WorkspaceVideoRepository = repositories.NewWorkspaceVideoRepository(c.DB)
+	c.WorkspaceVocabRepository = repositories.NewWorkspaceVocabRepository(c.DB)
+	c.DailyActivityRepository = repositories.NewDailyActivityRepository(c.DB)
 }
 
 // initExternalServices initializes external API services
 func (c *Container) initExternalServices() {
 	youtubeKey := c.Config.ExternalAPIs.YouTube.APIKey
 	geminiKey := c.Config.ExternalAPIs.Gemini.APIKey
-	
+
 	youtubePrefix := "empty"
 	if len(youtubeKey) > 10 {
 		youtubePrefix = youtubeKey[:10] + "..."
 	} else if len(youtubeKey) > 0 {
 		youtubePrefix = youtubeKey + "..."
 	}
-	
+
 	geminiPrefix := "empty"
 	if len(geminiKey) > 10 {
 		geminiPrefix = geminiKey[:10] + "..."
 	} else if len(geminiKey) > 0 {
 		geminiPrefix = geminiKey + "..."
 	}
-	
-	c.Logger.Zap().Info("Initializing external services", 
+
+	c.Logger.Zap().Info("Initializing external services",
 		zap.String("youtube_api_key_prefix", youtubePrefix),
 		zap.String("gemini_api_key_prefix", geminiPrefix))
 	c.GeminiService = gemini.NewService(geminiKey, c.Logger.Zap())
+
+	// Enforce the configured per-API rate limits
+	if limiter := c.buildAPIRateLimiter("gemini", c.Config.ExternalAPIs.Gemini.RateLimit); limiter != nil {
+		c.GeminiService.SetRateLimiter(limiter)
+	}
+	c.GeminiService.SetChunkOverlap(c.Config.Translation.ChunkOverlap)
+	c.GeminiService.SetSegmentCacheSize(c.Config.Translation.SegmentCacheSize)
+	c.GeminiService.ApplyModelParams(geminiModelParams(&c.Config.ExternalAPIs.Gemini))
+}
+
+// ReloadGeminiModel rebuilds the Gemini generative model from the given
+// config. main calls this on SIGHUP so operators can switch models or tune
+// generation parameters without a restart.
+func (c *Container) ReloadGeminiModel(cfg *config.GeminiConfig) {
+	c.GeminiService.ApplyModelParams(geminiModelParams(cfg))
+}
+
+// geminiModelParams converts the Gemini config section into model parameters
+func geminiModelParams(cfg *config.GeminiConfig) gemini.ModelParams {
+	return gemini.ModelParams{
+		Model:           cfg.Model,
+		Temperature:     float32(cfg.Temperature),
+		TopK:            int32(cfg.TopK),
+		TopP:            float32(cfg.TopP),
+		MaxOutputTokens: int32(cfg.MaxOutputTokens),
+		SafetySettings:  cfg.SafetySettings,
+	}
+}
+
+// buildAPIRateLimiter builds a token bucket limiter from a requests-per-minute
+// config value, returning nil when rate limiting is disabled
+func (c *Container) buildAPIRateLimiter(name string, requestsPerMinute int) patterns.RateLimiter {
+	if requestsPerMinute <= 0 {
+		c.Logger.Zap().Info("External API rate limiting disabled", zap.String("api", name))
+		return nil
+	}
+
+	refillRate := time.Minute / time.Duration(requestsPerMinute)
+	c.Logger.Zap().Info("External API rate limit configured",
+		zap.String("api", name),
+		zap.Int("requests_per_minute", requestsPerMinute))
+
+	return patterns.NewTokenBucketLimiter(requestsPerMinute, refillRate, c.Logger.Zap())
 }
 
 // initServices initializes all services
 func (c *Container) initServices() {
 	c.JWTService = jwtService.NewJWTService(c.Config)
+	c.LanguagesService = languagesService.NewService()
+	c.AnalyticsService = analyticsService.NewService(c.ProviderOutcomeRepository, c.Logger)
+	c.SearchService = searchService.NewService(c.TranscriptSearchRepository, c.Logger)
+	c.KeyphraseService = keyphraseService.NewService(c.Logger)
 	c.UserService = userService.NewUserService(c.UserRepository)
-	c.AuthService = authService.NewAuthService(c.UserService, c.JWTService, c.SessionRepository)
+	c.SessionInfoService = sessioninfoService.NewService(&c.Config.Security.SessionGeo, c.Logger)
+	c.AuthService = authService.NewAuthService(c.UserService, c.JWTService, c.SessionRepository, c.SessionInfoService)
+	c.APIKeyService = apikeyService.NewService(c.APIKeyRepository, c.Logger)
 	c.YouTubeOAuthService = oauthService.NewYouTubeOAuthService(c.Config, c.Logger)
-	
+
+	// Store each user's YouTube token encrypted in the database; without an
+	// encryption key the legacy shared token file is kept
+	if c.DB != nil && c.Config.Encryption.Key != "" {
+		tokenStore, err := oauthService.NewDatabaseTokenStore(c.OAuthTokenRepository, "youtube", c.Config.Encryption.Key)
+		if err != nil {
+			c.Logger.Error("Failed to initialize OAuth token store", zap.Error(err))
+		} else {
+			c.YouTubeOAuthService.SetTokenStore(tokenStore)
+		}
+	}
+
 	// Initialize YouTube service with OAuth support
 	youtubeKey := c.Config.ExternalAPIs.YouTube.APIKey
 	c.YouTubeService = youtube.NewServiceWithOAuth(youtubeKey, c.YouTubeOAuthService, c.Logger.Zap())
-	
+	if limiter := c.buildAPIRateLimiter("youtube", c.Config.ExternalAPIs.YouTube.RateLimit); limiter != nil {
+		c.YouTubeService.SetRateLimiter(limiter)
+	}
+	c.YouTubeService.SetEstimateSegmentSeconds(c.Config.ExternalAPIs.YouTube.EstimateSegmentSeconds)
+	if budget := c.Config.ExternalAPIs.YouTube.QuotaBudget; budget > 0 {
+		c.YouTubeQuota = youtube.NewQuotaTracker(budget, c.Config.ExternalAPIs.YouTube.QuotaLowFraction, c.Logger.Zap())
+		c.YouTubeService.SetQuotaTracker(c.YouTubeQuota)
+	}
+
 	// Initialize transcript service
 	transcriptSvc, err := transcriptService.NewService(c.Config, c.Logger)
 	if err != nil {
 		c.Logger.Error("Failed to initialize transcript service", zap.Error(err))
 	} else {
+		transcriptSvc.SetOutcomeRecorder(c.AnalyticsService)
+		transcriptSvc.SetSegmentIndexer(c.SearchService)
+		if c.YouTubeQuota != nil {
+			transcriptSvc.SetQuotaLowCheck(c.YouTubeQuota.LowBudget)
+		}
+		if c.Config.Transcript.Cache.Persist && c.DB != nil {
+			transcriptSvc.EnablePersistentCache(
+				transcriptCache.NewDatabaseStore(c.DB, c.Config.Transcript.Cache.MaxAgeDuration()))
+		}
 		c.TranscriptService = transcriptSvc
+
+		// Background provider health probing for the admin dashboard
+		if c.Config.Transcript.Health.Enabled {
+			c.TranscriptProber = transcriptSvc.NewHealthProber(c.Config.Transcript.Health.ProbeIntervalDuration())
+			c.TranscriptProber.Start()
+		}
 	}
-	
+
 	// Initialize translation service
 	translationSvc, err := translationService.NewService(&translationService.Config{
-		GeminiAPIKey: c.Config.ExternalAPIs.Gemini.APIKey,
-		Logger:       c.Logger,
+		GeminiAPIKey:          c.Config.ExternalAPIs.Gemini.APIKey,
+		Logger:                c.Logger,
+		Languages:             c.LanguagesService,
+		DetectionSampleCount:  c.Config.Translation.Detection.SampleCount,
+		DetectionMinAgreement: c.Config.Translation.Detection.MinAgreement,
+		MaxSegmentsPerRequest: c.Config.Translation.Limits.MaxSegments,
+		MaxCharsPerRequest:    c.Config.Translation.Limits.MaxChars,
+		ChunkOverlap:          c.Config.Translation.ChunkOverlap,
+		FuzzyMatchThreshold:   c.Config.Translation.Memory.FuzzyThreshold,
+		DeepL: translationService.ProviderConfig{
+			APIKey:   c.Config.ExternalAPIs.DeepL.APIKey,
+			APIURL:   c.Config.ExternalAPIs.DeepL.APIURL,
+			Enabled:  c.Config.ExternalAPIs.DeepL.Enabled,
+			Priority: c.Config.ExternalAPIs.DeepL.Priority,
+		},
+		GoogleTranslate: translationService.ProviderConfig{
+			APIKey:   c.Config.ExternalAPIs.GoogleTranslate.APIKey,
+			APIURL:   c.Config.ExternalAPIs.GoogleTranslate.APIURL,
+			Enabled:  c.Config.ExternalAPIs.GoogleTranslate.Enabled,
+			Priority: c.Config.ExternalAPIs.GoogleTranslate.Priority,
+		},
 	})
 	if err != nil {
 		c.Logger.Error("Failed to initialize translation service", zap.Error(err))
 	} else {
 		c.TranslationService = translationSvc
+		c.BulkTranslator = translationService.NewBulkTranslator(translationSvc.TranslateTexts, c.Logger)
+		c.BatchTranslator = translationService.NewBatchTranslator(translationSvc.TranslateTexts, c.Logger)
+		if transcriptSvc != nil {
+			transcriptSvc.SetTranslator(translationSvc)
+			transcriptSvc.SetFormatter(translationSvc)
+		}
 	}
-	
+
+	// Dictionary lookups are model-backed; without a configured Gemini
+	// client the service stays nil and the handler answers 503
+	if c.GeminiService.IsConfigured() {
+		c.DictionaryService = dictionaryService.NewDictionaryService(c.GeminiService.LookupWord, c.Logger)
+	} else {
+		c.Logger.Warn("Gemini API key not configured, dictionary lookups disabled")
+	}
+
+	// Grammar explanations are model-backed the same way; repeated
+	// sentences are served from the cache, fresh calls are limited per user
+	if c.GeminiService.IsConfigured() {
+		c.ExplainService = explainService.NewService(c.GeminiService.ExplainGrammar, explainService.Config{
+			RequestsPerMinute: c.Config.Explain.RequestsPerMinute,
+		}, c.Logger)
+	} else {
+		c.Logger.Warn("Gemini API key not configured, grammar explanations disabled")
+	}
+
+	// Chaptered transcript summaries lean on the same model backend
+	if c.GeminiService.IsConfigured() {
+		c.SummaryService = summaryService.NewService(c.GeminiService.SummarizeTranscript, summaryService.Config{
+			RequestsPerMinute: c.Config.Summary.RequestsPerMinute,
+		}, c.Logger)
+	} else {
+		c.Logger.Warn("Gemini API key not configured, transcript summaries disabled")
+	}
+
+	// Chapter detection always parses description markers; inferring
+	// boundaries for marker-less videos needs the model backend
+	var inferChapters chaptersService.InferFunc
+	if c.GeminiService.IsConfigured() {
+		inferChapters = c.GeminiService.DetectChapters
+	} else {
+		c.Logger.Warn("Gemini API key not configured, chapter inference disabled")
+	}
+	c.ChaptersService = chaptersService.NewService(inferChapters, c.Logger)
+
+	c.UsageService = usageService.NewService(c.TranslationUsageRepository, usageService.Config{
+		DailyChars:   c.Config.Translation.Quota.DailyChars,
+		MonthlyChars: c.Config.Translation.Quota.MonthlyChars,
+	}, c.Logger)
+
+	// Daily activity aggregates behind the study streak dashboard
+	c.StatsService = statsService.NewService(c.DailyActivityRepository, c.Logger)
+
+	c.VocabService = vocabService.NewService(c.VocabRepository, c.Logger)
+	c.VocabService.SetActivityRecorder(c.StatsService)
+
+	// Session sync hub fanning playback and vocabulary events out to a
+	// user's connected clients
+	c.RealtimeHub = realtimeService.NewHub(c.Logger)
+
+	c.UserVideoService = userVideoService.NewService(c.UserVideoRepository, c.Logger)
+	c.UserVideoService.SetActivityRecorder(c.StatsService)
+
+	// Owner transcript corrections, versioned per saved video
+	c.CorrectionService = correctionService.NewCorrectionService(c.TranscriptCorrectionRepository, c.UserVideoRepository, c.Logger)
+
+	c.AdminService = adminService.NewService(c.UserRepository, c.SessionRepository, c.AdminAuditLogRepository, c.Logger)
+
+	// Classroom workspaces with shared video lists and vocabulary decks
+	c.WorkspaceService = workspaceService.NewService(
+		c.WorkspaceRepository,
+		c.WorkspaceMemberRepository,
+		c.WorkspaceInviteRepository,
+		c.WorkspaceVideoRepository,
+		c.WorkspaceVocabRepository,
+		c.VocabRepository,
+		c.UserRepository,
+		c.Logger,
+	)
+
+	c.AuditService = auditService.NewService(c.AuditLogRepository, c.Logger)
+
 	c.VideoService = videoService.NewVideoService(c.YouTubeService, c.GeminiService, c.Logger.Zap())
+
+	// Transcript share links are signed with a key derived from the share
+	// secret, falling back to the JWT secret so the feature works out of
+	// the box
+	shareSecret := c.Config.Share.Secret
+	if shareSecret == "" {
+		shareSecret = c.Config.JWT.Secret
+	}
+	c.ShareService = shareService.NewService(
+		shareSecret,
+		c.Config.Share.DefaultTTLDuration(),
+		c.Config.Share.MaxTTLDuration(),
+		c.Config.Security.BcryptCost,
+		c.Logger,
+	)
+
+	// Persistent background job queue; long-running work runs through it and
+	// resumes after a restart
+	c.JobService = jobsService.NewService(c.JobRepository, jobsService.Config{
+		Workers:      c.Config.Jobs.Workers,
+		PollInterval: c.Config.Jobs.PollIntervalDuration(),
+		MaxAttempts:  c.Config.Jobs.MaxAttempts,
+		RetryBackoff: c.Config.Jobs.RetryBackoffDuration(),
+		JobTimeout:   c.Config.Jobs.TimeoutDuration(),
+	}, c.Logger)
+
+	// Batch playlist imports fan transcript fetches out through the shared
+	// transcript service, so cached videos are skipped automatically
+	c.ImportService = importerService.NewService(c.YouTubeService, c.TranscriptService, c.JobService, importerService.Config{
+		MaxVideos:  c.Config.BatchImport.MaxVideos,
+		MaxWorkers: c.Config.BatchImport.Workers,
+	}, c.Logger)
+
+	// Bilingual subtitle files render the dual-language transcript offline
+	// through the job queue
+	c.SubtitleService = subtitleService.NewService(c.VideoService, c.JobService, c.Logger)
+
+	// Pronunciation practice clips are scored against their reference text
+	// by Gemini audio; attempts are kept per user for progress graphs
+	c.PronunciationService = pronunciationService.NewService(c.GeminiService.AssessPronunciation, c.PronunciationAttemptRepository, pronunciationService.Config{
+		Provider:      c.Config.Practice.Provider,
+		MaxAudioBytes: c.Config.Practice.MaxAudioBytes,
+	}, c.Logger)
+
+	// Transcript quizzes are generated by Gemini from seeded segment
+	// selections, so a quiz can be reproduced from its seed
+	c.QuizService = quizService.NewService(c.VideoService, c.GeminiService.GenerateQuiz, c.QuizRepository, c.QuizSubmissionRepository, c.Logger)
+
+	// GDPR data exports and the scheduled hard delete of soft-deleted
+	// accounts past their retention window
+	c.PrivacyService = privacyService.NewService(privacyService.Repositories{
+		Users:       c.UserRepository,
+		Sessions:    c.SessionRepository,
+		SavedVideos: c.UserVideoRepository,
+		Vocab:       c.VocabRepository,
+		Usage:       c.TranslationUsageRepository,
+		OAuthTokens: c.OAuthTokenRepository,
+		APIKeys:     c.APIKeyRepository,
+		Jobs:        c.JobRepository,
+	}, c.JobService, privacyService.Config{
+		ExportDir:      c.Config.Privacy.ExportDir,
+		PurgeEnabled:   c.Config.Privacy.Purge.Enabled,
+		PurgeRetention: c.Config.Privacy.Purge.RetentionDuration(),
+		PurgeInterval:  c.Config.Privacy.Purge.IntervalDuration(),
+	}, c.Logger)
+
+	// Start the queue only after every job handler has been registered
+	c.JobService.Start()
 }
 
 // initMiddleware initializes all middleware
 func (c *Container) initMiddleware() {
-	c.AuthMiddleware = middleware.NewAuthMiddleware(c.JWTService, c.AuthService, c.Logger)
+	c.AuthMiddleware = middleware.NewAuthMiddleware(c.JWTService, c.AuthService, c.APIKeyService, c.Logger)
+	c.WorkspaceAuthMiddleware = middleware.NewWorkspaceAuthMiddleware(c.WorkspaceService, c.Logger)
+
+	// Rate limiting and CORS are wrapped so ApplyConfig can rebuild them
+	// when their config sections change at runtime
+	c.RateLimitMiddleware = middleware.NewReloadable(middleware.RateLimit(c.Config, c.JWTService, c.Logger))
+	c.CORSMiddleware = middleware.NewReloadable(middleware.CORS(c.Config))
 }
 
 // initHandlers initializes all handlers
 func (c *Container) initHandlers() {
 	c.AuthHandler = auth.NewAuthHandler(c.AuthService, c.Logger)
-	c.UserHandler = user.NewUserHandler(c.UserService, c.Logger)
-	c.VideoHandler = video.NewVideoHandler(c.VideoService, c.TranscriptService, c.Logger)
+	c.CapabilitiesHandler = capabilitiesHandler.NewCapabilitiesHandler(dto.CapabilitiesResponse{
+		Translation:   c.TranslationService != nil,
+		Transcription: c.TranscriptService != nil,
+		OAuthCaptions: c.Config.ExternalAPIs.YouTube.OAuth.ClientID != "" && c.Config.ExternalAPIs.YouTube.OAuth.ClientSecret != "",
+	})
+	c.UserHandler = user.NewUserHandler(c.UserService, c.UsageService, c.PrivacyService, c.StatsService, c.Logger)
+	c.VideoHandler = video.NewVideoHandler(c.VideoService, c.TranscriptService, c.CorrectionService, c.LanguagesService, c.ShareService, c.SummaryService, c.KeyphraseService, c.ChaptersService, c.Logger)
+	c.CorrectionHandler = correctionHandler.NewCorrectionHandler(c.CorrectionService, c.Logger)
 	c.OAuthHandler = oauth.NewOAuthHandler(c.YouTubeOAuthService, c.Logger)
-	c.TranslationHandler = translation.NewTranslationHandler(c.TranslationService, c.Logger)
-}
\ No newline at end of file
+	c.DictionaryHandler = dictionaryHandler.NewDictionaryHandler(c.DictionaryService, c.Logger)
+	c.ExplainHandler = explainHandler.NewExplainHandler(c.ExplainService, c.Logger)
+	c.RealtimeHandler = realtimeHandler.NewRealtimeHandler(c.RealtimeHub, c.Logger)
+	c.TranslationHandler = translation.NewTranslationHandler(c.TranslationService, c.BulkTranslator, c.BatchTranslator, c.LanguagesService, c.UsageService, c.StatsService, c.Logger)
+	c.AnalyticsHandler = analytics.NewAnalyticsHandler(c.AnalyticsService, c.Logger)
+	c.APIKeyHandler = apikey.NewAPIKeyHandler(c.APIKeyService, c.Logger)
+	c.VocabHandler = vocab.NewVocabHandler(c.VocabService, c.RealtimeHub, c.Logger)
+	c.UserVideoHandler = uservideo.NewUserVideoHandler(c.UserVideoService, c.Logger)
+	c.SearchHandler = searchHandler.NewSearchHandler(c.SearchService, c.Logger)
+	// The transcript diff endpoint needs the concrete service for
+	// per-provider fetching; interface-only deployments go without it
+	var compareTranscripts transcriptService.CompareFunc
+	if svc, ok := c.TranscriptService.(*transcriptService.Service); ok {
+		compareTranscripts = svc.CompareProviders
+	}
+	c.AdminHandler = admin.NewAdminHandler(c.TranscriptProber, c.AdminService, c.AuditService, c.ReloadConfig, compareTranscripts, c.YouTubeQuota, c.Logger)
+	c.ImportHandler = importerHandler.NewImportHandler(c.ImportService, c.Logger)
+	c.JobsHandler = jobsHandler.NewJobsHandler(c.JobService, c.Logger)
+	c.SubtitleHandler = subtitleHandler.NewSubtitleHandler(c.SubtitleService, c.Logger)
+	c.PracticeHandler = practiceHandler.NewPracticeHandler(c.PronunciationService, c.QuizService, c.Logger)
+	c.WorkspaceHandler = workspaceHandler.NewWorkspaceHandler(c.WorkspaceService, c.Logger)
+}
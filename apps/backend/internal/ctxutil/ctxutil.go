@@ -0,0 +1,45 @@
+// Package ctxutil provides typed accessors for per-request metadata stored
+// on a context. The accessors work with both the request's context.Context
+// and a *gin.Context (whose Value falls through to the request context), so
+// service-layer code can read user and request identity without importing
+// Gin.
+package ctxutil
+
+import (
+	"context"
+
+	"app-backend/internal/requestid"
+	"app-backend/internal/types"
+)
+
+// User returns the authenticated user context, or false when the request is
+// unauthenticated
+func User(ctx context.Context) (*types.UserContext, bool) {
+	return types.UserFromContext(ctx)
+}
+
+// UserID returns the authenticated user's ID, or zero when the request is
+// unauthenticated
+func UserID(ctx context.Context) uint {
+	if userCtx, ok := types.UserFromContext(ctx); ok {
+		return userCtx.UserID
+	}
+	// Fall back to the raw key some middleware stores on the Gin context
+	if id, ok := ctx.Value("user_id").(uint); ok {
+		return id
+	}
+	return 0
+}
+
+// RequestID returns the per-request correlation ID, or an empty string when
+// none is set
+func RequestID(ctx context.Context) string {
+	if requestID := requestid.FromContext(ctx); requestID != "" {
+		return requestID
+	}
+	// Fall back to the key the logging middleware stores on the Gin context
+	if requestID, ok := ctx.Value("request_id").(string); ok {
+		return requestID
+	}
+	return ""
+}
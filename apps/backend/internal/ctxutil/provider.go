@@ -0,0 +1,50 @@
+package ctxutil
+
+import "context"
+
+type providerOverrideKey struct{}
+
+type providerUsageKey struct{}
+
+// WithProviderOverride returns a context that forces the named provider for
+// the request, bypassing priority ordering
+func WithProviderOverride(ctx context.Context, provider string) context.Context {
+	return context.WithValue(ctx, providerOverrideKey{}, provider)
+}
+
+// ProviderOverride returns the forced provider name, or an empty string
+// when the request does not carry an override
+func ProviderOverride(ctx context.Context) string {
+	if provider, ok := ctx.Value(providerOverrideKey{}).(string); ok {
+		return provider
+	}
+	return ""
+}
+
+// ProviderUsage records how a request was actually served. A handler places
+// it on the context before calling into the service layer, which fills it
+// in as it works; the handler then reports the fields as response headers.
+type ProviderUsage struct {
+	// Provider is the name of the backend provider that served the request;
+	// empty when no provider was called
+	Provider string
+	// FromCache marks a request served entirely from a cache or memory
+	// without calling any provider
+	FromCache bool
+}
+
+// WithProviderUsage returns a context carrying a fresh usage record,
+// together with the record itself for the caller to read afterwards
+func WithProviderUsage(ctx context.Context) (context.Context, *ProviderUsage) {
+	usage := &ProviderUsage{}
+	return context.WithValue(ctx, providerUsageKey{}, usage), usage
+}
+
+// ProviderUsageFromContext returns the request's usage record, or nil when
+// the caller did not ask for one
+func ProviderUsageFromContext(ctx context.Context) *ProviderUsage {
+	if usage, ok := ctx.Value(providerUsageKey{}).(*ProviderUsage); ok {
+		return usage
+	}
+	return nil
+}
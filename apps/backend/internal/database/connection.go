@@ -1,8 +1,6 @@
 package database
 
 import (
-	"app-backend/internal/models"
-	
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -20,9 +18,3 @@ func NewConnection(databaseURL string) (*gorm.DB, error) {
 
 	return db, nil
 }
-
-func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(
-		&models.User{},
-	)
-}
\ No newline at end of file
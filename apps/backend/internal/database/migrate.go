@@ -0,0 +1,292 @@
+package database
+
+import (
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"app-backend/migrations"
+
+	"gorm.io/gorm"
+)
+
+// Migration is one versioned schema change parsed from the embedded SQL
+// files. DownSQL may be empty when no rollback file exists.
+type Migration struct {
+	Version int64
+	Name    string
+	UpSQL   string
+	DownSQL string
+}
+
+// AppliedMigration is a row in the tracking table recording when a version
+// was applied.
+type AppliedMigration struct {
+	Version   int64     `gorm:"primaryKey"`
+	Name      string    `gorm:"not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+// TableName keeps the tracking table name conventional
+func (AppliedMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// migrationFilePattern matches NNNNNN_name.up.sql / NNNNNN_name.down.sql
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// LoadMigrations parses the embedded migration files into versioned up/down
+// pairs sorted by version. Every version must have an up file; a missing
+// down file only prevents rolling that version back.
+func LoadMigrations() ([]Migration, error) {
+	byVersion := make(map[int64]*Migration)
+
+	entries, err := fs.ReadDir(migrations.Files, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read embedded migrations: %w", err)
+	}
+
+	for _, entry := range entries {
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", entry.Name(), err)
+		}
+
+		content, err := fs.ReadFile(migrations.Files, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf("conflicting names for migration version %d: %s and %s", version, migration.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.UpSQL = string(content)
+		} else {
+			migration.DownSQL = string(content)
+		}
+	}
+
+	result := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.UpSQL == "" {
+			return nil, fmt.Errorf("migration version %d (%s) has no up file", migration.Version, migration.Name)
+		}
+		result = append(result, *migration)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+
+	return result, nil
+}
+
+// Migrate applies every pending embedded migration in version order, each in
+// its own transaction, and returns how many were applied.
+func Migrate(db *gorm.DB) (int, error) {
+	all, err := LoadMigrations()
+	if err != nil {
+		return 0, err
+	}
+	return Apply(db, all)
+}
+
+// Apply runs the given migrations that have not been recorded as applied
+// yet, in version order. It is split from Migrate so tests can exercise the
+// runner with their own SQL.
+func Apply(db *gorm.DB, all []Migration) (int, error) {
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, migration := range all {
+		if applied[migration.Version] {
+			continue
+		}
+
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.UpSQL).Error; err != nil {
+				return err
+			}
+			return tx.Create(&AppliedMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now().UTC(),
+			}).Error
+		})
+		if err != nil {
+			return count, fmt.Errorf("migration %06d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// Pending returns the embedded migrations that have not been applied to the
+// database yet, in the order they would run.
+func Pending(db *gorm.DB) ([]Migration, error) {
+	all, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+	return pendingOf(db, all)
+}
+
+// RollbackLast reverts the most recently applied migration using its down
+// file and returns it. It reports an error when nothing is applied or the
+// migration has no down file.
+func RollbackLast(db *gorm.DB) (*Migration, error) {
+	all, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+	return Revert(db, all)
+}
+
+// Revert rolls back the highest applied version from the given migrations.
+// Like Apply, it exists separately so tests can supply their own SQL.
+func Revert(db *gorm.DB, all []Migration) (*Migration, error) {
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var last *Migration
+	for i := range all {
+		if applied[all[i].Version] && (last == nil || all[i].Version > last.Version) {
+			last = &all[i]
+		}
+	}
+	if last == nil {
+		return nil, fmt.Errorf("no applied migrations to roll back")
+	}
+	if last.DownSQL == "" {
+		return nil, fmt.Errorf("migration %06d_%s has no down file", last.Version, last.Name)
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(last.DownSQL).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&AppliedMigration{}, "version = ?", last.Version).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rollback of %06d_%s failed: %w", last.Version, last.Name, err)
+	}
+
+	return last, nil
+}
+
+// Applied returns the recorded migration history in version order.
+func Applied(db *gorm.DB) ([]AppliedMigration, error) {
+	if err := ensureMigrationsTable(db); err != nil {
+		return nil, err
+	}
+
+	var rows []AppliedMigration
+	if err := db.Order("version").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read migration history: %w", err)
+	}
+	return rows, nil
+}
+
+func pendingOf(db *gorm.DB, all []Migration) ([]Migration, error) {
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range all {
+		if !applied[migration.Version] {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+func appliedVersions(db *gorm.DB) (map[int64]bool, error) {
+	rows, err := Applied(db)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int64]bool, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = true
+	}
+	return applied, nil
+}
+
+// ensureMigrationsTable creates the tracking table on first use. GORM's
+// migrator keeps this portable across Postgres and the sqlite test driver.
+// Databases previously migrated with the golang-migrate CLI carry its
+// single-row (version, dirty) table under the same name; those are adopted
+// by backfilling one history row per version up to the recorded one.
+func ensureMigrationsTable(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&AppliedMigration{}) {
+		if err := db.Migrator().CreateTable(&AppliedMigration{}); err != nil {
+			return fmt.Errorf("failed to create schema_migrations table: %w", err)
+		}
+		return nil
+	}
+	if db.Migrator().HasColumn(&AppliedMigration{}, "Name") {
+		return nil
+	}
+	return adoptLegacyMigrationsTable(db)
+}
+
+func adoptLegacyMigrationsTable(db *gorm.DB) error {
+	var legacy struct {
+		Version int64
+		Dirty   bool
+	}
+	result := db.Table("schema_migrations").Limit(1).Find(&legacy)
+	if result.Error != nil {
+		return fmt.Errorf("failed to read legacy schema_migrations table: %w", result.Error)
+	}
+	if legacy.Dirty {
+		return fmt.Errorf("legacy schema_migrations table is dirty at version %d; resolve it with the golang-migrate CLI before switching", legacy.Version)
+	}
+
+	all, err := LoadMigrations()
+	if err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Migrator().RenameTable("schema_migrations", "schema_migrations_legacy"); err != nil {
+			return fmt.Errorf("failed to rename legacy schema_migrations table: %w", err)
+		}
+		if err := tx.Migrator().CreateTable(&AppliedMigration{}); err != nil {
+			return fmt.Errorf("failed to create schema_migrations table: %w", err)
+		}
+		for _, migration := range all {
+			if result.RowsAffected == 0 || migration.Version > legacy.Version {
+				continue
+			}
+			if err := tx.Create(&AppliedMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now().UTC(),
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// replicaContextKey marks a context as requiring the primary connection
+type replicaContextKey struct{}
+
+// WithPrimary returns a context that pins every query to the primary
+// database. Use it for read-your-writes consistency when a read must
+// observe a write that may not have reached the replica yet.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaContextKey{}, true)
+}
+
+// usesPrimary reports whether the context was pinned with WithPrimary
+func usesPrimary(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	pinned, _ := ctx.Value(replicaContextKey{}).(bool)
+	return pinned
+}
+
+// UseReplica opens a read replica and routes read-only queries on db to it.
+// Writes, transactions, and contexts pinned with WithPrimary stay on the
+// primary connection.
+func UseReplica(db *gorm.DB, replicaURL string) error {
+	replica, err := gorm.Open(postgres.Open(replicaURL), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	if err != nil {
+		return err
+	}
+	return RegisterReplica(db, replica)
+}
+
+// RegisterReplica installs query callbacks on db that swap the connection
+// pool to the replica for plain reads. It is split from UseReplica so tests
+// can supply their own replica connection.
+func RegisterReplica(db *gorm.DB, replica *gorm.DB) error {
+	route := func(tx *gorm.DB) {
+		// Reads inside a transaction must see the transaction's own writes
+		if _, inTx := tx.Statement.ConnPool.(gorm.TxCommitter); inTx {
+			return
+		}
+		if usesPrimary(tx.Statement.Context) {
+			return
+		}
+		tx.Statement.ConnPool = replica.ConnPool
+	}
+
+	if err := db.Callback().Query().Before("gorm:query").Register("database:route_read_replica", route); err != nil {
+		return err
+	}
+	return db.Callback().Row().Before("gorm:row").Register("database:route_read_replica", route)
+}
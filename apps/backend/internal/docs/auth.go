@@ -112,4 +112,4 @@ func AuthGetSessions(c *gin.Context) {}
 // @Failure 404 {object} map[string]interface{} "Session not found"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /auth/sessions/{sessionId} [delete]
-func AuthRevokeSession(c *gin.Context) {}
\ No newline at end of file
+func AuthRevokeSession(c *gin.Context) {}
@@ -0,0 +1,29 @@
+package docs
+
+import (
+	"app-backend/internal/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// NewBatchImportDocs creates instances of batch-import DTOs for swagger documentation
+// This function is never called but ensures the DTOs are considered "used" by the linter
+func NewBatchImportDocs() {
+	_ = dto.PlaylistImportRequest{}
+	_ = dto.PlaylistImportResponse{}
+}
+
+// ImportPlaylist godoc
+// @Summary Import transcripts for a whole playlist or channel
+// @Description Enumerate every video in a YouTube playlist or channel and enqueue a background job that fetches every transcript; progress is polled via the job endpoint
+// @Tags video
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.PlaylistImportRequest true "Playlist or channel URL"
+// @Success 202 {object} dto.PlaylistImportResponse "Queued import job"
+// @Failure 400 {object} map[string]interface{} "Unsupported playlist or channel URL"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 404 {object} map[string]interface{} "No videos found in playlist"
+// @Failure 502 {object} map[string]interface{} "Failed to enumerate playlist videos"
+// @Router /video/playlist/import [post]
+func ImportPlaylist(c *gin.Context) {}
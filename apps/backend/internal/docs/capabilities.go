@@ -0,0 +1,21 @@
+package docs
+
+import (
+	"app-backend/internal/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// NewCapabilitiesDocs creates instances of capability DTOs for swagger documentation
+// This function is never called but ensures the DTOs are considered "used" by the linter
+func NewCapabilitiesDocs() {
+	_ = dto.CapabilitiesResponse{}
+}
+
+// GetCapabilities godoc
+// @Summary Report deployment capabilities
+// @Description Report which optional features (translation, transcription, OAuth captions) are enabled on this deployment based on its configuration
+// @Tags capabilities
+// @Produce json
+// @Success 200 {object} dto.CapabilitiesResponse "Enabled features"
+// @Router /capabilities [get]
+func GetCapabilities(c *gin.Context) {}
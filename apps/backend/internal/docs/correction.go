@@ -0,0 +1,44 @@
+package docs
+
+import (
+	"app-backend/internal/dto"
+)
+
+// NewCorrectionDocs creates instances of transcript correction DTOs for
+// swagger documentation
+func NewCorrectionDocs() {
+	_ = dto.EditTranscriptSegmentRequest{}
+	_ = dto.TranscriptCorrectionResponse{}
+	_ = dto.TranscriptCorrectionHistoryResponse{}
+}
+
+// CorrectionEditSegment godoc
+// @Summary Correct a transcript segment
+// @Description Record corrected text for one transcript segment of a saved video. Each edit creates a new transcript version; later dual-language transcript calls translate the corrected text.
+// @Tags video
+// @Accept json
+// @Produce json
+// @Param videoUrl path string true "Video URL (base64 encoded)"
+// @Param index path int true "1-based segment index as returned by the transcript endpoints"
+// @Param request body dto.EditTranscriptSegmentRequest true "Corrected segment text"
+// @Success 200 {object} dto.TranscriptCorrectionResponse "Recorded correction"
+// @Failure 400 {object} errors.AppError "Invalid parameters"
+// @Failure 401 {object} errors.AppError "Authentication required"
+// @Failure 404 {object} errors.AppError "Video not in the user's saved videos"
+// @Router /api/v1/video/{videoUrl}/transcript/segments/{index} [patch]
+// @Security BearerAuth
+func CorrectionEditSegment() {}
+
+// CorrectionGetHistory godoc
+// @Summary Get transcript correction history
+// @Description List every recorded edit for a saved video, oldest first, with the current version number
+// @Tags video
+// @Accept json
+// @Produce json
+// @Param videoUrl path string true "Video URL (base64 encoded)"
+// @Success 200 {object} dto.TranscriptCorrectionHistoryResponse "Correction history"
+// @Failure 401 {object} errors.AppError "Authentication required"
+// @Failure 404 {object} errors.AppError "Video not in the user's saved videos"
+// @Router /api/v1/video/{videoUrl}/transcript/corrections [get]
+// @Security BearerAuth
+func CorrectionGetHistory() {}
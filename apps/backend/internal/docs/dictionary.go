@@ -0,0 +1,29 @@
+package docs
+
+import (
+	"app-backend/internal/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// NewDictionaryDocs creates instances of dictionary DTOs for swagger documentation
+// This function is never called but ensures the DTOs are considered "used" by the linter
+func NewDictionaryDocs() {
+	_ = dto.DictionaryLookupRequest{}
+	_ = dto.DictionaryLookupResponse{}
+}
+
+// DictionaryLookup godoc
+// @Summary Look up a word in context
+// @Description Return a structured dictionary entry (definition, part of speech, IPA, example sentences, optional translation) for a word as it is used in the surrounding transcript sentence
+// @Tags dictionary
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.DictionaryLookupRequest true "Word and its surrounding sentence"
+// @Success 200 {object} dto.DictionaryLookupResponse "Dictionary entry"
+// @Failure 400 {object} errors.AppError "Invalid request body"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 502 {object} errors.AppError "Lookup backend failed"
+// @Failure 503 {object} map[string]interface{} "Dictionary lookups not available"
+// @Router /dictionary/lookup [post]
+func DictionaryLookup(c *gin.Context) {}
@@ -20,4 +20,4 @@
 // @name Authorization
 // @description Type "Bearer" followed by a space and JWT token.
 
-package docs
\ No newline at end of file
+package docs
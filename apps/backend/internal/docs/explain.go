@@ -0,0 +1,29 @@
+package docs
+
+import (
+	"app-backend/internal/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// NewExplainDocs creates instances of explain DTOs for swagger documentation
+// This function is never called but ensures the DTOs are considered "used" by the linter
+func NewExplainDocs() {
+	_ = dto.ExplainRequest{}
+	_ = dto.ExplainResponse{}
+}
+
+// Explain godoc
+// @Summary Explain the grammar of a transcript sentence
+// @Description Return a structured grammar breakdown (clauses, tenses, idioms, literal vs natural translation) of a sentence, written in the user's native language. Breakdowns are cached by sentence and language; fresh model calls are rate limited per user.
+// @Tags explain
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.ExplainRequest true "Sentence and native language"
+// @Success 200 {object} dto.ExplainResponse "Grammar breakdown"
+// @Failure 400 {object} map[string]interface{} "Missing sentence or native language"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 429 {object} map[string]interface{} "Explanation rate limit exceeded"
+// @Failure 502 {object} map[string]interface{} "Explanation backend failed"
+// @Router /explain [post]
+func Explain(c *gin.Context) {}
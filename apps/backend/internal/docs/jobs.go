@@ -0,0 +1,26 @@
+package docs
+
+import (
+	"app-backend/internal/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// NewJobDocs creates instances of job DTOs for swagger documentation
+// This function is never called but ensures the DTOs are considered "used" by the linter
+func NewJobDocs() {
+	_ = dto.JobResponse{}
+}
+
+// GetJob godoc
+// @Summary Get background job status
+// @Description Report the state of one of the authenticated user's background jobs, including its type-specific progress or result JSON
+// @Tags jobs
+// @Produce json
+// @Security Bearer
+// @Param id path int true "Job ID"
+// @Success 200 {object} dto.JobResponse "Job state"
+// @Failure 400 {object} map[string]interface{} "Invalid job ID"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 404 {object} map[string]interface{} "Job not found"
+// @Router /jobs/{id} [get]
+func GetJob(c *gin.Context) {}
@@ -0,0 +1,82 @@
+package docs
+
+import (
+	"app-backend/internal/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// NewPracticeDocs creates instances of practice DTOs for swagger documentation
+// This function is never called but ensures the DTOs are considered "used" by the linter
+func NewPracticeDocs() {
+	_ = dto.AssessPronunciationRequest{}
+	_ = dto.AssessPronunciationResponse{}
+	_ = dto.PronunciationAttemptResponse{}
+	_ = dto.GenerateQuizRequest{}
+	_ = dto.GenerateQuizResponse{}
+	_ = dto.SubmitQuizAnswersRequest{}
+	_ = dto.SubmitQuizAnswersResponse{}
+}
+
+// AssessPronunciation godoc
+// @Summary Assess pronunciation of a practice clip
+// @Description Score a short uploaded audio clip against the transcript segment the user read aloud, returning an overall score and per-word scores; the attempt is stored for progress graphs
+// @Tags practice
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param audio formData file true "Audio clip (webm, ogg, mpeg, mp4, wav or flac)"
+// @Param text formData string true "Reference transcript segment"
+// @Param language formData string false "Language of the reference text"
+// @Param videoId formData string false "Video the segment came from"
+// @Success 200 {object} dto.AssessPronunciationResponse "Scored attempt"
+// @Failure 400 {object} map[string]interface{} "Missing clip, unsupported audio format, or missing reference text"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 413 {object} map[string]interface{} "Audio clip is too large"
+// @Failure 502 {object} map[string]interface{} "Assessment backend failed"
+// @Router /practice/pronunciation [post]
+func AssessPronunciation(c *gin.Context) {}
+
+// ListPronunciationAttempts godoc
+// @Summary List pronunciation practice attempts
+// @Description Return the user's stored attempts with pagination, newest first by default, for progress graphs
+// @Tags practice
+// @Produce json
+// @Security Bearer
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} map[string]interface{} "Paginated attempt history"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Router /practice/pronunciation/attempts [get]
+func ListPronunciationAttempts(c *gin.Context) {}
+
+// GenerateQuiz godoc
+// @Summary Generate a practice quiz from a video transcript
+// @Description Build fill-in-the-blank and multiple-choice questions from the video's transcript segments; generation is seeded, so the same video, language, and seed reproduce the same quiz. The quiz is stored and its questions returned without answers.
+// @Tags practice
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.GenerateQuizRequest true "Quiz generation request"
+// @Success 201 {object} dto.GenerateQuizResponse "Stored quiz with its questions"
+// @Failure 400 {object} map[string]interface{} "Invalid request data or video URL"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 422 {object} map[string]interface{} "Transcript has no usable segments"
+// @Failure 502 {object} map[string]interface{} "Transcript fetch or generation backend failed"
+// @Router /practice/quiz [post]
+func GenerateQuiz(c *gin.Context) {}
+
+// SubmitQuizAnswers godoc
+// @Summary Submit answers for a practice quiz
+// @Description Grade the submitted answers against the stored quiz, record the submission with its score, and return per-question results including the correct answers
+// @Tags practice
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param quizId path int true "Quiz ID"
+// @Param request body dto.SubmitQuizAnswersRequest true "Answers in question order"
+// @Success 200 {object} dto.SubmitQuizAnswersResponse "Graded submission"
+// @Failure 400 {object} map[string]interface{} "Invalid request data or answer count mismatch"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 404 {object} map[string]interface{} "Quiz not found"
+// @Router /practice/quiz/{quizId}/answers [post]
+func SubmitQuizAnswers(c *gin.Context) {}
@@ -0,0 +1,30 @@
+package docs
+
+import (
+	"app-backend/internal/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// NewSearchDocs creates instances of search-related DTOs for swagger documentation
+// This function is never called but ensures the DTOs are considered "used" by the linter
+func NewSearchDocs() {
+	_ = dto.SearchTranscriptsRequest{}
+	_ = dto.SearchTranscriptsResponse{}
+	_ = dto.TranscriptSearchMatchResponse{}
+}
+
+// SearchTranscripts godoc
+// @Summary Search cached transcripts
+// @Description Full-text search across indexed transcript segments, returning matching segments with video ID and timestamps
+// @Tags search
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param q query string true "Search query (2-200 characters)"
+// @Param limit query int false "Maximum number of matches" default(50)
+// @Success 200 {object} dto.SearchTranscriptsResponse "Matching transcript segments"
+// @Failure 400 {object} map[string]interface{} "Invalid request data"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /search/transcripts [get]
+func SearchTranscripts(c *gin.Context) {}
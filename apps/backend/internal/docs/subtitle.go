@@ -0,0 +1,42 @@
+package docs
+
+import (
+	"app-backend/internal/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// NewSubtitleDocs creates instances of subtitle DTOs for swagger documentation
+// This function is never called but ensures the DTOs are considered "used" by the linter
+func NewSubtitleDocs() {
+	_ = dto.GenerateSubtitleRequest{}
+	_ = dto.GenerateSubtitleResponse{}
+}
+
+// GenerateSubtitle godoc
+// @Summary Generate a bilingual subtitle file
+// @Description Enqueue a background job that renders a merged dual-language subtitle file (original on the first line, translation on the second) in SRT or ASS format; progress is polled via the job endpoint and the finished file is fetched from the download endpoint
+// @Tags video
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.GenerateSubtitleRequest true "Video, language pair, format and styling"
+// @Success 202 {object} dto.GenerateSubtitleResponse "Queued generation job"
+// @Failure 400 {object} map[string]interface{} "Invalid video URL, format or styling"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Router /subtitles [post]
+func GenerateSubtitle(c *gin.Context) {}
+
+// DownloadSubtitle godoc
+// @Summary Download a generated subtitle file
+// @Description Stream the finished subtitle file of a completed generation job as an attachment
+// @Tags video
+// @Produce application/x-subrip
+// @Security Bearer
+// @Param jobId path int true "Generation job ID"
+// @Success 200 {string} string "Subtitle file with a Content-Disposition attachment header"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 404 {object} map[string]interface{} "Job not found or not a subtitle job"
+// @Failure 409 {object} map[string]interface{} "Subtitle file is not ready yet"
+// @Failure 502 {object} map[string]interface{} "Subtitle generation failed"
+// @Router /subtitles/{jobId}/download [get]
+func DownloadSubtitle(c *gin.Context) {}
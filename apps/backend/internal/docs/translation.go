@@ -9,6 +9,13 @@ import (
 func NewTranslationDocs() {
 	_ = dto.TranslateTextsRequest{}
 	_ = dto.TranslateTextsResponse{}
+	_ = dto.TranslationMatchDTO{}
+	_ = dto.StreamTranslationEventDTO{}
+	_ = dto.StreamTranslationDoneDTO{}
+	_ = dto.BatchTranslateRequest{}
+	_ = dto.BatchTranslateResponse{}
+	_ = dto.BatchJobResponse{}
+	_ = dto.BatchStreamEventDTO{}
 }
 
 // TranslateTexts godoc
@@ -18,9 +25,63 @@ func NewTranslationDocs() {
 // @Accept json
 // @Produce json
 // @Param request body dto.TranslateTextsRequest true "Translation request"
-// @Success 200 {object} dto.TranslateTextsResponse "Translated texts"
-// @Failure 400 {object} dto.ErrorResponse "Invalid request"
-// @Failure 500 {object} dto.ErrorResponse "Translation service error"
+// @Param provider_override query string false "Force a specific translation provider, with no fallback on failure (admin only; the X-Provider-Override header takes precedence)"
+// @Success 200 {object} dto.TranslateTextsResponse "Translated texts (X-Provider-Used, X-Cache and X-Response-Time headers describe how they were served)"
+// @Failure 400 {object} errors.AppError "Invalid request"
+// @Failure 500 {object} errors.AppError "Translation service error"
 // @Router /api/v1/translate [post]
 // @Security BearerAuth
-func TranslateTexts() {}
\ No newline at end of file
+func TranslateTexts() {}
+
+// StreamTranslation godoc
+// @Summary Stream translations over SSE
+// @Description Translate texts and stream each translated segment back as a Server-Sent Event as it completes. Events: "translation" per segment, "error" for failed segments, "done" when the stream finishes.
+// @Tags translation
+// @Accept json
+// @Produce text/event-stream
+// @Param request body dto.TranslateTextsRequest true "Translation request"
+// @Success 200 {object} dto.StreamTranslationEventDTO "Stream of translated segments"
+// @Failure 400 {object} errors.AppError "Invalid request"
+// @Failure 503 {object} errors.AppError "Too many concurrent streams"
+// @Router /api/v1/translate/stream [post]
+// @Security BearerAuth
+func StreamTranslation() {}
+
+// BatchTranslate godoc
+// @Summary Start a batch translation job
+// @Description Enqueue a whole translation workload as one background job and return its ID immediately. Progress can then be polled or streamed.
+// @Tags translation
+// @Accept json
+// @Produce json
+// @Param request body dto.BatchTranslateRequest true "Batch translation request"
+// @Success 202 {object} dto.BatchTranslateResponse "Job accepted"
+// @Failure 400 {object} errors.AppError "Invalid request"
+// @Failure 503 {object} errors.AppError "Translation service unavailable"
+// @Router /api/v1/translate/batch [post]
+// @Security BearerAuth
+func BatchTranslate() {}
+
+// GetBatchJob godoc
+// @Summary Get batch translation job progress
+// @Description Return the current state of a batch translation job, including per-segment results completed so far and the progress percentage.
+// @Tags translation
+// @Produce json
+// @Param id path string true "Batch job ID"
+// @Success 200 {object} dto.BatchJobResponse "Job snapshot"
+// @Failure 404 {object} errors.AppError "Job not found"
+// @Router /api/v1/translate/batch/{id} [get]
+// @Security BearerAuth
+func GetBatchJob() {}
+
+// StreamBatchJob godoc
+// @Summary Stream batch translation job progress over SSE
+// @Description Stream progress updates for a batch translation job. Already completed segments are replayed first, then live updates follow until the job finishes. Events: "translation" per segment, "error" for failed segments, "done" when the job completes.
+// @Tags translation
+// @Produce text/event-stream
+// @Param id path string true "Batch job ID"
+// @Success 200 {object} dto.BatchStreamEventDTO "Stream of progress updates"
+// @Failure 404 {object} errors.AppError "Job not found"
+// @Failure 503 {object} errors.AppError "Too many concurrent streams"
+// @Router /api/v1/translate/batch/{id}/stream [get]
+// @Security BearerAuth
+func StreamBatchJob() {}
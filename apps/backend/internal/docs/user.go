@@ -13,6 +13,8 @@ func NewUserDocs() {
 	_ = dto.UpdateProfileRequest{}
 	_ = dto.ChangePasswordRequest{}
 	_ = dto.UserListResponse{}
+	_ = dto.GetUsageResponse{}
+	_ = dto.UserStatsResponse{}
 	_ = types.PaginationMetadata{}
 }
 
@@ -73,6 +75,62 @@ func UserChangePassword(c *gin.Context) {}
 // @Router /user/account [delete]
 func UserDeleteAccount(c *gin.Context) {}
 
+// UserGetUsage godoc
+// @Summary Get translation usage
+// @Description Get the authenticated user's translation character usage and remaining quota for the current day and month
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dto.GetUsageResponse "Translation usage and remaining quota"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /user/usage [get]
+func UserGetUsage(c *gin.Context) {}
+
+// UserGetStats godoc
+// @Summary Get study statistics
+// @Description Get the authenticated user's study streak, minutes studied, and per-day activity counts for the dashboard
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param range query string false "Range as a day count, e.g. 30d" default(30d)
+// @Success 200 {object} dto.UserStatsResponse "Streak and per-day activity"
+// @Failure 400 {object} map[string]interface{} "Invalid range"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /user/stats [get]
+func UserGetStats(c *gin.Context) {}
+
+// UserExportData godoc
+// @Summary Request a data export
+// @Description Queue a background job that gathers the authenticated user's data (profile, sessions, saved videos, vocabulary, usage) into a downloadable ZIP archive; poll the returned job and download the archive once it completes
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Success 202 {object} dto.JobResponse "Queued export job"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 503 {object} map[string]interface{} "Data export is not available"
+// @Router /user/export [get]
+func UserExportData(c *gin.Context) {}
+
+// UserDownloadExport godoc
+// @Summary Download a data export archive
+// @Description Download the ZIP archive of a finished export job
+// @Tags user
+// @Produce application/zip
+// @Security Bearer
+// @Param jobId path int true "Export job ID"
+// @Success 200 {file} file "Export archive"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 404 {object} map[string]interface{} "Export not found"
+// @Failure 409 {object} map[string]interface{} "Export is not ready yet"
+// @Failure 410 {object} map[string]interface{} "Export archive no longer exists"
+// @Router /user/export/{jobId}/download [get]
+func UserDownloadExport(c *gin.Context) {}
+
 // UserListUsers godoc
 // @Summary List users (Admin only)
 // @Description Get a paginated list of users - requires admin role
@@ -88,4 +146,4 @@ func UserDeleteAccount(c *gin.Context) {}
 // @Failure 403 {object} map[string]interface{} "Admin access required"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /user/list [get]
-func UserListUsers(c *gin.Context) {}
\ No newline at end of file
+func UserListUsers(c *gin.Context) {}
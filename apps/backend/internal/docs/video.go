@@ -9,8 +9,23 @@ import (
 func NewVideoDocs() {
 	_ = dto.VideoInfoRequest{}
 	_ = dto.VideoInfoResponse{}
+	_ = dto.ResolveVideoRequest{}
+	_ = dto.ResolveVideoResponse{}
 	_ = dto.GetTranscriptRequest{}
 	_ = dto.GetTranscriptResponse{}
+	_ = dto.GetDualTranscriptRequest{}
+	_ = dto.GetDualTranscriptResponse{}
+	_ = dto.ExportTranscriptRequest{}
+	_ = dto.ExportTranscriptResponse{}
+	_ = dto.GetVideoSummaryRequest{}
+	_ = dto.VideoSummaryResponse{}
+	_ = dto.GetKeyPhrasesRequest{}
+	_ = dto.GetKeyPhrasesResponse{}
+	_ = dto.GetChaptersRequest{}
+	_ = dto.GetChaptersResponse{}
+	_ = dto.CreateShareLinkRequest{}
+	_ = dto.ShareLinkResponse{}
+	_ = dto.GetSharedTranscriptRequest{}
 	_ = dto.GetAvailableLanguagesResponse{}
 	_ = dto.VideoCapabilitiesResponse{}
 	_ = dto.GetSupportedProvidersResponse{}
@@ -25,13 +40,25 @@ func NewVideoDocs() {
 // @Produce json
 // @Param videoUrl path string true "Video URL (base64 encoded)"
 // @Success 200 {object} dto.VideoInfoResponse "Video information"
-// @Failure 400 {object} dto.ErrorResponse "Invalid video URL"
-// @Failure 404 {object} dto.ErrorResponse "Video not found"
-// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Failure 400 {object} errors.AppError "Invalid video URL"
+// @Failure 404 {object} errors.AppError "Video not found"
+// @Failure 500 {object} errors.AppError "Internal server error"
 // @Router /api/v1/video/{videoUrl}/info [get]
 // @Security BearerAuth
 func VideoGetInfo() {}
 
+// VideoResolve godoc
+// @Summary Resolve a video URL
+// @Description Parse a video URL or bare video ID and report the detected provider, video ID, and URL format (watch, shorts, live, embed, share, or video_id). No provider API is called.
+// @Tags video
+// @Accept json
+// @Produce json
+// @Param request body dto.ResolveVideoRequest true "Video URL or bare video ID"
+// @Success 200 {object} dto.ResolveVideoResponse "Parsed video reference"
+// @Failure 400 {object} errors.AppError "Unrecognized or unsupported video URL"
+// @Router /api/v1/video/resolve [post]
+func VideoResolve() {}
+
 // VideoGetTranscript godoc
 // @Summary Get video transcript
 // @Description Get transcript for a video in the specified language
@@ -40,14 +67,104 @@ func VideoGetInfo() {}
 // @Produce json
 // @Param videoUrl path string true "Video URL (base64 encoded)"
 // @Param language query string false "Language code (e.g., 'en', 'es')" default(en)
-// @Success 200 {object} dto.GetTranscriptResponse "Video transcript"
-// @Failure 400 {object} dto.ErrorResponse "Invalid parameters"
-// @Failure 404 {object} dto.ErrorResponse "Transcript not found"
-// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Param granularity query string false "Timing detail: segment or word; word attaches per-word timestamps when the caption track provides them" default(segment)
+// @Param segmentation query string false "Segment chunking: none or sentence; sentence merges caption cues into full sentences with aggregated timing" default(none)
+// @Param from query number false "Start of the time range in seconds; only segments overlapping [from, to) are returned"
+// @Param to query number false "End of the time range in seconds; omit for the end of the video"
+// @Param offset query int false "Number of segments to skip after the range filter"
+// @Param limit query int false "Maximum number of segments to return"
+// @Param provider_override query string false "Force a specific transcript provider, bypassing priority ordering and the cache (admin only; the X-Provider-Override header takes precedence)"
+// @Success 200 {object} dto.GetTranscriptResponse "Video transcript (X-Provider-Used, X-Cache and X-Response-Time headers describe how it was served)"
+// @Failure 400 {object} errors.AppError "Invalid parameters"
+// @Failure 404 {object} errors.AppError "Transcript not found"
+// @Failure 500 {object} errors.AppError "Internal server error"
 // @Router /api/v1/video/{videoUrl}/transcript [get]
 // @Security BearerAuth
 func VideoGetTranscript() {}
 
+// VideoGetDualTranscript godoc
+// @Summary Get dual-language transcript
+// @Description Get original and translated transcript segments in one call. Segments are paginated; translated output is cached per language pair.
+// @Tags video
+// @Accept json
+// @Produce json
+// @Param videoUrl path string true "Video URL (base64 encoded)"
+// @Param targetLang query string true "Target language code (e.g., 'vi', 'es')"
+// @Param sourceLang query string false "Source language code (auto-detected if omitted)"
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Segments per page (max 500)" default(100)
+// @Success 200 {object} dto.GetDualTranscriptResponse "Dual-language transcript"
+// @Failure 400 {object} errors.AppError "Invalid parameters"
+// @Failure 500 {object} errors.AppError "Internal server error"
+// @Router /api/v1/video/{videoUrl}/transcript/dual [get]
+// @Security BearerAuth
+func VideoGetDualTranscript() {}
+
+// VideoExportTranscript godoc
+// @Summary Export transcript as a file
+// @Description Download the transcript as an SRT, WebVTT, JSON or plain text file. Setting targetLang exports the dual-language variant with the translation under each original line.
+// @Tags video
+// @Accept json
+// @Produce application/x-subrip
+// @Param videoUrl path string true "Video URL (base64 encoded)"
+// @Param format query string false "Export format: srt, vtt, json or txt" default(srt)
+// @Param lang query string false "Transcript language code"
+// @Param targetLang query string false "Target language for a dual-language export"
+// @Param sourceLang query string false "Source language code (auto-detected if omitted)"
+// @Success 200 {string} string "Transcript file with a Content-Disposition attachment header"
+// @Failure 400 {object} errors.AppError "Invalid parameters"
+// @Failure 500 {object} errors.AppError "Internal server error"
+// @Router /api/v1/video/{videoUrl}/transcript/export [get]
+func VideoExportTranscript() {}
+
+// VideoGetSummary godoc
+// @Summary Summarize a video transcript
+// @Description Get a chaptered, AI-generated summary of the transcript with timestamped sections, main ideas, and key vocabulary. Summaries are cached per video, language, and depth; fresh model calls are rate limited per user.
+// @Tags video
+// @Accept json
+// @Produce json
+// @Param videoUrl path string true "Video URL (base64 encoded)"
+// @Param lang query string false "Transcript language code"
+// @Param depth query string false "Summary depth: brief, standard or detailed" default(standard)
+// @Success 200 {object} dto.VideoSummaryResponse "Chaptered transcript summary"
+// @Failure 400 {object} errors.AppError "Invalid parameters"
+// @Failure 401 {object} errors.AppError "User not authenticated"
+// @Failure 429 {object} errors.AppError "Summary rate limit exceeded"
+// @Failure 502 {object} errors.AppError "Model backend failed"
+// @Failure 503 {object} errors.AppError "Summaries are not available"
+// @Router /api/v1/video/{videoUrl}/summary [get]
+// @Security BearerAuth
+func VideoGetSummary() {}
+
+// VideoGetKeyPhrases godoc
+// @Summary Extract key vocabulary from a transcript
+// @Description Extract high-value words and collocations from the transcript, scored by frequency and rarity. Each candidate carries the timestamp and sentence of its first occurrence, ready to save as a vocabulary item.
+// @Tags video
+// @Accept json
+// @Produce json
+// @Param videoUrl path string true "Video URL (base64 encoded)"
+// @Param lang query string false "Transcript language code"
+// @Param limit query int false "Maximum number of candidates" default(20)
+// @Success 200 {object} dto.GetKeyPhrasesResponse "Extracted vocabulary candidates"
+// @Failure 400 {object} errors.AppError "Invalid parameters"
+// @Failure 500 {object} errors.AppError "Internal server error"
+// @Router /api/v1/video/{videoUrl}/keyphrases [get]
+func VideoGetKeyPhrases() {}
+
+// VideoGetChapters godoc
+// @Summary Get video chapters
+// @Description Get the video's chapter list for player navigation. Timestamp markers in the description win; without them, topic boundaries are inferred from the transcript. A video where neither works gets an empty list.
+// @Tags video
+// @Accept json
+// @Produce json
+// @Param videoUrl path string true "Video URL (base64 encoded)"
+// @Param lang query string false "Transcript language used for inference"
+// @Success 200 {object} dto.GetChaptersResponse "Chapter list with its source"
+// @Failure 400 {object} errors.AppError "Invalid parameters"
+// @Failure 502 {object} errors.AppError "Model backend failed"
+// @Router /api/v1/video/{videoUrl}/chapters [get]
+func VideoGetChapters() {}
+
 // VideoGetAvailableLanguages godoc
 // @Summary Get available transcript languages
 // @Description Get list of available transcript languages for a video
@@ -56,9 +173,9 @@ func VideoGetTranscript() {}
 // @Produce json
 // @Param videoUrl path string true "Video URL (base64 encoded)"
 // @Success 200 {object} dto.GetAvailableLanguagesResponse "Available languages"
-// @Failure 400 {object} dto.ErrorResponse "Invalid video URL"
-// @Failure 404 {object} dto.ErrorResponse "Video not found"
-// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Failure 400 {object} errors.AppError "Invalid video URL"
+// @Failure 404 {object} errors.AppError "Video not found"
+// @Failure 500 {object} errors.AppError "Internal server error"
 // @Router /api/v1/video/{videoUrl}/languages [get]
 // @Security BearerAuth
 func VideoGetAvailableLanguages() {}
@@ -71,9 +188,9 @@ func VideoGetAvailableLanguages() {}
 // @Produce json
 // @Param videoUrl path string true "Video URL (base64 encoded)"
 // @Success 200 {object} dto.VideoCapabilitiesResponse "Video capabilities"
-// @Failure 400 {object} dto.ErrorResponse "Invalid video URL"
-// @Failure 404 {object} dto.ErrorResponse "Video not found"
-// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Failure 400 {object} errors.AppError "Invalid video URL"
+// @Failure 404 {object} errors.AppError "Video not found"
+// @Failure 500 {object} errors.AppError "Internal server error"
 // @Router /api/v1/video/{videoUrl}/capabilities [get]
 // @Security BearerAuth
 func VideoGetCapabilities() {}
@@ -91,11 +208,43 @@ func VideoGetSupportedProviders() {}
 
 // VideoGetSupportedLanguages godoc
 // @Summary Get supported translation languages
-// @Description Get list of supported languages for AI translation
+// @Description Get list of supported languages for AI translation, with native names and text direction
 // @Tags video
 // @Accept json
 // @Produce json
 // @Success 200 {object} dto.GetSupportedLanguagesResponse "Supported translation languages"
 // @Router /api/v1/video/languages [get]
 // @Security BearerAuth
-func VideoGetSupportedLanguages() {}
\ No newline at end of file
+func VideoGetSupportedLanguages() {}
+
+// VideoCreateShareLink godoc
+// @Summary Create a transcript share link
+// @Description Issue a signed, expiring URL that lets unauthenticated visitors view this video's dual-language transcript. An optional password gates access; the token never references the owner's account.
+// @Tags video
+// @Accept json
+// @Produce json
+// @Param videoUrl path string true "Video URL (base64 encoded)"
+// @Param request body dto.CreateShareLinkRequest true "Share link options"
+// @Success 201 {object} dto.ShareLinkResponse "Signed share link"
+// @Failure 400 {object} errors.AppError "Invalid video URL or request data"
+// @Failure 401 {object} errors.AppError "User not authenticated"
+// @Failure 503 {object} errors.AppError "Sharing is not available"
+// @Router /api/v1/video/{videoUrl}/share [post]
+// @Security BearerAuth
+func VideoCreateShareLink() {}
+
+// VideoGetSharedTranscript godoc
+// @Summary View a shared transcript
+// @Description Serve the read-only dual-language transcript behind a share link; no authentication required
+// @Tags video
+// @Produce json
+// @Param token path string true "Signed share token"
+// @Param password query string false "Link password, when the creator set one"
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Segments per page (max 500)" default(100)
+// @Success 200 {object} dto.GetDualTranscriptResponse "Paired original and translated segments"
+// @Failure 401 {object} errors.AppError "Password required or incorrect"
+// @Failure 404 {object} errors.AppError "Share link not found"
+// @Failure 410 {object} errors.AppError "Share link has expired"
+// @Router /api/v1/share/{token} [get]
+func VideoGetSharedTranscript() {}
@@ -0,0 +1,108 @@
+package docs
+
+import (
+	"app-backend/internal/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// NewVocabDocs creates instances of vocabulary-related DTOs for swagger documentation
+// This function is never called but ensures the DTOs are considered "used" by the linter
+func NewVocabDocs() {
+	_ = dto.CreateVocabItemRequest{}
+	_ = dto.UpdateVocabItemRequest{}
+	_ = dto.ReviewVocabItemRequest{}
+	_ = dto.VocabItemResponse{}
+	_ = dto.ReviewQueueResponse{}
+	_ = dto.ImportVocabResponse{}
+}
+
+// VocabCreateItem godoc
+// @Summary Save a vocabulary item
+// @Description Save a word with its definition, example sentence and source video for spaced-repetition review
+// @Tags vocab
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.CreateVocabItemRequest true "Vocabulary item"
+// @Success 201 {object} dto.VocabItemResponse "Saved vocabulary item"
+// @Failure 400 {object} map[string]interface{} "Invalid request data"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /vocab [post]
+func VocabCreateItem(c *gin.Context) {}
+
+// VocabListItems godoc
+// @Summary List vocabulary items
+// @Description List the authenticated user's vocabulary items with pagination and search
+// @Tags vocab
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Param search query string false "Search in word and definition"
+// @Success 200 {object} map[string]interface{} "Paginated vocabulary items"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /vocab [get]
+func VocabListItems(c *gin.Context) {}
+
+// VocabGetReviewQueue godoc
+// @Summary Get items due for review
+// @Description Get the vocabulary items whose SM-2 review time has passed, most overdue first
+// @Tags vocab
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param limit query int false "Maximum number of items" default(50)
+// @Success 200 {object} dto.ReviewQueueResponse "Items due for review"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /vocab/review [get]
+func VocabGetReviewQueue(c *gin.Context) {}
+
+// VocabReviewItem godoc
+// @Summary Grade a vocabulary review
+// @Description Record a review with an SM-2 quality grade (0-5) and reschedule the item
+// @Tags vocab
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param itemId path int true "Vocabulary item ID"
+// @Param request body dto.ReviewVocabItemRequest true "Review grade"
+// @Success 200 {object} dto.VocabItemResponse "Rescheduled vocabulary item"
+// @Failure 400 {object} map[string]interface{} "Invalid request data"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 404 {object} map[string]interface{} "Vocabulary item not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /vocab/{itemId}/review [post]
+func VocabReviewItem(c *gin.Context) {}
+
+// VocabImportItems godoc
+// @Summary Bulk-import vocabulary
+// @Description Import vocabulary from a CSV or TSV request body (term, reading, definition, example, source video URL, timestamp); Anki export directives and cloze markup are understood, and terms already saved are skipped as duplicates
+// @Tags vocab
+// @Accept plain
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dto.ImportVocabResponse "Import summary"
+// @Failure 400 {object} map[string]interface{} "Invalid or empty import file"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 413 {object} map[string]interface{} "Import payload too large"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /vocab/import [post]
+func VocabImportItems(c *gin.Context) {}
+
+// VocabExportItems godoc
+// @Summary Export vocabulary
+// @Description Download the whole vocabulary as CSV or as an Anki-importable TSV whose example column carries cloze markup
+// @Tags vocab
+// @Produce plain
+// @Security Bearer
+// @Param format query string false "Export format" Enums(csv, anki) default(csv)
+// @Success 200 {string} string "Exported vocabulary file"
+// @Failure 400 {object} map[string]interface{} "Unsupported export format"
+// @Failure 401 {object} map[string]interface{} "User not authenticated"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /vocab/export [get]
+func VocabExportItems(c *gin.Context) {}
@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// UpdateUserRoleRequest represents an admin changing a user's role
+type UpdateUserRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=user moderator admin"`
+}
+
+// UpdateUserStatusRequest represents an admin enabling or disabling an account
+type UpdateUserStatusRequest struct {
+	IsActive *bool `json:"is_active" binding:"required"`
+}
+
+// RevokeUserSessionsResponse reports how many sessions a force-logout closed
+type RevokeUserSessionsResponse struct {
+	RevokedSessions int `json:"revoked_sessions"`
+}
+
+// AdminAuditLogResponse represents one audit trail entry in responses
+type AdminAuditLogResponse struct {
+	ID           uint      `json:"id"`
+	ActorID      uint      `json:"actor_id"`
+	TargetUserID uint      `json:"target_user_id"`
+	Action       string    `json:"action"`
+	Detail       string    `json:"detail"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+import "app-backend/internal/repositories"
+
+// GetProviderStatsResponse represents aggregated provider outcomes over a window
+type GetProviderStatsResponse struct {
+	Window    string                              `json:"window"`
+	Providers []repositories.ProviderOutcomeStats `json:"providers"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+// CreateAPIKeyRequest represents a request to generate a new API key
+type CreateAPIKeyRequest struct {
+	Name string `json:"name" binding:"required,min=1,max=100"`
+	// Scopes restricts the key to specific endpoint scopes; empty grants all
+	Scopes []string `json:"scopes"`
+	// ExpiresInDays sets the key expiry; zero means the key never expires
+	ExpiresInDays int `json:"expires_in_days" binding:"omitempty,min=1,max=3650"`
+}
+
+// APIKeyResponse represents an API key without its secret
+type APIKeyResponse struct {
+	ID        uint       `json:"id"`
+	Name      string     `json:"name"`
+	Prefix    string     `json:"prefix"`
+	Scopes    []string   `json:"scopes,omitempty"`
+	IsActive  bool       `json:"is_active"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	LastUsed  *time.Time `json:"last_used,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// CreateAPIKeyResponse returns the generated key exactly once alongside its
+// metadata; the raw key is never retrievable again
+type CreateAPIKeyResponse struct {
+	Key    string         `json:"key"`
+	APIKey APIKeyResponse `json:"api_key"`
+}
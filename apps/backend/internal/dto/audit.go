@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+// ListAuditEventsRequest represents filters for the admin audit query
+type ListAuditEventsRequest struct {
+	Action       string `form:"action" binding:"omitempty,max=100"`
+	ActorID      uint   `form:"actor_id"`
+	TargetUserID uint   `form:"target_user_id"`
+}
+
+// AuditEventResponse represents one security event in responses
+type AuditEventResponse struct {
+	ID           uint      `json:"id"`
+	ActorID      uint      `json:"actor_id"`
+	TargetUserID uint      `json:"target_user_id"`
+	Action       string    `json:"action"`
+	Detail       string    `json:"detail"`
+	IPAddress    string    `json:"ip_address"`
+	UserAgent    string    `json:"user_agent"`
+	RequestID    string    `json:"request_id"`
+	CreatedAt    time.Time `json:"created_at"`
+}
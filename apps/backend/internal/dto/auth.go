@@ -1,8 +1,8 @@
 package dto
 
 import (
-	"time"
 	"app-backend/internal/types"
+	"time"
 )
 
 // LoginRequest represents the login request payload
@@ -54,13 +54,18 @@ type UserResponse struct {
 
 // SessionResponse represents session data in responses
 type SessionResponse struct {
-	ID        uint      `json:"id"`
-	IPAddress string    `json:"ip_address"`
-	UserAgent string    `json:"user_agent"`
-	LastUsed  time.Time `json:"last_used"`
-	ExpiresAt time.Time `json:"expires_at"`
-	IsActive  bool      `json:"is_active"`
-	CreatedAt time.Time `json:"created_at"`
+	ID        uint   `json:"id"`
+	IPAddress string `json:"ip_address"`
+	UserAgent string `json:"user_agent"`
+	Browser   string `json:"browser,omitempty"`
+	OS        string `json:"os,omitempty"`
+	Location  string `json:"location,omitempty"`
+	// DeviceLabel is a friendly description like "Chrome on macOS, Hanoi"
+	DeviceLabel string    `json:"device_label"`
+	LastUsed    time.Time `json:"last_used"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	IsActive    bool      `json:"is_active"`
+	CreatedAt   time.Time `json:"created_at"`
 }
 
 // UpdateProfileRequest represents the profile update request payload
@@ -72,6 +77,6 @@ type UpdateProfileRequest struct {
 
 // UserListResponse represents paginated user list response
 type UserListResponse struct {
-	Data []UserResponse `json:"data"`
+	Data       []UserResponse           `json:"data"`
 	Pagination types.PaginationMetadata `json:"pagination"`
 }
@@ -0,0 +1,20 @@
+package dto
+
+// PlaylistImportRequest asks the backend to fetch transcripts for every
+// video in a playlist or channel
+type PlaylistImportRequest struct {
+	// URL is a YouTube playlist URL (list= parameter), /channel/UC... URL,
+	// or /@handle URL
+	URL string `json:"url" binding:"required"`
+	// Language is the preferred transcript language for every video
+	Language string `json:"language"`
+}
+
+// PlaylistImportResponse acknowledges a queued import job; progress is
+// polled through the generic job endpoint
+type PlaylistImportResponse struct {
+	JobID       uint   `json:"jobId"`
+	Status      string `json:"status"`
+	PlaylistID  string `json:"playlistId"`
+	TotalVideos int    `json:"totalVideos"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+// CapabilitiesResponse reports which optional features this deployment has
+// enabled, so clients can hide or disable the corresponding UI instead of
+// discovering missing services through 503s
+type CapabilitiesResponse struct {
+	// Translation is true when the Gemini-backed translation service
+	// initialized successfully
+	Translation bool `json:"translation"`
+	// Transcription is true when the transcript extraction service is up
+	Transcription bool `json:"transcription"`
+	// OAuthCaptions is true when YouTube OAuth is configured, enabling
+	// caption downloads through the official API
+	OAuthCaptions bool `json:"oauth_captions"`
+}
@@ -0,0 +1,43 @@
+package dto
+
+import "app-backend/internal/types"
+
+// GetChaptersRequest asks for a video's chapter list
+type GetChaptersRequest struct {
+	VideoURL string `uri:"videoUrl" binding:"required,b64url"`
+	// Language selects the transcript language used when chapters have to
+	// be inferred; empty takes the video's default transcript
+	Language string `form:"lang" binding:"omitempty,lang_code"`
+}
+
+// ChapterResponse is one chapter for player navigation
+type ChapterResponse struct {
+	Title        string  `json:"title"`
+	StartSeconds float64 `json:"startSeconds"`
+	// EndSeconds is the start of the next chapter; zero when the chapter
+	// runs to an unknown video end
+	EndSeconds float64 `json:"endSeconds,omitempty"`
+}
+
+// Chapter sources reported in GetChaptersResponse
+const (
+	// ChapterSourceDescription marks chapters parsed from timestamp
+	// markers in the video description
+	ChapterSourceDescription = "description"
+	// ChapterSourceTranscript marks chapters inferred from the transcript
+	ChapterSourceTranscript = "transcript"
+	// ChapterSourceNone marks a video with no chapters available
+	ChapterSourceNone = "none"
+)
+
+// GetChaptersResponse is the chapter list of one video
+type GetChaptersResponse struct {
+	VideoID  string              `json:"videoId"`
+	Provider types.VideoProvider `json:"provider"`
+	// Source records where the chapters came from: description markers,
+	// transcript inference, or none
+	Source   string            `json:"source"`
+	Chapters []ChapterResponse `json:"chapters"`
+	// Cached reports whether inferred chapters were served from the cache
+	Cached bool `json:"cached"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+// DictionaryLookupRequest represents a contextual word lookup request. The
+// sentence is the transcript line the word appeared in and disambiguates
+// which sense of the word to explain.
+type DictionaryLookupRequest struct {
+	Word       string `json:"word" binding:"required,max=100"`
+	Sentence   string `json:"sentence" binding:"required,max=1000"`
+	TargetLang string `json:"targetLang" binding:"omitempty,max=10"` // translate the word to this language if set
+}
+
+// DictionaryLookupResponse represents a structured dictionary entry
+type DictionaryLookupResponse struct {
+	Word         string   `json:"word"`
+	Definition   string   `json:"definition"`
+	PartOfSpeech string   `json:"partOfSpeech"`
+	IPA          string   `json:"ipa"`
+	Examples     []string `json:"examples"`
+	Translation  string   `json:"translation,omitempty"`
+	// Cached is set when the entry was served from the lookup cache
+	Cached bool `json:"cached"`
+}
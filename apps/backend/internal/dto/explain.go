@@ -0,0 +1,40 @@
+package dto
+
+// ExplainRequest asks for a grammar breakdown of one transcript sentence
+type ExplainRequest struct {
+	Sentence string `json:"sentence" binding:"required,max=1000"`
+	// NativeLang is the learner's native language; explanations and
+	// translations are written in it
+	NativeLang string `json:"nativeLang" binding:"required,max=10"`
+}
+
+// ExplainClause is one clause of the analyzed sentence
+type ExplainClause struct {
+	Text string `json:"text"`
+	Role string `json:"role"`
+}
+
+// ExplainTense explains the tense of one verb in the sentence
+type ExplainTense struct {
+	Verb        string `json:"verb"`
+	Tense       string `json:"tense"`
+	Explanation string `json:"explanation"`
+}
+
+// ExplainIdiom explains an idiom or fixed expression found in the sentence
+type ExplainIdiom struct {
+	Phrase  string `json:"phrase"`
+	Meaning string `json:"meaning"`
+}
+
+// ExplainResponse is the structured grammar breakdown
+type ExplainResponse struct {
+	Sentence           string          `json:"sentence"`
+	Clauses            []ExplainClause `json:"clauses"`
+	Tenses             []ExplainTense  `json:"tenses,omitempty"`
+	Idioms             []ExplainIdiom  `json:"idioms,omitempty"`
+	LiteralTranslation string          `json:"literalTranslation"`
+	NaturalTranslation string          `json:"naturalTranslation"`
+	// Cached reports whether the breakdown was served from the cache
+	Cached bool `json:"cached"`
+}
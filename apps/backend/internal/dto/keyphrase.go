@@ -0,0 +1,39 @@
+package dto
+
+import "app-backend/internal/types"
+
+// GetKeyPhrasesRequest asks for high-value vocabulary extracted from a
+// video's transcript
+type GetKeyPhrasesRequest struct {
+	VideoURL string `uri:"videoUrl" binding:"required,b64url"`
+	// Language selects the transcript language to extract from; empty
+	// takes the video's default transcript
+	Language string `form:"lang" binding:"omitempty,lang_code"`
+	// Limit caps how many candidates are returned
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// KeyPhraseCandidate is one extracted word or collocation, with the
+// timestamp and sentence of its first occurrence so it can be saved as a
+// vocabulary item in one click
+type KeyPhraseCandidate struct {
+	Term string `json:"term"`
+	// Lemma is the dictionary form the occurrences were grouped under
+	Lemma string `json:"lemma"`
+	// Count is how often the term appears in the transcript
+	Count int `json:"count"`
+	// Score weights the count by the term's rarity in everyday English
+	Score float64 `json:"score"`
+	// TimestampSeconds is the playback position of the first occurrence
+	TimestampSeconds float64 `json:"timestampSeconds"`
+	// ExampleSentence is the transcript text of the first occurrence
+	ExampleSentence string `json:"exampleSentence"`
+}
+
+// GetKeyPhrasesResponse lists the extracted vocabulary candidates
+type GetKeyPhrasesResponse struct {
+	VideoID  string               `json:"videoId"`
+	Provider types.VideoProvider  `json:"provider"`
+	Language string               `json:"language"`
+	Phrases  []KeyPhraseCandidate `json:"phrases"`
+}
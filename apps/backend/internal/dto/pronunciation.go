@@ -0,0 +1,40 @@
+package dto
+
+import "time"
+
+// AssessPronunciationRequest is the multipart form accompanying a practice
+// audio clip; the clip itself is uploaded as the "audio" file field
+type AssessPronunciationRequest struct {
+	// Text is the reference transcript segment the user read aloud
+	Text string `form:"text" binding:"required,max=2000"`
+	// Language is the language of the reference text
+	Language string `form:"language" binding:"omitempty,max=10"`
+	// VideoID links the attempt to the video being practiced
+	VideoID string `form:"videoId" binding:"omitempty,max=255"`
+}
+
+// PronunciationWordScoreResponse is the verdict for one word of the clip
+type PronunciationWordScoreResponse struct {
+	Word     string  `json:"word"`
+	Score    float64 `json:"score"`
+	Feedback string  `json:"feedback,omitempty"`
+}
+
+// AssessPronunciationResponse is the scored result of a practice clip
+type AssessPronunciationResponse struct {
+	AttemptID    uint                             `json:"attemptId"`
+	OverallScore float64                          `json:"overallScore"`
+	Words        []PronunciationWordScoreResponse `json:"words"`
+	Provider     string                           `json:"provider"`
+}
+
+// PronunciationAttemptResponse is one stored attempt in the practice history
+type PronunciationAttemptResponse struct {
+	ID            uint                             `json:"id"`
+	VideoID       string                           `json:"videoId,omitempty"`
+	Language      string                           `json:"language,omitempty"`
+	ReferenceText string                           `json:"referenceText"`
+	OverallScore  float64                          `json:"overallScore"`
+	Words         []PronunciationWordScoreResponse `json:"words,omitempty"`
+	CreatedAt     time.Time                        `json:"createdAt"`
+}
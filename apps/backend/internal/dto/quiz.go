@@ -0,0 +1,53 @@
+package dto
+
+// GenerateQuizRequest asks for a quiz built from a video's transcript
+type GenerateQuizRequest struct {
+	VideoURL string `json:"videoUrl" binding:"required"`
+	// Language selects the transcript language; empty uses the default
+	Language string `json:"language" binding:"omitempty,max=10"`
+	// NumQuestions is how many questions to generate; defaults to 5
+	NumQuestions int `json:"numQuestions" binding:"omitempty,min=1,max=20"`
+	// Seed makes generation reproducible; zero derives a seed from the video
+	Seed int64 `json:"seed" binding:"omitempty,gte=0"`
+}
+
+// QuizQuestionResponse is one question as presented to the learner, without
+// its correct answer
+type QuizQuestionResponse struct {
+	Index    int      `json:"index"`
+	Type     string   `json:"type"`
+	Question string   `json:"question"`
+	Options  []string `json:"options,omitempty"`
+}
+
+// GenerateQuizResponse returns the stored quiz with its questions
+type GenerateQuizResponse struct {
+	QuizID    uint                   `json:"quizId"`
+	VideoID   string                 `json:"videoId"`
+	Language  string                 `json:"language,omitempty"`
+	Seed      int64                  `json:"seed"`
+	Questions []QuizQuestionResponse `json:"questions"`
+}
+
+// SubmitQuizAnswersRequest carries the learner's answers in question order
+type SubmitQuizAnswersRequest struct {
+	Answers []string `json:"answers" binding:"required,min=1"`
+}
+
+// QuizAnswerResult is the graded outcome of one answer, revealing the
+// correct answer after submission
+type QuizAnswerResult struct {
+	Index   int    `json:"index"`
+	Correct bool   `json:"correct"`
+	Given   string `json:"given"`
+	Answer  string `json:"answer"`
+}
+
+// SubmitQuizAnswersResponse returns the graded submission
+type SubmitQuizAnswersResponse struct {
+	QuizID        uint               `json:"quizId"`
+	Score         float64            `json:"score"`
+	CorrectCount  int                `json:"correctCount"`
+	QuestionCount int                `json:"questionCount"`
+	Results       []QuizAnswerResult `json:"results"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "app-backend/internal/types"
+
+// SearchTranscriptsRequest represents a transcript search query
+type SearchTranscriptsRequest struct {
+	Query string `form:"q" binding:"required,min=2,max=200"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=200"`
+}
+
+// TranscriptSearchMatchResponse is one transcript segment matching a search
+// query, with enough context to jump to the moment in the video
+type TranscriptSearchMatchResponse struct {
+	VideoID   string                    `json:"videoId"`
+	Language  string                    `json:"language"`
+	Text      string                    `json:"text"`
+	StartTime types.MillisecondDuration `json:"startTime"`
+	EndTime   types.MillisecondDuration `json:"endTime"`
+}
+
+// SearchTranscriptsResponse represents transcript search results
+type SearchTranscriptsResponse struct {
+	Query   string                          `json:"query"`
+	Matches []TranscriptSearchMatchResponse `json:"matches"`
+	Total   int                             `json:"total"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+// DailyStatDTO is one day's study activity counts
+type DailyStatDTO struct {
+	Date               string `json:"date"`
+	VideosWatched      int    `json:"videos_watched"`
+	SegmentsTranslated int    `json:"segments_translated"`
+	VocabReviewed      int    `json:"vocab_reviewed"`
+	MinutesStudied     int    `json:"minutes_studied"`
+}
+
+// UserStatsResponse represents a user's study streak and per-day activity
+// over the requested range for the dashboard
+type UserStatsResponse struct {
+	// RangeDays is the number of days covered, today included
+	RangeDays int `json:"range_days"`
+	// StreakDays is the current run of consecutive active days. An
+	// inactive today does not break the streak until the day ends.
+	StreakDays int `json:"streak_days"`
+
+	TotalVideosWatched      int `json:"total_videos_watched"`
+	TotalSegmentsTranslated int `json:"total_segments_translated"`
+	TotalVocabReviewed      int `json:"total_vocab_reviewed"`
+	TotalMinutesStudied     int `json:"total_minutes_studied"`
+
+	// Days holds one entry per day in the range, oldest first, with
+	// inactive days zero-filled
+	Days []DailyStatDTO `json:"days"`
+}
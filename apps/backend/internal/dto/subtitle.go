@@ -0,0 +1,28 @@
+package dto
+
+// GenerateSubtitleRequest asks the backend to render a merged dual-language
+// subtitle file (original on the first line, translation on the second)
+type GenerateSubtitleRequest struct {
+	// VideoURL is a video URL or bare video ID
+	VideoURL string `json:"videoUrl" binding:"required"`
+	// SourceLang is the original transcript language (auto-detected if omitted)
+	SourceLang string `json:"sourceLang"`
+	// TargetLang is the translation language
+	TargetLang string `json:"targetLang" binding:"required"`
+	// Format selects the subtitle container; defaults to srt
+	Format string `json:"format" binding:"omitempty,oneof=srt ass"`
+	// TranslationColor styles the translation line as #RRGGBB
+	TranslationColor string `json:"translationColor" binding:"omitempty,hexcolor"`
+	// TranslationSize is the translation font size in points (ASS only)
+	TranslationSize int `json:"translationSize" binding:"omitempty,min=8,max=96"`
+}
+
+// GenerateSubtitleResponse acknowledges a queued subtitle generation job;
+// progress is polled through the generic job endpoint and the finished file
+// is fetched from the download endpoint
+type GenerateSubtitleResponse struct {
+	JobID   uint   `json:"jobId"`
+	Status  string `json:"status"`
+	VideoID string `json:"videoId"`
+	Format  string `json:"format"`
+}
@@ -0,0 +1,41 @@
+package dto
+
+import "app-backend/internal/types"
+
+// GetVideoSummaryRequest asks for a chaptered summary of a video transcript
+type GetVideoSummaryRequest struct {
+	VideoURL string `uri:"videoUrl" binding:"required,b64url"`
+	// Language selects the transcript language to summarize; empty takes
+	// the video's default transcript
+	Language string `form:"lang" binding:"omitempty,lang_code"`
+	// Depth controls how detailed the summary is
+	Depth string `form:"depth" binding:"omitempty,oneof=brief standard detailed"`
+}
+
+// SummarySectionResponse is one timestamped chapter of the summary
+type SummarySectionResponse struct {
+	Title        string  `json:"title"`
+	StartSeconds float64 `json:"startSeconds"`
+	EndSeconds   float64 `json:"endSeconds"`
+	Summary      string  `json:"summary"`
+}
+
+// SummaryVocabularyResponse is one key term from the video worth learning
+type SummaryVocabularyResponse struct {
+	Term    string `json:"term"`
+	Meaning string `json:"meaning"`
+}
+
+// VideoSummaryResponse is the chaptered summary of a video transcript
+type VideoSummaryResponse struct {
+	VideoID       string                      `json:"videoId"`
+	Provider      types.VideoProvider         `json:"provider"`
+	Language      string                      `json:"language"`
+	Depth         string                      `json:"depth"`
+	Overview      string                      `json:"overview"`
+	MainIdeas     []string                    `json:"mainIdeas"`
+	Sections      []SummarySectionResponse    `json:"sections"`
+	KeyVocabulary []SummaryVocabularyResponse `json:"keyVocabulary,omitempty"`
+	// Cached reports whether the summary was served from the cache
+	Cached bool `json:"cached"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+// EditTranscriptSegmentRequest is the body of a segment correction
+type EditTranscriptSegmentRequest struct {
+	Text     string `json:"text" binding:"required,max=2000"`
+	Language string `json:"language" binding:"omitempty,max=10"`
+}
+
+// TranscriptCorrectionResponse is one recorded segment edit
+type TranscriptCorrectionResponse struct {
+	VideoID      string    `json:"videoId"`
+	SegmentIndex int       `json:"segmentIndex"`
+	Text         string    `json:"text"`
+	Language     string    `json:"language,omitempty"`
+	Version      int       `json:"version"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// TranscriptCorrectionHistoryResponse lists every edit for a video oldest
+// first; the highest version is the current corrected transcript
+type TranscriptCorrectionHistoryResponse struct {
+	VideoID        string                         `json:"videoId"`
+	CurrentVersion int                            `json:"currentVersion"`
+	Corrections    []TranscriptCorrectionResponse `json:"corrections"`
+}
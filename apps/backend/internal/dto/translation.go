@@ -2,14 +2,169 @@ package dto
 
 // TranslateTextsRequest represents a request to translate multiple texts
 type TranslateTextsRequest struct {
-	Texts      []string `json:"texts" binding:"required"`
-	SourceLang string   `json:"sourceLang"` // auto-detect if empty
-	TargetLang string   `json:"targetLang" binding:"required"`
+	Texts       []string `json:"texts" binding:"required"`
+	SourceLang  string   `json:"sourceLang" binding:"omitempty,lang_code"` // auto-detect if empty
+	TargetLang  string   `json:"targetLang" binding:"required,lang_code"`
+	UseGistPass bool     `json:"useGistPass"`                         // opt-in two-pass translation for better coherence
+	Mode        string   `json:"mode" binding:"omitempty,oneof=dual"` // "dual" returns literal and natural translations side by side
+}
+
+// DualTranslationDTO pairs a literal gloss with a natural translation
+type DualTranslationDTO struct {
+	Literal string `json:"literal"`
+	Natural string `json:"natural"`
+}
+
+// TranslationMatchDTO reports a segment served from the translation memory,
+// with the similarity between the request text and the stored source segment
+// (1 for exact hits, below 1 for fuzzy matches)
+type TranslationMatchDTO struct {
+	Index      int     `json:"index"`
+	SourceText string  `json:"sourceText"`
+	Similarity float64 `json:"similarity"`
 }
 
 // TranslateTextsResponse represents the response with translated texts
 type TranslateTextsResponse struct {
-	Translations []string `json:"translations"`
-	SourceLang   string   `json:"sourceLang"` // detected or provided
-	TargetLang   string   `json:"targetLang"`
-}
\ No newline at end of file
+	Translations []string `json:"translations,omitempty"`
+	// Dual holds the literal/natural pairs when mode=dual was requested
+	Dual       []DualTranslationDTO `json:"dual,omitempty"`
+	SourceLang string               `json:"sourceLang"` // detected or provided
+	TargetLang string               `json:"targetLang"`
+	// Matches lists the segments served from the translation memory
+	Matches []TranslationMatchDTO `json:"matches,omitempty"`
+}
+
+// StreamTranslationEventDTO is the SSE payload for one translated segment
+type StreamTranslationEventDTO struct {
+	Index       int    `json:"index"`
+	Translation string `json:"translation,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// StreamTranslationDoneDTO is the SSE payload that closes a translation stream
+type StreamTranslationDoneDTO struct {
+	Total  int `json:"total"`
+	Failed int `json:"failed"`
+}
+
+// TranslationMemoryEntryDTO represents a single TMX-like translation unit
+type TranslationMemoryEntryDTO struct {
+	SourceLang string `json:"sourceLang" binding:"required"`
+	TargetLang string `json:"targetLang" binding:"required"`
+	SourceText string `json:"sourceText" binding:"required"`
+	TargetText string `json:"targetText" binding:"required"`
+}
+
+// ExportTranslationMemoryResponse represents an exported translation memory
+type ExportTranslationMemoryResponse struct {
+	Entries []TranslationMemoryEntryDTO `json:"entries"`
+	Count   int                         `json:"count"`
+}
+
+// ImportTranslationMemoryRequest represents a translation memory import
+type ImportTranslationMemoryRequest struct {
+	Entries []TranslationMemoryEntryDTO `json:"entries" binding:"required"`
+}
+
+// ImportTranslationMemoryResponse reports the result of an import
+type ImportTranslationMemoryResponse struct {
+	Imported int `json:"imported"`
+	Skipped  int `json:"skipped"`
+}
+
+// SegmentLatencyStatsDTO summarizes a per-segment latency distribution in
+// milliseconds
+type SegmentLatencyStatsDTO struct {
+	Count int     `json:"count"`
+	AvgMs float64 `json:"avgMs"`
+	P50Ms float64 `json:"p50Ms"`
+	P90Ms float64 `json:"p90Ms"`
+	P99Ms float64 `json:"p99Ms"`
+}
+
+// TranslationLatencyMetricsResponse exposes per-segment translation latency
+// percentiles, split by cache hits and model calls
+type TranslationLatencyMetricsResponse struct {
+	CacheHits  SegmentLatencyStatsDTO `json:"cacheHits"`
+	ModelCalls SegmentLatencyStatsDTO `json:"modelCalls"`
+}
+
+// BulkTranslateItemDTO is a single item submitted for bulk translation
+type BulkTranslateItemDTO struct {
+	ID         string `json:"id" binding:"required"`
+	Text       string `json:"text" binding:"required"`
+	SourceLang string `json:"sourceLang"`
+	TargetLang string `json:"targetLang" binding:"required"`
+}
+
+// BulkTranslateRequest represents an asynchronous bulk translation submission
+type BulkTranslateRequest struct {
+	Items []BulkTranslateItemDTO `json:"items" binding:"required"`
+}
+
+// BulkTranslateResponse reports how many items were accepted for processing
+type BulkTranslateResponse struct {
+	Accepted int `json:"accepted"`
+}
+
+// DeadLetteredItemDTO is a bulk item that failed processing
+type DeadLetteredItemDTO struct {
+	ID         string `json:"id"`
+	Text       string `json:"text"`
+	SourceLang string `json:"sourceLang"`
+	TargetLang string `json:"targetLang"`
+	Reason     string `json:"reason"`
+	FailedAt   string `json:"failedAt"`
+}
+
+// ListDeadLettersResponse lists dead-lettered bulk items
+type ListDeadLettersResponse struct {
+	Items []DeadLetteredItemDTO `json:"items"`
+	Count int                   `json:"count"`
+}
+
+// BatchTranslateRequest enqueues a whole translation workload as one job
+type BatchTranslateRequest struct {
+	Texts      []string `json:"texts" binding:"required"`
+	SourceLang string   `json:"sourceLang"`
+	TargetLang string   `json:"targetLang" binding:"required"`
+}
+
+// BatchTranslateResponse returns the job ID for polling or streaming
+type BatchTranslateResponse struct {
+	JobID  string `json:"jobId"`
+	Status string `json:"status"`
+	Total  int    `json:"total"`
+}
+
+// BatchSegmentResultDTO is the state of one text within a batch job
+type BatchSegmentResultDTO struct {
+	Index       int    `json:"index"`
+	Translation string `json:"translation,omitempty"`
+	Error       string `json:"error,omitempty"`
+	Done        bool   `json:"done"`
+}
+
+// BatchJobResponse is a point-in-time view of a batch translation job
+type BatchJobResponse struct {
+	JobID           string                  `json:"jobId"`
+	Status          string                  `json:"status"`
+	SourceLang      string                  `json:"sourceLang,omitempty"`
+	TargetLang      string                  `json:"targetLang"`
+	Total           int                     `json:"total"`
+	Completed       int                     `json:"completed"`
+	Failed          int                     `json:"failed"`
+	ProgressPercent float64                 `json:"progressPercent"`
+	Results         []BatchSegmentResultDTO `json:"results"`
+}
+
+// BatchStreamEventDTO is the SSE payload for one batch progress update
+type BatchStreamEventDTO struct {
+	Index           int     `json:"index"`
+	Translation     string  `json:"translation,omitempty"`
+	Error           string  `json:"error,omitempty"`
+	Completed       int     `json:"completed"`
+	Total           int     `json:"total"`
+	ProgressPercent float64 `json:"progressPercent"`
+}
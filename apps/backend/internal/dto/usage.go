@@ -0,0 +1,17 @@
+package dto
+
+// UsageWindowResponse reports consumption against one quota window
+type UsageWindowResponse struct {
+	CharsUsed int64 `json:"charsUsed"`
+	// CharLimit is the configured cap; 0 means unlimited
+	CharLimit int `json:"charLimit"`
+	// Remaining is the allowance left in the window; -1 when unlimited
+	Remaining int64 `json:"remaining"`
+}
+
+// GetUsageResponse reports the user's translation usage for the current
+// daily and monthly windows
+type GetUsageResponse struct {
+	Daily   UsageWindowResponse `json:"daily"`
+	Monthly UsageWindowResponse `json:"monthly"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+// SaveUserVideoRequest records a study session for a video. Saving a video
+// the user already has updates its position, language pair and progress.
+type SaveUserVideoRequest struct {
+	// VideoID is the YouTube video identifier
+	VideoID string `json:"video_id" binding:"required,max=100"`
+	Title   string `json:"title" binding:"max=500"`
+	// LastPosition is the playback position in seconds to resume from
+	LastPosition float64 `json:"last_position" binding:"omitempty,min=0"`
+	// SourceLanguage and TargetLanguage are the study language pair
+	SourceLanguage string `json:"source_language" binding:"max=10"`
+	TargetLanguage string `json:"target_language" binding:"max=10"`
+	// ProgressPercent is how much of the video was watched (0-100)
+	ProgressPercent float64 `json:"progress_percent" binding:"omitempty,min=0,max=100"`
+}
+
+// UserVideoResponse represents a saved video with its study session state
+type UserVideoResponse struct {
+	ID              uint      `json:"id"`
+	VideoID         string    `json:"video_id"`
+	Title           string    `json:"title,omitempty"`
+	LastPosition    float64   `json:"last_position"`
+	SourceLanguage  string    `json:"source_language,omitempty"`
+	TargetLanguage  string    `json:"target_language,omitempty"`
+	ProgressPercent float64   `json:"progress_percent"`
+	LastWatchedAt   time.Time `json:"last_watched_at"`
+	CreatedAt       time.Time `json:"created_at"`
+}
@@ -1,41 +1,87 @@
 package dto
 
 import (
-	"app-backend/internal/types"
+	"time"
+
 	transcriptTypes "app-backend/internal/services/transcript/types"
+	"app-backend/internal/types"
 )
 
 // VideoInfoRequest represents a request to get video information
 type VideoInfoRequest struct {
-	VideoURL string `uri:"videoUrl" binding:"required"`
+	VideoURL string `uri:"videoUrl" binding:"required,b64url"`
 }
 
 // VideoInfoResponse represents video information response
 type VideoInfoResponse struct {
-	ID           string                       `json:"id"`
-	Provider     types.VideoProvider          `json:"provider"`
-	Title        string                       `json:"title"`
-	Description  string                       `json:"description"`
-	Duration     types.MillisecondDuration    `json:"duration"`
-	ThumbnailURL string                       `json:"thumbnailUrl"`
-	URL          string                       `json:"url"`
-	Capabilities *VideoCapabilitiesResponse   `json:"capabilities,omitempty"`
+	ID           string                     `json:"id"`
+	Provider     types.VideoProvider        `json:"provider"`
+	Title        string                     `json:"title"`
+	Description  string                     `json:"description"`
+	Duration     types.MillisecondDuration  `json:"duration"`
+	ThumbnailURL string                     `json:"thumbnailUrl"`
+	URL          string                     `json:"url"`
+	Capabilities *VideoCapabilitiesResponse `json:"capabilities,omitempty"`
+}
+
+// ResolveVideoRequest represents a request to parse a video URL or bare ID
+type ResolveVideoRequest struct {
+	VideoURL string `json:"videoUrl" binding:"required,max=2000,video_url"`
+}
+
+// ResolveVideoResponse reports the provider, video ID, and URL format a
+// video reference was recognized as
+type ResolveVideoResponse struct {
+	Provider types.VideoProvider `json:"provider"`
+	VideoID  string              `json:"videoId"`
+	Type     string              `json:"type"`
 }
 
 // GetTranscriptRequest represents a request to get video transcript
 type GetTranscriptRequest struct {
-	VideoURL string `uri:"videoUrl" binding:"required"`
-	Language string `form:"lang"`
+	VideoURL          string `uri:"videoUrl" binding:"required,b64url"`
+	Language          string `form:"lang" binding:"omitempty,lang_code"`
+	DebugRaw          bool   `form:"debugRaw"`
+	OnLanguageMissing string `form:"onLanguageMissing" binding:"omitempty,oneof=fail fallback_any auto_translate"`
+	RestoreFormatting bool   `form:"restoreFormatting"`
+	Granularity       string `form:"granularity" binding:"omitempty,oneof=segment word"`
+	Segmentation      string `form:"segmentation" binding:"omitempty,oneof=none sentence"`
+	// From and To restrict the transcript to segments overlapping the
+	// [from, to) time range, in seconds; omitting to means until the end
+	From float64 `form:"from" binding:"omitempty,gte=0"`
+	To   float64 `form:"to" binding:"omitempty,gte=0"`
+	// Offset and Limit paginate the segment list after the range filter
+	Offset int `form:"offset" binding:"omitempty,gte=0"`
+	Limit  int `form:"limit" binding:"omitempty,gte=1,max=5000"`
+	// ProviderOverride forces a specific transcript provider, bypassing
+	// priority ordering and the cache; admin-only, the X-Provider-Override
+	// header takes precedence
+	ProviderOverride string `form:"provider_override" binding:"omitempty,max=50"`
 }
 
 // GetTranscriptResponse represents transcript response
 type GetTranscriptResponse struct {
-	VideoID   string                    `json:"videoId"`
-	Provider  types.VideoProvider       `json:"provider"`
-	Language  string                    `json:"language"`
+	VideoID   string                      `json:"videoId"`
+	Provider  types.VideoProvider         `json:"provider"`
+	Language  string                      `json:"language"`
 	Segments  []TranscriptSegmentResponse `json:"segments"`
-	Available bool                      `json:"available"`
-	Source    string                    `json:"source"`
+	Available bool                        `json:"available"`
+	Source    string                      `json:"source"`
+	// LanguageFallback is set when the transcript language differs from the
+	// requested one under the fallback_any policy
+	LanguageFallback bool `json:"languageFallback,omitempty"`
+	// TranslatedFrom records the original language when the transcript was
+	// machine-translated under the auto_translate policy
+	TranslatedFrom string `json:"translatedFrom,omitempty"`
+	// Stale marks a cached transcript served past its maximum age because
+	// all live providers failed
+	Stale bool `json:"stale,omitempty"`
+	// Raw is the base64-encoded raw provider payload, attached only for
+	// admins requesting debugRaw=true
+	Raw string `json:"raw,omitempty"`
+	// TotalSegments is the segment count before pagination when the request
+	// carried a time range or segment window
+	TotalSegments int `json:"totalSegments,omitempty"`
 }
 
 // TranscriptSegmentResponse represents a transcript segment
@@ -44,31 +90,139 @@ type TranscriptSegmentResponse struct {
 	EndTime   types.MillisecondDuration `json:"endTime"`
 	Text      string                    `json:"text"`
 	Index     int                       `json:"index"`
+	// Words carries per-word timing, present only for granularity=word
+	// requests on caption tracks that provide it
+	Words []TranscriptWordResponse `json:"words,omitempty"`
+}
+
+// TranscriptWordResponse is the timing of one word within a segment
+type TranscriptWordResponse struct {
+	StartTime types.MillisecondDuration `json:"startTime"`
+	EndTime   types.MillisecondDuration `json:"endTime"`
+	Text      string                    `json:"text"`
+}
+
+// GetDualTranscriptRequest represents a request for a dual-language transcript
+type GetDualTranscriptRequest struct {
+	VideoURL   string `uri:"videoUrl" binding:"required,b64url"`
+	TargetLang string `form:"targetLang" binding:"omitempty,lang_code"`
+	SourceLang string `form:"sourceLang" binding:"omitempty,lang_code"`
+	Page       int    `form:"page" binding:"omitempty,min=1"`
+	PageSize   int    `form:"pageSize" binding:"omitempty,min=1,max=500"`
+}
+
+// DualTranscriptSegmentResponse pairs an original segment with its translation
+type DualTranscriptSegmentResponse struct {
+	StartTime      types.MillisecondDuration `json:"startTime"`
+	EndTime        types.MillisecondDuration `json:"endTime"`
+	Index          int                       `json:"index"`
+	Text           string                    `json:"text"`
+	TranslatedText string                    `json:"translatedText"`
+}
+
+// GetDualTranscriptResponse represents a dual-language transcript response
+type GetDualTranscriptResponse struct {
+	VideoID    string                          `json:"videoId"`
+	Provider   types.VideoProvider             `json:"provider"`
+	SourceLang string                          `json:"sourceLang"`
+	TargetLang string                          `json:"targetLang"`
+	Segments   []DualTranscriptSegmentResponse `json:"segments"`
+	Pagination types.PaginationMetadata        `json:"pagination"`
+	Cached     bool                            `json:"cached"`
+}
+
+// ExportTranscriptRequest represents a request to download a transcript as a
+// subtitle or text file. Setting targetLang exports the dual-language
+// variant with the translation under each original line.
+type ExportTranscriptRequest struct {
+	VideoURL   string `uri:"videoUrl" binding:"required,b64url"`
+	Format     string `form:"format" binding:"omitempty,oneof=srt vtt json txt"`
+	Language   string `form:"lang" binding:"omitempty,lang_code"`
+	TargetLang string `form:"targetLang" binding:"omitempty,lang_code"`
+	SourceLang string `form:"sourceLang" binding:"omitempty,lang_code"`
+}
+
+// ExportTranscriptSegment is one cue in a JSON transcript export
+type ExportTranscriptSegment struct {
+	Index          int                       `json:"index"`
+	StartTime      types.MillisecondDuration `json:"startTime"`
+	EndTime        types.MillisecondDuration `json:"endTime"`
+	Text           string                    `json:"text"`
+	TranslatedText string                    `json:"translatedText,omitempty"`
+}
+
+// ExportTranscriptResponse is the body of a JSON transcript export
+type ExportTranscriptResponse struct {
+	VideoID    string                    `json:"videoId"`
+	Language   string                    `json:"language,omitempty"`
+	SourceLang string                    `json:"sourceLang,omitempty"`
+	TargetLang string                    `json:"targetLang,omitempty"`
+	Segments   []ExportTranscriptSegment `json:"segments"`
+}
+
+// CreateShareLinkRequest represents a request to create a signed share link
+// for a video's dual-language transcript
+type CreateShareLinkRequest struct {
+	TargetLang string `json:"targetLang" binding:"required,lang_code"`
+	SourceLang string `json:"sourceLang" binding:"omitempty,lang_code"`
+	// ExpiresInHours bounds the link lifetime; omitting it picks the
+	// configured default
+	ExpiresInHours int `json:"expiresInHours" binding:"omitempty,min=1,max=720"`
+	// Password optionally gates the link; visitors must present it to view
+	// the transcript
+	Password string `json:"password" binding:"omitempty,min=4,max=72"`
+}
+
+// ShareLinkResponse carries a freshly issued share link
+type ShareLinkResponse struct {
+	Token     string    `json:"token"`
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// GetSharedTranscriptRequest represents an unauthenticated request to view a
+// shared transcript
+type GetSharedTranscriptRequest struct {
+	Token    string `uri:"token" binding:"required"`
+	Password string `form:"password"`
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"pageSize" binding:"omitempty,min=1,max=500"`
 }
 
 // GetAvailableLanguagesRequest represents a request to get available languages
 type GetAvailableLanguagesRequest struct {
-	VideoURL string `uri:"videoUrl" binding:"required"`
+	VideoURL string `uri:"videoUrl" binding:"required,b64url"`
 }
 
 // GetAvailableLanguagesResponse represents available languages response
 type GetAvailableLanguagesResponse struct {
-	VideoID   string             `json:"videoId"`
+	VideoID   string              `json:"videoId"`
 	Provider  types.VideoProvider `json:"provider"`
 	Languages []LanguageResponse  `json:"languages"`
 }
 
 // LanguageResponse represents a language option
 type LanguageResponse struct {
-	Code string `json:"code"`
-	Name string `json:"name"`
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	NativeName string `json:"nativeName,omitempty"`
+	RTL        bool   `json:"rtl,omitempty"`
+	// AutoGenerated marks ASR caption tracks
+	AutoGenerated bool `json:"autoGenerated,omitempty"`
 }
 
 // VideoCapabilitiesResponse represents video capabilities
 type VideoCapabilitiesResponse struct {
-	HasTranscript         bool               `json:"hasTranscript"`
-	AvailableLanguages    []LanguageResponse `json:"availableLanguages"`
-	SupportsAutoGenerated bool               `json:"supportsAutoGenerated"`
+	HasTranscript         bool                        `json:"hasTranscript"`
+	AvailableLanguages    []LanguageResponse          `json:"availableLanguages"`
+	SupportsAutoGenerated bool                        `json:"supportsAutoGenerated"`
+	TranscriptEstimate    *TranscriptEstimateResponse `json:"transcriptEstimate,omitempty"`
+}
+
+// TranscriptEstimateResponse estimates transcript size before fetching
+type TranscriptEstimateResponse struct {
+	SegmentCount    int   `json:"segmentCount"`
+	DurationSeconds int64 `json:"durationSeconds"`
 }
 
 // GetSupportedProvidersResponse represents supported providers response
@@ -81,13 +235,6 @@ type GetSupportedLanguagesResponse struct {
 	Languages []LanguageResponse `json:"languages"`
 }
 
-// ErrorResponse represents an error response
-type ErrorResponse struct {
-	Error   string `json:"error"`
-	Code    string `json:"code,omitempty"`
-	Details string `json:"details,omitempty"`
-}
-
 // ConvertToTranscriptSegmentResponse converts internal type to DTO
 func ConvertToTranscriptSegmentResponse(segment types.TranscriptSegment) TranscriptSegmentResponse {
 	return TranscriptSegmentResponse{
@@ -101,18 +248,39 @@ func ConvertToTranscriptSegmentResponse(segment types.TranscriptSegment) Transcr
 // ConvertToLanguageResponse converts internal type to DTO
 func ConvertToLanguageResponse(lang types.Language) LanguageResponse {
 	return LanguageResponse{
-		Code: lang.Code,
-		Name: lang.Name,
+		Code:       lang.Code,
+		Name:       lang.Name,
+		NativeName: lang.NativeName,
+		RTL:        lang.RTL,
+	}
+}
+
+// ConvertFromCaptionLanguage converts a transcript service caption track to DTO
+func ConvertFromCaptionLanguage(lang transcriptTypes.CaptionLanguage) LanguageResponse {
+	return LanguageResponse{
+		Code:          lang.Code,
+		Name:          lang.Name,
+		AutoGenerated: lang.AutoGenerated,
 	}
 }
 
 // ConvertFromTranscriptServiceSegment converts transcript service segment to DTO
 func ConvertFromTranscriptServiceSegment(segment transcriptTypes.TranscriptSegment) TranscriptSegmentResponse {
+	var words []TranscriptWordResponse
+	for _, word := range segment.Words {
+		words = append(words, TranscriptWordResponse{
+			StartTime: types.MillisecondDuration(word.Start.Milliseconds()),
+			EndTime:   types.MillisecondDuration((word.Start + word.Duration).Milliseconds()),
+			Text:      word.Text,
+		})
+	}
+
 	return TranscriptSegmentResponse{
 		StartTime: types.MillisecondDuration(segment.Start.Milliseconds()),
 		EndTime:   types.MillisecondDuration((segment.Start + segment.Duration).Milliseconds()),
 		Text:      segment.Text,
 		Index:     0, // Index not provided by transcript service
+		Words:     words,
 	}
 }
 
@@ -123,9 +291,18 @@ func ConvertToVideoCapabilitiesResponse(caps types.VideoCapabilities) VideoCapab
 		languages = append(languages, ConvertToLanguageResponse(lang))
 	}
 
-	return VideoCapabilitiesResponse{
+	response := VideoCapabilitiesResponse{
 		HasTranscript:         caps.HasTranscript,
 		AvailableLanguages:    languages,
 		SupportsAutoGenerated: caps.SupportsAutoGenerated,
 	}
-}
\ No newline at end of file
+
+	if caps.TranscriptEstimate != nil {
+		response.TranscriptEstimate = &TranscriptEstimateResponse{
+			SegmentCount:    caps.TranscriptEstimate.SegmentCount,
+			DurationSeconds: caps.TranscriptEstimate.DurationSeconds,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,64 @@
+package dto
+
+import "time"
+
+// CreateVocabItemRequest represents a request to save a vocabulary item
+type CreateVocabItemRequest struct {
+	Word string `json:"word" binding:"required,min=1,max=200"`
+	// Reading is the optional pronunciation aid (furigana, pinyin)
+	Reading         string `json:"reading" binding:"max=200"`
+	Definition      string `json:"definition" binding:"max=2000"`
+	ExampleSentence string `json:"example_sentence" binding:"max=2000"`
+	// SourceVideoID is the YouTube video the word was captured from
+	SourceVideoID string `json:"source_video_id" binding:"max=100"`
+	// VideoTimestamp is the playback position in seconds
+	VideoTimestamp float64 `json:"video_timestamp" binding:"omitempty,min=0"`
+}
+
+// UpdateVocabItemRequest represents a request to edit a saved item. Only the
+// provided fields are changed; scheduling state is never edited directly.
+type UpdateVocabItemRequest struct {
+	Word            *string `json:"word" binding:"omitempty,min=1,max=200"`
+	Reading         *string `json:"reading" binding:"omitempty,max=200"`
+	Definition      *string `json:"definition" binding:"omitempty,max=2000"`
+	ExampleSentence *string `json:"example_sentence" binding:"omitempty,max=2000"`
+}
+
+// ReviewVocabItemRequest grades a review using the SM-2 quality scale:
+// 0 (blackout) through 5 (perfect recall)
+type ReviewVocabItemRequest struct {
+	Quality *int `json:"quality" binding:"required,min=0,max=5"`
+}
+
+// VocabItemResponse represents a vocabulary item with its review schedule
+type VocabItemResponse struct {
+	ID              uint      `json:"id"`
+	Word            string    `json:"word"`
+	Reading         string    `json:"reading,omitempty"`
+	Definition      string    `json:"definition,omitempty"`
+	ExampleSentence string    `json:"example_sentence,omitempty"`
+	SourceVideoID   string    `json:"source_video_id,omitempty"`
+	VideoTimestamp  float64   `json:"video_timestamp,omitempty"`
+	EaseFactor      float64   `json:"ease_factor"`
+	IntervalDays    int       `json:"interval_days"`
+	Repetitions     int       `json:"repetitions"`
+	NextReviewAt    time.Time `json:"next_review_at"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// ReviewQueueResponse represents the items currently due for review
+type ReviewQueueResponse struct {
+	Items []VocabItemResponse `json:"items"`
+	Total int                 `json:"total"`
+}
+
+// ImportVocabResponse summarizes a bulk vocabulary import: how many rows
+// were saved, skipped as duplicates, or rejected as malformed
+type ImportVocabResponse struct {
+	TotalRows  int `json:"total_rows"`
+	Imported   int `json:"imported"`
+	Duplicates int `json:"duplicates"`
+	Failed     int `json:"failed"`
+	// Errors describes the first few rejected rows
+	Errors []string `json:"errors,omitempty"`
+}
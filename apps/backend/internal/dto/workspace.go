@@ -0,0 +1,121 @@
+package dto
+
+import "time"
+
+// CreateWorkspaceRequest represents a request to create a classroom workspace
+type CreateWorkspaceRequest struct {
+	Name        string `json:"name" binding:"required,min=1,max=200"`
+	Description string `json:"description" binding:"max=2000"`
+}
+
+// WorkspaceResponse represents a workspace together with the requesting
+// user's role in it
+type WorkspaceResponse struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	OwnerID     uint      `json:"owner_id"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// WorkspaceListResponse represents the workspaces the user belongs to
+type WorkspaceListResponse struct {
+	Workspaces []WorkspaceResponse `json:"workspaces"`
+	Total      int                 `json:"total"`
+}
+
+// WorkspaceMemberResponse represents one workspace member
+type WorkspaceMemberResponse struct {
+	UserID    uint      `json:"user_id"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	JoinedAt  time.Time `json:"joined_at"`
+}
+
+// WorkspaceMembersResponse represents a workspace's member list
+type WorkspaceMembersResponse struct {
+	Members []WorkspaceMemberResponse `json:"members"`
+	Total   int                       `json:"total"`
+}
+
+// InviteWorkspaceMemberRequest represents an email invitation into a
+// workspace. Only teachers may be invited by the owner.
+type InviteWorkspaceMemberRequest struct {
+	Email string `json:"email" binding:"required,email,max=255"`
+	Role  string `json:"role" binding:"required,oneof=teacher student"`
+}
+
+// WorkspaceInviteResponse represents a created invitation. The token is
+// returned once at creation so it can be delivered to the invitee.
+type WorkspaceInviteResponse struct {
+	ID          uint      `json:"id"`
+	WorkspaceID uint      `json:"workspace_id"`
+	Email       string    `json:"email"`
+	Role        string    `json:"role"`
+	Token       string    `json:"token,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// AcceptWorkspaceInviteRequest redeems an invitation token
+type AcceptWorkspaceInviteRequest struct {
+	Token string `json:"token" binding:"required,max=128"`
+}
+
+// ShareWorkspaceVideoRequest adds a video to the workspace's curated list
+type ShareWorkspaceVideoRequest struct {
+	VideoID string `json:"video_id" binding:"required,max=100"`
+	Title   string `json:"title" binding:"max=500"`
+	Note    string `json:"note" binding:"max=2000"`
+}
+
+// WorkspaceVideoResponse represents one curated video entry
+type WorkspaceVideoResponse struct {
+	ID      uint   `json:"id"`
+	VideoID string `json:"video_id"`
+	Title   string `json:"title,omitempty"`
+	Note    string `json:"note,omitempty"`
+	AddedBy uint   `json:"added_by"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WorkspaceVideosResponse represents a workspace's curated video list
+type WorkspaceVideosResponse struct {
+	Videos []WorkspaceVideoResponse `json:"videos"`
+	Total  int                      `json:"total"`
+}
+
+// ShareWorkspaceVocabRequest copies vocabulary items from the sharer's
+// personal list into the workspace deck
+type ShareWorkspaceVocabRequest struct {
+	ItemIDs []uint `json:"item_ids" binding:"required,min=1,max=100"`
+}
+
+// ShareWorkspaceVocabResponse summarizes a deck share: how many items were
+// copied in and how many were skipped as duplicates
+type ShareWorkspaceVocabResponse struct {
+	Shared     int `json:"shared"`
+	Duplicates int `json:"duplicates"`
+}
+
+// WorkspaceVocabItemResponse represents one word in the shared deck
+type WorkspaceVocabItemResponse struct {
+	ID              uint   `json:"id"`
+	Word            string `json:"word"`
+	Reading         string `json:"reading,omitempty"`
+	Definition      string `json:"definition,omitempty"`
+	ExampleSentence string `json:"example_sentence,omitempty"`
+	SourceVideoID   string `json:"source_video_id,omitempty"`
+	AddedBy         uint   `json:"added_by"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WorkspaceVocabResponse represents a workspace's shared vocabulary deck
+type WorkspaceVocabResponse struct {
+	Items []WorkspaceVocabItemResponse `json:"items"`
+	Total int                          `json:"total"`
+}
@@ -65,14 +65,14 @@ func NewAppError(message string, err error, status int) *AppError {
 
 // Common error codes
 const (
-	ErrCodeValidation      = "VALIDATION_ERROR"
-	ErrCodeNotFound        = "NOT_FOUND"
-	ErrCodeUnauthorized    = "UNAUTHORIZED"
-	ErrCodeForbidden       = "FORBIDDEN"
-	ErrCodeConflict        = "CONFLICT"
-	ErrCodeInternalServer  = "INTERNAL_SERVER_ERROR"
-	ErrCodeBadRequest      = "BAD_REQUEST"
-	ErrCodeServiceUnavail  = "SERVICE_UNAVAILABLE"
+	ErrCodeValidation     = "VALIDATION_ERROR"
+	ErrCodeNotFound       = "NOT_FOUND"
+	ErrCodeUnauthorized   = "UNAUTHORIZED"
+	ErrCodeForbidden      = "FORBIDDEN"
+	ErrCodeConflict       = "CONFLICT"
+	ErrCodeInternalServer = "INTERNAL_SERVER_ERROR"
+	ErrCodeBadRequest     = "BAD_REQUEST"
+	ErrCodeServiceUnavail = "SERVICE_UNAVAILABLE"
 )
 
 // Error builder functions
@@ -147,6 +147,19 @@ func NewInternalServerError(message string) *AppError {
 	}
 }
 
+func NewServiceUnavailableError(message string) *AppError {
+	if message == "" {
+		message = "Service unavailable"
+	}
+	return &AppError{
+		ID:        uuid.New().String(),
+		Code:      ErrCodeServiceUnavail,
+		Message:   message,
+		Timestamp: time.Now(),
+		Status:    http.StatusServiceUnavailable,
+	}
+}
+
 func NewBadRequestError(message string) *AppError {
 	return &AppError{
 		ID:        uuid.New().String(),
@@ -187,6 +200,12 @@ func HandleValidationError(err error) *AppError {
 			message = fmt.Sprintf("Must be exactly %s characters", err.Param())
 		case "oneof":
 			message = fmt.Sprintf("Must be one of: %s", err.Param())
+		case "video_url":
+			message = "Must be a video URL or a bare video ID"
+		case "lang_code":
+			message = "Must be a language code like en or pt-BR"
+		case "b64url":
+			message = "Must be base64url- or percent-encoded"
 		default:
 			message = fmt.Sprintf("Invalid value for %s", tag)
 		}
@@ -261,4 +280,4 @@ func (e *AppError) WithTraceID(traceID string) *AppError {
 func (e *AppError) WithDetails(details string) *AppError {
 	e.Details = details
 	return e
-}
\ No newline at end of file
+}
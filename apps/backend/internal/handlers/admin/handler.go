@@ -0,0 +1,329 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	videoHandler "app-backend/internal/handlers/video"
+	"app-backend/internal/logger"
+	"app-backend/internal/middleware"
+	"app-backend/internal/response"
+	adminService "app-backend/internal/services/admin"
+	"app-backend/internal/services/audit"
+	"app-backend/internal/services/transcript"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+	"app-backend/internal/types"
+	"app-backend/pkg/youtube"
+)
+
+type Handler struct {
+	healthProber *transcript.HealthProber
+	adminService adminService.ServiceInterface
+	auditService audit.ServiceInterface
+	// reloadConfig re-reads and applies the configuration; nil disables the
+	// reload endpoint
+	reloadConfig func() error
+	// compareTranscripts diffs a video's transcript across providers; nil
+	// disables the comparison endpoint
+	compareTranscripts transcript.CompareFunc
+	// youtubeQuota tracks the day's Data API usage; nil when quota
+	// accounting is disabled
+	youtubeQuota *youtube.QuotaTracker
+	logger       *logger.Logger
+}
+
+// NewAdminHandler creates a new admin handler; the prober may be nil when
+// background probing is disabled
+func NewAdminHandler(healthProber *transcript.HealthProber, adminSvc adminService.ServiceInterface, auditSvc audit.ServiceInterface, reloadConfig func() error, compareTranscripts transcript.CompareFunc, youtubeQuota *youtube.QuotaTracker, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		healthProber:       healthProber,
+		adminService:       adminSvc,
+		auditService:       auditSvc,
+		reloadConfig:       reloadConfig,
+		compareTranscripts: compareTranscripts,
+		youtubeQuota:       youtubeQuota,
+		logger:             logger,
+	}
+}
+
+// GetProviderHealth returns the rolling health statistics the background
+// prober has collected for each transcript provider
+func (h *Handler) GetProviderHealth(c *gin.Context) {
+	if h.healthProber == nil {
+		response.ErrorMessage(c, http.StatusServiceUnavailable, "Provider health probing is not enabled")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"providers": h.healthProber.Snapshot()})
+}
+
+// GetYouTubeQuota returns the day's YouTube Data API quota usage, broken
+// down per API method
+func (h *Handler) GetYouTubeQuota(c *gin.Context) {
+	if h.youtubeQuota == nil {
+		response.ErrorMessage(c, http.StatusServiceUnavailable, "YouTube quota tracking is not enabled")
+		return
+	}
+
+	c.JSON(http.StatusOK, h.youtubeQuota.Snapshot())
+}
+
+// ReloadConfig re-reads the configuration from disk and applies it to the
+// running components that support hot reload
+func (h *Handler) ReloadConfig(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	if h.reloadConfig == nil {
+		response.ErrorMessage(c, http.StatusServiceUnavailable, "Configuration reload is not enabled")
+		return
+	}
+
+	if err := h.reloadConfig(); err != nil {
+		h.logger.Error("Config reload failed",
+			zap.Uint("actor_id", userCtx.UserID),
+			zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to reload configuration", err.Error())
+		return
+	}
+
+	middleware.MarkAuditEvent(c, audit.Event{
+		Action:  audit.ActionConfigReload,
+		ActorID: userCtx.UserID,
+		Detail:  "configuration reloaded via admin endpoint",
+	})
+
+	c.JSON(http.StatusOK, gin.H{"message": "Configuration reloaded"})
+}
+
+// CompareTranscripts fetches the video's transcript from every provider in
+// parallel and returns a per-provider diff report for quality debugging
+func (h *Handler) CompareTranscripts(c *gin.Context) {
+	if h.compareTranscripts == nil {
+		response.ErrorMessage(c, http.StatusServiceUnavailable, "Transcript comparison is not enabled")
+		return
+	}
+
+	decodedURL, err := videoHandler.DecodeVideoURL(c.Param("videoUrl"))
+	if err != nil {
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL", err.Error())
+		return
+	}
+
+	report, err := h.compareTranscripts(c.Request.Context(), &transcriptTypes.TranscriptRequest{
+		VideoURL: decodedURL,
+		Language: c.Query("lang"),
+	})
+	if err != nil {
+		h.logger.Error("Transcript comparison failed",
+			zap.String("video_url", decodedURL),
+			zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadGateway, "Failed to compare transcript providers", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// UpdateUserRole changes the target user's role
+func (h *Handler) UpdateUserRole(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	userID, ok := h.userIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateUserRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid role update request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	user, err := h.adminService.UpdateUserRole(userCtx.UserID, userID, req.Role)
+	if err != nil {
+		h.respondError(c, err, "Failed to update user role", userCtx.UserID)
+		return
+	}
+
+	middleware.MarkAuditEvent(c, audit.Event{
+		Action:       audit.ActionRoleUpdate,
+		ActorID:      userCtx.UserID,
+		TargetUserID: userID,
+		Detail:       "role set to " + req.Role,
+	})
+	c.JSON(http.StatusOK, user)
+}
+
+// UpdateUserStatus enables or disables the target user's account
+func (h *Handler) UpdateUserStatus(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	userID, ok := h.userIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateUserStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid status update request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	user, err := h.adminService.SetUserActive(userCtx.UserID, userID, *req.IsActive)
+	if err != nil {
+		h.respondError(c, err, "Failed to update user status", userCtx.UserID)
+		return
+	}
+
+	action := audit.ActionAccountEnable
+	if !*req.IsActive {
+		action = audit.ActionAccountDisable
+	}
+	middleware.MarkAuditEvent(c, audit.Event{
+		Action:       action,
+		ActorID:      userCtx.UserID,
+		TargetUserID: userID,
+	})
+	c.JSON(http.StatusOK, user)
+}
+
+// RevokeUserSessions force-logs-out the target user by deactivating all of
+// their sessions
+func (h *Handler) RevokeUserSessions(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	userID, ok := h.userIDParam(c)
+	if !ok {
+		return
+	}
+
+	revoked, err := h.adminService.RevokeUserSessions(userCtx.UserID, userID)
+	if err != nil {
+		h.respondError(c, err, "Failed to revoke user sessions", userCtx.UserID)
+		return
+	}
+
+	middleware.MarkAuditEvent(c, audit.Event{
+		Action:       audit.ActionSessionRevoke,
+		ActorID:      userCtx.UserID,
+		TargetUserID: userID,
+	})
+	c.JSON(http.StatusOK, dto.RevokeUserSessionsResponse{RevokedSessions: revoked})
+}
+
+// GetUserAuditLog returns the admin action audit trail for the target user
+func (h *Handler) GetUserAuditLog(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	userID, ok := h.userIDParam(c)
+	if !ok {
+		return
+	}
+
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	sortBy := c.DefaultQuery("sort_by", "created_at")
+	sortDir := c.DefaultQuery("sort_dir", "desc")
+	search := c.Query("search")
+
+	pagReq := &types.PaginationRequest{
+		Page:     page,
+		PageSize: pageSize,
+		SortBy:   sortBy,
+		SortDir:  sortDir,
+		Search:   search,
+	}
+
+	entries, err := h.adminService.GetUserAuditLog(userID, pagReq)
+	if err != nil {
+		h.respondError(c, err, "Failed to list audit entries", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+// ListAuditEvents returns security events from the audit log, optionally
+// filtered by action, actor or target user
+func (h *Handler) ListAuditEvents(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.ListAuditEventsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Invalid audit query", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	sortBy := c.DefaultQuery("sort_by", "created_at")
+	sortDir := c.DefaultQuery("sort_dir", "desc")
+	search := c.Query("search")
+
+	pagReq := &types.PaginationRequest{
+		Page:     page,
+		PageSize: pageSize,
+		SortBy:   sortBy,
+		SortDir:  sortDir,
+		Search:   search,
+	}
+
+	events, err := h.auditService.ListEvents(&req, pagReq)
+	if err != nil {
+		h.respondError(c, err, "Failed to list audit events", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+// userIDParam parses the :id path parameter, responding with 400 on
+// malformed input
+func (h *Handler) userIDParam(c *gin.Context) (uint, bool) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusBadRequest, "Invalid user ID")
+		return 0, false
+	}
+	return uint(userID), true
+}
+
+// respondError maps service errors to HTTP responses with the shared
+// AppError status convention
+func (h *Handler) respondError(c *gin.Context, err error, logMsg string, actorID uint) {
+	h.logger.Error(logMsg, zap.Error(err), zap.Uint("actor_id", actorID))
+	response.Error(c, err)
+}
@@ -0,0 +1,34 @@
+package admin
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for admin handlers
+type HandlerInterface interface {
+	// GetProviderHealth returns rolling health statistics per transcript
+	// provider
+	GetProviderHealth(c *gin.Context)
+
+	// GetYouTubeQuota returns the day's YouTube Data API quota usage
+	GetYouTubeQuota(c *gin.Context)
+
+	// UpdateUserRole changes a user's role
+	UpdateUserRole(c *gin.Context)
+
+	// UpdateUserStatus enables or disables a user account
+	UpdateUserStatus(c *gin.Context)
+
+	// RevokeUserSessions force-logs-out a user
+	RevokeUserSessions(c *gin.Context)
+
+	// GetUserAuditLog returns the audit trail for a user account
+	GetUserAuditLog(c *gin.Context)
+
+	// ListAuditEvents returns security events from the global audit log
+	ListAuditEvents(c *gin.Context)
+
+	// ReloadConfig re-reads the configuration and applies it at runtime
+	ReloadConfig(c *gin.Context)
+
+	// CompareTranscripts diffs a video's transcript across all providers
+	CompareTranscripts(c *gin.Context)
+}
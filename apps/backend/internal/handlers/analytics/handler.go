@@ -0,0 +1,53 @@
+package analytics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	"app-backend/internal/services/analytics"
+)
+
+// Handler implements analytics HTTP handlers
+type Handler struct {
+	analyticsService analytics.ServiceInterface
+	logger           *logger.Logger
+}
+
+// NewAnalyticsHandler creates a new analytics handler
+func NewAnalyticsHandler(analyticsService analytics.ServiceInterface, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		analyticsService: analyticsService,
+		logger:           logger,
+	}
+}
+
+// GetProviderStats returns per-provider success rates over a time window
+func (h *Handler) GetProviderStats(c *gin.Context) {
+	window := c.DefaultQuery("window", "24h")
+
+	duration, err := time.ParseDuration(window)
+	if err != nil || duration <= 0 {
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid window duration", "window must be a positive duration such as 1h or 24h")
+		return
+	}
+
+	stats, err := h.analyticsService.GetProviderStats(time.Now().Add(-duration))
+	if err != nil {
+		h.logger.Error("Failed to aggregate provider stats",
+			zap.String("window", window),
+			zap.Error(err))
+		response.ErrorMessage(c, http.StatusInternalServerError, "Failed to retrieve provider statistics")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetProviderStatsResponse{
+		Window:    window,
+		Providers: stats,
+	})
+}
@@ -0,0 +1,9 @@
+package analytics
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for analytics HTTP handlers
+type HandlerInterface interface {
+	// GetProviderStats returns aggregated provider success rates over a time window
+	GetProviderStats(c *gin.Context)
+}
@@ -0,0 +1,93 @@
+package apikey
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	"app-backend/internal/services/apikey"
+	"app-backend/internal/types"
+)
+
+type Handler struct {
+	apiKeyService apikey.ServiceInterface
+	logger        *logger.Logger
+}
+
+func NewAPIKeyHandler(apiKeyService apikey.ServiceInterface, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		apiKeyService: apiKeyService,
+		logger:        logger,
+	}
+}
+
+// CreateKey generates a new API key for the authenticated user
+func (h *Handler) CreateKey(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid API key request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	created, err := h.apiKeyService.GenerateKey(userCtx.UserID, &req)
+	if err != nil {
+		h.logger.Error("API key generation failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+// ListKeys returns the authenticated user's API keys without secrets
+func (h *Handler) ListKeys(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	keys, err := h.apiKeyService.ListKeys(userCtx.UserID)
+	if err != nil {
+		h.logger.Error("Failed to list API keys", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"api_keys": keys})
+}
+
+// RevokeKey deactivates one of the authenticated user's API keys
+func (h *Handler) RevokeKey(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	keyID, err := strconv.ParseUint(c.Param("keyId"), 10, 32)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusBadRequest, "Invalid API key ID")
+		return
+	}
+
+	if err := h.apiKeyService.RevokeKey(userCtx.UserID, uint(keyID)); err != nil {
+		h.logger.Error("API key revocation failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "API key revoked successfully"})
+}
@@ -0,0 +1,10 @@
+package apikey
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for API key management handlers
+type HandlerInterface interface {
+	CreateKey(c *gin.Context)
+	ListKeys(c *gin.Context)
+	RevokeKey(c *gin.Context)
+}
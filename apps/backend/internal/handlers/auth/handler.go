@@ -2,10 +2,13 @@ package auth
 
 import (
 	"app-backend/internal/dto"
-	"app-backend/internal/errors"
 	"app-backend/internal/logger"
+	"app-backend/internal/middleware"
+	"app-backend/internal/response"
+	"app-backend/internal/services/audit"
 	"app-backend/internal/services/auth"
 	"app-backend/internal/types"
+	"app-backend/internal/validation"
 	"net/http"
 	"strconv"
 
@@ -27,147 +30,120 @@ func NewAuthHandler(authService auth.ServiceInterface, logger *logger.Logger) Ha
 
 func (h *Handler) Register(c *gin.Context) {
 	var req dto.RegisterRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := validation.BindJSON(c, &req); err != nil {
 		h.logger.Error("Invalid registration request", zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data", "details": err.Error()})
+		response.Error(c, err)
 		return
 	}
 
 	ipAddress := c.ClientIP()
 	userAgent := c.GetHeader("User-Agent")
 
-	response, err := h.authService.Register(&req, ipAddress, userAgent)
+	authResponse, err := h.authService.Register(&req, ipAddress, userAgent)
 	if err != nil {
-		if appErr, ok := err.(*errors.AppError); ok {
-			h.logger.Error("Registration failed", zap.Error(err), zap.String("email", req.Email))
-			c.JSON(appErr.Status, gin.H{"error": appErr.Message})
-			return
-		}
-		h.logger.Error("Unexpected registration error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		h.logger.Error("Registration failed", zap.Error(err), zap.String("email", req.Email))
+		response.Error(c, err)
 		return
 	}
 
-	h.logger.Info("User registered successfully", zap.Uint("user_id", response.User.ID), zap.String("email", response.User.Email))
-	c.JSON(http.StatusCreated, response)
+	h.logger.Info("User registered successfully", zap.Uint("user_id", authResponse.User.ID), zap.String("email", authResponse.User.Email))
+	c.JSON(http.StatusCreated, authResponse)
 }
 
 func (h *Handler) Login(c *gin.Context) {
 	var req dto.LoginRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := validation.BindJSON(c, &req); err != nil {
 		h.logger.Error("Invalid login request", zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data", "details": err.Error()})
+		response.Error(c, err)
 		return
 	}
 
 	ipAddress := c.ClientIP()
 	userAgent := c.GetHeader("User-Agent")
 
-	response, err := h.authService.Login(&req, ipAddress, userAgent)
+	authResponse, err := h.authService.Login(&req, ipAddress, userAgent)
 	if err != nil {
-		if appErr, ok := err.(*errors.AppError); ok {
-			h.logger.Error("Login failed", zap.Error(err), zap.String("email", req.Email))
-			c.JSON(appErr.Status, gin.H{"error": appErr.Message})
-			return
-		}
-		h.logger.Error("Unexpected login error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		h.logger.Error("Login failed", zap.Error(err), zap.String("email", req.Email))
+		response.Error(c, err)
 		return
 	}
 
-	h.logger.Info("User logged in successfully", zap.Uint("user_id", response.User.ID), zap.String("email", response.User.Email))
-	c.JSON(http.StatusOK, response)
+	h.logger.Info("User logged in successfully", zap.Uint("user_id", authResponse.User.ID), zap.String("email", authResponse.User.Email))
+	middleware.MarkAuditEvent(c, audit.Event{Action: audit.ActionLogin, ActorID: authResponse.User.ID})
+	c.JSON(http.StatusOK, authResponse)
 }
 
 func (h *Handler) Logout(c *gin.Context) {
 	userCtx, err := types.GetUserContext(c)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
 		return
 	}
 
 	err = h.authService.Logout(userCtx.UserID, userCtx.SessionID)
 	if err != nil {
-		if appErr, ok := err.(*errors.AppError); ok {
-			h.logger.Error("Logout failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
-			c.JSON(appErr.Status, gin.H{"error": appErr.Message})
-			return
-		}
-		h.logger.Error("Unexpected logout error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		h.logger.Error("Logout failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
 		return
 	}
 
 	h.logger.Info("User logged out successfully", zap.Uint("user_id", userCtx.UserID), zap.Uint("session_id", userCtx.SessionID))
+	middleware.MarkAuditEvent(c, audit.Event{Action: audit.ActionLogout, ActorID: userCtx.UserID})
 	c.JSON(http.StatusOK, gin.H{"message": "Logged out successfully"})
 }
 
 func (h *Handler) LogoutAll(c *gin.Context) {
 	userCtx, err := types.GetUserContext(c)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
 		return
 	}
 
 	err = h.authService.LogoutAll(userCtx.UserID)
 	if err != nil {
-		if appErr, ok := err.(*errors.AppError); ok {
-			h.logger.Error("Logout all failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
-			c.JSON(appErr.Status, gin.H{"error": appErr.Message})
-			return
-		}
-		h.logger.Error("Unexpected logout all error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		h.logger.Error("Logout all failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
 		return
 	}
 
 	h.logger.Info("User logged out from all sessions", zap.Uint("user_id", userCtx.UserID))
+	middleware.MarkAuditEvent(c, audit.Event{Action: audit.ActionLogoutAll, ActorID: userCtx.UserID})
 	c.JSON(http.StatusOK, gin.H{"message": "Logged out from all sessions successfully"})
 }
 
 func (h *Handler) RefreshToken(c *gin.Context) {
 	var req dto.RefreshTokenRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := validation.BindJSON(c, &req); err != nil {
 		h.logger.Error("Invalid refresh token request", zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data", "details": err.Error()})
+		response.Error(c, err)
 		return
 	}
 
 	ipAddress := c.ClientIP()
 	userAgent := c.GetHeader("User-Agent")
 
-	response, err := h.authService.RefreshToken(&req, ipAddress, userAgent)
+	authResponse, err := h.authService.RefreshToken(&req, ipAddress, userAgent)
 	if err != nil {
-		if appErr, ok := err.(*errors.AppError); ok {
-			h.logger.Error("Token refresh failed", zap.Error(err))
-			c.JSON(appErr.Status, gin.H{"error": appErr.Message})
-			return
-		}
-		h.logger.Error("Unexpected token refresh error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		h.logger.Error("Token refresh failed", zap.Error(err))
+		response.Error(c, err)
 		return
 	}
 
-	h.logger.Info("Token refreshed successfully", zap.Uint("user_id", response.User.ID))
-	c.JSON(http.StatusOK, response)
+	h.logger.Info("Token refreshed successfully", zap.Uint("user_id", authResponse.User.ID))
+	c.JSON(http.StatusOK, authResponse)
 }
 
 func (h *Handler) GetSessions(c *gin.Context) {
 	userCtx, err := types.GetUserContext(c)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
 		return
 	}
 
 	sessions, err := h.authService.GetUserSessions(userCtx.UserID)
 	if err != nil {
-		if appErr, ok := err.(*errors.AppError); ok {
-			h.logger.Error("Get sessions failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
-			c.JSON(appErr.Status, gin.H{"error": appErr.Message})
-			return
-		}
-		h.logger.Error("Unexpected get sessions error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		h.logger.Error("Get sessions failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
 		return
 	}
 
@@ -177,29 +153,24 @@ func (h *Handler) GetSessions(c *gin.Context) {
 func (h *Handler) RevokeSession(c *gin.Context) {
 	userCtx, err := types.GetUserContext(c)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
 		return
 	}
 
 	sessionIDStr := c.Param("sessionId")
 	sessionID, err := strconv.ParseUint(sessionIDStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid session ID"})
+		response.ErrorMessage(c, http.StatusBadRequest, "Invalid session ID")
 		return
 	}
 
 	err = h.authService.RevokeSession(userCtx.UserID, uint(sessionID))
 	if err != nil {
-		if appErr, ok := err.(*errors.AppError); ok {
-			h.logger.Error("Revoke session failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID), zap.Uint64("session_id", sessionID))
-			c.JSON(appErr.Status, gin.H{"error": appErr.Message})
-			return
-		}
-		h.logger.Error("Unexpected revoke session error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		h.logger.Error("Revoke session failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID), zap.Uint64("session_id", sessionID))
+		response.Error(c, err)
 		return
 	}
 
 	h.logger.Info("Session revoked successfully", zap.Uint("user_id", userCtx.UserID), zap.Uint64("session_id", sessionID))
 	c.JSON(http.StatusOK, gin.H{"message": "Session revoked successfully"})
-}
\ No newline at end of file
+}
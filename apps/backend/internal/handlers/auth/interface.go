@@ -11,4 +11,4 @@ type HandlerInterface interface {
 	RefreshToken(c *gin.Context)
 	GetSessions(c *gin.Context)
 	RevokeSession(c *gin.Context)
-}
\ No newline at end of file
+}
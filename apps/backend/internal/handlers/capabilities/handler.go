@@ -0,0 +1,27 @@
+package capabilities
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/dto"
+)
+
+type Handler struct {
+	capabilities dto.CapabilitiesResponse
+}
+
+// NewCapabilitiesHandler creates a capabilities handler. The flags are
+// computed once at startup from which services initialized; they cannot
+// change without a restart.
+func NewCapabilitiesHandler(capabilities dto.CapabilitiesResponse) HandlerInterface {
+	return &Handler{
+		capabilities: capabilities,
+	}
+}
+
+// GetCapabilities reports which optional features this deployment supports
+func (h *Handler) GetCapabilities(c *gin.Context) {
+	c.JSON(http.StatusOK, h.capabilities)
+}
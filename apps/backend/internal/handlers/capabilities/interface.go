@@ -0,0 +1,9 @@
+package capabilities
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for capability handlers
+type HandlerInterface interface {
+	// GetCapabilities reports which optional features are enabled
+	GetCapabilities(c *gin.Context)
+}
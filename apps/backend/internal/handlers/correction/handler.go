@@ -0,0 +1,135 @@
+package correction
+
+import (
+	goerrors "errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
+	videohandler "app-backend/internal/handlers/video"
+	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	"app-backend/internal/services/correction"
+	videoservice "app-backend/internal/services/video"
+	internalTypes "app-backend/internal/types"
+)
+
+// Handler implements transcript correction HTTP handlers
+type Handler struct {
+	correctionService correction.ServiceInterface
+	logger            *logger.Logger
+}
+
+// NewCorrectionHandler creates a new transcript correction handler
+func NewCorrectionHandler(correctionService correction.ServiceInterface, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		correctionService: correctionService,
+		logger:            logger,
+	}
+}
+
+// editSegmentURI binds the path of a segment correction
+type editSegmentURI struct {
+	VideoURL string `uri:"videoUrl" binding:"required"`
+	Index    int    `uri:"index" binding:"required,min=1"`
+}
+
+// EditSegment records corrected text for one transcript segment, creating the
+// next transcript version for the video
+func (h *Handler) EditSegment(c *gin.Context) {
+	userCtx, err := internalTypes.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	var uri editSegmentURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error("Invalid request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid segment path", err.Error())
+		return
+	}
+
+	videoID, ok := h.resolveVideoID(c, uri.VideoURL)
+	if !ok {
+		return
+	}
+
+	var req dto.EditTranscriptSegmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid JSON body", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	response, err := h.correctionService.EditSegment(userCtx.UserID, videoID, uri.Index, &req)
+	if err != nil {
+		h.respondError(c, "Failed to save transcript correction", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetHistory returns every recorded edit for a saved video, oldest first
+func (h *Handler) GetHistory(c *gin.Context) {
+	userCtx, err := internalTypes.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	var uri dto.VideoInfoRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error("Invalid request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL", err.Error())
+		return
+	}
+
+	videoID, ok := h.resolveVideoID(c, uri.VideoURL)
+	if !ok {
+		return
+	}
+
+	response, err := h.correctionService.History(userCtx.UserID, videoID)
+	if err != nil {
+		h.respondError(c, "Failed to load transcript corrections", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// resolveVideoID decodes the path parameter and extracts the canonical video
+// ID, answering the request itself when the reference is invalid
+func (h *Handler) resolveVideoID(c *gin.Context, rawURL string) (string, bool) {
+	decodedURL, err := videohandler.DecodeVideoURL(rawURL)
+	if err != nil {
+		h.logger.Error("Failed to decode URL", zap.String("url", rawURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL format", err.Error())
+		return "", false
+	}
+
+	parsed, err := videoservice.ParseVideoURL(decodedURL)
+	if err != nil {
+		h.logger.Error("Failed to parse video URL", zap.String("url", decodedURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Unsupported video provider or invalid URL", err.Error())
+		return "", false
+	}
+	return parsed.VideoID, true
+}
+
+// respondError maps service errors onto HTTP responses
+func (h *Handler) respondError(c *gin.Context, message string, err error) {
+	h.logger.Error(message, zap.Error(err))
+
+	var appErr *appErrors.AppError
+	if goerrors.As(err, &appErr) {
+		response.ErrorMessage(c, appErr.Status, appErr.Message)
+		return
+	}
+	response.ErrorMessage(c, http.StatusInternalServerError, message)
+}
@@ -0,0 +1,12 @@
+package correction
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for transcript correction handlers
+type HandlerInterface interface {
+	// EditSegment records corrected text for one transcript segment
+	EditSegment(c *gin.Context)
+
+	// GetHistory returns the full edit history for a saved video
+	GetHistory(c *gin.Context)
+}
@@ -0,0 +1,55 @@
+package dictionary
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	"app-backend/internal/services/dictionary"
+)
+
+// Handler implements dictionary HTTP handlers
+type Handler struct {
+	dictionaryService dictionary.ServiceInterface
+	logger            *logger.Logger
+}
+
+// NewDictionaryHandler creates a new dictionary handler
+func NewDictionaryHandler(dictionaryService dictionary.ServiceInterface, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		dictionaryService: dictionaryService,
+		logger:            logger,
+	}
+}
+
+// Lookup handles contextual word lookup requests
+func (h *Handler) Lookup(c *gin.Context) {
+	if h.dictionaryService == nil {
+		appErr := appErrors.NewServiceUnavailableError("Dictionary lookups are not available on this deployment")
+		response.Error(c, appErr)
+		return
+	}
+
+	var req dto.DictionaryLookupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid JSON body", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	result, err := h.dictionaryService.Lookup(c.Request.Context(), &req)
+	if err != nil {
+		h.logger.Error("Failed to look up word",
+			zap.String("word", req.Word),
+			zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,9 @@
+package dictionary
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for dictionary handlers
+type HandlerInterface interface {
+	// Lookup returns a structured dictionary entry for a word in context
+	Lookup(c *gin.Context)
+}
@@ -0,0 +1,61 @@
+package explain
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	explainService "app-backend/internal/services/explain"
+	"app-backend/internal/types"
+)
+
+// Handler implements grammar explanation HTTP handlers
+type Handler struct {
+	explainService explainService.ServiceInterface
+	logger         *logger.Logger
+}
+
+// NewExplainHandler creates a new grammar explanation handler
+func NewExplainHandler(explainService explainService.ServiceInterface, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		explainService: explainService,
+		logger:         logger,
+	}
+}
+
+// Explain handles grammar breakdown requests for a transcript sentence
+func (h *Handler) Explain(c *gin.Context) {
+	if h.explainService == nil {
+		response.Error(c, appErrors.NewServiceUnavailableError("Grammar explanations are not available on this deployment"))
+		return
+	}
+
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.ExplainRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid explain request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	result, err := h.explainService.Explain(c.Request.Context(), userCtx.UserID, &req)
+	if err != nil {
+		h.logger.Error("Failed to explain sentence",
+			zap.Uint("user_id", userCtx.UserID),
+			zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
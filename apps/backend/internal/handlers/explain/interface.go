@@ -0,0 +1,9 @@
+package explain
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for grammar explanation handlers
+type HandlerInterface interface {
+	// Explain returns a structured grammar breakdown of a sentence
+	Explain(c *gin.Context)
+}
@@ -0,0 +1,66 @@
+package importer
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	importerService "app-backend/internal/services/importer"
+	"app-backend/internal/types"
+)
+
+type Handler struct {
+	importService importerService.ServiceInterface
+	logger        *logger.Logger
+}
+
+func NewImportHandler(importService importerService.ServiceInterface, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		importService: importService,
+		logger:        logger,
+	}
+}
+
+// ImportPlaylist enqueues a background job that fetches transcripts for
+// every video in a playlist or channel; progress is polled via the job API
+func (h *Handler) ImportPlaylist(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.PlaylistImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid playlist import request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	job, err := h.importService.StartImport(c.Request.Context(), userCtx.UserID, req.URL, req.Language)
+	if err != nil {
+		h.respondError(c, err, "Failed to start playlist import", userCtx.UserID)
+		return
+	}
+
+	response := dto.PlaylistImportResponse{
+		JobID:  job.ID,
+		Status: job.Status,
+	}
+	if result, parseErr := importerService.ParseResult(job); parseErr == nil {
+		response.PlaylistID = result.PlaylistID
+		response.TotalVideos = result.TotalVideos
+	}
+
+	c.JSON(http.StatusAccepted, response)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *Handler) respondError(c *gin.Context, err error, logMsg string, userID uint) {
+	h.logger.Error(logMsg, zap.Error(err), zap.Uint("user_id", userID))
+	response.Error(c, err)
+}
@@ -0,0 +1,10 @@
+package importer
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for batch import handlers
+type HandlerInterface interface {
+	// ImportPlaylist queues transcript fetches for every video in a playlist
+	// or channel
+	ImportPlaylist(c *gin.Context)
+}
@@ -0,0 +1,57 @@
+package jobs
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	jobsService "app-backend/internal/services/jobs"
+	"app-backend/internal/types"
+)
+
+type Handler struct {
+	jobService jobsService.ServiceInterface
+	logger     *logger.Logger
+}
+
+func NewJobsHandler(jobService jobsService.ServiceInterface, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		jobService: jobService,
+		logger:     logger,
+	}
+}
+
+// GetJob reports the state of one of the user's background jobs, including
+// its type-specific progress or result JSON
+func (h *Handler) GetJob(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	jobID, err := strconv.ParseUint(c.Param("jobId"), 10, 32)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusBadRequest, "Invalid job ID")
+		return
+	}
+
+	job, err := h.jobService.GetJob(userCtx.UserID, uint(jobID))
+	if err != nil {
+		h.respondError(c, err, "Failed to get job", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ConvertToJobResponse(job))
+}
+
+// respondError maps service errors to HTTP responses
+func (h *Handler) respondError(c *gin.Context, err error, logMsg string, userID uint) {
+	h.logger.Error(logMsg, zap.Error(err), zap.Uint("user_id", userID))
+	response.Error(c, err)
+}
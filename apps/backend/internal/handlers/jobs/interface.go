@@ -0,0 +1,9 @@
+package jobs
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for background job handlers
+type HandlerInterface interface {
+	// GetJob reports the state of one of the user's background jobs
+	GetJob(c *gin.Context)
+}
@@ -4,15 +4,23 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"net/http"
+	"time"
 
-	"app-backend/internal/dto"
 	"app-backend/internal/logger"
+	"app-backend/internal/middleware"
+	"app-backend/internal/response"
+	"app-backend/internal/services/audit"
 	oauthService "app-backend/internal/services/oauth"
-	
+	"app-backend/internal/types"
+
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 )
 
+// completionPollTimeout is how long a single status long-poll request waits
+// for the matching OAuth callback before asking the client to retry
+const completionPollTimeout = 30 * time.Second
+
 // Handler implements OAuth HTTP handlers
 type Handler struct {
 	youtubeOAuth oauthService.ServiceInterface
@@ -27,20 +35,30 @@ func NewOAuthHandler(youtubeOAuth oauthService.ServiceInterface, logger *logger.
 	}
 }
 
-// InitiateYouTubeAuth starts the YouTube OAuth flow
+// InitiateYouTubeAuth starts the YouTube OAuth flow for the requesting user
 func (h *Handler) InitiateYouTubeAuth(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
 	// Generate random state for security
 	state := h.generateRandomState()
-	
-	// Store state in memory/session for verification (instead of cookie)
-	// For Chrome extension OAuth, cookies are not reliable due to cross-origin restrictions
-	h.youtubeOAuth.StoreState(state)
-	
+
+	// Store state in memory/session for verification (instead of cookie),
+	// remembering which user the callback should link the token to. For
+	// Chrome extension OAuth, cookies are not reliable due to cross-origin
+	// restrictions.
+	h.youtubeOAuth.StoreState(state, userCtx.UserID)
+
 	// Generate authorization URL
 	authURL := h.youtubeOAuth.GenerateAuthURL(state)
-	
-	h.logger.Info("Initiating YouTube OAuth flow", zap.String("state", state))
-	
+
+	h.logger.Info("Initiating YouTube OAuth flow",
+		zap.String("state", state),
+		zap.Uint("user_id", userCtx.UserID))
+
 	c.JSON(http.StatusOK, gin.H{
 		"authUrl": authURL,
 		"state":   state,
@@ -52,44 +70,58 @@ func (h *Handler) HandleYouTubeCallback(c *gin.Context) {
 	// Get authorization code and state from query parameters
 	code := c.Query("code")
 	state := c.Query("state")
-	
+
 	if code == "" {
 		h.logger.Error("No authorization code received in callback")
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Authorization code not provided",
-		})
+		response.ErrorMessage(c, http.StatusBadRequest, "Authorization code not provided")
 		return
 	}
-	
-	// Verify state parameter to prevent CSRF attacks
-	if !h.youtubeOAuth.ValidateAndClearState(state) {
+
+	// Verify state parameter to prevent CSRF attacks; the state also tells
+	// us which user initiated the flow, since Google's redirect carries no
+	// session
+	userID, ok := h.youtubeOAuth.ValidateAndClearState(state)
+	if !ok {
 		h.logger.Error("Invalid OAuth state", zap.String("received", state))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid state parameter",
-		})
+		response.ErrorMessage(c, http.StatusBadRequest, "Invalid state parameter")
 		return
 	}
-	
+
 	// Exchange code for tokens
-	token, err := h.youtubeOAuth.ExchangeCodeForTokens(c.Request.Context(), code)
+	token, err := h.youtubeOAuth.ExchangeCodeForTokens(c.Request.Context(), code, userID)
 	if err != nil {
 		h.logger.Error("Failed to exchange code for tokens", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "Failed to complete OAuth flow",
-			Details: err.Error(),
+		h.youtubeOAuth.NotifyCompletion(state, oauthService.CompletionResult{
+			Success: false,
+			Message: "Failed to complete OAuth flow",
 		})
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to complete OAuth flow", err.Error())
 		return
 	}
-	
+
 	h.logger.Info("Successfully completed YouTube OAuth flow")
-	
+	// The callback arrives without a session, so the actor comes from the
+	// state that initiated the flow
+	middleware.MarkAuditEvent(c, audit.Event{
+		Action:  audit.ActionOAuthLink,
+		ActorID: userID,
+		Detail:  "youtube account linked",
+	})
+
+	// Resolve any extension long-polling on this state
+	h.youtubeOAuth.NotifyCompletion(state, oauthService.CompletionResult{
+		Success:   true,
+		Message:   "YouTube authentication completed",
+		ExpiresAt: token.Expiry,
+	})
+
 	// For web flow, redirect to success page or return success response
 	if c.Query("redirect") == "web" {
 		// Redirect to frontend success page
 		c.Redirect(http.StatusFound, "/oauth/success")
 		return
 	}
-	
+
 	// For API flow, return token info (without sensitive data)
 	c.JSON(http.StatusOK, gin.H{
 		"status":    "success",
@@ -98,47 +130,91 @@ func (h *Handler) HandleYouTubeCallback(c *gin.Context) {
 	})
 }
 
-// GetAuthStatus checks the current YouTube authentication status
+// GetAuthStatus checks the requesting user's YouTube authentication status
 func (h *Handler) GetAuthStatus(c *gin.Context) {
-	isAuthenticated := h.youtubeOAuth.IsAuthenticated()
-	
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	isAuthenticated := h.youtubeOAuth.IsAuthenticated(c.Request.Context())
+
 	response := gin.H{
 		"authenticated": isAuthenticated,
 	}
-	
+
 	// If authenticated, get token expiry info
 	if isAuthenticated {
-		token, err := h.youtubeOAuth.LoadToken()
+		token, err := h.youtubeOAuth.LoadToken(userCtx.UserID)
 		if err == nil {
 			response["expiresAt"] = token.Expiry
 			response["valid"] = token.Valid()
 		}
 	}
-	
+
 	c.JSON(http.StatusOK, response)
 }
 
-// RevokeYouTubeAuth revokes the current YouTube authentication
-func (h *Handler) RevokeYouTubeAuth(c *gin.Context) {
-	if !h.youtubeOAuth.IsAuthenticated() {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "No active authentication to revoke",
+// AwaitAuthCompletion long-polls until the OAuth callback for the given
+// state completes, so the extension does not need to poll the status endpoint
+func (h *Handler) AwaitAuthCompletion(c *gin.Context) {
+	state := c.Param("state")
+	if state == "" {
+		response.ErrorMessage(c, http.StatusBadRequest, "State parameter is required")
+		return
+	}
+
+	result, err := h.youtubeOAuth.AwaitCompletion(c.Request.Context(), state, completionPollTimeout)
+	if err != nil {
+		// Timeout or client disconnect; the extension can retry
+		c.JSON(http.StatusRequestTimeout, gin.H{
+			"status": "pending",
+		})
+		return
+	}
+
+	if !result.Success {
+		c.JSON(http.StatusOK, gin.H{
+			"status":  "error",
+			"message": result.Message,
 		})
 		return
 	}
-	
-	err := h.youtubeOAuth.RevokeToken(c.Request.Context())
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":    "success",
+		"message":   result.Message,
+		"expiresAt": result.ExpiresAt,
+	})
+}
+
+// RevokeYouTubeAuth revokes the requesting user's YouTube authentication
+func (h *Handler) RevokeYouTubeAuth(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
 	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	if !h.youtubeOAuth.IsAuthenticated(c.Request.Context()) {
+		response.ErrorMessage(c, http.StatusBadRequest, "No active authentication to revoke")
+		return
+	}
+
+	if err := h.youtubeOAuth.RevokeToken(c.Request.Context()); err != nil {
 		h.logger.Error("Failed to revoke YouTube authentication", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "Failed to revoke authentication",
-			Details: err.Error(),
-		})
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to revoke authentication", err.Error())
 		return
 	}
-	
+
 	h.logger.Info("Successfully revoked YouTube authentication")
-	
+	middleware.MarkAuditEvent(c, audit.Event{
+		Action:  audit.ActionOAuthUnlink,
+		ActorID: userCtx.UserID,
+		Detail:  "youtube account unlinked",
+	})
+
 	c.JSON(http.StatusOK, gin.H{
 		"status":  "success",
 		"message": "YouTube authentication revoked",
@@ -150,4 +226,4 @@ func (h *Handler) generateRandomState() string {
 	b := make([]byte, 32)
 	rand.Read(b)
 	return base64.URLEncoding.EncodeToString(b)
-}
\ No newline at end of file
+}
@@ -6,13 +6,16 @@ import "github.com/gin-gonic/gin"
 type HandlerInterface interface {
 	// InitiateYouTubeAuth starts the YouTube OAuth flow
 	InitiateYouTubeAuth(c *gin.Context)
-	
+
 	// HandleYouTubeCallback handles the OAuth callback from YouTube
 	HandleYouTubeCallback(c *gin.Context)
-	
+
 	// GetAuthStatus checks the current YouTube authentication status
 	GetAuthStatus(c *gin.Context)
-	
+
+	// AwaitAuthCompletion long-polls until the callback for a state completes
+	AwaitAuthCompletion(c *gin.Context)
+
 	// RevokeYouTubeAuth revokes the current YouTube authentication
 	RevokeYouTubeAuth(c *gin.Context)
-}
\ No newline at end of file
+}
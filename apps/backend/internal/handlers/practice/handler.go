@@ -0,0 +1,170 @@
+package practice
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	pronunciationService "app-backend/internal/services/pronunciation"
+	quizService "app-backend/internal/services/quiz"
+	"app-backend/internal/types"
+)
+
+type Handler struct {
+	pronunciationService pronunciationService.ServiceInterface
+	quizService          quizService.ServiceInterface
+	logger               *logger.Logger
+}
+
+func NewPracticeHandler(pronunciationService pronunciationService.ServiceInterface, quizService quizService.ServiceInterface, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		pronunciationService: pronunciationService,
+		quizService:          quizService,
+		logger:               logger,
+	}
+}
+
+// AssessPronunciation scores an uploaded practice clip against its
+// reference transcript segment and stores the attempt
+func (h *Handler) AssessPronunciation(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.AssessPronunciationRequest
+	if err := c.ShouldBind(&req); err != nil {
+		h.logger.Error("Invalid pronunciation request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	fileHeader, err := c.FormFile("audio")
+	if err != nil {
+		response.ErrorMessage(c, http.StatusBadRequest, "Audio clip is required in the 'audio' field")
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		h.logger.Error("Failed to open uploaded audio", zap.Error(err))
+		response.ErrorMessage(c, http.StatusBadRequest, "Failed to read audio clip")
+		return
+	}
+	defer file.Close()
+
+	audio, err := io.ReadAll(file)
+	if err != nil {
+		h.logger.Error("Failed to read uploaded audio", zap.Error(err))
+		response.ErrorMessage(c, http.StatusBadRequest, "Failed to read audio clip")
+		return
+	}
+
+	result, err := h.pronunciationService.Assess(c.Request.Context(), userCtx.UserID, pronunciationService.Clip{
+		Data:     audio,
+		MIMEType: fileHeader.Header.Get("Content-Type"),
+	}, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to assess pronunciation", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ListAttempts returns the user's stored attempts with pagination
+func (h *Handler) ListAttempts(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	sortBy := c.DefaultQuery("sort_by", "created_at")
+	sortDir := c.DefaultQuery("sort_dir", "desc")
+
+	pagReq := &types.PaginationRequest{
+		Page:     page,
+		PageSize: pageSize,
+		SortBy:   sortBy,
+		SortDir:  sortDir,
+	}
+
+	attempts, err := h.pronunciationService.ListAttempts(userCtx.UserID, pagReq)
+	if err != nil {
+		h.respondError(c, err, "Failed to list pronunciation attempts", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, attempts)
+}
+
+// GenerateQuiz builds a quiz from a video's transcript and stores it
+func (h *Handler) GenerateQuiz(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.GenerateQuizRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid quiz request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	quiz, err := h.quizService.Generate(c.Request.Context(), userCtx.UserID, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to generate quiz", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusCreated, quiz)
+}
+
+// SubmitQuizAnswers grades submitted answers against a stored quiz
+func (h *Handler) SubmitQuizAnswers(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	quizID, err := strconv.ParseUint(c.Param("quizId"), 10, 32)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusBadRequest, "Invalid quiz ID")
+		return
+	}
+
+	var req dto.SubmitQuizAnswersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid quiz answers request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	result, err := h.quizService.Submit(userCtx.UserID, uint(quizID), &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to grade quiz submission", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *Handler) respondError(c *gin.Context, err error, logMsg string, userID uint) {
+	h.logger.Error(logMsg, zap.Error(err), zap.Uint("user_id", userID))
+	response.Error(c, err)
+}
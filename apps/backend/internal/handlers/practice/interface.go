@@ -0,0 +1,19 @@
+package practice
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for pronunciation practice handlers
+type HandlerInterface interface {
+	// AssessPronunciation scores an uploaded practice clip against its
+	// reference transcript segment
+	AssessPronunciation(c *gin.Context)
+
+	// ListAttempts returns the user's stored attempts for progress graphs
+	ListAttempts(c *gin.Context)
+
+	// GenerateQuiz builds a quiz from a video's transcript and stores it
+	GenerateQuiz(c *gin.Context)
+
+	// SubmitQuizAnswers grades submitted answers against a stored quiz
+	SubmitQuizAnswers(c *gin.Context)
+}
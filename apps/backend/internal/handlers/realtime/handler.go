@@ -0,0 +1,58 @@
+package realtime
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	"app-backend/internal/services/realtime"
+	"app-backend/internal/types"
+)
+
+// upgrader performs the WebSocket handshake. Origins are not checked here:
+// the extension and web app connect from different origins, and every
+// upgrade already carries a validated JWT.
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// Handler implements session sync HTTP handlers
+type Handler struct {
+	hub    *realtime.Hub
+	logger *logger.Logger
+}
+
+// NewRealtimeHandler creates a new session sync handler
+func NewRealtimeHandler(hub *realtime.Hub, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		hub:    hub,
+		logger: logger,
+	}
+}
+
+// Connect upgrades the request to a WebSocket and serves the connection in
+// the authenticated user's room until the peer disconnects
+func (h *Handler) Connect(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// Upgrade already wrote the handshake error response
+		h.logger.Warn("WebSocket upgrade failed",
+			zap.Uint("user_id", userCtx.UserID),
+			zap.Error(err))
+		return
+	}
+
+	h.hub.Serve(userCtx.UserID, conn)
+}
@@ -0,0 +1,9 @@
+package realtime
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for session sync handlers
+type HandlerInterface interface {
+	// Connect upgrades the request to a WebSocket and joins the user's room
+	Connect(c *gin.Context)
+}
@@ -0,0 +1,44 @@
+package search
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	"app-backend/internal/services/search"
+)
+
+type Handler struct {
+	searchService search.ServiceInterface
+	logger        *logger.Logger
+}
+
+func NewSearchHandler(searchService search.ServiceInterface, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		searchService: searchService,
+		logger:        logger,
+	}
+}
+
+// SearchTranscripts returns cached transcript segments matching the query
+func (h *Handler) SearchTranscripts(c *gin.Context) {
+	var req dto.SearchTranscriptsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Invalid transcript search request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	results, err := h.searchService.SearchTranscripts(&req)
+	if err != nil {
+		h.logger.Error("Transcript search failed", zap.Error(err), zap.String("query", req.Query))
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, results)
+}
@@ -0,0 +1,8 @@
+package search
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for transcript search handlers
+type HandlerInterface interface {
+	SearchTranscripts(c *gin.Context)
+}
@@ -0,0 +1,98 @@
+package subtitle
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	subtitleService "app-backend/internal/services/subtitle"
+	"app-backend/internal/types"
+)
+
+type Handler struct {
+	subtitleService subtitleService.ServiceInterface
+	logger          *logger.Logger
+}
+
+func NewSubtitleHandler(subtitleService subtitleService.ServiceInterface, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		subtitleService: subtitleService,
+		logger:          logger,
+	}
+}
+
+// GenerateSubtitle enqueues a background job that renders a merged
+// dual-language subtitle file; progress is polled via the job API and the
+// file is fetched from the download endpoint once the job completes
+func (h *Handler) GenerateSubtitle(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.GenerateSubtitleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid subtitle generation request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	job, err := h.subtitleService.StartGeneration(c.Request.Context(), userCtx.UserID,
+		req.VideoURL, req.SourceLang, req.TargetLang, req.Format, subtitleService.Style{
+			TranslationColor: req.TranslationColor,
+			TranslationSize:  req.TranslationSize,
+		})
+	if err != nil {
+		h.respondError(c, err, "Failed to start subtitle generation", userCtx.UserID)
+		return
+	}
+
+	response := dto.GenerateSubtitleResponse{
+		JobID:  job.ID,
+		Status: job.Status,
+	}
+	if result, parseErr := subtitleService.ParseResult(job); parseErr == nil {
+		response.VideoID = result.VideoID
+		response.Format = result.Format
+	}
+
+	c.JSON(http.StatusAccepted, response)
+}
+
+// DownloadSubtitle streams the finished subtitle file of one of the user's
+// completed generation jobs
+func (h *Handler) DownloadSubtitle(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	jobID, err := strconv.ParseUint(c.Param("jobId"), 10, 32)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusBadRequest, "Invalid job ID")
+		return
+	}
+
+	file, err := h.subtitleService.GetFile(userCtx.UserID, uint(jobID))
+	if err != nil {
+		h.respondError(c, err, "Failed to get subtitle file", userCtx.UserID)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Filename))
+	c.Data(http.StatusOK, file.ContentType, file.Content)
+}
+
+// respondError maps service errors to HTTP responses
+func (h *Handler) respondError(c *gin.Context, err error, logMsg string, userID uint) {
+	h.logger.Error(logMsg, zap.Error(err), zap.Uint("user_id", userID))
+	response.Error(c, err)
+}
@@ -0,0 +1,13 @@
+package subtitle
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for bilingual subtitle handlers
+type HandlerInterface interface {
+	// GenerateSubtitle queues background generation of a dual-language
+	// subtitle file
+	GenerateSubtitle(c *gin.Context)
+
+	// DownloadSubtitle streams a finished subtitle file as a download
+	DownloadSubtitle(c *gin.Context)
+}
@@ -0,0 +1,174 @@
+package translation
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/response"
+	"app-backend/internal/services/translation"
+)
+
+// BatchTranslate enqueues a whole workload as one background job and returns
+// its ID immediately, so large transcripts no longer hold a request open
+// until it times out
+func (h *Handler) BatchTranslate(c *gin.Context) {
+	if !h.serviceAvailable(c) {
+		return
+	}
+	if h.batchTranslator == nil {
+		appErr := appErrors.NewServiceUnavailableError("Batch translation is not available on this deployment")
+		response.Error(c, appErr)
+		return
+	}
+
+	var req dto.BatchTranslateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid JSON body", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if len(req.Texts) == 0 {
+		response.ErrorMessage(c, http.StatusBadRequest, "No texts provided for translation")
+		return
+	}
+
+	if !h.normalizeLanguagePair(c, &req.TargetLang, &req.SourceLang) {
+		return
+	}
+
+	// Quota is enforced and usage recorded at submission, since the work
+	// itself continues after this request returns
+	requestChars := countChars(req.Texts)
+	userID := requestUserID(c)
+	if !h.enforceQuota(c, userID, requestChars) {
+		return
+	}
+
+	jobID, err := h.batchTranslator.StartBatch(req.Texts, req.TargetLang, req.SourceLang)
+	if err != nil {
+		h.logger.Error("Failed to start batch translation job",
+			zap.Int("textCount", len(req.Texts)),
+			zap.String("targetLang", req.TargetLang),
+			zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusServiceUnavailable, "Failed to enqueue batch translation", err.Error())
+		return
+	}
+
+	h.recordUsage(userID, requestChars, req.TargetLang)
+
+	c.JSON(http.StatusAccepted, dto.BatchTranslateResponse{
+		JobID:  jobID,
+		Status: translation.BatchJobRunning,
+		Total:  len(req.Texts),
+	})
+}
+
+// GetBatchJob returns batch job progress and the partial results completed
+// so far
+func (h *Handler) GetBatchJob(c *gin.Context) {
+	if !h.serviceAvailable(c) {
+		return
+	}
+	if h.batchTranslator == nil {
+		appErr := appErrors.NewServiceUnavailableError("Batch translation is not available on this deployment")
+		response.Error(c, appErr)
+		return
+	}
+
+	snapshot, ok := h.batchTranslator.Job(c.Param("id"))
+	if !ok {
+		response.ErrorMessage(c, http.StatusNotFound, "No batch translation job with this ID")
+		return
+	}
+
+	c.JSON(http.StatusOK, toBatchJobResponse(snapshot))
+}
+
+// StreamBatchJob streams batch job progress over SSE: already completed
+// segments are replayed first, then live updates until the job finishes
+func (h *Handler) StreamBatchJob(c *gin.Context) {
+	if !h.serviceAvailable(c) {
+		return
+	}
+	if h.batchTranslator == nil {
+		appErr := appErrors.NewServiceUnavailableError("Batch translation is not available on this deployment")
+		response.Error(c, appErr)
+		return
+	}
+
+	updates, cancel, ok := h.batchTranslator.Subscribe(c.Param("id"))
+	if !ok {
+		response.ErrorMessage(c, http.StatusNotFound, "No batch translation job with this ID")
+		return
+	}
+	defer cancel()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Writer.WriteHeader(http.StatusOK)
+	c.Writer.Flush()
+
+	for {
+		select {
+		case update, open := <-updates:
+			if !open {
+				return
+			}
+			if update.Finished {
+				writeSSEEvent(c, "done", dto.BatchStreamEventDTO{
+					Completed:       update.Completed,
+					Total:           update.Total,
+					ProgressPercent: update.ProgressPercent,
+				})
+				return
+			}
+
+			eventName := "translation"
+			if update.Segment.Error != "" {
+				eventName = "error"
+			}
+			writeSSEEvent(c, eventName, dto.BatchStreamEventDTO{
+				Index:           update.Segment.Index,
+				Translation:     update.Segment.Translation,
+				Error:           update.Segment.Error,
+				Completed:       update.Completed,
+				Total:           update.Total,
+				ProgressPercent: update.ProgressPercent,
+			})
+
+		case <-c.Request.Context().Done():
+			return
+		}
+	}
+}
+
+// toBatchJobResponse converts a job snapshot to its DTO
+func toBatchJobResponse(snapshot *translation.BatchJobSnapshot) dto.BatchJobResponse {
+	results := make([]dto.BatchSegmentResultDTO, len(snapshot.Results))
+	for i, result := range snapshot.Results {
+		results[i] = dto.BatchSegmentResultDTO{
+			Index:       result.Index,
+			Translation: result.Translation,
+			Error:       result.Error,
+			Done:        result.Done,
+		}
+	}
+
+	return dto.BatchJobResponse{
+		JobID:           snapshot.ID,
+		Status:          snapshot.Status,
+		SourceLang:      snapshot.SourceLang,
+		TargetLang:      snapshot.TargetLang,
+		Total:           snapshot.Total,
+		Completed:       snapshot.Completed,
+		Failed:          snapshot.Failed,
+		ProgressPercent: snapshot.ProgressPercent,
+		Results:         results,
+	}
+}
@@ -1,13 +1,24 @@
 package translation
 
 import (
+	goerrors "errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
+	"unicode/utf8"
 
+	"app-backend/internal/ctxutil"
 	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
 	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	languagesService "app-backend/internal/services/languages"
+	statsService "app-backend/internal/services/stats"
 	"app-backend/internal/services/translation"
+	"app-backend/internal/services/usage"
+	internalTypes "app-backend/internal/types"
+	"app-backend/internal/validation"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 )
@@ -15,111 +26,211 @@ import (
 // Handler implements translation HTTP handlers
 type Handler struct {
 	translationService translation.ServiceInterface
+	bulkTranslator     translation.BulkTranslatorInterface
+	batchTranslator    translation.BatchTranslatorInterface
+	languagesService   languagesService.ServiceInterface
+	usageService       usage.ServiceInterface
+	statsService       statsService.ServiceInterface
 	logger             *logger.Logger
 }
 
-// NewTranslationHandler creates a new translation handler
-func NewTranslationHandler(translationService translation.ServiceInterface, logger *logger.Logger) HandlerInterface {
+// NewTranslationHandler creates a new translation handler. The usage service
+// may be nil, which disables quota enforcement and usage tracking; a nil
+// languages service skips language code validation; a nil stats service
+// skips daily study statistics.
+func NewTranslationHandler(translationService translation.ServiceInterface, bulkTranslator translation.BulkTranslatorInterface, batchTranslator translation.BatchTranslatorInterface, langService languagesService.ServiceInterface, usageService usage.ServiceInterface, stats statsService.ServiceInterface, logger *logger.Logger) HandlerInterface {
 	return &Handler{
 		translationService: translationService,
+		bulkTranslator:     bulkTranslator,
+		batchTranslator:    batchTranslator,
+		languagesService:   langService,
+		usageService:       usageService,
+		statsService:       stats,
 		logger:             logger,
 	}
 }
 
+// normalizeLanguagePair canonicalizes the target and optional source language
+// codes, rejecting unsupported ones with a 400 before any work is queued.
+// An empty or "auto" source language is the detection sentinel and passes
+// through untouched.
+func (h *Handler) normalizeLanguagePair(c *gin.Context, targetLang *string, sourceLang *string) bool {
+	if h.languagesService == nil {
+		return true
+	}
+
+	normalized, err := h.languagesService.Normalize(*targetLang)
+	if err != nil {
+		response.Error(c, err)
+		return false
+	}
+	*targetLang = normalized
+
+	if sourceLang != nil && *sourceLang != "" && *sourceLang != "auto" {
+		normalized, err := h.languagesService.Normalize(*sourceLang)
+		if err != nil {
+			response.Error(c, err)
+			return false
+		}
+		*sourceLang = normalized
+	}
+	return true
+}
+
+// serviceAvailable rejects the request with a structured 503 when the
+// translation service never initialized (e.g. the Gemini API key is
+// missing), instead of letting a nil service panic at request time
+func (h *Handler) serviceAvailable(c *gin.Context) bool {
+	if h.translationService != nil {
+		return true
+	}
+	appErr := appErrors.NewServiceUnavailableError("Translation is not available on this deployment")
+	response.Error(c, appErr)
+	return false
+}
+
 // TranslateTexts handles text translation requests
 func (h *Handler) TranslateTexts(c *gin.Context) {
+	if !h.serviceAvailable(c) {
+		return
+	}
+
 	var req dto.TranslateTextsRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := validation.BindJSON(c, &req); err != nil {
 		h.logger.Error("Invalid JSON body", zap.Error(err))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request body",
-			Details: err.Error(),
-		})
+		response.Error(c, err)
 		return
 	}
 
 	// Validate request
 	if len(req.Texts) == 0 {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "No texts provided for translation",
-		})
+		response.ErrorMessage(c, http.StatusBadRequest, "No texts provided for translation")
 		return
 	}
 
 	if req.TargetLang == "" {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Target language is required",
-		})
+		response.ErrorMessage(c, http.StatusBadRequest, "Target language is required")
+		return
+	}
+
+	if !h.normalizeLanguagePair(c, &req.TargetLang, &req.SourceLang) {
+		return
+	}
+
+	requestChars := countChars(req.Texts)
+	userID := requestUserID(c)
+	if !h.enforceQuota(c, userID, requestChars) {
 		return
 	}
 
+	// Thread the provider override (admin-only) and a usage record through
+	// the request context so the service layer can honor and fill them
+	serveStart := time.Now()
+	ctx := c.Request.Context()
+	if override := adminProviderOverride(c); override != "" {
+		ctx = ctxutil.WithProviderOverride(ctx, override)
+	}
+	ctx, providerUsage := ctxutil.WithProviderUsage(ctx)
+	c.Request = c.Request.WithContext(ctx)
+
 	// Auto-detect source language if not provided
 	detectedSourceLang := req.SourceLang
 	if req.SourceLang == "" && len(req.Texts) > 0 {
-		// Use first text to detect language
-		sampleText := req.Texts[0]
-		if len(sampleText) > 200 {
-			sampleText = sampleText[:200] // Limit sample size for detection
-		}
-
-		if detected, err := h.translationService.DetectLanguage(c.Request.Context(), sampleText); err == nil {
+		if detected, err := h.translationService.DetectLanguageFromSamples(c.Request.Context(), req.Texts); err == nil {
+			if detected == translation.LanguageUndetermined {
+				h.logger.Warn("Detected language samples disagree, requiring explicit source language",
+					zap.Int("textCount", len(req.Texts)))
+				response.ErrorMessage(c, http.StatusBadRequest, "Could not reliably detect source language, please provide sourceLang explicitly")
+				return
+			}
 			detectedSourceLang = detected
-			h.logger.Debug("Language detected", zap.String("detected", detected), zap.String("sample", sampleText[:min(50, len(sampleText))]))
+			h.logger.Debug("Language detected", zap.String("detected", detected))
 		} else {
 			h.logger.Warn("Failed to detect language", zap.Error(err))
 			detectedSourceLang = "auto" // Fallback to auto-detection
 		}
 	}
 
-	// Translate texts
-	translations, err := h.translationService.TranslateTexts(
-		c.Request.Context(),
-		req.Texts,
-		req.TargetLang,
-		detectedSourceLang,
-	)
+	// Dual mode returns a literal and a natural translation per segment
+	if req.Mode == "dual" {
+		h.translateDual(c, &req, detectedSourceLang, userID, requestChars, serveStart)
+		return
+	}
+
+	// Translate texts, with an optional gist pass for discourse coherence.
+	// The plain path also reports translation-memory matches; gist-informed
+	// results bypass the memory, so there is nothing to report for them.
+	var translations []string
+	var matches []translation.SegmentMatch
+	var err error
+	if req.UseGistPass {
+		translations, err = h.translationService.TranslateTextsWithGist(
+			c.Request.Context(),
+			req.Texts,
+			req.TargetLang,
+			detectedSourceLang,
+		)
+	} else {
+		translations, matches, err = h.translationService.TranslateTextsWithMatches(
+			c.Request.Context(),
+			req.Texts,
+			req.TargetLang,
+			detectedSourceLang,
+		)
+	}
 	if err != nil {
+		// Oversized requests carry their own status code (413); tell the
+		// client to resubmit in smaller chunks instead of masking the error
+		var appErr *appErrors.AppError
+		if goerrors.As(err, &appErr) && appErr.Status == http.StatusRequestEntityTooLarge {
+			response.ErrorMessage(c, appErr.Status, appErr.Message)
+			return
+		}
+
 		// Check if it's a quota exceeded or context canceled error and return mock data
 		if strings.Contains(err.Error(), "quota") || strings.Contains(err.Error(), "429") || strings.Contains(err.Error(), "context canceled") {
 			h.logger.Warn("Translation quota exceeded, returning mock translations",
 				zap.Int("textCount", len(req.Texts)),
 				zap.String("sourceLang", detectedSourceLang),
 				zap.String("targetLang", req.TargetLang))
-			
+
 			// Generate mock translations
 			mockTranslations := make([]string, len(req.Texts))
 			for i, text := range req.Texts {
 				// Simple mock translation - add [TRANSLATED] prefix
 				mockTranslations[i] = fmt.Sprintf("[%s] %s", strings.ToUpper(req.TargetLang), text)
 			}
-			
+
 			response := dto.TranslateTextsResponse{
 				Translations: mockTranslations,
 				SourceLang:   detectedSourceLang,
 				TargetLang:   req.TargetLang,
 			}
-			
+
 			c.JSON(http.StatusOK, response)
 			return
 		}
-		
+
 		h.logger.Error("Failed to translate texts",
 			zap.Int("textCount", len(req.Texts)),
 			zap.String("sourceLang", detectedSourceLang),
 			zap.String("targetLang", req.TargetLang),
 			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to translate texts",
-			Details: err.Error(),
-		})
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to translate texts", err.Error())
 		return
 	}
 
+	h.recordUsage(userID, requestChars, req.TargetLang)
+	h.recordActivity(userID, len(req.Texts))
+
+	response.DebugHeaders(c, providerUsage.Provider, providerUsage.FromCache, time.Since(serveStart))
+
 	// Return response
 	response := dto.TranslateTextsResponse{
 		Translations: translations,
 		SourceLang:   detectedSourceLang,
 		TargetLang:   req.TargetLang,
+		Matches:      toTranslationMatchDTOs(matches),
 	}
 
 	h.logger.Debug("Translation completed",
@@ -130,10 +241,341 @@ func (h *Handler) TranslateTexts(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// min helper function
-func min(a, b int) int {
-	if a < b {
-		return a
+// translateDual serves mode=dual requests with literal/natural pairs
+func (h *Handler) translateDual(c *gin.Context, req *dto.TranslateTextsRequest, sourceLang string, userID uint, requestChars int, serveStart time.Time) {
+	pairs, err := h.translationService.TranslateTextsDual(
+		c.Request.Context(),
+		req.Texts,
+		req.TargetLang,
+		sourceLang,
+	)
+	if err != nil {
+		var appErr *appErrors.AppError
+		if goerrors.As(err, &appErr) && appErr.Status == http.StatusRequestEntityTooLarge {
+			response.ErrorMessage(c, appErr.Status, appErr.Message)
+			return
+		}
+
+		h.logger.Error("Failed to translate texts in dual mode",
+			zap.Int("textCount", len(req.Texts)),
+			zap.String("sourceLang", sourceLang),
+			zap.String("targetLang", req.TargetLang),
+			zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to translate texts", err.Error())
+		return
+	}
+
+	h.recordUsage(userID, requestChars, req.TargetLang)
+	h.recordActivity(userID, len(req.Texts))
+
+	if usage := ctxutil.ProviderUsageFromContext(c.Request.Context()); usage != nil {
+		response.DebugHeaders(c, usage.Provider, usage.FromCache, time.Since(serveStart))
+	}
+
+	dual := make([]dto.DualTranslationDTO, len(pairs))
+	for i, pair := range pairs {
+		dual[i] = dto.DualTranslationDTO{
+			Literal: pair.Literal,
+			Natural: pair.Natural,
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.TranslateTextsResponse{
+		Dual:       dual,
+		SourceLang: sourceLang,
+		TargetLang: req.TargetLang,
+	})
+}
+
+// toTranslationMatchDTOs converts memory-served segment matches for the
+// response; nil stays nil so the field is omitted when nothing matched
+func toTranslationMatchDTOs(matches []translation.SegmentMatch) []dto.TranslationMatchDTO {
+	if len(matches) == 0 {
+		return nil
 	}
-	return b
-}
\ No newline at end of file
+
+	dtos := make([]dto.TranslationMatchDTO, len(matches))
+	for i, match := range matches {
+		dtos[i] = dto.TranslationMatchDTO{
+			Index:      match.Index,
+			SourceText: match.SourceText,
+			Similarity: match.Similarity,
+		}
+	}
+	return dtos
+}
+
+// usageProvider names the translation backend in usage records
+const usageProvider = "gemini"
+
+// countChars sums the rune count of the texts, matching how quotas are configured
+func countChars(texts []string) int {
+	total := 0
+	for _, text := range texts {
+		total += utf8.RuneCountInString(text)
+	}
+	return total
+}
+
+// adminProviderOverride reads the requested translation provider override from
+// the X-Provider-Override header (preferred) or the provider_override query
+// param. Only admins may force a provider; everyone else gets an empty string,
+// which leaves the normal priority ordering in place.
+func adminProviderOverride(c *gin.Context) string {
+	userCtx, err := internalTypes.GetUserContext(c)
+	if err != nil || !userCtx.IsAdmin() {
+		return ""
+	}
+
+	if header := strings.TrimSpace(c.GetHeader("X-Provider-Override")); header != "" {
+		return header
+	}
+	return strings.TrimSpace(c.Query("provider_override"))
+}
+
+// requestUserID returns the authenticated user's ID, or 0 for anonymous requests
+func requestUserID(c *gin.Context) uint {
+	userCtx, err := internalTypes.GetUserContext(c)
+	if err != nil {
+		return 0
+	}
+	return userCtx.UserID
+}
+
+// recordUsage persists a served translation for quota accounting
+func (h *Handler) recordUsage(userID uint, charCount int, targetLang string) {
+	if h.usageService == nil || userID == 0 {
+		return
+	}
+	h.usageService.RecordTranslation(userID, charCount, targetLang, usageProvider)
+}
+
+// recordActivity counts translated segments toward the user's daily study
+// statistics
+func (h *Handler) recordActivity(userID uint, segments int) {
+	if h.statsService == nil || userID == 0 {
+		return
+	}
+	h.statsService.RecordTranslatedSegments(userID, segments)
+}
+
+// enforceQuota rejects the request when it would exceed the user's quota,
+// writing the error response itself. Quotas only apply to authenticated
+// users; anonymous requests pass through untracked.
+func (h *Handler) enforceQuota(c *gin.Context, userID uint, requestChars int) bool {
+	if h.usageService == nil || userID == 0 {
+		return true
+	}
+
+	err := h.usageService.CheckQuota(userID, requestChars)
+	if err == nil {
+		return true
+	}
+
+	var appErr *appErrors.AppError
+	if goerrors.As(err, &appErr) {
+		h.logger.Warn("Rejected translation request over quota",
+			zap.Uint("user_id", userID),
+			zap.Int("char_count", requestChars))
+		response.ErrorMessage(c, appErr.Status, appErr.Message)
+		return false
+	}
+
+	h.logger.Error("Failed to check translation quota", zap.Error(err))
+	response.ErrorMessage(c, http.StatusInternalServerError, "Failed to check translation quota")
+	return false
+}
+
+// GetLatencyMetrics exposes per-segment translation latency percentiles
+func (h *Handler) GetLatencyMetrics(c *gin.Context) {
+	if !h.serviceAvailable(c) {
+		return
+	}
+
+	snapshot := h.translationService.LatencyMetrics()
+
+	c.JSON(http.StatusOK, dto.TranslationLatencyMetricsResponse{
+		CacheHits:  toLatencyStatsDTO(snapshot.CacheHits),
+		ModelCalls: toLatencyStatsDTO(snapshot.ModelCalls),
+	})
+}
+
+// toLatencyStatsDTO converts latency stats to their millisecond representation
+func toLatencyStatsDTO(stats translation.SegmentLatencyStats) dto.SegmentLatencyStatsDTO {
+	return dto.SegmentLatencyStatsDTO{
+		Count: stats.Count,
+		AvgMs: float64(stats.Avg) / float64(time.Millisecond),
+		P50Ms: float64(stats.P50) / float64(time.Millisecond),
+		P90Ms: float64(stats.P90) / float64(time.Millisecond),
+		P99Ms: float64(stats.P99) / float64(time.Millisecond),
+	}
+}
+
+// BulkTranslate submits items for asynchronous bulk translation
+func (h *Handler) BulkTranslate(c *gin.Context) {
+	if !h.serviceAvailable(c) {
+		return
+	}
+
+	var req dto.BulkTranslateRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.logger.Error("Invalid JSON body", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	if len(req.Items) == 0 {
+		response.ErrorMessage(c, http.StatusBadRequest, "No items provided for bulk translation")
+		return
+	}
+
+	accepted := 0
+	for _, item := range req.Items {
+		err := h.bulkTranslator.Submit(translation.BulkItem{
+			ID:         item.ID,
+			Text:       item.Text,
+			SourceLang: item.SourceLang,
+			TargetLang: item.TargetLang,
+		})
+		if err != nil {
+			h.logger.Warn("Failed to submit bulk translation item",
+				zap.String("jobId", item.ID),
+				zap.Error(err))
+			continue
+		}
+		accepted++
+	}
+
+	c.JSON(http.StatusAccepted, dto.BulkTranslateResponse{Accepted: accepted})
+}
+
+// GetBulkResult returns the completed translation for a bulk job ID
+func (h *Handler) GetBulkResult(c *gin.Context) {
+	if !h.serviceAvailable(c) {
+		return
+	}
+
+	id := c.Param("id")
+
+	result, ok := h.bulkTranslator.Result(id)
+	if !ok {
+		response.ErrorMessage(c, http.StatusNotFound, "No completed translation for this job ID")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"id":          id,
+		"translation": result,
+	})
+}
+
+// ListDeadLetters lists bulk items that failed processing
+func (h *Handler) ListDeadLetters(c *gin.Context) {
+	if !h.serviceAvailable(c) {
+		return
+	}
+
+	deadLetters := h.bulkTranslator.DeadLetters()
+
+	items := make([]dto.DeadLetteredItemDTO, len(deadLetters))
+	for i, deadLetter := range deadLetters {
+		items[i] = dto.DeadLetteredItemDTO{
+			ID:         deadLetter.Item.ID,
+			Text:       deadLetter.Item.Text,
+			SourceLang: deadLetter.Item.SourceLang,
+			TargetLang: deadLetter.Item.TargetLang,
+			Reason:     deadLetter.Reason,
+			FailedAt:   deadLetter.FailedAt.Format(time.RFC3339),
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.ListDeadLettersResponse{
+		Items: items,
+		Count: len(items),
+	})
+}
+
+// RetryDeadLetter re-submits a dead-lettered bulk item
+func (h *Handler) RetryDeadLetter(c *gin.Context) {
+	if !h.serviceAvailable(c) {
+		return
+	}
+
+	id := c.Param("id")
+
+	if err := h.bulkTranslator.Retry(id); err != nil {
+		response.ErrorWithDetails(c, http.StatusNotFound, "Failed to retry dead-lettered item", err.Error())
+		return
+	}
+
+	h.logger.Info("Re-submitted dead-lettered bulk item", zap.String("jobId", id))
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"id":     id,
+		"status": "resubmitted",
+	})
+}
+
+// ExportMemory exports the translation memory as TMX-like JSON
+func (h *Handler) ExportMemory(c *gin.Context) {
+	if !h.serviceAvailable(c) {
+		return
+	}
+
+	entries := h.translationService.ExportMemory()
+
+	entryDTOs := make([]dto.TranslationMemoryEntryDTO, len(entries))
+	for i, entry := range entries {
+		entryDTOs[i] = dto.TranslationMemoryEntryDTO{
+			SourceLang: entry.SourceLang,
+			TargetLang: entry.TargetLang,
+			SourceText: entry.SourceText,
+			TargetText: entry.TargetText,
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.ExportTranslationMemoryResponse{
+		Entries: entryDTOs,
+		Count:   len(entryDTOs),
+	})
+}
+
+// ImportMemory imports translation memory entries, deduping existing ones
+func (h *Handler) ImportMemory(c *gin.Context) {
+	if !h.serviceAvailable(c) {
+		return
+	}
+
+	var req dto.ImportTranslationMemoryRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.logger.Error("Invalid JSON body", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	entries := make([]translation.TranslationMemoryEntry, len(req.Entries))
+	for i, entry := range req.Entries {
+		entries[i] = translation.TranslationMemoryEntry{
+			SourceLang: entry.SourceLang,
+			TargetLang: entry.TargetLang,
+			SourceText: entry.SourceText,
+			TargetText: entry.TargetText,
+		}
+	}
+
+	imported, skipped, err := h.translationService.ImportMemory(entries)
+	if err != nil {
+		h.logger.Warn("Rejected translation memory import", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid translation memory entries", err.Error())
+		return
+	}
+
+	h.logger.Info("Imported translation memory entries",
+		zap.Int("imported", imported),
+		zap.Int("skipped", skipped))
+
+	c.JSON(http.StatusOK, dto.ImportTranslationMemoryResponse{
+		Imported: imported,
+		Skipped:  skipped,
+	})
+}
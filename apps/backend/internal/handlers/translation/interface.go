@@ -6,4 +6,37 @@ import "github.com/gin-gonic/gin"
 type HandlerInterface interface {
 	// TranslateTexts handles text translation requests
 	TranslateTexts(c *gin.Context)
-}
\ No newline at end of file
+
+	// StreamTranslation streams translated segments over SSE as they complete
+	StreamTranslation(c *gin.Context)
+
+	// ExportMemory exports the translation memory as TMX-like JSON
+	ExportMemory(c *gin.Context)
+
+	// ImportMemory imports translation memory entries, deduping existing ones
+	ImportMemory(c *gin.Context)
+
+	// GetLatencyMetrics exposes per-segment translation latency percentiles
+	GetLatencyMetrics(c *gin.Context)
+
+	// BatchTranslate enqueues a workload as one job and returns its ID
+	BatchTranslate(c *gin.Context)
+
+	// GetBatchJob returns batch job progress and partial results
+	GetBatchJob(c *gin.Context)
+
+	// StreamBatchJob streams batch job progress updates over SSE
+	StreamBatchJob(c *gin.Context)
+
+	// BulkTranslate submits items for asynchronous bulk translation
+	BulkTranslate(c *gin.Context)
+
+	// GetBulkResult returns the completed translation for a bulk job ID
+	GetBulkResult(c *gin.Context)
+
+	// ListDeadLetters lists bulk items that failed processing
+	ListDeadLetters(c *gin.Context)
+
+	// RetryDeadLetter re-submits a dead-lettered bulk item
+	RetryDeadLetter(c *gin.Context)
+}
@@ -0,0 +1,133 @@
+package translation
+
+import (
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/response"
+	"app-backend/internal/services/translation"
+)
+
+// StreamTranslation streams translated segments over Server-Sent Events as
+// they complete, so clients can display translations progressively instead of
+// waiting for the whole transcript
+func (h *Handler) StreamTranslation(c *gin.Context) {
+	var req dto.TranslateTextsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid JSON body", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if len(req.Texts) == 0 {
+		response.ErrorMessage(c, http.StatusBadRequest, "No texts provided for translation")
+		return
+	}
+
+	if req.TargetLang == "" {
+		response.ErrorMessage(c, http.StatusBadRequest, "Target language is required")
+		return
+	}
+
+	if !h.normalizeLanguagePair(c, &req.TargetLang, &req.SourceLang) {
+		return
+	}
+
+	requestChars := countChars(req.Texts)
+	userID := requestUserID(c)
+	if !h.enforceQuota(c, userID, requestChars) {
+		return
+	}
+
+	// Auto-detect source language if not provided
+	sourceLang := req.SourceLang
+	if sourceLang == "" {
+		if detected, err := h.translationService.DetectLanguageFromSamples(c.Request.Context(), req.Texts); err == nil {
+			if detected == translation.LanguageUndetermined {
+				response.ErrorMessage(c, http.StatusBadRequest, "Could not reliably detect source language, please provide sourceLang explicitly")
+				return
+			}
+			sourceLang = detected
+		} else {
+			h.logger.Warn("Failed to detect language", zap.Error(err))
+			sourceLang = "auto"
+		}
+	}
+
+	results, err := h.translationService.TranslateTextsStream(
+		c.Request.Context(),
+		req.Texts,
+		req.TargetLang,
+		sourceLang,
+	)
+	if err != nil {
+		var appErr *appErrors.AppError
+		if goerrors.As(err, &appErr) && appErr.Status == http.StatusRequestEntityTooLarge {
+			response.ErrorMessage(c, appErr.Status, appErr.Message)
+			return
+		}
+
+		h.logger.Error("Failed to start translation stream",
+			zap.Int("textCount", len(req.Texts)),
+			zap.String("targetLang", req.TargetLang),
+			zap.Error(err))
+		response.ErrorMessage(c, http.StatusInternalServerError, "Failed to translate texts")
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Writer.WriteHeader(http.StatusOK)
+	c.Writer.Flush()
+
+	failed := 0
+	for result := range results {
+		event := dto.StreamTranslationEventDTO{
+			Index:       result.Index,
+			Translation: result.Translation,
+		}
+		eventName := "translation"
+		if result.Err != nil {
+			failed++
+			eventName = "error"
+			event.Translation = ""
+			event.Error = "Translation failed"
+			h.logger.Warn("Streamed segment failed",
+				zap.Int("index", result.Index),
+				zap.Error(result.Err))
+		}
+		writeSSEEvent(c, eventName, event)
+	}
+
+	writeSSEEvent(c, "done", dto.StreamTranslationDoneDTO{
+		Total:  len(req.Texts),
+		Failed: failed,
+	})
+
+	h.recordUsage(userID, requestChars, req.TargetLang)
+
+	h.logger.Debug("Translation stream completed",
+		zap.Int("textCount", len(req.Texts)),
+		zap.Int("failed", failed),
+		zap.String("sourceLang", sourceLang),
+		zap.String("targetLang", req.TargetLang))
+}
+
+// writeSSEEvent writes one named SSE event with a JSON payload and flushes it
+// to the client
+func writeSSEEvent(c *gin.Context, name string, payload interface{}) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(c.Writer, "event: %s\ndata: %s\n\n", name, data)
+	c.Writer.Flush()
+}
@@ -2,46 +2,53 @@ package user
 
 import (
 	"app-backend/internal/dto"
-	"app-backend/internal/errors"
 	"app-backend/internal/logger"
+	"app-backend/internal/middleware"
 	"app-backend/internal/models"
+	"app-backend/internal/response"
+	"app-backend/internal/services/audit"
+	"app-backend/internal/services/privacy"
+	"app-backend/internal/services/stats"
+	"app-backend/internal/services/usage"
 	"app-backend/internal/services/user"
 	"app-backend/internal/types"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 )
 
 type Handler struct {
-	userService user.ServiceInterface
-	logger      *logger.Logger
+	userService    user.ServiceInterface
+	usageService   usage.ServiceInterface
+	privacyService privacy.ServiceInterface
+	statsService   stats.ServiceInterface
+	logger         *logger.Logger
 }
 
-func NewUserHandler(userService user.ServiceInterface, logger *logger.Logger) HandlerInterface {
+func NewUserHandler(userService user.ServiceInterface, usageService usage.ServiceInterface, privacyService privacy.ServiceInterface, statsService stats.ServiceInterface, logger *logger.Logger) HandlerInterface {
 	return &Handler{
-		userService: userService,
-		logger:      logger,
+		userService:    userService,
+		usageService:   usageService,
+		privacyService: privacyService,
+		statsService:   statsService,
+		logger:         logger,
 	}
 }
 
 func (h *Handler) GetProfile(c *gin.Context) {
 	userCtx, err := types.GetUserContext(c)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
 		return
 	}
 
 	user, err := h.userService.GetUser(userCtx.UserID)
 	if err != nil {
-		if appErr, ok := err.(*errors.AppError); ok {
-			h.logger.Error("Get profile failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
-			c.JSON(appErr.Status, gin.H{"error": appErr.Message})
-			return
-		}
-		h.logger.Error("Unexpected get profile error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		h.logger.Error("Get profile failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
 		return
 	}
 
@@ -63,26 +70,21 @@ func (h *Handler) GetProfile(c *gin.Context) {
 func (h *Handler) UpdateProfile(c *gin.Context) {
 	userCtx, err := types.GetUserContext(c)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
 		return
 	}
 
 	var req models.UpdateUserRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.logger.Error("Invalid update profile request", zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data", "details": err.Error()})
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
 		return
 	}
 
 	user, err := h.userService.UpdateUser(userCtx.UserID, &req)
 	if err != nil {
-		if appErr, ok := err.(*errors.AppError); ok {
-			h.logger.Error("Update profile failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
-			c.JSON(appErr.Status, gin.H{"error": appErr.Message})
-			return
-		}
-		h.logger.Error("Unexpected update profile error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		h.logger.Error("Update profile failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
 		return
 	}
 
@@ -105,49 +107,40 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 func (h *Handler) ChangePassword(c *gin.Context) {
 	userCtx, err := types.GetUserContext(c)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
 		return
 	}
 
 	var req dto.ChangePasswordRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.logger.Error("Invalid change password request", zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data", "details": err.Error()})
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
 		return
 	}
 
 	err = h.userService.ChangePassword(userCtx.UserID, &req)
 	if err != nil {
-		if appErr, ok := err.(*errors.AppError); ok {
-			h.logger.Error("Change password failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
-			c.JSON(appErr.Status, gin.H{"error": appErr.Message})
-			return
-		}
-		h.logger.Error("Unexpected change password error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		h.logger.Error("Change password failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
 		return
 	}
 
 	h.logger.Info("Password changed successfully", zap.Uint("user_id", userCtx.UserID))
+	middleware.MarkAuditEvent(c, audit.Event{Action: audit.ActionPasswordChange, ActorID: userCtx.UserID})
 	c.JSON(http.StatusOK, gin.H{"message": "Password changed successfully"})
 }
 
 func (h *Handler) DeleteAccount(c *gin.Context) {
 	userCtx, err := types.GetUserContext(c)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
 		return
 	}
 
 	err = h.userService.DeleteUser(userCtx.UserID)
 	if err != nil {
-		if appErr, ok := err.(*errors.AppError); ok {
-			h.logger.Error("Delete account failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
-			c.JSON(appErr.Status, gin.H{"error": appErr.Message})
-			return
-		}
-		h.logger.Error("Unexpected delete account error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		h.logger.Error("Delete account failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
 		return
 	}
 
@@ -155,6 +148,135 @@ func (h *Handler) DeleteAccount(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Account deleted successfully"})
 }
 
+func (h *Handler) GetUsage(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	if h.usageService == nil {
+		response.ErrorMessage(c, http.StatusServiceUnavailable, "Usage tracking is not available")
+		return
+	}
+
+	usageReport, err := h.usageService.GetUsage(userCtx.UserID)
+	if err != nil {
+		h.logger.Error("Get usage failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GetUsageResponse{
+		Daily:   toUsageWindowResponse(usageReport.Daily),
+		Monthly: toUsageWindowResponse(usageReport.Monthly),
+	})
+}
+
+// GetStats returns the user's study streak and per-day activity counts for
+// the dashboard. The range query parameter takes a day count like "30d".
+func (h *Handler) GetStats(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	rangeDays, ok := parseRangeDays(c.DefaultQuery("range", ""))
+	if !ok {
+		response.ErrorMessage(c, http.StatusBadRequest, "Invalid range; use a day count like 30d")
+		return
+	}
+
+	statsReport, err := h.statsService.GetStats(userCtx.UserID, rangeDays)
+	if err != nil {
+		h.logger.Error("Get stats failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, statsReport)
+}
+
+// parseRangeDays parses a "30d" style range parameter. An empty value picks
+// the service default; anything unparseable is rejected.
+func parseRangeDays(value string) (int, bool) {
+	if value == "" {
+		return 0, true
+	}
+
+	value = strings.TrimSuffix(value, "d")
+	days, err := strconv.Atoi(value)
+	if err != nil || days <= 0 {
+		return 0, false
+	}
+	return days, true
+}
+
+// ExportData queues a background job that gathers the user's data into a
+// downloadable archive; the job is polled like any other and the finished
+// archive fetched from the download endpoint
+func (h *Handler) ExportData(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	if h.privacyService == nil {
+		response.ErrorMessage(c, http.StatusServiceUnavailable, "Data export is not available")
+		return
+	}
+
+	job, err := h.privacyService.StartExport(userCtx.UserID)
+	if err != nil {
+		h.logger.Error("Start data export failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
+		return
+	}
+
+	middleware.MarkAuditEvent(c, audit.Event{Action: audit.ActionDataExport, ActorID: userCtx.UserID})
+	c.JSON(http.StatusAccepted, dto.ConvertToJobResponse(job))
+}
+
+// DownloadExport streams a finished export archive as an attachment
+func (h *Handler) DownloadExport(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	if h.privacyService == nil {
+		response.ErrorMessage(c, http.StatusServiceUnavailable, "Data export is not available")
+		return
+	}
+
+	jobID, err := strconv.ParseUint(c.Param("jobId"), 10, 32)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusBadRequest, "Invalid job ID")
+		return
+	}
+
+	file, err := h.privacyService.OpenExport(userCtx.UserID, uint(jobID))
+	if err != nil {
+		h.logger.Error("Download export failed", zap.Error(err), zap.Uint("user_id", userCtx.UserID))
+		response.Error(c, err)
+		return
+	}
+
+	c.FileAttachment(file.Path, file.Name)
+}
+
+// toUsageWindowResponse converts a service usage window to its DTO
+func toUsageWindowResponse(window usage.WindowUsage) dto.UsageWindowResponse {
+	return dto.UsageWindowResponse{
+		CharsUsed: window.CharsUsed,
+		CharLimit: window.CharLimit,
+		Remaining: window.Remaining,
+	}
+}
+
 func (h *Handler) ListUsers(c *gin.Context) {
 	// Parse pagination parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
@@ -173,15 +295,10 @@ func (h *Handler) ListUsers(c *gin.Context) {
 
 	users, err := h.userService.ListUsers(pagReq)
 	if err != nil {
-		if appErr, ok := err.(*errors.AppError); ok {
-			h.logger.Error("List users failed", zap.Error(err))
-			c.JSON(appErr.Status, gin.H{"error": appErr.Message})
-			return
-		}
-		h.logger.Error("Unexpected list users error", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		h.logger.Error("List users failed", zap.Error(err))
+		response.Error(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, users)
-}
\ No newline at end of file
+}
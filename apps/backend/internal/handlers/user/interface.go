@@ -8,5 +8,9 @@ type HandlerInterface interface {
 	UpdateProfile(c *gin.Context)
 	ChangePassword(c *gin.Context)
 	DeleteAccount(c *gin.Context)
+	GetUsage(c *gin.Context)
+	GetStats(c *gin.Context)
+	ExportData(c *gin.Context)
+	DownloadExport(c *gin.Context)
 	ListUsers(c *gin.Context)
-}
\ No newline at end of file
+}
@@ -0,0 +1,124 @@
+package uservideo
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	"app-backend/internal/services/uservideo"
+	"app-backend/internal/types"
+)
+
+type Handler struct {
+	userVideoService uservideo.ServiceInterface
+	logger           *logger.Logger
+}
+
+func NewUserVideoHandler(userVideoService uservideo.ServiceInterface, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		userVideoService: userVideoService,
+		logger:           logger,
+	}
+}
+
+// SaveVideo records a study session for the authenticated user, updating the
+// existing entry when the video was saved before
+func (h *Handler) SaveVideo(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.SaveUserVideoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid save video request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	video, err := h.userVideoService.SaveVideo(userCtx.UserID, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to save video", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, video)
+}
+
+// GetVideo returns one of the authenticated user's saved videos
+func (h *Handler) GetVideo(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	video, err := h.userVideoService.GetVideo(userCtx.UserID, c.Param("videoId"))
+	if err != nil {
+		h.respondError(c, err, "Failed to get saved video", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, video)
+}
+
+// ListVideos returns the authenticated user's watch history with pagination
+func (h *Handler) ListVideos(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	// Parse pagination parameters; history lists most recently watched first
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	sortBy := c.DefaultQuery("sort_by", "last_watched_at")
+	sortDir := c.DefaultQuery("sort_dir", "desc")
+	search := c.Query("search")
+
+	pagReq := &types.PaginationRequest{
+		Page:     page,
+		PageSize: pageSize,
+		SortBy:   sortBy,
+		SortDir:  sortDir,
+		Search:   search,
+	}
+
+	videos, err := h.userVideoService.ListVideos(userCtx.UserID, pagReq)
+	if err != nil {
+		h.respondError(c, err, "Failed to list saved videos", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, videos)
+}
+
+// DeleteVideo removes one of the authenticated user's saved videos
+func (h *Handler) DeleteVideo(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	if err := h.userVideoService.DeleteVideo(userCtx.UserID, c.Param("videoId")); err != nil {
+		h.respondError(c, err, "Failed to delete saved video", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Saved video deleted successfully"})
+}
+
+// respondError maps service errors to HTTP responses with the shared
+// AppError status convention
+func (h *Handler) respondError(c *gin.Context, err error, logMsg string, userID uint) {
+	h.logger.Error(logMsg, zap.Error(err), zap.Uint("user_id", userID))
+	response.Error(c, err)
+}
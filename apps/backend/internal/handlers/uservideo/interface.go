@@ -0,0 +1,11 @@
+package uservideo
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for saved-video handlers
+type HandlerInterface interface {
+	SaveVideo(c *gin.Context)
+	GetVideo(c *gin.Context)
+	ListVideos(c *gin.Context)
+	DeleteVideo(c *gin.Context)
+}
@@ -0,0 +1,117 @@
+package video
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/response"
+	"app-backend/internal/services/transcript/types"
+	"app-backend/internal/validation"
+)
+
+// GetChapters returns a video's chapter list for player navigation.
+// Timestamp markers in the video description win; without them, topic
+// boundaries are inferred from the transcript. A video where neither works
+// gets an empty list rather than an error.
+func (h *Handler) GetChapters(c *gin.Context) {
+	var req dto.GetChaptersRequest
+	if err := validation.BindURI(c, &req); err != nil {
+		h.logger.Error("Invalid request", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+	if err := validation.BindQuery(c, &req); err != nil {
+		h.logger.Error("Invalid query parameters", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	if !h.canonicalizeTranscriptLang(c, &req.Language) {
+		return
+	}
+
+	// Decode the video URL (base64url, percent-encoded, or raw)
+	decodedURL, err := DecodeVideoURL(req.VideoURL)
+	if err != nil {
+		h.logger.Error("Failed to decode URL", zap.String("url", req.VideoURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL format", err.Error())
+		return
+	}
+
+	// Detect provider and extract video ID
+	provider, videoID, err := h.videoService.DetectProvider(decodedURL)
+	if err != nil {
+		h.logger.Error("Failed to detect provider", zap.String("url", decodedURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Unsupported video provider or invalid URL", err.Error())
+		return
+	}
+
+	// The description and duration come from the provider API; when that
+	// fails the transcript fallback can still produce chapters
+	var description string
+	var duration time.Duration
+	if info, err := h.videoService.GetVideoInfo(c.Request.Context(), provider, videoID); err != nil {
+		h.logger.Warn("Failed to get video info for chapters",
+			zap.String("video_id", videoID),
+			zap.Error(err))
+	} else {
+		description = info.Description
+		duration = time.Duration(info.Duration)
+	}
+
+	if chapters := h.chaptersService.FromDescription(description, duration); chapters != nil {
+		c.JSON(http.StatusOK, dto.GetChaptersResponse{
+			VideoID:  videoID,
+			Provider: provider,
+			Source:   dto.ChapterSourceDescription,
+			Chapters: chapters,
+		})
+		return
+	}
+
+	// No markers in the description; infer boundaries from the transcript.
+	// A video without captions simply has no chapters.
+	transcript, err := h.transcriptService.GetTranscript(c.Request.Context(), &types.TranscriptRequest{
+		VideoURL: decodedURL,
+		Language: req.Language,
+	})
+	if err != nil {
+		h.logger.Warn("Failed to get transcript for chapters",
+			zap.String("video_url", decodedURL),
+			zap.String("language", req.Language),
+			zap.Error(err))
+		c.JSON(http.StatusOK, dto.GetChaptersResponse{
+			VideoID:  videoID,
+			Provider: provider,
+			Source:   dto.ChapterSourceNone,
+			Chapters: []dto.ChapterResponse{},
+		})
+		return
+	}
+
+	chapters, cached, err := h.chaptersService.InferFromTranscript(c.Request.Context(), provider, videoID, duration, transcript.Segments)
+	if err != nil {
+		h.logger.Error("Failed to infer chapters",
+			zap.String("video_id", videoID),
+			zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	source := dto.ChapterSourceTranscript
+	if chapters == nil {
+		source = dto.ChapterSourceNone
+		chapters = []dto.ChapterResponse{}
+	}
+	c.JSON(http.StatusOK, dto.GetChaptersResponse{
+		VideoID:  videoID,
+		Provider: provider,
+		Source:   source,
+		Chapters: chapters,
+		Cached:   cached,
+	})
+}
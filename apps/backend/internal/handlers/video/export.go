@@ -0,0 +1,220 @@
+package video
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/response"
+	"app-backend/internal/services/transcript/captions"
+	"app-backend/internal/services/transcript/types"
+	internalTypes "app-backend/internal/types"
+	"app-backend/internal/validation"
+)
+
+// exportContentTypes maps an export format to its Content-Type header
+var exportContentTypes = map[string]string{
+	"srt":  "application/x-subrip",
+	"vtt":  "text/vtt; charset=utf-8",
+	"json": "application/json",
+	"txt":  "text/plain; charset=utf-8",
+}
+
+// ExportTranscript downloads a transcript as an SRT, WebVTT, JSON or plain
+// text file. With a targetLang it exports the dual-language transcript with
+// the translation under each original line.
+func (h *Handler) ExportTranscript(c *gin.Context) {
+	var req dto.ExportTranscriptRequest
+	if err := validation.BindURI(c, &req); err != nil {
+		h.logger.Error("Invalid request", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	if err := validation.BindQuery(c, &req); err != nil {
+		h.logger.Error("Invalid query parameters", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	format := req.Format
+	if format == "" {
+		format = "srt"
+	}
+
+	// Decode the video URL (base64url, percent-encoded, or raw)
+	decodedURL, err := DecodeVideoURL(req.VideoURL)
+	if err != nil {
+		h.logger.Error("Failed to decode URL", zap.String("url", req.VideoURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL format", err.Error())
+		return
+	}
+
+	var export *dto.ExportTranscriptResponse
+	if req.TargetLang != "" {
+		export, err = h.buildDualExport(c, decodedURL, req.SourceLang, req.TargetLang)
+	} else {
+		export, err = h.buildPlainExport(c, decodedURL, req.Language)
+	}
+	if err != nil {
+		h.logger.Error("Failed to get transcript for export",
+			zap.String("video_url", decodedURL),
+			zap.String("format", format),
+			zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to retrieve transcript", err.Error())
+		return
+	}
+
+	body, err := renderExport(export, format)
+	if err != nil {
+		h.logger.Error("Failed to render transcript export", zap.Error(err))
+		response.ErrorMessage(c, http.StatusInternalServerError, "Failed to render transcript export")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", exportFilename(export, format)))
+	c.Data(http.StatusOK, exportContentTypes[format], body)
+}
+
+// buildPlainExport fetches the transcript in one language
+func (h *Handler) buildPlainExport(c *gin.Context, videoURL string, language string) (*dto.ExportTranscriptResponse, error) {
+	transcript, err := h.transcriptService.GetTranscript(c.Request.Context(), &types.TranscriptRequest{
+		VideoURL: videoURL,
+		Language: language,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]dto.ExportTranscriptSegment, len(transcript.Segments))
+	for i, segment := range transcript.Segments {
+		segments[i] = dto.ExportTranscriptSegment{
+			Index:     i + 1,
+			StartTime: internalTypes.MillisecondDuration(segment.Start),
+			EndTime:   internalTypes.MillisecondDuration(segment.Start + segment.Duration),
+			Text:      segment.Text,
+		}
+	}
+
+	return &dto.ExportTranscriptResponse{
+		VideoID:  transcript.VideoID,
+		Language: transcript.Language,
+		Segments: segments,
+	}, nil
+}
+
+// buildDualExport fetches the dual-language transcript and pairs each
+// original segment with its translation
+func (h *Handler) buildDualExport(c *gin.Context, videoURL string, sourceLang string, targetLang string) (*dto.ExportTranscriptResponse, error) {
+	provider, videoID, err := h.videoService.DetectProvider(videoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// An authenticated owner's corrections carry into the exported file
+	var corrections map[int]string
+	if userCtx, ctxErr := internalTypes.GetUserContext(c); ctxErr == nil && h.correctionService != nil {
+		if texts, _, corrErr := h.correctionService.CorrectionsFor(userCtx.UserID, videoID); corrErr == nil {
+			corrections = texts
+		}
+	}
+
+	dual, err := h.videoService.GetDualLanguageTranscript(c.Request.Context(), provider, videoID, sourceLang, targetLang, corrections)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]dto.ExportTranscriptSegment, len(dual.Segments))
+	for i, segment := range dual.Segments {
+		segments[i] = dto.ExportTranscriptSegment{
+			Index:     i + 1,
+			StartTime: segment.StartTime,
+			EndTime:   segment.EndTime,
+			Text:      segment.Text,
+		}
+		if i < len(dual.Translations) {
+			segments[i].TranslatedText = dual.Translations[i].TranslatedText
+		}
+	}
+
+	return &dto.ExportTranscriptResponse{
+		VideoID:    dual.VideoID,
+		SourceLang: dual.SourceLang,
+		TargetLang: dual.TargetLang,
+		Segments:   segments,
+	}, nil
+}
+
+// renderExport serializes the export in the requested format
+func renderExport(export *dto.ExportTranscriptResponse, format string) ([]byte, error) {
+	switch format {
+	case "json":
+		return json.MarshalIndent(export, "", "  ")
+	case "txt":
+		return []byte(renderText(export)), nil
+	case "vtt":
+		return []byte(captions.FormatVTT(exportCues(export))), nil
+	default:
+		return []byte(captions.FormatSRT(exportCues(export))), nil
+	}
+}
+
+// exportCues converts export segments to caption cues; dual-language
+// exports carry the translation as a second line of the cue
+func exportCues(export *dto.ExportTranscriptResponse) []types.TranscriptSegment {
+	cues := make([]types.TranscriptSegment, len(export.Segments))
+	for i, segment := range export.Segments {
+		text := segment.Text
+		if segment.TranslatedText != "" {
+			text += "\n" + segment.TranslatedText
+		}
+		start := time.Duration(segment.StartTime)
+		cues[i] = types.TranscriptSegment{
+			Text:     text,
+			Start:    start,
+			Duration: time.Duration(segment.EndTime) - start,
+		}
+	}
+	return cues
+}
+
+// renderText renders the transcript as plain text, one segment per line;
+// dual-language segments keep the translation under the original with a
+// blank line between pairs
+func renderText(export *dto.ExportTranscriptResponse) string {
+	lines := make([]string, len(export.Segments))
+	dual := false
+	for i, segment := range export.Segments {
+		lines[i] = segment.Text
+		if segment.TranslatedText != "" {
+			lines[i] += "\n" + segment.TranslatedText
+			dual = true
+		}
+	}
+
+	separator := "\n"
+	if dual {
+		separator = "\n\n"
+	}
+	return strings.Join(lines, separator) + "\n"
+}
+
+// exportFilename names the download after the video, its language pair and
+// the format
+func exportFilename(export *dto.ExportTranscriptResponse, format string) string {
+	lang := export.Language
+	if export.TargetLang != "" {
+		lang = export.SourceLang + "-" + export.TargetLang
+		lang = strings.Trim(lang, "-")
+	}
+	if lang == "" {
+		return fmt.Sprintf("%s.%s", export.VideoID, format)
+	}
+	return fmt.Sprintf("%s.%s.%s", export.VideoID, lang, format)
+}
@@ -1,16 +1,27 @@
 package video
 
 import (
+	"encoding/base64"
+	goerrors "errors"
 	"net/http"
-	"net/url"
+	"strings"
 	"sync"
+	"time"
 
 	"app-backend/internal/dto"
 	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	chaptersService "app-backend/internal/services/chapters"
+	"app-backend/internal/services/correction"
+	keyphraseService "app-backend/internal/services/keyphrase"
+	languagesService "app-backend/internal/services/languages"
+	shareService "app-backend/internal/services/share"
+	summaryService "app-backend/internal/services/summary"
 	"app-backend/internal/services/transcript"
 	"app-backend/internal/services/transcript/types"
 	"app-backend/internal/services/video"
 	internalTypes "app-backend/internal/types"
+	"app-backend/internal/validation"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 )
@@ -19,38 +30,62 @@ import (
 type Handler struct {
 	videoService      video.ServiceInterface
 	transcriptService transcript.ServiceInterface
+	correctionService correction.ServiceInterface
+	languagesService  languagesService.ServiceInterface
+	shareService      shareService.ServiceInterface
+	summaryService    summaryService.ServiceInterface
+	keyphraseService  keyphraseService.ServiceInterface
+	chaptersService   chaptersService.ServiceInterface
 	logger            *logger.Logger
 }
 
-// NewVideoHandler creates a new video handler
-func NewVideoHandler(videoService video.ServiceInterface, transcriptService transcript.ServiceInterface, logger *logger.Logger) HandlerInterface {
+// NewVideoHandler creates a new video handler. The correction service may be
+// nil, in which case transcripts are served without owner edits.
+func NewVideoHandler(videoService video.ServiceInterface, transcriptService transcript.ServiceInterface, correctionService correction.ServiceInterface, langService languagesService.ServiceInterface, shareSvc shareService.ServiceInterface, summarySvc summaryService.ServiceInterface, keyphraseSvc keyphraseService.ServiceInterface, chaptersSvc chaptersService.ServiceInterface, logger *logger.Logger) HandlerInterface {
 	return &Handler{
 		videoService:      videoService,
 		transcriptService: transcriptService,
+		correctionService: correctionService,
+		languagesService:  langService,
+		shareService:      shareSvc,
+		summaryService:    summarySvc,
+		keyphraseService:  keyphraseSvc,
+		chaptersService:   chaptersSvc,
 		logger:            logger,
 	}
 }
 
+// canonicalizeTranscriptLang rewrites lang to its canonical BCP-47 form,
+// rejecting malformed codes with a 400. Transcript languages come from the
+// video platform rather than the translation catalog, so only well-formedness
+// is checked. An empty code means "any language" and passes through.
+func (h *Handler) canonicalizeTranscriptLang(c *gin.Context, lang *string) bool {
+	if h.languagesService == nil || *lang == "" {
+		return true
+	}
+	canonical, err := h.languagesService.Canonicalize(*lang)
+	if err != nil {
+		response.Error(c, err)
+		return false
+	}
+	*lang = canonical
+	return true
+}
+
 // GetVideoInfo retrieves basic information about a video
 func (h *Handler) GetVideoInfo(c *gin.Context) {
 	var req dto.VideoInfoRequest
-	if err := c.ShouldBindUri(&req); err != nil {
+	if err := validation.BindURI(c, &req); err != nil {
 		h.logger.Error("Invalid request", zap.Error(err))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid video URL",
-			Details: err.Error(),
-		})
+		response.Error(c, err)
 		return
 	}
 
-	// URL decode the video URL
-	decodedURL, err := url.QueryUnescape(req.VideoURL)
+	// Decode the video URL (base64url, percent-encoded, or raw)
+	decodedURL, err := DecodeVideoURL(req.VideoURL)
 	if err != nil {
 		h.logger.Error("Failed to decode URL", zap.String("url", req.VideoURL), zap.Error(err))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid video URL format",
-			Details: err.Error(),
-		})
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL format", err.Error())
 		return
 	}
 
@@ -58,10 +93,7 @@ func (h *Handler) GetVideoInfo(c *gin.Context) {
 	provider, videoID, err := h.videoService.DetectProvider(decodedURL)
 	if err != nil {
 		h.logger.Error("Failed to detect provider", zap.String("url", decodedURL), zap.Error(err))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Unsupported video provider or invalid URL",
-			Details: err.Error(),
-		})
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Unsupported video provider or invalid URL", err.Error())
 		return
 	}
 
@@ -89,14 +121,11 @@ func (h *Handler) GetVideoInfo(c *gin.Context) {
 
 	// Check for critical video info error
 	if videoErr != nil {
-		h.logger.Error("Failed to get video info", 
+		h.logger.Error("Failed to get video info",
 			zap.String("provider", string(provider)),
 			zap.String("videoID", videoID),
 			zap.Error(videoErr))
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "Failed to retrieve video information",
-			Details: videoErr.Error(),
-		})
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to retrieve video information", videoErr.Error())
 		return
 	}
 
@@ -124,99 +153,194 @@ func (h *Handler) GetVideoInfo(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// ResolveVideo parses a video URL or bare ID and reports its provider,
+// video ID, and URL kind without calling any provider API
+func (h *Handler) ResolveVideo(c *gin.Context) {
+	var req dto.ResolveVideoRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.logger.Error("Invalid JSON body", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	parsed, err := h.videoService.ResolveVideoURL(req.VideoURL)
+	if err != nil {
+		h.logger.Warn("Failed to resolve video URL",
+			zap.String("url", req.VideoURL),
+			zap.Error(err))
+
+		message := "Invalid video URL"
+		switch {
+		case goerrors.Is(err, video.ErrUnsupportedVideoHost):
+			message = "Unsupported video provider"
+		case goerrors.Is(err, video.ErrNoVideoID):
+			message = "URL does not reference a video"
+		}
+		response.ErrorWithDetails(c, http.StatusBadRequest, message, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ResolveVideoResponse{
+		Provider: parsed.Provider,
+		VideoID:  parsed.VideoID,
+		Type:     string(parsed.Kind),
+	})
+}
+
 // GetTranscript retrieves transcript for a video
 func (h *Handler) GetTranscript(c *gin.Context) {
 	var req dto.GetTranscriptRequest
-	if err := c.ShouldBindUri(&req); err != nil {
+	if err := validation.BindURI(c, &req); err != nil {
 		h.logger.Error("Invalid request", zap.Error(err))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid video URL",
-			Details: err.Error(),
-		})
+		response.Error(c, err)
 		return
 	}
 
-	if err := c.ShouldBindQuery(&req); err != nil {
+	if err := validation.BindQuery(c, &req); err != nil {
 		h.logger.Error("Invalid query parameters", zap.Error(err))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid query parameters",
-			Details: err.Error(),
-		})
+		response.Error(c, err)
+		return
+	}
+
+	if !h.canonicalizeTranscriptLang(c, &req.Language) {
+		return
+	}
+
+	if req.To > 0 && req.From >= req.To {
+		response.ErrorMessage(c, http.StatusBadRequest, "'from' must be before 'to'")
 		return
 	}
 
-	// URL decode the video URL
-	decodedURL, err := url.QueryUnescape(req.VideoURL)
+	// Decode the video URL (base64url, percent-encoded, or raw)
+	decodedURL, err := DecodeVideoURL(req.VideoURL)
 	if err != nil {
 		h.logger.Error("Failed to decode URL", zap.String("url", req.VideoURL), zap.Error(err))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid video URL format",
-			Details: err.Error(),
-		})
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL format", err.Error())
 		return
 	}
 
+	// Raw payloads and provider overrides are admin-only debugging aids
+	isAdmin := false
+	if userCtx, err := internalTypes.GetUserContext(c); err == nil {
+		isAdmin = userCtx.IsAdmin()
+	}
+	forceProvider := ""
+	if isAdmin {
+		forceProvider = providerOverride(c, req.ProviderOverride)
+	}
+
 	// Create transcript request
 	transcriptReq := &types.TranscriptRequest{
-		VideoURL: decodedURL,
-		Language: req.Language,
+		VideoURL:          decodedURL,
+		Language:          req.Language,
+		IncludeRaw:        req.DebugRaw && isAdmin,
+		OnLanguageMissing: types.LanguageMissingPolicy(req.OnLanguageMissing),
+		RestoreFormatting: req.RestoreFormatting,
+		Granularity:       types.TranscriptGranularity(req.Granularity),
+		Segmentation:      types.TranscriptSegmentation(req.Segmentation),
+		From:              time.Duration(req.From * float64(time.Second)),
+		To:                time.Duration(req.To * float64(time.Second)),
+		Offset:            req.Offset,
+		Limit:             req.Limit,
+		ForceProvider:     forceProvider,
 	}
 
 	// Get transcript using our new transcript service
+	fetchStart := time.Now()
 	transcript, err := h.transcriptService.GetTranscript(c.Request.Context(), transcriptReq)
 	if err != nil {
 		h.logger.Error("Failed to get transcript",
 			zap.String("video_url", decodedURL),
 			zap.String("language", req.Language),
 			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "Failed to retrieve transcript",
-			Details: err.Error(),
-		})
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to retrieve transcript", err.Error())
 		return
 	}
+	response.DebugHeaders(c, transcript.Provider, transcript.FromCache, time.Since(fetchStart))
 
 	// Convert to response format
 	var segments []dto.TranscriptSegmentResponse
 	for i, segment := range transcript.Segments {
 		segmentResponse := dto.ConvertFromTranscriptServiceSegment(segment)
-		segmentResponse.Index = i + 1 // Set proper index
+		segmentResponse.Index = req.Offset + i + 1 // Index within the (range-filtered) transcript
 		segments = append(segments, segmentResponse)
 	}
 
 	response := dto.GetTranscriptResponse{
-		VideoID:   transcript.VideoID,
-		Provider:  internalTypes.VideoProvider(transcript.Provider),
-		Language:  transcript.Language,
-		Segments:  segments,
-		Available: true, // If we got here, transcript is available
-		Source:    transcript.Provider,
+		VideoID:          transcript.VideoID,
+		Provider:         internalTypes.VideoProvider(transcript.Provider),
+		Language:         transcript.Language,
+		Segments:         segments,
+		Available:        true, // If we got here, transcript is available
+		Source:           transcript.Provider,
+		LanguageFallback: transcript.LanguageFallback,
+		TranslatedFrom:   transcript.TranslatedFrom,
+		Stale:            transcript.Stale,
+		TotalSegments:    transcript.TotalSegments,
+	}
+
+	if isAdmin && req.DebugRaw && len(transcript.RawContent) > 0 {
+		response.Raw = base64.StdEncoding.EncodeToString(transcript.RawContent)
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
+// providerOverride returns the forced provider for a request, preferring
+// the X-Provider-Override header over the query parameter
+func providerOverride(c *gin.Context, queryValue string) string {
+	if header := strings.TrimSpace(c.GetHeader("X-Provider-Override")); header != "" {
+		return header
+	}
+	return strings.TrimSpace(queryValue)
+}
+
+// Default and maximum page sizes for dual-language transcript segments
+const (
+	defaultDualPageSize = 100
+	maxDualPageSize     = 500
+)
 
-// GetAvailableLanguages returns available transcript languages for a video
-func (h *Handler) GetAvailableLanguages(c *gin.Context) {
-	var req dto.GetAvailableLanguagesRequest
-	if err := c.ShouldBindUri(&req); err != nil {
+// GetDualTranscript returns the original and translated transcript segments
+// in one call, paginated
+func (h *Handler) GetDualTranscript(c *gin.Context) {
+	var req dto.GetDualTranscriptRequest
+	if err := validation.BindURI(c, &req); err != nil {
 		h.logger.Error("Invalid request", zap.Error(err))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid video URL",
-			Details: err.Error(),
-		})
+		response.Error(c, err)
 		return
 	}
 
-	// URL decode the video URL
-	decodedURL, err := url.QueryUnescape(req.VideoURL)
+	if err := validation.BindQuery(c, &req); err != nil {
+		h.logger.Error("Invalid query parameters", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	if req.TargetLang == "" {
+		response.ErrorMessage(c, http.StatusBadRequest, "targetLang query parameter is required")
+		return
+	}
+
+	// The translation target must be a supported language; the source side
+	// follows the transcript and is only canonicalized
+	if h.languagesService != nil {
+		normalized, err := h.languagesService.Normalize(req.TargetLang)
+		if err != nil {
+			response.Error(c, err)
+			return
+		}
+		req.TargetLang = normalized
+	}
+	if !h.canonicalizeTranscriptLang(c, &req.SourceLang) {
+		return
+	}
+
+	// Decode the video URL (base64url, percent-encoded, or raw)
+	decodedURL, err := DecodeVideoURL(req.VideoURL)
 	if err != nil {
 		h.logger.Error("Failed to decode URL", zap.String("url", req.VideoURL), zap.Error(err))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid video URL format",
-			Details: err.Error(),
-		})
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL format", err.Error())
 		return
 	}
 
@@ -224,31 +348,193 @@ func (h *Handler) GetAvailableLanguages(c *gin.Context) {
 	provider, videoID, err := h.videoService.DetectProvider(decodedURL)
 	if err != nil {
 		h.logger.Error("Failed to detect provider", zap.String("url", decodedURL), zap.Error(err))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Unsupported video provider or invalid URL",
-			Details: err.Error(),
-		})
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Unsupported video provider or invalid URL", err.Error())
 		return
 	}
 
-	// Get available languages
-	languages, err := h.videoService.GetAvailableLanguages(c.Request.Context(), provider, videoID)
+	// An authenticated owner's corrections feed the translation, so fixes
+	// to auto captions carry through to the translated text
+	var corrections map[int]string
+	if userCtx, ctxErr := internalTypes.GetUserContext(c); ctxErr == nil && h.correctionService != nil {
+		if texts, _, corrErr := h.correctionService.CorrectionsFor(userCtx.UserID, videoID); corrErr == nil {
+			corrections = texts
+		} else {
+			h.logger.Warn("Failed to load transcript corrections",
+				zap.String("videoID", videoID),
+				zap.Error(corrErr))
+		}
+	}
+
+	dual, err := h.videoService.GetDualLanguageTranscript(c.Request.Context(), provider, videoID, req.SourceLang, req.TargetLang, corrections)
 	if err != nil {
-		h.logger.Error("Failed to get available languages",
-			zap.String("provider", string(provider)),
+		h.logger.Error("Failed to get dual-language transcript",
 			zap.String("videoID", videoID),
+			zap.String("targetLang", req.TargetLang),
 			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "Failed to retrieve available languages",
-			Details: err.Error(),
-		})
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to retrieve dual-language transcript", err.Error())
 		return
 	}
 
-	// Convert to response format
+	segments := pairDualSegments(dual)
+	page, metadata := paginateDualSegments(segments, req.Page, req.PageSize)
+
+	c.JSON(http.StatusOK, dto.GetDualTranscriptResponse{
+		VideoID:    dual.VideoID,
+		Provider:   dual.Provider,
+		SourceLang: dual.SourceLang,
+		TargetLang: dual.TargetLang,
+		Segments:   page,
+		Pagination: metadata,
+		Cached:     dual.Cached,
+	})
+}
+
+// pairDualSegments pairs each original segment with its translation
+func pairDualSegments(dual *internalTypes.DualLanguageTranscript) []dto.DualTranscriptSegmentResponse {
+	segments := make([]dto.DualTranscriptSegmentResponse, len(dual.Segments))
+	for i, segment := range dual.Segments {
+		segments[i] = dto.DualTranscriptSegmentResponse{
+			StartTime: segment.StartTime,
+			EndTime:   segment.EndTime,
+			Index:     i + 1,
+			Text:      segment.Text,
+		}
+		if i < len(dual.Translations) {
+			segments[i].TranslatedText = dual.Translations[i].TranslatedText
+		}
+	}
+	return segments
+}
+
+// paginateDualSegments slices the paired segments into the requested page and
+// builds the accompanying pagination metadata
+func paginateDualSegments(segments []dto.DualTranscriptSegmentResponse, page, pageSize int) ([]dto.DualTranscriptSegmentResponse, internalTypes.PaginationMetadata) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultDualPageSize
+	}
+	if pageSize > maxDualPageSize {
+		pageSize = maxDualPageSize
+	}
+
+	total := len(segments)
+	totalPages := (total + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	start := (page - 1) * pageSize
+	if start > total {
+		start = total
+	}
+	end := start + pageSize
+	if end > total {
+		end = total
+	}
+
+	return segments[start:end], internalTypes.PaginationMetadata{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalPages:   totalPages,
+		TotalRecords: int64(total),
+		HasNext:      page < totalPages,
+		HasPrev:      page > 1,
+	}
+}
+
+// InvalidateTranscriptCache drops all cached transcripts for a video so the
+// next request re-fetches from the providers
+func (h *Handler) InvalidateTranscriptCache(c *gin.Context) {
+	var req dto.VideoInfoRequest
+	if err := validation.BindURI(c, &req); err != nil {
+		h.logger.Error("Invalid request", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	// Decode the video URL (base64url, percent-encoded, or raw)
+	decodedURL, err := DecodeVideoURL(req.VideoURL)
+	if err != nil {
+		h.logger.Error("Failed to decode URL", zap.String("url", req.VideoURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL format", err.Error())
+		return
+	}
+
+	// Detect provider and extract video ID
+	_, videoID, err := h.videoService.DetectProvider(decodedURL)
+	if err != nil {
+		h.logger.Error("Failed to detect provider", zap.String("url", decodedURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Unsupported video provider or invalid URL", err.Error())
+		return
+	}
+
+	if err := h.transcriptService.InvalidateTranscriptCache(c.Request.Context(), videoID); err != nil {
+		h.logger.Error("Failed to invalidate transcript cache",
+			zap.String("videoID", videoID),
+			zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to invalidate transcript cache", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Transcript cache invalidated",
+		"videoId": videoID,
+	})
+}
+
+// GetAvailableLanguages returns available transcript languages for a video
+func (h *Handler) GetAvailableLanguages(c *gin.Context) {
+	var req dto.GetAvailableLanguagesRequest
+	if err := validation.BindURI(c, &req); err != nil {
+		h.logger.Error("Invalid request", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	// Decode the video URL (base64url, percent-encoded, or raw)
+	decodedURL, err := DecodeVideoURL(req.VideoURL)
+	if err != nil {
+		h.logger.Error("Failed to decode URL", zap.String("url", req.VideoURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL format", err.Error())
+		return
+	}
+
+	// Detect provider and extract video ID
+	provider, videoID, err := h.videoService.DetectProvider(decodedURL)
+	if err != nil {
+		h.logger.Error("Failed to detect provider", zap.String("url", decodedURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Unsupported video provider or invalid URL", err.Error())
+		return
+	}
+
+	// The transcript service merges caption tracks across its providers and
+	// does not need an API key; the per-provider video service listing
+	// remains a fallback when every transcript provider fails
 	var languageResponses []dto.LanguageResponse
-	for _, lang := range languages {
-		languageResponses = append(languageResponses, dto.ConvertToLanguageResponse(lang))
+	captionLanguages, err := h.transcriptService.GetAvailableLanguages(c.Request.Context(), videoID)
+	if err == nil {
+		for _, lang := range captionLanguages {
+			languageResponses = append(languageResponses, dto.ConvertFromCaptionLanguage(lang))
+		}
+	} else {
+		h.logger.Warn("Transcript service language listing failed, falling back to video provider",
+			zap.String("videoID", videoID),
+			zap.Error(err))
+
+		languages, fallbackErr := h.videoService.GetAvailableLanguages(c.Request.Context(), provider, videoID)
+		if fallbackErr != nil {
+			h.logger.Error("Failed to get available languages",
+				zap.String("provider", string(provider)),
+				zap.String("videoID", videoID),
+				zap.Error(fallbackErr))
+			response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to retrieve available languages", fallbackErr.Error())
+			return
+		}
+		for _, lang := range languages {
+			languageResponses = append(languageResponses, dto.ConvertToLanguageResponse(lang))
+		}
 	}
 
 	response := dto.GetAvailableLanguagesResponse{
@@ -263,23 +549,17 @@ func (h *Handler) GetAvailableLanguages(c *gin.Context) {
 // GetCapabilities returns capabilities for a video
 func (h *Handler) GetCapabilities(c *gin.Context) {
 	var req dto.GetAvailableLanguagesRequest
-	if err := c.ShouldBindUri(&req); err != nil {
+	if err := validation.BindURI(c, &req); err != nil {
 		h.logger.Error("Invalid request", zap.Error(err))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid video URL",
-			Details: err.Error(),
-		})
+		response.Error(c, err)
 		return
 	}
 
-	// URL decode the video URL
-	decodedURL, err := url.QueryUnescape(req.VideoURL)
+	// Decode the video URL (base64url, percent-encoded, or raw)
+	decodedURL, err := DecodeVideoURL(req.VideoURL)
 	if err != nil {
 		h.logger.Error("Failed to decode URL", zap.String("url", req.VideoURL), zap.Error(err))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid video URL format",
-			Details: err.Error(),
-		})
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL format", err.Error())
 		return
 	}
 
@@ -287,10 +567,7 @@ func (h *Handler) GetCapabilities(c *gin.Context) {
 	provider, videoID, err := h.videoService.DetectProvider(decodedURL)
 	if err != nil {
 		h.logger.Error("Failed to detect provider", zap.String("url", decodedURL), zap.Error(err))
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Unsupported video provider or invalid URL",
-			Details: err.Error(),
-		})
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Unsupported video provider or invalid URL", err.Error())
 		return
 	}
 
@@ -301,10 +578,7 @@ func (h *Handler) GetCapabilities(c *gin.Context) {
 			zap.String("provider", string(provider)),
 			zap.String("videoID", videoID),
 			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "Failed to retrieve video capabilities",
-			Details: err.Error(),
-		})
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to retrieve video capabilities", err.Error())
 		return
 	}
 
@@ -315,7 +589,7 @@ func (h *Handler) GetCapabilities(c *gin.Context) {
 // GetSupportedProviders returns list of supported video providers
 func (h *Handler) GetSupportedProviders(c *gin.Context) {
 	providers := h.videoService.GetSupportedProviders()
-	
+
 	response := dto.GetSupportedProvidersResponse{
 		Providers: providers,
 	}
@@ -326,7 +600,7 @@ func (h *Handler) GetSupportedProviders(c *gin.Context) {
 // GetSupportedLanguages returns list of supported translation languages
 func (h *Handler) GetSupportedLanguages(c *gin.Context) {
 	languages := h.videoService.GetSupportedLanguages()
-	
+
 	var languageResponses []dto.LanguageResponse
 	for _, lang := range languages {
 		languageResponses = append(languageResponses, dto.ConvertToLanguageResponse(lang))
@@ -337,4 +611,4 @@ func (h *Handler) GetSupportedLanguages(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, response)
-}
\ No newline at end of file
+}
@@ -6,20 +6,51 @@ import "github.com/gin-gonic/gin"
 type HandlerInterface interface {
 	// GetVideoInfo retrieves basic information about a video
 	GetVideoInfo(c *gin.Context)
-	
+
+	// ResolveVideo parses a video URL and reports its provider, ID, and kind
+	ResolveVideo(c *gin.Context)
+
 	// GetTranscript retrieves transcript for a video
 	GetTranscript(c *gin.Context)
-	
-	
+
+	// GetDualTranscript returns original and translated segments in one call
+	GetDualTranscript(c *gin.Context)
+
+	// ExportTranscript downloads a transcript as an SRT, WebVTT, JSON or
+	// plain text file
+	ExportTranscript(c *gin.Context)
+
+	// GetVideoSummary returns a chaptered, model-generated summary of a
+	// video's transcript
+	GetVideoSummary(c *gin.Context)
+
+	// GetChapters returns a video's chapter list for player navigation,
+	// from description markers or transcript inference
+	GetChapters(c *gin.Context)
+
+	// GetKeyPhrases extracts high-value vocabulary and collocations from a
+	// video's transcript
+	GetKeyPhrases(c *gin.Context)
+
+	// CreateShareLink issues a signed, expiring URL for unauthenticated
+	// read-only access to a video's dual-language transcript
+	CreateShareLink(c *gin.Context)
+
+	// GetSharedTranscript serves the transcript behind a share link
+	GetSharedTranscript(c *gin.Context)
+
+	// InvalidateTranscriptCache drops all cached transcripts for a video
+	InvalidateTranscriptCache(c *gin.Context)
+
 	// GetAvailableLanguages returns available transcript languages for a video
 	GetAvailableLanguages(c *gin.Context)
-	
+
 	// GetCapabilities returns capabilities for a video
 	GetCapabilities(c *gin.Context)
-	
+
 	// GetSupportedProviders returns list of supported video providers
 	GetSupportedProviders(c *gin.Context)
-	
+
 	// GetSupportedLanguages returns list of supported translation languages
 	GetSupportedLanguages(c *gin.Context)
-}
\ No newline at end of file
+}
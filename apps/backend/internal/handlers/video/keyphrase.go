@@ -0,0 +1,73 @@
+package video
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/response"
+	"app-backend/internal/services/transcript/types"
+	"app-backend/internal/validation"
+)
+
+// GetKeyPhrases extracts high-value vocabulary and collocations from a
+// video's transcript, scored by frequency and rarity. Candidates carry
+// first-occurrence timestamps and sentences so they map straight onto new
+// vocabulary items.
+func (h *Handler) GetKeyPhrases(c *gin.Context) {
+	var req dto.GetKeyPhrasesRequest
+	if err := validation.BindURI(c, &req); err != nil {
+		h.logger.Error("Invalid request", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+	if err := validation.BindQuery(c, &req); err != nil {
+		h.logger.Error("Invalid query parameters", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	if !h.canonicalizeTranscriptLang(c, &req.Language) {
+		return
+	}
+
+	// Decode the video URL (base64url, percent-encoded, or raw)
+	decodedURL, err := DecodeVideoURL(req.VideoURL)
+	if err != nil {
+		h.logger.Error("Failed to decode URL", zap.String("url", req.VideoURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL format", err.Error())
+		return
+	}
+
+	// Detect provider and extract video ID
+	provider, videoID, err := h.videoService.DetectProvider(decodedURL)
+	if err != nil {
+		h.logger.Error("Failed to detect provider", zap.String("url", decodedURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Unsupported video provider or invalid URL", err.Error())
+		return
+	}
+
+	transcript, err := h.transcriptService.GetTranscript(c.Request.Context(), &types.TranscriptRequest{
+		VideoURL: decodedURL,
+		Language: req.Language,
+	})
+	if err != nil {
+		h.logger.Error("Failed to get transcript for key phrases",
+			zap.String("video_url", decodedURL),
+			zap.String("language", req.Language),
+			zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to retrieve transcript", err.Error())
+		return
+	}
+
+	phrases := h.keyphraseService.Extract(transcript.Segments, req.Limit)
+
+	c.JSON(http.StatusOK, dto.GetKeyPhrasesResponse{
+		VideoID:  videoID,
+		Provider: provider,
+		Language: transcript.Language,
+		Phrases:  phrases,
+	})
+}
@@ -0,0 +1,140 @@
+package video
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/response"
+	"app-backend/internal/services/share"
+	"app-backend/internal/validation"
+)
+
+// CreateShareLink issues a signed, expiring URL that lets unauthenticated
+// visitors read this video's dual-language transcript. The token carries the
+// video and language choice; nothing about the owner's account leaks into it.
+func (h *Handler) CreateShareLink(c *gin.Context) {
+	if h.shareService == nil {
+		response.ServiceUnavailable(c, "Transcript sharing is not available")
+		return
+	}
+
+	var uri dto.VideoInfoRequest
+	if err := validation.BindURI(c, &uri); err != nil {
+		h.logger.Error("Invalid request", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+	var req dto.CreateShareLinkRequest
+	if err := validation.BindJSON(c, &req); err != nil {
+		h.logger.Error("Invalid share link request", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	// Decode the video URL (base64url, percent-encoded, or raw)
+	decodedURL, err := DecodeVideoURL(uri.VideoURL)
+	if err != nil {
+		h.logger.Error("Failed to decode URL", zap.String("url", uri.VideoURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL format", err.Error())
+		return
+	}
+
+	// Detect provider and extract video ID
+	provider, videoID, err := h.videoService.DetectProvider(decodedURL)
+	if err != nil {
+		h.logger.Error("Failed to detect provider", zap.String("url", decodedURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Unsupported video provider or invalid URL", err.Error())
+		return
+	}
+
+	// The translation target must be a supported language; the source side
+	// follows the transcript and is only canonicalized
+	if h.languagesService != nil {
+		normalized, err := h.languagesService.Normalize(req.TargetLang)
+		if err != nil {
+			response.Error(c, err)
+			return
+		}
+		req.TargetLang = normalized
+	}
+	if !h.canonicalizeTranscriptLang(c, &req.SourceLang) {
+		return
+	}
+
+	token, expiresAt, err := h.shareService.CreateLink(share.CreateLinkParams{
+		Provider:   provider,
+		VideoID:    videoID,
+		SourceLang: req.SourceLang,
+		TargetLang: req.TargetLang,
+		TTL:        time.Duration(req.ExpiresInHours) * time.Hour,
+		Password:   req.Password,
+	})
+	if err != nil {
+		h.logger.Error("Failed to create share link", zap.String("videoID", videoID), zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ShareLinkResponse{
+		Token:     token,
+		URL:       "/api/v1/share/" + token,
+		ExpiresAt: expiresAt,
+	})
+}
+
+// GetSharedTranscript serves the read-only dual-language transcript behind a
+// share link. No authentication is required; the signed token alone decides
+// what is visible.
+func (h *Handler) GetSharedTranscript(c *gin.Context) {
+	if h.shareService == nil {
+		response.ServiceUnavailable(c, "Transcript sharing is not available")
+		return
+	}
+
+	var req dto.GetSharedTranscriptRequest
+	if err := validation.BindURI(c, &req); err != nil {
+		h.logger.Error("Invalid request", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+	if err := validation.BindQuery(c, &req); err != nil {
+		h.logger.Error("Invalid query parameters", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	payload, err := h.shareService.ResolveToken(req.Token, req.Password)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	// Shared transcripts never carry the owner's corrections; the page is a
+	// plain read-only view of the captions and their translation
+	dual, err := h.videoService.GetDualLanguageTranscript(c.Request.Context(), payload.Provider, payload.VideoID, payload.SourceLang, payload.TargetLang, nil)
+	if err != nil {
+		h.logger.Error("Failed to get shared transcript",
+			zap.String("videoID", payload.VideoID),
+			zap.String("targetLang", payload.TargetLang),
+			zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to retrieve dual-language transcript", err.Error())
+		return
+	}
+
+	segments := pairDualSegments(dual)
+	page, metadata := paginateDualSegments(segments, req.Page, req.PageSize)
+
+	c.JSON(http.StatusOK, dto.GetDualTranscriptResponse{
+		VideoID:    dual.VideoID,
+		Provider:   dual.Provider,
+		SourceLang: dual.SourceLang,
+		TargetLang: dual.TargetLang,
+		Segments:   page,
+		Pagination: metadata,
+		Cached:     dual.Cached,
+	})
+}
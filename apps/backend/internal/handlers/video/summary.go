@@ -0,0 +1,87 @@
+package video
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/response"
+	"app-backend/internal/services/transcript/types"
+	internalTypes "app-backend/internal/types"
+	"app-backend/internal/validation"
+)
+
+// GetVideoSummary returns a chaptered, model-generated summary of a video's
+// transcript with main ideas and key vocabulary. Summaries are cached per
+// video, language, and depth; fresh model calls are rate limited per user.
+func (h *Handler) GetVideoSummary(c *gin.Context) {
+	if h.summaryService == nil {
+		response.ServiceUnavailable(c, "Transcript summaries are not available")
+		return
+	}
+
+	userCtx, err := internalTypes.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.GetVideoSummaryRequest
+	if err := validation.BindURI(c, &req); err != nil {
+		h.logger.Error("Invalid request", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+	if err := validation.BindQuery(c, &req); err != nil {
+		h.logger.Error("Invalid query parameters", zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	if !h.canonicalizeTranscriptLang(c, &req.Language) {
+		return
+	}
+
+	// Decode the video URL (base64url, percent-encoded, or raw)
+	decodedURL, err := DecodeVideoURL(req.VideoURL)
+	if err != nil {
+		h.logger.Error("Failed to decode URL", zap.String("url", req.VideoURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid video URL format", err.Error())
+		return
+	}
+
+	// Detect provider and extract video ID
+	provider, videoID, err := h.videoService.DetectProvider(decodedURL)
+	if err != nil {
+		h.logger.Error("Failed to detect provider", zap.String("url", decodedURL), zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Unsupported video provider or invalid URL", err.Error())
+		return
+	}
+
+	transcript, err := h.transcriptService.GetTranscript(c.Request.Context(), &types.TranscriptRequest{
+		VideoURL: decodedURL,
+		Language: req.Language,
+	})
+	if err != nil {
+		h.logger.Error("Failed to get transcript for summary",
+			zap.String("video_url", decodedURL),
+			zap.String("language", req.Language),
+			zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusInternalServerError, "Failed to retrieve transcript", err.Error())
+		return
+	}
+
+	summary, err := h.summaryService.Summarize(c.Request.Context(), userCtx.UserID, provider, videoID, transcript.Language, req.Depth, transcript.Segments)
+	if err != nil {
+		h.logger.Error("Failed to summarize transcript",
+			zap.Uint("user_id", userCtx.UserID),
+			zap.String("video_id", videoID),
+			zap.Error(err))
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
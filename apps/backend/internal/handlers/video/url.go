@@ -0,0 +1,43 @@
+package video
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// DecodeVideoURL resolves the videoUrl path parameter. Clients may send the
+// URL base64url-encoded (the documented form), percent-encoded, or raw;
+// the encoding is auto-detected. Malformed input returns an error so the
+// handler can answer 400.
+func DecodeVideoURL(raw string) (string, error) {
+	if raw == "" {
+		return "", fmt.Errorf("video URL is empty")
+	}
+
+	// Try base64url first, accepting it only when the decoded value is
+	// itself a URL so plain video IDs that happen to be valid base64 are
+	// not mangled
+	for _, encoding := range []*base64.Encoding{base64.URLEncoding, base64.RawURLEncoding} {
+		if decoded, err := encoding.DecodeString(raw); err == nil {
+			if candidate := string(decoded); utf8.ValidString(candidate) && looksLikeVideoURL(candidate) {
+				return candidate, nil
+			}
+		}
+	}
+
+	// Fall back to the raw, possibly percent-encoded form
+	decoded, err := url.QueryUnescape(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid video URL encoding: %w", err)
+	}
+	return decoded, nil
+}
+
+// looksLikeVideoURL reports whether a decoded value is plausibly a video URL
+// rather than the byte noise a non-base64 input decodes to
+func looksLikeVideoURL(s string) bool {
+	return strings.Contains(s, "://") || strings.HasPrefix(s, "www.") || strings.HasPrefix(s, "youtu")
+}
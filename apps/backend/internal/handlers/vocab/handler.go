@@ -0,0 +1,298 @@
+package vocab
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	"app-backend/internal/response"
+	"app-backend/internal/services/realtime"
+	"app-backend/internal/services/vocab"
+	"app-backend/internal/types"
+)
+
+type Handler struct {
+	vocabService vocab.ServiceInterface
+	publisher    realtime.PublisherInterface
+	logger       *logger.Logger
+}
+
+// NewVocabHandler creates a new vocabulary handler. The publisher may be
+// nil, which disables session sync notifications for saves.
+func NewVocabHandler(vocabService vocab.ServiceInterface, publisher realtime.PublisherInterface, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		vocabService: vocabService,
+		publisher:    publisher,
+		logger:       logger,
+	}
+}
+
+// notifySaved announces a saved vocabulary item to the user's other
+// connected clients so their word lists refresh without polling
+func (h *Handler) notifySaved(userID uint, item interface{}) {
+	if h.publisher == nil {
+		return
+	}
+
+	payload, err := json.Marshal(item)
+	if err != nil {
+		h.logger.Warn("Failed to marshal vocab sync payload", zap.Error(err))
+		return
+	}
+	h.publisher.Broadcast(userID, &realtime.Event{
+		Type:    realtime.EventVocabSaved,
+		Payload: payload,
+	})
+}
+
+// CreateItem saves a new vocabulary item for the authenticated user
+func (h *Handler) CreateItem(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.CreateVocabItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid vocabulary item request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	item, err := h.vocabService.CreateItem(userCtx.UserID, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to create vocabulary item", userCtx.UserID)
+		return
+	}
+
+	h.notifySaved(userCtx.UserID, item)
+	c.JSON(http.StatusCreated, item)
+}
+
+// GetItem returns one of the authenticated user's vocabulary items
+func (h *Handler) GetItem(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	itemID, ok := h.itemIDParam(c)
+	if !ok {
+		return
+	}
+
+	item, err := h.vocabService.GetItem(userCtx.UserID, itemID)
+	if err != nil {
+		h.respondError(c, err, "Failed to get vocabulary item", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+// ListItems returns the authenticated user's vocabulary items with pagination
+func (h *Handler) ListItems(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	sortBy := c.DefaultQuery("sort_by", "created_at")
+	sortDir := c.DefaultQuery("sort_dir", "desc")
+	search := c.Query("search")
+
+	pagReq := &types.PaginationRequest{
+		Page:     page,
+		PageSize: pageSize,
+		SortBy:   sortBy,
+		SortDir:  sortDir,
+		Search:   search,
+	}
+
+	items, err := h.vocabService.ListItems(userCtx.UserID, pagReq)
+	if err != nil {
+		h.respondError(c, err, "Failed to list vocabulary items", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, items)
+}
+
+// UpdateItem edits the content of one of the authenticated user's items
+func (h *Handler) UpdateItem(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	itemID, ok := h.itemIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateVocabItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid vocabulary update request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	item, err := h.vocabService.UpdateItem(userCtx.UserID, itemID, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to update vocabulary item", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+// DeleteItem removes one of the authenticated user's items
+func (h *Handler) DeleteItem(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	itemID, ok := h.itemIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.vocabService.DeleteItem(userCtx.UserID, itemID); err != nil {
+		h.respondError(c, err, "Failed to delete vocabulary item", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Vocabulary item deleted successfully"})
+}
+
+// GetReviewQueue returns the authenticated user's items due for review
+func (h *Handler) GetReviewQueue(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "0"))
+
+	queue, err := h.vocabService.GetReviewQueue(userCtx.UserID, limit)
+	if err != nil {
+		h.respondError(c, err, "Failed to load review queue", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, queue)
+}
+
+// ReviewItem grades a review and returns the rescheduled item
+func (h *Handler) ReviewItem(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	itemID, ok := h.itemIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ReviewVocabItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid review request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	item, err := h.vocabService.ReviewItem(userCtx.UserID, itemID, *req.Quality)
+	if err != nil {
+		h.respondError(c, err, "Failed to record review", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+// maxImportBodyBytes caps the size of an import payload
+const maxImportBodyBytes = 1 << 20 // 1 MiB
+
+// ExportItems serves the user's whole vocabulary as a CSV or Anki-compatible
+// TSV download
+func (h *Handler) ExportItems(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	format := c.DefaultQuery("format", vocab.FormatCSV)
+	file, err := h.vocabService.ExportItems(userCtx.UserID, format)
+	if err != nil {
+		h.respondError(c, err, "Failed to export vocabulary", userCtx.UserID)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+file.FileName+`"`)
+	c.Data(http.StatusOK, file.ContentType, file.Data)
+}
+
+// ImportItems bulk-imports vocabulary from a CSV or TSV request body,
+// skipping words the user already saved
+func (h *Handler) ImportItems(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxImportBodyBytes+1))
+	if err != nil {
+		response.ErrorMessage(c, http.StatusBadRequest, "Failed to read import payload")
+		return
+	}
+	if len(body) > maxImportBodyBytes {
+		response.ErrorMessage(c, http.StatusRequestEntityTooLarge, "Import payload exceeds 1 MiB")
+		return
+	}
+
+	result, err := h.vocabService.ImportItems(userCtx.UserID, body)
+	if err != nil {
+		h.respondError(c, err, "Failed to import vocabulary", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// itemIDParam parses the :itemId path parameter, responding with 400 on
+// malformed input
+func (h *Handler) itemIDParam(c *gin.Context) (uint, bool) {
+	itemID, err := strconv.ParseUint(c.Param("itemId"), 10, 32)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusBadRequest, "Invalid vocabulary item ID")
+		return 0, false
+	}
+	return uint(itemID), true
+}
+
+// respondError maps service errors to HTTP responses with the shared
+// AppError status convention
+func (h *Handler) respondError(c *gin.Context, err error, logMsg string, userID uint) {
+	h.logger.Error(logMsg, zap.Error(err), zap.Uint("user_id", userID))
+	response.Error(c, err)
+}
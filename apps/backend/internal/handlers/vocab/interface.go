@@ -0,0 +1,16 @@
+package vocab
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for vocabulary handlers
+type HandlerInterface interface {
+	CreateItem(c *gin.Context)
+	GetItem(c *gin.Context)
+	ListItems(c *gin.Context)
+	UpdateItem(c *gin.Context)
+	DeleteItem(c *gin.Context)
+	GetReviewQueue(c *gin.Context)
+	ReviewItem(c *gin.Context)
+	ExportItems(c *gin.Context)
+	ImportItems(c *gin.Context)
+}
@@ -0,0 +1,290 @@
+package workspace
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	"app-backend/internal/middleware"
+	"app-backend/internal/models"
+	"app-backend/internal/response"
+	"app-backend/internal/services/workspace"
+	"app-backend/internal/types"
+)
+
+type Handler struct {
+	workspaceService workspace.ServiceInterface
+	logger           *logger.Logger
+}
+
+// NewWorkspaceHandler creates a new workspace handler
+func NewWorkspaceHandler(workspaceService workspace.ServiceInterface, logger *logger.Logger) HandlerInterface {
+	return &Handler{
+		workspaceService: workspaceService,
+		logger:           logger,
+	}
+}
+
+// CreateWorkspace creates a workspace owned by the authenticated user
+func (h *Handler) CreateWorkspace(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.CreateWorkspaceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid workspace request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	workspace, err := h.workspaceService.CreateWorkspace(userCtx.UserID, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to create workspace", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusCreated, workspace)
+}
+
+// ListWorkspaces returns the workspaces the authenticated user belongs to
+func (h *Handler) ListWorkspaces(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	workspaces, err := h.workspaceService.ListWorkspaces(userCtx.UserID)
+	if err != nil {
+		h.respondError(c, err, "Failed to list workspaces", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, workspaces)
+}
+
+// GetWorkspace returns the workspace with the requester's role
+func (h *Handler) GetWorkspace(c *gin.Context) {
+	member, ok := h.membership(c)
+	if !ok {
+		return
+	}
+
+	workspace, err := h.workspaceService.GetWorkspace(member)
+	if err != nil {
+		h.respondError(c, err, "Failed to get workspace", member.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, workspace)
+}
+
+// ListMembers returns the workspace's member list
+func (h *Handler) ListMembers(c *gin.Context) {
+	member, ok := h.membership(c)
+	if !ok {
+		return
+	}
+
+	members, err := h.workspaceService.ListMembers(member.WorkspaceID)
+	if err != nil {
+		h.respondError(c, err, "Failed to list workspace members", member.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, members)
+}
+
+// InviteMember creates an email invitation into the workspace
+func (h *Handler) InviteMember(c *gin.Context) {
+	member, ok := h.membership(c)
+	if !ok {
+		return
+	}
+
+	var req dto.InviteWorkspaceMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid workspace invite request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	invite, err := h.workspaceService.InviteMember(member, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to create workspace invitation", member.UserID)
+		return
+	}
+
+	c.JSON(http.StatusCreated, invite)
+}
+
+// AcceptInvite joins the authenticated user to the workspace named by the
+// invitation token
+func (h *Handler) AcceptInvite(c *gin.Context) {
+	userCtx, err := types.GetUserContext(c)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req dto.AcceptWorkspaceInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid invite acceptance request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	workspace, err := h.workspaceService.AcceptInvite(userCtx.UserID, userCtx.Email, req.Token)
+	if err != nil {
+		h.respondError(c, err, "Failed to accept workspace invitation", userCtx.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, workspace)
+}
+
+// RemoveMember removes a member from the workspace
+func (h *Handler) RemoveMember(c *gin.Context) {
+	member, ok := h.membership(c)
+	if !ok {
+		return
+	}
+
+	memberUserID, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusBadRequest, "Invalid member user ID")
+		return
+	}
+
+	if err := h.workspaceService.RemoveMember(member, uint(memberUserID)); err != nil {
+		h.respondError(c, err, "Failed to remove workspace member", member.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Workspace member removed successfully"})
+}
+
+// ShareVideo adds a video to the workspace's curated list
+func (h *Handler) ShareVideo(c *gin.Context) {
+	member, ok := h.membership(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ShareWorkspaceVideoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid workspace video request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	video, err := h.workspaceService.ShareVideo(member, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to share video", member.UserID)
+		return
+	}
+
+	c.JSON(http.StatusCreated, video)
+}
+
+// ListVideos returns the workspace's curated video list
+func (h *Handler) ListVideos(c *gin.Context) {
+	member, ok := h.membership(c)
+	if !ok {
+		return
+	}
+
+	videos, err := h.workspaceService.ListVideos(member.WorkspaceID)
+	if err != nil {
+		h.respondError(c, err, "Failed to list workspace videos", member.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, videos)
+}
+
+// RemoveVideo removes an entry from the curated video list
+func (h *Handler) RemoveVideo(c *gin.Context) {
+	member, ok := h.membership(c)
+	if !ok {
+		return
+	}
+
+	entryID, err := strconv.ParseUint(c.Param("videoId"), 10, 32)
+	if err != nil {
+		response.ErrorMessage(c, http.StatusBadRequest, "Invalid workspace video ID")
+		return
+	}
+
+	if err := h.workspaceService.RemoveVideo(member.WorkspaceID, uint(entryID)); err != nil {
+		h.respondError(c, err, "Failed to remove workspace video", member.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Workspace video removed successfully"})
+}
+
+// ShareVocab copies the requester's vocabulary items into the workspace deck
+func (h *Handler) ShareVocab(c *gin.Context) {
+	member, ok := h.membership(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ShareWorkspaceVocabRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid workspace vocabulary request", zap.Error(err))
+		response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	result, err := h.workspaceService.ShareVocab(member, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to share vocabulary", member.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ListVocab returns the workspace's shared vocabulary deck
+func (h *Handler) ListVocab(c *gin.Context) {
+	member, ok := h.membership(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.workspaceService.ListVocab(member.WorkspaceID)
+	if err != nil {
+		h.respondError(c, err, "Failed to list workspace vocabulary", member.UserID)
+		return
+	}
+
+	c.JSON(http.StatusOK, items)
+}
+
+// membership fetches the membership resolved by the workspace authorization
+// middleware, responding with 500 if the route is miswired
+func (h *Handler) membership(c *gin.Context) (*models.WorkspaceMember, bool) {
+	member, err := middleware.GetWorkspaceMembership(c)
+	if err != nil {
+		h.logger.Error("Workspace membership missing from context", zap.Error(err))
+		response.Error(c, err)
+		return nil, false
+	}
+	return member, true
+}
+
+// respondError maps service errors to HTTP responses with the shared
+// AppError status convention
+func (h *Handler) respondError(c *gin.Context, err error, logMsg string, userID uint) {
+	h.logger.Error(logMsg, zap.Error(err), zap.Uint("user_id", userID))
+	response.Error(c, err)
+}
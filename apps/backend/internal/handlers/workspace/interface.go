@@ -0,0 +1,19 @@
+package workspace
+
+import "github.com/gin-gonic/gin"
+
+// HandlerInterface defines the contract for workspace handlers
+type HandlerInterface interface {
+	CreateWorkspace(c *gin.Context)
+	ListWorkspaces(c *gin.Context)
+	GetWorkspace(c *gin.Context)
+	ListMembers(c *gin.Context)
+	InviteMember(c *gin.Context)
+	AcceptInvite(c *gin.Context)
+	RemoveMember(c *gin.Context)
+	ShareVideo(c *gin.Context)
+	ListVideos(c *gin.Context)
+	RemoveVideo(c *gin.Context)
+	ShareVocab(c *gin.Context)
+	ListVocab(c *gin.Context)
+}
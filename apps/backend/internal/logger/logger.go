@@ -12,33 +12,39 @@ import (
 type Logger struct {
 	zap  *zap.Logger
 	slog *slog.Logger
+	// level is the shared atomic level behind the zap logger, kept so the
+	// log level can be changed at runtime
+	level zap.AtomicLevel
 }
 
 func New(environment string) (*Logger, error) {
 	var zapLogger *zap.Logger
+	var level zap.AtomicLevel
 	var err error
 
 	// Configure based on environment
 	if environment == "production" {
 		config := zap.NewProductionConfig()
+		level = config.Level
 		config.EncoderConfig.TimeKey = "timestamp"
 		config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 		config.EncoderConfig.MessageKey = "message"
 		config.EncoderConfig.LevelKey = "level"
 		config.EncoderConfig.CallerKey = "caller"
 		config.EncoderConfig.StacktraceKey = "stacktrace"
-		
+
 		zapLogger, err = config.Build(
 			zap.AddCallerSkip(1),
 			zap.AddStacktrace(zapcore.ErrorLevel),
 		)
 	} else {
 		config := zap.NewDevelopmentConfig()
+		level = config.Level
 		config.EncoderConfig.TimeKey = "timestamp"
 		config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 		config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 		config.EncoderConfig.EncodeCaller = zapcore.ShortCallerEncoder
-		
+
 		zapLogger, err = config.Build(
 			zap.AddCallerSkip(1),
 			zap.AddStacktrace(zapcore.ErrorLevel),
@@ -53,12 +59,12 @@ func New(environment string) (*Logger, error) {
 	var slogHandler slog.Handler
 	if environment == "production" {
 		slogHandler = slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-			Level: slog.LevelInfo,
+			Level:     slog.LevelInfo,
 			AddSource: true,
 		})
 	} else {
 		slogHandler = slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-			Level: slog.LevelDebug,
+			Level:     slog.LevelDebug,
 			AddSource: true,
 		})
 	}
@@ -66,11 +72,23 @@ func New(environment string) (*Logger, error) {
 	slogLogger := slog.New(slogHandler)
 
 	return &Logger{
-		zap:  zapLogger,
-		slog: slogLogger,
+		zap:   zapLogger,
+		slog:  slogLogger,
+		level: level,
 	}, nil
 }
 
+// SetLevel changes the minimum level of the zap logger at runtime. Unknown
+// level names are rejected so a config typo cannot silence logging.
+func (l *Logger) SetLevel(levelName string) error {
+	parsed, err := zapcore.ParseLevel(levelName)
+	if err != nil {
+		return err
+	}
+	l.level.SetLevel(parsed)
+	return nil
+}
+
 // Zap returns the underlying zap logger
 func (l *Logger) Zap() *zap.Logger {
 	return l.zap
@@ -146,4 +164,4 @@ func (l *Logger) WithDuration(duration time.Duration) *Logger {
 
 func (l *Logger) WithError(err error) *Logger {
 	return l.With(zap.Error(err))
-}
\ No newline at end of file
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/services/apikey"
+	"app-backend/internal/types"
+)
+
+// APIKeyAuth authenticates requests via the X-API-Key header and sets the
+// user context for the key's owner. The scope names the endpoint group the
+// key must grant; keys without scopes grant everything.
+func APIKeyAuth(apiKeyService apikey.ServiceInterface, logger *logger.Logger, scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rawKey := c.GetHeader("X-API-Key")
+		if rawKey == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "X-API-Key header is required"})
+			c.Abort()
+			return
+		}
+
+		key, err := apiKeyService.Authenticate(rawKey, scope)
+		if err != nil {
+			if appErr, ok := err.(*errors.AppError); ok {
+				logger.Warn("API key authentication failed",
+					zap.String("scope", scope),
+					zap.Error(err))
+				c.JSON(appErr.Status, gin.H{"error": appErr.Message})
+				c.Abort()
+				return
+			}
+			logger.Error("Unexpected API key authentication error", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+			c.Abort()
+			return
+		}
+
+		types.SetUserContext(c, &types.UserContext{
+			UserID: key.UserID,
+			Email:  key.User.Email,
+			Role:   key.User.Role,
+		})
+
+		logger.Debug("API key authenticated",
+			zap.Uint("user_id", key.UserID),
+			zap.Uint("key_id", key.ID),
+			zap.String("scope", scope))
+		c.Next()
+	}
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/services/audit"
+)
+
+// auditEventsKey is the gin context key handlers queue events under
+const auditEventsKey = "audit_events"
+
+// MarkAuditEvent queues a security-relevant event on the current request.
+// The audit middleware persists it once the handler chain finishes, filling
+// in the request's IP address, user agent and request ID. Handlers only set
+// the semantic fields (action, actor, target, detail).
+func MarkAuditEvent(c *gin.Context, event audit.Event) {
+	events, _ := c.Get(auditEventsKey)
+	queued, _ := events.([]audit.Event)
+	c.Set(auditEventsKey, append(queued, event))
+}
+
+// Audit records events queued via MarkAuditEvent after each request. The
+// recorder may be nil, in which case marked events are dropped.
+func Audit(recorder audit.RecorderInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if recorder == nil {
+			return
+		}
+
+		events, exists := c.Get(auditEventsKey)
+		if !exists {
+			return
+		}
+		queued, ok := events.([]audit.Event)
+		if !ok {
+			return
+		}
+
+		ipAddress := c.ClientIP()
+		userAgent := c.GetHeader("User-Agent")
+		requestID := GetRequestID(c)
+
+		for i := range queued {
+			event := queued[i]
+			// Authenticated routes do not need to repeat the actor; fall
+			// back to the user the auth middleware resolved
+			if event.ActorID == 0 {
+				if userID, ok := c.Get("user_id"); ok {
+					if id, ok := userID.(uint); ok {
+						event.ActorID = id
+					}
+				}
+			}
+			event.IPAddress = ipAddress
+			event.UserAgent = userAgent
+			event.RequestID = requestID
+			recorder.Record(&event)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"app-backend/internal/errors"
 	"app-backend/internal/logger"
+	"app-backend/internal/services/apikey"
 	"app-backend/internal/services/auth"
 	"app-backend/internal/services/jwt"
 	"app-backend/internal/types"
@@ -14,22 +15,55 @@ import (
 )
 
 type AuthMiddleware struct {
-	jwtService  jwt.ServiceInterface
-	authService auth.ServiceInterface
-	logger      *logger.Logger
+	jwtService    jwt.ServiceInterface
+	authService   auth.ServiceInterface
+	apiKeyService apikey.ServiceInterface
+	logger        *logger.Logger
 }
 
-func NewAuthMiddleware(jwtService jwt.ServiceInterface, authService auth.ServiceInterface, logger *logger.Logger) *AuthMiddleware {
+// NewAuthMiddleware creates the auth middleware. The API key service may be
+// nil, in which case AcceptAPIKey passes every request through untouched.
+func NewAuthMiddleware(jwtService jwt.ServiceInterface, authService auth.ServiceInterface, apiKeyService apikey.ServiceInterface, logger *logger.Logger) *AuthMiddleware {
 	return &AuthMiddleware{
-		jwtService:  jwtService,
-		authService: authService,
-		logger:      logger,
+		jwtService:    jwtService,
+		authService:   authService,
+		apiKeyService: apiKeyService,
+		logger:        logger,
+	}
+}
+
+// AcceptAPIKey authenticates the X-API-Key header when one is present,
+// setting the key owner's user context, and passes the request through
+// untouched otherwise. It runs before a group's JWT middlewares so API keys
+// and sessions work side by side on the same routes; scope names the
+// endpoint group the key must grant. A key that is present but invalid or
+// out of scope fails the request.
+func (m *AuthMiddleware) AcceptAPIKey(scope string) gin.HandlerFunc {
+	if m.apiKeyService == nil {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
+	strict := APIKeyAuth(m.apiKeyService, m.logger, scope)
+	return func(c *gin.Context) {
+		if c.GetHeader("X-API-Key") == "" {
+			c.Next()
+			return
+		}
+		strict(c)
 	}
 }
 
 // RequireAuth middleware validates JWT token and sets user context
 func (m *AuthMiddleware) RequireAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// A preceding AcceptAPIKey may already have authenticated the request
+		if _, err := types.GetUserContext(c); err == nil {
+			c.Next()
+			return
+		}
+
 		// Get token from Authorization header
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -85,6 +119,55 @@ func (m *AuthMiddleware) RequireAuth() gin.HandlerFunc {
 	}
 }
 
+// RequireAuthUpgrade validates the JWT on a WebSocket upgrade request. The
+// browser WebSocket API cannot set an Authorization header, so the token is
+// also accepted from the "token" query parameter.
+func (m *AuthMiddleware) RequireAuthUpgrade() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var token string
+		if authHeader := c.GetHeader("Authorization"); authHeader != "" {
+			tokenParts := strings.Split(authHeader, " ")
+			if len(tokenParts) == 2 && tokenParts[0] == "Bearer" {
+				token = tokenParts[1]
+			}
+		}
+		if token == "" {
+			token = c.Query("token")
+		}
+		if token == "" {
+			m.logger.Warn("Missing token on WebSocket upgrade")
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication token is required"})
+			c.Abort()
+			return
+		}
+
+		claims, err := m.jwtService.ValidateToken(token)
+		if err != nil {
+			m.logger.Warn("Invalid JWT token on WebSocket upgrade", zap.Error(err))
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
+			c.Abort()
+			return
+		}
+
+		if claims.TokenType != "access" {
+			m.logger.Warn("Invalid token type on WebSocket upgrade", zap.String("token_type", claims.TokenType))
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token type"})
+			c.Abort()
+			return
+		}
+
+		userCtx := &types.UserContext{
+			UserID:    claims.UserID,
+			Email:     claims.Email,
+			Role:      claims.Role,
+			SessionID: claims.SessionID,
+		}
+		types.SetUserContext(c, userCtx)
+
+		c.Next()
+	}
+}
+
 // RequireRole middleware checks if user has required role
 func (m *AuthMiddleware) RequireRole(roles ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -110,6 +193,12 @@ func (m *AuthMiddleware) RequireRole(roles ...string) gin.HandlerFunc {
 // OptionalAuth middleware validates JWT token if present but doesn't require it
 func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// A preceding AcceptAPIKey may already have authenticated the request
+		if _, err := types.GetUserContext(c); err == nil {
+			c.Next()
+			return
+		}
+
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
 			c.Next()
@@ -194,4 +283,4 @@ func (m *AuthMiddleware) ValidateRefreshToken() gin.HandlerFunc {
 
 		c.Next()
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/errors"
+)
+
+// BodyLimit caps request body sizes. Requests whose declared Content-Length
+// exceeds their route's limit are rejected up front with a 413 in the
+// standard AppError shape; bodies without a declared length are capped while
+// they are read, so chunked uploads cannot sidestep the limit. A route's
+// limit is the longest matching prefix in overrides, falling back to
+// defaultBytes; a non-positive limit disables the cap for those routes.
+func BodyLimit(defaultBytes int64, overrides map[string]int64) gin.HandlerFunc {
+	// Longest prefix first, so a more specific override wins
+	prefixes := make([]string, 0, len(overrides))
+	for prefix := range overrides {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		return len(prefixes[i]) > len(prefixes[j])
+	})
+
+	return func(c *gin.Context) {
+		limit := defaultBytes
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(c.Request.URL.Path, prefix) {
+				limit = overrides[prefix]
+				break
+			}
+		}
+		if limit <= 0 {
+			c.Next()
+			return
+		}
+
+		if c.Request.ContentLength > limit {
+			_ = c.Error(errors.NewAppError("Request body too large", nil, http.StatusRequestEntityTooLarge))
+			c.Abort()
+			return
+		}
+
+		// Backstop for bodies without a Content-Length: reads past the
+		// limit fail, surfacing as a binding error in the handler
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+
+		c.Next()
+	}
+}
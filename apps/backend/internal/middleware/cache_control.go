@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/config"
+)
+
+// CacheControl sets the Cache-Control header for a route group based on its
+// endpoint type. Slow-changing lists can be cached by clients and proxies
+// while auth and per-request responses must never be.
+func CacheControl(cfg *config.Config, endpointType string) gin.HandlerFunc {
+	value := cfg.CacheControlValue(endpointType)
+
+	return func(c *gin.Context) {
+		if value != "" {
+			c.Header("Cache-Control", value)
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/pkg/patterns"
+)
+
+// concurrencyExemptPaths are never counted against the in-flight cap so
+// probes keep working while the server sheds load
+var concurrencyExemptPaths = map[string]struct{}{
+	"/health":  {},
+	"/metrics": {},
+}
+
+// ConcurrencyLimit bounds the total number of in-flight requests with a
+// semaphore. Saturated requests wait up to maxWait for a slot (zero rejects
+// immediately) and are then answered with 503 and Retry-After. A
+// non-positive max disables the limit.
+func ConcurrencyLimit(max int, maxWait time.Duration) gin.HandlerFunc {
+	if max <= 0 {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
+	sem := patterns.NewSemaphore(max)
+
+	return func(c *gin.Context) {
+		if _, exempt := concurrencyExemptPaths[c.Request.URL.Path]; exempt {
+			c.Next()
+			return
+		}
+
+		if !acquireSlot(c.Request.Context(), sem, maxWait) {
+			c.Header("Retry-After", "5")
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Server is at capacity"})
+			c.Abort()
+			return
+		}
+		defer sem.Release()
+
+		c.Next()
+	}
+}
+
+// acquireSlot takes a semaphore slot, queueing for up to maxWait when the
+// server is saturated
+func acquireSlot(ctx context.Context, sem *patterns.Semaphore, maxWait time.Duration) bool {
+	if sem.TryAcquire() {
+		return true
+	}
+	if maxWait <= 0 {
+		return false
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, maxWait)
+	defer cancel()
+	return sem.Acquire(waitCtx) == nil
+}
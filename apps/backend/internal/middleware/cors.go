@@ -1,27 +1,92 @@
 package middleware
 
 import (
-	"app-backend/internal/config"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/config"
 )
 
+// CORS applies the configured origin policy. The global cors section is the
+// default; cors.groups overrides the origin rules per route prefix (the
+// longest matching prefix wins), so public video endpoints can open up while
+// authenticated ones stay locked to known frontends. Chrome extension
+// origins are accepted only when the extension ID is on the configured
+// allowlist, never through the plain origin list.
 func CORS(cfg *config.Config) gin.HandlerFunc {
-	config := cors.Config{
+	defaultHandler := corsHandler(cfg, config.CORSGroupConfig{})
+	if len(cfg.CORS.Groups) == 0 {
+		return defaultHandler
+	}
+
+	// Longest prefix first, so a more specific group wins
+	prefixes := make([]string, 0, len(cfg.CORS.Groups))
+	for prefix := range cfg.CORS.Groups {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		return len(prefixes[i]) > len(prefixes[j])
+	})
+
+	handlers := make(map[string]gin.HandlerFunc, len(prefixes))
+	for _, prefix := range prefixes {
+		handlers[prefix] = corsHandler(cfg, cfg.CORS.Groups[prefix])
+	}
+
+	return func(c *gin.Context) {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(c.Request.URL.Path, prefix) {
+				handlers[prefix](c)
+				return
+			}
+		}
+		defaultHandler(c)
+	}
+}
+
+// corsHandler builds the cors middleware for one group; the zero group value
+// yields the global policy. All origin checks go through AllowOriginFunc so
+// allowed origins are echoed back instead of answered with a wildcard, which
+// keeps credentialed requests working.
+func corsHandler(cfg *config.Config, group config.CORSGroupConfig) gin.HandlerFunc {
+	origins := group.AllowedOrigins
+	if len(origins) == 0 {
+		origins = cfg.CORS.AllowedOrigins
+	}
+	extensionIDs := cfg.CORS.ExtensionIDs
+
+	// For development, allow all origins to support local frontends and
+	// unpacked extensions with unstable IDs
+	allowAll := group.AllowAllOrigins || cfg.App.Environment == "development"
+
+	return cors.New(cors.Config{
+		AllowOriginFunc: func(origin string) bool {
+			if allowAll {
+				return true
+			}
+			if id, ok := strings.CutPrefix(origin, "chrome-extension://"); ok {
+				return containsFold(extensionIDs, id)
+			}
+			return containsFold(origins, origin)
+		},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
 		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", "X-Request-ID"},
 		ExposeHeaders:    []string{"X-Request-ID"},
 		AllowCredentials: true,
-		MaxAge:           86400, // 24 hours
-	}
+		MaxAge:           time.Duration(cfg.CORS.MaxAge) * time.Second,
+	})
+}
 
-	// For development, allow all origins to support Chrome extensions
-	if cfg.App.Environment == "development" {
-		config.AllowAllOrigins = true
-	} else {
-		config.AllowOrigins = cfg.CORS.AllowedOrigins
+// containsFold reports whether values contains s, ignoring case
+func containsFold(values []string, s string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, s) {
+			return true
+		}
 	}
-
-	return cors.New(config)
-}
\ No newline at end of file
+	return false
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/config"
+)
+
+// FeatureGate rejects requests to an endpoint whose feature flag is disabled
+// in the features config map, letting operators turn expensive endpoints off
+// without a redeploy. Disabled features answer 404 so they are
+// indistinguishable from routes that do not exist.
+func FeatureGate(cfg *config.Config, name string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !cfg.FeatureEnabled(name) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"log/slog"
 	"time"
 
+	"app-backend/internal/requestid"
+
 	"github.com/fatih/color"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -20,7 +22,7 @@ const (
 func RequestID() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		start := time.Now()
-		
+
 		requestID := c.GetHeader(RequestIDHeader)
 		if requestID == "" {
 			requestID = uuid.New().String()
@@ -29,10 +31,14 @@ func RequestID() gin.HandlerFunc {
 		// Set request ID in context and header
 		c.Set(RequestIDKey, requestID)
 		c.Header(RequestIDHeader, requestID)
-		
+
+		// Thread the ID into the request context so outbound external calls
+		// can forward it for cross-system correlation
+		c.Request = c.Request.WithContext(requestid.NewContext(c.Request.Context(), requestID))
+
 		// Log incoming request with colorization
 		methodColor := getMethodColor(c.Request.Method)
-		
+
 		fmt.Printf("%s [%s] %s %s %s - Request ID: %s\n",
 			color.BlueString("====== INCOMING REQUEST"),
 			time.Now().Format("2006-01-02 15:04:05"),
@@ -40,13 +46,13 @@ func RequestID() gin.HandlerFunc {
 			color.YellowString(c.Request.URL.Path),
 			color.MagentaString(c.ClientIP()),
 			color.GreenString(requestID))
-		
+
 		c.Next()
-		
+
 		// Log outgoing response
 		duration := time.Since(start)
 		statusColor := getStatusColor(c.Writer.Status())
-		
+
 		fmt.Printf("%s [%s] %s %s %s %s %s - Request ID: %s\n",
 			color.BlueString("====== OUTGOING REQUEST"),
 			time.Now().Format("2006-01-02 15:04:05"),
@@ -148,4 +154,4 @@ func GetRequestID(c *gin.Context) string {
 		}
 	}
 	return ""
-}
\ No newline at end of file
+}
@@ -0,0 +1,238 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/config"
+	"app-backend/internal/logger"
+	"app-backend/internal/services/jwt"
+	"app-backend/internal/types"
+	"app-backend/pkg/patterns"
+)
+
+const (
+	// limiterIdleTTL is how long an idle client keeps its in-memory bucket
+	// before it is swept
+	limiterIdleTTL = 10 * time.Minute
+)
+
+// rateLimitExemptPaths are never rate limited so probes keep working while
+// a client is being throttled
+var rateLimitExemptPaths = map[string]struct{}{
+	"/health":  {},
+	"/metrics": {},
+}
+
+// RateLimitStore decides whether the client identified by key may make
+// another request. Implementations are the in-process token bucket store
+// and the Redis store shared across backend instances.
+type RateLimitStore interface {
+	// Allow reports whether the request is allowed and, when denied, how
+	// long the client should wait before retrying
+	Allow(ctx context.Context, key string) (allowed bool, retryAfter time.Duration, err error)
+}
+
+// RateLimit returns the per-client rate limiting middleware configured from
+// security.rate_limit. Limits are keyed by user ID when authenticated and
+// by client IP otherwise. When a redis_url is configured the counters live
+// in Redis so the limit holds across backend instances; otherwise each
+// instance enforces it independently with in-memory token buckets.
+func RateLimit(cfg *config.Config, jwtService jwt.ServiceInterface, log *logger.Logger) gin.HandlerFunc {
+	rl := cfg.Security.RateLimit
+	if !rl.Enabled || rl.RequestsPerMinute <= 0 {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
+	var store RateLimitStore
+	if rl.RedisURL != "" {
+		redisStore, err := NewRedisRateLimitStore(rl.RedisURL, rl.RequestsPerMinute+rl.Burst, log)
+		if err != nil {
+			log.Error("Failed to initialize Redis rate limit store, falling back to in-memory", zap.Error(err))
+		} else {
+			store = redisStore
+		}
+	}
+	if store == nil {
+		store = NewLocalRateLimitStore(rl.RequestsPerMinute, rl.Burst, log)
+	}
+
+	return NewClientRateLimiter(store, jwtService, log).Limit()
+}
+
+// ClientRateLimiter enforces a request rate per client through a
+// RateLimitStore, rejecting requests over the limit with 429 and a
+// Retry-After header
+type ClientRateLimiter struct {
+	store      RateLimitStore
+	jwtService jwt.ServiceInterface
+	logger     *logger.Logger
+}
+
+// NewClientRateLimiter creates a rate limiting middleware over the given
+// store. The JWT service resolves the user from the bearer token; it may be
+// nil, in which case requests without an established user context are keyed
+// by IP.
+func NewClientRateLimiter(store RateLimitStore, jwtService jwt.ServiceInterface, log *logger.Logger) *ClientRateLimiter {
+	return &ClientRateLimiter{
+		store:      store,
+		jwtService: jwtService,
+		logger:     log,
+	}
+}
+
+// Limit returns the middleware that checks the client's rate before the
+// request is handled. A store failure fails open so a Redis outage cannot
+// take the API down with it.
+func (l *ClientRateLimiter) Limit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, exempt := rateLimitExemptPaths[c.Request.URL.Path]; exempt {
+			c.Next()
+			return
+		}
+
+		key := l.clientKey(c)
+		allowed, retryAfter, err := l.store.Allow(c.Request.Context(), key)
+		if err != nil {
+			l.logger.Warn("Rate limit check failed, allowing request",
+				zap.String("client", key),
+				zap.Error(err))
+			c.Next()
+			return
+		}
+
+		if !allowed {
+			c.Header("Retry-After", strconv.Itoa(retryAfterSeconds(retryAfter)))
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Rate limit exceeded"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// clientKey identifies the client: by user ID when authenticated, by IP
+// otherwise. The limiter is installed ahead of the route groups' auth
+// middlewares, so when no user context has been set yet the user is resolved
+// from the bearer token directly.
+func (l *ClientRateLimiter) clientKey(c *gin.Context) string {
+	if userCtx, err := types.GetUserContext(c); err == nil {
+		return fmt.Sprintf("user:%d", userCtx.UserID)
+	}
+
+	if l.jwtService != nil {
+		if token := bearerToken(c); token != "" {
+			if claims, err := l.jwtService.ValidateToken(token); err == nil && claims.TokenType == "access" {
+				return fmt.Sprintf("user:%d", claims.UserID)
+			}
+		}
+	}
+
+	return "ip:" + c.ClientIP()
+}
+
+// bearerToken extracts the token from the Authorization header, returning
+// "" when the header is absent or not a Bearer credential
+func bearerToken(c *gin.Context) string {
+	authHeader := c.GetHeader("Authorization")
+	if authHeader == "" {
+		return ""
+	}
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return ""
+	}
+	return parts[1]
+}
+
+// retryAfterSeconds converts a wait duration to whole seconds for the
+// Retry-After header, never advertising less than one second
+func retryAfterSeconds(wait time.Duration) int {
+	seconds := int((wait + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	return seconds
+}
+
+// LocalRateLimitStore keeps a token bucket per client in process memory.
+// Each bucket holds burst tokens and refills at the configured
+// requests-per-minute rate; idle buckets are swept after limiterIdleTTL.
+type LocalRateLimitStore struct {
+	burst      int
+	refillRate time.Duration
+	logger     *logger.Logger
+
+	mu        sync.Mutex
+	limiters  map[string]*localLimiterEntry
+	lastSweep time.Time
+}
+
+type localLimiterEntry struct {
+	limiter  *patterns.TokenBucketLimiter
+	lastSeen time.Time
+}
+
+// NewLocalRateLimitStore creates an in-memory store allowing bursts of
+// burst requests refilled at requestsPerMinute; a non-positive burst falls
+// back to the per-minute rate
+func NewLocalRateLimitStore(requestsPerMinute int, burst int, log *logger.Logger) *LocalRateLimitStore {
+	if burst <= 0 {
+		burst = requestsPerMinute
+	}
+
+	return &LocalRateLimitStore{
+		burst:      burst,
+		refillRate: time.Minute / time.Duration(requestsPerMinute),
+		logger:     log,
+		limiters:   make(map[string]*localLimiterEntry),
+		lastSweep:  time.Now(),
+	}
+}
+
+// Allow takes a token from the client's bucket, creating it on first use
+func (s *LocalRateLimitStore) Allow(_ context.Context, key string) (bool, time.Duration, error) {
+	s.mu.Lock()
+	entry, ok := s.limiters[key]
+	if !ok {
+		entry = &localLimiterEntry{
+			limiter: patterns.NewTokenBucketLimiter(s.burst, s.refillRate, s.logger.Zap()),
+		}
+		s.limiters[key] = entry
+	}
+	entry.lastSeen = time.Now()
+	s.sweepLocked()
+	s.mu.Unlock()
+
+	if entry.limiter.Allow() {
+		return true, 0, nil
+	}
+	return false, s.refillRate, nil
+}
+
+// sweepLocked drops buckets for clients not seen within limiterIdleTTL so
+// one-off clients do not accumulate forever. Caller must hold the mutex.
+func (s *LocalRateLimitStore) sweepLocked() {
+	now := time.Now()
+	if now.Sub(s.lastSweep) < limiterIdleTTL {
+		return
+	}
+	s.lastSweep = now
+
+	for key, entry := range s.limiters {
+		if now.Sub(entry.lastSeen) >= limiterIdleTTL {
+			delete(s.limiters, key)
+		}
+	}
+}
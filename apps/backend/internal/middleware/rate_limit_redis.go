@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"app-backend/internal/logger"
+)
+
+const (
+	// redisRateLimitPrefix namespaces rate limit counters in Redis
+	redisRateLimitPrefix = "ratelimit"
+	// redisRateLimitWindow is the fixed counting window
+	redisRateLimitWindow = time.Minute
+)
+
+// RedisRateLimitStore counts requests per client in fixed one-minute
+// windows in Redis, so the limit is shared across backend instances. The
+// window key embeds its start time and expires on its own, which keeps the
+// implementation to a single INCR+EXPIRE round trip per request.
+type RedisRateLimitStore struct {
+	client *redis.Client
+	limit  int
+	logger *logger.Logger
+}
+
+// NewRedisRateLimitStore connects to Redis and creates a store allowing
+// limit requests per client per minute
+func NewRedisRateLimitStore(redisURL string, limit int, log *logger.Logger) (*RedisRateLimitStore, error) {
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis URL: %w", err)
+	}
+
+	client := redis.NewClient(opts)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+
+	return &RedisRateLimitStore{
+		client: client,
+		limit:  limit,
+		logger: log,
+	}, nil
+}
+
+// Allow increments the client's counter for the current window and denies
+// the request once the counter passes the limit
+func (s *RedisRateLimitStore) Allow(ctx context.Context, key string) (bool, time.Duration, error) {
+	now := time.Now()
+	windowStart := now.Truncate(redisRateLimitWindow)
+	redisKey := fmt.Sprintf("%s:%s:%d", redisRateLimitPrefix, key, windowStart.Unix())
+
+	pipe := s.client.Pipeline()
+	count := pipe.Incr(ctx, redisKey)
+	// The extra window of expiry keeps the key alive for clients polling
+	// their Retry-After right at the window boundary
+	pipe.Expire(ctx, redisKey, 2*redisRateLimitWindow)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, 0, err
+	}
+
+	if count.Val() > int64(s.limit) {
+		return false, windowStart.Add(redisRateLimitWindow).Sub(now), nil
+	}
+	return true, 0, nil
+}
+
+// Close releases the Redis connection
+func (s *RedisRateLimitStore) Close() error {
+	return s.client.Close()
+}
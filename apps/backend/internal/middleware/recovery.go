@@ -17,7 +17,7 @@ import (
 func Recovery(log *logger.Logger) gin.HandlerFunc {
 	return gin.CustomRecovery(func(c *gin.Context, err interface{}) {
 		requestID := GetRequestID(c)
-		
+
 		// Create structured error with oops
 		oopsErr := oops.
 			In("panic_recovery").
@@ -45,7 +45,7 @@ func Recovery(log *logger.Logger) gin.HandlerFunc {
 		// Convert to app error and respond
 		appErr := errors.FromOopsError(oopsErr).WithTraceID(requestID)
 		appErr.Status = http.StatusInternalServerError
-		
+
 		c.JSON(appErr.Status, appErr)
 		c.Abort()
 	})
@@ -110,7 +110,7 @@ func HandleError(c *gin.Context, err error) {
 // HandleOopsError is a helper function to create and set oops errors
 func HandleOopsError(c *gin.Context, domain, code, message string, attrs ...interface{}) {
 	requestID := GetRequestID(c)
-	
+
 	builder := oops.
 		In(domain).
 		Code(code).
@@ -130,4 +130,4 @@ func HandleOopsError(c *gin.Context, domain, code, message string, attrs ...inte
 
 	err := builder.Errorf(message)
 	c.Error(err)
-}
\ No newline at end of file
+}
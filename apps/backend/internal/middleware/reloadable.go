@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Reloadable wraps a middleware so the running handler chain can be rebuilt
+// at runtime, e.g. when rate limits or CORS origins change in the config.
+// The registered gin handler stays stable; only the wrapped middleware is
+// swapped.
+type Reloadable struct {
+	current atomic.Value // gin.HandlerFunc
+}
+
+// NewReloadable wraps the given middleware
+func NewReloadable(handler gin.HandlerFunc) *Reloadable {
+	r := &Reloadable{}
+	r.current.Store(handler)
+	return r
+}
+
+// Swap replaces the wrapped middleware; in-flight requests finish on the
+// handler they started with
+func (r *Reloadable) Swap(handler gin.HandlerFunc) {
+	r.current.Store(handler)
+}
+
+// Handler returns the stable middleware to register with the router
+func (r *Reloadable) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		r.current.Load().(gin.HandlerFunc)(c)
+	}
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/types"
+)
+
+const (
+	// defaultMaxStreams caps concurrent streaming connections process-wide
+	defaultMaxStreams = 100
+	// defaultMaxStreamsPerUser caps concurrent streams for a single user
+	defaultMaxStreamsPerUser = 4
+)
+
+// StreamLimiter bounds the number of concurrent streaming connections
+// (SSE, NDJSON) globally and per user so long-lived responses cannot
+// exhaust goroutines or server connections. Requests over either cap are
+// rejected with 503.
+type StreamLimiter struct {
+	maxGlobal  int
+	maxPerUser int
+
+	mu      sync.Mutex
+	active  int
+	perUser map[uint]int
+}
+
+// NewStreamLimiter creates a stream limiter; non-positive caps fall back to
+// the defaults
+func NewStreamLimiter(maxGlobal, maxPerUser int) *StreamLimiter {
+	if maxGlobal <= 0 {
+		maxGlobal = defaultMaxStreams
+	}
+	if maxPerUser <= 0 {
+		maxPerUser = defaultMaxStreamsPerUser
+	}
+
+	return &StreamLimiter{
+		maxGlobal:  maxGlobal,
+		maxPerUser: maxPerUser,
+		perUser:    make(map[uint]int),
+	}
+}
+
+// Limit returns the middleware that holds a stream slot for the lifetime of
+// the request. Unauthenticated requests share a single per-user bucket.
+func (l *StreamLimiter) Limit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var userID uint
+		if userCtx, err := types.GetUserContext(c); err == nil {
+			userID = userCtx.UserID
+		}
+
+		if !l.acquire(userID) {
+			c.Header("Retry-After", "5")
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Too many concurrent streams"})
+			c.Abort()
+			return
+		}
+		defer l.release(userID)
+
+		c.Next()
+	}
+}
+
+// ActiveStreams returns the number of streams currently held
+func (l *StreamLimiter) ActiveStreams() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.active
+}
+
+// ActiveStreamsForUser returns the number of streams the user currently holds
+func (l *StreamLimiter) ActiveStreamsForUser(userID uint) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.perUser[userID]
+}
+
+func (l *StreamLimiter) acquire(userID uint) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.active >= l.maxGlobal || l.perUser[userID] >= l.maxPerUser {
+		return false
+	}
+
+	l.active++
+	l.perUser[userID]++
+	return true
+}
+
+func (l *StreamLimiter) release(userID uint) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.active--
+	l.perUser[userID]--
+	if l.perUser[userID] <= 0 {
+		delete(l.perUser, userID)
+	}
+}
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/services/workspace"
+	"app-backend/internal/types"
+)
+
+// workspaceMembershipKey is the gin context key the resolved membership is
+// stored under
+const workspaceMembershipKey = "workspace_membership"
+
+// WorkspaceAuthMiddleware authorizes requests against a workspace's member
+// roles. It runs after RequireAuth on routes carrying a :workspaceId path
+// parameter.
+type WorkspaceAuthMiddleware struct {
+	workspaceService workspace.ServiceInterface
+	logger           *logger.Logger
+}
+
+func NewWorkspaceAuthMiddleware(workspaceService workspace.ServiceInterface, logger *logger.Logger) *WorkspaceAuthMiddleware {
+	return &WorkspaceAuthMiddleware{
+		workspaceService: workspaceService,
+		logger:           logger,
+	}
+}
+
+// RequireWorkspaceRole resolves the authenticated user's membership in the
+// :workspaceId workspace and stores it in the context. Without roles any
+// member passes; with roles the membership must hold one of them. Non-members
+// get 404 so workspaces they do not belong to look the same as missing ones.
+func (m *WorkspaceAuthMiddleware) RequireWorkspaceRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userCtx, err := types.GetUserContext(c)
+		if err != nil {
+			m.logger.Error("User context not found", zap.Error(err))
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+			c.Abort()
+			return
+		}
+
+		workspaceID, err := strconv.ParseUint(c.Param("workspaceId"), 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid workspace ID"})
+			c.Abort()
+			return
+		}
+
+		member, err := m.workspaceService.GetMembership(uint(workspaceID), userCtx.UserID)
+		if err != nil {
+			status := http.StatusInternalServerError
+			message := "Failed to load workspace membership"
+			if appErr, ok := err.(*errors.AppError); ok {
+				status = appErr.Status
+				message = appErr.Message
+			}
+			if status >= http.StatusInternalServerError {
+				m.logger.Error("Failed to resolve workspace membership",
+					zap.Uint64("workspace_id", workspaceID),
+					zap.Uint("user_id", userCtx.UserID),
+					zap.Error(err))
+			}
+			c.JSON(status, gin.H{"error": message})
+			c.Abort()
+			return
+		}
+
+		if len(roles) > 0 && !hasWorkspaceRole(member.Role, roles) {
+			m.logger.Warn("Insufficient workspace permissions",
+				zap.Uint64("workspace_id", workspaceID),
+				zap.Uint("user_id", userCtx.UserID),
+				zap.String("role", member.Role),
+				zap.Strings("required_roles", roles))
+			c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient workspace permissions"})
+			c.Abort()
+			return
+		}
+
+		c.Set(workspaceMembershipKey, member)
+		c.Next()
+	}
+}
+
+// hasWorkspaceRole reports whether the role is one of the required roles
+func hasWorkspaceRole(role string, roles []string) bool {
+	for _, required := range roles {
+		if role == required {
+			return true
+		}
+	}
+	return false
+}
+
+// GetWorkspaceMembership returns the membership resolved by
+// RequireWorkspaceRole for the current request
+func GetWorkspaceMembership(c *gin.Context) (*models.WorkspaceMember, error) {
+	value, exists := c.Get(workspaceMembershipKey)
+	if !exists {
+		return nil, errors.NewAppError("Workspace membership not found in context", nil, http.StatusInternalServerError)
+	}
+
+	member, ok := value.(*models.WorkspaceMember)
+	if !ok {
+		return nil, errors.NewAppError("Invalid workspace membership in context", nil, http.StatusInternalServerError)
+	}
+	return member, nil
+}
@@ -0,0 +1,20 @@
+package models
+
+// AdminAuditLog records a privileged action an admin performed against a
+// user account, so account changes stay attributable after the fact
+type AdminAuditLog struct {
+	Auditable
+
+	// ActorID is the admin who performed the action
+	ActorID uint `json:"actor_id" gorm:"not null;index"`
+	// TargetUserID is the account the action was applied to
+	TargetUserID uint `json:"target_user_id" gorm:"not null;index"`
+
+	Action string `json:"action" gorm:"not null"`
+	Detail string `json:"detail"`
+}
+
+// TableName returns the table name for the AdminAuditLog model
+func (AdminAuditLog) TableName() string {
+	return "admin_audit_logs"
+}
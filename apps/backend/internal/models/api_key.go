@@ -0,0 +1,56 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// APIKey represents a long-lived key for programmatic access. Only a hash of
+// the key is stored; the raw key is shown once at generation time.
+type APIKey struct {
+	Auditable
+
+	UserID  uint   `json:"user_id" gorm:"not null;index"`
+	User    User   `json:"user" gorm:"foreignKey:UserID"`
+	Name    string `json:"name" gorm:"not null"`
+	KeyHash string `json:"-" gorm:"uniqueIndex;not null"`
+	// Prefix is the non-secret start of the key, kept for identification
+	Prefix string `json:"prefix" gorm:"not null"`
+	// Scopes is a comma-separated list of endpoint scopes; empty means all
+	Scopes    string     `json:"scopes"`
+	IsActive  bool       `json:"is_active" gorm:"default:true"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	LastUsed  *time.Time `json:"last_used"`
+}
+
+// IsExpired checks if the API key has expired; keys without an expiry never do
+func (k *APIKey) IsExpired() bool {
+	return k.ExpiresAt != nil && time.Now().After(*k.ExpiresAt)
+}
+
+// IsValid checks if the API key is active and not expired
+func (k *APIKey) IsValid() bool {
+	return k.IsActive && !k.IsExpired()
+}
+
+// HasScope checks if the key grants the given endpoint scope. Keys without
+// scopes grant everything.
+func (k *APIKey) HasScope(scope string) bool {
+	if k.Scopes == "" {
+		return true
+	}
+	for _, granted := range strings.Split(k.Scopes, ",") {
+		if granted == scope || granted == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// ScopeList returns the granted scopes as a slice; empty for unrestricted keys
+func (k *APIKey) ScopeList() []string {
+	if k.Scopes == "" {
+		return nil
+	}
+	return strings.Split(k.Scopes, ",")
+}
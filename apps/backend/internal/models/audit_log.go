@@ -0,0 +1,27 @@
+package models
+
+// AuditLog records a security-relevant event (login, logout, password
+// change, role change, OAuth link/unlink, admin actions) together with the
+// request metadata needed to investigate it later
+type AuditLog struct {
+	Auditable
+
+	// ActorID is the user who triggered the event
+	ActorID uint `json:"actor_id" gorm:"index"`
+	// TargetUserID is the account the event affected, when it differs from
+	// the actor (e.g. admin actions)
+	TargetUserID uint `json:"target_user_id" gorm:"index"`
+
+	Action string `json:"action" gorm:"not null;index"`
+	Detail string `json:"detail"`
+
+	// Request metadata captured by the audit middleware
+	IPAddress string `json:"ip_address"`
+	UserAgent string `json:"user_agent"`
+	RequestID string `json:"request_id"`
+}
+
+// TableName returns the table name for the AuditLog model
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
@@ -25,4 +25,4 @@ func (a *Auditable) BeforeCreate(tx *gorm.DB) error {
 func (a *Auditable) BeforeUpdate(tx *gorm.DB) error {
 	a.UpdatedAt = time.Now()
 	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,29 @@
+package models
+
+// DailyActivity aggregates one user's study activity for one UTC calendar
+// day. The services bump the counters as activity happens; the stats
+// endpoint reads the rows back for streaks and dashboard charts.
+type DailyActivity struct {
+	Auditable
+
+	UserID uint `json:"user_id" gorm:"not null;uniqueIndex:idx_daily_activities_user_date"`
+	User   User `json:"-" gorm:"foreignKey:UserID"`
+
+	// Date is the UTC day in YYYY-MM-DD form, so range queries stay
+	// lexicographic
+	Date string `json:"date" gorm:"type:varchar(10);not null;uniqueIndex:idx_daily_activities_user_date"`
+
+	// VideosWatched counts distinct videos studied that day
+	VideosWatched int `json:"videos_watched" gorm:"not null;default:0"`
+	// SegmentsTranslated counts transcript segments sent for translation
+	SegmentsTranslated int `json:"segments_translated" gorm:"not null;default:0"`
+	// VocabReviewed counts graded spaced-repetition reviews
+	VocabReviewed int `json:"vocab_reviewed" gorm:"not null;default:0"`
+	// StudySeconds accumulates watched playback time in seconds
+	StudySeconds int `json:"study_seconds" gorm:"not null;default:0"`
+}
+
+// TableName returns the table name for the DailyActivity model
+func (DailyActivity) TableName() string {
+	return "daily_activities"
+}
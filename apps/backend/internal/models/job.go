@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+)
+
+// Job lifecycle states
+const (
+	// JobStatusQueued means the job is waiting for a worker (possibly for a
+	// retry after its backoff elapses)
+	JobStatusQueued = "queued"
+	// JobStatusRunning means a worker is executing the job
+	JobStatusRunning = "running"
+	// JobStatusCompleted means the job finished successfully
+	JobStatusCompleted = "completed"
+	// JobStatusFailed means the job exhausted its attempts
+	JobStatusFailed = "failed"
+)
+
+// Job represents one unit of long-running background work. Payload and
+// Result hold type-specific JSON; handlers may overwrite Result while the
+// job runs to report progress.
+type Job struct {
+	Auditable
+
+	UserID uint   `json:"user_id" gorm:"not null;index"`
+	User   User   `json:"user" gorm:"foreignKey:UserID"`
+	Type   string `json:"type" gorm:"not null;index"`
+	Status string `json:"status" gorm:"not null;index;default:'queued'"`
+	// Payload is the JSON input the handler receives
+	Payload string `json:"payload" gorm:"type:text"`
+	// Result is the JSON progress/result the handler reports
+	Result string `json:"result" gorm:"type:text"`
+	// LastError records why the most recent attempt failed
+	LastError   string `json:"last_error" gorm:"type:text"`
+	Attempts    int    `json:"attempts" gorm:"default:0"`
+	MaxAttempts int    `json:"max_attempts" gorm:"default:3"`
+	// NextRunAt is when the job becomes due; retries push it into the future
+	NextRunAt  time.Time  `json:"next_run_at" gorm:"index"`
+	StartedAt  *time.Time `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+}
+
+// TableName specifies the table name for the Job model
+func (Job) TableName() string {
+	return "jobs"
+}
+
+// IsTerminal reports whether the job has reached a final state
+func (j *Job) IsTerminal() bool {
+	return j.Status == JobStatusCompleted || j.Status == JobStatusFailed
+}
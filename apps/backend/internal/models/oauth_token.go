@@ -0,0 +1,19 @@
+package models
+
+// OAuthToken stores one user's OAuth token for an external provider. The
+// token JSON is encrypted before it reaches the database; only the
+// ciphertext is persisted.
+type OAuthToken struct {
+	Auditable
+
+	UserID   uint   `json:"user_id" gorm:"not null;uniqueIndex:idx_oauth_tokens_user_provider"`
+	User     User   `json:"user" gorm:"foreignKey:UserID"`
+	Provider string `json:"provider" gorm:"not null;uniqueIndex:idx_oauth_tokens_user_provider"`
+	// Ciphertext is the encrypted token JSON, base64-encoded
+	Ciphertext string `json:"-" gorm:"type:text;not null"`
+}
+
+// TableName specifies the table name for the OAuthToken model
+func (OAuthToken) TableName() string {
+	return "oauth_tokens"
+}
@@ -0,0 +1,28 @@
+package models
+
+// PronunciationAttempt is one scored pronunciation practice recording,
+// kept per user so progress over time can be graphed
+type PronunciationAttempt struct {
+	Auditable
+
+	UserID uint `json:"user_id" gorm:"not null;index"`
+	User   User `json:"-" gorm:"foreignKey:UserID"`
+
+	// VideoID is the video the practiced segment came from, when known
+	VideoID string `json:"video_id" gorm:"index"`
+	// Language is the language of the reference text
+	Language string `json:"language"`
+	// ReferenceText is the transcript segment the user read aloud
+	ReferenceText string `json:"reference_text" gorm:"type:text;not null"`
+	// OverallScore is the clip-level score from 0 to 100
+	OverallScore float64 `json:"overall_score" gorm:"not null"`
+	// WordScores holds the per-word assessment as JSON
+	WordScores string `json:"word_scores" gorm:"type:text"`
+	// Provider records which speech-assessment backend scored the attempt
+	Provider string `json:"provider"`
+}
+
+// TableName returns the table name for the PronunciationAttempt model
+func (PronunciationAttempt) TableName() string {
+	return "pronunciation_attempts"
+}
@@ -0,0 +1,18 @@
+package models
+
+// ProviderOutcome records the outcome of a single transcript provider attempt
+// for analytics (which providers serve traffic, which fail, and how fast)
+type ProviderOutcome struct {
+	Auditable
+	VideoID   string `gorm:"index;not null" json:"videoId"`
+	Provider  string `gorm:"index;not null" json:"provider"`
+	Served    bool   `gorm:"default:false" json:"served"`  // This attempt produced the response
+	Success   bool   `gorm:"default:false" json:"success"` // The provider returned a transcript
+	ErrorCode string `json:"errorCode,omitempty"`
+	LatencyMs int64  `json:"latencyMs"`
+}
+
+// TableName overrides the table name for ProviderOutcome
+func (ProviderOutcome) TableName() string {
+	return "provider_outcomes"
+}
@@ -0,0 +1,53 @@
+package models
+
+// Quiz is a generated practice quiz built from a video's transcript. The
+// questions, including their correct answers, are stored as JSON so grading
+// happens server-side and answers never reach the client unsolicited.
+type Quiz struct {
+	Auditable
+
+	UserID uint `json:"user_id" gorm:"not null;index"`
+	User   User `json:"-" gorm:"foreignKey:UserID"`
+
+	// VideoID is the video whose transcript the quiz was built from
+	VideoID string `json:"video_id" gorm:"index"`
+	// Language is the transcript language the questions were written in
+	Language string `json:"language"`
+	// Seed is the deterministic seed used for segment selection and
+	// question generation, so the quiz can be reproduced
+	Seed int64 `json:"seed" gorm:"not null"`
+	// Questions holds the generated questions with answers as JSON
+	Questions string `json:"-" gorm:"type:text;not null"`
+	// QuestionCount is the number of questions in the quiz
+	QuestionCount int `json:"question_count" gorm:"not null"`
+}
+
+// TableName returns the table name for the Quiz model
+func (Quiz) TableName() string {
+	return "quizzes"
+}
+
+// QuizSubmission is one graded set of answers a user gave for a quiz
+type QuizSubmission struct {
+	Auditable
+
+	QuizID uint `json:"quiz_id" gorm:"not null;index"`
+	Quiz   Quiz `json:"-" gorm:"foreignKey:QuizID"`
+
+	UserID uint `json:"user_id" gorm:"not null;index"`
+	User   User `json:"-" gorm:"foreignKey:UserID"`
+
+	// Answers holds the submitted answers, in question order, as JSON
+	Answers string `json:"answers" gorm:"type:text"`
+	// Score is the percentage of questions answered correctly, 0 to 100
+	Score float64 `json:"score" gorm:"not null"`
+	// CorrectCount is how many answers were correct
+	CorrectCount int `json:"correct_count" gorm:"not null"`
+	// QuestionCount is the number of questions graded
+	QuestionCount int `json:"question_count" gorm:"not null"`
+}
+
+// TableName returns the table name for the QuizSubmission model
+func (QuizSubmission) TableName() string {
+	return "quiz_submissions"
+}
@@ -7,7 +7,7 @@ import (
 // Session represents a user session in the database
 type Session struct {
 	Auditable
-	
+
 	UserID    uint      `json:"user_id" gorm:"not null;index"`
 	User      User      `json:"user" gorm:"foreignKey:UserID"`
 	TokenHash string    `json:"-" gorm:"uniqueIndex;not null"` // JWT token hash for validation
@@ -16,6 +16,12 @@ type Session struct {
 	IPAddress string    `json:"ip_address"`
 	UserAgent string    `json:"user_agent"`
 	LastUsed  time.Time `json:"last_used"`
+
+	// Browser, OS and Location are parsed at login so the sessions list can
+	// show friendly device labels without re-resolving on every read
+	Browser  string `json:"browser"`
+	OS       string `json:"os"`
+	Location string `json:"location"`
 }
 
 // IsExpired checks if the session has expired
@@ -26,4 +32,4 @@ func (s *Session) IsExpired() bool {
 // IsValid checks if the session is active and not expired
 func (s *Session) IsValid() bool {
 	return s.IsActive && !s.IsExpired()
-}
\ No newline at end of file
+}
@@ -0,0 +1,31 @@
+package models
+
+// TranscriptCorrection is one owner edit to a transcript segment of a saved
+// video. Edits are append-only: each one takes the next version number for
+// its user and video, so the full edit history stays reconstructable and the
+// latest text per segment index forms the corrected transcript.
+type TranscriptCorrection struct {
+	Auditable
+
+	UserID uint `json:"user_id" gorm:"not null;index:idx_transcript_corrections_user_video"`
+	User   User `json:"-" gorm:"foreignKey:UserID"`
+
+	// VideoID is the YouTube video identifier
+	VideoID string `json:"video_id" gorm:"not null;index:idx_transcript_corrections_user_video"`
+	// Language is the transcript language the correction applies to
+	Language string `json:"language"`
+
+	// SegmentIndex is the 1-based segment index as returned by the
+	// transcript endpoints
+	SegmentIndex int `json:"segment_index" gorm:"not null"`
+	// Text is the corrected segment text
+	Text string `json:"text" gorm:"type:text;not null"`
+	// Version is the transcript version this edit created, monotonic per
+	// user and video
+	Version int `json:"version" gorm:"not null"`
+}
+
+// TableName returns the table name for the TranscriptCorrection model
+func (TranscriptCorrection) TableName() string {
+	return "transcript_corrections"
+}
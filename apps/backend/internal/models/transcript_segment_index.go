@@ -0,0 +1,21 @@
+package models
+
+// TranscriptSegmentIndex is one cached transcript segment flattened into its
+// own row so transcript text can be searched across videos. Rows are replaced
+// wholesale whenever a transcript for the video and language is re-fetched.
+type TranscriptSegmentIndex struct {
+	Auditable
+
+	VideoID  string `json:"video_id" gorm:"not null;index:idx_transcript_segment_index_video_lang"`
+	Language string `json:"language" gorm:"not null;index:idx_transcript_segment_index_video_lang"`
+	// SegmentIndex is the segment's position within the transcript
+	SegmentIndex int    `json:"segment_index" gorm:"not null"`
+	Text         string `json:"text" gorm:"type:text;not null"`
+	StartMs      int64  `json:"start_ms"`
+	DurationMs   int64  `json:"duration_ms"`
+}
+
+// TableName returns the table name for the TranscriptSegmentIndex model
+func (TranscriptSegmentIndex) TableName() string {
+	return "transcript_segment_index"
+}
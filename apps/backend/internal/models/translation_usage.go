@@ -0,0 +1,16 @@
+package models
+
+// TranslationUsage records a single translation request made by a user so
+// per-user quotas can be enforced and remaining allowance displayed
+type TranslationUsage struct {
+	Auditable
+	UserID     uint   `gorm:"index;not null" json:"userId"`
+	CharCount  int    `gorm:"not null" json:"charCount"`
+	TargetLang string `gorm:"not null" json:"targetLang"`
+	Provider   string `json:"provider"`
+}
+
+// TableName overrides the table name for TranslationUsage
+func (TranslationUsage) TableName() string {
+	return "translation_usage"
+}
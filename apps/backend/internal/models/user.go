@@ -2,7 +2,7 @@ package models
 
 type User struct {
 	Auditable
-	
+
 	FirstName string `json:"first_name" gorm:"not null"`
 	LastName  string `json:"last_name" gorm:"not null"`
 	Email     string `json:"email" gorm:"uniqueIndex;not null"`
@@ -24,4 +24,4 @@ type UpdateUserRequest struct {
 	Avatar    *string `json:"avatar,omitempty"`
 	IsActive  *bool   `json:"is_active,omitempty"`
 	Role      *string `json:"role,omitempty"`
-}
\ No newline at end of file
+}
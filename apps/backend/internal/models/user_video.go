@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// UserVideo records a video a user studied: the last playback position, the
+// chosen language pair, and how far they got, so the extension can restore a
+// study session on any device. One row exists per user and video; saving the
+// same video again updates it.
+type UserVideo struct {
+	Auditable
+
+	UserID uint `json:"user_id" gorm:"not null;uniqueIndex:idx_user_videos_user_video"`
+	User   User `json:"-" gorm:"foreignKey:UserID"`
+
+	// VideoID is the YouTube video identifier
+	VideoID string `json:"video_id" gorm:"not null;uniqueIndex:idx_user_videos_user_video"`
+	Title   string `json:"title"`
+
+	// LastPosition is the playback position in seconds to resume from
+	LastPosition float64 `json:"last_position"`
+	// SourceLanguage and TargetLanguage are the study language pair the user
+	// chose for this video
+	SourceLanguage string `json:"source_language"`
+	TargetLanguage string `json:"target_language"`
+	// ProgressPercent is how much of the video was watched (0-100)
+	ProgressPercent float64 `json:"progress_percent"`
+
+	LastWatchedAt time.Time `json:"last_watched_at" gorm:"index"`
+}
+
+// TableName returns the table name for the UserVideo model
+func (UserVideo) TableName() string {
+	return "user_videos"
+}
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// VocabItem is a vocabulary entry a user saved while watching a video,
+// with SM-2 spaced-repetition state for review scheduling
+type VocabItem struct {
+	Auditable
+
+	UserID uint `json:"user_id" gorm:"not null;index"`
+	User   User `json:"-" gorm:"foreignKey:UserID"`
+
+	Word string `json:"word" gorm:"not null"`
+	// Reading is the optional pronunciation aid (furigana, pinyin,
+	// phonetic transcription) for the word
+	Reading         string `json:"reading"`
+	Definition      string `json:"definition"`
+	ExampleSentence string `json:"example_sentence"`
+	// SourceVideoID is the YouTube video the word was captured from
+	SourceVideoID string `json:"source_video_id"`
+	// VideoTimestamp is the playback position in seconds where the word
+	// occurred, so the frontend can jump back to it
+	VideoTimestamp float64 `json:"video_timestamp"`
+
+	// SM-2 scheduling state
+	EaseFactor   float64   `json:"ease_factor" gorm:"default:2.5"`
+	IntervalDays int       `json:"interval_days" gorm:"default:0"`
+	Repetitions  int       `json:"repetitions" gorm:"default:0"`
+	NextReviewAt time.Time `json:"next_review_at" gorm:"index"`
+}
+
+// TableName returns the table name for the VocabItem model
+func (VocabItem) TableName() string {
+	return "vocab_items"
+}
+
+// IsDue reports whether the item is due for review at the given time
+func (v *VocabItem) IsDue(now time.Time) bool {
+	return !v.NextReviewAt.After(now)
+}
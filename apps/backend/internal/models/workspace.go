@@ -0,0 +1,120 @@
+package models
+
+import "time"
+
+// Workspace roles, from most to least privileged. The owner is the teacher
+// who created the workspace; teachers curate shared content and invite
+// students; students consume what is shared with them.
+const (
+	WorkspaceRoleOwner   = "owner"
+	WorkspaceRoleTeacher = "teacher"
+	WorkspaceRoleStudent = "student"
+)
+
+// Workspace is a classroom-style group owned by a teacher. Members share
+// curated video lists and vocabulary decks within it.
+type Workspace struct {
+	Auditable
+
+	Name        string `json:"name" gorm:"not null"`
+	Description string `json:"description"`
+
+	OwnerID uint `json:"owner_id" gorm:"not null;index"`
+	Owner   User `json:"-" gorm:"foreignKey:OwnerID"`
+}
+
+// TableName returns the table name for the Workspace model
+func (Workspace) TableName() string {
+	return "workspaces"
+}
+
+// WorkspaceMember ties a user to a workspace with a role. A user joins a
+// workspace at most once.
+type WorkspaceMember struct {
+	Auditable
+
+	WorkspaceID uint      `json:"workspace_id" gorm:"not null;uniqueIndex:idx_workspace_members_workspace_user"`
+	Workspace   Workspace `json:"-" gorm:"foreignKey:WorkspaceID"`
+
+	UserID uint `json:"user_id" gorm:"not null;uniqueIndex:idx_workspace_members_workspace_user"`
+	User   User `json:"-" gorm:"foreignKey:UserID"`
+
+	// Role is one of the WorkspaceRole constants
+	Role string `json:"role" gorm:"not null"`
+}
+
+// TableName returns the table name for the WorkspaceMember model
+func (WorkspaceMember) TableName() string {
+	return "workspace_members"
+}
+
+// WorkspaceInvite is a pending email invitation into a workspace. The token
+// is single-use and only redeemable by an account with the invited email.
+type WorkspaceInvite struct {
+	Auditable
+
+	WorkspaceID uint      `json:"workspace_id" gorm:"not null;index"`
+	Workspace   Workspace `json:"-" gorm:"foreignKey:WorkspaceID"`
+
+	// Email is the invited address, stored lowercased
+	Email string `json:"email" gorm:"not null;index"`
+	// Role the invitee will join with (teacher or student)
+	Role string `json:"role" gorm:"not null"`
+	// Token is the secret the invitee redeems; never exposed in listings
+	Token string `json:"-" gorm:"uniqueIndex;not null"`
+
+	InvitedBy uint `json:"invited_by" gorm:"not null"`
+
+	ExpiresAt time.Time `json:"expires_at" gorm:"not null"`
+	// AcceptedAt is set once the invite has been redeemed
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+}
+
+// TableName returns the table name for the WorkspaceInvite model
+func (WorkspaceInvite) TableName() string {
+	return "workspace_invites"
+}
+
+// WorkspaceVideo is one entry in a workspace's curated video list
+type WorkspaceVideo struct {
+	Auditable
+
+	WorkspaceID uint      `json:"workspace_id" gorm:"not null;uniqueIndex:idx_workspace_videos_workspace_video"`
+	Workspace   Workspace `json:"-" gorm:"foreignKey:WorkspaceID"`
+
+	// VideoID is the YouTube video identifier
+	VideoID string `json:"video_id" gorm:"not null;uniqueIndex:idx_workspace_videos_workspace_video"`
+	Title   string `json:"title"`
+	// Note is an optional teacher's note shown alongside the video
+	Note string `json:"note"`
+
+	AddedBy uint `json:"added_by" gorm:"not null"`
+}
+
+// TableName returns the table name for the WorkspaceVideo model
+func (WorkspaceVideo) TableName() string {
+	return "workspace_videos"
+}
+
+// WorkspaceVocabItem is one word in a workspace's shared vocabulary deck.
+// Sharing copies the content fields from a member's personal item, so the
+// deck stays intact when the sharer edits or deletes their own copy.
+type WorkspaceVocabItem struct {
+	Auditable
+
+	WorkspaceID uint      `json:"workspace_id" gorm:"not null;index"`
+	Workspace   Workspace `json:"-" gorm:"foreignKey:WorkspaceID"`
+
+	Word            string `json:"word" gorm:"not null"`
+	Reading         string `json:"reading"`
+	Definition      string `json:"definition"`
+	ExampleSentence string `json:"example_sentence"`
+	SourceVideoID   string `json:"source_video_id"`
+
+	AddedBy uint `json:"added_by" gorm:"not null"`
+}
+
+// TableName returns the table name for the WorkspaceVocabItem model
+func (WorkspaceVocabItem) TableName() string {
+	return "workspace_vocab_items"
+}
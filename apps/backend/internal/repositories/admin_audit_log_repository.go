@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+	"app-backend/internal/types"
+)
+
+type AdminAuditLogRepositoryInterface interface {
+	BaseRepositoryInterface[models.AdminAuditLog]
+	ListByTargetUser(targetUserID uint, req *types.PaginationRequest) (*types.PaginationResponse[models.AdminAuditLog], error)
+}
+
+type AdminAuditLogRepository struct {
+	*BaseRepository[models.AdminAuditLog]
+}
+
+func NewAdminAuditLogRepository(db *gorm.DB) AdminAuditLogRepositoryInterface {
+	return &AdminAuditLogRepository{
+		BaseRepository: NewBaseRepository[models.AdminAuditLog](db),
+	}
+}
+
+// ListByTargetUser returns the audit trail for one account with pagination
+func (r *AdminAuditLogRepository) ListByTargetUser(targetUserID uint, req *types.PaginationRequest) (*types.PaginationResponse[models.AdminAuditLog], error) {
+	opts := &QueryOptions{
+		Conditions:   map[string]interface{}{"target_user_id": targetUserID},
+		SearchFields: []string{"action", "detail"},
+	}
+	return r.List(req, opts)
+}
@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+)
+
+type APIKeyRepositoryInterface interface {
+	BaseRepositoryInterface[models.APIKey]
+	GetByKeyHash(keyHash string) (*models.APIKey, error)
+	GetByUserID(userID uint) ([]*models.APIKey, error)
+	RevokeKey(userID uint, keyID uint) error
+	UpdateLastUsed(keyID uint) error
+}
+
+type APIKeyRepository struct {
+	*BaseRepository[models.APIKey]
+}
+
+func NewAPIKeyRepository(db *gorm.DB) APIKeyRepositoryInterface {
+	return &APIKeyRepository{
+		BaseRepository: NewBaseRepository[models.APIKey](db),
+	}
+}
+
+// GetByKeyHash finds an active API key by its hash
+func (r *APIKeyRepository) GetByKeyHash(keyHash string) (*models.APIKey, error) {
+	var key models.APIKey
+	err := r.GetDB().Preload("User").Where("key_hash = ? AND is_active = ?", keyHash, true).First(&key).Error
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// GetByUserID retrieves all API keys belonging to a user, newest first
+func (r *APIKeyRepository) GetByUserID(userID uint) ([]*models.APIKey, error) {
+	var keys []*models.APIKey
+	err := r.GetDB().Where("user_id = ?", userID).Order("created_at DESC").Find(&keys).Error
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// RevokeKey deactivates a key, scoped to the owning user so one user cannot
+// revoke another's keys
+func (r *APIKeyRepository) RevokeKey(userID uint, keyID uint) error {
+	result := r.GetDB().Model(&models.APIKey{}).
+		Where("id = ? AND user_id = ?", keyID, userID).
+		Update("is_active", false)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// UpdateLastUsed updates the last used timestamp for a key
+func (r *APIKeyRepository) UpdateLastUsed(keyID uint) error {
+	return r.GetDB().Model(&models.APIKey{}).
+		Where("id = ?", keyID).
+		Update("last_used", time.Now()).Error
+}
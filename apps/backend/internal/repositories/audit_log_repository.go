@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+	"app-backend/internal/types"
+)
+
+type AuditLogRepositoryInterface interface {
+	BaseRepositoryInterface[models.AuditLog]
+	ListEvents(action string, actorID, targetUserID uint, req *types.PaginationRequest) (*types.PaginationResponse[models.AuditLog], error)
+}
+
+type AuditLogRepository struct {
+	*BaseRepository[models.AuditLog]
+}
+
+func NewAuditLogRepository(db *gorm.DB) AuditLogRepositoryInterface {
+	return &AuditLogRepository{
+		BaseRepository: NewBaseRepository[models.AuditLog](db),
+	}
+}
+
+// ListEvents returns audit events with pagination; zero-valued filters are
+// not applied
+func (r *AuditLogRepository) ListEvents(action string, actorID, targetUserID uint, req *types.PaginationRequest) (*types.PaginationResponse[models.AuditLog], error) {
+	conditions := map[string]interface{}{}
+	if action != "" {
+		conditions["action"] = action
+	}
+	if actorID != 0 {
+		conditions["actor_id"] = actorID
+	}
+	if targetUserID != 0 {
+		conditions["target_user_id"] = targetUserID
+	}
+
+	opts := &QueryOptions{
+		Conditions:   conditions,
+		SearchFields: []string{"action", "detail", "ip_address"},
+	}
+	return r.List(req, opts)
+}
@@ -1,8 +1,10 @@
 package repositories
 
 import (
+	"context"
+
 	"app-backend/internal/types"
-	
+
 	"gorm.io/gorm"
 )
 
@@ -22,6 +24,8 @@ type BaseRepositoryInterface[T any] interface {
 	List(req *types.PaginationRequest, opts *QueryOptions) (*types.PaginationResponse[T], error)
 	FindBy(field string, value interface{}) (*T, error)
 	FindAllBy(field string, value interface{}) ([]*T, error)
+	HardDeleteAllBy(field string, value interface{}) (int64, error)
+	WithContext(ctx context.Context) BaseRepositoryInterface[T]
 }
 
 // BaseRepository provides common database operations
@@ -95,14 +99,14 @@ func (r *BaseRepository[T]) List(req *types.PaginationRequest, opts *QueryOption
 	// Apply search conditions
 	if req.Search != "" && len(opts.SearchFields) > 0 {
 		searchQuery := r.db.Model(&entity)
-		
+
 		// Apply existing conditions to search query too
 		if opts.Conditions != nil {
 			for field, value := range opts.Conditions {
 				searchQuery = searchQuery.Where(field+" = ?", value)
 			}
 		}
-		
+
 		// Add search conditions
 		searchQuery = searchQuery.Where("1=0") // Start with false condition
 		for _, field := range opts.SearchFields {
@@ -129,7 +133,7 @@ func (r *BaseRepository[T]) List(req *types.PaginationRequest, opts *QueryOption
 			countQuery = countQuery.Or(field+" ILIKE ?", "%"+req.Search+"%")
 		}
 	}
-	
+
 	if err := countQuery.Count(&total).Error; err != nil {
 		return nil, err
 	}
@@ -140,7 +144,7 @@ func (r *BaseRepository[T]) List(req *types.PaginationRequest, opts *QueryOption
 		Offset(req.GetOffset()).
 		Limit(req.GetLimit()).
 		Find(&entities).Error
-		
+
 	if err != nil {
 		return nil, err
 	}
@@ -168,7 +172,23 @@ func (r *BaseRepository[T]) FindAllBy(field string, value interface{}) ([]*T, er
 	return entities, nil
 }
 
+// HardDeleteAllBy permanently removes every entity matching a field,
+// including soft-deleted rows, and returns how many rows were deleted
+func (r *BaseRepository[T]) HardDeleteAllBy(field string, value interface{}) (int64, error) {
+	var entity T
+	result := r.db.Unscoped().Where(field+" = ?", value).Delete(&entity)
+	return result.RowsAffected, result.Error
+}
+
+// WithContext returns a repository whose queries carry ctx. When a read
+// replica is configured, reads normally go to the replica; pass a context
+// from database.WithPrimary to pin them to the primary and observe writes
+// that may not have replicated yet.
+func (r *BaseRepository[T]) WithContext(ctx context.Context) BaseRepositoryInterface[T] {
+	return &BaseRepository[T]{db: r.db.WithContext(ctx)}
+}
+
 // GetDB returns the database instance for custom queries
 func (r *BaseRepository[T]) GetDB() *gorm.DB {
 	return r.db
-}
\ No newline at end of file
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"app-backend/internal/models"
+)
+
+type DailyActivityRepositoryInterface interface {
+	BaseRepositoryInterface[models.DailyActivity]
+	AddActivity(userID uint, date string, delta *models.DailyActivity) error
+	ListRange(userID uint, fromDate string, toDate string) ([]*models.DailyActivity, error)
+}
+
+type DailyActivityRepository struct {
+	*BaseRepository[models.DailyActivity]
+}
+
+func NewDailyActivityRepository(db *gorm.DB) DailyActivityRepositoryInterface {
+	return &DailyActivityRepository{
+		BaseRepository: NewBaseRepository[models.DailyActivity](db),
+	}
+}
+
+// AddActivity atomically adds the delta's counters onto the user's row for
+// the day, creating the row on first activity
+func (r *DailyActivityRepository) AddActivity(userID uint, date string, delta *models.DailyActivity) error {
+	row := &models.DailyActivity{
+		UserID:             userID,
+		Date:               date,
+		VideosWatched:      delta.VideosWatched,
+		SegmentsTranslated: delta.SegmentsTranslated,
+		VocabReviewed:      delta.VocabReviewed,
+		StudySeconds:       delta.StudySeconds,
+	}
+	return r.GetDB().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "date"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"videos_watched":      gorm.Expr("videos_watched + ?", delta.VideosWatched),
+			"segments_translated": gorm.Expr("segments_translated + ?", delta.SegmentsTranslated),
+			"vocab_reviewed":      gorm.Expr("vocab_reviewed + ?", delta.VocabReviewed),
+			"study_seconds":       gorm.Expr("study_seconds + ?", delta.StudySeconds),
+		}),
+	}).Create(row).Error
+}
+
+// ListRange returns the user's activity rows between the dates inclusive,
+// oldest first. Days without activity have no row.
+func (r *DailyActivityRepository) ListRange(userID uint, fromDate string, toDate string) ([]*models.DailyActivity, error) {
+	var rows []*models.DailyActivity
+	err := r.GetDB().
+		Where("user_id = ? AND date >= ? AND date <= ?", userID, fromDate, toDate).
+		Order("date ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
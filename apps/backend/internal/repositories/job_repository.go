@@ -0,0 +1,135 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+)
+
+type JobRepositoryInterface interface {
+	BaseRepositoryInterface[models.Job]
+	GetByUserAndID(userID uint, jobID uint) (*models.Job, error)
+	UpdateResult(jobID uint, result string) error
+	MarkCompleted(jobID uint, result string, finishedAt time.Time) error
+	MarkFailed(jobID uint, lastError string, finishedAt time.Time) error
+	Reschedule(jobID uint, lastError string, nextRunAt time.Time) error
+	ClaimDue(limit int, now time.Time) ([]*models.Job, error)
+	RequeueRunning() (int64, error)
+}
+
+type JobRepository struct {
+	*BaseRepository[models.Job]
+}
+
+func NewJobRepository(db *gorm.DB) JobRepositoryInterface {
+	return &JobRepository{
+		BaseRepository: NewBaseRepository[models.Job](db),
+	}
+}
+
+// GetByUserAndID finds one of the user's jobs
+func (r *JobRepository) GetByUserAndID(userID uint, jobID uint) (*models.Job, error) {
+	var job models.Job
+	err := r.GetDB().Where("id = ? AND user_id = ?", jobID, userID).First(&job).Error
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// UpdateResult overwrites a job's result JSON without touching its status
+func (r *JobRepository) UpdateResult(jobID uint, result string) error {
+	return r.GetDB().Model(&models.Job{}).
+		Where("id = ?", jobID).
+		Update("result", result).Error
+}
+
+// MarkCompleted finalizes a successful job with its result JSON
+func (r *JobRepository) MarkCompleted(jobID uint, result string, finishedAt time.Time) error {
+	return r.GetDB().Model(&models.Job{}).
+		Where("id = ?", jobID).
+		Updates(map[string]interface{}{
+			"status":      models.JobStatusCompleted,
+			"result":      result,
+			"last_error":  "",
+			"finished_at": finishedAt,
+		}).Error
+}
+
+// MarkFailed finalizes a job that exhausted its attempts, keeping whatever
+// result its handler last reported
+func (r *JobRepository) MarkFailed(jobID uint, lastError string, finishedAt time.Time) error {
+	return r.GetDB().Model(&models.Job{}).
+		Where("id = ?", jobID).
+		Updates(map[string]interface{}{
+			"status":      models.JobStatusFailed,
+			"last_error":  lastError,
+			"finished_at": finishedAt,
+		}).Error
+}
+
+// Reschedule returns a failed attempt to the queue to retry at nextRunAt
+func (r *JobRepository) Reschedule(jobID uint, lastError string, nextRunAt time.Time) error {
+	return r.GetDB().Model(&models.Job{}).
+		Where("id = ?", jobID).
+		Updates(map[string]interface{}{
+			"status":      models.JobStatusQueued,
+			"last_error":  lastError,
+			"next_run_at": nextRunAt,
+		}).Error
+}
+
+// ClaimDue atomically moves up to limit due queued jobs to running and
+// returns them, oldest due first. Each claim is a conditional update on the
+// queued status, so two pollers can never claim the same job.
+func (r *JobRepository) ClaimDue(limit int, now time.Time) ([]*models.Job, error) {
+	var candidates []models.Job
+	err := r.GetDB().
+		Where("status = ? AND next_run_at <= ?", models.JobStatusQueued, now).
+		Order("next_run_at ASC").
+		Limit(limit).
+		Find(&candidates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make([]*models.Job, 0, len(candidates))
+	for i := range candidates {
+		job := &candidates[i]
+		result := r.GetDB().Model(&models.Job{}).
+			Where("id = ? AND status = ?", job.ID, models.JobStatusQueued).
+			Updates(map[string]interface{}{
+				"status":     models.JobStatusRunning,
+				"started_at": now,
+				"attempts":   gorm.Expr("attempts + 1"),
+			})
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			// Claimed by a concurrent poller in the meantime
+			continue
+		}
+
+		job.Status = models.JobStatusRunning
+		job.StartedAt = &now
+		job.Attempts++
+		claimed = append(claimed, job)
+	}
+
+	return claimed, nil
+}
+
+// RequeueRunning moves every running job back to queued. Used on startup and
+// shutdown so jobs interrupted mid-flight are retried instead of stuck.
+func (r *JobRepository) RequeueRunning() (int64, error) {
+	result := r.GetDB().Model(&models.Job{}).
+		Where("status = ?", models.JobStatusRunning).
+		Updates(map[string]interface{}{
+			"status":      models.JobStatusQueued,
+			"next_run_at": time.Now().UTC(),
+		})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"app-backend/internal/models"
+)
+
+type OAuthTokenRepositoryInterface interface {
+	BaseRepositoryInterface[models.OAuthToken]
+	GetByUserAndProvider(userID uint, provider string) (*models.OAuthToken, error)
+	Upsert(token *models.OAuthToken) error
+	DeleteByUserAndProvider(userID uint, provider string) error
+}
+
+type OAuthTokenRepository struct {
+	*BaseRepository[models.OAuthToken]
+}
+
+func NewOAuthTokenRepository(db *gorm.DB) OAuthTokenRepositoryInterface {
+	return &OAuthTokenRepository{
+		BaseRepository: NewBaseRepository[models.OAuthToken](db),
+	}
+}
+
+// GetByUserAndProvider finds the user's stored token for a provider
+func (r *OAuthTokenRepository) GetByUserAndProvider(userID uint, provider string) (*models.OAuthToken, error) {
+	var token models.OAuthToken
+	err := r.GetDB().Where("user_id = ? AND provider = ?", userID, provider).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Upsert creates the token row or replaces the ciphertext of an existing one
+// for the same user and provider
+func (r *OAuthTokenRepository) Upsert(token *models.OAuthToken) error {
+	return r.GetDB().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "provider"}},
+		DoUpdates: clause.AssignmentColumns([]string{"ciphertext", "updated_at"}),
+	}).Create(token).Error
+}
+
+// DeleteByUserAndProvider removes the user's stored token for a provider.
+// Tokens are deleted for real rather than soft-deleted: keeping revoked
+// credential ciphertext around serves nobody, and a lingering soft-deleted
+// row would collide with the unique index when the user re-links.
+func (r *OAuthTokenRepository) DeleteByUserAndProvider(userID uint, provider string) error {
+	result := r.GetDB().Unscoped().Where("user_id = ? AND provider = ?", userID, provider).
+		Delete(&models.OAuthToken{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+	"app-backend/internal/types"
+)
+
+type PronunciationAttemptRepositoryInterface interface {
+	BaseRepositoryInterface[models.PronunciationAttempt]
+	ListByUser(userID uint, req *types.PaginationRequest) (*types.PaginationResponse[models.PronunciationAttempt], error)
+	ListByUserSince(userID uint, since time.Time) ([]*models.PronunciationAttempt, error)
+}
+
+type PronunciationAttemptRepository struct {
+	*BaseRepository[models.PronunciationAttempt]
+}
+
+func NewPronunciationAttemptRepository(db *gorm.DB) PronunciationAttemptRepositoryInterface {
+	return &PronunciationAttemptRepository{
+		BaseRepository: NewBaseRepository[models.PronunciationAttempt](db),
+	}
+}
+
+// ListByUser returns a user's pronunciation attempts with pagination
+func (r *PronunciationAttemptRepository) ListByUser(userID uint, req *types.PaginationRequest) (*types.PaginationResponse[models.PronunciationAttempt], error) {
+	opts := &QueryOptions{
+		Conditions: map[string]interface{}{"user_id": userID},
+	}
+	return r.List(req, opts)
+}
+
+// ListByUserSince returns a user's attempts recorded after the given time,
+// oldest first, for progress graphs
+func (r *PronunciationAttemptRepository) ListByUserSince(userID uint, since time.Time) ([]*models.PronunciationAttempt, error) {
+	var attempts []*models.PronunciationAttempt
+	err := r.GetDB().
+		Where("user_id = ? AND created_at >= ?", userID, since).
+		Order("created_at ASC").
+		Find(&attempts).Error
+	if err != nil {
+		return nil, err
+	}
+	return attempts, nil
+}
@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"time"
+
+	"app-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// ProviderOutcomeStats aggregates provider outcomes over a time window
+type ProviderOutcomeStats struct {
+	Provider     string  `json:"provider"`
+	Attempts     int64   `json:"attempts"`
+	Successes    int64   `json:"successes"`
+	Served       int64   `json:"served"`
+	SuccessRate  float64 `json:"successRate"`
+	AvgLatencyMs float64 `json:"avgLatencyMs"`
+}
+
+// ProviderOutcomeRepositoryInterface extends base repository with analytics queries
+type ProviderOutcomeRepositoryInterface interface {
+	BaseRepositoryInterface[models.ProviderOutcome]
+	AggregateSince(since time.Time) ([]ProviderOutcomeStats, error)
+}
+
+// ProviderOutcomeRepository implements provider outcome persistence and aggregation
+type ProviderOutcomeRepository struct {
+	*BaseRepository[models.ProviderOutcome]
+}
+
+// NewProviderOutcomeRepository creates a new provider outcome repository
+func NewProviderOutcomeRepository(db *gorm.DB) ProviderOutcomeRepositoryInterface {
+	return &ProviderOutcomeRepository{
+		BaseRepository: NewBaseRepository[models.ProviderOutcome](db),
+	}
+}
+
+// AggregateSince returns per-provider success rates and latency for outcomes
+// recorded after the given time
+func (r *ProviderOutcomeRepository) AggregateSince(since time.Time) ([]ProviderOutcomeStats, error) {
+	var stats []ProviderOutcomeStats
+
+	err := r.GetDB().Model(&models.ProviderOutcome{}).
+		Select(`provider,
+			COUNT(*) AS attempts,
+			SUM(CASE WHEN success THEN 1 ELSE 0 END) AS successes,
+			SUM(CASE WHEN served THEN 1 ELSE 0 END) AS served,
+			AVG(latency_ms) AS avg_latency_ms`).
+		Where("created_at >= ?", since).
+		Group("provider").
+		Order("provider").
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range stats {
+		if stats[i].Attempts > 0 {
+			stats[i].SuccessRate = float64(stats[i].Successes) / float64(stats[i].Attempts)
+		}
+	}
+
+	return stats, nil
+}
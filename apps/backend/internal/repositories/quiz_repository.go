@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+	"app-backend/internal/types"
+)
+
+type QuizRepositoryInterface interface {
+	BaseRepositoryInterface[models.Quiz]
+	ListByUser(userID uint, req *types.PaginationRequest) (*types.PaginationResponse[models.Quiz], error)
+}
+
+type QuizRepository struct {
+	*BaseRepository[models.Quiz]
+}
+
+func NewQuizRepository(db *gorm.DB) QuizRepositoryInterface {
+	return &QuizRepository{
+		BaseRepository: NewBaseRepository[models.Quiz](db),
+	}
+}
+
+// ListByUser returns a user's generated quizzes with pagination
+func (r *QuizRepository) ListByUser(userID uint, req *types.PaginationRequest) (*types.PaginationResponse[models.Quiz], error) {
+	opts := &QueryOptions{
+		Conditions: map[string]interface{}{"user_id": userID},
+	}
+	return r.List(req, opts)
+}
+
+type QuizSubmissionRepositoryInterface interface {
+	BaseRepositoryInterface[models.QuizSubmission]
+	ListByQuiz(quizID uint) ([]*models.QuizSubmission, error)
+}
+
+type QuizSubmissionRepository struct {
+	*BaseRepository[models.QuizSubmission]
+}
+
+func NewQuizSubmissionRepository(db *gorm.DB) QuizSubmissionRepositoryInterface {
+	return &QuizSubmissionRepository{
+		BaseRepository: NewBaseRepository[models.QuizSubmission](db),
+	}
+}
+
+// ListByQuiz returns the submissions recorded for a quiz, oldest first
+func (r *QuizSubmissionRepository) ListByQuiz(quizID uint) ([]*models.QuizSubmission, error) {
+	var submissions []*models.QuizSubmission
+	err := r.GetDB().
+		Where("quiz_id = ?", quizID).
+		Order("created_at ASC").
+		Find(&submissions).Error
+	if err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
@@ -46,25 +46,25 @@ func (r *SessionRepository) GetActiveSessionsByUserID(userID uint) ([]*models.Se
 			"is_active": true,
 		},
 	}
-	
+
 	req := &types.PaginationRequest{
 		Page:     1,
 		PageSize: 100, // Get all active sessions
 		SortBy:   "last_used",
 		SortDir:  "desc",
 	}
-	
+
 	response, err := r.List(req, opts)
 	if err != nil {
 		return nil, err
 	}
-	
+
 	// Convert to slice of pointers
 	sessions := make([]*models.Session, len(response.Data))
 	for i := range response.Data {
 		sessions[i] = &response.Data[i]
 	}
-	
+
 	return sessions, nil
 }
 
@@ -92,4 +92,4 @@ func (r *SessionRepository) UpdateLastUsed(sessionID uint) error {
 	return r.GetDB().Model(&models.Session{}).
 		Where("id = ?", sessionID).
 		Update("last_used", time.Now()).Error
-}
\ No newline at end of file
+}
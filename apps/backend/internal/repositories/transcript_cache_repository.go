@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"time"
+
+	"app-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// TranscriptCacheRepositoryInterface extends base repository with cache lookups
+// keyed by video ID and language, plus per-video invalidation
+type TranscriptCacheRepositoryInterface interface {
+	BaseRepositoryInterface[models.VideoTranscriptCache]
+	GetLatest(videoID, language string) (*models.VideoTranscriptCache, error)
+	DeleteByVideo(videoID string) (int64, error)
+	DeleteExpired() (int64, error)
+}
+
+// TranscriptCacheRepository implements persistent transcript cache storage
+type TranscriptCacheRepository struct {
+	*BaseRepository[models.VideoTranscriptCache]
+}
+
+// NewTranscriptCacheRepository creates a new transcript cache repository
+func NewTranscriptCacheRepository(db *gorm.DB) TranscriptCacheRepositoryInterface {
+	return &TranscriptCacheRepository{
+		BaseRepository: NewBaseRepository[models.VideoTranscriptCache](db),
+	}
+}
+
+// GetLatest returns the most recent unexpired cache entry for the video and
+// language, or nil when none exists
+func (r *TranscriptCacheRepository) GetLatest(videoID, language string) (*models.VideoTranscriptCache, error) {
+	var record models.VideoTranscriptCache
+	err := r.GetDB().
+		Where("video_id = ? AND language = ? AND expires_at > ?", videoID, language, time.Now()).
+		Order("created_at DESC").
+		First(&record).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &record, nil
+}
+
+// DeleteByVideo removes all cache entries for the video across languages and
+// providers, returning how many entries were removed
+func (r *TranscriptCacheRepository) DeleteByVideo(videoID string) (int64, error) {
+	result := r.GetDB().
+		Where("video_id = ?", videoID).
+		Delete(&models.VideoTranscriptCache{})
+	return result.RowsAffected, result.Error
+}
+
+// DeleteExpired removes cache entries past their expiry, returning how many
+// entries were removed
+func (r *TranscriptCacheRepository) DeleteExpired() (int64, error) {
+	result := r.GetDB().
+		Where("expires_at <= ?", time.Now()).
+		Delete(&models.VideoTranscriptCache{})
+	return result.RowsAffected, result.Error
+}
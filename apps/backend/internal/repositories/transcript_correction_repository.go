@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+)
+
+type TranscriptCorrectionRepositoryInterface interface {
+	BaseRepositoryInterface[models.TranscriptCorrection]
+	ListByUserAndVideo(userID uint, videoID string) ([]models.TranscriptCorrection, error)
+	LatestVersion(userID uint, videoID string) (int, error)
+}
+
+type TranscriptCorrectionRepository struct {
+	*BaseRepository[models.TranscriptCorrection]
+}
+
+func NewTranscriptCorrectionRepository(db *gorm.DB) TranscriptCorrectionRepositoryInterface {
+	return &TranscriptCorrectionRepository{
+		BaseRepository: NewBaseRepository[models.TranscriptCorrection](db),
+	}
+}
+
+// ListByUserAndVideo returns a user's corrections for one video oldest first,
+// so replaying them in order reconstructs every transcript version
+func (r *TranscriptCorrectionRepository) ListByUserAndVideo(userID uint, videoID string) ([]models.TranscriptCorrection, error) {
+	var corrections []models.TranscriptCorrection
+	err := r.GetDB().
+		Where("user_id = ? AND video_id = ?", userID, videoID).
+		Order("version ASC").
+		Find(&corrections).Error
+	if err != nil {
+		return nil, err
+	}
+	return corrections, nil
+}
+
+// LatestVersion returns the highest version number recorded for a user and
+// video, or zero when no corrections exist yet
+func (r *TranscriptCorrectionRepository) LatestVersion(userID uint, videoID string) (int, error) {
+	var version int
+	err := r.GetDB().
+		Model(&models.TranscriptCorrection{}).
+		Where("user_id = ? AND video_id = ?", userID, videoID).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&version).Error
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+)
+
+type TranscriptSearchRepositoryInterface interface {
+	BaseRepositoryInterface[models.TranscriptSegmentIndex]
+	ReplaceForVideo(videoID, language string, segments []models.TranscriptSegmentIndex) error
+	Search(query string, limit int) ([]*models.TranscriptSegmentIndex, error)
+	DeleteByVideo(videoID string) (int64, error)
+}
+
+type TranscriptSearchRepository struct {
+	*BaseRepository[models.TranscriptSegmentIndex]
+}
+
+func NewTranscriptSearchRepository(db *gorm.DB) TranscriptSearchRepositoryInterface {
+	return &TranscriptSearchRepository{
+		BaseRepository: NewBaseRepository[models.TranscriptSegmentIndex](db),
+	}
+}
+
+// ReplaceForVideo swaps the indexed segments for a video and language in one
+// transaction, so a re-fetched transcript never leaves stale rows behind
+func (r *TranscriptSearchRepository) ReplaceForVideo(videoID, language string, segments []models.TranscriptSegmentIndex) error {
+	return r.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().
+			Where("video_id = ? AND language = ?", videoID, language).
+			Delete(&models.TranscriptSegmentIndex{}).Error; err != nil {
+			return err
+		}
+		if len(segments) == 0 {
+			return nil
+		}
+		return tx.Create(&segments).Error
+	})
+}
+
+// Search returns indexed segments matching the query, in playback order per
+// video. On Postgres the match uses full-text search backed by a GIN index;
+// other dialects (the in-memory test database) fall back to a substring scan.
+func (r *TranscriptSearchRepository) Search(query string, limit int) ([]*models.TranscriptSegmentIndex, error) {
+	db := r.GetDB()
+
+	var matches []*models.TranscriptSegmentIndex
+	search := db.Model(&models.TranscriptSegmentIndex{})
+	if db.Dialector.Name() == "postgres" {
+		search = search.Where("to_tsvector('simple', text) @@ plainto_tsquery('simple', ?)", query)
+	} else {
+		search = search.Where(`LOWER(text) LIKE ? ESCAPE '\'`, "%"+strings.ToLower(escapeLike(query))+"%")
+	}
+
+	err := search.
+		Order("video_id ASC, start_ms ASC").
+		Limit(limit).
+		Find(&matches).Error
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// DeleteByVideo removes all indexed segments for the video across languages,
+// returning how many rows were removed
+func (r *TranscriptSearchRepository) DeleteByVideo(videoID string) (int64, error) {
+	result := r.GetDB().Unscoped().
+		Where("video_id = ?", videoID).
+		Delete(&models.TranscriptSegmentIndex{})
+	return result.RowsAffected, result.Error
+}
+
+// escapeLike escapes LIKE metacharacters so a query matches them literally
+func escapeLike(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(s)
+}
@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"time"
+
+	"app-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// TranslationUsageRepositoryInterface extends base repository with usage aggregation
+type TranslationUsageRepositoryInterface interface {
+	BaseRepositoryInterface[models.TranslationUsage]
+	SumCharsSince(userID uint, since time.Time) (int64, error)
+}
+
+// TranslationUsageRepository implements translation usage persistence
+type TranslationUsageRepository struct {
+	*BaseRepository[models.TranslationUsage]
+}
+
+// NewTranslationUsageRepository creates a new translation usage repository
+func NewTranslationUsageRepository(db *gorm.DB) TranslationUsageRepositoryInterface {
+	return &TranslationUsageRepository{
+		BaseRepository: NewBaseRepository[models.TranslationUsage](db),
+	}
+}
+
+// SumCharsSince returns the total characters the user translated after the
+// given time
+func (r *TranslationUsageRepository) SumCharsSince(userID uint, since time.Time) (int64, error) {
+	var total int64
+
+	err := r.GetDB().Model(&models.TranslationUsage{}).
+		Select("COALESCE(SUM(char_count), 0)").
+		Where("user_id = ? AND created_at >= ?", userID, since).
+		Scan(&total).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
@@ -3,7 +3,8 @@ package repositories
 import (
 	"app-backend/internal/models"
 	"app-backend/internal/types"
-	
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -12,6 +13,8 @@ type UserRepositoryInterface interface {
 	BaseRepositoryInterface[models.User]
 	GetByEmail(email string) (*models.User, error)
 	GetActiveUsers(req *types.PaginationRequest) (*types.PaginationResponse[models.User], error)
+	ListDeletedBefore(cutoff time.Time) ([]*models.User, error)
+	HardDelete(id uint) error
 }
 
 // UserRepository implements user-specific repository
@@ -40,4 +43,22 @@ func (r *UserRepository) GetActiveUsers(req *types.PaginationRequest) (*types.Pa
 		SearchFields: []string{"first_name", "last_name", "email"},
 	}
 	return r.List(req, opts)
-}
\ No newline at end of file
+}
+
+// ListDeletedBefore returns users whose soft deletion happened before the
+// cutoff, so the purge worker can find accounts past their retention window
+func (r *UserRepository) ListDeletedBefore(cutoff time.Time) ([]*models.User, error) {
+	var users []*models.User
+	err := r.GetDB().Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// HardDelete permanently removes a user row, including soft-deleted ones
+func (r *UserRepository) HardDelete(id uint) error {
+	return r.GetDB().Unscoped().Delete(&models.User{}, id).Error
+}
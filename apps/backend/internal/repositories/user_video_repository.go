@@ -0,0 +1,57 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+	"app-backend/internal/types"
+)
+
+type UserVideoRepositoryInterface interface {
+	BaseRepositoryInterface[models.UserVideo]
+	GetByUserAndVideo(userID uint, videoID string) (*models.UserVideo, error)
+	ListByUser(userID uint, req *types.PaginationRequest) (*types.PaginationResponse[models.UserVideo], error)
+	DeleteByUserAndVideo(userID uint, videoID string) error
+}
+
+type UserVideoRepository struct {
+	*BaseRepository[models.UserVideo]
+}
+
+func NewUserVideoRepository(db *gorm.DB) UserVideoRepositoryInterface {
+	return &UserVideoRepository{
+		BaseRepository: NewBaseRepository[models.UserVideo](db),
+	}
+}
+
+// GetByUserAndVideo finds a watch-history entry scoped to the owning user
+func (r *UserVideoRepository) GetByUserAndVideo(userID uint, videoID string) (*models.UserVideo, error) {
+	var video models.UserVideo
+	err := r.GetDB().Where("user_id = ? AND video_id = ?", userID, videoID).First(&video).Error
+	if err != nil {
+		return nil, err
+	}
+	return &video, nil
+}
+
+// ListByUser returns a user's watch history with pagination and search
+func (r *UserVideoRepository) ListByUser(userID uint, req *types.PaginationRequest) (*types.PaginationResponse[models.UserVideo], error) {
+	opts := &QueryOptions{
+		Conditions:   map[string]interface{}{"user_id": userID},
+		SearchFields: []string{"title", "video_id"},
+	}
+	return r.List(req, opts)
+}
+
+// DeleteByUserAndVideo removes an entry, scoped to the owning user so one
+// user cannot delete another's history
+func (r *UserVideoRepository) DeleteByUserAndVideo(userID uint, videoID string) error {
+	result := r.GetDB().Where("user_id = ? AND video_id = ?", userID, videoID).Delete(&models.UserVideo{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
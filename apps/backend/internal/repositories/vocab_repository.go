@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+	"app-backend/internal/types"
+)
+
+type VocabRepositoryInterface interface {
+	BaseRepositoryInterface[models.VocabItem]
+	GetByUserAndID(userID uint, itemID uint) (*models.VocabItem, error)
+	ListByUser(userID uint, req *types.PaginationRequest) (*types.PaginationResponse[models.VocabItem], error)
+	GetDueForReview(userID uint, now time.Time, limit int) ([]*models.VocabItem, error)
+	DeleteByUserAndID(userID uint, itemID uint) error
+}
+
+type VocabRepository struct {
+	*BaseRepository[models.VocabItem]
+}
+
+func NewVocabRepository(db *gorm.DB) VocabRepositoryInterface {
+	return &VocabRepository{
+		BaseRepository: NewBaseRepository[models.VocabItem](db),
+	}
+}
+
+// GetByUserAndID finds a vocabulary item scoped to the owning user
+func (r *VocabRepository) GetByUserAndID(userID uint, itemID uint) (*models.VocabItem, error) {
+	var item models.VocabItem
+	err := r.GetDB().Where("id = ? AND user_id = ?", itemID, userID).First(&item).Error
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// ListByUser returns a user's vocabulary items with pagination and search
+func (r *VocabRepository) ListByUser(userID uint, req *types.PaginationRequest) (*types.PaginationResponse[models.VocabItem], error) {
+	opts := &QueryOptions{
+		Conditions:   map[string]interface{}{"user_id": userID},
+		SearchFields: []string{"word", "definition"},
+	}
+	return r.List(req, opts)
+}
+
+// GetDueForReview returns the user's items whose next review time has
+// passed, most overdue first
+func (r *VocabRepository) GetDueForReview(userID uint, now time.Time, limit int) ([]*models.VocabItem, error) {
+	var items []*models.VocabItem
+	query := r.GetDB().
+		Where("user_id = ? AND next_review_at <= ?", userID, now).
+		Order("next_review_at ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if err := query.Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// DeleteByUserAndID removes an item, scoped to the owning user so one user
+// cannot delete another's entries
+func (r *VocabRepository) DeleteByUserAndID(userID uint, itemID uint) error {
+	result := r.GetDB().Where("id = ? AND user_id = ?", itemID, userID).Delete(&models.VocabItem{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+)
+
+type WorkspaceVideoRepositoryInterface interface {
+	BaseRepositoryInterface[models.WorkspaceVideo]
+	GetByWorkspaceAndVideo(workspaceID uint, videoID string) (*models.WorkspaceVideo, error)
+	GetByWorkspaceAndID(workspaceID uint, entryID uint) (*models.WorkspaceVideo, error)
+	ListByWorkspace(workspaceID uint) ([]*models.WorkspaceVideo, error)
+}
+
+type WorkspaceVideoRepository struct {
+	*BaseRepository[models.WorkspaceVideo]
+}
+
+func NewWorkspaceVideoRepository(db *gorm.DB) WorkspaceVideoRepositoryInterface {
+	return &WorkspaceVideoRepository{
+		BaseRepository: NewBaseRepository[models.WorkspaceVideo](db),
+	}
+}
+
+// GetByWorkspaceAndVideo finds a curated entry by its YouTube video ID
+func (r *WorkspaceVideoRepository) GetByWorkspaceAndVideo(workspaceID uint, videoID string) (*models.WorkspaceVideo, error) {
+	var entry models.WorkspaceVideo
+	err := r.GetDB().Where("workspace_id = ? AND video_id = ?", workspaceID, videoID).First(&entry).Error
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// GetByWorkspaceAndID finds a curated entry scoped to the workspace
+func (r *WorkspaceVideoRepository) GetByWorkspaceAndID(workspaceID uint, entryID uint) (*models.WorkspaceVideo, error) {
+	var entry models.WorkspaceVideo
+	err := r.GetDB().Where("id = ? AND workspace_id = ?", entryID, workspaceID).First(&entry).Error
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// ListByWorkspace returns a workspace's curated videos, newest first
+func (r *WorkspaceVideoRepository) ListByWorkspace(workspaceID uint) ([]*models.WorkspaceVideo, error) {
+	var entries []*models.WorkspaceVideo
+	err := r.GetDB().Where("workspace_id = ?", workspaceID).Order("created_at DESC").Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+type WorkspaceVocabRepositoryInterface interface {
+	BaseRepositoryInterface[models.WorkspaceVocabItem]
+	GetByWorkspaceAndWord(workspaceID uint, word string) (*models.WorkspaceVocabItem, error)
+	ListByWorkspace(workspaceID uint) ([]*models.WorkspaceVocabItem, error)
+}
+
+type WorkspaceVocabRepository struct {
+	*BaseRepository[models.WorkspaceVocabItem]
+}
+
+func NewWorkspaceVocabRepository(db *gorm.DB) WorkspaceVocabRepositoryInterface {
+	return &WorkspaceVocabRepository{
+		BaseRepository: NewBaseRepository[models.WorkspaceVocabItem](db),
+	}
+}
+
+// GetByWorkspaceAndWord finds a shared deck entry by its word
+func (r *WorkspaceVocabRepository) GetByWorkspaceAndWord(workspaceID uint, word string) (*models.WorkspaceVocabItem, error) {
+	var item models.WorkspaceVocabItem
+	err := r.GetDB().Where("workspace_id = ? AND word = ?", workspaceID, word).First(&item).Error
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// ListByWorkspace returns a workspace's shared vocabulary deck, newest first
+func (r *WorkspaceVocabRepository) ListByWorkspace(workspaceID uint) ([]*models.WorkspaceVocabItem, error) {
+	var items []*models.WorkspaceVocabItem
+	err := r.GetDB().Where("workspace_id = ?", workspaceID).Order("created_at DESC").Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
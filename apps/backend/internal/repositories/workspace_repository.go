@@ -0,0 +1,132 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+)
+
+type WorkspaceRepositoryInterface interface {
+	BaseRepositoryInterface[models.Workspace]
+	ListByMember(userID uint) ([]*models.Workspace, error)
+}
+
+type WorkspaceRepository struct {
+	*BaseRepository[models.Workspace]
+}
+
+func NewWorkspaceRepository(db *gorm.DB) WorkspaceRepositoryInterface {
+	return &WorkspaceRepository{
+		BaseRepository: NewBaseRepository[models.Workspace](db),
+	}
+}
+
+// ListByMember returns every workspace the user belongs to, newest first
+func (r *WorkspaceRepository) ListByMember(userID uint) ([]*models.Workspace, error) {
+	var workspaces []*models.Workspace
+	err := r.GetDB().
+		Joins("JOIN workspace_members ON workspace_members.workspace_id = workspaces.id").
+		Where("workspace_members.user_id = ? AND workspace_members.deleted_at IS NULL", userID).
+		Order("workspaces.created_at DESC").
+		Find(&workspaces).Error
+	if err != nil {
+		return nil, err
+	}
+	return workspaces, nil
+}
+
+type WorkspaceMemberRepositoryInterface interface {
+	BaseRepositoryInterface[models.WorkspaceMember]
+	GetByWorkspaceAndUser(workspaceID uint, userID uint) (*models.WorkspaceMember, error)
+	ListByWorkspace(workspaceID uint) ([]*models.WorkspaceMember, error)
+}
+
+type WorkspaceMemberRepository struct {
+	*BaseRepository[models.WorkspaceMember]
+}
+
+func NewWorkspaceMemberRepository(db *gorm.DB) WorkspaceMemberRepositoryInterface {
+	return &WorkspaceMemberRepository{
+		BaseRepository: NewBaseRepository[models.WorkspaceMember](db),
+	}
+}
+
+// GetByWorkspaceAndUser finds a user's membership in a workspace
+func (r *WorkspaceMemberRepository) GetByWorkspaceAndUser(workspaceID uint, userID uint) (*models.WorkspaceMember, error) {
+	var member models.WorkspaceMember
+	err := r.GetDB().Where("workspace_id = ? AND user_id = ?", workspaceID, userID).First(&member).Error
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// ListByWorkspace returns a workspace's members with their users preloaded,
+// oldest membership first so the owner leads the list
+func (r *WorkspaceMemberRepository) ListByWorkspace(workspaceID uint) ([]*models.WorkspaceMember, error) {
+	var members []*models.WorkspaceMember
+	err := r.GetDB().
+		Preload("User").
+		Where("workspace_id = ?", workspaceID).
+		Order("created_at ASC").
+		Find(&members).Error
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+type WorkspaceInviteRepositoryInterface interface {
+	BaseRepositoryInterface[models.WorkspaceInvite]
+	GetByToken(token string) (*models.WorkspaceInvite, error)
+	GetPendingByWorkspaceAndEmail(workspaceID uint, email string, now time.Time) (*models.WorkspaceInvite, error)
+	ListPendingByWorkspace(workspaceID uint, now time.Time) ([]*models.WorkspaceInvite, error)
+}
+
+type WorkspaceInviteRepository struct {
+	*BaseRepository[models.WorkspaceInvite]
+}
+
+func NewWorkspaceInviteRepository(db *gorm.DB) WorkspaceInviteRepositoryInterface {
+	return &WorkspaceInviteRepository{
+		BaseRepository: NewBaseRepository[models.WorkspaceInvite](db),
+	}
+}
+
+// GetByToken finds an invite by its redemption token
+func (r *WorkspaceInviteRepository) GetByToken(token string) (*models.WorkspaceInvite, error) {
+	var invite models.WorkspaceInvite
+	err := r.GetDB().Where("token = ?", token).First(&invite).Error
+	if err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+// GetPendingByWorkspaceAndEmail finds an unaccepted, unexpired invite for the
+// email in the workspace
+func (r *WorkspaceInviteRepository) GetPendingByWorkspaceAndEmail(workspaceID uint, email string, now time.Time) (*models.WorkspaceInvite, error) {
+	var invite models.WorkspaceInvite
+	err := r.GetDB().
+		Where("workspace_id = ? AND email = ? AND accepted_at IS NULL AND expires_at > ?", workspaceID, email, now).
+		First(&invite).Error
+	if err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+// ListPendingByWorkspace returns a workspace's unaccepted, unexpired invites
+func (r *WorkspaceInviteRepository) ListPendingByWorkspace(workspaceID uint, now time.Time) ([]*models.WorkspaceInvite, error) {
+	var invites []*models.WorkspaceInvite
+	err := r.GetDB().
+		Where("workspace_id = ? AND accepted_at IS NULL AND expires_at > ?", workspaceID, now).
+		Order("created_at DESC").
+		Find(&invites).Error
+	if err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
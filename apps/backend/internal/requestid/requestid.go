@@ -0,0 +1,60 @@
+// Package requestid propagates the per-request ID from incoming HTTP
+// requests to outbound calls so external failures can be correlated with the
+// originating request across systems.
+package requestid
+
+import (
+	"context"
+	"net/http"
+)
+
+// Header is the HTTP header carrying the request ID on inbound and outbound
+// requests
+const Header = "X-Request-ID"
+
+// contextKey is a private type so only this package can write the value
+type contextKey struct{}
+
+// NewContext returns a context carrying the given request ID
+func NewContext(ctx context.Context, requestID string) context.Context {
+	if requestID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, requestID)
+}
+
+// FromContext returns the request ID stored in the context, or an empty
+// string when none is set
+func FromContext(ctx context.Context) string {
+	if requestID, ok := ctx.Value(contextKey{}).(string); ok {
+		return requestID
+	}
+	return ""
+}
+
+// Transport is an http.RoundTripper that copies the request ID from the
+// request context into the outbound request header
+type Transport struct {
+	Base http.RoundTripper
+}
+
+// NewTransport wraps base with request ID propagation; pass nil to wrap
+// http.DefaultTransport
+func NewTransport(base http.RoundTripper) *Transport {
+	return &Transport{Base: base}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if requestID := FromContext(req.Context()); requestID != "" && req.Header.Get(Header) == "" {
+		// Per RoundTripper contract the request must not be mutated
+		req = req.Clone(req.Context())
+		req.Header.Set(Header, requestID)
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
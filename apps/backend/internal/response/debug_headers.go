@@ -0,0 +1,23 @@
+package response
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DebugHeaders attaches the provider debugging headers to a successful
+// response: which backend provider served it, whether it was a cache hit,
+// and how long serving took. Must be called before the body is written.
+func DebugHeaders(c *gin.Context, provider string, fromCache bool, elapsed time.Duration) {
+	if provider != "" {
+		c.Header("X-Provider-Used", provider)
+	}
+	cacheStatus := "MISS"
+	if fromCache {
+		cacheStatus = "HIT"
+	}
+	c.Header("X-Cache", cacheStatus)
+	c.Header("X-Response-Time", strconv.FormatInt(elapsed.Milliseconds(), 10)+"ms")
+}
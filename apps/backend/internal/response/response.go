@@ -0,0 +1,71 @@
+// Package response centralizes handler error responses so every endpoint
+// emits the same AppError JSON shape (code, message, trace_id, fields) that
+// the error-handling middleware produces. Handlers should not write error
+// bodies with gin.H or ad-hoc DTOs.
+package response
+
+import (
+	goerrors "errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/requestid"
+)
+
+// Error writes err in the standard shape. An *errors.AppError is written
+// as-is with the request's trace ID attached; any other error becomes an
+// opaque 500 so internals never leak to clients.
+func Error(c *gin.Context, err error) {
+	var appErr *errors.AppError
+	if !goerrors.As(err, &appErr) {
+		appErr = errors.NewInternalServerError("")
+	}
+	write(c, appErr)
+}
+
+// ErrorMessage writes a standard-shape error built from a status and message
+func ErrorMessage(c *gin.Context, status int, message string) {
+	write(c, errors.NewAppError(message, nil, status))
+}
+
+// ErrorWithDetails writes a standard-shape error carrying a details string,
+// e.g. the underlying binding error for a malformed request body
+func ErrorWithDetails(c *gin.Context, status int, message string, details string) {
+	write(c, errors.NewAppError(message, nil, status).WithDetails(details))
+}
+
+// ValidationError writes a 400 with per-field messages derived from the
+// binding error
+func ValidationError(c *gin.Context, err error) {
+	write(c, errors.HandleValidationError(err))
+}
+
+// ServiceUnavailable writes a 503 for a dependency that is not configured
+// or temporarily down
+func ServiceUnavailable(c *gin.Context, message string) {
+	write(c, errors.NewServiceUnavailableError(message))
+}
+
+// NotFound writes a 404 for the named resource
+func NotFound(c *gin.Context, resource string) {
+	write(c, errors.NewNotFoundError(resource))
+}
+
+// Unauthorized writes a 401; an empty message uses the standard wording
+func Unauthorized(c *gin.Context, message string) {
+	write(c, errors.NewUnauthorizedError(message))
+}
+
+// write attaches the request's trace ID and emits the error. The status is
+// normalized so a zero value cannot produce an invalid response.
+func write(c *gin.Context, appErr *errors.AppError) {
+	if appErr.Status == 0 {
+		appErr.Status = http.StatusInternalServerError
+	}
+	if traceID := requestid.FromContext(c.Request.Context()); traceID != "" {
+		appErr.TraceID = traceID
+	}
+	c.JSON(appErr.Status, appErr)
+}
@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"app-backend/internal/handlers/admin"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupAdminRoutes configures admin-only operational routes
+func SetupAdminRoutes(rg *gin.RouterGroup, handler admin.HandlerInterface, authMiddleware *middleware.AuthMiddleware) {
+	adminGroup := rg.Group("/admin")
+	adminGroup.Use(authMiddleware.RequireAuth(), authMiddleware.RequireRole("admin"))
+	{
+		// Rolling transcript provider health from the background prober
+		adminGroup.GET("/providers/health", handler.GetProviderHealth)
+
+		// Daily YouTube Data API quota usage and remaining budget
+		adminGroup.GET("/youtube/quota", handler.GetYouTubeQuota)
+
+		// Security event audit log
+		adminGroup.GET("/audit", handler.ListAuditEvents)
+
+		// Cross-provider transcript diff for quality debugging
+		adminGroup.GET("/video/:videoUrl/transcript/compare", handler.CompareTranscripts)
+
+		// Hot configuration reload, same effect as the file watcher and SIGHUP
+		adminGroup.POST("/config/reload", handler.ReloadConfig)
+
+		// User account management with an audit trail per action
+		usersGroup := adminGroup.Group("/users")
+		{
+			usersGroup.PUT("/:id/role", handler.UpdateUserRole)
+			usersGroup.PUT("/:id/status", handler.UpdateUserStatus)
+			usersGroup.DELETE("/:id/sessions", handler.RevokeUserSessions)
+			usersGroup.GET("/:id/audit", handler.GetUserAuditLog)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"app-backend/internal/handlers/analytics"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupAnalyticsRoutes configures admin-only analytics routes
+func SetupAnalyticsRoutes(rg *gin.RouterGroup, handler analytics.HandlerInterface, authMiddleware *middleware.AuthMiddleware) {
+	analyticsGroup := rg.Group("/analytics")
+	analyticsGroup.Use(authMiddleware.RequireAuth(), authMiddleware.RequireRole("admin"))
+	{
+		// Provider success-rate aggregation
+		analyticsGroup.GET("/providers", handler.GetProviderStats)
+	}
+}
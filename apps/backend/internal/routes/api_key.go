@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"app-backend/internal/handlers/apikey"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupAPIKeyRoutes configures API key management routes. Keys are managed
+// with a JWT session, never with another API key.
+func SetupAPIKeyRoutes(router *gin.RouterGroup, handler apikey.HandlerInterface, authMiddleware *middleware.AuthMiddleware) {
+	keyGroup := router.Group("/user/api-keys")
+	keyGroup.Use(authMiddleware.RequireAuth())
+	{
+		keyGroup.POST("", handler.CreateKey)
+		keyGroup.GET("", handler.ListKeys)
+		keyGroup.DELETE("/:keyId", handler.RevokeKey)
+	}
+}
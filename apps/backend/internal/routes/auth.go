@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"app-backend/internal/config"
 	"app-backend/internal/handlers/auth"
 	"app-backend/internal/middleware"
 
@@ -8,8 +9,8 @@ import (
 )
 
 // SetupAuthRoutes configures all authentication routes
-func SetupAuthRoutes(router *gin.RouterGroup, authHandler auth.HandlerInterface, authMiddleware *middleware.AuthMiddleware) {
-	authGroup := router.Group("/auth")
+func SetupAuthRoutes(router *gin.RouterGroup, authHandler auth.HandlerInterface, authMiddleware *middleware.AuthMiddleware, cfg *config.Config) {
+	authGroup := router.Group("/auth", middleware.CacheControl(cfg, "auth"))
 	{
 		// Public routes (no authentication required)
 		authGroup.POST("/register", authHandler.Register)
@@ -26,4 +27,4 @@ func SetupAuthRoutes(router *gin.RouterGroup, authHandler auth.HandlerInterface,
 			protected.DELETE("/sessions/:sessionId", authHandler.RevokeSession)
 		}
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"app-backend/internal/config"
+	"app-backend/internal/handlers/importer"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupImportRoutes configures playlist batch import routes. Imports fan out
+// into many transcript fetches, so starting one requires an authenticated
+// user; progress is polled through the job routes.
+func SetupImportRoutes(rg *gin.RouterGroup, handler importer.HandlerInterface, authMiddleware *middleware.AuthMiddleware, cfg *config.Config) {
+	// Queue transcript fetches for a whole playlist or channel
+	videoGroup := rg.Group("/video", authMiddleware.RequireAuth())
+	{
+		videoGroup.POST("/playlist/import", middleware.FeatureGate(cfg, "transcript"), handler.ImportPlaylist)
+	}
+}
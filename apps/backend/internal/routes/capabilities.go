@@ -0,0 +1,12 @@
+package routes
+
+import (
+	"app-backend/internal/handlers/capabilities"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupCapabilitiesRoutes configures the public capability discovery route
+func SetupCapabilitiesRoutes(rg *gin.RouterGroup, handler capabilities.HandlerInterface) {
+	rg.GET("/capabilities", handler.GetCapabilities)
+}
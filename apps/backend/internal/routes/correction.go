@@ -0,0 +1,20 @@
+package routes
+
+import (
+	correctionHandler "app-backend/internal/handlers/correction"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupTranscriptCorrectionRoutes configures owner transcript correction
+// routes. Corrections belong to the account that saved the video, so every
+// route requires authentication.
+func SetupTranscriptCorrectionRoutes(router *gin.RouterGroup, handler correctionHandler.HandlerInterface, authMiddleware *middleware.AuthMiddleware) {
+	videoGroup := router.Group("/video")
+	videoGroup.Use(authMiddleware.RequireAuth())
+	{
+		videoGroup.PATCH("/:videoUrl/transcript/segments/:index", handler.EditSegment)
+		videoGroup.GET("/:videoUrl/transcript/corrections", handler.GetHistory)
+	}
+}
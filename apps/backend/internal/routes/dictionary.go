@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"app-backend/internal/config"
+	"app-backend/internal/handlers/dictionary"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupDictionaryRoutes configures dictionary-related routes
+func SetupDictionaryRoutes(rg *gin.RouterGroup, handler dictionary.HandlerInterface, authMiddleware *middleware.AuthMiddleware, cfg *config.Config) {
+	dictionaryGroup := rg.Group("/dictionary")
+	dictionaryGroup.Use(authMiddleware.RequireAuth())
+	{
+		// Contextual word lookup backed by the model; cached per
+		// word+context, gated so operators can turn the cost off
+		dictionaryGroup.POST("/lookup", middleware.FeatureGate(cfg, "dictionary"), handler.Lookup)
+	}
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"app-backend/internal/config"
+	"app-backend/internal/handlers/explain"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupExplainRoutes configures the grammar explanation route. Fresh
+// breakdowns are rate limited per user inside the service, so the endpoint
+// requires authentication.
+func SetupExplainRoutes(rg *gin.RouterGroup, handler explain.HandlerInterface, authMiddleware *middleware.AuthMiddleware, cfg *config.Config) {
+	rg.POST("/explain", authMiddleware.RequireAuth(), middleware.FeatureGate(cfg, "explain"), handler.Explain)
+}
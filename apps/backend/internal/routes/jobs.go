@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"app-backend/internal/handlers/jobs"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupJobRoutes configures background job routes. Jobs belong to the user
+// that enqueued them, so polling them requires an authenticated user.
+func SetupJobRoutes(rg *gin.RouterGroup, handler jobs.HandlerInterface, authMiddleware *middleware.AuthMiddleware) {
+	jobsGroup := rg.Group("/jobs", authMiddleware.RequireAuth())
+	{
+		jobsGroup.GET("/:jobId", handler.GetJob)
+	}
+}
@@ -2,28 +2,39 @@ package routes
 
 import (
 	"app-backend/internal/handlers/oauth"
-	
+	"app-backend/internal/middleware"
+
 	"github.com/gin-gonic/gin"
 )
 
-// SetupOAuthRoutes sets up all OAuth related routes
-func SetupOAuthRoutes(rg *gin.RouterGroup, handler oauth.HandlerInterface) {
+// SetupOAuthRoutes sets up all OAuth related routes. Initiating, inspecting,
+// and revoking a link require the user's session so each user links their
+// own account; the callback and completion long-poll stay public because
+// Google's redirect and the extension poll carry no session.
+func SetupOAuthRoutes(rg *gin.RouterGroup, handler oauth.HandlerInterface, authMiddleware *middleware.AuthMiddleware) {
 	oauthGroup := rg.Group("/oauth")
 	{
 		// YouTube OAuth routes
 		youtube := oauthGroup.Group("/youtube")
 		{
-			// Initiate YouTube OAuth flow
-			youtube.GET("/auth", handler.InitiateYouTubeAuth)
-			
 			// Handle YouTube OAuth callback
 			youtube.GET("/callback", handler.HandleYouTubeCallback)
-			
-			// Get current authentication status
-			youtube.GET("/status", handler.GetAuthStatus)
-			
-			// Revoke current authentication
-			youtube.POST("/revoke", handler.RevokeYouTubeAuth)
+
+			// Long-poll for completion of the callback matching a state
+			youtube.GET("/status/:state", handler.AwaitAuthCompletion)
+
+			authenticated := youtube.Group("")
+			authenticated.Use(authMiddleware.RequireAuth())
+			{
+				// Initiate YouTube OAuth flow
+				authenticated.GET("/auth", handler.InitiateYouTubeAuth)
+
+				// Get the requesting user's authentication status
+				authenticated.GET("/status", handler.GetAuthStatus)
+
+				// Revoke the requesting user's authentication
+				authenticated.POST("/revoke", handler.RevokeYouTubeAuth)
+			}
 		}
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"app-backend/internal/config"
+	"app-backend/internal/handlers/practice"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupPracticeRoutes configures practice routes. Attempts, quizzes, and
+// submissions all belong to a user, so every endpoint requires authentication.
+func SetupPracticeRoutes(rg *gin.RouterGroup, handler practice.HandlerInterface, authMiddleware *middleware.AuthMiddleware, cfg *config.Config) {
+	practiceGroup := rg.Group("/practice", authMiddleware.RequireAuth())
+	{
+		practiceGroup.POST("/pronunciation", middleware.FeatureGate(cfg, "pronunciation"), handler.AssessPronunciation)
+		practiceGroup.GET("/pronunciation/attempts", handler.ListAttempts)
+		practiceGroup.POST("/quiz", middleware.FeatureGate(cfg, "quiz"), handler.GenerateQuiz)
+		practiceGroup.POST("/quiz/:quizId/answers", handler.SubmitQuizAnswers)
+	}
+}
@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"app-backend/internal/handlers/realtime"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupRealtimeRoutes configures the session sync WebSocket route. The
+// route sits outside /api/v1 because it speaks WebSocket, not REST.
+func SetupRealtimeRoutes(router *gin.Engine, handler realtime.HandlerInterface, authMiddleware *middleware.AuthMiddleware) {
+	router.GET("/ws", authMiddleware.RequireAuthUpgrade(), handler.Connect)
+}
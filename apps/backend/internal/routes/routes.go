@@ -1,11 +1,28 @@
 package routes
 
 import (
+	"app-backend/internal/config"
+	"app-backend/internal/handlers/admin"
+	"app-backend/internal/handlers/analytics"
+	"app-backend/internal/handlers/apikey"
 	"app-backend/internal/handlers/auth"
+	"app-backend/internal/handlers/capabilities"
+	correctionHandlers "app-backend/internal/handlers/correction"
+	"app-backend/internal/handlers/dictionary"
+	explainHandler "app-backend/internal/handlers/explain"
+	"app-backend/internal/handlers/importer"
+	"app-backend/internal/handlers/jobs"
 	"app-backend/internal/handlers/oauth"
+	"app-backend/internal/handlers/practice"
+	realtimeHandler "app-backend/internal/handlers/realtime"
+	"app-backend/internal/handlers/search"
+	"app-backend/internal/handlers/subtitle"
 	"app-backend/internal/handlers/translation"
 	"app-backend/internal/handlers/user"
+	"app-backend/internal/handlers/uservideo"
 	"app-backend/internal/handlers/video"
+	"app-backend/internal/handlers/vocab"
+	workspaceHandler "app-backend/internal/handlers/workspace"
 	"app-backend/internal/middleware"
 
 	"github.com/gin-gonic/gin"
@@ -13,12 +30,31 @@ import (
 
 // RouteConfig holds all the dependencies needed for route setup
 type RouteConfig struct {
-	AuthHandler        auth.HandlerInterface
-	UserHandler        user.HandlerInterface
-	VideoHandler       video.HandlerInterface
-	OAuthHandler       oauth.HandlerInterface
-	TranslationHandler translation.HandlerInterface
-	AuthMiddleware     *middleware.AuthMiddleware
+	AuthHandler         auth.HandlerInterface
+	CapabilitiesHandler capabilities.HandlerInterface
+	CorrectionHandler   correctionHandlers.HandlerInterface
+	DictionaryHandler   dictionary.HandlerInterface
+	ExplainHandler      explainHandler.HandlerInterface
+	RealtimeHandler     realtimeHandler.HandlerInterface
+	UserHandler         user.HandlerInterface
+	VideoHandler        video.HandlerInterface
+	OAuthHandler        oauth.HandlerInterface
+	TranslationHandler  translation.HandlerInterface
+	AnalyticsHandler    analytics.HandlerInterface
+	APIKeyHandler       apikey.HandlerInterface
+	VocabHandler        vocab.HandlerInterface
+	UserVideoHandler    uservideo.HandlerInterface
+	SearchHandler       search.HandlerInterface
+	AdminHandler        admin.HandlerInterface
+	ImportHandler       importer.HandlerInterface
+	JobsHandler         jobs.HandlerInterface
+	SubtitleHandler     subtitle.HandlerInterface
+	PracticeHandler     practice.HandlerInterface
+	WorkspaceHandler    workspaceHandler.HandlerInterface
+
+	AuthMiddleware          *middleware.AuthMiddleware
+	WorkspaceAuthMiddleware *middleware.WorkspaceAuthMiddleware
+	Config                  *config.Config
 }
 
 // SetupRoutes configures all application routes
@@ -35,13 +71,31 @@ func SetupRoutes(router *gin.Engine, config *RouteConfig) {
 	v1 := router.Group("/api/v1")
 	{
 		// Setup all route groups
-		SetupAuthRoutes(v1, config.AuthHandler, config.AuthMiddleware)
+		SetupCapabilitiesRoutes(v1, config.CapabilitiesHandler)
+		SetupAuthRoutes(v1, config.AuthHandler, config.AuthMiddleware, config.Config)
 		SetupUserRoutes(v1, config.UserHandler, config.AuthMiddleware)
-		SetupVideoRoutes(v1, config.VideoHandler, config.AuthMiddleware)
-		SetupTranslationRoutes(v1, config.TranslationHandler)
-		SetupOAuthRoutes(v1, config.OAuthHandler)
+		SetupUserVideoRoutes(v1, config.UserVideoHandler, config.AuthMiddleware)
+		SetupVideoRoutes(v1, config.VideoHandler, config.AuthMiddleware, config.Config)
+		SetupTranscriptCorrectionRoutes(v1, config.CorrectionHandler, config.AuthMiddleware)
+		SetupImportRoutes(v1, config.ImportHandler, config.AuthMiddleware, config.Config)
+		SetupJobRoutes(v1, config.JobsHandler, config.AuthMiddleware)
+		SetupSubtitleRoutes(v1, config.SubtitleHandler, config.AuthMiddleware, config.Config)
+		SetupPracticeRoutes(v1, config.PracticeHandler, config.AuthMiddleware, config.Config)
+		SetupTranslationRoutes(v1, config.TranslationHandler, config.AuthMiddleware, config.Config)
+		SetupDictionaryRoutes(v1, config.DictionaryHandler, config.AuthMiddleware, config.Config)
+		SetupExplainRoutes(v1, config.ExplainHandler, config.AuthMiddleware, config.Config)
+		SetupOAuthRoutes(v1, config.OAuthHandler, config.AuthMiddleware)
+		SetupAnalyticsRoutes(v1, config.AnalyticsHandler, config.AuthMiddleware)
+		SetupAPIKeyRoutes(v1, config.APIKeyHandler, config.AuthMiddleware)
+		SetupVocabRoutes(v1, config.VocabHandler, config.AuthMiddleware)
+		SetupWorkspaceRoutes(v1, config.WorkspaceHandler, config.AuthMiddleware, config.WorkspaceAuthMiddleware)
+		SetupSearchRoutes(v1, config.SearchHandler, config.AuthMiddleware)
+		SetupAdminRoutes(v1, config.AdminHandler, config.AuthMiddleware)
 	}
 
+	// Session sync WebSocket endpoint
+	SetupRealtimeRoutes(router, config.RealtimeHandler, config.AuthMiddleware)
+
 	// Setup Swagger documentation routes
 	SetupSwaggerRoutes(router)
-}
\ No newline at end of file
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"app-backend/internal/handlers/search"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupSearchRoutes configures transcript search routes
+func SetupSearchRoutes(router *gin.RouterGroup, searchHandler search.HandlerInterface, authMiddleware *middleware.AuthMiddleware) {
+	searchGroup := router.Group("/search")
+	searchGroup.Use(authMiddleware.RequireAuth())
+	{
+		searchGroup.GET("/transcripts", searchHandler.SearchTranscripts)
+	}
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"app-backend/internal/config"
+	"app-backend/internal/handlers/subtitle"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupSubtitleRoutes configures bilingual subtitle generation routes.
+// Generation runs through the background job queue, so starting one requires
+// an authenticated user; progress is polled through the job routes and the
+// finished file is fetched from the download endpoint.
+func SetupSubtitleRoutes(rg *gin.RouterGroup, handler subtitle.HandlerInterface, authMiddleware *middleware.AuthMiddleware, cfg *config.Config) {
+	subtitleGroup := rg.Group("/subtitles", authMiddleware.RequireAuth())
+	{
+		subtitleGroup.POST("", middleware.FeatureGate(cfg, "translation"), handler.GenerateSubtitle)
+		subtitleGroup.GET("/:jobId/download", handler.DownloadSubtitle)
+	}
+}
@@ -10,9 +10,9 @@ import (
 func SetupSwaggerRoutes(router *gin.Engine) {
 	// Swagger documentation endpoint
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
-	
+
 	// Redirect root to swagger docs in development
 	router.GET("/", func(c *gin.Context) {
 		c.Redirect(302, "/swagger/index.html")
 	})
-}
\ No newline at end of file
+}
@@ -1,16 +1,51 @@
 package routes
 
 import (
+	"app-backend/internal/config"
 	"app-backend/internal/handlers/translation"
+	"app-backend/internal/middleware"
 
 	"github.com/gin-gonic/gin"
 )
 
 // SetupTranslationRoutes configures translation-related routes
-func SetupTranslationRoutes(rg *gin.RouterGroup, handler translation.HandlerInterface) {
-	translationGroup := rg.Group("/translate")
+func SetupTranslationRoutes(rg *gin.RouterGroup, handler translation.HandlerInterface, authMiddleware *middleware.AuthMiddleware, cfg *config.Config) {
+	// Scripts may authenticate with an API key granting the "translate"
+	// scope instead of a JWT session
+	translationGroup := rg.Group("/translate", authMiddleware.AcceptAPIKey("translate"))
 	{
-		// Text translation endpoint
-		translationGroup.POST("", handler.TranslateTexts)
+		// Text translation endpoint. Auth is optional, but authenticated
+		// requests count against the user's translation quota.
+		translationGroup.POST("", authMiddleware.OptionalAuth(), middleware.FeatureGate(cfg, "translation"), handler.TranslateTexts)
+
+		// Streaming translation over SSE; long-lived connections are bounded
+		// globally and per user
+		streamLimiter := middleware.NewStreamLimiter(cfg.Streaming.MaxConcurrent, cfg.Streaming.MaxPerUser)
+		translationGroup.POST("/stream", authMiddleware.OptionalAuth(), middleware.FeatureGate(cfg, "translation"), streamLimiter.Limit(), handler.StreamTranslation)
+
+		// Translation memory export/import
+		translationGroup.GET("/memory", handler.ExportMemory)
+		translationGroup.POST("/memory", handler.ImportMemory)
+
+		// Per-segment latency percentiles for batch-size tuning
+		translationGroup.GET("/metrics/latency", handler.GetLatencyMetrics)
+
+		// Job-style batch translation: submit once, then poll or stream
+		// progress by job ID
+		batchGroup := translationGroup.Group("/batch", middleware.FeatureGate(cfg, "translation_batch"))
+		{
+			batchGroup.POST("", authMiddleware.OptionalAuth(), handler.BatchTranslate)
+			batchGroup.GET("/:id", handler.GetBatchJob)
+			batchGroup.GET("/:id/stream", streamLimiter.Limit(), handler.StreamBatchJob)
+		}
+
+		// Asynchronous bulk translation with a dead-letter queue
+		bulkGroup := translationGroup.Group("/bulk", middleware.FeatureGate(cfg, "translation_bulk"))
+		{
+			bulkGroup.POST("", handler.BulkTranslate)
+			bulkGroup.GET("/:id", handler.GetBulkResult)
+			bulkGroup.GET("/dead-letters", handler.ListDeadLetters)
+			bulkGroup.POST("/dead-letters/:id/retry", handler.RetryDeadLetter)
+		}
 	}
-}
\ No newline at end of file
+}
@@ -18,6 +18,16 @@ func SetupUserRoutes(router *gin.RouterGroup, userHandler user.HandlerInterface,
 		userGroup.POST("/change-password", userHandler.ChangePassword)
 		userGroup.DELETE("/account", userHandler.DeleteAccount)
 
+		// Translation quota consumption for the frontend usage display
+		userGroup.GET("/usage", userHandler.GetUsage)
+
+		// Study streak and per-day activity for the dashboard
+		userGroup.GET("/stats", userHandler.GetStats)
+
+		// GDPR data export: queue an archive build, then download the result
+		userGroup.GET("/export", userHandler.ExportData)
+		userGroup.GET("/export/:jobId/download", userHandler.DownloadExport)
+
 		// Admin only routes
 		adminGroup := userGroup.Group("")
 		adminGroup.Use(authMiddleware.RequireRole("admin"))
@@ -25,4 +35,4 @@ func SetupUserRoutes(router *gin.RouterGroup, userHandler user.HandlerInterface,
 			adminGroup.GET("/list", userHandler.ListUsers)
 		}
 	}
-}
\ No newline at end of file
+}
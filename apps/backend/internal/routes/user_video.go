@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"app-backend/internal/handlers/uservideo"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupUserVideoRoutes configures saved-video / watch history routes. History
+// is always tied to an account, so every route requires authentication.
+func SetupUserVideoRoutes(router *gin.RouterGroup, userVideoHandler uservideo.HandlerInterface, authMiddleware *middleware.AuthMiddleware) {
+	videoGroup := router.Group("/user/videos")
+	videoGroup.Use(authMiddleware.RequireAuth())
+	{
+		videoGroup.POST("", userVideoHandler.SaveVideo)
+		videoGroup.GET("", userVideoHandler.ListVideos)
+		videoGroup.GET("/:videoId", userVideoHandler.GetVideo)
+		videoGroup.DELETE("/:videoId", userVideoHandler.DeleteVideo)
+	}
+}
@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"app-backend/internal/config"
 	"app-backend/internal/handlers/video"
 	"app-backend/internal/middleware"
 
@@ -8,19 +9,58 @@ import (
 )
 
 // SetupVideoRoutes configures video-related routes
-func SetupVideoRoutes(rg *gin.RouterGroup, handler video.HandlerInterface, authMiddleware *middleware.AuthMiddleware) {
-	videoGroup := rg.Group("/video")
+func SetupVideoRoutes(rg *gin.RouterGroup, handler video.HandlerInterface, authMiddleware *middleware.AuthMiddleware, cfg *config.Config) {
+	// Scripts may authenticate any video endpoint with an API key granting
+	// the "video" scope instead of a JWT session
+	videoGroup := rg.Group("/video", authMiddleware.AcceptAPIKey("video"))
 	{
-		// Video information and capabilities
-		videoGroup.GET("/:videoUrl/info", handler.GetVideoInfo)
-		videoGroup.GET("/:videoUrl/capabilities", handler.GetCapabilities)
-		
-		// Transcript operations
-		videoGroup.GET("/:videoUrl/transcript", handler.GetTranscript)
-		videoGroup.GET("/:videoUrl/languages", handler.GetAvailableLanguages)
-		
-		// System endpoints
-		videoGroup.GET("/providers", handler.GetSupportedProviders)
-		videoGroup.GET("/languages", handler.GetSupportedLanguages)
+		// URL parsing only; no provider API calls
+		videoGroup.POST("/resolve", handler.ResolveVideo)
+
+		// Per-video endpoints serve dynamic content
+		dynamicGroup := videoGroup.Group("", middleware.CacheControl(cfg, "dynamic"))
+		{
+			// Video information and capabilities
+			dynamicGroup.GET("/:videoUrl/info", handler.GetVideoInfo)
+			dynamicGroup.GET("/:videoUrl/capabilities", handler.GetCapabilities)
+
+			// Transcript operations. Optional auth resolves the admin role
+			// gating the raw-payload and provider-override debugging aids.
+			dynamicGroup.GET("/:videoUrl/transcript", authMiddleware.OptionalAuth(), middleware.FeatureGate(cfg, "transcript"), handler.GetTranscript)
+			// Optional auth lets an owner's transcript corrections feed
+			// the translation and the exported file
+			dynamicGroup.GET("/:videoUrl/transcript/dual", authMiddleware.OptionalAuth(), middleware.FeatureGate(cfg, "transcript"), handler.GetDualTranscript)
+			dynamicGroup.GET("/:videoUrl/transcript/export", authMiddleware.OptionalAuth(), middleware.FeatureGate(cfg, "transcript"), handler.ExportTranscript)
+			// Summaries are model-backed and rate limited per user, so the
+			// endpoint requires authentication
+			dynamicGroup.GET("/:videoUrl/summary", authMiddleware.RequireAuth(), middleware.FeatureGate(cfg, "transcript"), handler.GetVideoSummary)
+			dynamicGroup.GET("/:videoUrl/keyphrases", middleware.FeatureGate(cfg, "transcript"), handler.GetKeyPhrases)
+			dynamicGroup.GET("/:videoUrl/chapters", handler.GetChapters)
+			dynamicGroup.GET("/:videoUrl/languages", handler.GetAvailableLanguages)
+		}
+
+		// Only authenticated users may mint share links for a video
+		videoGroup.POST("/:videoUrl/share", authMiddleware.RequireAuth(), handler.CreateShareLink)
+
+		// Cache invalidation is admin-only
+		adminGroup := videoGroup.Group("", authMiddleware.RequireAuth(), authMiddleware.RequireRole("admin"))
+		{
+			adminGroup.DELETE("/:videoUrl/transcript/cache", handler.InvalidateTranscriptCache)
+		}
+
+		// System endpoints list supported providers and languages, which only
+		// change on deploys, so clients may cache them
+		staticGroup := videoGroup.Group("", middleware.CacheControl(cfg, "static"))
+		{
+			staticGroup.GET("/providers", handler.GetSupportedProviders)
+			staticGroup.GET("/languages", handler.GetSupportedLanguages)
+		}
+	}
+
+	// Share links grant unauthenticated, read-only access to one video's
+	// dual-language transcript; the signed token is the whole credential
+	shareGroup := rg.Group("/share", middleware.CacheControl(cfg, "dynamic"))
+	{
+		shareGroup.GET("/:token", middleware.FeatureGate(cfg, "transcript"), handler.GetSharedTranscript)
 	}
-}
\ No newline at end of file
+}
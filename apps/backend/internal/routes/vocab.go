@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"app-backend/internal/handlers/vocab"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupVocabRoutes configures vocabulary list and spaced-repetition review
+// routes. Vocabulary is always tied to an account, so every route requires
+// authentication.
+func SetupVocabRoutes(router *gin.RouterGroup, vocabHandler vocab.HandlerInterface, authMiddleware *middleware.AuthMiddleware) {
+	vocabGroup := router.Group("/vocab")
+	vocabGroup.Use(authMiddleware.RequireAuth())
+	{
+		vocabGroup.POST("", vocabHandler.CreateItem)
+		vocabGroup.GET("", vocabHandler.ListItems)
+		// Review queue before the :itemId routes so "review" is not
+		// parsed as an item ID
+		vocabGroup.GET("/review", vocabHandler.GetReviewQueue)
+		// Bulk transfer in CSV and Anki-compatible TSV formats
+		vocabGroup.POST("/import", vocabHandler.ImportItems)
+		vocabGroup.GET("/export", vocabHandler.ExportItems)
+		vocabGroup.GET("/:itemId", vocabHandler.GetItem)
+		vocabGroup.PUT("/:itemId", vocabHandler.UpdateItem)
+		vocabGroup.DELETE("/:itemId", vocabHandler.DeleteItem)
+		vocabGroup.POST("/:itemId/review", vocabHandler.ReviewItem)
+	}
+}
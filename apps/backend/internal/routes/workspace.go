@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"app-backend/internal/handlers/workspace"
+	"app-backend/internal/middleware"
+	"app-backend/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupWorkspaceRoutes configures classroom workspace routes. Every route
+// requires authentication; routes under :workspaceId additionally pass
+// through the workspace authorization middleware, which resolves the
+// requester's membership and enforces the role each endpoint needs.
+func SetupWorkspaceRoutes(router *gin.RouterGroup, workspaceHandler workspace.HandlerInterface, authMiddleware *middleware.AuthMiddleware, workspaceAuth *middleware.WorkspaceAuthMiddleware) {
+	workspaceGroup := router.Group("/workspaces")
+	workspaceGroup.Use(authMiddleware.RequireAuth())
+	{
+		workspaceGroup.POST("", workspaceHandler.CreateWorkspace)
+		workspaceGroup.GET("", workspaceHandler.ListWorkspaces)
+		// Accepting an invite precedes membership, so it is only gated on
+		// authentication
+		workspaceGroup.POST("/invites/accept", workspaceHandler.AcceptInvite)
+
+		scoped := workspaceGroup.Group("/:workspaceId")
+		{
+			anyMember := workspaceAuth.RequireWorkspaceRole()
+			curators := workspaceAuth.RequireWorkspaceRole(models.WorkspaceRoleOwner, models.WorkspaceRoleTeacher)
+
+			scoped.GET("", anyMember, workspaceHandler.GetWorkspace)
+			scoped.GET("/members", anyMember, workspaceHandler.ListMembers)
+			scoped.POST("/invites", curators, workspaceHandler.InviteMember)
+			scoped.DELETE("/members/:userId", curators, workspaceHandler.RemoveMember)
+
+			// Curated video list
+			scoped.GET("/videos", anyMember, workspaceHandler.ListVideos)
+			scoped.POST("/videos", curators, workspaceHandler.ShareVideo)
+			scoped.DELETE("/videos/:videoId", curators, workspaceHandler.RemoveVideo)
+
+			// Shared vocabulary deck
+			scoped.GET("/vocab", anyMember, workspaceHandler.ListVocab)
+			scoped.POST("/vocab", curators, workspaceHandler.ShareVocab)
+		}
+	}
+}
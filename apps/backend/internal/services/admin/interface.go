@@ -0,0 +1,23 @@
+package admin
+
+import (
+	"app-backend/internal/dto"
+	"app-backend/internal/types"
+)
+
+// ServiceInterface defines the contract for admin user management
+type ServiceInterface interface {
+	// UpdateUserRole changes a user's role and records an audit entry
+	UpdateUserRole(actorID, userID uint, role string) (*dto.UserResponse, error)
+
+	// SetUserActive enables or disables an account; disabling also revokes
+	// the user's active sessions
+	SetUserActive(actorID, userID uint, isActive bool) (*dto.UserResponse, error)
+
+	// RevokeUserSessions force-logs-out a user by deactivating all of their
+	// sessions, returning how many were active
+	RevokeUserSessions(actorID, userID uint) (int, error)
+
+	// GetUserAuditLog returns the audit trail for one account
+	GetUserAuditLog(userID uint, pagReq *types.PaginationRequest) (*types.PaginationResponse[dto.AdminAuditLogResponse], error)
+}
@@ -0,0 +1,218 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/types"
+)
+
+// Audit log action names, one per privileged operation
+const (
+	actionRoleUpdate     = "role_update"
+	actionAccountEnable  = "account_enable"
+	actionAccountDisable = "account_disable"
+	actionSessionRevoke  = "session_revoke"
+)
+
+type Service struct {
+	userRepo    repositories.UserRepositoryInterface
+	sessionRepo repositories.SessionRepositoryInterface
+	auditRepo   repositories.AdminAuditLogRepositoryInterface
+	logger      *logger.Logger
+}
+
+func NewService(
+	userRepo repositories.UserRepositoryInterface,
+	sessionRepo repositories.SessionRepositoryInterface,
+	auditRepo repositories.AdminAuditLogRepositoryInterface,
+	logger *logger.Logger,
+) ServiceInterface {
+	return &Service{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		auditRepo:   auditRepo,
+		logger:      logger,
+	}
+}
+
+func (s *Service) UpdateUserRole(actorID, userID uint, role string) (*dto.UserResponse, error) {
+	// An admin demoting themselves would lock them out of this API mid-request
+	if actorID == userID {
+		return nil, errors.NewAppError("Admins cannot change their own role", nil, http.StatusBadRequest)
+	}
+
+	user, err := s.getUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousRole := user.Role
+	if previousRole == role {
+		return toAdminUserResponse(user), nil
+	}
+
+	user.Role = role
+	if err := s.userRepo.Update(user); err != nil {
+		return nil, errors.NewAppError("Failed to update user role", err, http.StatusInternalServerError)
+	}
+
+	s.recordAudit(actorID, userID, actionRoleUpdate,
+		fmt.Sprintf("role changed from %s to %s", previousRole, role))
+	s.logger.Info("User role updated",
+		zap.Uint("actor_id", actorID),
+		zap.Uint("user_id", userID),
+		zap.String("role", role))
+
+	return toAdminUserResponse(user), nil
+}
+
+func (s *Service) SetUserActive(actorID, userID uint, isActive bool) (*dto.UserResponse, error) {
+	// Disabling their own account would leave no admin able to undo it
+	if actorID == userID && !isActive {
+		return nil, errors.NewAppError("Admins cannot disable their own account", nil, http.StatusBadRequest)
+	}
+
+	user, err := s.getUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsActive == isActive {
+		return toAdminUserResponse(user), nil
+	}
+
+	user.IsActive = isActive
+	if err := s.userRepo.Update(user); err != nil {
+		return nil, errors.NewAppError("Failed to update user status", err, http.StatusInternalServerError)
+	}
+
+	action := actionAccountEnable
+	detail := "account enabled"
+	if !isActive {
+		action = actionAccountDisable
+		detail = "account disabled"
+
+		// A disabled account must not keep working on existing tokens
+		if err := s.sessionRepo.DeactivateUserSessions(userID); err != nil {
+			s.logger.Warn("Failed to revoke sessions for disabled account",
+				zap.Error(err), zap.Uint("user_id", userID))
+		}
+	}
+
+	s.recordAudit(actorID, userID, action, detail)
+	s.logger.Info("User status updated",
+		zap.Uint("actor_id", actorID),
+		zap.Uint("user_id", userID),
+		zap.Bool("is_active", isActive))
+
+	return toAdminUserResponse(user), nil
+}
+
+func (s *Service) RevokeUserSessions(actorID, userID uint) (int, error) {
+	if _, err := s.getUser(userID); err != nil {
+		return 0, err
+	}
+
+	sessions, err := s.sessionRepo.GetActiveSessionsByUserID(userID)
+	if err != nil {
+		return 0, errors.NewAppError("Failed to load user sessions", err, http.StatusInternalServerError)
+	}
+
+	if err := s.sessionRepo.DeactivateUserSessions(userID); err != nil {
+		return 0, errors.NewAppError("Failed to revoke user sessions", err, http.StatusInternalServerError)
+	}
+
+	s.recordAudit(actorID, userID, actionSessionRevoke,
+		fmt.Sprintf("%d active sessions revoked", len(sessions)))
+	s.logger.Info("User sessions revoked",
+		zap.Uint("actor_id", actorID),
+		zap.Uint("user_id", userID),
+		zap.Int("sessions", len(sessions)))
+
+	return len(sessions), nil
+}
+
+func (s *Service) GetUserAuditLog(userID uint, pagReq *types.PaginationRequest) (*types.PaginationResponse[dto.AdminAuditLogResponse], error) {
+	if _, err := s.getUser(userID); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.auditRepo.ListByTargetUser(userID, pagReq)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to list audit entries", err, http.StatusInternalServerError)
+	}
+
+	responses := make([]dto.AdminAuditLogResponse, len(entries.Data))
+	for i := range entries.Data {
+		responses[i] = *toAuditLogResponse(&entries.Data[i])
+	}
+
+	return &types.PaginationResponse[dto.AdminAuditLogResponse]{
+		Data:       responses,
+		Pagination: entries.Pagination,
+	}, nil
+}
+
+// getUser loads the target account, mapping a missing record to 404
+func (s *Service) getUser(userID uint) (*models.User, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewAppError("User not found", err, http.StatusNotFound)
+		}
+		return nil, errors.NewAppError("Failed to get user", err, http.StatusInternalServerError)
+	}
+	return user, nil
+}
+
+// recordAudit persists an audit entry; the action itself has already been
+// applied, so a failed write is logged rather than surfaced
+func (s *Service) recordAudit(actorID, targetUserID uint, action, detail string) {
+	entry := &models.AdminAuditLog{
+		ActorID:      actorID,
+		TargetUserID: targetUserID,
+		Action:       action,
+		Detail:       detail,
+	}
+	if err := s.auditRepo.Create(entry); err != nil {
+		s.logger.Warn("Failed to record admin audit entry",
+			zap.Error(err),
+			zap.Uint("actor_id", actorID),
+			zap.Uint("target_user_id", targetUserID),
+			zap.String("action", action))
+	}
+}
+
+func toAdminUserResponse(user *models.User) *dto.UserResponse {
+	return &dto.UserResponse{
+		ID:        user.ID,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+		Avatar:    user.Avatar,
+		IsActive:  user.IsActive,
+		Role:      user.Role,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}
+}
+
+func toAuditLogResponse(entry *models.AdminAuditLog) *dto.AdminAuditLogResponse {
+	return &dto.AdminAuditLogResponse{
+		ID:           entry.ID,
+		ActorID:      entry.ActorID,
+		TargetUserID: entry.TargetUserID,
+		Action:       entry.Action,
+		Detail:       entry.Detail,
+		CreatedAt:    entry.CreatedAt,
+	}
+}
@@ -0,0 +1,20 @@
+package analytics
+
+import (
+	"time"
+
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+)
+
+// ServiceInterface defines the contract for the analytics service
+type ServiceInterface interface {
+	// RecordProviderOutcome persists a provider outcome asynchronously
+	RecordProviderOutcome(outcome *models.ProviderOutcome)
+
+	// GetProviderStats aggregates provider success rates since the given time
+	GetProviderStats(since time.Time) ([]repositories.ProviderOutcomeStats, error)
+
+	// Stop shuts down the background writer
+	Stop()
+}
@@ -0,0 +1,77 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/pkg/patterns"
+)
+
+// Service persists analytics records asynchronously through a worker pool so
+// request handling is never blocked on analytics writes
+type Service struct {
+	outcomeRepository repositories.ProviderOutcomeRepositoryInterface
+	logger            *logger.Logger
+	pool              *patterns.WorkerPool[*models.ProviderOutcome, struct{}]
+}
+
+// NewService creates a new analytics service with a background writer pool
+func NewService(outcomeRepository repositories.ProviderOutcomeRepositoryInterface, log *logger.Logger) *Service {
+	service := &Service{
+		outcomeRepository: outcomeRepository,
+		logger:            log,
+	}
+
+	service.pool = patterns.NewWorkerPool[*models.ProviderOutcome, struct{}](patterns.WorkerPoolConfig{
+		WorkerCount: 2,
+		QueueSize:   256,
+		Timeout:     10 * time.Second,
+		Logger:      log.Zap(),
+	})
+	service.pool.Start()
+
+	// Drain results so workers never block; write failures are logged by the pool
+	go func() {
+		for range service.pool.Results() {
+		}
+	}()
+
+	return service
+}
+
+// RecordProviderOutcome implements ServiceInterface
+func (s *Service) RecordProviderOutcome(outcome *models.ProviderOutcome) {
+	if outcome == nil {
+		return
+	}
+
+	job := patterns.Job[*models.ProviderOutcome, struct{}]{
+		ID:   uuid.New().String(),
+		Data: outcome,
+		Process: func(ctx context.Context, o *models.ProviderOutcome) (struct{}, error) {
+			return struct{}{}, s.outcomeRepository.Create(o)
+		},
+	}
+
+	if err := s.pool.Submit(job); err != nil {
+		s.logger.Warn("Dropping provider outcome record",
+			zap.String("provider", outcome.Provider),
+			zap.Error(err))
+	}
+}
+
+// GetProviderStats implements ServiceInterface
+func (s *Service) GetProviderStats(since time.Time) ([]repositories.ProviderOutcomeStats, error) {
+	return s.outcomeRepository.AggregateSince(since)
+}
+
+// Stop implements ServiceInterface
+func (s *Service) Stop() {
+	s.pool.Stop()
+}
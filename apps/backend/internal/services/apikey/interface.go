@@ -0,0 +1,22 @@
+package apikey
+
+import (
+	"app-backend/internal/dto"
+	"app-backend/internal/models"
+)
+
+type ServiceInterface interface {
+	// GenerateKey creates a new API key for the user; the raw key is only
+	// present in the response
+	GenerateKey(userID uint, req *dto.CreateAPIKeyRequest) (*dto.CreateAPIKeyResponse, error)
+
+	// ListKeys returns all API keys owned by the user, without secrets
+	ListKeys(userID uint) ([]*dto.APIKeyResponse, error)
+
+	// RevokeKey deactivates one of the user's keys
+	RevokeKey(userID uint, keyID uint) error
+
+	// Authenticate validates a raw key against the given endpoint scope and
+	// returns the key with its owning user loaded
+	Authenticate(rawKey string, scope string) (*models.APIKey, error)
+}
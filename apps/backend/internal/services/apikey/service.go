@@ -0,0 +1,153 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+)
+
+const (
+	// keyPrefix marks keys from this service so they are recognizable in
+	// logs and secret scanners
+	keyPrefix = "ela_"
+	// keyBytes is the entropy of the secret part of a key
+	keyBytes = 24
+	// prefixLength is how much of the key is stored for identification
+	prefixLength = 12
+)
+
+type Service struct {
+	apiKeyRepo repositories.APIKeyRepositoryInterface
+	logger     *logger.Logger
+}
+
+func NewService(apiKeyRepo repositories.APIKeyRepositoryInterface, logger *logger.Logger) ServiceInterface {
+	return &Service{
+		apiKeyRepo: apiKeyRepo,
+		logger:     logger,
+	}
+}
+
+func (s *Service) GenerateKey(userID uint, req *dto.CreateAPIKeyRequest) (*dto.CreateAPIKeyResponse, error) {
+	secret := make([]byte, keyBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.NewAppError("Failed to generate API key", err, http.StatusInternalServerError)
+	}
+	rawKey := keyPrefix + hex.EncodeToString(secret)
+
+	var expiresAt *time.Time
+	if req.ExpiresInDays > 0 {
+		expiry := time.Now().AddDate(0, 0, req.ExpiresInDays)
+		expiresAt = &expiry
+	}
+
+	key := &models.APIKey{
+		UserID:    userID,
+		Name:      req.Name,
+		KeyHash:   hashKey(rawKey),
+		Prefix:    rawKey[:prefixLength],
+		Scopes:    strings.Join(req.Scopes, ","),
+		IsActive:  true,
+		ExpiresAt: expiresAt,
+	}
+
+	if err := s.apiKeyRepo.Create(key); err != nil {
+		return nil, errors.NewAppError("Failed to create API key", err, http.StatusInternalServerError)
+	}
+
+	s.logger.Info("API key generated",
+		zap.Uint("user_id", userID),
+		zap.Uint("key_id", key.ID),
+		zap.String("prefix", key.Prefix))
+
+	return &dto.CreateAPIKeyResponse{
+		Key:    rawKey,
+		APIKey: *toAPIKeyResponse(key),
+	}, nil
+}
+
+func (s *Service) ListKeys(userID uint) ([]*dto.APIKeyResponse, error) {
+	keys, err := s.apiKeyRepo.GetByUserID(userID)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to list API keys", err, http.StatusInternalServerError)
+	}
+
+	responses := make([]*dto.APIKeyResponse, len(keys))
+	for i, key := range keys {
+		responses[i] = toAPIKeyResponse(key)
+	}
+	return responses, nil
+}
+
+func (s *Service) RevokeKey(userID uint, keyID uint) error {
+	err := s.apiKeyRepo.RevokeKey(userID, keyID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.NewAppError("API key not found", nil, http.StatusNotFound)
+		}
+		return errors.NewAppError("Failed to revoke API key", err, http.StatusInternalServerError)
+	}
+
+	s.logger.Info("API key revoked",
+		zap.Uint("user_id", userID),
+		zap.Uint("key_id", keyID))
+	return nil
+}
+
+func (s *Service) Authenticate(rawKey string, scope string) (*models.APIKey, error) {
+	key, err := s.apiKeyRepo.GetByKeyHash(hashKey(rawKey))
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewAppError("Invalid API key", nil, http.StatusUnauthorized)
+		}
+		return nil, errors.NewAppError("Failed to validate API key", err, http.StatusInternalServerError)
+	}
+
+	if !key.IsValid() {
+		return nil, errors.NewAppError("API key expired", nil, http.StatusUnauthorized)
+	}
+
+	if !key.HasScope(scope) {
+		return nil, errors.NewAppError("API key does not grant access to this endpoint", nil, http.StatusForbidden)
+	}
+
+	// Best effort; a stale timestamp must not fail the request
+	if err := s.apiKeyRepo.UpdateLastUsed(key.ID); err != nil {
+		s.logger.Warn("Failed to update API key last used",
+			zap.Uint("key_id", key.ID),
+			zap.Error(err))
+	}
+
+	return key, nil
+}
+
+// hashKey returns the storage hash of a raw key
+func hashKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
+
+func toAPIKeyResponse(key *models.APIKey) *dto.APIKeyResponse {
+	return &dto.APIKeyResponse{
+		ID:        key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.ScopeList(),
+		IsActive:  key.IsActive,
+		ExpiresAt: key.ExpiresAt,
+		LastUsed:  key.LastUsed,
+		CreatedAt: key.CreatedAt,
+	}
+}
@@ -0,0 +1,49 @@
+package audit
+
+import (
+	"app-backend/internal/dto"
+	"app-backend/internal/types"
+)
+
+// Security-relevant event names recorded in the audit log
+const (
+	ActionLogin          = "login"
+	ActionLogout         = "logout"
+	ActionLogoutAll      = "logout_all"
+	ActionPasswordChange = "password_change"
+	ActionRoleUpdate     = "role_update"
+	ActionAccountEnable  = "account_enable"
+	ActionAccountDisable = "account_disable"
+	ActionSessionRevoke  = "session_revoke"
+	ActionOAuthLink      = "oauth_link"
+	ActionOAuthUnlink    = "oauth_unlink"
+	ActionConfigReload   = "config_reload"
+	ActionDataExport     = "data_export"
+)
+
+// Event is one security-relevant occurrence to be persisted. Handlers fill
+// the semantic fields; the audit middleware fills the request metadata.
+type Event struct {
+	ActorID      uint
+	TargetUserID uint
+	Action       string
+	Detail       string
+	IPAddress    string
+	UserAgent    string
+	RequestID    string
+}
+
+// RecorderInterface is the write-side contract the audit middleware needs
+type RecorderInterface interface {
+	// Record persists an event; failures are logged, never surfaced, so
+	// auditing cannot break the request that triggered it
+	Record(event *Event)
+}
+
+// ServiceInterface defines the contract for the audit log service
+type ServiceInterface interface {
+	RecorderInterface
+
+	// ListEvents returns audit events matching the request's filters
+	ListEvents(req *dto.ListAuditEventsRequest, pagReq *types.PaginationRequest) (*types.PaginationResponse[dto.AuditEventResponse], error)
+}
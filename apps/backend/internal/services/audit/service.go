@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/types"
+)
+
+type Service struct {
+	auditRepo repositories.AuditLogRepositoryInterface
+	logger    *logger.Logger
+}
+
+func NewService(auditRepo repositories.AuditLogRepositoryInterface, logger *logger.Logger) ServiceInterface {
+	return &Service{
+		auditRepo: auditRepo,
+		logger:    logger,
+	}
+}
+
+func (s *Service) Record(event *Event) {
+	entry := &models.AuditLog{
+		ActorID:      event.ActorID,
+		TargetUserID: event.TargetUserID,
+		Action:       event.Action,
+		Detail:       event.Detail,
+		IPAddress:    event.IPAddress,
+		UserAgent:    event.UserAgent,
+		RequestID:    event.RequestID,
+	}
+	if err := s.auditRepo.Create(entry); err != nil {
+		s.logger.Warn("Failed to record audit event",
+			zap.Error(err),
+			zap.String("action", event.Action),
+			zap.Uint("actor_id", event.ActorID))
+	}
+}
+
+func (s *Service) ListEvents(req *dto.ListAuditEventsRequest, pagReq *types.PaginationRequest) (*types.PaginationResponse[dto.AuditEventResponse], error) {
+	events, err := s.auditRepo.ListEvents(req.Action, req.ActorID, req.TargetUserID, pagReq)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to list audit events", err, http.StatusInternalServerError)
+	}
+
+	responses := make([]dto.AuditEventResponse, len(events.Data))
+	for i := range events.Data {
+		responses[i] = *toAuditEventResponse(&events.Data[i])
+	}
+
+	return &types.PaginationResponse[dto.AuditEventResponse]{
+		Data:       responses,
+		Pagination: events.Pagination,
+	}, nil
+}
+
+func toAuditEventResponse(entry *models.AuditLog) *dto.AuditEventResponse {
+	return &dto.AuditEventResponse{
+		ID:           entry.ID,
+		ActorID:      entry.ActorID,
+		TargetUserID: entry.TargetUserID,
+		Action:       entry.Action,
+		Detail:       entry.Detail,
+		IPAddress:    entry.IPAddress,
+		UserAgent:    entry.UserAgent,
+		RequestID:    entry.RequestID,
+		CreatedAt:    entry.CreatedAt,
+	}
+}
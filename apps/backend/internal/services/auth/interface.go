@@ -14,4 +14,4 @@ type ServiceInterface interface {
 	ValidateSession(tokenHash string) (*models.Session, error)
 	GetUserSessions(userID uint) ([]*dto.SessionResponse, error)
 	RevokeSession(userID uint, sessionID uint) error
-}
\ No newline at end of file
+}
@@ -1,11 +1,14 @@
 package auth
 
 import (
+	"context"
+
 	"app-backend/internal/dto"
 	"app-backend/internal/errors"
 	"app-backend/internal/models"
 	"app-backend/internal/repositories"
 	"app-backend/internal/services/jwt"
+	"app-backend/internal/services/sessioninfo"
 	"app-backend/internal/services/user"
 	"net/http"
 	"time"
@@ -18,20 +21,32 @@ type Service struct {
 	userService user.ServiceInterface
 	jwtService  jwt.ServiceInterface
 	sessionRepo repositories.SessionRepositoryInterface
+	sessionInfo sessioninfo.ServiceInterface
 }
 
 func NewAuthService(
 	userService user.ServiceInterface,
 	jwtService jwt.ServiceInterface,
 	sessionRepo repositories.SessionRepositoryInterface,
+	sessionInfo sessioninfo.ServiceInterface,
 ) ServiceInterface {
 	return &Service{
 		userService: userService,
 		jwtService:  jwtService,
 		sessionRepo: sessionRepo,
+		sessionInfo: sessionInfo,
 	}
 }
 
+// enrichSession parses the User-Agent and resolves the IP to a coarse
+// location; a nil enrichment service falls back to device parsing only
+func (s *Service) enrichSession(ipAddress, userAgent string) sessioninfo.Enrichment {
+	if s.sessionInfo == nil {
+		return sessioninfo.ParseUserAgent(userAgent)
+	}
+	return s.sessionInfo.Enrich(context.Background(), ipAddress, userAgent)
+}
+
 func (s *Service) Register(req *dto.RegisterRequest, ipAddress, userAgent string) (*dto.AuthResponse, error) {
 	// Create user
 	user, err := s.userService.CreateUser(req)
@@ -139,6 +154,11 @@ func (s *Service) RefreshToken(req *dto.RefreshTokenRequest, ipAddress, userAgen
 	session.IPAddress = ipAddress
 	session.UserAgent = userAgent
 
+	enrichment := s.enrichSession(ipAddress, userAgent)
+	session.Browser = enrichment.Browser
+	session.OS = enrichment.OS
+	session.Location = enrichment.Location
+
 	err = s.sessionRepo.Update(session)
 	if err != nil {
 		return nil, errors.NewAppError("Failed to update session", err, http.StatusInternalServerError)
@@ -194,14 +214,31 @@ func (s *Service) GetUserSessions(userID uint) ([]*dto.SessionResponse, error) {
 
 	sessionResponses := make([]*dto.SessionResponse, len(sessions))
 	for i, session := range sessions {
+		enrichment := sessioninfo.Enrichment{
+			Browser:  session.Browser,
+			OS:       session.OS,
+			Location: session.Location,
+		}
+		// Sessions created before enrichment existed still have a stored
+		// User-Agent to parse on the fly
+		if enrichment.Browser == "" && enrichment.OS == "" {
+			parsed := sessioninfo.ParseUserAgent(session.UserAgent)
+			enrichment.Browser = parsed.Browser
+			enrichment.OS = parsed.OS
+		}
+
 		sessionResponses[i] = &dto.SessionResponse{
-			ID:        session.ID,
-			UserAgent: session.UserAgent,
-			IPAddress: session.IPAddress,
-			LastUsed:  session.LastUsed,
-			ExpiresAt: session.ExpiresAt,
-			IsActive:  session.IsActive,
-			CreatedAt: session.CreatedAt,
+			ID:          session.ID,
+			UserAgent:   session.UserAgent,
+			IPAddress:   session.IPAddress,
+			Browser:     enrichment.Browser,
+			OS:          enrichment.OS,
+			Location:    enrichment.Location,
+			DeviceLabel: enrichment.Label(),
+			LastUsed:    session.LastUsed,
+			ExpiresAt:   session.ExpiresAt,
+			IsActive:    session.IsActive,
+			CreatedAt:   session.CreatedAt,
 		}
 	}
 
@@ -232,11 +269,16 @@ func (s *Service) RevokeSession(userID uint, sessionID uint) error {
 }
 
 func (s *Service) createAuthResponse(user *models.User, ipAddress, userAgent string) (*dto.AuthResponse, error) {
+	enrichment := s.enrichSession(ipAddress, userAgent)
+
 	// Create session first (without token hash)
 	session := &models.Session{
 		UserID:    user.ID,
 		IPAddress: ipAddress,
 		UserAgent: userAgent,
+		Browser:   enrichment.Browser,
+		OS:        enrichment.OS,
+		Location:  enrichment.Location,
 		IsActive:  true,
 		LastUsed:  time.Now(),
 		ExpiresAt: time.Now().Add(s.jwtService.GetRefreshTokenTTL()),
@@ -281,4 +323,4 @@ func (s *Service) createAuthResponse(user *models.User, ipAddress, userAgent str
 			UpdatedAt: user.UpdatedAt,
 		},
 	}, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,32 @@
+package chapters
+
+import (
+	"context"
+	"time"
+
+	"app-backend/internal/dto"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+	"app-backend/internal/types"
+	"app-backend/pkg/gemini"
+)
+
+// InferFunc produces chapter boundaries from a rendered transcript. It
+// matches gemini.Service.DetectChapters so the model backend can be swapped
+// in tests.
+type InferFunc func(ctx context.Context, transcript string) ([]gemini.Chapter, error)
+
+// ServiceInterface defines the contract for chapter detection
+type ServiceInterface interface {
+	// FromDescription parses chapter markers out of a video description,
+	// following the platform convention: the first marker at 0:00, at
+	// least three markers, in ascending order. It returns nil when the
+	// description carries no usable markers.
+	FromDescription(description string, duration time.Duration) []dto.ChapterResponse
+
+	// InferFromTranscript asks the model backend for topic boundaries in
+	// the transcript, for videos whose description has no markers.
+	// Results are cached per video; the transcript text is part of the
+	// cache key, so a changed transcript is segmented fresh. Without a
+	// configured backend it returns nil with no error.
+	InferFromTranscript(ctx context.Context, provider types.VideoProvider, videoID string, duration time.Duration, segments []transcriptTypes.TranscriptSegment) ([]dto.ChapterResponse, bool, error)
+}
@@ -0,0 +1,190 @@
+package chapters
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/logger"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+	"app-backend/internal/types"
+	"app-backend/pkg/patterns"
+)
+
+const (
+	// defaultChaptersCacheSize is how many inferred chapter lists are
+	// kept; chapters only depend on the transcript, so everyone watching
+	// the same video shares the cached entries
+	defaultChaptersCacheSize = 500
+	// minDescriptionChapters is how many markers a description needs
+	// before they count as a chapter list, mirroring the platform rule
+	minDescriptionChapters = 3
+	// maxTranscriptChars caps how much transcript text goes into the
+	// prompt; longer transcripts are truncated at a segment boundary
+	maxTranscriptChars = 60000
+)
+
+// timestampPattern matches a chapter marker timestamp like 0:00 or 1:02:33
+var timestampPattern = regexp.MustCompile(`(?:\d{1,2}:)?\d{1,2}:\d{2}`)
+
+// markerSeparators are the characters descriptions put between a chapter
+// timestamp and its title
+const markerSeparators = " \t-–—:|.[]()"
+
+// Service detects video chapters, preferring timestamp markers in the video
+// description and falling back to model-inferred topic boundaries from the
+// transcript
+type Service struct {
+	inferFn InferFunc
+	cache   *patterns.LRUCache[string, []dto.ChapterResponse]
+	logger  *logger.Logger
+}
+
+// NewService creates the chapter detection service. The model backend may be
+// nil, in which case only description markers are detected.
+func NewService(inferFn InferFunc, logger *logger.Logger) ServiceInterface {
+	return &Service{
+		inferFn: inferFn,
+		cache:   patterns.NewLRUCache[string, []dto.ChapterResponse](defaultChaptersCacheSize),
+		logger:  logger,
+	}
+}
+
+// FromDescription parses chapter markers out of a video description. It
+// returns nil unless the markers follow the platform convention: at least
+// three, the first at 0:00, in ascending order.
+func (s *Service) FromDescription(description string, duration time.Duration) []dto.ChapterResponse {
+	var chapters []dto.ChapterResponse
+
+	for _, line := range strings.Split(description, "\n") {
+		match := timestampPattern.FindStringIndex(line)
+		if match == nil {
+			continue
+		}
+
+		start, err := parseTimestamp(line[match[0]:match[1]])
+		if err != nil {
+			continue
+		}
+
+		// The title is whatever surrounds the timestamp, preferring the
+		// text after it ("0:00 Intro") over the text before ("Intro - 0:00")
+		title := strings.Trim(line[match[1]:], markerSeparators)
+		if title == "" {
+			title = strings.Trim(line[:match[0]], markerSeparators)
+		}
+		if title == "" {
+			continue
+		}
+
+		chapters = append(chapters, dto.ChapterResponse{
+			Title:        title,
+			StartSeconds: start.Seconds(),
+		})
+	}
+
+	if len(chapters) < minDescriptionChapters || chapters[0].StartSeconds != 0 {
+		return nil
+	}
+	for i := 1; i < len(chapters); i++ {
+		if chapters[i].StartSeconds <= chapters[i-1].StartSeconds {
+			return nil
+		}
+	}
+
+	return fillEndTimes(chapters, duration)
+}
+
+// InferFromTranscript asks the model backend for topic boundaries in the
+// transcript. The second return value reports a cache hit.
+func (s *Service) InferFromTranscript(ctx context.Context, provider types.VideoProvider, videoID string, duration time.Duration, segments []transcriptTypes.TranscriptSegment) ([]dto.ChapterResponse, bool, error) {
+	if s.inferFn == nil || len(segments) == 0 {
+		return nil, false, nil
+	}
+
+	transcript := renderTranscript(segments)
+	key := chaptersCacheKey(provider, videoID, transcript)
+	if cached, ok := s.cache.Get(key); ok {
+		return cached, true, nil
+	}
+
+	inferred, err := s.inferFn(ctx, transcript)
+	if err != nil {
+		s.logger.Error("Chapter inference failed",
+			zap.String("video_id", videoID),
+			zap.Error(err))
+		return nil, false, appErrors.NewAppError("Failed to detect chapters", err, http.StatusBadGateway)
+	}
+
+	chapters := make([]dto.ChapterResponse, len(inferred))
+	for i, chapter := range inferred {
+		chapters[i] = dto.ChapterResponse{
+			Title:        chapter.Title,
+			StartSeconds: chapter.StartSeconds,
+		}
+	}
+	chapters = fillEndTimes(chapters, duration)
+	s.cache.Put(key, chapters)
+
+	return chapters, false, nil
+}
+
+// fillEndTimes closes each chapter at the start of the next one; the last
+// chapter runs to the video duration when it is known
+func fillEndTimes(chapters []dto.ChapterResponse, duration time.Duration) []dto.ChapterResponse {
+	for i := range chapters {
+		if i+1 < len(chapters) {
+			chapters[i].EndSeconds = chapters[i+1].StartSeconds
+		} else if duration > 0 {
+			chapters[i].EndSeconds = duration.Seconds()
+		}
+	}
+	return chapters
+}
+
+// parseTimestamp converts a "m:ss" or "h:mm:ss" marker into a duration
+func parseTimestamp(value string) (time.Duration, error) {
+	parts := strings.Split(value, ":")
+
+	var total time.Duration
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, err
+		}
+		total = total*60 + time.Duration(n)*time.Second
+	}
+	return total, nil
+}
+
+// renderTranscript flattens segments into one "[<seconds>s] text" line per
+// segment, truncated at a segment boundary once the prompt budget is spent
+func renderTranscript(segments []transcriptTypes.TranscriptSegment) string {
+	var rendered strings.Builder
+	for _, segment := range segments {
+		line := fmt.Sprintf("[%ds] %s\n", int(segment.Start/time.Second), segment.Text)
+		if rendered.Len()+len(line) > maxTranscriptChars {
+			break
+		}
+		rendered.WriteString(line)
+	}
+	return rendered.String()
+}
+
+// chaptersCacheKey hashes everything inferred chapters depend on. Including
+// the rendered transcript means a changed transcript yields a new key and
+// the stale chapters simply age out of the LRU.
+func chaptersCacheKey(provider types.VideoProvider, videoID string, transcript string) string {
+	sum := sha256.Sum256([]byte(string(provider) + "\x00" + videoID + "\x00" + transcript))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,18 @@
+package correction
+
+import "app-backend/internal/dto"
+
+// ServiceInterface defines the contract for the transcript correction service
+type ServiceInterface interface {
+	// EditSegment records corrected text for one transcript segment of a
+	// video the user has saved, creating the next transcript version
+	EditSegment(userID uint, videoID string, segmentIndex int, req *dto.EditTranscriptSegmentRequest) (*dto.TranscriptCorrectionResponse, error)
+
+	// History returns every recorded edit for a saved video, oldest first
+	History(userID uint, videoID string) (*dto.TranscriptCorrectionHistoryResponse, error)
+
+	// CorrectionsFor returns the latest corrected text per segment index
+	// and the current version, for overlaying on a fetched transcript.
+	// A user without corrections gets a nil map and version zero.
+	CorrectionsFor(userID uint, videoID string) (map[int]string, int, error)
+}
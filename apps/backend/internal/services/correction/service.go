@@ -0,0 +1,144 @@
+package correction
+
+import (
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+)
+
+type Service struct {
+	correctionRepo repositories.TranscriptCorrectionRepositoryInterface
+	userVideoRepo  repositories.UserVideoRepositoryInterface
+	logger         *logger.Logger
+}
+
+func NewCorrectionService(
+	correctionRepo repositories.TranscriptCorrectionRepositoryInterface,
+	userVideoRepo repositories.UserVideoRepositoryInterface,
+	logger *logger.Logger,
+) ServiceInterface {
+	return &Service{
+		correctionRepo: correctionRepo,
+		userVideoRepo:  userVideoRepo,
+		logger:         logger,
+	}
+}
+
+func (s *Service) EditSegment(userID uint, videoID string, segmentIndex int, req *dto.EditTranscriptSegmentRequest) (*dto.TranscriptCorrectionResponse, error) {
+	if segmentIndex < 1 {
+		return nil, errors.NewValidationError("Segment index must be at least 1", map[string]string{
+			"index": "must be a 1-based segment index",
+		})
+	}
+	text := strings.TrimSpace(req.Text)
+	if text == "" {
+		return nil, errors.NewValidationError("Corrected text must not be blank", map[string]string{
+			"text": "must not be blank",
+		})
+	}
+
+	// Only the owner of a saved video may correct its transcript
+	if err := s.requireSavedVideo(userID, videoID); err != nil {
+		return nil, err
+	}
+
+	latest, err := s.correctionRepo.LatestVersion(userID, videoID)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to load transcript versions", err, http.StatusInternalServerError)
+	}
+
+	edit := &models.TranscriptCorrection{
+		UserID:       userID,
+		VideoID:      videoID,
+		Language:     req.Language,
+		SegmentIndex: segmentIndex,
+		Text:         text,
+		Version:      latest + 1,
+	}
+	if err := s.correctionRepo.Create(edit); err != nil {
+		return nil, errors.NewAppError("Failed to save transcript correction", err, http.StatusInternalServerError)
+	}
+
+	s.logger.Info("Transcript segment corrected",
+		zap.Uint("user_id", userID),
+		zap.String("video_id", videoID),
+		zap.Int("segment_index", segmentIndex),
+		zap.Int("version", edit.Version))
+
+	return toCorrectionResponse(edit), nil
+}
+
+func (s *Service) History(userID uint, videoID string) (*dto.TranscriptCorrectionHistoryResponse, error) {
+	if err := s.requireSavedVideo(userID, videoID); err != nil {
+		return nil, err
+	}
+
+	corrections, err := s.correctionRepo.ListByUserAndVideo(userID, videoID)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to load transcript corrections", err, http.StatusInternalServerError)
+	}
+
+	response := &dto.TranscriptCorrectionHistoryResponse{
+		VideoID:     videoID,
+		Corrections: make([]dto.TranscriptCorrectionResponse, len(corrections)),
+	}
+	for i := range corrections {
+		response.Corrections[i] = *toCorrectionResponse(&corrections[i])
+		if corrections[i].Version > response.CurrentVersion {
+			response.CurrentVersion = corrections[i].Version
+		}
+	}
+	return response, nil
+}
+
+func (s *Service) CorrectionsFor(userID uint, videoID string) (map[int]string, int, error) {
+	corrections, err := s.correctionRepo.ListByUserAndVideo(userID, videoID)
+	if err != nil {
+		return nil, 0, errors.NewAppError("Failed to load transcript corrections", err, http.StatusInternalServerError)
+	}
+	if len(corrections) == 0 {
+		return nil, 0, nil
+	}
+
+	// Rows arrive oldest first, so later versions overwrite earlier ones
+	texts := make(map[int]string, len(corrections))
+	version := 0
+	for i := range corrections {
+		texts[corrections[i].SegmentIndex] = corrections[i].Text
+		if corrections[i].Version > version {
+			version = corrections[i].Version
+		}
+	}
+	return texts, version, nil
+}
+
+// requireSavedVideo verifies the video is in the user's watch history
+func (s *Service) requireSavedVideo(userID uint, videoID string) error {
+	_, err := s.userVideoRepo.GetByUserAndVideo(userID, videoID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.NewAppError("Saved video not found", nil, http.StatusNotFound)
+		}
+		return errors.NewAppError("Failed to load saved video", err, http.StatusInternalServerError)
+	}
+	return nil
+}
+
+func toCorrectionResponse(edit *models.TranscriptCorrection) *dto.TranscriptCorrectionResponse {
+	return &dto.TranscriptCorrectionResponse{
+		VideoID:      edit.VideoID,
+		SegmentIndex: edit.SegmentIndex,
+		Text:         edit.Text,
+		Language:     edit.Language,
+		Version:      edit.Version,
+		CreatedAt:    edit.CreatedAt,
+	}
+}
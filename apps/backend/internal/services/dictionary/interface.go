@@ -0,0 +1,19 @@
+package dictionary
+
+import (
+	"context"
+
+	"app-backend/internal/dto"
+	"app-backend/pkg/gemini"
+)
+
+// LookupFunc is the model backend that produces a structured dictionary
+// entry for a word in the context of its surrounding sentence
+type LookupFunc func(ctx context.Context, word string, sentence string, targetLang string) (*gemini.DictionaryEntry, error)
+
+// ServiceInterface defines the contract for dictionary lookups
+type ServiceInterface interface {
+	// Lookup returns a structured dictionary entry for a word as used in
+	// its surrounding transcript sentence
+	Lookup(ctx context.Context, req *dto.DictionaryLookupRequest) (*dto.DictionaryLookupResponse, error)
+}
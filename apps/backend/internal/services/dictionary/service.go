@@ -0,0 +1,84 @@
+package dictionary
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/pkg/patterns"
+)
+
+// defaultLookupCacheSize is how many dictionary entries are kept. Learners
+// look up the same words repeatedly while working through a video, and a
+// word+sentence pair always yields the same entry, so caching skips the
+// model call entirely.
+const defaultLookupCacheSize = 5000
+
+// Service implements dictionary lookups backed by a model lookup function
+// with an LRU cache keyed by word and context
+type Service struct {
+	lookupFn LookupFunc
+	cache    *patterns.LRUCache[string, dto.DictionaryLookupResponse]
+	logger   *logger.Logger
+}
+
+// NewDictionaryService creates a new dictionary service around the given
+// model lookup backend
+func NewDictionaryService(lookupFn LookupFunc, logger *logger.Logger) ServiceInterface {
+	return &Service{
+		lookupFn: lookupFn,
+		cache:    patterns.NewLRUCache[string, dto.DictionaryLookupResponse](defaultLookupCacheSize),
+		logger:   logger,
+	}
+}
+
+// Lookup returns a structured dictionary entry for a word as used in its
+// surrounding transcript sentence, serving repeated lookups from the cache
+func (s *Service) Lookup(ctx context.Context, req *dto.DictionaryLookupRequest) (*dto.DictionaryLookupResponse, error) {
+	word := strings.TrimSpace(req.Word)
+	if word == "" {
+		return nil, appErrors.NewValidationError("Word is required", nil)
+	}
+	sentence := strings.TrimSpace(req.Sentence)
+
+	key := lookupCacheKey(word, sentence, req.TargetLang)
+	if cached, ok := s.cache.Get(key); ok {
+		cached.Cached = true
+		return &cached, nil
+	}
+
+	entry, err := s.lookupFn(ctx, word, sentence, req.TargetLang)
+	if err != nil {
+		s.logger.Error("Dictionary lookup failed",
+			zap.String("word", word),
+			zap.Error(err))
+		return nil, appErrors.NewAppError("Failed to look up word", err, http.StatusBadGateway)
+	}
+
+	response := dto.DictionaryLookupResponse{
+		Word:         entry.Word,
+		Definition:   entry.Definition,
+		PartOfSpeech: entry.PartOfSpeech,
+		IPA:          entry.IPA,
+		Examples:     entry.Examples,
+		Translation:  entry.Translation,
+	}
+	s.cache.Put(key, response)
+
+	return &response, nil
+}
+
+// lookupCacheKey hashes the word, its surrounding sentence, and the target
+// language into a cache key. The sentence is part of the key because the
+// same word can carry a different sense in a different context.
+func lookupCacheKey(word string, sentence string, targetLang string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(word) + "\x00" + sentence + "\x00" + targetLang))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,22 @@
+package explain
+
+import (
+	"context"
+
+	"app-backend/internal/dto"
+	"app-backend/pkg/gemini"
+)
+
+// ExplainFunc produces a grammar breakdown of a sentence. It matches
+// gemini.Service.ExplainGrammar so the model backend can be swapped in
+// tests.
+type ExplainFunc func(ctx context.Context, sentence string, nativeLang string) (*gemini.GrammarExplanation, error)
+
+// ServiceInterface defines the contract for grammar explanation operations
+type ServiceInterface interface {
+	// Explain returns a structured grammar breakdown of a transcript
+	// sentence, explained in the user's native language. Repeated requests
+	// are served from the cache; fresh breakdowns are rate limited per
+	// user.
+	Explain(ctx context.Context, userID uint, req *dto.ExplainRequest) (*dto.ExplainResponse, error)
+}
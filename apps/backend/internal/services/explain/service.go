@@ -0,0 +1,143 @@
+package explain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/pkg/gemini"
+	"app-backend/pkg/patterns"
+)
+
+const (
+	// defaultExplainCacheSize is how many grammar breakdowns are kept.
+	// A sentence+language pair always yields the same breakdown, so every
+	// learner working through the same video shares the cached entries.
+	defaultExplainCacheSize = 2000
+	// defaultRequestsPerMinute bounds fresh model calls per user when the
+	// config leaves the limit unset; cache hits are not counted
+	defaultRequestsPerMinute = 10
+)
+
+// Config holds grammar explanation tuning knobs; zero values use defaults
+type Config struct {
+	// RequestsPerMinute bounds fresh breakdowns per user per minute
+	RequestsPerMinute int
+}
+
+// Service produces grammar breakdowns through a model backend with an LRU
+// cache and a per-user rate limit on fresh model calls
+type Service struct {
+	explainFn         ExplainFunc
+	cache             *patterns.LRUCache[string, dto.ExplainResponse]
+	requestsPerMinute int
+	logger            *logger.Logger
+
+	mu       sync.Mutex
+	limiters map[uint]*patterns.SlidingWindowLimiter
+}
+
+// NewService creates the grammar explanation service around the given
+// model backend
+func NewService(explainFn ExplainFunc, cfg Config, logger *logger.Logger) ServiceInterface {
+	requestsPerMinute := cfg.RequestsPerMinute
+	if requestsPerMinute <= 0 {
+		requestsPerMinute = defaultRequestsPerMinute
+	}
+
+	return &Service{
+		explainFn:         explainFn,
+		cache:             patterns.NewLRUCache[string, dto.ExplainResponse](defaultExplainCacheSize),
+		requestsPerMinute: requestsPerMinute,
+		logger:            logger,
+		limiters:          make(map[uint]*patterns.SlidingWindowLimiter),
+	}
+}
+
+// Explain returns a structured grammar breakdown of a transcript sentence.
+// The cache is checked before the rate limit, so rereading an already
+// explained sentence never costs quota.
+func (s *Service) Explain(ctx context.Context, userID uint, req *dto.ExplainRequest) (*dto.ExplainResponse, error) {
+	if s.explainFn == nil {
+		return nil, appErrors.NewAppError("Grammar explanation not available", nil, http.StatusServiceUnavailable)
+	}
+
+	sentence := strings.TrimSpace(req.Sentence)
+	if sentence == "" {
+		return nil, appErrors.NewValidationError("Sentence is required", nil)
+	}
+	nativeLang := strings.TrimSpace(req.NativeLang)
+	if nativeLang == "" {
+		return nil, appErrors.NewValidationError("Native language is required", nil)
+	}
+
+	key := explainCacheKey(sentence, nativeLang)
+	if cached, ok := s.cache.Get(key); ok {
+		cached.Cached = true
+		return &cached, nil
+	}
+
+	if !s.userLimiter(userID).Allow() {
+		return nil, appErrors.NewAppError("Too many explanation requests, please wait a moment", nil, http.StatusTooManyRequests)
+	}
+
+	explanation, err := s.explainFn(ctx, sentence, nativeLang)
+	if err != nil {
+		s.logger.Error("Grammar explanation failed",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return nil, appErrors.NewAppError("Failed to explain sentence", err, http.StatusBadGateway)
+	}
+
+	response := toExplainResponse(explanation)
+	s.cache.Put(key, response)
+
+	return &response, nil
+}
+
+// userLimiter returns the user's rate limiter, creating it on first use
+func (s *Service) userLimiter(userID uint) *patterns.SlidingWindowLimiter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	limiter, ok := s.limiters[userID]
+	if !ok {
+		limiter = patterns.NewSlidingWindowLimiter(s.requestsPerMinute, time.Minute, s.logger.Zap())
+		s.limiters[userID] = limiter
+	}
+	return limiter
+}
+
+func toExplainResponse(explanation *gemini.GrammarExplanation) dto.ExplainResponse {
+	response := dto.ExplainResponse{
+		Sentence:           explanation.Sentence,
+		LiteralTranslation: explanation.LiteralTranslation,
+		NaturalTranslation: explanation.NaturalTranslation,
+	}
+	for _, clause := range explanation.Clauses {
+		response.Clauses = append(response.Clauses, dto.ExplainClause{Text: clause.Text, Role: clause.Role})
+	}
+	for _, tense := range explanation.Tenses {
+		response.Tenses = append(response.Tenses, dto.ExplainTense{Verb: tense.Verb, Tense: tense.Tense, Explanation: tense.Explanation})
+	}
+	for _, idiom := range explanation.Idioms {
+		response.Idioms = append(response.Idioms, dto.ExplainIdiom{Phrase: idiom.Phrase, Meaning: idiom.Meaning})
+	}
+	return response
+}
+
+// explainCacheKey hashes the sentence and native language into a cache
+// key; the breakdown depends on both
+func explainCacheKey(sentence string, nativeLang string) string {
+	sum := sha256.Sum256([]byte(sentence + "\x00" + strings.ToLower(nativeLang)))
+	return hex.EncodeToString(sum[:])
+}
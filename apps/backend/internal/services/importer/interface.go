@@ -0,0 +1,71 @@
+package importer
+
+import (
+	"context"
+
+	"app-backend/internal/models"
+)
+
+// JobTypePlaylistImport is the job type playlist imports run under in the
+// background job queue
+const JobTypePlaylistImport = "playlist_import"
+
+// Import statuses reported in a job's result while a batch import progresses
+const (
+	// JobStatusRunning means queued transcript fetches are still outstanding
+	JobStatusRunning = "running"
+	// JobStatusCompleted means every video's transcript was fetched
+	JobStatusCompleted = "completed"
+	// JobStatusCompletedWithErrors means the import finished but some videos failed
+	JobStatusCompletedWithErrors = "completed_with_errors"
+)
+
+// Video statuses within an import
+const (
+	VideoStatusPending   = "pending"
+	VideoStatusCompleted = "completed"
+	VideoStatusFailed    = "failed"
+)
+
+// JobVideo is the state of one video within an import
+type JobVideo struct {
+	VideoID string `json:"videoId"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+// ImportResult is the progress snapshot a playlist import stores as its
+// job's result JSON; it is updated after every video while the import runs
+type ImportResult struct {
+	Status          string     `json:"status"`
+	SourceURL       string     `json:"sourceUrl"`
+	PlaylistID      string     `json:"playlistId"`
+	Language        string     `json:"language,omitempty"`
+	TotalVideos     int        `json:"totalVideos"`
+	CompletedVideos int        `json:"completedVideos"`
+	FailedVideos    int        `json:"failedVideos"`
+	Videos          []JobVideo `json:"videos"`
+}
+
+// PlaylistSource enumerates the videos behind a playlist or channel.
+// *youtube.Service satisfies it.
+type PlaylistSource interface {
+	// ListPlaylistVideoIDs returns the video IDs of a playlist in playlist
+	// order, truncated to maxVideos when positive
+	ListPlaylistVideoIDs(ctx context.Context, playlistID string, maxVideos int) ([]string, error)
+
+	// ResolveUploadsPlaylistID resolves a channel ID or @handle to the
+	// channel's uploads playlist
+	ResolveUploadsPlaylistID(ctx context.Context, channelRef string) (string, error)
+}
+
+// ServiceInterface defines the contract for the batch import service
+type ServiceInterface interface {
+	// StartImport enumerates the videos behind a playlist or channel URL and
+	// enqueues a background job that fetches every transcript, returning the
+	// queued job
+	StartImport(ctx context.Context, userID uint, sourceURL, language string) (*models.Job, error)
+
+	// Stop drains the transcript fetch queue and stops the workers
+	Stop()
+}
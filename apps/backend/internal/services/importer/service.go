@@ -0,0 +1,387 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/services/jobs"
+	"app-backend/internal/services/transcript"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+	"app-backend/pkg/patterns"
+)
+
+const (
+	// defaultMaxVideos caps how many videos a single import may enumerate
+	defaultMaxVideos = 200
+	// fetchBatchSize bounds how many transcript fetches one worker runs per batch
+	fetchBatchSize = 5
+	// fetchBatchTimeout bounds one batch of sequential transcript fetches
+	fetchBatchTimeout = 5 * time.Minute
+	// fetchFlushInterval is how long a worker waits before running a partial batch
+	fetchFlushInterval = 2 * time.Second
+)
+
+// Config holds batch import tuning knobs; zero values use defaults
+type Config struct {
+	// MaxVideos caps how many videos one import job may cover
+	MaxVideos int
+	// MaxWorkers is the number of concurrent transcript fetch workers
+	MaxWorkers int
+}
+
+// fetchTask is one queued transcript fetch
+type fetchTask struct {
+	videoID  string
+	language string
+}
+
+// importPayload is the JSON a playlist import job carries. Video IDs are
+// enumerated before enqueueing, so retries rerun the same set of videos.
+type importPayload struct {
+	SourceURL  string   `json:"sourceUrl"`
+	PlaylistID string   `json:"playlistId"`
+	Language   string   `json:"language,omitempty"`
+	VideoIDs   []string `json:"videoIds"`
+}
+
+// Service runs playlist and channel transcript imports through the background
+// job queue; the fetched transcripts land in the shared transcript cache
+type Service struct {
+	source      PlaylistSource
+	transcripts transcript.ServiceInterface
+	queue       jobs.ServiceInterface
+	processor   *patterns.AsyncBatchProcessor[fetchTask, struct{}]
+	maxVideos   int
+	logger      *logger.Logger
+}
+
+// NewService creates the batch import service, starts its fetch workers, and
+// registers the playlist import handler on the job queue
+func NewService(source PlaylistSource, transcripts transcript.ServiceInterface, queue jobs.ServiceInterface, cfg Config, logger *logger.Logger) ServiceInterface {
+	maxVideos := cfg.MaxVideos
+	if maxVideos <= 0 {
+		maxVideos = defaultMaxVideos
+	}
+
+	s := &Service{
+		source:      source,
+		transcripts: transcripts,
+		queue:       queue,
+		maxVideos:   maxVideos,
+		logger:      logger,
+	}
+
+	s.processor = patterns.NewAsyncBatchProcessor(patterns.BatchProcessorConfig{
+		BatchSize:      fetchBatchSize,
+		FlushInterval:  fetchFlushInterval,
+		MaxWorkers:     cfg.MaxWorkers,
+		ProcessTimeout: fetchBatchTimeout,
+		Logger:         logger.Zap(),
+	}, s.fetchBatch)
+	s.processor.Start()
+
+	if queue != nil {
+		queue.RegisterHandler(JobTypePlaylistImport, s.runImport)
+	}
+
+	return s
+}
+
+// Stop drains the transcript fetch queue and stops the workers
+func (s *Service) Stop() {
+	s.processor.Stop()
+}
+
+// StartImport enumerates the videos behind a playlist or channel URL and
+// enqueues a background job that fetches every transcript
+func (s *Service) StartImport(ctx context.Context, userID uint, sourceURL, language string) (*models.Job, error) {
+	if s.transcripts == nil {
+		return nil, errors.NewAppError("Transcript service not available", nil, http.StatusServiceUnavailable)
+	}
+	if s.queue == nil {
+		return nil, errors.NewAppError("Job queue not available", nil, http.StatusServiceUnavailable)
+	}
+
+	playlistID, err := s.resolvePlaylistID(ctx, sourceURL)
+	if err != nil {
+		return nil, err
+	}
+
+	videoIDs, err := s.source.ListPlaylistVideoIDs(ctx, playlistID, s.maxVideos)
+	if err != nil {
+		s.logger.Error("Failed to enumerate playlist videos",
+			zap.String("playlist_id", playlistID),
+			zap.Error(err))
+		return nil, errors.NewAppError("Failed to enumerate playlist videos", err, http.StatusBadGateway)
+	}
+	if len(videoIDs) == 0 {
+		return nil, errors.NewAppError("No videos found in playlist", nil, http.StatusNotFound)
+	}
+
+	payload := importPayload{
+		SourceURL:  sourceURL,
+		PlaylistID: playlistID,
+		Language:   language,
+		VideoIDs:   videoIDs,
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to encode import payload", err, http.StatusInternalServerError)
+	}
+
+	job, err := s.queue.Enqueue(userID, JobTypePlaylistImport, string(payloadJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	// Persist the initial all-pending snapshot so job polls have the import
+	// shape before the first attempt starts
+	if resultJSON, marshalErr := json.Marshal(newImportProgress(payload).result()); marshalErr == nil {
+		if updateErr := s.queue.UpdateResult(job.ID, string(resultJSON)); updateErr == nil {
+			job.Result = string(resultJSON)
+		}
+	}
+
+	s.logger.Info("Queued playlist import",
+		zap.Uint("job_id", job.ID),
+		zap.Uint("user_id", userID),
+		zap.String("playlist_id", playlistID),
+		zap.Int("videos", len(videoIDs)))
+
+	return job, nil
+}
+
+// runImport is the playlist import job handler: it fans the payload's videos
+// out to the fetch workers and reports per-video progress on the job
+func (s *Service) runImport(ctx context.Context, job *models.Job) (string, error) {
+	var payload importPayload
+	if err := json.Unmarshal([]byte(job.Payload), &payload); err != nil {
+		return "", fmt.Errorf("invalid playlist import payload: %w", err)
+	}
+
+	progress := newImportProgress(payload)
+
+	// Queue a fetch per video; submissions rejected by a full queue fail the
+	// video immediately rather than blocking the worker
+	type pendingResult struct {
+		videoID string
+		ch      <-chan patterns.BatchResult[struct{}]
+	}
+	pending := make([]pendingResult, 0, len(payload.VideoIDs))
+	for _, videoID := range payload.VideoIDs {
+		ch, err := s.processor.SubmitAsync(patterns.BatchItem[fetchTask]{
+			ID:   fmt.Sprintf("%d:%s", job.ID, videoID),
+			Data: fetchTask{videoID: videoID, language: payload.Language},
+		})
+		if err != nil {
+			progress.record(videoID, err)
+			continue
+		}
+		pending = append(pending, pendingResult{videoID: videoID, ch: ch})
+	}
+
+	for _, p := range pending {
+		select {
+		case result, ok := <-p.ch:
+			if !ok {
+				// Processor stopped before this fetch ran
+				progress.record(p.videoID, context.Canceled)
+			} else {
+				progress.record(p.videoID, result.Error)
+			}
+			s.reportProgress(job.ID, progress)
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+
+	progress.finalize()
+	resultJSON, err := json.Marshal(progress.result())
+	if err != nil {
+		return "", fmt.Errorf("failed to encode import result: %w", err)
+	}
+
+	// When not a single transcript could be fetched the whole import likely
+	// hit an outage; fail the attempt so the queue retries it later
+	if progress.completed == 0 && progress.failed > 0 {
+		s.reportProgress(job.ID, progress)
+		return "", fmt.Errorf("all %d transcript fetches failed", progress.failed)
+	}
+
+	s.logger.Info("Playlist import finished",
+		zap.Uint("job_id", job.ID),
+		zap.String("status", progress.status),
+		zap.Int("completed", progress.completed),
+		zap.Int("failed", progress.failed))
+
+	return string(resultJSON), nil
+}
+
+// reportProgress stores the current import snapshot on the job so polls see
+// per-video progress while the import runs
+func (s *Service) reportProgress(jobID uint, progress *importProgress) {
+	resultJSON, err := json.Marshal(progress.result())
+	if err != nil {
+		return
+	}
+	if err := s.queue.UpdateResult(jobID, string(resultJSON)); err != nil {
+		s.logger.Warn("Failed to report import progress",
+			zap.Uint("job_id", jobID),
+			zap.Error(err))
+	}
+}
+
+// fetchBatch runs one batch of transcript fetches sequentially; each fetch
+// populates the shared transcript cache on success
+func (s *Service) fetchBatch(ctx context.Context, items []patterns.BatchItem[fetchTask]) ([]patterns.BatchResult[struct{}], error) {
+	results := make([]patterns.BatchResult[struct{}], 0, len(items))
+	for _, item := range items {
+		_, err := s.transcripts.GetTranscript(ctx, &transcriptTypes.TranscriptRequest{
+			VideoID:  item.Data.videoID,
+			Language: item.Data.language,
+		})
+		results = append(results, patterns.BatchResult[struct{}]{ID: item.ID, Error: err})
+	}
+	return results, nil
+}
+
+// resolvePlaylistID turns a playlist or channel URL into a playlist ID,
+// resolving channels to their uploads playlist
+func (s *Service) resolvePlaylistID(ctx context.Context, sourceURL string) (string, error) {
+	playlistID, channelRef, err := parseImportSource(sourceURL)
+	if err != nil {
+		return "", err
+	}
+	if playlistID != "" {
+		return playlistID, nil
+	}
+
+	uploadsID, err := s.source.ResolveUploadsPlaylistID(ctx, channelRef)
+	if err != nil {
+		s.logger.Error("Failed to resolve channel uploads playlist",
+			zap.String("channel_ref", channelRef),
+			zap.Error(err))
+		return "", errors.NewAppError("Failed to resolve channel", err, http.StatusBadGateway)
+	}
+	return uploadsID, nil
+}
+
+// parseImportSource extracts a playlist ID or channel reference from a
+// YouTube URL. Supported forms: any URL with a list= parameter, /channel/UC...
+// paths, and /@handle paths.
+func parseImportSource(sourceURL string) (playlistID, channelRef string, err error) {
+	trimmed := strings.TrimSpace(sourceURL)
+
+	parsed, parseErr := url.Parse(trimmed)
+	if parseErr == nil && parsed.Host != "" {
+		if list := parsed.Query().Get("list"); list != "" {
+			return list, "", nil
+		}
+
+		segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+		if len(segments) >= 2 && segments[0] == "channel" {
+			return "", segments[1], nil
+		}
+		if len(segments) >= 1 && strings.HasPrefix(segments[0], "@") {
+			return "", segments[0], nil
+		}
+	}
+
+	return "", "", errors.NewAppError(
+		"Unsupported playlist or channel URL", nil, http.StatusBadRequest)
+}
+
+// importProgress tracks one import run; it is only touched from the job
+// handler goroutine, so it needs no locking
+type importProgress struct {
+	sourceURL  string
+	playlistID string
+	language   string
+	status     string
+	videos     []JobVideo
+	videoIndex map[string]int
+	completed  int
+	failed     int
+}
+
+// newImportProgress builds the all-pending progress state for a payload
+func newImportProgress(payload importPayload) *importProgress {
+	p := &importProgress{
+		sourceURL:  payload.SourceURL,
+		playlistID: payload.PlaylistID,
+		language:   payload.Language,
+		status:     JobStatusRunning,
+		videos:     make([]JobVideo, 0, len(payload.VideoIDs)),
+		videoIndex: make(map[string]int, len(payload.VideoIDs)),
+	}
+	for _, videoID := range payload.VideoIDs {
+		p.videoIndex[videoID] = len(p.videos)
+		p.videos = append(p.videos, JobVideo{VideoID: videoID, Status: VideoStatusPending})
+	}
+	return p
+}
+
+// record marks one video done
+func (p *importProgress) record(videoID string, fetchErr error) {
+	idx, ok := p.videoIndex[videoID]
+	if !ok || p.videos[idx].Status != VideoStatusPending {
+		return
+	}
+
+	if fetchErr != nil {
+		p.videos[idx].Status = VideoStatusFailed
+		p.videos[idx].Error = fetchErr.Error()
+		p.failed++
+	} else {
+		p.videos[idx].Status = VideoStatusCompleted
+		p.completed++
+	}
+}
+
+// finalize settles the import status once every video is done
+func (p *importProgress) finalize() {
+	if p.failed > 0 {
+		p.status = JobStatusCompletedWithErrors
+	} else {
+		p.status = JobStatusCompleted
+	}
+}
+
+// result builds the snapshot stored as the job's result JSON
+func (p *importProgress) result() *ImportResult {
+	videos := make([]JobVideo, len(p.videos))
+	copy(videos, p.videos)
+
+	return &ImportResult{
+		Status:          p.status,
+		SourceURL:       p.sourceURL,
+		PlaylistID:      p.playlistID,
+		Language:        p.language,
+		TotalVideos:     len(p.videos),
+		CompletedVideos: p.completed,
+		FailedVideos:    p.failed,
+		Videos:          videos,
+	}
+}
+
+// ParseResult decodes a playlist import job's result snapshot
+func ParseResult(job *models.Job) (*ImportResult, error) {
+	if job == nil || job.Result == "" {
+		return nil, fmt.Errorf("job has no import result")
+	}
+	var result ImportResult
+	if err := json.Unmarshal([]byte(job.Result), &result); err != nil {
+		return nil, fmt.Errorf("invalid import result: %w", err)
+	}
+	return &result, nil
+}
@@ -0,0 +1,37 @@
+package jobs
+
+import (
+	"context"
+
+	"app-backend/internal/models"
+)
+
+// HandlerFunc executes one job attempt. It receives the persisted job row
+// and returns the result JSON to store; a non-nil error schedules a retry
+// until the job's attempts are exhausted.
+type HandlerFunc func(ctx context.Context, job *models.Job) (result string, err error)
+
+// ServiceInterface defines the contract for the background job queue
+type ServiceInterface interface {
+	// RegisterHandler binds a handler to a job type. Must be called before
+	// Start; jobs of an unregistered type fail permanently.
+	RegisterHandler(jobType string, handler HandlerFunc)
+
+	// Enqueue persists a job and makes it due immediately
+	Enqueue(userID uint, jobType string, payload string) (*models.Job, error)
+
+	// GetJob returns one of the user's jobs
+	GetJob(userID uint, jobID uint) (*models.Job, error)
+
+	// UpdateResult overwrites a job's result JSON; handlers call it to
+	// report progress mid-run
+	UpdateResult(jobID uint, result string) error
+
+	// Start requeues jobs interrupted by a previous shutdown and launches
+	// the poller and workers
+	Start()
+
+	// Stop drains in-flight jobs, stops the workers, and requeues anything
+	// left running so it resumes after restart
+	Stop()
+}
@@ -0,0 +1,322 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/pkg/patterns"
+)
+
+const (
+	// defaultPollInterval is how often the poller claims due jobs
+	defaultPollInterval = time.Second
+	// defaultMaxAttempts is how often a job runs before failing permanently
+	defaultMaxAttempts = 3
+	// defaultRetryBackoff is the first retry delay; it doubles per attempt
+	defaultRetryBackoff = 30 * time.Second
+	// defaultJobTimeout bounds a single job attempt
+	defaultJobTimeout = 10 * time.Minute
+)
+
+// Config holds job queue tuning knobs; zero values use defaults
+type Config struct {
+	// Workers is the number of concurrent job workers
+	Workers int
+	// PollInterval is how often due jobs are claimed from the database
+	PollInterval time.Duration
+	// MaxAttempts is how often a job runs before failing permanently
+	MaxAttempts int
+	// RetryBackoff is the first retry delay; it doubles per attempt
+	RetryBackoff time.Duration
+	// JobTimeout bounds a single job attempt
+	JobTimeout time.Duration
+}
+
+// Service is a database-backed job queue. The poller claims due jobs and
+// hands them to a worker pool; failed attempts are retried with exponential
+// backoff until the job's attempts are exhausted.
+type Service struct {
+	jobRepo repositories.JobRepositoryInterface
+	logger  *logger.Logger
+
+	pollInterval time.Duration
+	maxAttempts  int
+	retryBackoff time.Duration
+	// claimBatchSize caps how many due jobs one poll claims; keeping it at
+	// the worker count avoids holding claimed jobs the pool cannot run yet
+	claimBatchSize int
+
+	handlersMu sync.RWMutex
+	handlers   map[string]HandlerFunc
+
+	pool *patterns.WorkerPool[*models.Job, struct{}]
+	// inFlight tracks claimed jobs from submission until their row is
+	// final, so Stop can drain them before stopping the pool
+	inFlight sync.WaitGroup
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stop      chan struct{}
+	done      chan struct{}
+	// now is injectable for deterministic scheduling tests
+	now func() time.Time
+}
+
+// NewService creates the job queue; call Start to begin processing
+func NewService(jobRepo repositories.JobRepositoryInterface, cfg Config, logger *logger.Logger) ServiceInterface {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 3
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultPollInterval
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultMaxAttempts
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = defaultRetryBackoff
+	}
+	if cfg.JobTimeout <= 0 {
+		cfg.JobTimeout = defaultJobTimeout
+	}
+
+	return &Service{
+		jobRepo:        jobRepo,
+		logger:         logger,
+		pollInterval:   cfg.PollInterval,
+		maxAttempts:    cfg.MaxAttempts,
+		retryBackoff:   cfg.RetryBackoff,
+		claimBatchSize: cfg.Workers,
+		handlers:       make(map[string]HandlerFunc),
+		pool: patterns.NewWorkerPool[*models.Job, struct{}](patterns.WorkerPoolConfig{
+			WorkerCount: cfg.Workers,
+			Timeout:     cfg.JobTimeout,
+			Logger:      logger.Zap(),
+		}),
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+		now:  time.Now,
+	}
+}
+
+// RegisterHandler binds a handler to a job type
+func (s *Service) RegisterHandler(jobType string, handler HandlerFunc) {
+	s.handlersMu.Lock()
+	defer s.handlersMu.Unlock()
+	s.handlers[jobType] = handler
+}
+
+// Enqueue persists a job and makes it due immediately
+func (s *Service) Enqueue(userID uint, jobType string, payload string) (*models.Job, error) {
+	job := &models.Job{
+		UserID:      userID,
+		Type:        jobType,
+		Status:      models.JobStatusQueued,
+		Payload:     payload,
+		MaxAttempts: s.maxAttempts,
+		NextRunAt:   s.now().UTC(),
+	}
+
+	if err := s.jobRepo.Create(job); err != nil {
+		return nil, errors.NewAppError("Failed to enqueue job", err, http.StatusInternalServerError)
+	}
+
+	s.logger.Info("Job enqueued",
+		zap.Uint("job_id", job.ID),
+		zap.String("type", jobType),
+		zap.Uint("user_id", userID))
+	return job, nil
+}
+
+// GetJob returns one of the user's jobs
+func (s *Service) GetJob(userID uint, jobID uint) (*models.Job, error) {
+	job, err := s.jobRepo.GetByUserAndID(userID, jobID)
+	if err != nil {
+		return nil, errors.NewAppError("Job not found", err, http.StatusNotFound)
+	}
+	return job, nil
+}
+
+// UpdateResult overwrites a job's result JSON without touching its status
+func (s *Service) UpdateResult(jobID uint, result string) error {
+	return s.jobRepo.UpdateResult(jobID, result)
+}
+
+// Start requeues jobs interrupted by a previous shutdown and launches the
+// poller and workers
+func (s *Service) Start() {
+	s.startOnce.Do(func() {
+		if requeued, err := s.jobRepo.RequeueRunning(); err != nil {
+			s.logger.Error("Failed to requeue interrupted jobs", zap.Error(err))
+		} else if requeued > 0 {
+			s.logger.Info("Requeued jobs interrupted by previous shutdown",
+				zap.Int64("count", requeued))
+		}
+
+		s.pool.Start()
+		go s.drainResults()
+		go s.pollLoop()
+	})
+}
+
+// Stop drains in-flight jobs, stops the workers, and requeues anything left
+// running so it resumes after restart
+func (s *Service) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+		<-s.done
+
+		// Let claimed jobs finish before tearing the pool down
+		s.inFlight.Wait()
+		s.pool.Stop()
+
+		if requeued, err := s.jobRepo.RequeueRunning(); err != nil {
+			s.logger.Error("Failed to requeue running jobs on shutdown", zap.Error(err))
+		} else if requeued > 0 {
+			s.logger.Warn("Requeued jobs still running at shutdown",
+				zap.Int64("count", requeued))
+		}
+	})
+}
+
+// pollLoop claims due jobs on an interval until stopped
+func (s *Service) pollLoop() {
+	defer close(s.done)
+
+	// Claim immediately so startup backlogs begin draining without waiting
+	// a full interval
+	s.claimAndSubmit()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.claimAndSubmit()
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// claimAndSubmit moves due jobs to running and hands them to the workers
+func (s *Service) claimAndSubmit() {
+	claimed, err := s.jobRepo.ClaimDue(s.claimBatchSize, s.now().UTC())
+	if err != nil {
+		s.logger.Error("Failed to claim due jobs", zap.Error(err))
+		return
+	}
+
+	for _, job := range claimed {
+		s.submit(job)
+	}
+}
+
+// submit hands one claimed job to the worker pool, releasing it back to the
+// queue when the pool cannot take it
+func (s *Service) submit(job *models.Job) {
+	s.inFlight.Add(1)
+	err := s.pool.Submit(patterns.Job[*models.Job, struct{}]{
+		ID:   strconv.FormatUint(uint64(job.ID), 10),
+		Data: job,
+		Process: func(ctx context.Context, job *models.Job) (struct{}, error) {
+			defer s.inFlight.Done()
+			s.execute(ctx, job)
+			return struct{}{}, nil
+		},
+	})
+	if err != nil {
+		s.inFlight.Done()
+		s.logger.Warn("Worker pool rejected job, rescheduling",
+			zap.Uint("job_id", job.ID),
+			zap.Error(err))
+		s.reschedule(job, fmt.Errorf("worker pool rejected job: %w", err))
+	}
+}
+
+// execute runs one job attempt and persists the outcome
+func (s *Service) execute(ctx context.Context, job *models.Job) {
+	s.handlersMu.RLock()
+	handler, ok := s.handlers[job.Type]
+	s.handlersMu.RUnlock()
+
+	if !ok {
+		s.fail(job, fmt.Errorf("no handler registered for job type %q", job.Type))
+		return
+	}
+
+	s.logger.Info("Job attempt started",
+		zap.Uint("job_id", job.ID),
+		zap.String("type", job.Type),
+		zap.Int("attempt", job.Attempts))
+
+	result, err := handler(ctx, job)
+	if err != nil {
+		s.reschedule(job, err)
+		return
+	}
+
+	if updateErr := s.jobRepo.MarkCompleted(job.ID, result, s.now().UTC()); updateErr != nil {
+		s.logger.Error("Failed to persist completed job", zap.Uint("job_id", job.ID), zap.Error(updateErr))
+		return
+	}
+
+	s.logger.Info("Job completed",
+		zap.Uint("job_id", job.ID),
+		zap.String("type", job.Type),
+		zap.Int("attempts", job.Attempts))
+}
+
+// reschedule queues a failed attempt for retry with exponential backoff, or
+// fails the job permanently once its attempts are exhausted
+func (s *Service) reschedule(job *models.Job, attemptErr error) {
+	if job.Attempts >= job.MaxAttempts {
+		s.fail(job, attemptErr)
+		return
+	}
+
+	backoff := s.retryBackoff << (job.Attempts - 1)
+	if err := s.jobRepo.Reschedule(job.ID, attemptErr.Error(), s.now().UTC().Add(backoff)); err != nil {
+		s.logger.Error("Failed to reschedule job", zap.Uint("job_id", job.ID), zap.Error(err))
+		return
+	}
+
+	s.logger.Warn("Job attempt failed, retry scheduled",
+		zap.Uint("job_id", job.ID),
+		zap.String("type", job.Type),
+		zap.Int("attempt", job.Attempts),
+		zap.Duration("backoff", backoff),
+		zap.Error(attemptErr))
+}
+
+// fail marks a job permanently failed, keeping whatever result its handler
+// last reported
+func (s *Service) fail(job *models.Job, attemptErr error) {
+	if err := s.jobRepo.MarkFailed(job.ID, attemptErr.Error(), s.now().UTC()); err != nil {
+		s.logger.Error("Failed to persist failed job", zap.Uint("job_id", job.ID), zap.Error(err))
+		return
+	}
+
+	s.logger.Error("Job failed permanently",
+		zap.Uint("job_id", job.ID),
+		zap.String("type", job.Type),
+		zap.Int("attempts", job.Attempts),
+		zap.Error(attemptErr))
+}
+
+// drainResults consumes the pool's result channel; outcomes are persisted by
+// execute, so results only need to be discarded to keep workers unblocked
+func (s *Service) drainResults() {
+	for range s.pool.Results() {
+	}
+}
@@ -11,4 +11,4 @@ type Claims struct {
 	SessionID uint   `json:"session_id"`
 	TokenType string `json:"token_type"`
 	jwt.RegisteredClaims
-}
\ No newline at end of file
+}
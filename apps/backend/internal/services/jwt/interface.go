@@ -11,4 +11,4 @@ type ServiceInterface interface {
 	GetTokenHash(token string) string
 	GetAccessTokenTTL() time.Duration
 	GetRefreshTokenTTL() time.Duration
-}
\ No newline at end of file
+}
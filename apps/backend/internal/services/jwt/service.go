@@ -10,16 +10,16 @@ import (
 )
 
 type Service struct {
-	secretKey           []byte
-	accessTokenTTL      time.Duration
-	refreshTokenTTL     time.Duration
+	secretKey       []byte
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
 }
 
 func NewJWTService(cfg *config.Config) ServiceInterface {
 	return &Service{
-		secretKey:           []byte(cfg.JWT.Secret),
-		accessTokenTTL:      time.Duration(cfg.JWT.AccessTTLMinutes) * time.Minute,
-		refreshTokenTTL:     time.Duration(cfg.JWT.RefreshTTLHours) * time.Hour,
+		secretKey:       []byte(cfg.JWT.Secret),
+		accessTokenTTL:  time.Duration(cfg.JWT.AccessTTLMinutes) * time.Minute,
+		refreshTokenTTL: time.Duration(cfg.JWT.RefreshTTLHours) * time.Hour,
 	}
 }
 
@@ -93,4 +93,4 @@ func (s *Service) GetAccessTokenTTL() time.Duration {
 
 func (s *Service) GetRefreshTokenTTL() time.Duration {
 	return s.refreshTokenTTL
-}
\ No newline at end of file
+}
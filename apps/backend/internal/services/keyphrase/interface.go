@@ -0,0 +1,17 @@
+package keyphrase
+
+import (
+	"app-backend/internal/dto"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+)
+
+// ServiceInterface defines the contract for key-phrase extraction
+type ServiceInterface interface {
+	// Extract returns the highest-value vocabulary candidates from the
+	// given transcript segments, scored by how often each term appears and
+	// how rare it is in everyday English. Candidates carry the timestamp
+	// and sentence of their first occurrence so they can be saved to a
+	// vocabulary list in one click. Limit caps the result count; zero or
+	// negative uses the default.
+	Extract(segments []transcriptTypes.TranscriptSegment, limit int) []dto.KeyPhraseCandidate
+}
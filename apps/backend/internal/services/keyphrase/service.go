@@ -0,0 +1,295 @@
+package keyphrase
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+)
+
+const (
+	// defaultLimit caps result counts when the request gives no limit
+	defaultLimit = 20
+	// minWordLength filters out tokens too short to be worth learning
+	minWordLength = 3
+	// minCollocationCount is how often a two-word combination must recur
+	// before it counts as a collocation rather than a coincidence
+	minCollocationCount = 2
+)
+
+// Service extracts high-value vocabulary and collocations from transcript
+// segments. Everything is computed locally: tokens are lemmatized with
+// heuristic suffix rules, then scored by transcript frequency weighted by
+// rarity against an embedded everyday-English ranking.
+type Service struct {
+	logger *logger.Logger
+}
+
+// NewService creates a new key-phrase extraction service
+func NewService(logger *logger.Logger) ServiceInterface {
+	return &Service{logger: logger}
+}
+
+// candidate accumulates the occurrences of one lemma or collocation
+type candidate struct {
+	term         string
+	lemma        string
+	count        int
+	rarity       float64
+	firstStart   time.Duration
+	firstExample string
+}
+
+// Extract returns the highest-value vocabulary candidates from the given
+// transcript segments
+func (s *Service) Extract(segments []transcriptTypes.TranscriptSegment, limit int) []dto.KeyPhraseCandidate {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+
+	words := make(map[string]*candidate)
+	collocations := make(map[string]*candidate)
+
+	for _, segment := range segments {
+		tokens := tokenize(segment.Text)
+
+		for i, token := range tokens {
+			if stopwords[token] || len(token) < minWordLength {
+				continue
+			}
+			lemma := lemmatize(token)
+			if stopwords[lemma] {
+				continue
+			}
+			record(words, lemma, token, rarity(lemma), segment)
+
+			// Two content words with at most one function word between
+			// them ("strike a balance") form a collocation candidate; it
+			// still has to recur to survive the cut
+			next, ok := nextContentToken(tokens, i)
+			if !ok {
+				continue
+			}
+			nextLemma := lemmatize(next)
+			if stopwords[nextLemma] {
+				continue
+			}
+			pairRarity := (rarity(lemma) + rarity(nextLemma)) / 2
+			record(collocations, lemma+" "+nextLemma, token+" "+next, pairRarity, segment)
+		}
+	}
+
+	// A stem that lost its silent "e" to the suffix rules merges into the
+	// e-form when both were seen, so "negotiat" (from "negotiating") joins
+	// "negotiate" (from "negotiates")
+	for key, c := range words {
+		target, ok := words[key+"e"]
+		if !ok {
+			continue
+		}
+		target.count += c.count
+		if c.firstStart < target.firstStart {
+			target.term = c.term
+			target.firstStart = c.firstStart
+			target.firstExample = c.firstExample
+		}
+		delete(words, key)
+	}
+
+	ranked := make([]*candidate, 0, len(words)+len(collocations))
+	for _, c := range words {
+		ranked = append(ranked, c)
+	}
+	for _, c := range collocations {
+		if c.count >= minCollocationCount {
+			ranked = append(ranked, c)
+		}
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		si, sj := score(ranked[i]), score(ranked[j])
+		if si != sj {
+			return si > sj
+		}
+		if ranked[i].count != ranked[j].count {
+			return ranked[i].count > ranked[j].count
+		}
+		return ranked[i].term < ranked[j].term
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	result := make([]dto.KeyPhraseCandidate, len(ranked))
+	for i, c := range ranked {
+		result[i] = dto.KeyPhraseCandidate{
+			Term:             c.term,
+			Lemma:            c.lemma,
+			Count:            c.count,
+			Score:            score(c),
+			TimestampSeconds: c.firstStart.Seconds(),
+			ExampleSentence:  c.firstExample,
+		}
+	}
+	return result
+}
+
+// nextContentToken finds the content word following position i, skipping at
+// most one stopword so articles and prepositions inside a collocation do not
+// break the pair
+func nextContentToken(tokens []string, i int) (string, bool) {
+	for j := i + 1; j < len(tokens) && j <= i+2; j++ {
+		if stopwords[tokens[j]] {
+			continue
+		}
+		if len(tokens[j]) < minWordLength {
+			return "", false
+		}
+		return tokens[j], true
+	}
+	return "", false
+}
+
+// record counts one occurrence, keeping the timestamp and sentence of the
+// first one as the candidate's example
+func record(candidates map[string]*candidate, key string, term string, termRarity float64, segment transcriptTypes.TranscriptSegment) {
+	c, ok := candidates[key]
+	if !ok {
+		c = &candidate{
+			term:         term,
+			lemma:        key,
+			rarity:       termRarity,
+			firstStart:   segment.Start,
+			firstExample: strings.TrimSpace(segment.Text),
+		}
+		candidates[key] = c
+	}
+	c.count++
+}
+
+// score weights how often a term recurs in the transcript by how rare it is
+// in everyday English, so a repeated unusual word outranks a repeated
+// common one
+func score(c *candidate) float64 {
+	return float64(c.count) * c.rarity
+}
+
+// rarity scores a lemma between 0 (everyday word) and 1 (not in the
+// everyday-English ranking at all)
+func rarity(lemma string) float64 {
+	rank, ok := commonLemmaRank[lemma]
+	if !ok {
+		return 1.0
+	}
+	return 0.1 + 0.4*float64(rank)/float64(len(commonLemmas))
+}
+
+// tokenize lowercases the text and splits it into words, keeping internal
+// apostrophes ("don't") but dropping any other punctuation and numbers
+func tokenize(text string) []string {
+	tokens := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\''
+	})
+	result := tokens[:0]
+	for _, token := range tokens {
+		token = strings.Trim(token, "'")
+		if token != "" {
+			result = append(result, token)
+		}
+	}
+	return result
+}
+
+// irregularLemmas maps common irregular forms that suffix rules cannot
+// reach to their dictionary form
+var irregularLemmas = map[string]string{
+	"men": "man", "women": "woman", "children": "child", "people": "person",
+	"feet": "foot", "teeth": "tooth", "mice": "mouse", "lives": "life",
+	"went": "go", "gone": "go", "made": "make", "said": "say", "saw": "see",
+	"seen": "see", "took": "take", "taken": "take", "gave": "give",
+	"given": "give", "knew": "know", "known": "know", "thought": "think",
+	"found": "find", "told": "tell", "felt": "feel", "left": "leave",
+	"came": "come", "brought": "bring", "bought": "buy", "wrote": "write",
+	"written": "write", "spoke": "speak", "spoken": "speak", "ran": "run",
+	"ate": "eat", "eaten": "eat", "grew": "grow", "grown": "grow",
+	"chose": "choose", "chosen": "choose", "drew": "draw", "drawn": "draw",
+	"broke": "break", "broken": "break", "caught": "catch", "taught": "teach",
+	"paid": "pay", "met": "meet", "sat": "sit", "stood": "stand",
+	"lost": "lose", "won": "win", "sold": "sell", "built": "build",
+	"sent": "send", "spent": "spend", "fell": "fall", "held": "hold",
+	"kept": "keep", "heard": "hear", "meant": "mean", "began": "begin",
+	"begun": "begin", "understood": "understand",
+}
+
+// lemmatize reduces a word to its dictionary form with a small irregular
+// table and heuristic suffix rules. The rules are deliberately conservative:
+// merging "studies" into "study" matters more than handling every edge case,
+// and an unrecognized word is simply kept as-is.
+func lemmatize(word string) string {
+	if lemma, ok := irregularLemmas[word]; ok {
+		return lemma
+	}
+
+	switch {
+	case strings.HasSuffix(word, "ies") && len(word) > 4:
+		return word[:len(word)-3] + "y"
+	case strings.HasSuffix(word, "ing") && len(word) > 5:
+		return restoreStem(word[:len(word)-3])
+	case strings.HasSuffix(word, "ed") && len(word) > 4:
+		return restoreStem(word[:len(word)-2])
+	case strings.HasSuffix(word, "es") && len(word) > 3 && esTakingEnding(word[:len(word)-2]):
+		return word[:len(word)-2]
+	case strings.HasSuffix(word, "s") && len(word) > 3 &&
+		!strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us") && !strings.HasSuffix(word, "is"):
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+// esTakingEnding reports whether a stem takes "-es" rather than "-s" in the
+// plural (boxes, watches, passes)
+func esTakingEnding(stem string) bool {
+	return strings.HasSuffix(stem, "s") || strings.HasSuffix(stem, "x") ||
+		strings.HasSuffix(stem, "z") || strings.HasSuffix(stem, "ch") ||
+		strings.HasSuffix(stem, "sh")
+}
+
+// restoreStem repairs a stem after stripping "-ing" or "-ed": doubled final
+// consonants lose one letter (running -> run) and short stems that dropped a
+// silent "e" get it back (making -> make)
+func restoreStem(stem string) string {
+	if len(stem) < 3 {
+		return stem
+	}
+	last := stem[len(stem)-1]
+	if last == stem[len(stem)-2] && !isVowel(rune(last)) && last != 'l' && last != 's' {
+		return stem[:len(stem)-1]
+	}
+	if endsShortCVC(stem) {
+		return stem + "e"
+	}
+	return stem
+}
+
+// endsShortCVC reports whether the stem ends consonant-vowel-consonant,
+// the pattern where English drops a silent "e" before a vowel suffix
+func endsShortCVC(stem string) bool {
+	if len(stem) < 3 {
+		return false
+	}
+	runes := []rune(stem[len(stem)-3:])
+	if isVowel(runes[0]) || !isVowel(runes[1]) || isVowel(runes[2]) {
+		return false
+	}
+	// w, x, and y never end a dropped-e stem (snowing, boxing, playing)
+	last := runes[2]
+	return last != 'w' && last != 'x' && last != 'y'
+}
+
+func isVowel(r rune) bool {
+	return r == 'a' || r == 'e' || r == 'i' || r == 'o' || r == 'u'
+}
@@ -0,0 +1,98 @@
+package keyphrase
+
+// stopwords are function words that carry no vocabulary value on their own
+// and never appear in extraction results
+var stopwords = map[string]bool{
+	"a": true, "about": true, "above": true, "after": true, "again": true,
+	"against": true, "all": true, "am": true, "an": true, "and": true,
+	"any": true, "are": true, "as": true, "at": true, "be": true,
+	"because": true, "been": true, "before": true, "being": true, "below": true,
+	"between": true, "both": true, "but": true, "by": true, "can": true,
+	"cannot": true, "could": true, "did": true, "do": true, "does": true,
+	"doing": true, "down": true, "during": true, "each": true, "few": true,
+	"for": true, "from": true, "further": true, "had": true, "has": true,
+	"have": true, "having": true, "he": true, "her": true, "here": true,
+	"hers": true, "herself": true, "him": true, "himself": true, "his": true,
+	"how": true, "i": true, "if": true, "in": true, "into": true,
+	"is": true, "it": true, "its": true, "itself": true, "just": true,
+	"me": true, "more": true, "most": true, "my": true, "myself": true,
+	"no": true, "nor": true, "not": true, "now": true, "of": true,
+	"off": true, "on": true, "once": true, "only": true, "or": true,
+	"other": true, "our": true, "ours": true, "ourselves": true, "out": true,
+	"over": true, "own": true, "same": true, "she": true, "should": true,
+	"so": true, "some": true, "such": true, "than": true, "that": true,
+	"the": true, "their": true, "theirs": true, "them": true, "themselves": true,
+	"then": true, "there": true, "these": true, "they": true, "this": true,
+	"those": true, "through": true, "to": true, "too": true, "under": true,
+	"until": true, "up": true, "very": true, "was": true, "we": true,
+	"were": true, "what": true, "when": true, "where": true, "which": true,
+	"while": true, "who": true, "whom": true, "why": true, "will": true,
+	"with": true, "would": true, "you": true, "your": true, "yours": true,
+	"yourself": true, "yourselves": true, "dont": true, "gonna": true,
+	"gotta": true, "im": true, "ive": true, "lets": true, "okay": true,
+	"ok": true, "oh": true, "yeah": true, "yes": true, "uh": true, "um": true,
+	"well": true, "like": true, "also": true, "really": true, "going": true,
+	"get": true, "got": true, "one": true, "two": true, "thing": true,
+	"things": true,
+}
+
+// commonLemmas ranks everyday English lemmas from most to least frequent.
+// A lemma's position drives its rarity score: early entries are words every
+// learner already knows and score near zero, unlisted lemmas score full
+// rarity. The list deliberately stays below intermediate-learner level.
+var commonLemmas = []string{
+	"say", "make", "go", "know", "take", "see", "come", "think", "look",
+	"want", "give", "use", "find", "tell", "ask", "work", "seem", "feel",
+	"try", "leave", "call", "good", "new", "first", "last", "long", "great",
+	"little", "old", "right", "big", "high", "different", "small", "large",
+	"next", "early", "young", "important", "public", "bad", "able", "time",
+	"person", "year", "way", "day", "man", "woman", "child", "world", "life",
+	"hand", "part", "place", "case", "week", "company", "system", "program",
+	"question", "government", "number", "night", "point", "home", "water",
+	"room", "mother", "area", "money", "story", "fact", "month", "lot",
+	"study", "book", "eye", "job", "word", "business", "issue", "side",
+	"kind", "head", "house", "friend", "father", "power", "hour", "game",
+	"line", "end", "member", "law", "car", "city", "name", "team", "minute",
+	"idea", "body", "back", "parent", "face", "others", "level", "office",
+	"door", "health", "art", "war", "history", "party", "result", "change",
+	"morning", "reason", "research", "moment", "air", "teacher", "force",
+	"education", "foot", "boy", "girl", "food", "need", "help", "talk",
+	"turn", "start", "show", "hear", "play", "run", "move", "live",
+	"believe", "bring", "happen", "write", "sit", "stand", "lose", "pay",
+	"meet", "include", "continue", "set", "learn", "understand", "watch",
+	"follow", "stop", "create", "speak", "read", "spend", "grow", "open",
+	"walk", "win", "teach", "offer", "remember", "consider", "appear",
+	"buy", "serve", "die", "send", "build", "stay", "fall", "cut", "reach",
+	"kill", "raise", "pass", "sell", "decide", "return", "explain", "hope",
+	"develop", "carry", "break", "receive", "agree", "support", "hit",
+	"produce", "eat", "cover", "catch", "draw", "choose", "wait", "school",
+	"state", "family", "student", "group", "country", "problem", "fun",
+	"easy", "hard", "sure", "real", "free", "true", "best", "better",
+	"nice", "close", "strong", "whole", "certain", "full", "special",
+	"local", "late", "today", "second", "together", "however", "almost",
+	"enough", "often", "always", "sometimes", "never", "actually", "maybe",
+	"probably", "around", "still", "even", "much", "many", "another",
+	"example", "news", "music", "plan", "course", "phone", "light", "love",
+	"human", "far", "black", "white", "red", "green", "blue", "ready",
+	"clear", "simple", "recent", "short", "past", "several", "least",
+	"against", "answer", "mind", "interest", "process", "market", "price",
+	"class", "care", "effect", "experience", "information", "service",
+	"become", "keep", "let", "put", "mean", "begin", "allow", "add",
+	"remain", "share", "expect", "suggest", "report", "require", "sense",
+	"order", "action", "age", "piece", "land", "road", "list", "movie",
+	"film", "video", "internet", "computer", "test", "half", "top",
+	"matter", "form", "paper", "space", "ground", "letter", "voice",
+	"position", "chance", "event", "field", "energy", "future", "type",
+	"rest", "step", "practice", "language", "english",
+}
+
+// commonLemmaRank maps a lemma to its 1-based position in commonLemmas
+var commonLemmaRank = buildCommonLemmaRank()
+
+func buildCommonLemmaRank() map[string]int {
+	ranks := make(map[string]int, len(commonLemmas))
+	for i, lemma := range commonLemmas {
+		ranks[lemma] = i + 1
+	}
+	return ranks
+}
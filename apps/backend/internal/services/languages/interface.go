@@ -0,0 +1,21 @@
+package languages
+
+import "app-backend/internal/types"
+
+// ServiceInterface defines the contract for language metadata operations
+type ServiceInterface interface {
+	// Canonicalize parses code as BCP-47 and returns its canonical form
+	// (e.g. "PT-br" becomes "pt-BR") without requiring the language to be
+	// in the supported set
+	Canonicalize(code string) (string, error)
+
+	// Normalize resolves code to the closest supported language and returns
+	// its canonical code; unsupported or malformed codes yield a 400 AppError
+	Normalize(code string) (string, error)
+
+	// Info returns display metadata for the supported language code resolves to
+	Info(code string) (*types.Language, error)
+
+	// Supported returns all supported languages with display metadata
+	Supported() []types.Language
+}
@@ -0,0 +1,115 @@
+package languages
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/types"
+)
+
+// supportedCodes lists the languages translation can target, including
+// regional variants that translate differently from their base language.
+// Display names and scripts come from the CLDR tables bundled with x/text.
+var supportedCodes = []string{
+	"en", "es", "fr", "de", "it",
+	"pt", "pt-BR", "ru", "uk", "pl",
+	"ja", "ko", "zh", "zh-TW",
+	"ar", "he", "fa", "hi", "th", "vi", "id",
+	"nl", "sv", "no", "da", "fi", "tr",
+}
+
+// rtlScripts are the scripts written right-to-left among the supported set
+var rtlScripts = map[string]bool{
+	"Arab": true,
+	"Hebr": true,
+	"Thaa": true,
+	"Syrc": true,
+	"Nkoo": true,
+}
+
+// Service resolves language codes against the supported set and carries
+// CLDR-derived display metadata for each entry
+type Service struct {
+	tags    []language.Tag
+	matcher language.Matcher
+	infos   []types.Language
+	byCode  map[string]*types.Language
+}
+
+// NewService builds the language catalog; the data is static so the service
+// has no dependencies
+func NewService() ServiceInterface {
+	service := &Service{
+		tags:   make([]language.Tag, 0, len(supportedCodes)),
+		infos:  make([]types.Language, 0, len(supportedCodes)),
+		byCode: make(map[string]*types.Language, len(supportedCodes)),
+	}
+
+	englishNames := display.English.Languages()
+	for _, code := range supportedCodes {
+		tag := language.MustParse(code)
+		script, _ := tag.Script()
+		service.tags = append(service.tags, tag)
+		service.infos = append(service.infos, types.Language{
+			Code:       tag.String(),
+			Name:       englishNames.Name(tag),
+			NativeName: display.Self.Name(tag),
+			RTL:        rtlScripts[script.String()],
+		})
+	}
+	for i := range service.infos {
+		service.byCode[service.infos[i].Code] = &service.infos[i]
+	}
+	service.matcher = language.NewMatcher(service.tags)
+
+	return service
+}
+
+// Canonicalize parses code as BCP-47 and returns its canonical form
+func (s *Service) Canonicalize(code string) (string, error) {
+	tag, err := language.Parse(strings.TrimSpace(code))
+	if err != nil {
+		return "", errors.NewAppError(fmt.Sprintf("Unrecognized language code %q", code), err, http.StatusBadRequest)
+	}
+	return tag.String(), nil
+}
+
+// Normalize resolves code to the closest supported language
+func (s *Service) Normalize(code string) (string, error) {
+	tag, err := language.Parse(strings.TrimSpace(code))
+	if err != nil {
+		return "", errors.NewAppError(fmt.Sprintf("Unrecognized language code %q", code), err, http.StatusBadRequest)
+	}
+
+	// The matcher falls back to its first tag for unrelated languages, so a
+	// confidence check alone is not enough; the match only counts when the
+	// base language actually agrees
+	_, index, _ := s.matcher.Match(tag)
+	wantBase, baseConfidence := tag.Base()
+	matchedBase, _ := s.tags[index].Base()
+	if baseConfidence == language.No || matchedBase != wantBase {
+		return "", errors.NewAppError(fmt.Sprintf("Unsupported language %q", code), nil, http.StatusBadRequest)
+	}
+	return s.infos[index].Code, nil
+}
+
+// Info returns display metadata for the supported language code resolves to
+func (s *Service) Info(code string) (*types.Language, error) {
+	normalized, err := s.Normalize(code)
+	if err != nil {
+		return nil, err
+	}
+	return s.byCode[normalized], nil
+}
+
+// Supported returns all supported languages with display metadata
+func (s *Service) Supported() []types.Language {
+	supported := make([]types.Language, len(s.infos))
+	copy(supported, s.infos)
+	return supported
+}
@@ -0,0 +1,115 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// completionResultTTL bounds how long a callback result is kept for waiters
+// that register after the callback already finished
+const completionResultTTL = 2 * time.Minute
+
+// CompletionResult describes the outcome of an OAuth callback for a state
+type CompletionResult struct {
+	Success   bool      `json:"success"`
+	Message   string    `json:"message,omitempty"`
+	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+}
+
+// completionEntry is a stored callback result with an expiration
+type completionEntry struct {
+	result CompletionResult
+	expiry time.Time
+}
+
+// NotifyCompletion resolves a waiter blocked on the given state, or stores
+// the result briefly so a waiter arriving just after the callback still
+// resolves immediately
+func (s *Service) NotifyCompletion(state string, result CompletionResult) {
+	s.completionMutex.Lock()
+	defer s.completionMutex.Unlock()
+
+	if ch, exists := s.completionWaiters[state]; exists {
+		ch <- result
+		delete(s.completionWaiters, state)
+		s.logger.Debug("Resolved waiting OAuth completion", zap.String("state", state))
+		return
+	}
+
+	s.cleanupExpiredCompletions()
+	s.completionResults[state] = completionEntry{
+		result: result,
+		expiry: time.Now().Add(completionResultTTL),
+	}
+	s.logger.Debug("Stored OAuth completion result", zap.String("state", state))
+}
+
+// AwaitCompletion blocks until the callback for the given state finishes,
+// the timeout elapses, or the context is cancelled. The waiter channel is
+// cleaned up on timeout.
+func (s *Service) AwaitCompletion(ctx context.Context, state string, timeout time.Duration) (*CompletionResult, error) {
+	s.completionMutex.Lock()
+
+	// The callback may have already completed
+	if entry, exists := s.completionResults[state]; exists {
+		delete(s.completionResults, state)
+		s.completionMutex.Unlock()
+		if time.Now().Before(entry.expiry) {
+			return &entry.result, nil
+		}
+		return nil, fmt.Errorf("oauth completion result for state expired")
+	}
+
+	ch := make(chan CompletionResult, 1)
+	s.completionWaiters[state] = ch
+	s.completionMutex.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case result := <-ch:
+		return &result, nil
+	case <-timer.C:
+		s.removeCompletionWaiter(state, ch)
+		return nil, fmt.Errorf("timed out waiting for oauth completion")
+	case <-ctx.Done():
+		s.removeCompletionWaiter(state, ch)
+		return nil, ctx.Err()
+	}
+}
+
+// removeCompletionWaiter unregisters a waiter channel, draining a result
+// that raced with the timeout so it is not lost
+func (s *Service) removeCompletionWaiter(state string, ch chan CompletionResult) {
+	s.completionMutex.Lock()
+	defer s.completionMutex.Unlock()
+
+	if current, exists := s.completionWaiters[state]; exists && current == ch {
+		delete(s.completionWaiters, state)
+	}
+
+	// A callback may have sent on the channel between the timeout firing and
+	// the waiter being removed; keep that result for a retry
+	select {
+	case result := <-ch:
+		s.completionResults[state] = completionEntry{
+			result: result,
+			expiry: time.Now().Add(completionResultTTL),
+		}
+	default:
+	}
+}
+
+// cleanupExpiredCompletions removes expired stored results (called with lock held)
+func (s *Service) cleanupExpiredCompletions() {
+	now := time.Now()
+	for state, entry := range s.completionResults {
+		if now.After(entry.expiry) {
+			delete(s.completionResults, state)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package oauth
 
 import (
 	"context"
+	"time"
+
 	"golang.org/x/oauth2"
 )
 
@@ -9,31 +11,45 @@ import (
 type ServiceInterface interface {
 	// GenerateAuthURL creates an authorization URL for the user to visit
 	GenerateAuthURL(state string) string
-	
-	// ExchangeCodeForTokens exchanges authorization code for access and refresh tokens
-	ExchangeCodeForTokens(ctx context.Context, code string) (*oauth2.Token, error)
-	
-	// GetValidToken returns a valid access token, refreshing if necessary
+
+	// ExchangeCodeForTokens exchanges an authorization code for tokens and
+	// saves them under the user who initiated the flow
+	ExchangeCodeForTokens(ctx context.Context, code string, userID uint) (*oauth2.Token, error)
+
+	// GetValidToken returns a valid access token for the requesting user
+	// (identified via the context), refreshing if necessary
 	GetValidToken(ctx context.Context) (*oauth2.Token, error)
-	
+
 	// RefreshToken refreshes an expired access token using refresh token
 	RefreshToken(ctx context.Context, token *oauth2.Token) (*oauth2.Token, error)
-	
-	// SaveToken saves token to persistent storage
-	SaveToken(token *oauth2.Token) error
-	
-	// LoadToken loads token from persistent storage
-	LoadToken() (*oauth2.Token, error)
-	
-	// IsAuthenticated checks if user is currently authenticated
-	IsAuthenticated() bool
-	
-	// RevokeToken revokes the current token
+
+	// SaveToken saves the user's token to persistent storage
+	SaveToken(userID uint, token *oauth2.Token) error
+
+	// LoadToken loads the user's token from persistent storage
+	LoadToken(userID uint) (*oauth2.Token, error)
+
+	// IsAuthenticated checks if the requesting user has a linked account
+	IsAuthenticated(ctx context.Context) bool
+
+	// RevokeToken revokes the requesting user's token
 	RevokeToken(ctx context.Context) error
-	
-	// StoreState stores an OAuth state parameter for CSRF protection
-	StoreState(state string)
-	
-	// ValidateAndClearState validates and removes an OAuth state parameter
-	ValidateAndClearState(state string) bool
-}
\ No newline at end of file
+
+	// SetTokenStore replaces the default file-based token storage
+	SetTokenStore(store TokenStore)
+
+	// StoreState stores an OAuth state parameter for CSRF protection,
+	// remembering which user initiated the flow
+	StoreState(state string, userID uint)
+
+	// ValidateAndClearState validates and removes an OAuth state parameter,
+	// returning the user who initiated the flow
+	ValidateAndClearState(state string) (uint, bool)
+
+	// NotifyCompletion resolves a long-poll waiter for the given OAuth state
+	NotifyCompletion(state string, result CompletionResult)
+
+	// AwaitCompletion blocks until the callback for the given state finishes
+	// or the timeout elapses
+	AwaitCompletion(ctx context.Context, state string, timeout time.Duration) (*CompletionResult, error)
+}
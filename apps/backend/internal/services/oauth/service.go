@@ -4,29 +4,35 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
 	"net/http"
-	"os"
-	"path/filepath"
 	"sync"
 	"time"
 
 	"app-backend/internal/config"
+	"app-backend/internal/ctxutil"
 	"app-backend/internal/logger"
-	
+	"app-backend/pkg/patterns"
+
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 )
 
+// stateTTL is how long an OAuth state parameter stays valid
+const stateTTL = 10 * time.Minute
+
 // Service implements OAuth operations for YouTube API
 type Service struct {
-	config      *oauth2.Config
-	tokenPath   string
-	logger      *logger.Logger
-	stateStore  map[string]time.Time // In-memory state storage with expiration
-	stateMutex  sync.RWMutex         // Mutex for thread-safe state operations
+	config     *oauth2.Config
+	tokens     TokenStore
+	logger     *logger.Logger
+	stateStore *patterns.TTLMap[string, uint] // Single-use state -> user ID storage with janitor-driven expiry
+
+	// Completion notification for long-polling clients (keyed by state)
+	completionWaiters map[string]chan CompletionResult
+	completionResults map[string]completionEntry
+	completionMutex   sync.Mutex
 }
 
 // NewYouTubeOAuthService creates a new OAuth service for YouTube API
@@ -40,10 +46,20 @@ func NewYouTubeOAuthService(cfg *config.Config, logger *logger.Logger) ServiceIn
 	}
 
 	return &Service{
-		config:     oauth2Config,
-		tokenPath:  cfg.ExternalAPIs.YouTube.OAuth.TokenStorage,
-		logger:     logger,
-		stateStore: make(map[string]time.Time),
+		config:            oauth2Config,
+		tokens:            NewFileTokenStore(cfg.ExternalAPIs.YouTube.OAuth.TokenStorage),
+		logger:            logger,
+		stateStore:        patterns.NewTTLMap[string, uint](time.Minute),
+		completionWaiters: make(map[string]chan CompletionResult),
+		completionResults: make(map[string]completionEntry),
+	}
+}
+
+// SetTokenStore replaces the default file-based token storage, e.g. with the
+// per-user database store
+func (s *Service) SetTokenStore(store TokenStore) {
+	if store != nil {
+		s.tokens = store
 	}
 }
 
@@ -52,12 +68,13 @@ func (s *Service) GenerateAuthURL(state string) string {
 	if state == "" {
 		state = s.generateRandomState()
 	}
-	
+
 	return s.config.AuthCodeURL(state, oauth2.AccessTypeOffline, oauth2.SetAuthURLParam("prompt", "consent"))
 }
 
-// ExchangeCodeForTokens exchanges authorization code for access and refresh tokens
-func (s *Service) ExchangeCodeForTokens(ctx context.Context, code string) (*oauth2.Token, error) {
+// ExchangeCodeForTokens exchanges an authorization code for tokens and saves
+// them under the user who initiated the flow
+func (s *Service) ExchangeCodeForTokens(ctx context.Context, code string, userID uint) (*oauth2.Token, error) {
 	token, err := s.config.Exchange(ctx, code)
 	if err != nil {
 		s.logger.Error("Failed to exchange code for token", zap.Error(err))
@@ -65,18 +82,19 @@ func (s *Service) ExchangeCodeForTokens(ctx context.Context, code string) (*oaut
 	}
 
 	// Save the token for future use
-	if err := s.SaveToken(token); err != nil {
-		s.logger.Warn("Failed to save token", zap.Error(err))
+	if err := s.SaveToken(userID, token); err != nil {
+		s.logger.Warn("Failed to save token", zap.Error(err), zap.Uint("user_id", userID))
 		// Don't return error here as the token exchange was successful
 	}
 
-	s.logger.Info("Successfully exchanged code for token")
+	s.logger.Info("Successfully exchanged code for token", zap.Uint("user_id", userID))
 	return token, nil
 }
 
-// GetValidToken returns a valid access token, refreshing if necessary
+// GetValidToken returns a valid access token for the requesting user
+// (identified via the context), refreshing if necessary
 func (s *Service) GetValidToken(ctx context.Context) (*oauth2.Token, error) {
-	token, err := s.LoadToken()
+	token, err := s.LoadToken(ctxutil.UserID(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("no saved token found: %w", err)
 	}
@@ -103,8 +121,8 @@ func (s *Service) RefreshToken(ctx context.Context, token *oauth2.Token) (*oauth
 		return nil, fmt.Errorf("failed to refresh token: %w", err)
 	}
 
-	// Save the refreshed token
-	if err := s.SaveToken(newToken); err != nil {
+	// Save the refreshed token under the requesting user
+	if err := s.SaveToken(ctxutil.UserID(ctx), newToken); err != nil {
 		s.logger.Warn("Failed to save refreshed token", zap.Error(err))
 	}
 
@@ -112,46 +130,24 @@ func (s *Service) RefreshToken(ctx context.Context, token *oauth2.Token) (*oauth
 	return newToken, nil
 }
 
-// SaveToken saves token to persistent storage
-func (s *Service) SaveToken(token *oauth2.Token) error {
-	// Create directory if it doesn't exist
-	if err := os.MkdirAll(filepath.Dir(s.tokenPath), 0700); err != nil {
-		return fmt.Errorf("failed to create token directory: %w", err)
+// SaveToken saves the user's token to persistent storage
+func (s *Service) SaveToken(userID uint, token *oauth2.Token) error {
+	if err := s.tokens.Save(userID, token); err != nil {
+		return err
 	}
 
-	// Marshal token to JSON
-	data, err := json.Marshal(token)
-	if err != nil {
-		return fmt.Errorf("failed to marshal token: %w", err)
-	}
-
-	// Write token to file with restricted permissions
-	if err := os.WriteFile(s.tokenPath, data, 0600); err != nil {
-		return fmt.Errorf("failed to write token file: %w", err)
-	}
-
-	s.logger.Debug("Token saved successfully", zap.String("path", s.tokenPath))
+	s.logger.Debug("Token saved successfully", zap.Uint("user_id", userID))
 	return nil
 }
 
-// LoadToken loads token from persistent storage
-func (s *Service) LoadToken() (*oauth2.Token, error) {
-	data, err := os.ReadFile(s.tokenPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read token file: %w", err)
-	}
-
-	var token oauth2.Token
-	if err := json.Unmarshal(data, &token); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal token: %w", err)
-	}
-
-	return &token, nil
+// LoadToken loads the user's token from persistent storage
+func (s *Service) LoadToken(userID uint) (*oauth2.Token, error) {
+	return s.tokens.Load(userID)
 }
 
-// IsAuthenticated checks if user is currently authenticated
-func (s *Service) IsAuthenticated() bool {
-	token, err := s.LoadToken()
+// IsAuthenticated checks if the requesting user has a linked account
+func (s *Service) IsAuthenticated(ctx context.Context) bool {
+	token, err := s.LoadToken(ctxutil.UserID(ctx))
 	if err != nil {
 		return false
 	}
@@ -160,16 +156,17 @@ func (s *Service) IsAuthenticated() bool {
 	return token != nil && token.Valid() && token.Expiry.After(time.Now().Add(5*time.Minute))
 }
 
-// RevokeToken revokes the current token
+// RevokeToken revokes the requesting user's token
 func (s *Service) RevokeToken(ctx context.Context) error {
-	token, err := s.LoadToken()
+	userID := ctxutil.UserID(ctx)
+	token, err := s.LoadToken(userID)
 	if err != nil {
 		return fmt.Errorf("no token to revoke: %w", err)
 	}
 
 	// Google OAuth2 revoke endpoint
 	revokeURL := fmt.Sprintf("https://oauth2.googleapis.com/revoke?token=%s", token.AccessToken)
-	
+
 	// Make HTTP request to revoke token
 	client := &http.Client{Timeout: 10 * time.Second}
 	resp, err := client.Post(revokeURL, "application/x-www-form-urlencoded", nil)
@@ -182,12 +179,12 @@ func (s *Service) RevokeToken(ctx context.Context) error {
 		return fmt.Errorf("failed to revoke token, status: %d", resp.StatusCode)
 	}
 
-	// Remove token file
-	if err := os.Remove(s.tokenPath); err != nil && !os.IsNotExist(err) {
-		s.logger.Warn("Failed to remove token file", zap.Error(err))
+	// Remove the stored token
+	if err := s.tokens.Delete(userID); err != nil {
+		s.logger.Warn("Failed to remove stored token", zap.Error(err), zap.Uint("user_id", userID))
 	}
 
-	s.logger.Info("Successfully revoked token")
+	s.logger.Info("Successfully revoked token", zap.Uint("user_id", userID))
 	return nil
 }
 
@@ -198,51 +195,25 @@ func (s *Service) generateRandomState() string {
 	return base64.URLEncoding.EncodeToString(b)
 }
 
-// StoreState stores an OAuth state parameter with expiration (10 minutes)
-func (s *Service) StoreState(state string) {
-	s.stateMutex.Lock()
-	defer s.stateMutex.Unlock()
-	
-	// Clean up expired states while we have the lock
-	s.cleanupExpiredStates()
-	
-	// Store new state with expiration time
-	s.stateStore[state] = time.Now().Add(10 * time.Minute)
-	
-	s.logger.Debug("Stored OAuth state", zap.String("state", state))
+// StoreState stores an OAuth state parameter along with the user who
+// initiated the flow; expired states are swept by the TTL map's janitor
+// rather than on every store
+func (s *Service) StoreState(state string, userID uint) {
+	s.stateStore.Set(state, userID, stateTTL)
+
+	s.logger.Debug("Stored OAuth state", zap.String("state", state), zap.Uint("user_id", userID))
 }
 
-// ValidateAndClearState validates a state parameter and removes it from storage
-func (s *Service) ValidateAndClearState(state string) bool {
-	s.stateMutex.Lock()
-	defer s.stateMutex.Unlock()
-	
-	expiry, exists := s.stateStore[state]
-	if !exists {
-		s.logger.Warn("OAuth state not found", zap.String("state", state))
-		return false
+// ValidateAndClearState validates a state parameter and removes it from
+// storage so each state can only be used once, returning the user who
+// initiated the flow
+func (s *Service) ValidateAndClearState(state string) (uint, bool) {
+	userID, ok := s.stateStore.GetAndDelete(state)
+	if !ok {
+		s.logger.Warn("OAuth state not found or expired", zap.String("state", state))
+		return 0, false
 	}
-	
-	// Remove the state (use once)
-	delete(s.stateStore, state)
-	
-	// Check if expired
-	if time.Now().After(expiry) {
-		s.logger.Warn("OAuth state expired", zap.String("state", state))
-		return false
-	}
-	
+
 	s.logger.Debug("OAuth state validated successfully", zap.String("state", state))
-	return true
+	return userID, true
 }
-
-// cleanupExpiredStates removes expired states from storage (called with lock held)
-func (s *Service) cleanupExpiredStates() {
-	now := time.Now()
-	for state, expiry := range s.stateStore {
-		if now.After(expiry) {
-			delete(s.stateStore, state)
-			s.logger.Debug("Cleaned up expired OAuth state", zap.String("state", state))
-		}
-	}
-}
\ No newline at end of file
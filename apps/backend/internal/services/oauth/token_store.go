@@ -0,0 +1,188 @@
+package oauth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/oauth2"
+
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+)
+
+// TokenStore persists OAuth tokens keyed by user ID so each authenticated
+// user links their own external account
+type TokenStore interface {
+	Save(userID uint, token *oauth2.Token) error
+	Load(userID uint) (*oauth2.Token, error)
+	Delete(userID uint) error
+}
+
+// FileTokenStore keeps a single token in a file on disk. It ignores the user
+// ID, so every caller shares one identity; it exists as the fallback for
+// deployments without a database or encryption key.
+type FileTokenStore struct {
+	path string
+}
+
+// NewFileTokenStore creates a store writing the token to the given path
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{path: path}
+}
+
+// Save writes the token to disk with restricted permissions
+func (s *FileTokenStore) Save(_ uint, token *oauth2.Token) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return fmt.Errorf("failed to create token directory: %w", err)
+	}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to marshal token: %w", err)
+	}
+
+	if err := os.WriteFile(s.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	return nil
+}
+
+// Load reads the shared token from disk
+func (s *FileTokenStore) Load(_ uint) (*oauth2.Token, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	var token oauth2.Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token: %w", err)
+	}
+	return &token, nil
+}
+
+// Delete removes the shared token file
+func (s *FileTokenStore) Delete(_ uint) error {
+	if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove token file: %w", err)
+	}
+	return nil
+}
+
+// DatabaseTokenStore persists one token per user in the database, encrypted
+// at rest with AES-256-GCM
+type DatabaseTokenStore struct {
+	repo     repositories.OAuthTokenRepositoryInterface
+	provider string
+	aead     cipher.AEAD
+}
+
+// NewDatabaseTokenStore creates a per-user token store for the given
+// provider. The encryption key may be any non-empty string; it is stretched
+// to an AES-256 key.
+func NewDatabaseTokenStore(repo repositories.OAuthTokenRepositoryInterface, provider, encryptionKey string) (*DatabaseTokenStore, error) {
+	if encryptionKey == "" {
+		return nil, fmt.Errorf("encryption key is required for database token storage")
+	}
+
+	keyBytes := sha256.Sum256([]byte(encryptionKey))
+	block, err := aes.NewCipher(keyBytes[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	return &DatabaseTokenStore{
+		repo:     repo,
+		provider: provider,
+		aead:     aead,
+	}, nil
+}
+
+// Save encrypts the token and upserts it under the user's ID
+func (s *DatabaseTokenStore) Save(userID uint, token *oauth2.Token) error {
+	if userID == 0 {
+		return fmt.Errorf("cannot save token without an authenticated user")
+	}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to marshal token: %w", err)
+	}
+
+	ciphertext, err := s.encrypt(data)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt token: %w", err)
+	}
+
+	return s.repo.Upsert(&models.OAuthToken{
+		UserID:     userID,
+		Provider:   s.provider,
+		Ciphertext: ciphertext,
+	})
+}
+
+// Load decrypts and returns the user's stored token
+func (s *DatabaseTokenStore) Load(userID uint) (*oauth2.Token, error) {
+	if userID == 0 {
+		return nil, fmt.Errorf("cannot load token without an authenticated user")
+	}
+
+	record, err := s.repo.GetByUserAndProvider(userID, s.provider)
+	if err != nil {
+		return nil, fmt.Errorf("no stored token for user: %w", err)
+	}
+
+	data, err := s.decrypt(record.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt token: %w", err)
+	}
+
+	var token oauth2.Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token: %w", err)
+	}
+	return &token, nil
+}
+
+// Delete removes the user's stored token
+func (s *DatabaseTokenStore) Delete(userID uint) error {
+	if userID == 0 {
+		return fmt.Errorf("cannot delete token without an authenticated user")
+	}
+	return s.repo.DeleteByUserAndProvider(userID, s.provider)
+}
+
+// encrypt seals the plaintext with a random nonce and returns base64 of
+// nonce||ciphertext
+func (s *DatabaseTokenStore) encrypt(plaintext []byte) (string, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := s.aead.Seal(nonce, nonce, plaintext, nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decrypt reverses encrypt
+func (s *DatabaseTokenStore) decrypt(encoded string) ([]byte, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < s.aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:s.aead.NonceSize()], sealed[s.aead.NonceSize():]
+	return s.aead.Open(nil, nonce, ciphertext, nil)
+}
@@ -0,0 +1,57 @@
+package privacy
+
+import (
+	"time"
+
+	"app-backend/internal/models"
+)
+
+// Job types the privacy service runs through the background job queue
+const (
+	// JobTypeUserExport builds a user's downloadable data archive
+	JobTypeUserExport = "user_export"
+	// JobTypeAccountPurge hard-deletes accounts past their retention window
+	JobTypeAccountPurge = "account_purge"
+)
+
+// ExportResult is the snapshot a data export job stores as its result JSON
+// once the archive has been written
+type ExportResult struct {
+	FileName     string `json:"fileName"`
+	SizeBytes    int64  `json:"sizeBytes"`
+	Sessions     int    `json:"sessions"`
+	SavedVideos  int    `json:"savedVideos"`
+	VocabItems   int    `json:"vocabItems"`
+	UsageRecords int    `json:"usageRecords"`
+}
+
+// PurgeResult is the snapshot a purge job stores as its result JSON
+type PurgeResult struct {
+	// Cutoff is the soft-deletion timestamp accounts had to precede to be
+	// purged in this run
+	Cutoff time.Time `json:"cutoff"`
+	// PurgedAccounts is how many accounts were hard-deleted
+	PurgedAccounts int `json:"purgedAccounts"`
+}
+
+// ExportFile locates a finished export archive on disk so handlers can
+// stream it as a download
+type ExportFile struct {
+	Path string
+	Name string
+}
+
+// ServiceInterface defines the contract for GDPR data export and purge
+type ServiceInterface interface {
+	// StartExport enqueues a background job that gathers the user's data
+	// into a downloadable ZIP archive, returning the queued job
+	StartExport(userID uint) (*models.Job, error)
+
+	// OpenExport locates the archive of one of the user's finished export
+	// jobs; it fails while the export is still running and once the archive
+	// has been removed from disk
+	OpenExport(userID uint, jobID uint) (*ExportFile, error)
+
+	// Stop halts the purge scheduler
+	Stop()
+}
@@ -0,0 +1,124 @@
+package privacy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/models"
+)
+
+// purgeLoop schedules purge runs through the job queue so they get the
+// queue's retries and result history like any other background work
+func (s *Service) purgeLoop() {
+	// Run once at startup so restarts do not postpone overdue purges by a
+	// full interval
+	s.enqueuePurge()
+
+	ticker := time.NewTicker(s.config.PurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.enqueuePurge()
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// enqueuePurge queues one purge run
+func (s *Service) enqueuePurge() {
+	if _, err := s.queue.Enqueue(purgeSystemUserID, JobTypeAccountPurge, "{}"); err != nil {
+		s.logger.Error("Failed to enqueue account purge", zap.Error(err))
+	}
+}
+
+// runPurge is the account purge job handler: it hard-deletes every account
+// whose soft deletion is older than the retention window, along with all of
+// the account's data and export archives
+func (s *Service) runPurge(ctx context.Context, job *models.Job) (string, error) {
+	cutoff := s.now().UTC().Add(-s.config.PurgeRetention)
+
+	users, err := s.repos.Users.ListDeletedBefore(cutoff)
+	if err != nil {
+		return "", fmt.Errorf("failed to list soft-deleted users: %w", err)
+	}
+
+	result := PurgeResult{Cutoff: cutoff}
+	failed := 0
+	for _, user := range users {
+		if err := s.purgeUser(user.ID); err != nil {
+			failed++
+			s.logger.Error("Failed to purge account",
+				zap.Uint("user_id", user.ID),
+				zap.Error(err))
+			continue
+		}
+		result.PurgedAccounts++
+		s.logger.Info("Purged soft-deleted account", zap.Uint("user_id", user.ID))
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode purge result: %w", err)
+	}
+
+	// Keep the partial tally visible and fail the attempt so the queue
+	// retries the remaining accounts; purging is idempotent
+	if failed > 0 {
+		if updateErr := s.queue.UpdateResult(job.ID, string(resultJSON)); updateErr != nil {
+			s.logger.Warn("Failed to report purge progress", zap.Error(updateErr))
+		}
+		return "", fmt.Errorf("failed to purge %d of %d accounts", failed, len(users))
+	}
+
+	return string(resultJSON), nil
+}
+
+// purgeUser hard-deletes one account and everything attached to it
+func (s *Service) purgeUser(userID uint) error {
+	stores := []struct {
+		name string
+		repo userScoped
+	}{
+		{"sessions", s.repos.Sessions},
+		{"saved videos", s.repos.SavedVideos},
+		{"vocabulary", s.repos.Vocab},
+		{"usage records", s.repos.Usage},
+		{"oauth tokens", s.repos.OAuthTokens},
+		{"api keys", s.repos.APIKeys},
+		{"jobs", s.repos.Jobs},
+	}
+	for _, store := range stores {
+		if _, err := store.repo.HardDeleteAllBy("user_id", userID); err != nil {
+			return fmt.Errorf("failed to delete %s: %w", store.name, err)
+		}
+	}
+
+	s.removeExportArchives(userID)
+
+	return s.repos.Users.HardDelete(userID)
+}
+
+// removeExportArchives deletes any export archives left on disk for a user;
+// leftovers are only logged since the account data itself is already gone
+func (s *Service) removeExportArchives(userID uint) {
+	pattern := filepath.Join(s.config.ExportDir, fmt.Sprintf("user-%d-export-*.zip", userID))
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return
+	}
+	for _, path := range matches {
+		if err := os.Remove(path); err != nil {
+			s.logger.Warn("Failed to remove export archive",
+				zap.String("path", path),
+				zap.Error(err))
+		}
+	}
+}
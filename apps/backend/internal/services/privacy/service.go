@@ -0,0 +1,316 @@
+package privacy
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/jobs"
+)
+
+const (
+	// defaultExportDir is where export archives are written
+	defaultExportDir = "./storage/exports"
+	// defaultPurgeRetention is how long soft-deleted accounts are kept
+	// before the purge worker hard-deletes them
+	defaultPurgeRetention = 30 * 24 * time.Hour
+	// defaultPurgeInterval is how often a purge run is scheduled
+	defaultPurgeInterval = 24 * time.Hour
+	// purgeSystemUserID owns the scheduled purge jobs; it is no real user,
+	// so the jobs never show up in anyone's job polls
+	purgeSystemUserID = 0
+)
+
+// Config holds export and purge tuning knobs; zero values use defaults
+type Config struct {
+	// ExportDir is the directory export archives are written to
+	ExportDir string
+	// PurgeEnabled turns the scheduled account purge on
+	PurgeEnabled bool
+	// PurgeRetention is how long soft-deleted accounts are kept
+	PurgeRetention time.Duration
+	// PurgeInterval is how often a purge run is scheduled
+	PurgeInterval time.Duration
+}
+
+// Repositories bundles the per-user data stores the privacy service exports
+// from and purges
+type Repositories struct {
+	Users       repositories.UserRepositoryInterface
+	Sessions    repositories.SessionRepositoryInterface
+	SavedVideos repositories.UserVideoRepositoryInterface
+	Vocab       repositories.VocabRepositoryInterface
+	Usage       repositories.TranslationUsageRepositoryInterface
+	OAuthTokens repositories.OAuthTokenRepositoryInterface
+	APIKeys     repositories.APIKeyRepositoryInterface
+	Jobs        repositories.JobRepositoryInterface
+}
+
+// userScoped is the slice of the repository contract the purge needs; every
+// repository satisfies it through the base repository
+type userScoped interface {
+	HardDeleteAllBy(field string, value interface{}) (int64, error)
+}
+
+// Service runs GDPR data exports and the scheduled account purge through the
+// background job queue
+type Service struct {
+	repos  Repositories
+	queue  jobs.ServiceInterface
+	config Config
+	logger *logger.Logger
+
+	stop     chan struct{}
+	stopOnce func()
+	// now is injectable for deterministic retention tests
+	now func() time.Time
+}
+
+// NewService creates the privacy service, registers its job handlers, and
+// starts the purge scheduler when purging is enabled
+func NewService(repos Repositories, queue jobs.ServiceInterface, cfg Config, logger *logger.Logger) ServiceInterface {
+	if cfg.ExportDir == "" {
+		cfg.ExportDir = defaultExportDir
+	}
+	if cfg.PurgeRetention <= 0 {
+		cfg.PurgeRetention = defaultPurgeRetention
+	}
+	if cfg.PurgeInterval <= 0 {
+		cfg.PurgeInterval = defaultPurgeInterval
+	}
+
+	s := &Service{
+		repos:  repos,
+		queue:  queue,
+		config: cfg,
+		logger: logger,
+		stop:   make(chan struct{}),
+		now:    time.Now,
+	}
+	s.stopOnce = func() { close(s.stop) }
+
+	if queue != nil {
+		queue.RegisterHandler(JobTypeUserExport, s.runExport)
+		queue.RegisterHandler(JobTypeAccountPurge, s.runPurge)
+
+		if cfg.PurgeEnabled {
+			go s.purgeLoop()
+		}
+	}
+
+	return s
+}
+
+// Stop halts the purge scheduler; a purge already claimed by the job queue
+// finishes normally
+func (s *Service) Stop() {
+	stopOnce := s.stopOnce
+	s.stopOnce = func() {}
+	stopOnce()
+}
+
+// StartExport enqueues a background job that gathers the user's data into a
+// downloadable ZIP archive
+func (s *Service) StartExport(userID uint) (*models.Job, error) {
+	if s.queue == nil {
+		return nil, errors.NewAppError("Job queue not available", nil, http.StatusServiceUnavailable)
+	}
+
+	job, err := s.queue.Enqueue(userID, JobTypeUserExport, "{}")
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Queued data export",
+		zap.Uint("job_id", job.ID),
+		zap.Uint("user_id", userID))
+	return job, nil
+}
+
+// OpenExport locates the archive of one of the user's finished export jobs
+func (s *Service) OpenExport(userID uint, jobID uint) (*ExportFile, error) {
+	if s.queue == nil {
+		return nil, errors.NewAppError("Job queue not available", nil, http.StatusServiceUnavailable)
+	}
+
+	job, err := s.queue.GetJob(userID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.Type != JobTypeUserExport {
+		return nil, errors.NewAppError("Export not found", nil, http.StatusNotFound)
+	}
+	if job.Status == models.JobStatusFailed {
+		return nil, errors.NewAppError("Export failed; request a new one", nil, http.StatusGone)
+	}
+	if job.Status != models.JobStatusCompleted {
+		return nil, errors.NewAppError("Export is not ready yet", nil, http.StatusConflict)
+	}
+
+	var result ExportResult
+	if err := json.Unmarshal([]byte(job.Result), &result); err != nil || result.FileName == "" {
+		return nil, errors.NewAppError("Export result is corrupt", err, http.StatusInternalServerError)
+	}
+
+	path := filepath.Join(s.config.ExportDir, result.FileName)
+	if _, err := os.Stat(path); err != nil {
+		return nil, errors.NewAppError("Export archive no longer exists; request a new one", err, http.StatusGone)
+	}
+
+	return &ExportFile{Path: path, Name: result.FileName}, nil
+}
+
+// exportManifest is the archive's top-level summary file
+type exportManifest struct {
+	GeneratedAt time.Time `json:"generatedAt"`
+	UserID      uint      `json:"userId"`
+	Email       string    `json:"email"`
+	Files       []string  `json:"files"`
+}
+
+// runExport is the data export job handler: it gathers the user's data and
+// writes the ZIP archive the download endpoint serves
+func (s *Service) runExport(ctx context.Context, job *models.Job) (string, error) {
+	user, err := s.repos.Users.GetByID(job.UserID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load user %d: %w", job.UserID, err)
+	}
+
+	sessions, err := s.repos.Sessions.FindAllBy("user_id", job.UserID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load sessions: %w", err)
+	}
+	savedVideos, err := s.repos.SavedVideos.FindAllBy("user_id", job.UserID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load saved videos: %w", err)
+	}
+	vocabItems, err := s.repos.Vocab.FindAllBy("user_id", job.UserID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load vocabulary: %w", err)
+	}
+	usageRecords, err := s.repos.Usage.FindAllBy("user_id", job.UserID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load usage records: %w", err)
+	}
+
+	// One JSON file per data category, plus a manifest describing the run
+	files := []exportEntry{
+		{"profile.json", user},
+		{"sessions.json", sessions},
+		{"saved_videos.json", savedVideos},
+		{"vocabulary.json", vocabItems},
+		{"usage.json", usageRecords},
+	}
+
+	manifest := exportManifest{
+		GeneratedAt: s.now().UTC(),
+		UserID:      job.UserID,
+		Email:       user.Email,
+	}
+	for _, file := range files {
+		manifest.Files = append(manifest.Files, file.name)
+	}
+
+	fileName := archiveName(job.UserID, job.ID)
+	sizeBytes, err := s.writeArchive(fileName, manifest, files)
+	if err != nil {
+		return "", err
+	}
+
+	result := ExportResult{
+		FileName:     fileName,
+		SizeBytes:    sizeBytes,
+		Sessions:     len(sessions),
+		SavedVideos:  len(savedVideos),
+		VocabItems:   len(vocabItems),
+		UsageRecords: len(usageRecords),
+	}
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode export result: %w", err)
+	}
+
+	s.logger.Info("Data export finished",
+		zap.Uint("job_id", job.ID),
+		zap.Uint("user_id", job.UserID),
+		zap.String("file", fileName),
+		zap.Int64("size_bytes", sizeBytes))
+
+	return string(resultJSON), nil
+}
+
+// exportEntry is one JSON file within the export archive
+type exportEntry struct {
+	name    string
+	content interface{}
+}
+
+// writeArchive writes the export ZIP and returns its size
+func (s *Service) writeArchive(fileName string, manifest exportManifest, files []exportEntry) (int64, error) {
+	if err := os.MkdirAll(s.config.ExportDir, 0o755); err != nil {
+		return 0, fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	path := filepath.Join(s.config.ExportDir, fileName)
+	archive, err := os.Create(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create export archive: %w", err)
+	}
+
+	writer := zip.NewWriter(archive)
+	writeErr := addJSONEntry(writer, "manifest.json", manifest)
+	for _, file := range files {
+		if writeErr != nil {
+			break
+		}
+		writeErr = addJSONEntry(writer, file.name, file.content)
+	}
+	if closeErr := writer.Close(); writeErr == nil {
+		writeErr = closeErr
+	}
+	if closeErr := archive.Close(); writeErr == nil {
+		writeErr = closeErr
+	}
+
+	if writeErr != nil {
+		os.Remove(path)
+		return 0, fmt.Errorf("failed to write export archive: %w", writeErr)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to stat export archive: %w", err)
+	}
+	return info.Size(), nil
+}
+
+// addJSONEntry writes one indented JSON file into the archive
+func addJSONEntry(writer *zip.Writer, name string, content interface{}) error {
+	entry, err := writer.Create(name)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = entry.Write(data)
+	return err
+}
+
+// archiveName builds the export file name; the job ID keeps repeated exports
+// of the same user apart
+func archiveName(userID uint, jobID uint) string {
+	return fmt.Sprintf("user-%d-export-%d.zip", userID, jobID)
+}
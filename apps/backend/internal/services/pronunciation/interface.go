@@ -0,0 +1,32 @@
+package pronunciation
+
+import (
+	"context"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/types"
+	"app-backend/pkg/gemini"
+)
+
+// AssessFunc is the speech-assessment backend that scores a spoken audio
+// clip against its reference text
+type AssessFunc func(ctx context.Context, audio []byte, mimeType string, referenceText string, language string) (*gemini.PronunciationAssessment, error)
+
+// Clip is one uploaded practice recording
+type Clip struct {
+	// Data is the raw audio payload
+	Data []byte
+	// MIMEType is the clip's content type (audio/webm, audio/mpeg, ...)
+	MIMEType string
+}
+
+// ServiceInterface defines the contract for pronunciation practice
+type ServiceInterface interface {
+	// Assess scores a practice clip against its reference text and stores
+	// the attempt for the user's progress history
+	Assess(ctx context.Context, userID uint, clip Clip, req *dto.AssessPronunciationRequest) (*dto.AssessPronunciationResponse, error)
+
+	// ListAttempts returns the user's stored attempts with pagination,
+	// newest first by default
+	ListAttempts(userID uint, pagReq *types.PaginationRequest) (*types.PaginationResponse[dto.PronunciationAttemptResponse], error)
+}
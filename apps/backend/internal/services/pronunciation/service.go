@@ -0,0 +1,185 @@
+package pronunciation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/types"
+)
+
+// DefaultMaxAudioBytes bounds uploaded clips when the config leaves the
+// limit unset; a short practice clip comfortably fits
+const DefaultMaxAudioBytes = 2 << 20
+
+// allowedMIMETypes are the audio containers browsers record practice clips
+// in; anything else is rejected before reaching the assessment backend
+var allowedMIMETypes = map[string]bool{
+	"audio/webm":  true,
+	"audio/ogg":   true,
+	"audio/mpeg":  true,
+	"audio/mp4":   true,
+	"audio/wav":   true,
+	"audio/x-wav": true,
+	"audio/flac":  true,
+}
+
+// Config holds pronunciation practice tuning knobs; zero values use defaults
+type Config struct {
+	// Provider names the speech-assessment backend recorded on attempts
+	Provider string
+	// MaxAudioBytes bounds the size of an uploaded clip
+	MaxAudioBytes int64
+}
+
+// Service scores practice clips through a speech-assessment backend and
+// keeps the attempts for progress graphs
+type Service struct {
+	assessFn      AssessFunc
+	attempts      repositories.PronunciationAttemptRepositoryInterface
+	provider      string
+	maxAudioBytes int64
+	logger        *logger.Logger
+}
+
+// NewService creates the pronunciation practice service around the given
+// speech-assessment backend
+func NewService(assessFn AssessFunc, attempts repositories.PronunciationAttemptRepositoryInterface, cfg Config, logger *logger.Logger) ServiceInterface {
+	provider := cfg.Provider
+	if provider == "" {
+		provider = "gemini"
+	}
+	maxAudioBytes := cfg.MaxAudioBytes
+	if maxAudioBytes <= 0 {
+		maxAudioBytes = DefaultMaxAudioBytes
+	}
+
+	return &Service{
+		assessFn:      assessFn,
+		attempts:      attempts,
+		provider:      provider,
+		maxAudioBytes: maxAudioBytes,
+		logger:        logger,
+	}
+}
+
+// Assess scores a practice clip against its reference text and stores the
+// attempt for the user's progress history
+func (s *Service) Assess(ctx context.Context, userID uint, clip Clip, req *dto.AssessPronunciationRequest) (*dto.AssessPronunciationResponse, error) {
+	if s.assessFn == nil {
+		return nil, errors.NewAppError("Pronunciation assessment not available", nil, http.StatusServiceUnavailable)
+	}
+
+	referenceText := strings.TrimSpace(req.Text)
+	if referenceText == "" {
+		return nil, errors.NewValidationError("Reference text is required", nil)
+	}
+	if len(clip.Data) == 0 {
+		return nil, errors.NewValidationError("Audio clip is required", nil)
+	}
+	if int64(len(clip.Data)) > s.maxAudioBytes {
+		return nil, errors.NewAppError("Audio clip is too large", nil, http.StatusRequestEntityTooLarge)
+	}
+
+	mimeType := normalizeMIMEType(clip.MIMEType)
+	if !allowedMIMETypes[mimeType] {
+		return nil, errors.NewValidationError("Unsupported audio format", nil)
+	}
+
+	assessment, err := s.assessFn(ctx, clip.Data, mimeType, referenceText, req.Language)
+	if err != nil {
+		s.logger.Error("Pronunciation assessment failed",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return nil, errors.NewAppError("Failed to assess pronunciation", err, http.StatusBadGateway)
+	}
+
+	words := make([]dto.PronunciationWordScoreResponse, len(assessment.Words))
+	for i, word := range assessment.Words {
+		words[i] = dto.PronunciationWordScoreResponse{
+			Word:     word.Word,
+			Score:    word.Score,
+			Feedback: word.Feedback,
+		}
+	}
+
+	attempt := &models.PronunciationAttempt{
+		UserID:        userID,
+		VideoID:       req.VideoID,
+		Language:      req.Language,
+		ReferenceText: referenceText,
+		OverallScore:  assessment.OverallScore,
+		Provider:      s.provider,
+	}
+	if scoresJSON, marshalErr := json.Marshal(words); marshalErr == nil {
+		attempt.WordScores = string(scoresJSON)
+	}
+
+	// A failed save loses the history point but not the assessment the
+	// user is waiting on
+	if err := s.attempts.Create(attempt); err != nil {
+		s.logger.Error("Failed to store pronunciation attempt",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+	}
+
+	return &dto.AssessPronunciationResponse{
+		AttemptID:    attempt.ID,
+		OverallScore: assessment.OverallScore,
+		Words:        words,
+		Provider:     s.provider,
+	}, nil
+}
+
+// ListAttempts returns the user's stored attempts with pagination
+func (s *Service) ListAttempts(userID uint, pagReq *types.PaginationRequest) (*types.PaginationResponse[dto.PronunciationAttemptResponse], error) {
+	attempts, err := s.attempts.ListByUser(userID, pagReq)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to list pronunciation attempts", err, http.StatusInternalServerError)
+	}
+
+	responses := make([]dto.PronunciationAttemptResponse, len(attempts.Data))
+	for i := range attempts.Data {
+		responses[i] = toAttemptResponse(&attempts.Data[i])
+	}
+
+	return &types.PaginationResponse[dto.PronunciationAttemptResponse]{
+		Data:       responses,
+		Pagination: attempts.Pagination,
+	}, nil
+}
+
+// toAttemptResponse converts a stored attempt to its DTO, decoding the
+// per-word scores JSON
+func toAttemptResponse(attempt *models.PronunciationAttempt) dto.PronunciationAttemptResponse {
+	response := dto.PronunciationAttemptResponse{
+		ID:            attempt.ID,
+		VideoID:       attempt.VideoID,
+		Language:      attempt.Language,
+		ReferenceText: attempt.ReferenceText,
+		OverallScore:  attempt.OverallScore,
+		CreatedAt:     attempt.CreatedAt,
+	}
+	if attempt.WordScores != "" {
+		// Undecodable scores from older rows degrade to the overall score
+		_ = json.Unmarshal([]byte(attempt.WordScores), &response.Words)
+	}
+	return response
+}
+
+// normalizeMIMEType strips codec parameters ("audio/webm;codecs=opus") so
+// the allow-list matches on the bare type
+func normalizeMIMEType(mimeType string) string {
+	if i := strings.IndexByte(mimeType, ';'); i >= 0 {
+		mimeType = mimeType[:i]
+	}
+	return strings.ToLower(strings.TrimSpace(mimeType))
+}
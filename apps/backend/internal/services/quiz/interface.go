@@ -0,0 +1,32 @@
+package quiz
+
+import (
+	"context"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/types"
+	"app-backend/pkg/gemini"
+)
+
+// GenerateFunc builds quiz questions from transcript excerpts. It matches
+// gemini.Service.GenerateQuiz so the model backend can be swapped in tests.
+type GenerateFunc func(ctx context.Context, segments []string, language string, numQuestions int, seed int64) ([]gemini.QuizQuestion, error)
+
+// TranscriptSource is the slice of the video service quiz generation needs
+type TranscriptSource interface {
+	// DetectProvider detects the video provider from URL or video ID
+	DetectProvider(videoURL string) (types.VideoProvider, string, error)
+
+	// GetTranscript retrieves transcript for a video in specified language
+	GetTranscript(ctx context.Context, provider types.VideoProvider, videoID string, language string) (*types.Transcript, error)
+}
+
+// ServiceInterface defines the contract for transcript quiz operations
+type ServiceInterface interface {
+	// Generate builds a quiz from a video's transcript and stores it
+	Generate(ctx context.Context, userID uint, req *dto.GenerateQuizRequest) (*dto.GenerateQuizResponse, error)
+
+	// Submit grades a set of answers against a stored quiz and records
+	// the submission
+	Submit(userID uint, quizID uint, req *dto.SubmitQuizAnswersRequest) (*dto.SubmitQuizAnswersResponse, error)
+}
@@ -0,0 +1,241 @@
+package quiz
+
+import (
+	"context"
+	"encoding/json"
+	goerrors "errors"
+	"hash/fnv"
+	"math/rand"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/types"
+	"app-backend/pkg/gemini"
+)
+
+const (
+	// defaultQuestionCount is used when the request leaves the count unset
+	defaultQuestionCount = 5
+	// minSegmentWords filters out segments too short to blank a word from
+	minSegmentWords = 4
+	// maxPromptSegments caps how much transcript material goes to the model
+	maxPromptSegments = 40
+)
+
+// Service generates transcript quizzes through a model backend and grades
+// submitted answers against the stored questions
+type Service struct {
+	videos      TranscriptSource
+	generateFn  GenerateFunc
+	quizzes     repositories.QuizRepositoryInterface
+	submissions repositories.QuizSubmissionRepositoryInterface
+	logger      *logger.Logger
+}
+
+// NewService creates the quiz service around the given generation backend
+func NewService(videos TranscriptSource, generateFn GenerateFunc, quizzes repositories.QuizRepositoryInterface, submissions repositories.QuizSubmissionRepositoryInterface, logger *logger.Logger) ServiceInterface {
+	return &Service{
+		videos:      videos,
+		generateFn:  generateFn,
+		quizzes:     quizzes,
+		submissions: submissions,
+		logger:      logger,
+	}
+}
+
+// Generate builds a quiz from a video's transcript and stores it. Segment
+// selection is seeded, and the seed is passed through to the model, so the
+// same video, language, and seed reproduce the same quiz.
+func (s *Service) Generate(ctx context.Context, userID uint, req *dto.GenerateQuizRequest) (*dto.GenerateQuizResponse, error) {
+	if s.generateFn == nil || s.videos == nil {
+		return nil, errors.NewAppError("Quiz generation not available", nil, http.StatusServiceUnavailable)
+	}
+
+	numQuestions := req.NumQuestions
+	if numQuestions <= 0 {
+		numQuestions = defaultQuestionCount
+	}
+
+	provider, videoID, err := s.videos.DetectProvider(req.VideoURL)
+	if err != nil {
+		return nil, errors.NewAppError("Invalid video URL", err, http.StatusBadRequest)
+	}
+
+	transcript, err := s.videos.GetTranscript(ctx, provider, videoID, req.Language)
+	if err != nil {
+		var appErr *errors.AppError
+		if goerrors.As(err, &appErr) {
+			return nil, err
+		}
+		return nil, errors.NewAppError("Failed to fetch transcript", err, http.StatusBadGateway)
+	}
+
+	candidates := quizCandidates(transcript.Segments)
+	if len(candidates) == 0 {
+		return nil, errors.NewAppError("Transcript has no segments long enough for quiz questions", nil, http.StatusUnprocessableEntity)
+	}
+
+	seed := req.Seed
+	if seed == 0 {
+		seed = defaultSeed(videoID, transcript.Language)
+	}
+	selected := selectSegments(candidates, seed)
+
+	questions, err := s.generateFn(ctx, selected, transcript.Language, numQuestions, seed)
+	if err != nil {
+		s.logger.Error("Quiz generation failed",
+			zap.Uint("user_id", userID),
+			zap.String("video_id", videoID),
+			zap.Error(err))
+		return nil, errors.NewAppError("Failed to generate quiz", err, http.StatusBadGateway)
+	}
+	if len(questions) > numQuestions {
+		questions = questions[:numQuestions]
+	}
+
+	questionsJSON, err := json.Marshal(questions)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to encode quiz", err, http.StatusInternalServerError)
+	}
+
+	storedQuiz := &models.Quiz{
+		UserID:        userID,
+		VideoID:       videoID,
+		Language:      transcript.Language,
+		Seed:          seed,
+		Questions:     string(questionsJSON),
+		QuestionCount: len(questions),
+	}
+	if err := s.quizzes.Create(storedQuiz); err != nil {
+		return nil, errors.NewAppError("Failed to store quiz", err, http.StatusInternalServerError)
+	}
+
+	return &dto.GenerateQuizResponse{
+		QuizID:    storedQuiz.ID,
+		VideoID:   videoID,
+		Language:  transcript.Language,
+		Seed:      seed,
+		Questions: toQuestionResponses(questions),
+	}, nil
+}
+
+// Submit grades a set of answers against a stored quiz and records the
+// submission with its score
+func (s *Service) Submit(userID uint, quizID uint, req *dto.SubmitQuizAnswersRequest) (*dto.SubmitQuizAnswersResponse, error) {
+	storedQuiz, err := s.quizzes.GetByID(quizID)
+	if err != nil || storedQuiz.UserID != userID {
+		// Another user's quiz looks the same as a missing one
+		return nil, errors.NewAppError("Quiz not found", nil, http.StatusNotFound)
+	}
+
+	var questions []gemini.QuizQuestion
+	if err := json.Unmarshal([]byte(storedQuiz.Questions), &questions); err != nil {
+		return nil, errors.NewAppError("Failed to decode stored quiz", err, http.StatusInternalServerError)
+	}
+
+	if len(req.Answers) != len(questions) {
+		return nil, errors.NewValidationError("Answer count does not match question count", map[string]string{
+			"answers": "one answer per question is required",
+		})
+	}
+
+	results := make([]dto.QuizAnswerResult, len(questions))
+	correct := 0
+	for i, question := range questions {
+		given := strings.TrimSpace(req.Answers[i])
+		isCorrect := strings.EqualFold(given, strings.TrimSpace(question.Answer))
+		if isCorrect {
+			correct++
+		}
+		results[i] = dto.QuizAnswerResult{
+			Index:   i + 1,
+			Correct: isCorrect,
+			Given:   given,
+			Answer:  question.Answer,
+		}
+	}
+	score := float64(correct) / float64(len(questions)) * 100
+
+	answersJSON, _ := json.Marshal(req.Answers)
+	submission := &models.QuizSubmission{
+		QuizID:        storedQuiz.ID,
+		UserID:        userID,
+		Answers:       string(answersJSON),
+		Score:         score,
+		CorrectCount:  correct,
+		QuestionCount: len(questions),
+	}
+	if err := s.submissions.Create(submission); err != nil {
+		return nil, errors.NewAppError("Failed to record quiz submission", err, http.StatusInternalServerError)
+	}
+
+	return &dto.SubmitQuizAnswersResponse{
+		QuizID:        storedQuiz.ID,
+		Score:         score,
+		CorrectCount:  correct,
+		QuestionCount: len(questions),
+		Results:       results,
+	}, nil
+}
+
+// quizCandidates keeps segments with enough words to blank one out
+func quizCandidates(segments []types.TranscriptSegment) []string {
+	var candidates []string
+	for _, segment := range segments {
+		if len(strings.Fields(segment.Text)) >= minSegmentWords {
+			candidates = append(candidates, segment.Text)
+		}
+	}
+	return candidates
+}
+
+// selectSegments picks the transcript material for the prompt using a
+// seeded shuffle, so the same seed selects the same segments
+func selectSegments(candidates []string, seed int64) []string {
+	rng := rand.New(rand.NewSource(seed))
+	order := rng.Perm(len(candidates))
+
+	count := len(candidates)
+	if count > maxPromptSegments {
+		count = maxPromptSegments
+	}
+	selected := make([]string, count)
+	for i := 0; i < count; i++ {
+		selected[i] = candidates[order[i]]
+	}
+	return selected
+}
+
+// defaultSeed derives a stable seed from the video and language, so a quiz
+// generated without an explicit seed is still reproducible
+func defaultSeed(videoID string, language string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(videoID))
+	h.Write([]byte("|"))
+	h.Write([]byte(language))
+	seed := int64(h.Sum64() & (1<<63 - 1))
+	if seed == 0 {
+		seed = 1
+	}
+	return seed
+}
+
+func toQuestionResponses(questions []gemini.QuizQuestion) []dto.QuizQuestionResponse {
+	responses := make([]dto.QuizQuestionResponse, len(questions))
+	for i, question := range questions {
+		responses[i] = dto.QuizQuestionResponse{
+			Index:    i + 1,
+			Type:     question.Type,
+			Question: question.Question,
+			Options:  question.Options,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,196 @@
+package realtime
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	"app-backend/internal/logger"
+)
+
+// sendBufferSize is how many pending messages a client may fall behind
+// before further messages to it are dropped
+const sendBufferSize = 16
+
+// maxMessageSize bounds inbound client messages; sync events are tiny
+const maxMessageSize = 4 * 1024
+
+// Hub fans session sync events out to a user's connected clients. Each user
+// has a room holding every connection they opened (extension popup, web
+// dashboard); events reach all of them so the clients stay in step.
+type Hub struct {
+	logger *logger.Logger
+
+	mu    sync.RWMutex
+	rooms map[uint]map[*client]struct{}
+}
+
+// client is one WebSocket connection inside a user's room
+type client struct {
+	hub    *Hub
+	userID uint
+	conn   *websocket.Conn
+	send   chan []byte
+}
+
+// NewHub creates a new session sync hub
+func NewHub(logger *logger.Logger) *Hub {
+	return &Hub{
+		logger: logger,
+		rooms:  make(map[uint]map[*client]struct{}),
+	}
+}
+
+// Serve registers the connection in the user's room and pumps messages until
+// the peer disconnects. It blocks, so call it from the handler goroutine.
+func (h *Hub) Serve(userID uint, conn *websocket.Conn) {
+	c := &client{
+		hub:    h,
+		userID: userID,
+		conn:   conn,
+		send:   make(chan []byte, sendBufferSize),
+	}
+
+	h.register(c)
+	go c.writePump()
+	c.readPump()
+}
+
+// Broadcast delivers the event to every connected client of the user
+func (h *Hub) Broadcast(userID uint, event *Event) {
+	h.broadcast(userID, nil, event)
+}
+
+// ClientCount reports how many connections the user currently has
+func (h *Hub) ClientCount(userID uint) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.rooms[userID])
+}
+
+// broadcast sends the event to the user's room, skipping the sender so a
+// client never echoes its own message back. Slow clients that cannot drain
+// their send buffer have messages dropped rather than stalling the room.
+func (h *Hub) broadcast(userID uint, sender *client, event *Event) {
+	if event.SentAt.IsZero() {
+		event.SentAt = time.Now()
+	}
+
+	message, err := json.Marshal(event)
+	if err != nil {
+		h.logger.Error("Failed to marshal sync event",
+			zap.String("type", event.Type),
+			zap.Error(err))
+		return
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for c := range h.rooms[userID] {
+		if c == sender {
+			continue
+		}
+		select {
+		case c.send <- message:
+		default:
+			h.logger.Warn("Dropping sync event for slow client",
+				zap.Uint("user_id", userID),
+				zap.String("type", event.Type))
+		}
+	}
+}
+
+func (h *Hub) register(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	room, ok := h.rooms[c.userID]
+	if !ok {
+		room = make(map[*client]struct{})
+		h.rooms[c.userID] = room
+	}
+	room[c] = struct{}{}
+
+	h.logger.Debug("Sync client connected",
+		zap.Uint("user_id", c.userID),
+		zap.Int("room_size", len(room)))
+}
+
+func (h *Hub) unregister(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	room, ok := h.rooms[c.userID]
+	if !ok {
+		return
+	}
+	if _, ok := room[c]; !ok {
+		return
+	}
+
+	delete(room, c)
+	close(c.send)
+	if len(room) == 0 {
+		delete(h.rooms, c.userID)
+	}
+}
+
+// clientEventAllowed reports whether clients may send this event type;
+// server-published events like vocab_saved are rejected so a client cannot
+// forge them
+func clientEventAllowed(eventType string) bool {
+	switch eventType {
+	case EventPlaybackPosition, EventActiveSegment:
+		return true
+	}
+	return false
+}
+
+// readPump relays inbound client events to the rest of the user's room
+// until the connection closes
+func (c *client) readPump() {
+	defer func() {
+		c.hub.unregister(c)
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadLimit(maxMessageSize)
+
+	for {
+		_, raw, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var event Event
+		if err := json.Unmarshal(raw, &event); err != nil {
+			c.hub.logger.Warn("Ignoring malformed sync message",
+				zap.Uint("user_id", c.userID),
+				zap.Error(err))
+			continue
+		}
+		if !clientEventAllowed(event.Type) {
+			c.hub.logger.Warn("Ignoring disallowed sync event type",
+				zap.Uint("user_id", c.userID),
+				zap.String("type", event.Type))
+			continue
+		}
+
+		event.SentAt = time.Now()
+		c.hub.broadcast(c.userID, c, &event)
+	}
+}
+
+// writePump writes queued messages to the connection until the client is
+// unregistered and its send channel closed
+func (c *client) writePump() {
+	for message := range c.send {
+		if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+			return
+		}
+	}
+}
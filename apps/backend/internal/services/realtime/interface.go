@@ -0,0 +1,33 @@
+package realtime
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event types exchanged over a session sync connection
+const (
+	// EventPlaybackPosition carries the current playback position of the
+	// video a client is watching
+	EventPlaybackPosition = "playback_position"
+	// EventActiveSegment carries the transcript segment currently highlighted
+	EventActiveSegment = "active_segment"
+	// EventVocabSaved announces a vocabulary item saved from any client;
+	// published server-side, never accepted from clients
+	EventVocabSaved = "vocab_saved"
+)
+
+// Event is one message on a session sync connection. The payload is passed
+// through opaquely so clients can evolve their shapes without a server change.
+type Event struct {
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+	SentAt  time.Time       `json:"sentAt"`
+}
+
+// PublisherInterface lets other components push events into a user's room
+// without depending on the full hub
+type PublisherInterface interface {
+	// Broadcast delivers the event to every connected client of the user
+	Broadcast(userID uint, event *Event)
+}
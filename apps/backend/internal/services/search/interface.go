@@ -0,0 +1,20 @@
+package search
+
+import (
+	"app-backend/internal/dto"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+)
+
+// ServiceInterface defines the contract for the transcript search service
+type ServiceInterface interface {
+	// IndexTranscript replaces the indexed segments for a video and language
+	// with the given transcript's segments
+	IndexTranscript(videoID, language string, segments []transcriptTypes.TranscriptSegment) error
+
+	// SearchTranscripts returns indexed segments matching the query
+	SearchTranscripts(req *dto.SearchTranscriptsRequest) (*dto.SearchTranscriptsResponse, error)
+
+	// RemoveVideo drops all indexed segments for the video, used when its
+	// cached transcripts are invalidated
+	RemoveVideo(videoID string) error
+}
@@ -0,0 +1,111 @@
+package search
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+	internalTypes "app-backend/internal/types"
+)
+
+const (
+	// defaultSearchLimit caps result counts when the request gives no limit
+	defaultSearchLimit = 50
+	// minQueryLength rejects queries too short to be meaningful
+	minQueryLength = 2
+)
+
+type Service struct {
+	searchRepo repositories.TranscriptSearchRepositoryInterface
+	logger     *logger.Logger
+}
+
+func NewService(searchRepo repositories.TranscriptSearchRepositoryInterface, logger *logger.Logger) ServiceInterface {
+	return &Service{
+		searchRepo: searchRepo,
+		logger:     logger,
+	}
+}
+
+func (s *Service) IndexTranscript(videoID, language string, segments []transcriptTypes.TranscriptSegment) error {
+	rows := make([]models.TranscriptSegmentIndex, 0, len(segments))
+	for i, segment := range segments {
+		text := strings.TrimSpace(segment.Text)
+		if text == "" {
+			continue
+		}
+		rows = append(rows, models.TranscriptSegmentIndex{
+			VideoID:      videoID,
+			Language:     language,
+			SegmentIndex: i,
+			Text:         text,
+			StartMs:      segment.Start.Milliseconds(),
+			DurationMs:   segment.Duration.Milliseconds(),
+		})
+	}
+
+	if err := s.searchRepo.ReplaceForVideo(videoID, language, rows); err != nil {
+		return errors.NewAppError("Failed to index transcript segments", err, http.StatusInternalServerError)
+	}
+
+	s.logger.Debug("Transcript segments indexed",
+		zap.String("video_id", videoID),
+		zap.String("language", language),
+		zap.Int("segments", len(rows)))
+	return nil
+}
+
+func (s *Service) SearchTranscripts(req *dto.SearchTranscriptsRequest) (*dto.SearchTranscriptsResponse, error) {
+	query := strings.TrimSpace(req.Query)
+	if len(query) < minQueryLength {
+		return nil, errors.NewAppError("Search query is too short", nil, http.StatusBadRequest)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	matches, err := s.searchRepo.Search(query, limit)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to search transcripts", err, http.StatusInternalServerError)
+	}
+
+	responses := make([]dto.TranscriptSearchMatchResponse, len(matches))
+	for i, match := range matches {
+		responses[i] = dto.TranscriptSearchMatchResponse{
+			VideoID:   match.VideoID,
+			Language:  match.Language,
+			Text:      match.Text,
+			StartTime: internalTypes.MillisecondDuration(time.Duration(match.StartMs) * time.Millisecond),
+			EndTime:   internalTypes.MillisecondDuration(time.Duration(match.StartMs+match.DurationMs) * time.Millisecond),
+		}
+	}
+
+	return &dto.SearchTranscriptsResponse{
+		Query:   query,
+		Matches: responses,
+		Total:   len(responses),
+	}, nil
+}
+
+func (s *Service) RemoveVideo(videoID string) error {
+	removed, err := s.searchRepo.DeleteByVideo(videoID)
+	if err != nil {
+		return errors.NewAppError("Failed to remove indexed segments", err, http.StatusInternalServerError)
+	}
+	if removed > 0 {
+		s.logger.Debug("Indexed transcript segments removed",
+			zap.String("video_id", videoID),
+			zap.Int64("segments", removed))
+	}
+	return nil
+}
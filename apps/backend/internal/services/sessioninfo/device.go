@@ -0,0 +1,62 @@
+package sessioninfo
+
+import "strings"
+
+// browserMarkers maps User-Agent substrings to browser names; order matters
+// because Chrome-derived browsers keep the Chrome token in their User-Agent
+var browserMarkers = []struct {
+	marker string
+	name   string
+}{
+	{"Edg/", "Edge"},
+	{"EdgA/", "Edge"},
+	{"OPR/", "Opera"},
+	{"Opera/", "Opera"},
+	{"SamsungBrowser/", "Samsung Internet"},
+	{"Firefox/", "Firefox"},
+	{"FxiOS/", "Firefox"},
+	{"CriOS/", "Chrome"},
+	{"Chrome/", "Chrome"},
+	{"Safari/", "Safari"},
+}
+
+// osMarkers maps User-Agent substrings to operating system names; iOS and
+// Android must come before the desktop tokens they embed
+var osMarkers = []struct {
+	marker string
+	name   string
+}{
+	{"iPhone", "iOS"},
+	{"iPad", "iOS"},
+	{"Android", "Android"},
+	{"CrOS", "ChromeOS"},
+	{"Windows NT", "Windows"},
+	{"Mac OS X", "macOS"},
+	{"Macintosh", "macOS"},
+	{"Linux", "Linux"},
+}
+
+// ParseUserAgent extracts the browser and operating system from a User-Agent
+// header. It is a coarse lookup table rather than a full parser: session
+// labels only need the family name, and unknown agents simply come back
+// empty.
+func ParseUserAgent(userAgent string) Enrichment {
+	var enrichment Enrichment
+	if userAgent == "" {
+		return enrichment
+	}
+
+	for _, browser := range browserMarkers {
+		if strings.Contains(userAgent, browser.marker) {
+			enrichment.Browser = browser.name
+			break
+		}
+	}
+	for _, os := range osMarkers {
+		if strings.Contains(userAgent, os.marker) {
+			enrichment.OS = os.name
+			break
+		}
+	}
+	return enrichment
+}
@@ -0,0 +1,42 @@
+package sessioninfo
+
+import (
+	"context"
+	"strings"
+)
+
+// Enrichment is the device and location metadata derived from a session's
+// IP address and User-Agent
+type Enrichment struct {
+	Browser  string
+	OS       string
+	Location string
+}
+
+// Label renders the enrichment as a friendly session description like
+// "Chrome on macOS, Hanoi" so users can spot logins that are not theirs
+func (e Enrichment) Label() string {
+	var parts []string
+	switch {
+	case e.Browser != "" && e.OS != "":
+		parts = append(parts, e.Browser+" on "+e.OS)
+	case e.Browser != "":
+		parts = append(parts, e.Browser)
+	case e.OS != "":
+		parts = append(parts, e.OS)
+	default:
+		parts = append(parts, "Unknown device")
+	}
+	if e.Location != "" {
+		parts = append(parts, e.Location)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ServiceInterface defines the contract for session enrichment
+type ServiceInterface interface {
+	// Enrich parses the User-Agent and resolves the IP address to a coarse
+	// location; lookups that fail leave the location empty rather than
+	// delaying the login
+	Enrich(ctx context.Context, ipAddress, userAgent string) Enrichment
+}
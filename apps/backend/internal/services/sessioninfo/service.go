@@ -0,0 +1,120 @@
+package sessioninfo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/config"
+	"app-backend/internal/logger"
+	"app-backend/pkg/patterns"
+)
+
+// Service enriches sessions with device and location metadata. Locations are
+// resolved through a configurable ip-api style endpoint and cached per IP so
+// repeated logins from the same address do not re-query it.
+type Service struct {
+	config *config.SessionGeoConfig
+	client *http.Client
+	cache  *patterns.TTLMap[string, string]
+	logger *logger.Logger
+}
+
+// NewService creates a session enrichment service
+func NewService(cfg *config.SessionGeoConfig, logger *logger.Logger) ServiceInterface {
+	return &Service{
+		config: cfg,
+		client: &http.Client{Timeout: cfg.TimeoutDuration()},
+		cache:  patterns.NewTTLMap[string, string](0),
+		logger: logger,
+	}
+}
+
+// Enrich parses the User-Agent and resolves the IP address to a coarse
+// location
+func (s *Service) Enrich(ctx context.Context, ipAddress, userAgent string) Enrichment {
+	enrichment := ParseUserAgent(userAgent)
+	enrichment.Location = s.resolveLocation(ctx, ipAddress)
+	return enrichment
+}
+
+// geoResponse is the subset of the lookup endpoint's JSON the service reads
+type geoResponse struct {
+	City    string `json:"city"`
+	Country string `json:"country"`
+}
+
+// resolveLocation returns a "City, Country" label for the IP, an empty
+// string when lookups are disabled or fail, and a fixed label for private
+// addresses that no public geo database can place
+func (s *Service) resolveLocation(ctx context.Context, ipAddress string) string {
+	if ipAddress == "" {
+		return ""
+	}
+
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return ""
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() {
+		return "Local network"
+	}
+
+	if !s.config.Enabled || s.config.Endpoint == "" {
+		return ""
+	}
+
+	if location, ok := s.cache.Get(ipAddress); ok {
+		return location
+	}
+
+	location := s.lookupLocation(ctx, ipAddress)
+	// Failed lookups are cached as empty so an unreachable endpoint is not
+	// retried on every login
+	s.cache.Set(ipAddress, location, s.config.CacheTTLDuration())
+	return location
+}
+
+// lookupLocation queries the configured endpoint for one IP
+func (s *Service) lookupLocation(ctx context.Context, ipAddress string) string {
+	url := fmt.Sprintf("%s/%s", strings.TrimSuffix(s.config.Endpoint, "/"), ipAddress)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		s.logger.Warn("Failed to build geo lookup request", zap.Error(err))
+		return ""
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.logger.Warn("Geo lookup failed", zap.String("ip", ipAddress), zap.Error(err))
+		return ""
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Warn("Geo lookup returned unexpected status",
+			zap.String("ip", ipAddress),
+			zap.Int("status", resp.StatusCode))
+		return ""
+	}
+
+	var geo geoResponse
+	if err := json.NewDecoder(resp.Body).Decode(&geo); err != nil {
+		s.logger.Warn("Failed to decode geo lookup response", zap.Error(err))
+		return ""
+	}
+
+	var parts []string
+	if geo.City != "" {
+		parts = append(parts, geo.City)
+	}
+	if geo.Country != "" {
+		parts = append(parts, geo.Country)
+	}
+	return strings.Join(parts, ", ")
+}
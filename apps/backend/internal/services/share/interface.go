@@ -0,0 +1,44 @@
+package share
+
+import (
+	"time"
+
+	"app-backend/internal/types"
+)
+
+// CreateLinkParams carries everything a share token locks in at creation
+type CreateLinkParams struct {
+	Provider   types.VideoProvider
+	VideoID    string
+	SourceLang string
+	TargetLang string
+	// TTL is how long the link stays valid; non-positive picks the
+	// configured default and values over the configured cap are clamped
+	TTL time.Duration
+	// Password optionally gates the link; empty leaves it open
+	Password string
+}
+
+// Payload is the verified content of a share token
+type Payload struct {
+	Provider   types.VideoProvider `json:"provider"`
+	VideoID    string              `json:"video_id"`
+	SourceLang string              `json:"source_lang,omitempty"`
+	TargetLang string              `json:"target_lang"`
+	ExpiresAt  int64               `json:"expires_at"`
+	// PasswordHash is the bcrypt hash of the link password; empty means the
+	// link is open
+	PasswordHash string `json:"password_hash,omitempty"`
+}
+
+// ServiceInterface defines the contract for transcript share links. Tokens
+// are stateless: the payload travels inside the token and an HMAC signature
+// makes it tamper-proof, so nothing is stored server-side.
+type ServiceInterface interface {
+	// CreateLink issues a signed share token and reports when it expires
+	CreateLink(params CreateLinkParams) (string, time.Time, error)
+
+	// ResolveToken verifies a token's signature, expiry, and password and
+	// returns its payload
+	ResolveToken(token, password string) (*Payload, error)
+}
@@ -0,0 +1,125 @@
+package share
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+)
+
+// keyPurpose namespaces the derived signing key so share tokens can never
+// validate as other material signed with the same secret
+const keyPurpose = "transcript-share:"
+
+// Service implements ServiceInterface with stateless HMAC-signed tokens
+type Service struct {
+	key        []byte
+	defaultTTL time.Duration
+	maxTTL     time.Duration
+	bcryptCost int
+	logger     *logger.Logger
+	now        func() time.Time
+}
+
+// NewService creates a share link service signing with a key derived from
+// secret. bcryptCost hashes link passwords, matching the account password
+// cost.
+func NewService(secret string, defaultTTL, maxTTL time.Duration, bcryptCost int, logger *logger.Logger) ServiceInterface {
+	key := sha256.Sum256([]byte(keyPurpose + secret))
+	return &Service{
+		key:        key[:],
+		defaultTTL: defaultTTL,
+		maxTTL:     maxTTL,
+		bcryptCost: bcryptCost,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// CreateLink issues a signed share token and reports when it expires
+func (s *Service) CreateLink(params CreateLinkParams) (string, time.Time, error) {
+	ttl := params.TTL
+	if ttl <= 0 {
+		ttl = s.defaultTTL
+	}
+	if ttl > s.maxTTL {
+		ttl = s.maxTTL
+	}
+	expiresAt := s.now().Add(ttl)
+
+	payload := Payload{
+		Provider:   params.Provider,
+		VideoID:    params.VideoID,
+		SourceLang: params.SourceLang,
+		TargetLang: params.TargetLang,
+		ExpiresAt:  expiresAt.Unix(),
+	}
+	if params.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(params.Password), s.bcryptCost)
+		if err != nil {
+			return "", time.Time{}, errors.NewAppError("Failed to create share link", err, http.StatusInternalServerError)
+		}
+		payload.PasswordHash = string(hash)
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return "", time.Time{}, errors.NewAppError("Failed to create share link", err, http.StatusInternalServerError)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(encoded) + "." + s.sign(encoded)
+	return token, expiresAt, nil
+}
+
+// ResolveToken verifies a token's signature, expiry, and password and
+// returns its payload. A forged or malformed token looks the same as a
+// missing one.
+func (s *Service) ResolveToken(token, password string) (*Payload, error) {
+	encodedPayload, signature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, errors.NewAppError("Share link not found", nil, http.StatusNotFound)
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, errors.NewAppError("Share link not found", nil, http.StatusNotFound)
+	}
+	if !hmac.Equal([]byte(s.sign(decoded)), []byte(signature)) {
+		return nil, errors.NewAppError("Share link not found", nil, http.StatusNotFound)
+	}
+
+	var payload Payload
+	if err := json.Unmarshal(decoded, &payload); err != nil {
+		return nil, errors.NewAppError("Share link not found", nil, http.StatusNotFound)
+	}
+
+	if s.now().After(time.Unix(payload.ExpiresAt, 0)) {
+		return nil, errors.NewAppError("Share link has expired", nil, http.StatusGone)
+	}
+
+	if payload.PasswordHash != "" {
+		if password == "" {
+			return nil, errors.NewAppError("This share link requires a password", nil, http.StatusUnauthorized)
+		}
+		if err := bcrypt.CompareHashAndPassword([]byte(payload.PasswordHash), []byte(password)); err != nil {
+			return nil, errors.NewAppError("Invalid share link password", nil, http.StatusUnauthorized)
+		}
+	}
+
+	return &payload, nil
+}
+
+// sign computes the base64url HMAC-SHA256 signature of a payload
+func (s *Service) sign(payload []byte) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(payload)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
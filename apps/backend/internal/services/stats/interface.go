@@ -0,0 +1,25 @@
+package stats
+
+import "app-backend/internal/dto"
+
+// ServiceInterface defines the contract for the study statistics service.
+// The Record methods are best-effort: aggregation failures are logged and
+// never fail the user action that triggered them.
+type ServiceInterface interface {
+	// RecordVideoWatched counts a video studied today
+	RecordVideoWatched(userID uint)
+
+	// RecordTranslatedSegments counts transcript segments sent for
+	// translation today
+	RecordTranslatedSegments(userID uint, segments int)
+
+	// RecordVocabReviews counts graded spaced-repetition reviews today
+	RecordVocabReviews(userID uint, reviews int)
+
+	// RecordStudyTime adds watched playback time to today's total
+	RecordStudyTime(userID uint, seconds int)
+
+	// GetStats returns the user's streak, totals and per-day counts over
+	// the last rangeDays days, today included
+	GetStats(userID uint, rangeDays int) (*dto.UserStatsResponse, error)
+}
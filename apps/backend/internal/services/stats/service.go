@@ -0,0 +1,159 @@
+package stats
+
+import (
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+)
+
+const (
+	// DefaultRangeDays is the dashboard range when none is requested
+	DefaultRangeDays = 30
+	// MaxRangeDays caps how far back per-day counts are returned
+	MaxRangeDays = 365
+	// streakLookbackDays is how far back the streak is counted, independent
+	// of the requested range
+	streakLookbackDays = 365
+	// dateLayout is the aggregate table's UTC day format
+	dateLayout = "2006-01-02"
+)
+
+type Service struct {
+	activityRepo repositories.DailyActivityRepositoryInterface
+	logger       *logger.Logger
+	// now is injectable for deterministic streak tests
+	now func() time.Time
+}
+
+func NewService(activityRepo repositories.DailyActivityRepositoryInterface, logger *logger.Logger) ServiceInterface {
+	return &Service{
+		activityRepo: activityRepo,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+func (s *Service) RecordVideoWatched(userID uint) {
+	s.record(userID, &models.DailyActivity{VideosWatched: 1})
+}
+
+func (s *Service) RecordTranslatedSegments(userID uint, segments int) {
+	if segments <= 0 {
+		return
+	}
+	s.record(userID, &models.DailyActivity{SegmentsTranslated: segments})
+}
+
+func (s *Service) RecordVocabReviews(userID uint, reviews int) {
+	if reviews <= 0 {
+		return
+	}
+	s.record(userID, &models.DailyActivity{VocabReviewed: reviews})
+}
+
+func (s *Service) RecordStudyTime(userID uint, seconds int) {
+	if seconds <= 0 {
+		return
+	}
+	s.record(userID, &models.DailyActivity{StudySeconds: seconds})
+}
+
+// record adds the delta onto today's aggregate row. Failures are logged
+// rather than surfaced so statistics never break the triggering action.
+func (s *Service) record(userID uint, delta *models.DailyActivity) {
+	if userID == 0 {
+		return
+	}
+
+	date := s.now().UTC().Format(dateLayout)
+	if err := s.activityRepo.AddActivity(userID, date, delta); err != nil {
+		s.logger.Warn("Failed to record daily activity",
+			zap.Uint("user_id", userID),
+			zap.String("date", date),
+			zap.Error(err))
+	}
+}
+
+func (s *Service) GetStats(userID uint, rangeDays int) (*dto.UserStatsResponse, error) {
+	if rangeDays <= 0 {
+		rangeDays = DefaultRangeDays
+	}
+	if rangeDays > MaxRangeDays {
+		rangeDays = MaxRangeDays
+	}
+
+	today := s.now().UTC().Truncate(24 * time.Hour)
+	// The streak can run past the requested range, so load the full
+	// lookback window once
+	lookback := rangeDays
+	if streakLookbackDays > lookback {
+		lookback = streakLookbackDays
+	}
+	from := today.AddDate(0, 0, -(lookback - 1))
+
+	rows, err := s.activityRepo.ListRange(userID, from.Format(dateLayout), today.Format(dateLayout))
+	if err != nil {
+		return nil, errors.NewAppError("Failed to load study statistics", err, http.StatusInternalServerError)
+	}
+
+	byDate := make(map[string]*models.DailyActivity, len(rows))
+	for _, row := range rows {
+		byDate[row.Date] = row
+	}
+
+	response := &dto.UserStatsResponse{
+		RangeDays:  rangeDays,
+		StreakDays: streak(byDate, today),
+		Days:       make([]dto.DailyStatDTO, 0, rangeDays),
+	}
+
+	for offset := rangeDays - 1; offset >= 0; offset-- {
+		date := today.AddDate(0, 0, -offset).Format(dateLayout)
+		day := dto.DailyStatDTO{Date: date}
+		if row, ok := byDate[date]; ok {
+			day.VideosWatched = row.VideosWatched
+			day.SegmentsTranslated = row.SegmentsTranslated
+			day.VocabReviewed = row.VocabReviewed
+			day.MinutesStudied = row.StudySeconds / 60
+		}
+		response.Days = append(response.Days, day)
+		response.TotalVideosWatched += day.VideosWatched
+		response.TotalSegmentsTranslated += day.SegmentsTranslated
+		response.TotalVocabReviewed += day.VocabReviewed
+		response.TotalMinutesStudied += day.MinutesStudied
+	}
+
+	return response, nil
+}
+
+// streak counts consecutive active days ending today. An inactive today
+// starts the count from yesterday instead, so the streak survives until the
+// day actually ends without activity.
+func streak(byDate map[string]*models.DailyActivity, today time.Time) int {
+	day := today
+	if !active(byDate[day.Format(dateLayout)]) {
+		day = day.AddDate(0, 0, -1)
+	}
+
+	count := 0
+	for active(byDate[day.Format(dateLayout)]) {
+		count++
+		day = day.AddDate(0, 0, -1)
+	}
+	return count
+}
+
+// active reports whether the day's row records any study activity
+func active(row *models.DailyActivity) bool {
+	if row == nil {
+		return false
+	}
+	return row.VideosWatched > 0 || row.SegmentsTranslated > 0 || row.VocabReviewed > 0 || row.StudySeconds > 0
+}
@@ -0,0 +1,72 @@
+package subtitle
+
+import (
+	"context"
+
+	"app-backend/internal/models"
+	"app-backend/internal/types"
+)
+
+// JobTypeBilingualSubtitle is the job type bilingual subtitle generation
+// runs under in the background job queue
+const JobTypeBilingualSubtitle = "bilingual_subtitle"
+
+// Subtitle file formats
+const (
+	// FormatSRT renders SRT cues with the translation styled via font tags
+	FormatSRT = "srt"
+	// FormatASS renders an Advanced SubStation Alpha file with a dedicated
+	// translation style
+	FormatASS = "ass"
+)
+
+// Style controls how the translation line is rendered. Zero values fall back
+// to the defaults.
+type Style struct {
+	// TranslationColor is the translation line's color as #RRGGBB
+	TranslationColor string `json:"translationColor,omitempty"`
+	// TranslationSize is the translation font size in points (ASS only; SRT
+	// has no reliable size markup)
+	TranslationSize int `json:"translationSize,omitempty"`
+}
+
+// GenerationResult is the snapshot a subtitle job stores as its result JSON;
+// Content holds the finished file once the job completes
+type GenerationResult struct {
+	VideoID    string `json:"videoId"`
+	SourceLang string `json:"sourceLang"`
+	TargetLang string `json:"targetLang"`
+	Format     string `json:"format"`
+	Filename   string `json:"filename"`
+	Segments   int    `json:"segments,omitempty"`
+	Content    string `json:"content,omitempty"`
+}
+
+// File is a finished subtitle file ready to stream as a download
+type File struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// DualTranscriptSource provides the dual-language transcript a subtitle file
+// is built from. video.ServiceInterface satisfies it.
+type DualTranscriptSource interface {
+	// DetectProvider detects the video provider from URL or video ID
+	DetectProvider(videoURL string) (types.VideoProvider, string, error)
+
+	// GetDualLanguageTranscript retrieves the transcript and its translation
+	GetDualLanguageTranscript(ctx context.Context, provider types.VideoProvider, videoID string, sourceLang string, targetLang string, corrections map[int]string) (*types.DualLanguageTranscript, error)
+}
+
+// ServiceInterface defines the contract for bilingual subtitle generation
+type ServiceInterface interface {
+	// StartGeneration enqueues a background job that fetches the
+	// dual-language transcript and renders the subtitle file, returning the
+	// queued job
+	StartGeneration(ctx context.Context, userID uint, videoURL, sourceLang, targetLang, format string, style Style) (*models.Job, error)
+
+	// GetFile returns the finished subtitle file of one of the user's
+	// completed generation jobs
+	GetFile(userID uint, jobID uint) (*File, error)
+}
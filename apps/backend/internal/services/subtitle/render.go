@@ -0,0 +1,124 @@
+package subtitle
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"app-backend/internal/services/transcript/captions"
+	"app-backend/internal/types"
+)
+
+// Defaults for the translation line's styling
+const (
+	defaultTranslationColor = "#FFD700"
+	defaultTranslationSize  = 36
+	// originalFontSize is the fixed font size of the original line in ASS
+	// output; the translation size is relative to it
+	originalFontSize = 48
+)
+
+// hexColorPattern matches a #RRGGBB color
+var hexColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// cue is one bilingual subtitle entry
+type cue struct {
+	start       time.Duration
+	end         time.Duration
+	text        string
+	translation string
+}
+
+// buildCues pairs each original segment with its translation
+func buildCues(dual *types.DualLanguageTranscript) []cue {
+	cues := make([]cue, len(dual.Segments))
+	for i, segment := range dual.Segments {
+		cues[i] = cue{
+			start: time.Duration(segment.StartTime),
+			end:   time.Duration(segment.EndTime),
+			text:  strings.TrimSpace(segment.Text),
+		}
+		if i < len(dual.Translations) {
+			cues[i].translation = strings.TrimSpace(dual.Translations[i].TranslatedText)
+		}
+	}
+	return cues
+}
+
+// renderSRT serializes bilingual cues as an SRT file: the original on the
+// first line, the translation on the second wrapped in a font color tag
+func renderSRT(cues []cue, style Style) string {
+	var b strings.Builder
+	for i, c := range cues {
+		fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n",
+			i+1,
+			captions.FormatTimestamp(c.start, ','),
+			captions.FormatTimestamp(c.end, ','),
+			c.text)
+		if c.translation != "" {
+			fmt.Fprintf(&b, "<font color=\"%s\">%s</font>\n", style.TranslationColor, c.translation)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// renderASS serializes bilingual cues as an Advanced SubStation Alpha file
+// with separate Original and Translation styles, so players render the
+// translation in its configured color and size
+func renderASS(cues []cue, title string, style Style) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "[Script Info]\nTitle: %s\nScriptType: v4.00+\nWrapStyle: 0\nPlayResX: 1280\nPlayResY: 720\n\n", title)
+
+	b.WriteString("[V4+ Styles]\n")
+	b.WriteString("Format: Name, Fontname, Fontsize, PrimaryColour, SecondaryColour, OutlineColour, BackColour, Bold, Italic, Underline, StrikeOut, ScaleX, ScaleY, Spacing, Angle, BorderStyle, Outline, Shadow, Alignment, MarginL, MarginR, MarginV, Encoding\n")
+	fmt.Fprintf(&b, "Style: Original,Arial,%d,&H00FFFFFF,&H000000FF,&H00000000,&H64000000,0,0,0,0,100,100,0,0,1,2,0,2,20,20,50,1\n",
+		originalFontSize)
+	fmt.Fprintf(&b, "Style: Translation,Arial,%d,%s,&H000000FF,&H00000000,&H64000000,0,0,0,0,100,100,0,0,1,2,0,2,20,20,12,1\n\n",
+		style.TranslationSize, assColor(style.TranslationColor))
+
+	b.WriteString("[Events]\n")
+	b.WriteString("Format: Layer, Start, End, Style, Name, MarginL, MarginR, MarginV, Effect, Text\n")
+	for _, c := range cues {
+		fmt.Fprintf(&b, "Dialogue: 0,%s,%s,Original,,0,0,0,,%s\n",
+			formatASSTimestamp(c.start), formatASSTimestamp(c.end), assText(c.text))
+		if c.translation != "" {
+			fmt.Fprintf(&b, "Dialogue: 0,%s,%s,Translation,,0,0,0,,%s\n",
+				formatASSTimestamp(c.start), formatASSTimestamp(c.end), assText(c.translation))
+		}
+	}
+	return b.String()
+}
+
+// assColor converts a #RRGGBB color to the ASS &HAABBGGRR form
+func assColor(hex string) string {
+	if !hexColorPattern.MatchString(hex) {
+		hex = defaultTranslationColor
+	}
+	return fmt.Sprintf("&H00%s%s%s", strings.ToUpper(hex[5:7]), strings.ToUpper(hex[3:5]), strings.ToUpper(hex[1:3]))
+}
+
+// assText escapes cue text for an ASS Dialogue line: newlines become soft
+// breaks and braces are neutralized so they cannot open an override block
+var assReplacer = strings.NewReplacer("\n", "\\N", "{", "(", "}", ")")
+
+func assText(text string) string {
+	return assReplacer.Replace(text)
+}
+
+// formatASSTimestamp renders an ASS timestamp: H:MM:SS.cc (centiseconds)
+func formatASSTimestamp(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	cs := d.Milliseconds() / 10
+	hours := cs / 360000
+	cs -= hours * 360000
+	minutes := cs / 6000
+	cs -= minutes * 6000
+	seconds := cs / 100
+	cs -= seconds * 100
+	return fmt.Sprintf("%d:%02d:%02d.%02d", hours, minutes, seconds, cs)
+}
@@ -0,0 +1,248 @@
+package subtitle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/services/jobs"
+)
+
+// Bounds on the translation font size (ASS points)
+const (
+	minTranslationSize = 8
+	maxTranslationSize = 96
+)
+
+// subtitleContentTypes maps a subtitle format to its Content-Type header
+var subtitleContentTypes = map[string]string{
+	FormatSRT: "application/x-subrip",
+	FormatASS: "text/x-ssa; charset=utf-8",
+}
+
+// generationPayload is the JSON a subtitle generation job carries
+type generationPayload struct {
+	VideoURL   string `json:"videoUrl"`
+	VideoID    string `json:"videoId"`
+	SourceLang string `json:"sourceLang,omitempty"`
+	TargetLang string `json:"targetLang"`
+	Format     string `json:"format"`
+	Style      Style  `json:"style"`
+}
+
+// Service generates bilingual subtitle files through the background job
+// queue; finished files are stored on the job and downloaded from there
+type Service struct {
+	videos DualTranscriptSource
+	queue  jobs.ServiceInterface
+	logger *logger.Logger
+}
+
+// NewService creates the subtitle generation service and registers its job
+// handler on the queue
+func NewService(videos DualTranscriptSource, queue jobs.ServiceInterface, logger *logger.Logger) ServiceInterface {
+	s := &Service{
+		videos: videos,
+		queue:  queue,
+		logger: logger,
+	}
+
+	if queue != nil {
+		queue.RegisterHandler(JobTypeBilingualSubtitle, s.runGeneration)
+	}
+
+	return s
+}
+
+// StartGeneration validates the request and enqueues a background job that
+// renders the bilingual subtitle file
+func (s *Service) StartGeneration(ctx context.Context, userID uint, videoURL, sourceLang, targetLang, format string, style Style) (*models.Job, error) {
+	if s.videos == nil {
+		return nil, errors.NewAppError("Video service not available", nil, http.StatusServiceUnavailable)
+	}
+	if s.queue == nil {
+		return nil, errors.NewAppError("Job queue not available", nil, http.StatusServiceUnavailable)
+	}
+	if targetLang == "" {
+		return nil, errors.NewAppError("Target language is required", nil, http.StatusBadRequest)
+	}
+
+	if format == "" {
+		format = FormatSRT
+	}
+	if _, ok := subtitleContentTypes[format]; !ok {
+		return nil, errors.NewAppError(fmt.Sprintf("Unsupported subtitle format: %s", format), nil, http.StatusBadRequest)
+	}
+
+	normalized, err := normalizeStyle(style)
+	if err != nil {
+		return nil, err
+	}
+
+	_, videoID, err := s.videos.DetectProvider(videoURL)
+	if err != nil {
+		return nil, errors.NewAppError("Unsupported video URL", err, http.StatusBadRequest)
+	}
+
+	payload := generationPayload{
+		VideoURL:   videoURL,
+		VideoID:    videoID,
+		SourceLang: sourceLang,
+		TargetLang: targetLang,
+		Format:     format,
+		Style:      normalized,
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to encode subtitle payload", err, http.StatusInternalServerError)
+	}
+
+	job, err := s.queue.Enqueue(userID, JobTypeBilingualSubtitle, string(payloadJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	// Persist an initial snapshot so job polls see what is being generated
+	// before the first attempt starts
+	initial := GenerationResult{
+		VideoID:    videoID,
+		SourceLang: sourceLang,
+		TargetLang: targetLang,
+		Format:     format,
+	}
+	if resultJSON, marshalErr := json.Marshal(initial); marshalErr == nil {
+		if updateErr := s.queue.UpdateResult(job.ID, string(resultJSON)); updateErr == nil {
+			job.Result = string(resultJSON)
+		}
+	}
+
+	s.logger.Info("Queued bilingual subtitle generation",
+		zap.Uint("job_id", job.ID),
+		zap.Uint("user_id", userID),
+		zap.String("video_id", videoID),
+		zap.String("target_lang", targetLang),
+		zap.String("format", format))
+
+	return job, nil
+}
+
+// GetFile returns the finished subtitle file of one of the user's
+// completed generation jobs
+func (s *Service) GetFile(userID uint, jobID uint) (*File, error) {
+	if s.queue == nil {
+		return nil, errors.NewAppError("Job queue not available", nil, http.StatusServiceUnavailable)
+	}
+
+	job, err := s.queue.GetJob(userID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.Type != JobTypeBilingualSubtitle {
+		return nil, errors.NewAppError("Job is not a subtitle generation job", nil, http.StatusNotFound)
+	}
+	if job.Status == models.JobStatusFailed {
+		return nil, errors.NewAppError("Subtitle generation failed", nil, http.StatusBadGateway)
+	}
+	if !job.IsTerminal() {
+		return nil, errors.NewAppError("Subtitle file is not ready yet", nil, http.StatusConflict)
+	}
+
+	result, err := ParseResult(job)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to decode subtitle result", err, http.StatusInternalServerError)
+	}
+	if result.Content == "" {
+		return nil, errors.NewAppError("Subtitle file is empty", nil, http.StatusNotFound)
+	}
+
+	return &File{
+		Filename:    result.Filename,
+		ContentType: subtitleContentTypes[result.Format],
+		Content:     []byte(result.Content),
+	}, nil
+}
+
+// runGeneration is the subtitle job handler: it fetches the dual-language
+// transcript and renders the file into the job's result
+func (s *Service) runGeneration(ctx context.Context, job *models.Job) (string, error) {
+	var payload generationPayload
+	if err := json.Unmarshal([]byte(job.Payload), &payload); err != nil {
+		return "", fmt.Errorf("invalid subtitle payload: %w", err)
+	}
+
+	provider, videoID, err := s.videos.DetectProvider(payload.VideoURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to detect video provider: %w", err)
+	}
+
+	dual, err := s.videos.GetDualLanguageTranscript(ctx, provider, videoID, payload.SourceLang, payload.TargetLang, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch dual-language transcript: %w", err)
+	}
+
+	cues := buildCues(dual)
+	var content string
+	switch payload.Format {
+	case FormatASS:
+		title := fmt.Sprintf("%s %s-%s", dual.VideoID, dual.SourceLang, dual.TargetLang)
+		content = renderASS(cues, title, payload.Style)
+	default:
+		content = renderSRT(cues, payload.Style)
+	}
+
+	result := GenerationResult{
+		VideoID:    dual.VideoID,
+		SourceLang: dual.SourceLang,
+		TargetLang: dual.TargetLang,
+		Format:     payload.Format,
+		Filename:   fmt.Sprintf("%s.%s-%s.%s", dual.VideoID, dual.SourceLang, dual.TargetLang, payload.Format),
+		Segments:   len(cues),
+		Content:    content,
+	}
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode subtitle result: %w", err)
+	}
+
+	s.logger.Info("Bilingual subtitle file generated",
+		zap.Uint("job_id", job.ID),
+		zap.String("video_id", dual.VideoID),
+		zap.String("format", payload.Format),
+		zap.Int("segments", len(cues)))
+
+	return string(resultJSON), nil
+}
+
+// normalizeStyle validates the style and fills in the defaults
+func normalizeStyle(style Style) (Style, error) {
+	if style.TranslationColor == "" {
+		style.TranslationColor = defaultTranslationColor
+	} else if !hexColorPattern.MatchString(style.TranslationColor) {
+		return Style{}, errors.NewAppError("Translation color must be a #RRGGBB value", nil, http.StatusBadRequest)
+	}
+
+	if style.TranslationSize == 0 {
+		style.TranslationSize = defaultTranslationSize
+	} else if style.TranslationSize < minTranslationSize || style.TranslationSize > maxTranslationSize {
+		return Style{}, errors.NewAppError(
+			fmt.Sprintf("Translation size must be between %d and %d", minTranslationSize, maxTranslationSize),
+			nil, http.StatusBadRequest)
+	}
+
+	return style, nil
+}
+
+// ParseResult decodes a subtitle generation job's result snapshot
+func ParseResult(job *models.Job) (*GenerationResult, error) {
+	var result GenerationResult
+	if err := json.Unmarshal([]byte(job.Result), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,24 @@
+package summary
+
+import (
+	"context"
+
+	"app-backend/internal/dto"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+	"app-backend/internal/types"
+	"app-backend/pkg/gemini"
+)
+
+// SummarizeFunc produces a chaptered summary of a rendered transcript. It
+// matches gemini.Service.SummarizeTranscript so the model backend can be
+// swapped in tests.
+type SummarizeFunc func(ctx context.Context, transcript string, lang string, depth string) (*gemini.TranscriptSummary, error)
+
+// ServiceInterface defines the contract for transcript summary operations
+type ServiceInterface interface {
+	// Summarize returns a chaptered summary of the given transcript
+	// segments. Summaries are cached per video, language, and depth; the
+	// transcript text is part of the cache key, so a changed transcript is
+	// summarized fresh. Fresh model calls are rate limited per user.
+	Summarize(ctx context.Context, userID uint, provider types.VideoProvider, videoID string, lang string, depth string, segments []transcriptTypes.TranscriptSegment) (*dto.VideoSummaryResponse, error)
+}
@@ -0,0 +1,171 @@
+package summary
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/logger"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+	"app-backend/internal/types"
+	"app-backend/pkg/gemini"
+	"app-backend/pkg/patterns"
+)
+
+const (
+	// defaultSummaryCacheSize is how many summaries are kept. A summary
+	// only depends on the transcript, language, and depth, so every learner
+	// watching the same video shares the cached entries.
+	defaultSummaryCacheSize = 500
+	// defaultRequestsPerMinute bounds fresh model calls per user when the
+	// config leaves the limit unset; cache hits are not counted
+	defaultRequestsPerMinute = 5
+	// defaultDepth is used when the request does not pick a depth
+	defaultDepth = "standard"
+	// maxTranscriptChars caps how much transcript text goes into the
+	// prompt; longer transcripts are truncated at a segment boundary
+	maxTranscriptChars = 60000
+)
+
+// Config holds transcript summary tuning knobs; zero values use defaults
+type Config struct {
+	// RequestsPerMinute bounds fresh summaries per user per minute
+	RequestsPerMinute int
+}
+
+// Service produces chaptered transcript summaries through a model backend
+// with an LRU cache and a per-user rate limit on fresh model calls
+type Service struct {
+	summarizeFn       SummarizeFunc
+	cache             *patterns.LRUCache[string, dto.VideoSummaryResponse]
+	requestsPerMinute int
+	logger            *logger.Logger
+
+	mu       sync.Mutex
+	limiters map[uint]*patterns.SlidingWindowLimiter
+}
+
+// NewService creates the transcript summary service around the given model
+// backend
+func NewService(summarizeFn SummarizeFunc, cfg Config, logger *logger.Logger) ServiceInterface {
+	requestsPerMinute := cfg.RequestsPerMinute
+	if requestsPerMinute <= 0 {
+		requestsPerMinute = defaultRequestsPerMinute
+	}
+
+	return &Service{
+		summarizeFn:       summarizeFn,
+		cache:             patterns.NewLRUCache[string, dto.VideoSummaryResponse](defaultSummaryCacheSize),
+		requestsPerMinute: requestsPerMinute,
+		logger:            logger,
+		limiters:          make(map[uint]*patterns.SlidingWindowLimiter),
+	}
+}
+
+// Summarize returns a chaptered summary of the given transcript segments.
+// The cache is checked before the rate limit, so re-opening an already
+// summarized video never costs quota. The rendered transcript is part of the
+// cache key, so a changed transcript invalidates the cached summary.
+func (s *Service) Summarize(ctx context.Context, userID uint, provider types.VideoProvider, videoID string, lang string, depth string, segments []transcriptTypes.TranscriptSegment) (*dto.VideoSummaryResponse, error) {
+	if s.summarizeFn == nil {
+		return nil, appErrors.NewAppError("Transcript summaries not available", nil, http.StatusServiceUnavailable)
+	}
+	if len(segments) == 0 {
+		return nil, appErrors.NewValidationError("Transcript has no segments to summarize", nil)
+	}
+	if depth == "" {
+		depth = defaultDepth
+	}
+
+	transcript := renderTranscript(segments)
+	key := summaryCacheKey(provider, videoID, lang, depth, transcript)
+	if cached, ok := s.cache.Get(key); ok {
+		cached.Cached = true
+		return &cached, nil
+	}
+
+	if !s.userLimiter(userID).Allow() {
+		return nil, appErrors.NewAppError("Too many summary requests, please wait a moment", nil, http.StatusTooManyRequests)
+	}
+
+	summary, err := s.summarizeFn(ctx, transcript, lang, depth)
+	if err != nil {
+		s.logger.Error("Transcript summary failed",
+			zap.Uint("user_id", userID),
+			zap.String("video_id", videoID),
+			zap.Error(err))
+		return nil, appErrors.NewAppError("Failed to summarize transcript", err, http.StatusBadGateway)
+	}
+
+	response := toSummaryResponse(provider, videoID, lang, depth, summary)
+	s.cache.Put(key, response)
+
+	return &response, nil
+}
+
+// userLimiter returns the user's rate limiter, creating it on first use
+func (s *Service) userLimiter(userID uint) *patterns.SlidingWindowLimiter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	limiter, ok := s.limiters[userID]
+	if !ok {
+		limiter = patterns.NewSlidingWindowLimiter(s.requestsPerMinute, time.Minute, s.logger.Zap())
+		s.limiters[userID] = limiter
+	}
+	return limiter
+}
+
+// renderTranscript flattens segments into one "[<seconds>s] text" line per
+// segment, truncated at a segment boundary once the prompt budget is spent
+func renderTranscript(segments []transcriptTypes.TranscriptSegment) string {
+	var rendered strings.Builder
+	for _, segment := range segments {
+		line := fmt.Sprintf("[%ds] %s\n", int(segment.Start/time.Second), segment.Text)
+		if rendered.Len()+len(line) > maxTranscriptChars {
+			break
+		}
+		rendered.WriteString(line)
+	}
+	return rendered.String()
+}
+
+func toSummaryResponse(provider types.VideoProvider, videoID string, lang string, depth string, summary *gemini.TranscriptSummary) dto.VideoSummaryResponse {
+	response := dto.VideoSummaryResponse{
+		VideoID:   videoID,
+		Provider:  provider,
+		Language:  lang,
+		Depth:     depth,
+		Overview:  summary.Overview,
+		MainIdeas: summary.MainIdeas,
+	}
+	for _, section := range summary.Sections {
+		response.Sections = append(response.Sections, dto.SummarySectionResponse{
+			Title:        section.Title,
+			StartSeconds: section.StartSeconds,
+			EndSeconds:   section.EndSeconds,
+			Summary:      section.Summary,
+		})
+	}
+	for _, item := range summary.Vocabulary {
+		response.KeyVocabulary = append(response.KeyVocabulary, dto.SummaryVocabularyResponse{Term: item.Term, Meaning: item.Meaning})
+	}
+	return response
+}
+
+// summaryCacheKey hashes everything the summary depends on. Including the
+// rendered transcript means a changed transcript yields a new key and the
+// stale summary simply ages out of the LRU.
+func summaryCacheKey(provider types.VideoProvider, videoID string, lang string, depth string, transcript string) string {
+	sum := sha256.Sum256([]byte(string(provider) + "\x00" + videoID + "\x00" + strings.ToLower(lang) + "\x00" + depth + "\x00" + transcript))
+	return hex.EncodeToString(sum[:])
+}
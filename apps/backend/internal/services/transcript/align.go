@@ -0,0 +1,141 @@
+package transcript
+
+import (
+	"sort"
+	"time"
+
+	"app-backend/internal/services/transcript/types"
+)
+
+// DefaultAlignTolerance is how far apart two segments' intervals may be while
+// still being considered overlapping
+const DefaultAlignTolerance = 250 * time.Millisecond
+
+// AlignedPair groups the segments from two tracks that cover the same span of
+// time. One-to-many and many-to-one mappings put several segments on one
+// side; a segment with no counterpart leaves the other side empty.
+type AlignedPair struct {
+	A []types.TranscriptSegment `json:"a"`
+	B []types.TranscriptSegment `json:"b"`
+}
+
+// AlignByTime aligns two segment tracks by interval overlap using the default
+// tolerance. It underpins merge, dual-alignment, and correction-overlay
+// features that need to relate segments across tracks.
+func AlignByTime(a, b []types.TranscriptSegment) []AlignedPair {
+	return AlignByTimeWithTolerance(a, b, DefaultAlignTolerance)
+}
+
+// AlignByTimeWithTolerance aligns two segment tracks by interval overlap.
+// Segments whose intervals come within tolerance of each other are grouped
+// into the same pair, so slightly offset timings still align.
+func AlignByTimeWithTolerance(a, b []types.TranscriptSegment, tolerance time.Duration) []AlignedPair {
+	sortedA := sortSegmentsByStart(a)
+	sortedB := sortSegmentsByStart(b)
+
+	// Union cross-track segments whose intervals overlap within tolerance.
+	// Nodes 0..len(a)-1 are track A, len(a)..len(a)+len(b)-1 are track B.
+	parent := make([]int, len(sortedA)+len(sortedB))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	i, j := 0, 0
+	for i < len(sortedA) && j < len(sortedB) {
+		if segmentsOverlap(sortedA[i], sortedB[j], tolerance) {
+			union(parent, i, len(sortedA)+j)
+		}
+		if segmentEnd(sortedA[i]) < segmentEnd(sortedB[j]) {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	// Collect each connected component into one aligned pair
+	pairsByRoot := make(map[int]*AlignedPair)
+	order := make([]int, 0)
+	for index, segment := range sortedA {
+		root := find(parent, index)
+		pair, ok := pairsByRoot[root]
+		if !ok {
+			pair = &AlignedPair{}
+			pairsByRoot[root] = pair
+			order = append(order, root)
+		}
+		pair.A = append(pair.A, segment)
+	}
+	for index, segment := range sortedB {
+		root := find(parent, len(sortedA)+index)
+		pair, ok := pairsByRoot[root]
+		if !ok {
+			pair = &AlignedPair{}
+			pairsByRoot[root] = pair
+			order = append(order, root)
+		}
+		pair.B = append(pair.B, segment)
+	}
+
+	pairs := make([]AlignedPair, 0, len(order))
+	for _, root := range order {
+		pairs = append(pairs, *pairsByRoot[root])
+	}
+
+	sort.SliceStable(pairs, func(x, y int) bool {
+		return pairStart(pairs[x]) < pairStart(pairs[y])
+	})
+
+	return pairs
+}
+
+// segmentsOverlap reports whether two intervals overlap once each is widened
+// by the tolerance. Merely touching endpoints do not count, so back-to-back
+// segments stay in separate pairs.
+func segmentsOverlap(a, b types.TranscriptSegment, tolerance time.Duration) bool {
+	return a.Start < segmentEnd(b)+tolerance && b.Start < segmentEnd(a)+tolerance
+}
+
+// segmentEnd returns the end time of a segment's interval
+func segmentEnd(segment types.TranscriptSegment) time.Duration {
+	return segment.Start + segment.Duration
+}
+
+// pairStart returns the earliest start time covered by a pair
+func pairStart(pair AlignedPair) time.Duration {
+	start := time.Duration(1<<63 - 1)
+	for _, segment := range pair.A {
+		if segment.Start < start {
+			start = segment.Start
+		}
+	}
+	for _, segment := range pair.B {
+		if segment.Start < start {
+			start = segment.Start
+		}
+	}
+	return start
+}
+
+// sortSegmentsByStart returns a copy of the segments ordered by start time
+func sortSegmentsByStart(segments []types.TranscriptSegment) []types.TranscriptSegment {
+	sorted := make([]types.TranscriptSegment, len(segments))
+	copy(sorted, segments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Start < sorted[j].Start
+	})
+	return sorted
+}
+
+// find returns the root of a union-find node with path compression
+func find(parent []int, node int) int {
+	for parent[node] != node {
+		parent[node] = parent[parent[node]]
+		node = parent[node]
+	}
+	return node
+}
+
+// union joins the components containing the two nodes
+func union(parent []int, a, b int) {
+	parent[find(parent, a)] = find(parent, b)
+}
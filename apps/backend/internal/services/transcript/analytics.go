@@ -0,0 +1,64 @@
+package transcript
+
+import (
+	"time"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/models"
+	"app-backend/internal/services/transcript/types"
+)
+
+// OutcomeRecorderInterface receives per-provider request outcomes for analytics.
+// Implementations must not block the caller.
+type OutcomeRecorderInterface interface {
+	RecordProviderOutcome(outcome *models.ProviderOutcome)
+}
+
+// SetOutcomeRecorder wires an analytics recorder into the service
+func (s *Service) SetOutcomeRecorder(recorder OutcomeRecorderInterface) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.outcomeRecorder = recorder
+}
+
+// recordOutcomes forwards provider outcomes to the analytics recorder, if any
+func (s *Service) recordOutcomes(outcomes []*models.ProviderOutcome) {
+	s.mu.RLock()
+	recorder := s.outcomeRecorder
+	s.mu.RUnlock()
+
+	if recorder == nil {
+		return
+	}
+
+	for _, outcome := range outcomes {
+		recorder.RecordProviderOutcome(outcome)
+	}
+}
+
+// newProviderOutcome builds an outcome record for a single provider attempt
+func newProviderOutcome(req *types.TranscriptRequest, provider string, err error, latency time.Duration) *models.ProviderOutcome {
+	videoID := req.VideoID
+	if videoID == "" {
+		videoID = req.VideoURL
+	}
+
+	return &models.ProviderOutcome{
+		VideoID:   videoID,
+		Provider:  provider,
+		Success:   err == nil,
+		ErrorCode: outcomeErrorCode(err),
+		LatencyMs: latency.Milliseconds(),
+	}
+}
+
+// outcomeErrorCode maps a provider error to a stable code for aggregation
+func outcomeErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	if appErr, ok := err.(*errors.AppError); ok {
+		return appErr.Code
+	}
+	return errors.ErrCodeInternalServer
+}
@@ -0,0 +1,90 @@
+package transcript
+
+import (
+	"context"
+	goerrors "errors"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/services/transcript/errors"
+	"app-backend/internal/services/transcript/types"
+	"app-backend/pkg/patterns"
+)
+
+// providerBreakers holds one circuit breaker per transcript provider so a
+// repeatedly failing provider (e.g. innertube answering 403 on every call)
+// is skipped for a cooldown period instead of being retried on every request
+type providerBreakers struct {
+	mu       sync.Mutex
+	breakers map[types.ProviderType]*patterns.CircuitBreaker
+}
+
+// breakerFor returns the provider's circuit breaker, creating it on first
+// use. Returns nil when breakers are disabled.
+func (s *Service) breakerFor(providerType types.ProviderType) *patterns.CircuitBreaker {
+	if !s.config.Transcript.Breaker.Enabled {
+		return nil
+	}
+
+	s.breakers.mu.Lock()
+	defer s.breakers.mu.Unlock()
+
+	if s.breakers.breakers == nil {
+		s.breakers.breakers = make(map[types.ProviderType]*patterns.CircuitBreaker)
+	}
+	breaker, ok := s.breakers.breakers[providerType]
+	if !ok {
+		breaker = patterns.NewCircuitBreaker(patterns.CircuitBreakerConfig{
+			Name:             "transcript-" + string(providerType),
+			FailureThreshold: uint32(s.config.Transcript.Breaker.FailureThreshold),
+			Timeout:          s.config.Transcript.Breaker.CooldownDuration(),
+			IsFailure:        isBreakerFailure,
+			OnStateChange: func(name string, from, to patterns.CircuitBreakerState) {
+				s.logger.Warn("Transcript provider circuit breaker state changed",
+					zap.String("provider", string(providerType)),
+					zap.String("from", from.String()),
+					zap.String("to", to.String()))
+			},
+			Logger: s.logger.Zap(),
+		})
+		s.breakers.breakers[providerType] = breaker
+	}
+	return breaker
+}
+
+// isBreakerFailure decides which provider errors count against the breaker.
+// Cancellations (losing a provider race, client disconnect) and "this video
+// has no captions" answers say nothing about provider health, so only real
+// upstream failures trip the cooldown.
+func isBreakerFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if goerrors.Is(err, context.Canceled) || goerrors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if goerrors.Is(err, errors.ErrTranscriptNotFound) ||
+		goerrors.Is(err, errors.ErrTranscriptDisabled) ||
+		goerrors.Is(err, errors.ErrLanguageNotAvailable) {
+		return false
+	}
+	return true
+}
+
+// ProviderBreakerMetrics returns current circuit-breaker metrics per
+// provider, sorted by breaker name for stable output
+func (s *Service) ProviderBreakerMetrics() []patterns.CircuitBreakerMetrics {
+	s.breakers.mu.Lock()
+	defer s.breakers.mu.Unlock()
+
+	metrics := make([]patterns.CircuitBreakerMetrics, 0, len(s.breakers.breakers))
+	for _, breaker := range s.breakers.breakers {
+		metrics = append(metrics, breaker.GetMetrics())
+	}
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].Name < metrics[j].Name
+	})
+	return metrics
+}
@@ -0,0 +1,176 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/services/transcript/types"
+)
+
+// FetchFunc retrieves a fresh transcript when the cache cannot serve one
+type FetchFunc func(ctx context.Context) (*types.Transcript, error)
+
+// Config controls entry lifetime in the transcript cache
+type Config struct {
+	// MaxAge is the hard limit; entries older than this are never served
+	MaxAge time.Duration
+	// StaleAfter is the soft limit; entries older than this are still served
+	// but trigger an asynchronous background refresh (stale-while-revalidate)
+	StaleAfter time.Duration
+	// ServeStaleOnError serves entries past MaxAge, marked Stale, when a
+	// fresh fetch fails; better than an error for read endpoints
+	ServeStaleOnError bool
+}
+
+// CacheInterface defines the contract for transcript caches
+type CacheInterface interface {
+	// GetOrFetch returns the cached transcript for key when present and fresh
+	// enough, calling fetch otherwise. The boolean reports whether the result
+	// was served from cache.
+	GetOrFetch(ctx context.Context, key string, fetch FetchFunc) (*types.Transcript, bool, error)
+
+	// Set stores a transcript under the given key
+	Set(key string, transcript *types.Transcript)
+
+	// Invalidate drops all cached transcripts for the video across languages
+	Invalidate(ctx context.Context, videoID string) error
+}
+
+type entry struct {
+	transcript *types.Transcript
+	storedAt   time.Time
+}
+
+// MemoryCache is an in-memory transcript cache with stale-while-revalidate semantics
+type MemoryCache struct {
+	config     Config
+	logger     *logger.Logger
+	mu         sync.RWMutex
+	entries    map[string]*entry
+	refreshing map[string]bool
+}
+
+// NewMemoryCache creates a new in-memory transcript cache
+func NewMemoryCache(config Config, logger *logger.Logger) *MemoryCache {
+	if config.MaxAge <= 0 {
+		config.MaxAge = 24 * time.Hour
+	}
+	if config.StaleAfter <= 0 || config.StaleAfter > config.MaxAge {
+		config.StaleAfter = config.MaxAge
+	}
+
+	return &MemoryCache{
+		config:     config,
+		logger:     logger,
+		entries:    make(map[string]*entry),
+		refreshing: make(map[string]bool),
+	}
+}
+
+// Key builds a cache key from video ID and requested language
+func Key(videoID, language string) string {
+	return fmt.Sprintf("%s:%s", videoID, language)
+}
+
+// GetOrFetch implements CacheInterface
+func (c *MemoryCache) GetOrFetch(ctx context.Context, key string, fetch FetchFunc) (*types.Transcript, bool, error) {
+	c.mu.RLock()
+	cached, exists := c.entries[key]
+	c.mu.RUnlock()
+
+	if exists {
+		age := time.Since(cached.storedAt)
+		if age <= c.config.MaxAge {
+			if age > c.config.StaleAfter {
+				c.refreshAsync(key, fetch)
+			}
+			return cached.transcript, true, nil
+		}
+		// Past the hard limit - treat as a miss and fetch synchronously
+	}
+
+	transcript, err := fetch(ctx)
+	if err != nil {
+		// Serve-stale-on-error: an expired entry beats an error when all
+		// live providers failed
+		if exists && c.config.ServeStaleOnError {
+			c.logger.Warn("Serving stale transcript after fetch failure",
+				zap.String("key", key),
+				zap.Duration("age", time.Since(cached.storedAt)),
+				zap.Error(err))
+			stale := *cached.transcript
+			stale.Stale = true
+			return &stale, true, nil
+		}
+		return nil, false, err
+	}
+
+	c.Set(key, transcript)
+	return transcript, false, nil
+}
+
+// Set implements CacheInterface
+func (c *MemoryCache) Set(key string, transcript *types.Transcript) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = &entry{
+		transcript: transcript,
+		storedAt:   time.Now(),
+	}
+}
+
+// Invalidate implements CacheInterface
+func (c *MemoryCache) Invalidate(ctx context.Context, videoID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prefix := videoID + ":"
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+		}
+	}
+	return nil
+}
+
+// refreshAsync re-fetches the transcript in the background, ensuring only one
+// refresh is in flight per key
+func (c *MemoryCache) refreshAsync(key string, fetch FetchFunc) {
+	c.mu.Lock()
+	if c.refreshing[key] {
+		c.mu.Unlock()
+		return
+	}
+	c.refreshing[key] = true
+	c.mu.Unlock()
+
+	go func() {
+		defer func() {
+			c.mu.Lock()
+			delete(c.refreshing, key)
+			c.mu.Unlock()
+		}()
+
+		// Detached from the request context so the refresh can outlive it
+		refreshCtx, cancel := context.WithTimeout(context.Background(), 45*time.Second)
+		defer cancel()
+
+		transcript, err := fetch(refreshCtx)
+		if err != nil {
+			c.logger.Warn("Background transcript refresh failed",
+				zap.String("key", key),
+				zap.Error(err))
+			return
+		}
+
+		c.Set(key, transcript)
+		c.logger.Debug("Background transcript refresh completed", zap.String("key", key))
+	}()
+}
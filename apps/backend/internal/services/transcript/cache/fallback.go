@@ -0,0 +1,185 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/services/transcript/types"
+)
+
+// maxPendingWrites bounds the write-through queue during a store outage;
+// the oldest entries are dropped first since they are re-fetchable
+const maxPendingWrites = 256
+
+// pendingWrite is a write-through that could not reach the store and is
+// queued until the store recovers
+type pendingWrite struct {
+	key        string
+	transcript *types.Transcript
+}
+
+// FallbackCache layers a durable store (the database) under the in-memory
+// cache. Reads prefer the store; when it is unavailable the cache enters
+// degraded mode and keeps serving from memory, while write-throughs are
+// queued and flushed once the store recovers.
+type FallbackCache struct {
+	store  StoreInterface
+	memory *MemoryCache
+	logger *logger.Logger
+
+	mu       sync.Mutex
+	pending  []pendingWrite
+	degraded bool
+}
+
+// NewFallbackCache creates a cache that reads through the given store and
+// falls back to the in-memory cache when the store is unavailable
+func NewFallbackCache(store StoreInterface, memory *MemoryCache, logger *logger.Logger) *FallbackCache {
+	return &FallbackCache{
+		store:  store,
+		memory: memory,
+		logger: logger,
+	}
+}
+
+// GetOrFetch implements CacheInterface
+func (c *FallbackCache) GetOrFetch(ctx context.Context, key string, fetch FetchFunc) (*types.Transcript, bool, error) {
+	transcript, err := c.store.Get(ctx, key)
+	if err != nil {
+		c.enterDegradedMode(err)
+		// Store unavailable: serve read-only traffic from the in-memory
+		// cache and queue any fresh fetch for later persistence
+		return c.memory.GetOrFetch(ctx, key, c.queueingFetch(key, fetch))
+	}
+
+	c.flushPending(ctx)
+
+	if transcript != nil {
+		// Keep the in-memory cache warm so it can cover a later outage
+		c.memory.Set(key, transcript)
+		return transcript, true, nil
+	}
+
+	// Store miss: let the in-memory cache serve or fetch, writing fresh
+	// results through to the store
+	return c.memory.GetOrFetch(ctx, key, func(fetchCtx context.Context) (*types.Transcript, error) {
+		fetched, fetchErr := fetch(fetchCtx)
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		c.writeThrough(fetchCtx, key, fetched)
+		return fetched, nil
+	})
+}
+
+// Set implements CacheInterface
+func (c *FallbackCache) Set(key string, transcript *types.Transcript) {
+	c.memory.Set(key, transcript)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c.writeThrough(ctx, key, transcript)
+}
+
+// Invalidate implements CacheInterface. The store deletion must succeed for
+// the invalidation to count, since a surviving store entry would repopulate
+// the memory cache on the next read.
+func (c *FallbackCache) Invalidate(ctx context.Context, videoID string) error {
+	if err := c.memory.Invalidate(ctx, videoID); err != nil {
+		return err
+	}
+	if err := c.store.Delete(ctx, videoID); err != nil {
+		c.enterDegradedMode(err)
+		return err
+	}
+	return nil
+}
+
+// PendingWrites reports how many write-throughs are queued awaiting store
+// recovery
+func (c *FallbackCache) PendingWrites() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.pending)
+}
+
+// queueingFetch wraps fetch so a successful result during an outage is
+// queued for persistence instead of being lost
+func (c *FallbackCache) queueingFetch(key string, fetch FetchFunc) FetchFunc {
+	return func(fetchCtx context.Context) (*types.Transcript, error) {
+		fetched, err := fetch(fetchCtx)
+		if err != nil {
+			return nil, err
+		}
+		c.queueWrite(key, fetched)
+		return fetched, nil
+	}
+}
+
+// writeThrough persists a transcript to the store, queueing it when the
+// store is unavailable
+func (c *FallbackCache) writeThrough(ctx context.Context, key string, transcript *types.Transcript) {
+	if err := c.store.Put(ctx, key, transcript); err != nil {
+		c.enterDegradedMode(err)
+		c.queueWrite(key, transcript)
+		return
+	}
+	c.flushPending(ctx)
+}
+
+// queueWrite appends a write-through to the pending queue, dropping the
+// oldest entry when the queue is full
+func (c *FallbackCache) queueWrite(key string, transcript *types.Transcript) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.pending) >= maxPendingWrites {
+		c.pending = c.pending[1:]
+	}
+	c.pending = append(c.pending, pendingWrite{key: key, transcript: transcript})
+}
+
+// enterDegradedMode records that the store is unavailable, logging only on
+// the transition so outages do not flood the logs
+func (c *FallbackCache) enterDegradedMode(err error) {
+	c.mu.Lock()
+	alreadyDegraded := c.degraded
+	c.degraded = true
+	c.mu.Unlock()
+
+	if !alreadyDegraded {
+		c.logger.Warn("Transcript cache store unavailable, serving from memory in degraded mode",
+			zap.Error(err))
+	}
+}
+
+// flushPending persists queued write-throughs after the store recovers and
+// clears degraded mode. Writes that fail again are re-queued.
+func (c *FallbackCache) flushPending(ctx context.Context) {
+	c.mu.Lock()
+	wasDegraded := c.degraded
+	c.degraded = false
+	queued := c.pending
+	c.pending = nil
+	c.mu.Unlock()
+
+	if wasDegraded {
+		c.logger.Info("Transcript cache store recovered",
+			zap.Int("queued_writes", len(queued)))
+	}
+
+	for i, write := range queued {
+		if err := c.store.Put(ctx, write.key, write.transcript); err != nil {
+			c.enterDegradedMode(err)
+			// Re-queue this and the remaining writes for the next recovery
+			c.mu.Lock()
+			c.pending = append(queued[i:], c.pending...)
+			c.mu.Unlock()
+			return
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	internalTypes "app-backend/internal/types"
+
+	"app-backend/internal/services/transcript/types"
+)
+
+// StoreInterface is a durable backing store for transcript cache entries,
+// typically the database. A nil transcript with a nil error means the store
+// has no entry for the key.
+type StoreInterface interface {
+	// Get returns the stored transcript for key, or nil when absent or expired
+	Get(ctx context.Context, key string) (*types.Transcript, error)
+
+	// Put stores a transcript under the given key
+	Put(ctx context.Context, key string, transcript *types.Transcript) error
+
+	// Delete removes all stored transcripts for the video across languages
+	Delete(ctx context.Context, videoID string) error
+}
+
+// DatabaseStore persists transcript cache entries to the database so they
+// survive restarts and are shared across instances
+type DatabaseStore struct {
+	repo   repositories.TranscriptCacheRepositoryInterface
+	maxAge time.Duration
+}
+
+// NewDatabaseStore creates a database-backed transcript cache store
+func NewDatabaseStore(db *gorm.DB, maxAge time.Duration) *DatabaseStore {
+	if maxAge <= 0 {
+		maxAge = 24 * time.Hour
+	}
+	return &DatabaseStore{
+		repo:   repositories.NewTranscriptCacheRepository(db),
+		maxAge: maxAge,
+	}
+}
+
+// Get implements StoreInterface
+func (s *DatabaseStore) Get(ctx context.Context, key string) (*types.Transcript, error) {
+	videoID, language := splitKey(key)
+
+	record, err := s.repo.GetLatest(videoID, language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transcript cache: %w", err)
+	}
+	if record == nil {
+		return nil, nil
+	}
+
+	var transcript types.Transcript
+	if err := json.Unmarshal([]byte(record.Content), &transcript); err != nil {
+		return nil, fmt.Errorf("failed to decode cached transcript: %w", err)
+	}
+
+	return &transcript, nil
+}
+
+// Put implements StoreInterface
+func (s *DatabaseStore) Put(ctx context.Context, key string, transcript *types.Transcript) error {
+	videoID, language := splitKey(key)
+
+	content, err := json.Marshal(transcript)
+	if err != nil {
+		return fmt.Errorf("failed to encode transcript for cache: %w", err)
+	}
+
+	record := &models.VideoTranscriptCache{
+		VideoID:   videoID,
+		Provider:  internalTypes.VideoProvider(transcript.Provider),
+		Language:  language,
+		Content:   string(content),
+		Source:    transcript.Source,
+		ExpiresAt: time.Now().Add(s.maxAge),
+	}
+
+	if err := s.repo.Create(record); err != nil {
+		return fmt.Errorf("failed to write transcript cache: %w", err)
+	}
+
+	return nil
+}
+
+// Delete implements StoreInterface
+func (s *DatabaseStore) Delete(ctx context.Context, videoID string) error {
+	if _, err := s.repo.DeleteByVideo(videoID); err != nil {
+		return fmt.Errorf("failed to invalidate transcript cache: %w", err)
+	}
+	return nil
+}
+
+// splitKey breaks a cache key built by Key back into its video ID and
+// language components
+func splitKey(key string) (string, string) {
+	if i := strings.Index(key, ":"); i >= 0 {
+		return key[:i], key[i+1:]
+	}
+	return key, ""
+}
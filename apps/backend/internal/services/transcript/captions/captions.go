@@ -0,0 +1,165 @@
+// Package captions parses caption file formats into transcript segments so
+// every provider shares one implementation instead of bundling its own.
+package captions
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"app-backend/internal/services/transcript/types"
+)
+
+// tagPattern matches inline markup tags such as VTT's <c> and <i> spans
+var tagPattern = regexp.MustCompile(`<[^>]+>`)
+
+// ParseSRT parses SRT subtitle content into transcript segments
+func ParseSRT(content string) ([]types.TranscriptSegment, error) {
+	var segments []types.TranscriptSegment
+
+	blocks := strings.Split(content, "\n\n")
+	for _, block := range blocks {
+		lines := strings.Split(strings.TrimSpace(block), "\n")
+		if len(lines) < 3 {
+			continue
+		}
+
+		// Parse timing line (format: 00:00:01,000 --> 00:00:04,000)
+		segment, ok := parseCue(lines[1], lines[2:])
+		if ok {
+			segments = append(segments, segment)
+		}
+	}
+
+	return segments, nil
+}
+
+// ParseVTT parses WebVTT subtitle content into transcript segments
+func ParseVTT(content string) ([]types.TranscriptSegment, error) {
+	var segments []types.TranscriptSegment
+
+	blocks := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n")
+	for _, block := range blocks {
+		lines := strings.Split(strings.TrimSpace(block), "\n")
+		if len(lines) == 0 {
+			continue
+		}
+
+		// Skip the header and non-cue blocks
+		first := strings.TrimSpace(lines[0])
+		if strings.HasPrefix(first, "WEBVTT") || strings.HasPrefix(first, "NOTE") || strings.HasPrefix(first, "STYLE") {
+			continue
+		}
+
+		// Cues may carry an optional identifier line before the timing line
+		timingIndex := -1
+		for i, line := range lines {
+			if strings.Contains(line, "-->") {
+				timingIndex = i
+				break
+			}
+		}
+		if timingIndex < 0 || timingIndex+1 >= len(lines) {
+			continue
+		}
+
+		segment, ok := parseCue(lines[timingIndex], lines[timingIndex+1:])
+		if ok {
+			segments = append(segments, segment)
+		}
+	}
+
+	return segments, nil
+}
+
+// parseCue parses one timing line plus its text lines into a segment,
+// reporting false when the cue is malformed or empty
+func parseCue(timingLine string, textLines []string) (types.TranscriptSegment, bool) {
+	times := strings.Split(timingLine, "-->")
+	if len(times) != 2 {
+		return types.TranscriptSegment{}, false
+	}
+
+	start, err := ParseTimestamp(strings.TrimSpace(times[0]))
+	if err != nil {
+		return types.TranscriptSegment{}, false
+	}
+
+	// VTT timing lines may carry cue settings after the end timestamp
+	endFields := strings.Fields(strings.TrimSpace(times[1]))
+	if len(endFields) == 0 {
+		return types.TranscriptSegment{}, false
+	}
+	end, err := ParseTimestamp(endFields[0])
+	if err != nil {
+		return types.TranscriptSegment{}, false
+	}
+
+	text := strings.TrimSpace(tagPattern.ReplaceAllString(strings.Join(textLines, " "), ""))
+	if text == "" {
+		return types.TranscriptSegment{}, false
+	}
+
+	return types.TranscriptSegment{
+		Text:     text,
+		Start:    start,
+		Duration: end - start,
+	}, true
+}
+
+// ParseTimestamp parses an SRT (00:00:01,000) or WebVTT (00:01.000)
+// timestamp into a duration; the hours component is optional
+func ParseTimestamp(timeStr string) (time.Duration, error) {
+	// SRT separates milliseconds with a comma, WebVTT with a dot
+	timeStr = strings.Replace(timeStr, ",", ".", 1)
+
+	parts := strings.Split(timeStr, ":")
+	if len(parts) == 2 {
+		parts = append([]string{"0"}, parts...)
+	}
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid time format: %s", timeStr)
+	}
+
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, err
+	}
+
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, err
+	}
+
+	secondsParts := strings.Split(parts[2], ".")
+	seconds, err := strconv.Atoi(secondsParts[0])
+	if err != nil {
+		return 0, err
+	}
+
+	var milliseconds int
+	if len(secondsParts) > 1 {
+		// Pad or truncate to 3 digits
+		msStr := secondsParts[1]
+		if len(msStr) > 3 {
+			msStr = msStr[:3]
+		} else {
+			for len(msStr) < 3 {
+				msStr += "0"
+			}
+		}
+		milliseconds, err = strconv.Atoi(msStr)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	duration := time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		time.Duration(seconds)*time.Second +
+		time.Duration(milliseconds)*time.Millisecond
+
+	return duration, nil
+}
@@ -0,0 +1,55 @@
+package captions
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"app-backend/internal/services/transcript/types"
+)
+
+// FormatSRT serializes transcript segments as an SRT file: numbered cues
+// with comma-separated milliseconds, one blank line between cues
+func FormatSRT(segments []types.TranscriptSegment) string {
+	var b strings.Builder
+	for i, segment := range segments {
+		fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n\n",
+			i+1,
+			FormatTimestamp(segment.Start, ','),
+			FormatTimestamp(segment.Start+segment.Duration, ','),
+			strings.TrimSpace(segment.Text))
+	}
+	return b.String()
+}
+
+// FormatVTT serializes transcript segments as a WebVTT file: the WEBVTT
+// header followed by cues with dot-separated milliseconds
+func FormatVTT(segments []types.TranscriptSegment) string {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n\n")
+	for _, segment := range segments {
+		fmt.Fprintf(&b, "%s --> %s\n%s\n\n",
+			FormatTimestamp(segment.Start, '.'),
+			FormatTimestamp(segment.Start+segment.Duration, '.'),
+			strings.TrimSpace(segment.Text))
+	}
+	return b.String()
+}
+
+// FormatTimestamp renders a duration as HH:MM:SS<sep>mmm, the shared shape
+// of SRT (comma) and WebVTT (dot) cue times
+func FormatTimestamp(d time.Duration, sep byte) string {
+	if d < 0 {
+		d = 0
+	}
+
+	ms := d.Milliseconds()
+	hours := ms / int64(time.Hour/time.Millisecond)
+	ms -= hours * int64(time.Hour/time.Millisecond)
+	minutes := ms / int64(time.Minute/time.Millisecond)
+	ms -= minutes * int64(time.Minute/time.Millisecond)
+	seconds := ms / 1000
+	ms -= seconds * 1000
+
+	return fmt.Sprintf("%02d:%02d:%02d%c%03d", hours, minutes, seconds, sep, ms)
+}
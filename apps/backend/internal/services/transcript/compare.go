@@ -0,0 +1,271 @@
+package transcript
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/services/transcript/types"
+	"app-backend/pkg/patterns"
+)
+
+// CompareFunc fetches a transcript from every available provider and builds
+// a per-provider diff report; injected into the admin handler so it stays
+// decoupled from the concrete transcript service
+type CompareFunc func(ctx context.Context, req *types.TranscriptRequest) (*ComparisonReport, error)
+
+// compareWorkerCount caps how many providers are queried concurrently
+const compareWorkerCount = 4
+
+// compareFetchTimeout bounds each individual provider fetch
+const compareFetchTimeout = 45 * time.Second
+
+// ProviderComparison is the diff of one provider's transcript against the
+// reference provider
+type ProviderComparison struct {
+	Provider  string `json:"provider"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+	Reference bool   `json:"reference,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+
+	SegmentCount    int     `json:"segment_count"`
+	DurationSeconds float64 `json:"duration_seconds"`
+
+	// CoveragePercent is how much of the reference's spoken time this
+	// provider's segments overlap
+	CoveragePercent float64 `json:"coverage_percent"`
+	// AvgTimingDriftMs is the mean absolute start-time difference across
+	// time-aligned segment pairs
+	AvgTimingDriftMs float64 `json:"avg_timing_drift_ms"`
+	// TextSimilarity is the mean token Jaccard similarity across aligned
+	// segment pairs, from 0 to 1
+	TextSimilarity float64 `json:"text_similarity"`
+	// AlignedSegments is how many reference segments found a time-overlapping
+	// counterpart in this provider's transcript
+	AlignedSegments int `json:"aligned_segments"`
+}
+
+// ComparisonReport is the full cross-provider transcript diff for one video
+type ComparisonReport struct {
+	VideoID   string               `json:"video_id"`
+	Language  string               `json:"language,omitempty"`
+	Reference string               `json:"reference"`
+	Providers []ProviderComparison `json:"providers"`
+}
+
+// CompareProviders fetches the transcript from every enabled provider in
+// parallel and diffs each result against the highest-priority successful
+// provider. Providers that fail are still listed with their error so a
+// broken provider shows up in the report rather than hiding it.
+func (s *Service) CompareProviders(ctx context.Context, req *types.TranscriptRequest) (*ComparisonReport, error) {
+	var providers []ProviderInterface
+	for _, provider := range s.getProvidersInPriorityOrder(req.PreferredProviders) {
+		if provider.IsAvailable(ctx) {
+			providers = append(providers, provider)
+		}
+	}
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("no transcript providers available")
+	}
+
+	workerCount := compareWorkerCount
+	if len(providers) < workerCount {
+		workerCount = len(providers)
+	}
+	pool := patterns.NewWorkerPool[ProviderInterface, *types.Transcript](patterns.WorkerPoolConfig{
+		WorkerCount: workerCount,
+		QueueSize:   len(providers),
+		Timeout:     compareFetchTimeout,
+		Logger:      s.logger.Zap(),
+	})
+	pool.Start()
+	defer pool.Stop()
+
+	for _, provider := range providers {
+		job := patterns.Job[ProviderInterface, *types.Transcript]{
+			ID:   string(provider.GetProviderType()),
+			Data: provider,
+			Process: func(jobCtx context.Context, p ProviderInterface) (*types.Transcript, error) {
+				return p.GetTranscript(jobCtx, req)
+			},
+		}
+		if err := pool.Submit(job); err != nil {
+			return nil, fmt.Errorf("failed to queue provider fetch: %w", err)
+		}
+	}
+
+	type fetchOutcome struct {
+		transcript *types.Transcript
+		err        error
+		latency    time.Duration
+	}
+	outcomes := make(map[string]fetchOutcome, len(providers))
+	for range providers {
+		select {
+		case result := <-pool.Results():
+			outcomes[result.JobID] = fetchOutcome{
+				transcript: result.Data,
+				err:        result.Error,
+				latency:    result.Timing,
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	// The reference is the highest-priority provider that returned segments,
+	// i.e. the transcript the fallback chain would normally serve
+	var reference *types.Transcript
+	referenceProvider := ""
+	for _, provider := range providers {
+		outcome := outcomes[string(provider.GetProviderType())]
+		if outcome.err == nil && outcome.transcript != nil && len(outcome.transcript.Segments) > 0 {
+			reference = outcome.transcript
+			referenceProvider = string(provider.GetProviderType())
+			break
+		}
+	}
+	if reference == nil {
+		return nil, fmt.Errorf("no provider returned a transcript for comparison")
+	}
+
+	report := &ComparisonReport{
+		VideoID:   reference.VideoID,
+		Language:  reference.Language,
+		Reference: referenceProvider,
+	}
+	for _, provider := range providers {
+		providerType := string(provider.GetProviderType())
+		outcome := outcomes[providerType]
+
+		comparison := ProviderComparison{
+			Provider:  providerType,
+			Reference: providerType == referenceProvider,
+			LatencyMs: outcome.latency.Milliseconds(),
+		}
+		if outcome.err != nil {
+			comparison.Error = outcome.err.Error()
+		} else if outcome.transcript != nil {
+			comparison.Success = true
+			comparison.SegmentCount = len(outcome.transcript.Segments)
+			comparison.DurationSeconds = coveredDuration(outcome.transcript.Segments).Seconds()
+			diffSegments(&comparison, reference.Segments, outcome.transcript.Segments)
+		}
+		report.Providers = append(report.Providers, comparison)
+	}
+
+	s.logger.Info("Compared transcript providers",
+		zap.String("video_id", report.VideoID),
+		zap.String("reference", referenceProvider),
+		zap.Int("provider_count", len(report.Providers)))
+
+	return report, nil
+}
+
+// diffSegments fills the alignment metrics of comparison by matching each
+// reference segment with the candidate segment overlapping it the most
+func diffSegments(comparison *ProviderComparison, reference, candidate []types.TranscriptSegment) {
+	if len(reference) == 0 {
+		return
+	}
+
+	var overlapTotal time.Duration
+	var driftTotal time.Duration
+	var similarityTotal float64
+	aligned := 0
+
+	for _, refSegment := range reference {
+		best := -1
+		var bestOverlap time.Duration
+		for i, candSegment := range candidate {
+			if overlap := segmentOverlap(refSegment, candSegment); overlap > bestOverlap {
+				bestOverlap = overlap
+				best = i
+			}
+		}
+		if best < 0 {
+			continue
+		}
+
+		aligned++
+		overlapTotal += bestOverlap
+		drift := refSegment.Start - candidate[best].Start
+		if drift < 0 {
+			drift = -drift
+		}
+		driftTotal += drift
+		similarityTotal += tokenJaccard(refSegment.Text, candidate[best].Text)
+	}
+
+	comparison.AlignedSegments = aligned
+	if refDuration := coveredDuration(reference); refDuration > 0 {
+		comparison.CoveragePercent = float64(overlapTotal) / float64(refDuration) * 100
+	}
+	if aligned > 0 {
+		comparison.AvgTimingDriftMs = float64(driftTotal.Milliseconds()) / float64(aligned)
+		comparison.TextSimilarity = similarityTotal / float64(aligned)
+	}
+}
+
+// segmentOverlap returns how long two segments overlap in time
+func segmentOverlap(a, b types.TranscriptSegment) time.Duration {
+	start := a.Start
+	if b.Start > start {
+		start = b.Start
+	}
+	end := a.Start + a.Duration
+	if bEnd := b.Start + b.Duration; bEnd < end {
+		end = bEnd
+	}
+	if end <= start {
+		return 0
+	}
+	return end - start
+}
+
+// coveredDuration sums the segment durations of a transcript
+func coveredDuration(segments []types.TranscriptSegment) time.Duration {
+	var total time.Duration
+	for _, segment := range segments {
+		total += segment.Duration
+	}
+	return total
+}
+
+// tokenJaccard computes the Jaccard similarity of the lowercased token sets
+// of two texts
+func tokenJaccard(a, b string) float64 {
+	tokensA := tokenSet(a)
+	tokensB := tokenSet(b)
+	if len(tokensA) == 0 && len(tokensB) == 0 {
+		return 1
+	}
+	if len(tokensA) == 0 || len(tokensB) == 0 {
+		return 0
+	}
+
+	intersection := 0
+	for token := range tokensA {
+		if tokensB[token] {
+			intersection++
+		}
+	}
+	union := len(tokensA) + len(tokensB) - intersection
+	return float64(intersection) / float64(union)
+}
+
+// tokenSet lowercases text and splits it into a set of alphanumeric tokens
+func tokenSet(text string) map[string]bool {
+	tokens := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !('a' <= r && r <= 'z') && !('0' <= r && r <= '9') && r < 0x80
+	})
+	set := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		set[token] = true
+	}
+	return set
+}
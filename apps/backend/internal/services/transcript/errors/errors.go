@@ -9,14 +9,15 @@ import (
 
 var (
 	// Provider-specific errors
-	ErrTranscriptNotFound      = errors.NewAppError("Transcript not found for this video", nil, http.StatusNotFound)
-	ErrTranscriptDisabled      = errors.NewAppError("Transcripts are disabled for this video", nil, http.StatusForbidden)
-	ErrInvalidVideoID          = errors.NewAppError("Invalid YouTube video ID", nil, http.StatusBadRequest)
-	ErrProviderNotAvailable    = errors.NewAppError("Transcript provider is not available", nil, http.StatusServiceUnavailable)
-	ErrAllProvidersFailed      = errors.NewAppError("All transcript providers failed", nil, http.StatusServiceUnavailable)
-	ErrInvalidLanguage         = errors.NewAppError("Invalid or unsupported language code", nil, http.StatusBadRequest)
-	ErrRateLimitExceeded       = errors.NewAppError("Rate limit exceeded for transcript provider", nil, http.StatusTooManyRequests)
-	ErrAuthenticationFailed    = errors.NewAppError("Authentication failed with transcript provider", nil, http.StatusUnauthorized)
+	ErrTranscriptNotFound   = errors.NewAppError("Transcript not found for this video", nil, http.StatusNotFound)
+	ErrTranscriptDisabled   = errors.NewAppError("Transcripts are disabled for this video", nil, http.StatusForbidden)
+	ErrInvalidVideoID       = errors.NewAppError("Invalid YouTube video ID", nil, http.StatusBadRequest)
+	ErrProviderNotAvailable = errors.NewAppError("Transcript provider is not available", nil, http.StatusServiceUnavailable)
+	ErrAllProvidersFailed   = errors.NewAppError("All transcript providers failed", nil, http.StatusServiceUnavailable)
+	ErrInvalidLanguage      = errors.NewAppError("Invalid or unsupported language code", nil, http.StatusBadRequest)
+	ErrLanguageNotAvailable = errors.NewAppError("Requested transcript language is not available", nil, http.StatusNotFound)
+	ErrRateLimitExceeded    = errors.NewAppError("Rate limit exceeded for transcript provider", nil, http.StatusTooManyRequests)
+	ErrAuthenticationFailed = errors.NewAppError("Authentication failed with transcript provider", nil, http.StatusUnauthorized)
 )
 
 // NewProviderError creates a new provider-specific error
@@ -35,4 +36,4 @@ func NewVideoIDExtractionError(url string, err error) *errors.AppError {
 		err,
 		http.StatusBadRequest,
 	)
-}
\ No newline at end of file
+}
@@ -0,0 +1,66 @@
+package transcript
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/services/transcript/types"
+)
+
+// FormatterInterface restores punctuation and capitalization on raw ASR
+// segment texts for the restore_formatting request flag
+type FormatterInterface interface {
+	RestoreFormatting(ctx context.Context, texts []string) ([]string, error)
+}
+
+// SetFormatter wires a formatting restoration service into the transcript
+// service
+func (s *Service) SetFormatter(formatter FormatterInterface) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.formatter = formatter
+}
+
+// applyFormattingRestoration restores punctuation and capitalization on an
+// ASR transcript when the request asked for it. Manual caption tracks are
+// returned untouched, and restoration failures degrade to the raw transcript
+// rather than failing the request.
+func (s *Service) applyFormattingRestoration(ctx context.Context, req *types.TranscriptRequest, transcript *types.Transcript) *types.Transcript {
+	if !req.RestoreFormatting || transcript == nil || transcript.Source != types.SourceAutoGenerated {
+		return transcript
+	}
+
+	s.mu.RLock()
+	formatter := s.formatter
+	s.mu.RUnlock()
+
+	if formatter == nil {
+		s.logger.Warn("No formatter configured for restore_formatting, returning raw transcript",
+			zap.String("video_id", transcript.VideoID))
+		return transcript
+	}
+
+	texts := make([]string, len(transcript.Segments))
+	for i, segment := range transcript.Segments {
+		texts[i] = segment.Text
+	}
+
+	restored, err := formatter.RestoreFormatting(ctx, texts)
+	if err != nil {
+		s.logger.Error("Failed to restore transcript formatting, returning raw transcript",
+			zap.String("video_id", transcript.VideoID),
+			zap.String("language", transcript.Language),
+			zap.Error(err))
+		return transcript
+	}
+
+	for i := range transcript.Segments {
+		if i < len(restored) {
+			transcript.Segments[i].Text = restored[i]
+		}
+	}
+
+	transcript.FormattingRestored = true
+	return transcript
+}
@@ -0,0 +1,269 @@
+package transcript
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/services/transcript/types"
+	"app-backend/pkg/patterns"
+)
+
+const (
+	// defaultProbeInterval is how often providers are probed when no
+	// interval is configured
+	defaultProbeInterval = time.Minute
+	// probeTimeout bounds a single provider availability check
+	probeTimeout = 10 * time.Second
+	// probeWindowSize is how many recent probe outcomes and latencies feed
+	// the rolling success rate and average latency
+	probeWindowSize = 20
+)
+
+// ProviderHealthStats is the rolling health picture of one provider, built
+// from its recent probe outcomes and circuit-breaker state
+type ProviderHealthStats struct {
+	Provider             string    `json:"provider"`
+	Healthy              bool      `json:"healthy"`
+	State                string    `json:"state"`
+	TotalProbes          uint32    `json:"total_probes"`
+	TotalFailures        uint32    `json:"total_failures"`
+	SuccessRate          float64   `json:"success_rate"`
+	ConsecutiveFailures  uint32    `json:"consecutive_failures"`
+	LastLatencyMs        int64     `json:"last_latency_ms"`
+	AvgLatencyMs         int64     `json:"avg_latency_ms"`
+	LastCheckedAt        time.Time `json:"last_checked_at"`
+	ConsecutiveSuccesses uint32    `json:"consecutive_successes"`
+}
+
+// providerProbeState is the prober's per-provider bookkeeping. The breaker
+// decides when to stop hitting a failing provider; the counters here survive
+// its state changes (the breaker resets its own counts on every transition)
+type providerProbeState struct {
+	breaker              *patterns.CircuitBreaker
+	healthy              bool
+	outcomes             []bool
+	latencies            []time.Duration
+	lastLatency          time.Duration
+	lastChecked          time.Time
+	totalProbes          uint32
+	totalFailures        uint32
+	consecutiveFailures  uint32
+	consecutiveSuccesses uint32
+}
+
+// HealthProber periodically checks every transcript provider in the
+// background, feeding the results through a circuit breaker per provider so
+// the dashboard sees rolling success rates rather than a single
+// point-in-time check
+type HealthProber struct {
+	service  *Service
+	interval time.Duration
+	logger   *zap.Logger
+
+	mu        sync.RWMutex
+	providers map[types.ProviderType]*providerProbeState
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewHealthProber creates a prober for the service's providers; a
+// non-positive interval falls back to the default
+func (s *Service) NewHealthProber(interval time.Duration) *HealthProber {
+	if interval <= 0 {
+		interval = defaultProbeInterval
+	}
+
+	return &HealthProber{
+		service:   s,
+		interval:  interval,
+		logger:    s.logger.Zap(),
+		providers: make(map[types.ProviderType]*providerProbeState),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start launches the background probe loop, probing once immediately so the
+// dashboard has data as soon as the server is up
+func (p *HealthProber) Start() {
+	go func() {
+		defer close(p.done)
+
+		p.probeAll()
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				p.probeAll()
+			case <-p.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts the probe loop and waits for an in-flight round to finish
+func (p *HealthProber) Stop() {
+	p.stopOnce.Do(func() {
+		close(p.stop)
+	})
+	<-p.done
+}
+
+// probeAll checks every enabled provider once. Providers are probed
+// concurrently so one hung availability check cannot delay the round for
+// the others.
+func (p *HealthProber) probeAll() {
+	p.service.mu.RLock()
+	providers := make(map[types.ProviderType]ProviderInterface, len(p.service.providers))
+	for providerType, provider := range p.service.providers {
+		if p.service.disabled[providerType] {
+			continue
+		}
+		providers[providerType] = provider
+	}
+	p.service.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	for providerType, provider := range providers {
+		wg.Add(1)
+		go func(providerType types.ProviderType, provider ProviderInterface) {
+			defer wg.Done()
+			p.probe(providerType, provider)
+		}(providerType, provider)
+	}
+	wg.Wait()
+}
+
+// probe runs one availability check through the provider's circuit breaker
+// and records the outcome
+func (p *HealthProber) probe(providerType types.ProviderType, provider ProviderInterface) {
+	state := p.stateFor(providerType)
+
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := state.breaker.Execute(ctx, func() error {
+		if !provider.IsAvailable(ctx) {
+			return fmt.Errorf("provider %s reports unavailable", providerType)
+		}
+		return nil
+	})
+	latency := time.Since(start)
+
+	p.mu.Lock()
+	state.healthy = err == nil
+	state.lastLatency = latency
+	state.lastChecked = time.Now()
+	state.totalProbes++
+	if err != nil {
+		state.totalFailures++
+		state.consecutiveFailures++
+		state.consecutiveSuccesses = 0
+	} else {
+		state.consecutiveSuccesses++
+		state.consecutiveFailures = 0
+	}
+	state.outcomes = append(state.outcomes, err == nil)
+	if len(state.outcomes) > probeWindowSize {
+		state.outcomes = state.outcomes[len(state.outcomes)-probeWindowSize:]
+	}
+	state.latencies = append(state.latencies, latency)
+	if len(state.latencies) > probeWindowSize {
+		state.latencies = state.latencies[len(state.latencies)-probeWindowSize:]
+	}
+	p.mu.Unlock()
+
+	if err != nil {
+		p.logger.Warn("Transcript provider probe failed",
+			zap.String("provider", string(providerType)),
+			zap.Duration("latency", latency),
+			zap.Error(err))
+	}
+}
+
+// stateFor returns the provider's probe state, creating it with a fresh
+// circuit breaker on first sight
+func (p *HealthProber) stateFor(providerType types.ProviderType) *providerProbeState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	state, ok := p.providers[providerType]
+	if !ok {
+		state = &providerProbeState{
+			breaker: patterns.NewCircuitBreaker(patterns.CircuitBreakerConfig{
+				Name: "transcript-probe-" + string(providerType),
+				// Count failures over many probe rounds before the window
+				// resets
+				Interval:         30 * p.interval,
+				FailureThreshold: 3,
+				// Re-probe an open breaker after a couple of rounds
+				Timeout: 2 * p.interval,
+				Logger:  p.logger,
+			}),
+		}
+		p.providers[providerType] = state
+	}
+	return state
+}
+
+// Snapshot returns rolling health statistics per provider, sorted by name
+// for stable dashboard output
+func (p *HealthProber) Snapshot() []ProviderHealthStats {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	stats := make([]ProviderHealthStats, 0, len(p.providers))
+	for providerType, state := range p.providers {
+		var totalLatency time.Duration
+		for _, latency := range state.latencies {
+			totalLatency += latency
+		}
+		var avgLatency time.Duration
+		if len(state.latencies) > 0 {
+			avgLatency = totalLatency / time.Duration(len(state.latencies))
+		}
+
+		successRate := 1.0
+		if len(state.outcomes) > 0 {
+			successes := 0
+			for _, ok := range state.outcomes {
+				if ok {
+					successes++
+				}
+			}
+			successRate = float64(successes) / float64(len(state.outcomes))
+		}
+
+		// GetMetrics reads the breaker without advancing its state machine,
+		// so a snapshot can never race an in-flight probe
+		stats = append(stats, ProviderHealthStats{
+			Provider:             string(providerType),
+			Healthy:              state.healthy,
+			State:                state.breaker.GetMetrics().State,
+			TotalProbes:          state.totalProbes,
+			TotalFailures:        state.totalFailures,
+			SuccessRate:          successRate,
+			ConsecutiveFailures:  state.consecutiveFailures,
+			ConsecutiveSuccesses: state.consecutiveSuccesses,
+			LastLatencyMs:        state.lastLatency.Milliseconds(),
+			AvgLatencyMs:         avgLatency.Milliseconds(),
+			LastCheckedAt:        state.lastChecked,
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Provider < stats[j].Provider
+	})
+	return stats
+}
@@ -0,0 +1,65 @@
+package transcript
+
+import (
+	"go.uber.org/zap"
+
+	"app-backend/internal/services/transcript/types"
+)
+
+// SegmentIndexerInterface receives fetched transcripts so their segments can
+// be indexed for cross-video text search, and removal notices when a video's
+// cache is invalidated.
+type SegmentIndexerInterface interface {
+	IndexTranscript(videoID, language string, segments []types.TranscriptSegment) error
+	RemoveVideo(videoID string) error
+}
+
+// SetSegmentIndexer wires a search indexer into the transcript service
+func (s *Service) SetSegmentIndexer(indexer SegmentIndexerInterface) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.segmentIndexer = indexer
+}
+
+// indexSegments hands a freshly fetched transcript to the search indexer in
+// the background; indexing failures never affect the transcript response
+func (s *Service) indexSegments(transcript *types.Transcript) {
+	s.mu.RLock()
+	indexer := s.segmentIndexer
+	s.mu.RUnlock()
+
+	if indexer == nil || transcript == nil || len(transcript.Segments) == 0 {
+		return
+	}
+	// Machine-translated variants duplicate the original in another language
+	// under the same video; the original fetch already indexed it
+	if transcript.TranslatedFrom != "" {
+		return
+	}
+
+	go func() {
+		if err := indexer.IndexTranscript(transcript.VideoID, transcript.Language, transcript.Segments); err != nil {
+			s.logger.Warn("Failed to index transcript for search",
+				zap.String("video_id", transcript.VideoID),
+				zap.String("language", transcript.Language),
+				zap.Error(err))
+		}
+	}()
+}
+
+// removeIndexedSegments drops a video from the search index alongside a cache
+// invalidation
+func (s *Service) removeIndexedSegments(videoID string) {
+	s.mu.RLock()
+	indexer := s.segmentIndexer
+	s.mu.RUnlock()
+
+	if indexer == nil {
+		return
+	}
+	if err := indexer.RemoveVideo(videoID); err != nil {
+		s.logger.Warn("Failed to remove video from search index",
+			zap.String("video_id", videoID),
+			zap.Error(err))
+	}
+}
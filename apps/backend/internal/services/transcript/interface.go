@@ -10,31 +10,45 @@ import (
 type ProviderInterface interface {
 	// GetTranscript retrieves transcript for a video
 	GetTranscript(ctx context.Context, req *types.TranscriptRequest) (*types.Transcript, error)
-	
+
 	// GetVideoID extracts video ID from YouTube URL
 	GetVideoID(url string) (string, error)
-	
+
 	// IsAvailable checks if the provider is currently available
 	IsAvailable(ctx context.Context) bool
-	
+
 	// GetProviderType returns the provider type
 	GetProviderType() types.ProviderType
-	
+
 	// GetPriority returns the provider priority (lower number = higher priority)
 	GetPriority() int
 }
 
+// LanguageListerInterface is implemented by providers that can enumerate a
+// video's caption tracks
+type LanguageListerInterface interface {
+	// GetAvailableLanguages lists the caption tracks the video offers
+	GetAvailableLanguages(ctx context.Context, videoID string) ([]types.CaptionLanguage, error)
+}
+
 // ServiceInterface defines the main transcript service contract
 type ServiceInterface interface {
 	// GetTranscript retrieves transcript using the best available provider
 	GetTranscript(ctx context.Context, req *types.TranscriptRequest) (*types.Transcript, error)
-	
+
+	// GetAvailableLanguages lists a video's caption tracks, merged and
+	// deduplicated across every provider that can enumerate them
+	GetAvailableLanguages(ctx context.Context, videoID string) ([]types.CaptionLanguage, error)
+
 	// GetTranscriptWithProvider retrieves transcript using a specific provider
 	GetTranscriptWithProvider(ctx context.Context, provider types.ProviderType, req *types.TranscriptRequest) (*types.Transcript, error)
-	
+
 	// GetAvailableProviders returns list of currently available providers
 	GetAvailableProviders(ctx context.Context) []types.ProviderType
-	
+
 	// RegisterProvider adds a new provider to the service
 	RegisterProvider(provider ProviderInterface) error
-}
\ No newline at end of file
+
+	// InvalidateTranscriptCache drops all cached transcripts for a video
+	InvalidateTranscriptCache(ctx context.Context, videoID string) error
+}
@@ -0,0 +1,130 @@
+package transcript
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/services/transcript/errors"
+	"app-backend/internal/services/transcript/types"
+)
+
+// TranslatorInterface translates transcript segment texts for the
+// auto_translate language policy
+type TranslatorInterface interface {
+	TranslateTexts(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]string, error)
+}
+
+// SetTranslator wires a translation service into the transcript service
+func (s *Service) SetTranslator(translator TranslatorInterface) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.translator = translator
+}
+
+// applyLanguagePolicy resolves a mismatch between the requested language and
+// the fetched transcript according to the request's OnLanguageMissing policy
+func (s *Service) applyLanguagePolicy(ctx context.Context, req *types.TranscriptRequest, providers []ProviderInterface, transcript *types.Transcript, fetchErr error) (*types.Transcript, error) {
+	// No policy needed when no language was requested or it was satisfied
+	if req.Language == "" || (fetchErr == nil && languageMatches(transcript.Language, req.Language)) {
+		return transcript, fetchErr
+	}
+
+	policy := req.OnLanguageMissing
+	if policy == "" {
+		policy = types.LanguagePolicyFail
+	}
+
+	switch policy {
+	case types.LanguagePolicyFallbackAny, types.LanguagePolicyAutoTranslate:
+		// Retry without the language constraint when no transcript came back
+		if fetchErr != nil {
+			anyReq := *req
+			anyReq.Language = ""
+			fallback, err := s.getTranscriptParallel(ctx, &anyReq, providers)
+			if err != nil {
+				return nil, err
+			}
+			transcript = fallback
+			if languageMatches(transcript.Language, req.Language) {
+				return transcript, nil
+			}
+		}
+
+		if policy == types.LanguagePolicyAutoTranslate {
+			return s.translateTranscript(ctx, req, transcript)
+		}
+
+		s.logger.Info("Returning fallback transcript language",
+			zap.String("video_id", transcript.VideoID),
+			zap.String("requested_language", req.Language),
+			zap.String("language", transcript.Language))
+		transcript.LanguageFallback = true
+		return transcript, nil
+
+	default:
+		// fail (and any unknown policy): surface a typed error, preserving the
+		// original provider error when nothing was fetched at all
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		return nil, errors.ErrLanguageNotAvailable
+	}
+}
+
+// translateTranscript machine-translates segment texts into the requested
+// language, falling back to the untranslated transcript when no translator
+// is configured
+func (s *Service) translateTranscript(ctx context.Context, req *types.TranscriptRequest, transcript *types.Transcript) (*types.Transcript, error) {
+	s.mu.RLock()
+	translator := s.translator
+	s.mu.RUnlock()
+
+	if translator == nil {
+		s.logger.Warn("No translator configured for auto_translate policy, returning fallback language",
+			zap.String("video_id", transcript.VideoID),
+			zap.String("requested_language", req.Language))
+		transcript.LanguageFallback = true
+		return transcript, nil
+	}
+
+	texts := make([]string, len(transcript.Segments))
+	for i, segment := range transcript.Segments {
+		texts[i] = segment.Text
+	}
+
+	translated, err := translator.TranslateTexts(ctx, texts, req.Language, transcript.Language)
+	if err != nil {
+		s.logger.Error("Failed to auto-translate transcript",
+			zap.String("video_id", transcript.VideoID),
+			zap.String("source_language", transcript.Language),
+			zap.String("target_language", req.Language),
+			zap.Error(err))
+		return nil, err
+	}
+
+	for i := range transcript.Segments {
+		if i < len(translated) {
+			transcript.Segments[i].Text = translated[i]
+		}
+	}
+
+	transcript.TranslatedFrom = transcript.Language
+	transcript.Language = req.Language
+	return transcript, nil
+}
+
+// languageMatches compares two language codes by their primary subtag,
+// so "en" matches "en-US"
+func languageMatches(got, want string) bool {
+	return baseLanguage(got) == baseLanguage(want)
+}
+
+// baseLanguage extracts the lowercase primary subtag from a language code
+func baseLanguage(code string) string {
+	if i := strings.IndexAny(code, "-_"); i >= 0 {
+		code = code[:i]
+	}
+	return strings.ToLower(code)
+}
@@ -0,0 +1,266 @@
+package innertube
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/services/transcript/errors"
+	"app-backend/internal/services/transcript/providers/timedtext"
+	"app-backend/internal/services/transcript/types"
+)
+
+// playerResponse mirrors the caption parts of the Innertube player endpoint
+type playerResponse struct {
+	Captions struct {
+		PlayerCaptionsTracklistRenderer struct {
+			CaptionTracks        []captionTrack        `json:"captionTracks"`
+			TranslationLanguages []translationLanguage `json:"translationLanguages"`
+		} `json:"playerCaptionsTracklistRenderer"`
+	} `json:"captions"`
+}
+
+// captionTrack is one caption track a video offers. Kind is "asr" for
+// auto-generated tracks; IsTranslatable marks tracks YouTube can translate
+// into any of the video's translation languages.
+type captionTrack struct {
+	BaseURL string `json:"baseUrl"`
+	Name    struct {
+		SimpleText string `json:"simpleText"`
+		Runs       []struct {
+			Text string `json:"text"`
+		} `json:"runs"`
+	} `json:"name"`
+	LanguageCode   string `json:"languageCode"`
+	Kind           string `json:"kind"`
+	IsTranslatable bool   `json:"isTranslatable"`
+}
+
+// translationLanguage is a target language YouTube can translate captions into
+type translationLanguage struct {
+	LanguageCode string `json:"languageCode"`
+	LanguageName struct {
+		SimpleText string `json:"simpleText"`
+	} `json:"languageName"`
+}
+
+// displayName returns the track's human-readable name
+func (t *captionTrack) displayName() string {
+	if t.Name.SimpleText != "" {
+		return t.Name.SimpleText
+	}
+	var builder strings.Builder
+	for _, run := range t.Name.Runs {
+		builder.WriteString(run.Text)
+	}
+	return builder.String()
+}
+
+// trackSelection is the outcome of matching a requested language against a
+// video's caption tracks
+type trackSelection struct {
+	track *captionTrack
+	// language is the code the fetched captions will actually be in
+	language string
+	// translateTo is set when the track must be translated server-side
+	// (appended as tlang), for languages with no native track
+	translateTo string
+}
+
+// fetchCaptionTracks lists a video's caption tracks via the player endpoint
+func (p *Provider) fetchCaptionTracks(ctx context.Context, videoID string) (*playerResponse, error) {
+	innertubeReq := InnertubeRequest{VideoID: videoID}
+	innertubeReq.Context.Client.ClientName = "ANDROID"
+	innertubeReq.Context.Client.ClientVersion = "17.31.35"
+	innertubeReq.Context.Client.Platform = "MOBILE"
+
+	reqBody, err := json.Marshal(innertubeReq)
+	if err != nil {
+		return nil, errors.NewProviderError("innertube", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", p.playerEndpoint, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, errors.NewProviderError("innertube", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "com.google.android.youtube/17.31.35 (Linux; U; Android 11) gzip")
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, errors.NewProviderError("innertube", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.NewProviderError("innertube", fmt.Errorf("player HTTP %d", resp.StatusCode))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.NewProviderError("innertube", err)
+	}
+
+	var player playerResponse
+	if err := json.Unmarshal(body, &player); err != nil {
+		return nil, errors.NewProviderError("innertube", err)
+	}
+
+	return &player, nil
+}
+
+// selectTrack picks the caption track best matching the requested language:
+// a manual track first, then an auto-generated one, then a base-language
+// match ("en" for "en-US"), and finally a translation track when the video
+// offers none natively. An empty language selects the first manual track.
+func selectTrack(player *playerResponse, language string) (*trackSelection, bool) {
+	tracks := player.Captions.PlayerCaptionsTracklistRenderer.CaptionTracks
+	if len(tracks) == 0 {
+		return nil, false
+	}
+
+	if language == "" {
+		track := firstManualTrack(tracks)
+		return &trackSelection{track: track, language: track.LanguageCode}, true
+	}
+
+	// Exact match, preferring manual over auto-generated
+	var asrMatch *captionTrack
+	for i := range tracks {
+		if !strings.EqualFold(tracks[i].LanguageCode, language) {
+			continue
+		}
+		if tracks[i].Kind != "asr" {
+			return &trackSelection{track: &tracks[i], language: tracks[i].LanguageCode}, true
+		}
+		if asrMatch == nil {
+			asrMatch = &tracks[i]
+		}
+	}
+	if asrMatch != nil {
+		return &trackSelection{track: asrMatch, language: asrMatch.LanguageCode}, true
+	}
+
+	// Base-language match: "en" serves "en-US" and vice versa
+	base := baseLanguage(language)
+	for i := range tracks {
+		if baseLanguage(tracks[i].LanguageCode) == base {
+			return &trackSelection{track: &tracks[i], language: tracks[i].LanguageCode}, true
+		}
+	}
+
+	// No native track: translate a translatable track when YouTube supports
+	// the requested language as a translation target
+	for _, target := range player.Captions.PlayerCaptionsTracklistRenderer.TranslationLanguages {
+		if !strings.EqualFold(target.LanguageCode, language) {
+			continue
+		}
+		for i := range tracks {
+			if tracks[i].IsTranslatable && tracks[i].Kind != "asr" {
+				return &trackSelection{track: &tracks[i], language: language, translateTo: target.LanguageCode}, true
+			}
+		}
+		for i := range tracks {
+			if tracks[i].IsTranslatable {
+				return &trackSelection{track: &tracks[i], language: language, translateTo: target.LanguageCode}, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// firstManualTrack returns the first non-ASR track, or the first track when
+// every track is auto-generated
+func firstManualTrack(tracks []captionTrack) *captionTrack {
+	for i := range tracks {
+		if tracks[i].Kind != "asr" {
+			return &tracks[i]
+		}
+	}
+	return &tracks[0]
+}
+
+// baseLanguage strips a regional suffix ("en-US" -> "en")
+func baseLanguage(code string) string {
+	if idx := strings.IndexAny(code, "-_"); idx > 0 {
+		code = code[:idx]
+	}
+	return strings.ToLower(code)
+}
+
+// fetchTrackSegments downloads the selected caption track in json3 format
+// and parses it into transcript segments
+func (p *Provider) fetchTrackSegments(ctx context.Context, selection *trackSelection) ([]types.TranscriptSegment, []byte, error) {
+	url := selection.track.BaseURL
+	separator := "?"
+	if strings.Contains(url, "?") {
+		separator = "&"
+	}
+	url += separator + "fmt=json3"
+	if selection.translateTo != "" {
+		url += "&tlang=" + selection.translateTo
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, errors.NewProviderError("innertube", err)
+	}
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, nil, errors.NewProviderError("innertube", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, errors.NewProviderError("innertube", fmt.Errorf("caption track HTTP %d", resp.StatusCode))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, errors.NewProviderError("innertube", err)
+	}
+	if len(body) == 0 {
+		return nil, nil, errors.NewProviderError("innertube", fmt.Errorf("empty caption track"))
+	}
+
+	segments, err := timedtext.ParseJSON3(body)
+	if err != nil {
+		return nil, nil, errors.NewProviderError("innertube", err)
+	}
+
+	return segments, body, nil
+}
+
+// GetAvailableLanguages enumerates the video's caption tracks, including
+// auto-generated ones, so the transcript service can report what a video
+// actually offers instead of guessing.
+func (p *Provider) GetAvailableLanguages(ctx context.Context, videoID string) ([]types.CaptionLanguage, error) {
+	player, err := p.fetchCaptionTracks(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks := player.Captions.PlayerCaptionsTracklistRenderer.CaptionTracks
+	languages := make([]types.CaptionLanguage, 0, len(tracks))
+	for i := range tracks {
+		languages = append(languages, types.CaptionLanguage{
+			Code:          tracks[i].LanguageCode,
+			Name:          tracks[i].displayName(),
+			AutoGenerated: tracks[i].Kind == "asr",
+		})
+	}
+
+	p.logger.Debug("Listed caption tracks",
+		zap.String("video_id", videoID),
+		zap.Int("tracks", len(languages)))
+
+	return languages, nil
+}
@@ -53,7 +53,7 @@ func (p *Provider) GetTranscript(ctx context.Context, req *types.TranscriptReque
 	// Get video information
 	video, err := p.client.GetVideo(videoID)
 	if err != nil {
-		p.logger.Error("Failed to get video with kkdai/youtube", 
+		p.logger.Error("Failed to get video with kkdai/youtube",
 			zap.String("video_id", videoID),
 			zap.Error(err))
 		return nil, errors.NewProviderError("kkdai_youtube", err)
@@ -68,16 +68,16 @@ func (p *Provider) GetTranscript(ctx context.Context, req *types.TranscriptReque
 	// Get transcript
 	transcript, err := p.client.GetTranscript(video, language)
 	if err != nil {
-		p.logger.Error("Failed to get transcript with kkdai/youtube", 
+		p.logger.Error("Failed to get transcript with kkdai/youtube",
 			zap.String("video_id", videoID),
 			zap.String("language", language),
 			zap.Error(err))
-		
+
 		// Check if it's the specific "transcript disabled" error
 		if err == youtube.ErrTranscriptDisabled {
 			return nil, errors.ErrTranscriptDisabled
 		}
-		
+
 		return nil, errors.NewProviderError("kkdai_youtube", err)
 	}
 
@@ -105,6 +105,25 @@ func (p *Provider) GetTranscript(ctx context.Context, req *types.TranscriptReque
 	}, nil
 }
 
+// GetAvailableLanguages lists the video's caption tracks from the player
+// metadata the library already fetches
+func (p *Provider) GetAvailableLanguages(ctx context.Context, videoID string) ([]types.CaptionLanguage, error) {
+	video, err := p.client.GetVideoContext(ctx, videoID)
+	if err != nil {
+		return nil, errors.NewProviderError("kkdai_youtube", err)
+	}
+
+	languages := make([]types.CaptionLanguage, 0, len(video.CaptionTracks))
+	for _, track := range video.CaptionTracks {
+		languages = append(languages, types.CaptionLanguage{
+			Code:          track.LanguageCode,
+			Name:          track.Name.SimpleText,
+			AutoGenerated: track.Kind == "asr",
+		})
+	}
+	return languages, nil
+}
+
 func (p *Provider) GetVideoID(url string) (string, error) {
 	patterns := []string{
 		`(?:youtube\.com/watch\?v=|youtu\.be/|youtube\.com/embed/)([a-zA-Z0-9_-]{11})`,
@@ -139,4 +158,4 @@ func (p *Provider) GetProviderType() types.ProviderType {
 
 func (p *Provider) GetPriority() int {
 	return p.priority
-}
\ No newline at end of file
+}
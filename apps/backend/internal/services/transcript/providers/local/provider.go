@@ -0,0 +1,234 @@
+// Package local serves transcripts from a configured directory of caption
+// files named by video ID, for offline deployments and deterministic demos.
+// Files are looked up as <videoID>.<language>.srt|vtt, falling back to
+// <videoID>.srt|vtt when no language-specific file exists.
+package local
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/services/transcript/captions"
+	"app-backend/internal/services/transcript/errors"
+	"app-backend/internal/services/transcript/types"
+)
+
+type Provider struct {
+	directory string
+	logger    *logger.Logger
+	priority  int
+}
+
+type Config struct {
+	Directory string `json:"directory"`
+	Priority  int    `json:"priority"`
+}
+
+func NewProvider(config *Config, logger *logger.Logger) (*Provider, error) {
+	if config.Directory == "" {
+		return nil, fmt.Errorf("caption directory is required for the local provider")
+	}
+
+	priority := config.Priority
+	if priority == 0 {
+		priority = 5 // Default priority
+	}
+
+	return &Provider{
+		directory: config.Directory,
+		logger:    logger,
+		priority:  priority,
+	}, nil
+}
+
+func (p *Provider) GetTranscript(ctx context.Context, req *types.TranscriptRequest) (*types.Transcript, error) {
+	videoID := req.VideoID
+	if videoID == "" && req.VideoURL != "" {
+		var err error
+		videoID, err = p.GetVideoID(req.VideoURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if videoID == "" {
+		return nil, errors.ErrInvalidVideoID
+	}
+
+	path, language, err := p.findCaptionFile(videoID, req.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		p.logger.Error("Failed to read caption file",
+			zap.String("video_id", videoID),
+			zap.String("path", path),
+			zap.Error(err))
+		return nil, errors.NewProviderError("local", err)
+	}
+
+	var segments []types.TranscriptSegment
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".srt":
+		segments, err = captions.ParseSRT(string(content))
+	case ".vtt":
+		segments, err = captions.ParseVTT(string(content))
+	default:
+		err = fmt.Errorf("unsupported caption format: %s", filepath.Ext(path))
+	}
+	if err != nil {
+		return nil, errors.NewProviderError("local", err)
+	}
+
+	if len(segments) == 0 {
+		return nil, errors.ErrTranscriptNotFound
+	}
+
+	return &types.Transcript{
+		VideoID:   videoID,
+		Language:  language,
+		Segments:  segments,
+		Provider:  string(types.ProviderLocal),
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// findCaptionFile resolves the caption file for a video ID, preferring a
+// language-specific file when a language was requested, then a file without
+// a language suffix, then any language available for the video
+func (p *Provider) findCaptionFile(videoID, language string) (string, string, error) {
+	extensions := []string{".srt", ".vtt"}
+
+	if language != "" {
+		for _, ext := range extensions {
+			path := filepath.Join(p.directory, videoID+"."+language+ext)
+			if fileExists(path) {
+				return path, language, nil
+			}
+		}
+	}
+
+	for _, ext := range extensions {
+		path := filepath.Join(p.directory, videoID+ext)
+		if fileExists(path) {
+			return path, fallbackLanguage(language), nil
+		}
+	}
+
+	// Any language beats no transcript at all; entries are read in sorted
+	// order so the pick is deterministic
+	entries, err := os.ReadDir(p.directory)
+	if err != nil {
+		return "", "", errors.NewProviderError("local", err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		ext := strings.ToLower(filepath.Ext(name))
+		if entry.IsDir() || (ext != ".srt" && ext != ".vtt") {
+			continue
+		}
+		if !strings.HasPrefix(name, videoID+".") {
+			continue
+		}
+		fileLanguage := strings.TrimSuffix(strings.TrimPrefix(name, videoID+"."), ext)
+		if fileLanguage == "" {
+			fileLanguage = fallbackLanguage(language)
+		}
+		return filepath.Join(p.directory, name), fileLanguage, nil
+	}
+
+	return "", "", errors.ErrTranscriptNotFound
+}
+
+// fallbackLanguage labels a caption file without a language suffix
+func fallbackLanguage(requested string) string {
+	if requested != "" {
+		return requested
+	}
+	return "en"
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// GetAvailableLanguages lists the languages of the caption files stored for
+// a video; a file without a language suffix is reported under the fallback
+// label it would be served as
+func (p *Provider) GetAvailableLanguages(_ context.Context, videoID string) ([]types.CaptionLanguage, error) {
+	entries, err := os.ReadDir(p.directory)
+	if err != nil {
+		return nil, errors.NewProviderError("local", err)
+	}
+
+	var languages []types.CaptionLanguage
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		name := entry.Name()
+		ext := strings.ToLower(filepath.Ext(name))
+		if entry.IsDir() || (ext != ".srt" && ext != ".vtt") {
+			continue
+		}
+		if name != videoID+ext && !strings.HasPrefix(name, videoID+".") {
+			continue
+		}
+
+		language := strings.TrimSuffix(strings.TrimPrefix(name, videoID), ext)
+		language = strings.TrimPrefix(language, ".")
+		if language == "" {
+			language = fallbackLanguage("")
+		}
+		if seen[language] {
+			continue
+		}
+		seen[language] = true
+		languages = append(languages, types.CaptionLanguage{Code: language})
+	}
+	return languages, nil
+}
+
+func (p *Provider) GetVideoID(url string) (string, error) {
+	patterns := []string{
+		`(?:youtube\.com/watch\?v=|youtu\.be/|youtube\.com/embed/)([a-zA-Z0-9_-]{11})`,
+		`(?:youtube\.com/v/)([a-zA-Z0-9_-]{11})`,
+	}
+
+	for _, pattern := range patterns {
+		re := regexp.MustCompile(pattern)
+		matches := re.FindStringSubmatch(url)
+		if len(matches) > 1 {
+			return matches[1], nil
+		}
+	}
+
+	// Check if it's already a video ID
+	if matched, _ := regexp.MatchString(`^[a-zA-Z0-9_-]{11}$`, url); matched {
+		return url, nil
+	}
+
+	return "", errors.NewVideoIDExtractionError(url, nil)
+}
+
+func (p *Provider) IsAvailable(ctx context.Context) bool {
+	info, err := os.Stat(p.directory)
+	return err == nil && info.IsDir()
+}
+
+func (p *Provider) GetProviderType() types.ProviderType {
+	return types.ProviderLocal
+}
+
+func (p *Provider) GetPriority() int {
+	return p.priority
+}
@@ -0,0 +1,242 @@
+// Package timedtext fetches and parses YouTube timedtext caption tracks in
+// the json3 format, which carries per-word timing for ASR tracks. Providers
+// that build segments from other sources use it to decorate their segments
+// with word-level timing on demand.
+package timedtext
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/services/transcript/types"
+)
+
+// DefaultEndpoint is the public YouTube timedtext API URL
+const DefaultEndpoint = "https://www.youtube.com/api/timedtext"
+
+// matchTolerance is how far apart a cue and a segment start may be and
+// still be treated as the same cue when attaching word timing
+const matchTolerance = time.Second
+
+// Client fetches json3 timedtext tracks for a video
+type Client struct {
+	httpClient *http.Client
+	endpoint   string
+	logger     *logger.Logger
+}
+
+// NewClient creates a timedtext client sharing the provider's HTTP client;
+// an empty endpoint uses DefaultEndpoint
+func NewClient(httpClient *http.Client, endpoint string, logger *logger.Logger) *Client {
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	return &Client{
+		httpClient: httpClient,
+		endpoint:   endpoint,
+		logger:     logger,
+	}
+}
+
+// FetchWordTimings returns the video's caption cues with per-word timing.
+// The default track is tried first; when it carries no word timing (manual
+// tracks usually don't) the ASR track is tried as a fallback.
+func (c *Client) FetchWordTimings(ctx context.Context, videoID, language string) ([]types.TranscriptSegment, error) {
+	segments, err := c.fetchTrack(ctx, videoID, language, "")
+	if err == nil && hasWordTiming(segments) {
+		return segments, nil
+	}
+
+	asrSegments, asrErr := c.fetchTrack(ctx, videoID, language, "asr")
+	if asrErr == nil && hasWordTiming(asrSegments) {
+		return asrSegments, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	if asrErr != nil {
+		return nil, asrErr
+	}
+	return nil, fmt.Errorf("no word-level timing available for video %s", videoID)
+}
+
+// fetchTrack downloads one json3 timedtext track
+func (c *Client) fetchTrack(ctx context.Context, videoID, language, kind string) ([]types.TranscriptSegment, error) {
+	params := neturl.Values{}
+	params.Set("v", videoID)
+	params.Set("lang", language)
+	params.Set("fmt", "json3")
+	if kind != "" {
+		params.Set("kind", kind)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.endpoint+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("timedtext HTTP %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	// The endpoint answers 200 with an empty body for unknown tracks
+	if len(body) == 0 {
+		return nil, fmt.Errorf("timedtext track not found")
+	}
+
+	segments, err := ParseJSON3(body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("Fetched timedtext track",
+		zap.String("video_id", videoID),
+		zap.String("language", language),
+		zap.String("kind", kind),
+		zap.Int("segments", len(segments)))
+
+	return segments, nil
+}
+
+// json3Document mirrors the parts of YouTube's json3 timedtext format the
+// parser needs: cues ("events") holding timed text runs ("segs")
+type json3Document struct {
+	Events []struct {
+		StartMs    int64 `json:"tStartMs"`
+		DurationMs int64 `json:"dDurationMs"`
+		Segs       []struct {
+			Text     string `json:"utf8"`
+			OffsetMs int64  `json:"tOffsetMs"`
+		} `json:"segs"`
+	} `json:"events"`
+}
+
+// ParseJSON3 parses a json3 timedtext payload into transcript segments,
+// attaching per-word timing when the track provides it. ASR tracks carry one
+// seg per word with an offset into the cue; manual tracks usually have a
+// single untimed seg and yield segments without words.
+func ParseJSON3(data []byte) ([]types.TranscriptSegment, error) {
+	var doc json3Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid json3 timedtext: %w", err)
+	}
+
+	var segments []types.TranscriptSegment
+	for _, event := range doc.Events {
+		if len(event.Segs) == 0 {
+			continue
+		}
+
+		var textBuilder strings.Builder
+		var words []types.TranscriptWord
+		for _, seg := range event.Segs {
+			textBuilder.WriteString(seg.Text)
+
+			word := strings.TrimSpace(seg.Text)
+			if word == "" {
+				continue
+			}
+			words = append(words, types.TranscriptWord{
+				Text:  word,
+				Start: time.Duration(event.StartMs+seg.OffsetMs) * time.Millisecond,
+			})
+		}
+
+		// Newline-only filler events pad rolling ASR captions
+		text := strings.TrimSpace(textBuilder.String())
+		if text == "" {
+			continue
+		}
+
+		segment := types.TranscriptSegment{
+			Text:     text,
+			Start:    time.Duration(event.StartMs) * time.Millisecond,
+			Duration: time.Duration(event.DurationMs) * time.Millisecond,
+		}
+
+		// A single seg without an offset carries no word timing
+		if len(words) > 1 || (len(words) == 1 && event.Segs[0].OffsetMs > 0) {
+			fillWordDurations(words, segment.Start+segment.Duration)
+			segment.Words = words
+		}
+
+		segments = append(segments, segment)
+	}
+
+	return segments, nil
+}
+
+// fillWordDurations derives each word's duration from the next word's start,
+// closing the last word at the cue end
+func fillWordDurations(words []types.TranscriptWord, cueEnd time.Duration) {
+	for i := range words {
+		end := cueEnd
+		if i+1 < len(words) {
+			end = words[i+1].Start
+		}
+		if end > words[i].Start {
+			words[i].Duration = end - words[i].Start
+		}
+	}
+}
+
+// AttachWords copies word timing from timedtext cues onto matching segments,
+// pairing cues and segments by start time, and reports how many segments
+// received words
+func AttachWords(segments []types.TranscriptSegment, timed []types.TranscriptSegment) int {
+	attached := 0
+	j := 0
+	for i := range segments {
+		for j < len(timed) && timed[j].Start < segments[i].Start-matchTolerance {
+			j++
+		}
+		if j >= len(timed) {
+			break
+		}
+		if len(timed[j].Words) > 0 && withinTolerance(timed[j].Start, segments[i].Start) {
+			segments[i].Words = timed[j].Words
+			attached++
+			j++
+		}
+	}
+	return attached
+}
+
+// hasWordTiming reports whether any segment carries word timing
+func hasWordTiming(segments []types.TranscriptSegment) bool {
+	for _, segment := range segments {
+		if len(segment.Words) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// withinTolerance reports whether two cue starts refer to the same cue
+func withinTolerance(a, b time.Duration) bool {
+	diff := a - b
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff <= matchTolerance
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +11,7 @@ import (
 	"google.golang.org/api/youtube/v3"
 
 	"app-backend/internal/logger"
+	"app-backend/internal/services/transcript/captions"
 	"app-backend/internal/services/transcript/errors"
 	"app-backend/internal/services/transcript/types"
 )
@@ -139,7 +139,7 @@ func (p *Provider) GetTranscript(ctx context.Context, req *types.TranscriptReque
 	srtContent := string(buf[:n])
 
 	// Parse SRT content
-	segments, err := p.parseSRT(srtContent)
+	segments, err := captions.ParseSRT(srtContent)
 	if err != nil {
 		return nil, errors.NewProviderError("youtube_api", err)
 	}
@@ -151,9 +151,30 @@ func (p *Provider) GetTranscript(ctx context.Context, req *types.TranscriptReque
 		Segments:  segments,
 		Provider:  string(types.ProviderYouTubeAPI),
 		CreatedAt: time.Now(),
+		Source:    trackKindToSource(selectedCaption.Snippet.TrackKind),
 	}, nil
 }
 
+// GetAvailableLanguages lists a video's caption tracks via the official
+// captions API
+func (p *Provider) GetAvailableLanguages(ctx context.Context, videoID string) ([]types.CaptionLanguage, error) {
+	call := p.service.Captions.List([]string{"snippet"}, videoID)
+	response, err := call.Context(ctx).Do()
+	if err != nil {
+		return nil, errors.NewProviderError("youtube_api", err)
+	}
+
+	languages := make([]types.CaptionLanguage, 0, len(response.Items))
+	for _, caption := range response.Items {
+		languages = append(languages, types.CaptionLanguage{
+			Code:          caption.Snippet.Language,
+			Name:          caption.Snippet.Name,
+			AutoGenerated: strings.EqualFold(caption.Snippet.TrackKind, "asr"),
+		})
+	}
+	return languages, nil
+}
+
 func (p *Provider) GetVideoID(url string) (string, error) {
 	patterns := []string{
 		`(?:youtube\.com/watch\?v=|youtu\.be/|youtube\.com/embed/)([a-zA-Z0-9_-]{11})`,
@@ -190,97 +211,10 @@ func (p *Provider) GetPriority() int {
 	return p.priority
 }
 
-// parseSRT parses SRT subtitle format into transcript segments
-func (p *Provider) parseSRT(content string) ([]types.TranscriptSegment, error) {
-	var segments []types.TranscriptSegment
-	
-	blocks := strings.Split(content, "\n\n")
-	for _, block := range blocks {
-		lines := strings.Split(strings.TrimSpace(block), "\n")
-		if len(lines) < 3 {
-			continue
-		}
-
-		// Parse timing line (format: 00:00:01,000 --> 00:00:04,000)
-		timingLine := lines[1]
-		times := strings.Split(timingLine, " --> ")
-		if len(times) != 2 {
-			continue
-		}
-
-		start, err := p.parseSRTTime(strings.TrimSpace(times[0]))
-		if err != nil {
-			continue
-		}
-
-		end, err := p.parseSRTTime(strings.TrimSpace(times[1]))
-		if err != nil {
-			continue
-		}
-
-		// Combine text lines
-		text := strings.Join(lines[2:], " ")
-		text = strings.TrimSpace(text)
-
-		if text != "" {
-			segments = append(segments, types.TranscriptSegment{
-				Text:     text,
-				Start:    start,
-				Duration: end - start,
-			})
-		}
+// trackKindToSource maps the YouTube caption track kind to our source values
+func trackKindToSource(trackKind string) string {
+	if trackKind == "asr" {
+		return types.SourceAutoGenerated
 	}
-
-	return segments, nil
+	return types.SourceManual
 }
-
-// parseSRTTime parses SRT time format (00:00:01,000) to time.Duration
-func (p *Provider) parseSRTTime(timeStr string) (time.Duration, error) {
-	// Replace comma with dot for milliseconds
-	timeStr = strings.Replace(timeStr, ",", ".", 1)
-	
-	parts := strings.Split(timeStr, ":")
-	if len(parts) != 3 {
-		return 0, fmt.Errorf("invalid time format: %s", timeStr)
-	}
-
-	hours, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return 0, err
-	}
-
-	minutes, err := strconv.Atoi(parts[1])
-	if err != nil {
-		return 0, err
-	}
-
-	secondsParts := strings.Split(parts[2], ".")
-	seconds, err := strconv.Atoi(secondsParts[0])
-	if err != nil {
-		return 0, err
-	}
-
-	var milliseconds int
-	if len(secondsParts) > 1 {
-		// Pad or truncate to 3 digits
-		msStr := secondsParts[1]
-		if len(msStr) > 3 {
-			msStr = msStr[:3]
-		} else {
-			for len(msStr) < 3 {
-				msStr += "0"
-			}
-		}
-		milliseconds, err = strconv.Atoi(msStr)
-		if err != nil {
-			return 0, err
-		}
-	}
-
-	duration := time.Duration(hours)*time.Hour +
-		time.Duration(minutes)*time.Minute +
-		time.Duration(seconds)*time.Second +
-		time.Duration(milliseconds)*time.Millisecond
-
-	return duration, nil
-}
\ No newline at end of file
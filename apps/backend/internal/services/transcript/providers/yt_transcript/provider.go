@@ -2,23 +2,30 @@ package yt_transcript
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/chand1012/yt_transcript"
 	"go.uber.org/zap"
 
 	"app-backend/internal/logger"
+	"app-backend/internal/requestid"
 	"app-backend/internal/services/transcript/errors"
+	"app-backend/internal/services/transcript/providers/timedtext"
 	"app-backend/internal/services/transcript/types"
 )
 
 type Provider struct {
-	logger   *logger.Logger
-	priority int
+	logger    *logger.Logger
+	priority  int
+	timedtext *timedtext.Client
 }
 
 type Config struct {
 	Priority int `json:"priority"`
+	// TimedtextEndpoint overrides the timedtext URL used for word-level
+	// timing (tests, proxies)
+	TimedtextEndpoint string `json:"timedtext_endpoint"`
 }
 
 func NewProvider(config *Config, logger *logger.Logger) *Provider {
@@ -27,9 +34,17 @@ func NewProvider(config *Config, logger *logger.Logger) *Provider {
 		priority = 2 // Default priority (lower than YouTube API)
 	}
 
+	// The yt_transcript library brings its own HTTP client; this one only
+	// serves the timedtext word-timing fetches
+	httpClient := &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: requestid.NewTransport(nil),
+	}
+
 	return &Provider{
-		logger:   logger,
-		priority: priority,
+		logger:    logger,
+		priority:  priority,
+		timedtext: timedtext.NewClient(httpClient, config.TimedtextEndpoint, logger),
 	}
 }
 
@@ -60,7 +75,7 @@ func (p *Provider) GetTranscript(ctx context.Context, req *types.TranscriptReque
 	// Fetch transcript using yt_transcript library
 	transcriptResponses, title, err := yt_transcript.FetchTranscript(videoID, language, country)
 	if err != nil {
-		p.logger.Error("Failed to fetch transcript with yt_transcript", 
+		p.logger.Error("Failed to fetch transcript with yt_transcript",
 			zap.String("video_id", videoID),
 			zap.String("language", language),
 			zap.String("country", country),
@@ -82,14 +97,34 @@ func (p *Provider) GetTranscript(ctx context.Context, req *types.TranscriptReque
 		}
 	}
 
-	return &types.Transcript{
+	transcript := &types.Transcript{
 		VideoID:   videoID,
 		Title:     title,
 		Language:  language,
 		Segments:  segments,
 		Provider:  string(types.ProviderYTTranscript),
 		CreatedAt: time.Now(),
-	}, nil
+	}
+
+	if req.Granularity == types.GranularityWord {
+		p.attachWordTimings(ctx, transcript)
+	}
+
+	return transcript, nil
+}
+
+// attachWordTimings adds per-word timing from the json3 timedtext track on a
+// best-effort basis; tracks without word timing just leave segments as-is
+func (p *Provider) attachWordTimings(ctx context.Context, transcript *types.Transcript) {
+	timed, err := p.timedtext.FetchWordTimings(ctx, transcript.VideoID, transcript.Language)
+	if err != nil {
+		p.logger.Debug("Word-level timing unavailable",
+			zap.String("video_id", transcript.VideoID),
+			zap.String("language", transcript.Language),
+			zap.Error(err))
+		return
+	}
+	timedtext.AttachWords(transcript.Segments, timed)
 }
 
 func (p *Provider) GetVideoID(url string) (string, error) {
@@ -108,4 +143,4 @@ func (p *Provider) GetProviderType() types.ProviderType {
 
 func (p *Provider) GetPriority() int {
 	return p.priority
-}
\ No newline at end of file
+}
@@ -0,0 +1,157 @@
+package transcript
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"app-backend/internal/services/transcript/types"
+)
+
+const (
+	// defaultScoringWindow is how many recent attempts inform a provider's
+	// score when no window is configured
+	defaultScoringWindow = 50
+	// neutralScore is assumed for providers without any recorded attempts,
+	// so new or rarely-used providers are neither favored nor starved
+	neutralScore = 0.5
+)
+
+// Score weights: reliability dominates, result quality refines, and speed
+// breaks close calls.
+const (
+	successWeight = 0.6
+	qualityWeight = 0.3
+	latencyWeight = 0.1
+)
+
+// providerSample is one recorded provider attempt
+type providerSample struct {
+	success bool
+	latency time.Duration
+	quality float64
+}
+
+// ProviderScorer keeps a rolling window of attempt outcomes per provider and
+// derives an ordering score from success rate, segment quality, and latency.
+// Static priorities only break ties between providers that have been scoring
+// the same.
+type ProviderScorer struct {
+	window int
+
+	mu      sync.Mutex
+	samples map[types.ProviderType][]providerSample
+}
+
+// NewProviderScorer creates a scorer over a rolling window of attempts per
+// provider; a non-positive window uses the default
+func NewProviderScorer(window int) *ProviderScorer {
+	if window <= 0 {
+		window = defaultScoringWindow
+	}
+	return &ProviderScorer{
+		window:  window,
+		samples: make(map[types.ProviderType][]providerSample),
+	}
+}
+
+// Record adds one attempt's outcome to the provider's rolling window. The
+// transcript may be nil for failed attempts.
+func (s *ProviderScorer) Record(provider types.ProviderType, success bool, latency time.Duration, transcript *types.Transcript) {
+	sample := providerSample{
+		success: success,
+		latency: latency,
+		quality: segmentQuality(transcript),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	samples := append(s.samples[provider], sample)
+	if len(samples) > s.window {
+		samples = samples[len(samples)-s.window:]
+	}
+	s.samples[provider] = samples
+}
+
+// Score returns the provider's current ordering score in [0, 1]; higher is
+// better. Providers without recorded attempts score neutrally.
+func (s *ProviderScorer) Score(provider types.ProviderType) float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	samples := s.samples[provider]
+	if len(samples) == 0 {
+		return neutralScore
+	}
+
+	successes := 0
+	var totalQuality float64
+	var totalLatency time.Duration
+	for _, sample := range samples {
+		if sample.success {
+			successes++
+		}
+		totalQuality += sample.quality
+		totalLatency += sample.latency
+	}
+
+	successRate := float64(successes) / float64(len(samples))
+	avgQuality := totalQuality / float64(len(samples))
+	avgLatency := totalLatency / time.Duration(len(samples))
+	// Latency maps onto (0, 1]: instant is 1, one second is 0.5, and slower
+	// responses taper off
+	latencyScore := 1 / (1 + avgLatency.Seconds())
+
+	return successRate*successWeight + avgQuality*qualityWeight + latencyScore*latencyWeight
+}
+
+// segmentQuality grades a transcript's segments in [0, 1] from cheap
+// heuristics: segments should carry text and positive timing. A nil or empty
+// transcript scores zero.
+func segmentQuality(transcript *types.Transcript) float64 {
+	if transcript == nil || len(transcript.Segments) == 0 {
+		return 0
+	}
+
+	withText := 0
+	withTiming := 0
+	for _, segment := range transcript.Segments {
+		if segment.Text != "" {
+			withText++
+		}
+		if segment.Duration > 0 {
+			withTiming++
+		}
+	}
+
+	total := float64(len(transcript.Segments))
+	return (float64(withText) + float64(withTiming)) / (2 * total)
+}
+
+// applyAdaptiveOrder reorders providers by their observed score, best first.
+// Providers pinned in the scoring config keep their static priority and are
+// ordered ahead of the scored ones. The input is expected to already be in
+// static priority order, which the stable sort preserves for equal scores.
+func (s *Service) applyAdaptiveOrder(providers []ProviderInterface) []ProviderInterface {
+	pinned := make(map[types.ProviderType]bool)
+	for _, name := range s.config.Transcript.Scoring.Pinned {
+		pinned[types.ProviderType(name)] = true
+	}
+
+	var fixed []ProviderInterface
+	var scored []ProviderInterface
+	for _, provider := range providers {
+		if pinned[provider.GetProviderType()] {
+			fixed = append(fixed, provider)
+			continue
+		}
+		scored = append(scored, provider)
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		return s.scorer.Score(scored[i].GetProviderType()) > s.scorer.Score(scored[j].GetProviderType())
+	})
+
+	return append(fixed, scored...)
+}
@@ -0,0 +1,109 @@
+package transcript
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/services/transcript/types"
+	"app-backend/pkg/patterns"
+)
+
+// sentencePauseGap is the silence between two caption cues treated as a
+// sentence boundary even without terminal punctuation. Auto-generated tracks
+// often omit punctuation entirely, so pauses are the only boundary signal.
+const sentencePauseGap = 2 * time.Second
+
+// sentenceTerminators are the runes that end a sentence, including the
+// full-width forms used by CJK caption tracks
+const sentenceTerminators = ".!?…。！？"
+
+// trailingQuotes are closing marks that may follow a sentence terminator
+const trailingQuotes = "\"')）」』"
+
+// sentenceMergeStage merges caption cues into full sentences. Auto-generated
+// captions break mid-sentence, which makes per-segment translations poor;
+// merging on punctuation and pause gaps gives downstream consumers complete
+// sentences with aggregated timing.
+type sentenceMergeStage struct{}
+
+// Name implements patterns.Stage
+func (sentenceMergeStage) Name() string {
+	return "sentence-merge"
+}
+
+// Process implements patterns.Stage
+func (sentenceMergeStage) Process(_ context.Context, transcript *types.Transcript) (*types.Transcript, error) {
+	transcript.Segments = mergeIntoSentences(transcript.Segments)
+	transcript.SentenceSegmented = true
+	return transcript, nil
+}
+
+// mergeIntoSentences folds consecutive segments into one until a sentence
+// boundary is reached: terminal punctuation on the accumulated text, or a
+// pause before the next cue of at least sentencePauseGap. The merged segment
+// starts where its first cue started and ends where its last cue ended.
+func mergeIntoSentences(segments []types.TranscriptSegment) []types.TranscriptSegment {
+	merged := make([]types.TranscriptSegment, 0, len(segments))
+	var current *types.TranscriptSegment
+
+	for i := range segments {
+		segment := segments[i]
+		if current == nil {
+			accumulated := segment
+			current = &accumulated
+		} else {
+			current.Text = strings.TrimSpace(current.Text) + " " + strings.TrimSpace(segment.Text)
+			current.Duration = segment.Start + segment.Duration - current.Start
+			current.Words = append(current.Words, segment.Words...)
+		}
+
+		pauseBoundary := false
+		if i+1 < len(segments) {
+			pauseBoundary = segments[i+1].Start-(segment.Start+segment.Duration) >= sentencePauseGap
+		}
+
+		if endsSentence(current.Text) || pauseBoundary || i == len(segments)-1 {
+			merged = append(merged, *current)
+			current = nil
+		}
+	}
+
+	return merged
+}
+
+// endsSentence reports whether the text ends with terminal punctuation,
+// ignoring trailing whitespace and closing quotes
+func endsSentence(text string) bool {
+	trimmed := strings.TrimRight(strings.TrimSpace(text), trailingQuotes)
+	if trimmed == "" {
+		return false
+	}
+	runes := []rune(trimmed)
+	return strings.ContainsRune(sentenceTerminators, runes[len(runes)-1])
+}
+
+// applySentenceSegmentation re-chunks the transcript into full sentences when
+// the request asked for it. Runs after formatting restoration so restored
+// punctuation informs the boundaries, and before caching so the re-chunked
+// version is reused.
+func (s *Service) applySentenceSegmentation(ctx context.Context, req *types.TranscriptRequest, transcript *types.Transcript) *types.Transcript {
+	if req.Segmentation != types.SegmentationSentence || transcript == nil || len(transcript.Segments) == 0 {
+		return transcript
+	}
+
+	pipeline := patterns.NewPipeline[*types.Transcript](s.logger.Zap()).
+		AddStage(sentenceMergeStage{})
+
+	result, err := pipeline.Execute(ctx, transcript)
+	if err != nil {
+		s.logger.Error("Failed to re-chunk transcript into sentences, returning cue segments",
+			zap.String("video_id", transcript.VideoID),
+			zap.Error(err))
+		return transcript
+	}
+
+	return result
+}
@@ -2,8 +2,11 @@ package transcript
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
+	"math/rand"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -11,26 +14,65 @@ import (
 
 	"app-backend/internal/config"
 	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/requestid"
+	"app-backend/internal/services/transcript/cache"
 	"app-backend/internal/services/transcript/errors"
 	"app-backend/internal/services/transcript/providers/innertube"
 	"app-backend/internal/services/transcript/providers/kkdai_youtube"
-	"app-backend/internal/services/transcript/providers/yt_transcript"
+	"app-backend/internal/services/transcript/providers/local"
 	"app-backend/internal/services/transcript/providers/youtube_api"
+	"app-backend/internal/services/transcript/providers/yt_transcript"
 	"app-backend/internal/services/transcript/types"
+	"app-backend/pkg/patterns"
 )
 
 type Service struct {
 	providers map[types.ProviderType]ProviderInterface
-	config    *config.Config
-	logger    *logger.Logger
-	mu        sync.RWMutex
+	// disabled holds providers turned off via config at runtime; they stay
+	// registered so re-enabling them needs no re-initialization
+	disabled        map[types.ProviderType]bool
+	config          *config.Config
+	logger          *logger.Logger
+	cache           cache.CacheInterface
+	outcomeRecorder OutcomeRecorderInterface
+	translator      TranslatorInterface
+	formatter       FormatterInterface
+	segmentIndexer  SegmentIndexerInterface
+	breakers        providerBreakers
+	// flights coalesces concurrent requests for the same video and language
+	// so they share one provider fetch
+	flights *patterns.SingleFlight[string, *types.Transcript]
+	// quotaLow reports that the YouTube Data API quota budget is nearly
+	// spent, demoting the official API provider behind the scrapers
+	quotaLow func() bool
+	// scorer adaptively reorders providers by observed results; nil keeps
+	// the static priority ordering
+	scorer *ProviderScorer
+	mu     sync.RWMutex
 }
 
 func NewService(config *config.Config, logger *logger.Logger) (*Service, error) {
 	service := &Service{
 		providers: make(map[types.ProviderType]ProviderInterface),
+		disabled:  make(map[types.ProviderType]bool),
 		config:    config,
 		logger:    logger,
+		flights:   patterns.NewSingleFlight[string, *types.Transcript](),
+	}
+
+	// Initialize transcript cache if enabled
+	if config.Transcript.Cache.Enabled {
+		service.cache = cache.NewMemoryCache(cache.Config{
+			MaxAge:            config.Transcript.Cache.MaxAgeDuration(),
+			StaleAfter:        config.Transcript.Cache.StaleAfterDuration(),
+			ServeStaleOnError: config.Transcript.Cache.ServeStaleOnError,
+		}, logger)
+	}
+
+	// Adaptive provider ordering from observed results, if enabled
+	if config.Transcript.Scoring.Enabled {
+		service.scorer = NewProviderScorer(config.Transcript.Scoring.Window)
 	}
 
 	// Initialize providers based on configuration
@@ -38,6 +80,9 @@ func NewService(config *config.Config, logger *logger.Logger) (*Service, error)
 		return nil, fmt.Errorf("failed to initialize providers: %w", err)
 	}
 
+	// Honor the per-provider enable flags from config
+	service.ApplyProviderConfig(config.Transcript.Providers)
+
 	return service, nil
 }
 
@@ -78,7 +123,21 @@ func (s *Service) initializeProviders() error {
 	innertubeProvider := innertube.NewProvider(innertubeConfig, s.logger)
 	s.providers[types.ProviderInnertube] = innertubeProvider
 
-	s.logger.Info("Initialized transcript providers", 
+	// Initialize the local caption-file provider if enabled
+	if s.config.Transcript.Local.Enabled {
+		localConfig := &local.Config{
+			Directory: s.config.Transcript.Local.Directory,
+			Priority:  s.config.Transcript.Local.Priority,
+		}
+		localProvider, err := local.NewProvider(localConfig, s.logger)
+		if err != nil {
+			s.logger.Warn("Failed to initialize local captions provider", zap.Error(err))
+		} else {
+			s.providers[types.ProviderLocal] = localProvider
+		}
+	}
+
+	s.logger.Info("Initialized transcript providers",
 		zap.Int("provider_count", len(s.providers)),
 		zap.Strings("providers", s.getProviderTypes()))
 
@@ -97,143 +156,370 @@ func (s *Service) GetTranscript(ctx context.Context, req *types.TranscriptReques
 
 	// Get providers in priority order
 	providers := s.getProvidersInPriorityOrder(req.PreferredProviders)
+	if req.ForceProvider != "" {
+		// A forced provider bypasses ordering and fallback entirely
+		s.mu.RLock()
+		forced, exists := s.providers[types.ProviderType(req.ForceProvider)]
+		s.mu.RUnlock()
+		if !exists {
+			return nil, errors.ErrProviderNotAvailable
+		}
+		providers = []ProviderInterface{forced}
+	}
 	if len(providers) == 0 {
 		return nil, errors.ErrProviderNotAvailable
 	}
 
-	// Use parallel approach for better performance
-	return s.getTranscriptParallel(ctx, req, providers)
+	fetch := func(fetchCtx context.Context) (*types.Transcript, error) {
+		// Use parallel approach for better performance
+		transcript, err := s.getTranscriptParallel(fetchCtx, req, providers)
+		transcript, err = s.applyLanguagePolicy(fetchCtx, req, providers, transcript, err)
+		if err != nil {
+			return nil, err
+		}
+		// Restore formatting before caching so the restored version is reused
+		transcript = s.applyFormattingRestoration(fetchCtx, req, transcript)
+		transcript = s.applySentenceSegmentation(fetchCtx, req, transcript)
+		s.indexSegments(transcript)
+		return transcript, nil
+	}
+
+	// A forced provider must actually be exercised, so the cache and the
+	// in-flight coalescing (whose keys ignore the provider) are bypassed
+	if req.ForceProvider != "" {
+		transcript, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return applySegmentWindow(transcript, req), nil
+	}
+
+	serve := func() (*types.Transcript, error) {
+		// Serve from cache when possible; stale entries are returned
+		// immediately while a background refresh re-fetches them
+		if s.cache != nil {
+			transcript, cached, err := s.cache.GetOrFetch(ctx, cache.Key(s.cacheKeyID(req), s.cacheKeyLanguage(req)), fetch)
+			if cached && err == nil {
+				s.logger.Debug("Serving transcript from cache",
+					zap.String("video_id", req.VideoID),
+					zap.String("language", req.Language))
+				// Mark a copy so the cached entry itself stays untouched
+				hit := *transcript
+				hit.FromCache = true
+				transcript = &hit
+			}
+			return transcript, err
+		}
+
+		return fetch(ctx)
+	}
+
+	// Coalesce concurrent requests for the same video and language so only
+	// one of them goes through the cache and providers
+	transcript, shared, err := s.flights.Do(cache.Key(s.cacheKeyID(req), s.cacheKeyLanguage(req)), serve)
+	if shared {
+		s.logger.Debug("Shared in-flight transcript fetch",
+			zap.String("video_id", req.VideoID),
+			zap.String("language", req.Language))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return applySegmentWindow(transcript, req), nil
+}
+
+// applySegmentWindow returns a copy of the transcript restricted to the
+// requested time range and segment page. The range is not part of the cache
+// key: the full transcript is fetched and cached once, so successive chunked
+// reads of a long video reuse a single provider fetch.
+func applySegmentWindow(transcript *types.Transcript, req *types.TranscriptRequest) *types.Transcript {
+	if transcript == nil || (req.From <= 0 && req.To <= 0 && req.Offset <= 0 && req.Limit <= 0) {
+		return transcript
+	}
+
+	segments := transcript.Segments
+	if req.From > 0 || req.To > 0 {
+		filtered := make([]types.TranscriptSegment, 0, len(segments))
+		for _, segment := range segments {
+			if segment.Start+segment.Duration <= req.From {
+				continue
+			}
+			if req.To > 0 && segment.Start >= req.To {
+				continue
+			}
+			filtered = append(filtered, segment)
+		}
+		segments = filtered
+	}
+
+	total := len(segments)
+	if req.Offset > 0 {
+		if req.Offset >= len(segments) {
+			segments = nil
+		} else {
+			segments = segments[req.Offset:]
+		}
+	}
+	if req.Limit > 0 && req.Limit < len(segments) {
+		segments = segments[:req.Limit]
+	}
+
+	windowed := *transcript
+	windowed.Segments = segments
+	windowed.TotalSegments = total
+	return &windowed
 }
 
-// getTranscriptParallel attempts to get transcript from multiple providers concurrently
-// Returns the first successful result, respecting provider priority order
+// InvalidateTranscriptCache drops all cached transcripts for the video so the
+// next request re-fetches from the providers, and removes the video from the
+// search index. A no-op when caching is disabled.
+func (s *Service) InvalidateTranscriptCache(ctx context.Context, videoID string) error {
+	s.removeIndexedSegments(videoID)
+
+	if s.cache == nil {
+		return nil
+	}
+
+	if err := s.cache.Invalidate(ctx, videoID); err != nil {
+		return fmt.Errorf("failed to invalidate transcript cache: %w", err)
+	}
+
+	s.logger.Info("Invalidated transcript cache", zap.String("video_id", videoID))
+	return nil
+}
+
+// EnablePersistentCache layers a durable store under the in-memory cache so
+// cached transcripts survive restarts and reads keep working from memory
+// when the store is unavailable. A no-op when caching is disabled.
+func (s *Service) EnablePersistentCache(store cache.StoreInterface) {
+	memory, ok := s.cache.(*cache.MemoryCache)
+	if !ok {
+		return
+	}
+	s.cache = cache.NewFallbackCache(store, memory, s.logger)
+}
+
+// cacheKeyID returns the identifier used for cache keys, preferring the video
+// ID over the raw URL
+func (s *Service) cacheKeyID(req *types.TranscriptRequest) string {
+	if req.VideoID != "" {
+		return req.VideoID
+	}
+	return req.VideoURL
+}
+
+// cacheKeyLanguage returns the language component of the cache key; requests
+// with a non-default language-missing policy, formatting restoration, word
+// granularity, or sentence segmentation are cached separately so a fallback,
+// translated, restored, word-timed, or re-chunked transcript is never served
+// to a plain request. Raw-including requests are also cached apart so an
+// admin's raw payload never reaches other clients from the cache.
+func (s *Service) cacheKeyLanguage(req *types.TranscriptRequest) string {
+	key := req.Language
+	if req.OnLanguageMissing != "" && req.OnLanguageMissing != types.LanguagePolicyFail {
+		key += "|" + string(req.OnLanguageMissing)
+	}
+	if req.IncludeRaw {
+		key += "|raw"
+	}
+	if req.RestoreFormatting {
+		key += "|restored"
+	}
+	if req.Granularity == types.GranularityWord {
+		key += "|word"
+	}
+	if req.Segmentation == types.SegmentationSentence {
+		key += "|sentence"
+	}
+	return key
+}
+
+// getTranscriptParallel races all available providers concurrently via
+// patterns.FirstSuccess: the first provider to succeed wins and the rest
+// are cancelled. Providers are launched in priority order.
 func (s *Service) getTranscriptParallel(ctx context.Context, req *types.TranscriptRequest, providers []ProviderInterface) (*types.Transcript, error) {
-	type providerResult struct {
+	type providerSuccess struct {
 		transcript *types.Transcript
 		provider   string
-		priority   int
-		err        error
 	}
 
 	// Create context with timeout to prevent hanging on slow providers
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, 45*time.Second)
 	defer cancel()
 
-	resultChan := make(chan providerResult, len(providers))
-	var wg sync.WaitGroup
-	activeProviders := 0
-
-	// Launch goroutines for all available providers
-	for i, provider := range providers {
-		// Check if provider is available before launching goroutine
+	var available []ProviderInterface
+	for _, provider := range providers {
+		// Stop probing remaining providers once the request is cancelled
+		if err := ctxWithTimeout.Err(); err != nil {
+			return nil, err
+		}
 		if !provider.IsAvailable(ctxWithTimeout) {
-			s.logger.Warn("Provider not available", 
+			s.logger.Warn("Provider not available",
 				zap.String("provider", string(provider.GetProviderType())))
 			continue
 		}
+		available = append(available, provider)
+	}
 
-		activeProviders++
-		wg.Add(1)
-		go func(p ProviderInterface, priority int) {
-			defer wg.Done()
-			
-			s.logger.Info("Attempting to get transcript", 
-				zap.String("provider", string(p.GetProviderType())),
+	if len(available) == 0 {
+		return nil, errors.ErrProviderNotAvailable
+	}
+
+	var outcomesMu sync.Mutex
+	var outcomes []*models.ProviderOutcome
+
+	// With a configured fallback backoff, provider launches are staggered:
+	// each provider waits for its predecessor to fail, then pauses briefly
+	// before hitting the next (often shared) upstream. Without it all
+	// providers race immediately.
+	backoff := s.config.Transcript.FallbackBackoffDuration()
+	failed := make([]chan struct{}, len(available))
+	failureErrs := make([]error, len(available))
+	for i := range failed {
+		failed[i] = make(chan struct{})
+	}
+
+	fns := make([]func(context.Context) (providerSuccess, error), len(available))
+	for i, provider := range available {
+		p := provider
+		idx := i
+		attempt := func(raceCtx context.Context) (providerSuccess, error) {
+			providerName := string(p.GetProviderType())
+
+			s.logger.Info("Attempting to get transcript",
+				zap.String("provider", providerName),
 				zap.String("video_id", req.VideoID),
 				zap.String("video_url", req.VideoURL),
 				zap.String("language", req.Language))
 
-			transcript, err := p.GetTranscript(ctxWithTimeout, req)
-			
-			result := providerResult{
-				transcript: transcript,
-				provider:   string(p.GetProviderType()),
-				priority:   priority,
-				err:        err,
+			attemptStart := time.Now()
+			var transcript *types.Transcript
+			var err error
+			if breaker := s.breakerFor(p.GetProviderType()); breaker != nil {
+				err = breaker.Execute(raceCtx, func() error {
+					var callErr error
+					transcript, callErr = p.GetTranscript(raceCtx, req)
+					return callErr
+				})
+			} else {
+				transcript, err = p.GetTranscript(raceCtx, req)
 			}
 
-			select {
-			case resultChan <- result:
-			case <-ctxWithTimeout.Done():
-				s.logger.Debug("Context cancelled during result send", 
-					zap.String("provider", string(p.GetProviderType())))
-				return
+			// An open breaker means the provider was skipped without touching
+			// the upstream, so there is no outcome to record
+			if patterns.IsCircuitBreakerError(err) {
+				s.logger.Debug("Skipping provider with open circuit breaker",
+					zap.String("provider", providerName),
+					zap.String("video_id", req.VideoID))
+				return providerSuccess{}, fmt.Errorf("provider %s: %w", providerName, err)
 			}
-		}(provider, i)
-	}
 
-	if activeProviders == 0 {
-		return nil, errors.ErrProviderNotAvailable
-	}
+			// Don't record attempts that merely lost the race and were cancelled
+			if err != nil && raceCtx.Err() != nil {
+				return providerSuccess{}, err
+			}
 
-	// Close result channel when all goroutines complete
-	go func() {
-		wg.Wait()
-		close(resultChan)
-	}()
+			outcomesMu.Lock()
+			outcomes = append(outcomes, newProviderOutcome(req, providerName, err, time.Since(attemptStart)))
+			outcomesMu.Unlock()
 
-	// Collect results and find the best one (lowest priority number = highest priority)
-	var bestResult *providerResult
-	var providerErrors []string
-	var lastErr error
-	completedProviders := 0
-
-	for result := range resultChan {
-		completedProviders++
-		
-		if result.err != nil {
-			errMsg := fmt.Sprintf("Provider %s failed: %v", result.provider, result.err)
-			providerErrors = append(providerErrors, errMsg)
-			s.logger.Error("Provider failed to get transcript", 
-				zap.String("provider", result.provider),
-				zap.String("video_id", req.VideoID),
-				zap.Error(result.err))
-			lastErr = result.err
-			continue
+			if s.scorer != nil {
+				s.scorer.Record(p.GetProviderType(), err == nil, time.Since(attemptStart), transcript)
+			}
+
+			if err != nil {
+				s.logger.Error("Provider failed to get transcript",
+					zap.String("provider", providerName),
+					zap.String("video_id", req.VideoID),
+					zap.String("request_id", requestid.FromContext(raceCtx)),
+					zap.Error(err))
+				if rid := requestid.FromContext(raceCtx); rid != "" {
+					return providerSuccess{}, fmt.Errorf("provider %s (request %s): %w", providerName, rid, err)
+				}
+				return providerSuccess{}, fmt.Errorf("provider %s: %w", providerName, err)
+			}
+
+			return providerSuccess{transcript: transcript, provider: providerName}, nil
 		}
 
-		// Success - check if this is better than our current best result
-		if result.transcript != nil && (bestResult == nil || result.priority < bestResult.priority) {
-			bestResult = &result
-			
-			// If this is the highest priority provider (priority 0), return immediately
-			// This provides early termination optimization
-			if result.priority == 0 {
-				s.logger.Info("Successfully retrieved transcript from highest priority provider", 
-					zap.String("provider", result.provider),
-					zap.String("video_id", result.transcript.VideoID),
-					zap.Int("segment_count", len(result.transcript.Segments)),
-					zap.String("language", result.transcript.Language))
-				cancel() // Cancel remaining providers
-				return result.transcript, nil
+		fns[i] = func(raceCtx context.Context) (providerSuccess, error) {
+			if backoff > 0 && idx > 0 {
+				if err := s.waitForFallbackTurn(raceCtx, backoff, failed[idx-1], &failureErrs[idx-1]); err != nil {
+					return providerSuccess{}, err
+				}
+			}
+
+			success, err := attempt(raceCtx)
+			if err != nil {
+				failureErrs[idx] = err
+				close(failed[idx])
 			}
+			return success, err
 		}
 	}
 
-	// Return the best result we found
-	if bestResult != nil {
-		s.logger.Info("Successfully retrieved transcript", 
-			zap.String("provider", bestResult.provider),
-			zap.String("video_id", bestResult.transcript.VideoID),
-			zap.Int("segment_count", len(bestResult.transcript.Segments)),
-			zap.String("language", bestResult.transcript.Language),
-			zap.Int("completed_providers", completedProviders),
-			zap.Int("total_providers", activeProviders))
-		return bestResult.transcript, nil
+	winner, err := patterns.FirstSuccess(ctxWithTimeout, fns)
+	if err != nil {
+		outcomesMu.Lock()
+		recorded := outcomes
+		outcomes = nil
+		outcomesMu.Unlock()
+		s.recordOutcomes(recorded)
+
+		// Log summary of all failures
+		s.logger.Error("All transcript providers failed",
+			zap.String("video_id", req.VideoID),
+			zap.Int("total_providers", len(available)),
+			zap.Error(err))
+		return nil, err
 	}
 
-	// Log summary of all failures
-	s.logger.Error("All transcript providers failed", 
-		zap.String("video_id", req.VideoID),
-		zap.Strings("provider_errors", providerErrors),
-		zap.Int("completed_providers", completedProviders),
-		zap.Int("total_providers", activeProviders))
+	outcomesMu.Lock()
+	for _, outcome := range outcomes {
+		if outcome.Provider == winner.provider && outcome.Success {
+			outcome.Served = true
+			break
+		}
+	}
+	recorded := outcomes
+	outcomes = nil
+	outcomesMu.Unlock()
+	s.recordOutcomes(recorded)
+
+	s.logger.Info("Successfully retrieved transcript",
+		zap.String("provider", winner.provider),
+		zap.String("video_id", winner.transcript.VideoID),
+		zap.Int("segment_count", len(winner.transcript.Segments)),
+		zap.String("language", winner.transcript.Language),
+		zap.Int("total_providers", len(available)))
+	return winner.transcript, nil
+}
 
-	if lastErr != nil {
-		return nil, lastErr
+// waitForFallbackTurn blocks until the previous provider has failed, then
+// pauses for a jittered backoff before the next attempt touches the (often
+// shared) upstream. The pause is skipped when the predecessor failed fast
+// because it was not available or its circuit breaker was open, since those
+// attempts never reached the upstream. Cancellation aborts the wait.
+func (s *Service) waitForFallbackTurn(ctx context.Context, backoff time.Duration, prevFailed <-chan struct{}, prevErr *error) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-prevFailed:
+	}
+
+	if goerrors.Is(*prevErr, errors.ErrProviderNotAvailable) || patterns.IsCircuitBreakerError(*prevErr) {
+		return nil
 	}
 
-	return nil, errors.ErrAllProvidersFailed
+	// Jitter in [backoff/2, backoff*3/2) so concurrent requests spread out
+	delay := backoff/2 + time.Duration(rand.Int63n(int64(backoff)))
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+	}
+
+	return nil
 }
 
 func (s *Service) GetTranscriptWithProvider(ctx context.Context, providerType types.ProviderType, req *types.TranscriptRequest) (*types.Transcript, error) {
@@ -258,7 +544,7 @@ func (s *Service) GetAvailableProviders(ctx context.Context) []types.ProviderTyp
 
 	var available []types.ProviderType
 	for providerType, provider := range s.providers {
-		if provider.IsAvailable(ctx) {
+		if !s.disabled[providerType] && provider.IsAvailable(ctx) {
 			available = append(available, providerType)
 		}
 	}
@@ -266,6 +552,60 @@ func (s *Service) GetAvailableProviders(ctx context.Context) []types.ProviderTyp
 	return available
 }
 
+// GetAvailableLanguages merges the caption tracks reported by every provider
+// that can enumerate them, deduplicated by language code. A manual track
+// replaces an auto-generated listing for the same code; otherwise the
+// highest-priority provider's entry wins. Provider failures only surface
+// when no provider answered at all.
+func (s *Service) GetAvailableLanguages(ctx context.Context, videoID string) ([]types.CaptionLanguage, error) {
+	providers := s.getProvidersInPriorityOrder(nil)
+
+	var merged []types.CaptionLanguage
+	index := make(map[string]int)
+	supported := false
+	answered := false
+	var lastErr error
+
+	for _, provider := range providers {
+		lister, ok := provider.(LanguageListerInterface)
+		if !ok {
+			continue
+		}
+		supported = true
+
+		languages, err := lister.GetAvailableLanguages(ctx, videoID)
+		if err != nil {
+			s.logger.Debug("Caption track listing failed",
+				zap.String("provider", string(provider.GetProviderType())),
+				zap.String("video_id", videoID),
+				zap.Error(err))
+			lastErr = err
+			continue
+		}
+		answered = true
+
+		for _, language := range languages {
+			key := strings.ToLower(language.Code)
+			if pos, seen := index[key]; seen {
+				if merged[pos].AutoGenerated && !language.AutoGenerated {
+					merged[pos] = language
+				}
+				continue
+			}
+			index[key] = len(merged)
+			merged = append(merged, language)
+		}
+	}
+
+	if !supported {
+		return nil, errors.ErrProviderNotAvailable
+	}
+	if !answered && lastErr != nil {
+		return nil, lastErr
+	}
+	return merged, nil
+}
+
 func (s *Service) RegisterProvider(provider ProviderInterface) error {
 	if provider == nil {
 		return fmt.Errorf("provider cannot be nil")
@@ -277,15 +617,46 @@ func (s *Service) RegisterProvider(provider ProviderInterface) error {
 	providerType := provider.GetProviderType()
 	s.providers[providerType] = provider
 
-	s.logger.Info("Registered new transcript provider", 
+	s.logger.Info("Registered new transcript provider",
 		zap.String("provider", string(providerType)),
 		zap.Int("priority", provider.GetPriority()))
 
 	return nil
 }
 
+// ApplyProviderConfig enables or disables registered providers to match the
+// given configuration. Providers absent from the list keep their current
+// state; disabled providers stay registered so flipping the flag back needs
+// no re-initialization.
+func (s *Service) ApplyProviderConfig(providerConfigs []config.TranscriptProviderConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, providerConfig := range providerConfigs {
+		providerType := types.ProviderType(providerConfig.Type)
+		if _, exists := s.providers[providerType]; !exists {
+			continue
+		}
+		if s.disabled[providerType] == !providerConfig.Enabled {
+			continue
+		}
+		s.disabled[providerType] = !providerConfig.Enabled
+		s.logger.Info("Transcript provider toggled",
+			zap.String("provider", string(providerType)),
+			zap.Bool("enabled", providerConfig.Enabled))
+	}
+}
+
 // getProvidersInPriorityOrder returns providers sorted by priority
 // If preferred providers are specified, they are tried first in the order given
+// SetQuotaLowCheck wires in the YouTube Data API budget signal; when check
+// reports true, the official API provider is ordered behind the scrapers
+func (s *Service) SetQuotaLowCheck(check func() bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.quotaLow = check
+}
+
 func (s *Service) getProvidersInPriorityOrder(preferredProviders []string) []ProviderInterface {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -296,7 +667,7 @@ func (s *Service) getProvidersInPriorityOrder(preferredProviders []string) []Pro
 	// First, add preferred providers in the order specified
 	for _, preferred := range preferredProviders {
 		providerType := types.ProviderType(preferred)
-		if provider, exists := s.providers[providerType]; exists {
+		if provider, exists := s.providers[providerType]; exists && !s.disabled[providerType] {
 			result = append(result, provider)
 			usedProviders[providerType] = true
 		}
@@ -305,7 +676,7 @@ func (s *Service) getProvidersInPriorityOrder(preferredProviders []string) []Pro
 	// Then add remaining providers sorted by priority
 	var remaining []ProviderInterface
 	for providerType, provider := range s.providers {
-		if !usedProviders[providerType] {
+		if !usedProviders[providerType] && !s.disabled[providerType] {
 			remaining = append(remaining, provider)
 		}
 	}
@@ -315,10 +686,38 @@ func (s *Service) getProvidersInPriorityOrder(preferredProviders []string) []Pro
 		return remaining[i].GetPriority() < remaining[j].GetPriority()
 	})
 
+	// With scoring enabled, observed results trump the static priorities
+	// (except for pinned providers)
+	if s.scorer != nil {
+		remaining = s.applyAdaptiveOrder(remaining)
+	}
+
 	result = append(result, remaining...)
+
+	// When the Data API budget is nearly spent, keep the official API
+	// provider as a last resort so scrapers absorb the traffic
+	if s.quotaLow != nil && s.quotaLow() {
+		result = demoteProvider(result, types.ProviderYouTubeAPI)
+	}
+
 	return result
 }
 
+// demoteProvider moves the named provider to the end of the list, keeping
+// the relative order of the others
+func demoteProvider(providers []ProviderInterface, demoted types.ProviderType) []ProviderInterface {
+	reordered := make([]ProviderInterface, 0, len(providers))
+	var moved []ProviderInterface
+	for _, provider := range providers {
+		if provider.GetProviderType() == demoted {
+			moved = append(moved, provider)
+			continue
+		}
+		reordered = append(reordered, provider)
+	}
+	return append(reordered, moved...)
+}
+
 func (s *Service) getProviderTypes() []string {
 	var types []string
 	for providerType := range s.providers {
@@ -351,4 +750,4 @@ func (s *Service) HealthCheck(ctx context.Context) map[string]interface{} {
 	status["healthy"] = availableCount > 0
 
 	return status
-}
\ No newline at end of file
+}
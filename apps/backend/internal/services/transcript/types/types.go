@@ -8,41 +8,198 @@ type TranscriptSegment struct {
 	Start    time.Duration `json:"start"`
 	Duration time.Duration `json:"duration"`
 	Offset   int64         `json:"offset,omitempty"`
+	// Words carries per-word timing when the caption source provides it
+	// (json3 timedtext, typically ASR tracks) and the request asked for
+	// word granularity
+	Words []TranscriptWord `json:"words,omitempty"`
 }
 
+// TranscriptWord is the timing of one word within a segment
+type TranscriptWord struct {
+	Text     string        `json:"text"`
+	Start    time.Duration `json:"start"`
+	Duration time.Duration `json:"duration"`
+}
+
+// MaxRawContentBytes bounds the size of raw provider payloads attached for debugging
+const MaxRawContentBytes = 256 * 1024
+
 // Transcript represents the complete transcript of a video
 type Transcript struct {
-	VideoID    string               `json:"video_id"`
-	Title      string               `json:"title,omitempty"`
-	Language   string               `json:"language"`
-	Segments   []TranscriptSegment  `json:"segments"`
-	Provider   string               `json:"provider"`
-	CreatedAt  time.Time            `json:"created_at"`
+	VideoID   string              `json:"video_id"`
+	Title     string              `json:"title,omitempty"`
+	Language  string              `json:"language"`
+	Segments  []TranscriptSegment `json:"segments"`
+	Provider  string              `json:"provider"`
+	CreatedAt time.Time           `json:"created_at"`
+	// RawContent holds the raw provider payload (TTML/SRT/XML/JSON) when the
+	// request asked for it; never serialized directly
+	RawContent []byte `json:"-"`
+	// LanguageFallback marks a transcript returned in a different language
+	// than requested under the fallback_any policy
+	LanguageFallback bool `json:"language_fallback,omitempty"`
+	// TranslatedFrom records the original language when the transcript was
+	// machine-translated under the auto_translate policy
+	TranslatedFrom string `json:"translated_from,omitempty"`
+	// Stale marks a cached transcript served past its maximum age because all
+	// live providers failed (serve-stale-on-error policy)
+	Stale bool `json:"stale,omitempty"`
+	// Source records the caption track kind when the provider knows it
+	// (see SourceManual/SourceAutoGenerated)
+	Source string `json:"source,omitempty"`
+	// FormattingRestored marks an ASR transcript whose punctuation and
+	// capitalization were restored in post-processing
+	FormattingRestored bool `json:"formatting_restored,omitempty"`
+	// SentenceSegmented marks a transcript whose caption cues were merged
+	// into full sentences in post-processing
+	SentenceSegmented bool `json:"sentence_segmented,omitempty"`
+	// TotalSegments is the segment count before pagination when the request
+	// carried a time range or segment window; zero otherwise
+	TotalSegments int `json:"total_segments,omitempty"`
+	// FromCache marks a transcript served from the cache rather than a live
+	// provider fetch; surfaced as the X-Cache response header
+	FromCache bool `json:"-"`
+}
+
+// Caption track kinds as reported by providers
+const (
+	// SourceManual is a human-authored caption track
+	SourceManual = "manual"
+	// SourceAutoGenerated is an ASR (speech recognition) caption track
+	SourceAutoGenerated = "auto-generated"
+)
+
+// CaptionLanguage describes one caption track a video offers
+type CaptionLanguage struct {
+	Code string `json:"code"`
+	Name string `json:"name,omitempty"`
+	// AutoGenerated marks ASR tracks
+	AutoGenerated bool `json:"auto_generated,omitempty"`
+}
+
+// LanguageMissingPolicy controls service behavior when the requested
+// language has no transcript
+type LanguageMissingPolicy string
+
+const (
+	// LanguagePolicyFail returns a typed error when the language is unavailable
+	LanguagePolicyFail LanguageMissingPolicy = "fail"
+	// LanguagePolicyFallbackAny returns any available language, marked as a fallback
+	LanguagePolicyFallbackAny LanguageMissingPolicy = "fallback_any"
+	// LanguagePolicyAutoTranslate fetches any language and translates it to the
+	// requested one
+	LanguagePolicyAutoTranslate LanguageMissingPolicy = "auto_translate"
+)
+
+// TranscriptGranularity selects the timing detail of returned segments
+type TranscriptGranularity string
+
+const (
+	// GranularitySegment returns caption-cue timing only (the default)
+	GranularitySegment TranscriptGranularity = "segment"
+	// GranularityWord additionally returns per-word timing when the caption
+	// source provides it
+	GranularityWord TranscriptGranularity = "word"
+)
+
+// TranscriptSegmentation selects how returned segments are chunked
+type TranscriptSegmentation string
+
+const (
+	// SegmentationNone returns segments exactly as the caption track cues
+	// them (the default)
+	SegmentationNone TranscriptSegmentation = "none"
+	// SegmentationSentence merges caption cues into full sentences based on
+	// punctuation and pause gaps, aggregating their timing
+	SegmentationSentence TranscriptSegmentation = "sentence"
+)
+
+// ValidTranscriptSegmentation reports whether the given segmentation is known
+func ValidTranscriptSegmentation(segmentation TranscriptSegmentation) bool {
+	switch segmentation {
+	case SegmentationNone, SegmentationSentence:
+		return true
+	}
+	return false
+}
+
+// ValidTranscriptGranularity reports whether the given granularity is known
+func ValidTranscriptGranularity(granularity TranscriptGranularity) bool {
+	switch granularity {
+	case GranularitySegment, GranularityWord:
+		return true
+	}
+	return false
+}
+
+// ValidLanguageMissingPolicy reports whether the given policy value is known
+func ValidLanguageMissingPolicy(policy LanguageMissingPolicy) bool {
+	switch policy {
+	case LanguagePolicyFail, LanguagePolicyFallbackAny, LanguagePolicyAutoTranslate:
+		return true
+	}
+	return false
 }
 
 // TranscriptRequest represents a request for video transcript
 type TranscriptRequest struct {
-	VideoID     string `json:"video_id" validate:"required"`
-	VideoURL    string `json:"video_url,omitempty"`
-	Language    string `json:"language,omitempty"`
-	Country     string `json:"country,omitempty"`
+	VideoID            string   `json:"video_id" validate:"required"`
+	VideoURL           string   `json:"video_url,omitempty"`
+	Language           string   `json:"language,omitempty"`
+	Country            string   `json:"country,omitempty"`
 	PreferredProviders []string `json:"preferred_providers,omitempty"`
+	// ForceProvider restricts the fetch to exactly this provider, bypassing
+	// priority ordering, fallback, and the cache (a debugging aid for
+	// privileged users)
+	ForceProvider string `json:"force_provider,omitempty"`
+	// IncludeRaw asks providers to attach the raw caption payload they parsed
+	// (admin-only debugging aid)
+	IncludeRaw bool `json:"include_raw,omitempty"`
+	// OnLanguageMissing selects the policy applied when the requested language
+	// has no transcript; defaults to LanguagePolicyFail
+	OnLanguageMissing LanguageMissingPolicy `json:"on_language_missing,omitempty"`
+	// RestoreFormatting asks for punctuation and capitalization to be
+	// restored on raw ASR transcripts (asr track kind only)
+	RestoreFormatting bool `json:"restore_formatting,omitempty"`
+	// Granularity asks providers to attach per-word timing to segments when
+	// the caption source provides it; defaults to GranularitySegment
+	Granularity TranscriptGranularity `json:"granularity,omitempty"`
+	// Segmentation asks for caption cues to be re-chunked in post-processing;
+	// defaults to SegmentationNone
+	Segmentation TranscriptSegmentation `json:"segmentation,omitempty"`
+	// From and To restrict the returned segments to those overlapping the
+	// [From, To) time range; a zero To means until the end of the video
+	From time.Duration `json:"from,omitempty"`
+	To   time.Duration `json:"to,omitempty"`
+	// Offset and Limit paginate the segment list after the time-range filter;
+	// a zero Limit returns everything from Offset onwards
+	Offset int `json:"offset,omitempty"`
+	Limit  int `json:"limit,omitempty"`
+}
+
+// BoundRawContent truncates a raw provider payload to MaxRawContentBytes
+func BoundRawContent(raw []byte) []byte {
+	if len(raw) > MaxRawContentBytes {
+		return raw[:MaxRawContentBytes]
+	}
+	return raw
 }
 
 // ProviderType represents available transcript providers
 type ProviderType string
 
 const (
-	ProviderYouTubeAPI    ProviderType = "youtube_api"
-	ProviderYTTranscript  ProviderType = "yt_transcript"
-	ProviderKkdaiYouTube  ProviderType = "kkdai_youtube"
-	ProviderInnertube     ProviderType = "innertube"
+	ProviderYouTubeAPI   ProviderType = "youtube_api"
+	ProviderYTTranscript ProviderType = "yt_transcript"
+	ProviderKkdaiYouTube ProviderType = "kkdai_youtube"
+	ProviderInnertube    ProviderType = "innertube"
+	ProviderLocal        ProviderType = "local"
 )
 
 // ProviderConfig represents configuration for a transcript provider
 type ProviderConfig struct {
-	Type     ProviderType `json:"type"`
-	Enabled  bool         `json:"enabled"`
-	Priority int          `json:"priority"`
+	Type     ProviderType           `json:"type"`
+	Enabled  bool                   `json:"enabled"`
+	Priority int                    `json:"priority"`
 	Config   map[string]interface{} `json:"config,omitempty"`
-}
\ No newline at end of file
+}
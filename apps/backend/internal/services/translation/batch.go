@@ -0,0 +1,313 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"app-backend/internal/logger"
+	"app-backend/pkg/patterns"
+)
+
+// Batch job states
+const (
+	BatchJobRunning   = "running"
+	BatchJobCompleted = "completed"
+)
+
+// BatchSegmentResult is the state of one text within a batch job
+type BatchSegmentResult struct {
+	Index       int
+	Translation string
+	Error       string
+	Done        bool
+}
+
+// BatchJobSnapshot is a point-in-time view of a batch job, including every
+// segment result completed so far
+type BatchJobSnapshot struct {
+	ID              string
+	Status          string
+	SourceLang      string
+	TargetLang      string
+	Total           int
+	Completed       int
+	Failed          int
+	ProgressPercent float64
+	Results         []BatchSegmentResult
+}
+
+// BatchUpdate is one progress event pushed to batch job subscribers
+type BatchUpdate struct {
+	Segment         BatchSegmentResult
+	Completed       int
+	Total           int
+	ProgressPercent float64
+	Finished        bool
+}
+
+// BatchTranslatorInterface defines the contract for job-style batch
+// translation: a workload is enqueued once, identified by a job ID, and
+// observed through polling or a subscription
+type BatchTranslatorInterface interface {
+	// StartBatch enqueues the texts and returns the job ID immediately
+	StartBatch(texts []string, targetLang string, sourceLang string) (string, error)
+
+	// Job returns a snapshot of a batch job with per-segment results
+	Job(id string) (*BatchJobSnapshot, bool)
+
+	// Subscribe returns a channel of progress updates for a job. Already
+	// completed segments are replayed first, and the channel closes after
+	// the final update. The returned cancel function releases the
+	// subscription early.
+	Subscribe(id string) (<-chan BatchUpdate, func(), bool)
+
+	// Stop shuts down the underlying batch processor
+	Stop()
+}
+
+// batchJob tracks the progress of one enqueued workload
+type batchJob struct {
+	mu          sync.Mutex
+	id          string
+	sourceLang  string
+	targetLang  string
+	results     []BatchSegmentResult
+	completed   int
+	failed      int
+	subscribers map[chan BatchUpdate]struct{}
+}
+
+// BatchTranslator runs whole translation workloads through an async batch
+// processor, so large transcripts translate in the background instead of
+// holding a request open until it times out
+type BatchTranslator struct {
+	processor *patterns.AsyncBatchProcessor[BulkItem, string]
+	logger    *logger.Logger
+
+	mu   sync.RWMutex
+	jobs map[string]*batchJob
+}
+
+// NewBatchTranslator creates and starts a batch translator backed by the
+// given translation function
+func NewBatchTranslator(translateFn TranslateFunc, log *logger.Logger) BatchTranslatorInterface {
+	bt := &BatchTranslator{
+		logger: log,
+		jobs:   make(map[string]*batchJob),
+	}
+
+	bt.processor = patterns.NewAsyncBatchProcessor(patterns.BatchProcessorConfig{
+		BatchSize:     10,
+		FlushInterval: 100 * time.Millisecond,
+		MaxWorkers:    1,
+		Logger:        log.Zap(),
+	}, func(ctx context.Context, items []patterns.BatchItem[BulkItem]) ([]patterns.BatchResult[string], error) {
+		results := make([]patterns.BatchResult[string], len(items))
+		for i, item := range items {
+			translations, err := translateFn(ctx, []string{item.Data.Text}, item.Data.TargetLang, item.Data.SourceLang)
+			if err != nil {
+				results[i] = patterns.BatchResult[string]{ID: item.ID, Error: err}
+				continue
+			}
+			results[i] = patterns.BatchResult[string]{ID: item.ID, Data: translations[0]}
+		}
+		return results, nil
+	})
+	bt.processor.Start()
+
+	return bt
+}
+
+// StartBatch enqueues every text as one job and returns its ID immediately
+func (bt *BatchTranslator) StartBatch(texts []string, targetLang string, sourceLang string) (string, error) {
+	if len(texts) == 0 {
+		return "", fmt.Errorf("no texts provided")
+	}
+	if targetLang == "" {
+		return "", fmt.Errorf("target language is required")
+	}
+
+	job := &batchJob{
+		id:          uuid.New().String(),
+		sourceLang:  sourceLang,
+		targetLang:  targetLang,
+		results:     make([]BatchSegmentResult, len(texts)),
+		subscribers: make(map[chan BatchUpdate]struct{}),
+	}
+	for i := range job.results {
+		job.results[i].Index = i
+	}
+
+	// Submit every segment before registering the job, so a full input
+	// buffer fails the whole request instead of leaving a partial job
+	channels := make([]<-chan patterns.BatchResult[string], len(texts))
+	for i, text := range texts {
+		resultChan, err := bt.processor.SubmitAsync(patterns.BatchItem[BulkItem]{
+			ID: fmt.Sprintf("%s#%d", job.id, i),
+			Data: BulkItem{
+				ID:         job.id,
+				Text:       text,
+				SourceLang: sourceLang,
+				TargetLang: targetLang,
+			},
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to enqueue batch: %w", err)
+		}
+		channels[i] = resultChan
+	}
+
+	bt.mu.Lock()
+	bt.jobs[job.id] = job
+	bt.mu.Unlock()
+
+	for i, resultChan := range channels {
+		go bt.collectSegment(job, i, resultChan)
+	}
+
+	bt.logger.Info("Batch translation job started",
+		zap.String("jobId", job.id),
+		zap.Int("textCount", len(texts)),
+		zap.String("targetLang", targetLang))
+
+	return job.id, nil
+}
+
+// Job returns a snapshot of a batch job
+func (bt *BatchTranslator) Job(id string) (*BatchJobSnapshot, bool) {
+	bt.mu.RLock()
+	job, ok := bt.jobs[id]
+	bt.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	return job.snapshotLocked(), true
+}
+
+// Subscribe attaches a progress listener to a job, replaying already
+// completed segments first
+func (bt *BatchTranslator) Subscribe(id string) (<-chan BatchUpdate, func(), bool) {
+	bt.mu.RLock()
+	job, ok := bt.jobs[id]
+	bt.mu.RUnlock()
+	if !ok {
+		return nil, nil, false
+	}
+
+	job.mu.Lock()
+	defer job.mu.Unlock()
+
+	// Every segment is delivered exactly once plus one terminal update, so
+	// this buffer guarantees sends never block the result collector
+	updates := make(chan BatchUpdate, len(job.results)+1)
+	for _, result := range job.results {
+		if result.Done {
+			updates <- job.updateLocked(result, false)
+		}
+	}
+
+	if job.completed == len(job.results) {
+		updates <- job.updateLocked(BatchSegmentResult{Index: -1}, true)
+		close(updates)
+		return updates, func() {}, true
+	}
+
+	job.subscribers[updates] = struct{}{}
+	cancel := func() {
+		job.mu.Lock()
+		defer job.mu.Unlock()
+		if _, subscribed := job.subscribers[updates]; subscribed {
+			delete(job.subscribers, updates)
+			close(updates)
+		}
+	}
+	return updates, cancel, true
+}
+
+// Stop shuts down the underlying batch processor
+func (bt *BatchTranslator) Stop() {
+	bt.processor.Stop()
+}
+
+// collectSegment records one segment result and notifies subscribers
+func (bt *BatchTranslator) collectSegment(job *batchJob, index int, resultChan <-chan patterns.BatchResult[string]) {
+	result, ok := <-resultChan
+	if !ok {
+		// Processor shut down before the segment completed
+		return
+	}
+
+	job.mu.Lock()
+	defer job.mu.Unlock()
+
+	segment := &job.results[index]
+	segment.Done = true
+	if result.Error != nil {
+		segment.Error = result.Error.Error()
+		job.failed++
+	} else {
+		segment.Translation = result.Data
+	}
+	job.completed++
+
+	update := job.updateLocked(*segment, false)
+	for subscriber := range job.subscribers {
+		subscriber <- update
+	}
+
+	if job.completed == len(job.results) {
+		final := job.updateLocked(BatchSegmentResult{Index: -1}, true)
+		for subscriber := range job.subscribers {
+			subscriber <- final
+			close(subscriber)
+			delete(job.subscribers, subscriber)
+		}
+	}
+}
+
+// snapshotLocked builds a snapshot; the job mutex must be held
+func (j *batchJob) snapshotLocked() *BatchJobSnapshot {
+	snapshot := &BatchJobSnapshot{
+		ID:              j.id,
+		Status:          BatchJobRunning,
+		SourceLang:      j.sourceLang,
+		TargetLang:      j.targetLang,
+		Total:           len(j.results),
+		Completed:       j.completed,
+		Failed:          j.failed,
+		ProgressPercent: j.progressLocked(),
+		Results:         make([]BatchSegmentResult, len(j.results)),
+	}
+	copy(snapshot.Results, j.results)
+	if j.completed == len(j.results) {
+		snapshot.Status = BatchJobCompleted
+	}
+	return snapshot
+}
+
+// updateLocked builds a progress update; the job mutex must be held
+func (j *batchJob) updateLocked(segment BatchSegmentResult, finished bool) BatchUpdate {
+	return BatchUpdate{
+		Segment:         segment,
+		Completed:       j.completed,
+		Total:           len(j.results),
+		ProgressPercent: j.progressLocked(),
+		Finished:        finished,
+	}
+}
+
+// progressLocked computes the completion percentage; the job mutex must be held
+func (j *batchJob) progressLocked() float64 {
+	if len(j.results) == 0 {
+		return 100
+	}
+	return float64(j.completed) / float64(len(j.results)) * 100
+}
@@ -0,0 +1,181 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"app-backend/internal/logger"
+	"app-backend/pkg/patterns"
+
+	"go.uber.org/zap"
+)
+
+// BulkItem is a single text submitted for asynchronous bulk translation
+type BulkItem struct {
+	ID         string `json:"id"`
+	Text       string `json:"text"`
+	SourceLang string `json:"sourceLang"`
+	TargetLang string `json:"targetLang"`
+}
+
+// DeadLetteredItem is a bulk item that failed processing, kept with its
+// failure reason so it can be inspected and re-submitted
+type DeadLetteredItem struct {
+	Item     BulkItem  `json:"item"`
+	Reason   string    `json:"reason"`
+	FailedAt time.Time `json:"failedAt"`
+}
+
+// TranslateFunc is the translation backend used by the bulk translator
+type TranslateFunc func(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]string, error)
+
+// BulkTranslatorInterface defines the contract for asynchronous bulk
+// translation with a dead-letter queue for failed items
+type BulkTranslatorInterface interface {
+	// Submit queues an item for asynchronous translation
+	Submit(item BulkItem) error
+
+	// Result returns the translation for a job ID once it has completed
+	Result(id string) (string, bool)
+
+	// DeadLetters lists all dead-lettered items
+	DeadLetters() []DeadLetteredItem
+
+	// Retry removes an item from the dead-letter queue and re-submits it
+	Retry(id string) error
+
+	// Stop shuts down the underlying batch processor
+	Stop()
+}
+
+// BulkTranslator processes bulk translation items in batches. Items that
+// fail are dead-lettered with their failure reason, keyed by job ID.
+type BulkTranslator struct {
+	processor *patterns.BatchProcessor[BulkItem, string]
+	logger    *logger.Logger
+
+	mu          sync.RWMutex
+	deadLetters map[string]DeadLetteredItem
+	results     map[string]string
+}
+
+// NewBulkTranslator creates and starts a bulk translator backed by the given
+// translation function
+func NewBulkTranslator(translateFn TranslateFunc, log *logger.Logger) *BulkTranslator {
+	bt := &BulkTranslator{
+		logger:      log,
+		deadLetters: make(map[string]DeadLetteredItem),
+		results:     make(map[string]string),
+	}
+
+	bt.processor = patterns.NewBatchProcessor(patterns.BatchProcessorConfig{
+		BatchSize:     10,
+		FlushInterval: 100 * time.Millisecond,
+		MaxWorkers:    1,
+		Logger:        log.Zap(),
+	}, func(ctx context.Context, items []patterns.BatchItem[BulkItem]) ([]patterns.BatchResult[string], error) {
+		results := make([]patterns.BatchResult[string], len(items))
+		for i, item := range items {
+			translations, err := translateFn(ctx, []string{item.Data.Text}, item.Data.TargetLang, item.Data.SourceLang)
+			if err != nil {
+				results[i] = patterns.BatchResult[string]{ID: item.ID, Error: err}
+				continue
+			}
+			results[i] = patterns.BatchResult[string]{ID: item.ID, Data: translations[0]}
+		}
+		return results, nil
+	})
+
+	bt.processor.SetDeadLetterHandler(bt.handleDeadLetter)
+	bt.processor.Start()
+	go bt.collectResults()
+
+	return bt
+}
+
+// Submit queues an item for asynchronous translation
+func (bt *BulkTranslator) Submit(item BulkItem) error {
+	if item.ID == "" {
+		return fmt.Errorf("bulk item ID is required")
+	}
+	if item.Text == "" {
+		return fmt.Errorf("bulk item text is required")
+	}
+	if item.TargetLang == "" {
+		return fmt.Errorf("bulk item target language is required")
+	}
+
+	return bt.processor.Submit(patterns.BatchItem[BulkItem]{ID: item.ID, Data: item})
+}
+
+// Result returns the completed translation for a job ID
+func (bt *BulkTranslator) Result(id string) (string, bool) {
+	bt.mu.RLock()
+	defer bt.mu.RUnlock()
+	result, ok := bt.results[id]
+	return result, ok
+}
+
+// DeadLetters lists all dead-lettered items
+func (bt *BulkTranslator) DeadLetters() []DeadLetteredItem {
+	bt.mu.RLock()
+	defer bt.mu.RUnlock()
+
+	items := make([]DeadLetteredItem, 0, len(bt.deadLetters))
+	for _, item := range bt.deadLetters {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Retry removes an item from the dead-letter queue and re-submits it. If the
+// item fails again it is dead-lettered again.
+func (bt *BulkTranslator) Retry(id string) error {
+	bt.mu.Lock()
+	deadLetter, ok := bt.deadLetters[id]
+	if ok {
+		delete(bt.deadLetters, id)
+	}
+	bt.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("no dead-lettered item with ID %s", id)
+	}
+
+	return bt.Submit(deadLetter.Item)
+}
+
+// Stop shuts down the underlying batch processor
+func (bt *BulkTranslator) Stop() {
+	bt.processor.Stop()
+}
+
+// handleDeadLetter persists a failed item with its failure reason
+func (bt *BulkTranslator) handleDeadLetter(item patterns.BatchItem[BulkItem], err error) {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+
+	bt.deadLetters[item.ID] = DeadLetteredItem{
+		Item:     item.Data,
+		Reason:   err.Error(),
+		FailedAt: time.Now(),
+	}
+
+	bt.logger.Warn("Bulk translation item dead-lettered",
+		zap.String("jobId", item.ID),
+		zap.Error(err))
+}
+
+// collectResults drains the processor's result channel into the results map
+func (bt *BulkTranslator) collectResults() {
+	for result := range bt.processor.Results() {
+		if result.Error != nil {
+			continue
+		}
+		bt.mu.Lock()
+		bt.results[result.ID] = result.Data
+		bt.mu.Unlock()
+	}
+}
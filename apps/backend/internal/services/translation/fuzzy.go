@@ -0,0 +1,159 @@
+package translation
+
+import "strings"
+
+// defaultFuzzyMatchThreshold is the minimum similarity a stored segment must
+// reach to be served as a fuzzy translation-memory match
+const defaultFuzzyMatchThreshold = 0.75
+
+// trigramPrefilterRatio relaxes the caller's threshold for the cheap trigram
+// screen so near-threshold candidates still reach the exact Levenshtein
+// scoring pass
+const trigramPrefilterRatio = 0.5
+
+// FuzzyMatch is a stored translation whose source text approximately matches
+// a requested segment
+type FuzzyMatch struct {
+	SourceText string
+	TargetText string
+	Similarity float64
+}
+
+// SegmentMatch reports that one request segment was served from the
+// translation memory instead of the model, with the similarity between the
+// requested text and the stored source segment (1 for exact hits)
+type SegmentMatch struct {
+	Index      int
+	SourceText string
+	Similarity float64
+}
+
+// FuzzyLookup scans the entries stored for the language pair and returns the
+// one whose source text is most similar to sourceText, provided the
+// similarity reaches threshold. Candidates are screened with cheap trigram
+// overlap first; only plausible ones are scored with Levenshtein distance.
+func (m *TranslationMemory) FuzzyLookup(sourceLang, targetLang, sourceText string, threshold float64) (*FuzzyMatch, bool) {
+	if threshold <= 0 || threshold > 1 {
+		return nil, false
+	}
+
+	needle := normalizeForMatch(sourceText)
+	if needle == "" {
+		return nil, false
+	}
+	needleTrigrams := trigramSet(needle)
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var best FuzzyMatch
+	for _, key := range m.pairs[pairKey(sourceLang, targetLang)] {
+		entry := m.entries[key]
+		candidate := normalizeForMatch(entry.SourceText)
+		if candidate == "" {
+			continue
+		}
+
+		if trigramSimilarity(needleTrigrams, trigramSet(candidate)) < threshold*trigramPrefilterRatio {
+			continue
+		}
+
+		score := levenshteinSimilarity(needle, candidate)
+		if score >= threshold && score > best.Similarity {
+			best = FuzzyMatch{
+				SourceText: entry.SourceText,
+				TargetText: entry.TargetText,
+				Similarity: score,
+			}
+		}
+	}
+
+	if best.TargetText == "" {
+		return nil, false
+	}
+	return &best, true
+}
+
+// normalizeForMatch lowercases the text and collapses whitespace so matching
+// is insensitive to casing and spacing differences
+func normalizeForMatch(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+// trigramSet returns the set of character trigrams of the text, padded with
+// a leading and trailing space so short texts still produce trigrams
+func trigramSet(text string) map[string]struct{} {
+	runes := []rune(" " + text + " ")
+	set := make(map[string]struct{}, len(runes))
+	for i := 0; i+3 <= len(runes); i++ {
+		set[string(runes[i:i+3])] = struct{}{}
+	}
+	return set
+}
+
+// trigramSimilarity is the Jaccard similarity of two trigram sets
+func trigramSimilarity(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for gram := range a {
+		if _, ok := b[gram]; ok {
+			shared++
+		}
+	}
+	union := len(a) + len(b) - shared
+	return float64(shared) / float64(union)
+}
+
+// levenshteinSimilarity maps the edit distance of two texts onto a 0-1 scale,
+// where 1 means the texts are identical
+func levenshteinSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+
+	aRunes := []rune(a)
+	bRunes := []rune(b)
+	longest := len(aRunes)
+	if len(bRunes) > longest {
+		longest = len(bRunes)
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshteinDistance(aRunes, bRunes))/float64(longest)
+}
+
+// levenshteinDistance computes the edit distance with the classic two-row
+// dynamic program, keeping memory linear in the shorter text
+func levenshteinDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			best := prev[j] + 1 // deletion
+			if insert := curr[j-1] + 1; insert < best {
+				best = insert
+			}
+			if substitute := prev[j-1] + cost; substitute < best {
+				best = substitute
+			}
+			curr[j] = best
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
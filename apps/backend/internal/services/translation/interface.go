@@ -10,13 +10,61 @@ import (
 type ServiceInterface interface {
 	// TranslateTexts translates an array of texts to the target language
 	TranslateTexts(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]string, error)
-	
+
+	// TranslateTextsWithMatches translates texts and additionally reports
+	// which segments were served from the translation memory, with the
+	// similarity between the request text and the matched source segment
+	TranslateTextsWithMatches(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]string, []SegmentMatch, error)
+
+	// TranslateTextsWithGist translates texts in two passes: a gist pass over
+	// the whole chunk followed by segment translation informed by that gist.
+	// Costs an extra model call per request, so callers must opt in.
+	TranslateTextsWithGist(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]string, error)
+
+	// TranslateTextsDual translates texts in dual mode, returning a literal
+	// and a natural translation per segment
+	TranslateTextsDual(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]DualTranslation, error)
+
+	// TranslateTextsChecked translates texts, retries segments the model
+	// returned unchanged, and flags those still untranslated afterwards
+	TranslateTextsChecked(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]CheckedTranslation, error)
+
+	// TranslateTextsStream translates texts in small batches and delivers
+	// each translated segment on the returned channel as it completes
+	TranslateTextsStream(ctx context.Context, texts []string, targetLang string, sourceLang string) (<-chan StreamResult, error)
+
+	// RestoreFormatting restores punctuation and capitalization on raw ASR
+	// transcript texts without changing their wording
+	RestoreFormatting(ctx context.Context, texts []string) ([]string, error)
+
 	// DetectLanguage detects the language of the given text
 	DetectLanguage(ctx context.Context, text string) (string, error)
-	
+
+	// DetectLanguageFromSamples detects the language across multiple sampled
+	// texts, returning LanguageUndetermined when the samples disagree
+	DetectLanguageFromSamples(ctx context.Context, texts []string) (string, error)
+
+	// ExportMemory returns all translation memory entries for export
+	ExportMemory() []TranslationMemoryEntry
+
+	// ImportMemory validates and adds entries to the translation memory,
+	// returning the number of imported and skipped (duplicate) entries
+	ImportMemory(entries []TranslationMemoryEntry) (int, int, error)
+
+	// LatencyMetrics returns per-segment translation latency percentiles,
+	// split by cache hits and model calls
+	LatencyMetrics() LatencyMetricsSnapshot
+
+	// RegisterProvider adds a new translation provider to the registry
+	RegisterProvider(provider ProviderInterface) error
+
+	// GetAvailableProviders returns the names of currently available
+	// translation providers in priority order
+	GetAvailableProviders(ctx context.Context) []string
+
 	// GetSupportedLanguages returns list of supported translation languages
 	GetSupportedLanguages() []types.Language
-	
+
 	// Close closes the translation service and cleans up resources
 	Close() error
-}
\ No newline at end of file
+}
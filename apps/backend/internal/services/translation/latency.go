@@ -0,0 +1,140 @@
+package translation
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// maxLatencySamples bounds how many recent per-segment samples are kept per
+// source so the recorder's memory stays constant under load
+const maxLatencySamples = 1024
+
+// SegmentLatencyStats summarizes the per-segment latency distribution for
+// one translation source
+type SegmentLatencyStats struct {
+	Count int           `json:"count"`
+	Avg   time.Duration `json:"avg"`
+	P50   time.Duration `json:"p50"`
+	P90   time.Duration `json:"p90"`
+	P99   time.Duration `json:"p99"`
+}
+
+// LatencyMetricsSnapshot splits per-segment latency by translation source.
+// Cache hits are near-zero; model calls carry the real API latency, which is
+// what guides BatchSize and concurrency tuning.
+type LatencyMetricsSnapshot struct {
+	CacheHits  SegmentLatencyStats `json:"cacheHits"`
+	ModelCalls SegmentLatencyStats `json:"modelCalls"`
+}
+
+// LatencyRecorder tracks recent per-segment translation latencies in a
+// bounded ring buffer per source
+type LatencyRecorder struct {
+	mu         sync.Mutex
+	cacheHits  *latencyRing
+	modelCalls *latencyRing
+}
+
+// NewLatencyRecorder creates an empty latency recorder
+func NewLatencyRecorder() *LatencyRecorder {
+	return &LatencyRecorder{
+		cacheHits:  newLatencyRing(maxLatencySamples),
+		modelCalls: newLatencyRing(maxLatencySamples),
+	}
+}
+
+// RecordCacheHits records per-segment latency for segments served from cache.
+// The total lookup time is spread evenly across the segments it covered.
+func (r *LatencyRecorder) RecordCacheHits(total time.Duration, segments int) {
+	r.record(r.cacheHits, total, segments)
+}
+
+// RecordModelBatch records per-segment latency for a model call, derived as
+// batch latency divided by batch size
+func (r *LatencyRecorder) RecordModelBatch(total time.Duration, segments int) {
+	r.record(r.modelCalls, total, segments)
+}
+
+// Snapshot returns the current latency distribution per source
+func (r *LatencyRecorder) Snapshot() LatencyMetricsSnapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return LatencyMetricsSnapshot{
+		CacheHits:  r.cacheHits.stats(),
+		ModelCalls: r.modelCalls.stats(),
+	}
+}
+
+// record spreads a total duration across segments and stores one sample per
+// segment so percentiles weight batches by their size
+func (r *LatencyRecorder) record(ring *latencyRing, total time.Duration, segments int) {
+	if segments <= 0 {
+		return
+	}
+
+	perSegment := total / time.Duration(segments)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := 0; i < segments; i++ {
+		ring.add(perSegment)
+	}
+}
+
+// latencyRing is a fixed-size ring buffer of duration samples
+type latencyRing struct {
+	samples []time.Duration
+	next    int
+	full    bool
+}
+
+func newLatencyRing(size int) *latencyRing {
+	return &latencyRing{samples: make([]time.Duration, size)}
+}
+
+func (lr *latencyRing) add(sample time.Duration) {
+	lr.samples[lr.next] = sample
+	lr.next = (lr.next + 1) % len(lr.samples)
+	if lr.next == 0 {
+		lr.full = true
+	}
+}
+
+func (lr *latencyRing) stats() SegmentLatencyStats {
+	count := lr.next
+	if lr.full {
+		count = len(lr.samples)
+	}
+	if count == 0 {
+		return SegmentLatencyStats{}
+	}
+
+	sorted := make([]time.Duration, count)
+	copy(sorted, lr.samples[:count])
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var sum time.Duration
+	for _, sample := range sorted {
+		sum += sample
+	}
+
+	return SegmentLatencyStats{
+		Count: count,
+		Avg:   sum / time.Duration(count),
+		P50:   percentile(sorted, 0.50),
+		P90:   percentile(sorted, 0.90),
+		P99:   percentile(sorted, 0.99),
+	}
+}
+
+// percentile returns the value at the given rank of a sorted sample set
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	index := int(float64(len(sorted)-1) * p)
+	return sorted[index]
+}
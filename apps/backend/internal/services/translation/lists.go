@@ -0,0 +1,53 @@
+package translation
+
+import (
+	"regexp"
+)
+
+// listMarkerPattern matches segments that open with ordered numbering
+// ("1.", "2)", "(3)") or a bullet ("-", "*", "•"), capturing the marker and
+// the item body. Numbers are capped at three digits so years and timestamps
+// are not mistaken for list items.
+var listMarkerPattern = regexp.MustCompile(`^(\s*(?:\(?\d{1,3}[.)]|[-*•]))\s+(\S.*)$`)
+
+// SplitListMarker splits a list-like segment into its leading marker and the
+// item body. ok is false for segments that are not list items.
+func SplitListMarker(text string) (marker string, body string, ok bool) {
+	match := listMarkerPattern.FindStringSubmatch(text)
+	if match == nil {
+		return "", "", false
+	}
+	return match[1], match[2], true
+}
+
+// splitListMarkers separates list markers from item bodies across a batch so
+// only the bodies are sent through the model. markers holds the empty string
+// for segments that are not list items; found reports whether any segment is.
+func splitListMarkers(texts []string) (markers []string, bodies []string, found bool) {
+	markers = make([]string, len(texts))
+	bodies = make([]string, len(texts))
+	for i, text := range texts {
+		if marker, body, ok := SplitListMarker(text); ok {
+			markers[i] = marker
+			bodies[i] = body
+			found = true
+			continue
+		}
+		bodies[i] = text
+	}
+	return markers, bodies, found
+}
+
+// joinListMarkers re-attaches the markers stripped by splitListMarkers to the
+// translated bodies, guaranteeing the original numbering survives translation
+func joinListMarkers(markers []string, translations []string) []string {
+	for i, marker := range markers {
+		if i >= len(translations) {
+			break
+		}
+		if marker != "" {
+			translations[i] = marker + " " + translations[i]
+		}
+	}
+	return translations
+}
@@ -0,0 +1,137 @@
+package translation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// TranslationMemoryEntry is a single vetted translation unit, loosely
+// modelled on a TMX translation unit
+type TranslationMemoryEntry struct {
+	SourceLang string `json:"sourceLang"`
+	TargetLang string `json:"targetLang"`
+	SourceText string `json:"sourceText"`
+	TargetText string `json:"targetText"`
+}
+
+// Validate checks that the entry is complete enough to be stored
+func (e *TranslationMemoryEntry) Validate() error {
+	if strings.TrimSpace(e.SourceLang) == "" {
+		return fmt.Errorf("sourceLang is required")
+	}
+	if strings.TrimSpace(e.TargetLang) == "" {
+		return fmt.Errorf("targetLang is required")
+	}
+	if strings.TrimSpace(e.SourceText) == "" {
+		return fmt.Errorf("sourceText is required")
+	}
+	if strings.TrimSpace(e.TargetText) == "" {
+		return fmt.Errorf("targetText is required")
+	}
+	return nil
+}
+
+// TranslationMemory caches translations keyed by language pair and source
+// text so vetted translations can be exported, re-imported and served
+// without hitting the translation backend again
+type TranslationMemory struct {
+	mu      sync.RWMutex
+	entries map[string]TranslationMemoryEntry
+	// pairs indexes entry keys per language pair so fuzzy lookups only scan
+	// segments that could actually match
+	pairs map[string][]string
+}
+
+// NewTranslationMemory creates an empty translation memory
+func NewTranslationMemory() *TranslationMemory {
+	return &TranslationMemory{
+		entries: make(map[string]TranslationMemoryEntry),
+		pairs:   make(map[string][]string),
+	}
+}
+
+// pairKey identifies a language pair in the fuzzy index
+func pairKey(sourceLang, targetLang string) string {
+	return strings.ToLower(sourceLang) + "\x1f" + strings.ToLower(targetLang)
+}
+
+// memoryKey builds the lookup key for a language pair and source text
+func memoryKey(sourceLang, targetLang, sourceText string) string {
+	return pairKey(sourceLang, targetLang) + "\x1f" + sourceText
+}
+
+// Lookup returns the stored translation for the given source text, if any
+func (m *TranslationMemory) Lookup(sourceLang, targetLang, sourceText string) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entry, ok := m.entries[memoryKey(sourceLang, targetLang, sourceText)]
+	if !ok {
+		return "", false
+	}
+	return entry.TargetText, true
+}
+
+// Store adds an entry to the memory, returning false when an entry for the
+// same language pair and source text already exists
+func (m *TranslationMemory) Store(entry TranslationMemoryEntry) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := memoryKey(entry.SourceLang, entry.TargetLang, entry.SourceText)
+	if _, exists := m.entries[key]; exists {
+		return false
+	}
+	m.entries[key] = entry
+
+	pair := pairKey(entry.SourceLang, entry.TargetLang)
+	m.pairs[pair] = append(m.pairs[pair], key)
+	return true
+}
+
+// Entries returns all stored entries in a deterministic order
+func (m *TranslationMemory) Entries() []TranslationMemoryEntry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	keys := make([]string, 0, len(m.entries))
+	for key := range m.entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	entries := make([]TranslationMemoryEntry, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, m.entries[key])
+	}
+	return entries
+}
+
+// ExportMemory returns all translation memory entries for export
+func (s *Service) ExportMemory() []TranslationMemoryEntry {
+	return s.memory.Entries()
+}
+
+// ImportMemory validates the given entries and adds them to the memory,
+// skipping entries that already exist. It returns the number of imported
+// and skipped entries.
+func (s *Service) ImportMemory(entries []TranslationMemoryEntry) (int, int, error) {
+	for i, entry := range entries {
+		if err := entry.Validate(); err != nil {
+			return 0, 0, fmt.Errorf("invalid entry at index %d: %w", i, err)
+		}
+	}
+
+	imported := 0
+	skipped := 0
+	for _, entry := range entries {
+		if s.memory.Store(entry) {
+			imported++
+		} else {
+			skipped++
+		}
+	}
+	return imported, skipped, nil
+}
@@ -0,0 +1,165 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/ctxutil"
+)
+
+// ProviderInterface defines the contract for translation providers
+type ProviderInterface interface {
+	// Translate translates the texts to the target language
+	Translate(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]string, error)
+
+	// IsAvailable checks if the provider is currently available
+	IsAvailable(ctx context.Context) bool
+
+	// GetName returns the provider name
+	GetName() string
+
+	// GetPriority returns the provider priority (lower number = higher priority)
+	GetPriority() int
+}
+
+// ProviderConfig configures an optional translation provider
+type ProviderConfig struct {
+	APIKey   string
+	APIURL   string
+	Enabled  bool
+	Priority int
+}
+
+// RegisterProvider adds a new provider to the service
+func (s *Service) RegisterProvider(provider ProviderInterface) error {
+	if provider == nil {
+		return fmt.Errorf("provider cannot be nil")
+	}
+
+	s.providersMu.Lock()
+	defer s.providersMu.Unlock()
+
+	s.providers[provider.GetName()] = provider
+
+	s.logger.Info("Registered new translation provider",
+		zap.String("provider", provider.GetName()),
+		zap.Int("priority", provider.GetPriority()))
+
+	return nil
+}
+
+// GetAvailableProviders returns the names of currently available providers in
+// priority order
+func (s *Service) GetAvailableProviders(ctx context.Context) []string {
+	available := make([]string, 0)
+	for _, provider := range s.getProvidersInPriorityOrder() {
+		if provider.IsAvailable(ctx) {
+			available = append(available, provider.GetName())
+		}
+	}
+	return available
+}
+
+// getProvidersInPriorityOrder returns providers sorted by priority
+func (s *Service) getProvidersInPriorityOrder() []ProviderInterface {
+	s.providersMu.RLock()
+	defer s.providersMu.RUnlock()
+
+	providers := make([]ProviderInterface, 0, len(s.providers))
+	for _, provider := range s.providers {
+		providers = append(providers, provider)
+	}
+
+	sort.Slice(providers, func(i, j int) bool {
+		if providers[i].GetPriority() == providers[j].GetPriority() {
+			return providers[i].GetName() < providers[j].GetName()
+		}
+		return providers[i].GetPriority() < providers[j].GetPriority()
+	})
+
+	return providers
+}
+
+// translateWithProviders tries each provider in priority order until one
+// succeeds, skipping providers that report themselves unavailable. It returns
+// the translations together with the name of the provider that served them.
+func (s *Service) translateWithProviders(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]string, string, error) {
+	if override := ctxutil.ProviderOverride(ctx); override != "" {
+		return s.translateWithForcedProvider(ctx, override, texts, targetLang, sourceLang)
+	}
+
+	var lastErr error
+
+	for _, provider := range s.getProvidersInPriorityOrder() {
+		if !provider.IsAvailable(ctx) {
+			s.logger.Debug("Skipping unavailable translation provider",
+				zap.String("provider", provider.GetName()))
+			continue
+		}
+
+		translations, err := provider.Translate(ctx, texts, targetLang, sourceLang)
+		if err != nil {
+			s.logger.Warn("Translation provider failed, trying next",
+				zap.String("provider", provider.GetName()),
+				zap.Error(err))
+			lastErr = err
+			continue
+		}
+		if len(translations) != len(texts) {
+			s.logger.Warn("Translation provider returned a mismatched result count",
+				zap.String("provider", provider.GetName()),
+				zap.Int("expected", len(texts)),
+				zap.Int("got", len(translations)))
+			lastErr = fmt.Errorf("provider %s returned %d translations for %d texts",
+				provider.GetName(), len(translations), len(texts))
+			continue
+		}
+
+		recordProviderUsed(ctx, provider.GetName())
+		return translations, provider.GetName(), nil
+	}
+
+	if lastErr != nil {
+		return nil, "", fmt.Errorf("all translation providers failed: %w", lastErr)
+	}
+	return nil, "", fmt.Errorf("no translation providers available")
+}
+
+// translateWithForcedProvider serves the request through exactly the named
+// provider, without falling back on failure (a debugging aid for privileged
+// users)
+func (s *Service) translateWithForcedProvider(ctx context.Context, name string, texts []string, targetLang string, sourceLang string) ([]string, string, error) {
+	s.providersMu.RLock()
+	provider, exists := s.providers[name]
+	s.providersMu.RUnlock()
+
+	if !exists {
+		return nil, "", fmt.Errorf("translation provider %q is not registered", name)
+	}
+	if !provider.IsAvailable(ctx) {
+		return nil, "", fmt.Errorf("translation provider %q is not available", name)
+	}
+
+	translations, err := provider.Translate(ctx, texts, targetLang, sourceLang)
+	if err != nil {
+		return nil, "", fmt.Errorf("forced translation provider %q failed: %w", name, err)
+	}
+	if len(translations) != len(texts) {
+		return nil, "", fmt.Errorf("provider %s returned %d translations for %d texts",
+			name, len(translations), len(texts))
+	}
+
+	recordProviderUsed(ctx, name)
+	return translations, name, nil
+}
+
+// recordProviderUsed notes the serving provider on the request's usage
+// record, when the handler asked for one
+func recordProviderUsed(ctx context.Context, name string) {
+	if usage := ctxutil.ProviderUsageFromContext(ctx); usage != nil {
+		usage.Provider = name
+	}
+}
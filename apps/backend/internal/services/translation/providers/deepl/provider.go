@@ -0,0 +1,158 @@
+// Package deepl implements a translation provider backed by the DeepL API.
+// It is an optional fallback behind an API key configured under
+// external_apis.deepl.
+package deepl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/logger"
+)
+
+const (
+	// defaultAPIURL is the DeepL API base URL; the free tier uses
+	// api-free.deepl.com instead
+	defaultAPIURL = "https://api.deepl.com"
+	// defaultPriority places DeepL behind the Gemini provider
+	defaultPriority = 2
+
+	requestTimeout = 30 * time.Second
+)
+
+type Provider struct {
+	apiKey   string
+	apiURL   string
+	priority int
+	client   *http.Client
+	logger   *logger.Logger
+}
+
+type Config struct {
+	APIKey   string `json:"api_key"`
+	APIURL   string `json:"api_url"`
+	Priority int    `json:"priority"`
+}
+
+func NewProvider(config *Config, log *logger.Logger) (*Provider, error) {
+	if config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required for the DeepL provider")
+	}
+
+	apiURL := config.APIURL
+	if apiURL == "" {
+		apiURL = defaultAPIURL
+	}
+
+	priority := config.Priority
+	if priority <= 0 {
+		priority = defaultPriority
+	}
+
+	return &Provider{
+		apiKey:   config.APIKey,
+		apiURL:   strings.TrimSuffix(apiURL, "/"),
+		priority: priority,
+		client:   &http.Client{Timeout: requestTimeout},
+		logger:   log,
+	}, nil
+}
+
+// translateRequest is the DeepL /v2/translate request body
+type translateRequest struct {
+	Text       []string `json:"text"`
+	TargetLang string   `json:"target_lang"`
+	SourceLang string   `json:"source_lang,omitempty"`
+}
+
+// translateResponse is the DeepL /v2/translate response body
+type translateResponse struct {
+	Translations []struct {
+		DetectedSourceLanguage string `json:"detected_source_language"`
+		Text                   string `json:"text"`
+	} `json:"translations"`
+}
+
+func (p *Provider) Translate(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]string, error) {
+	body, err := json.Marshal(translateRequest{
+		Text:       texts,
+		TargetLang: apiLanguageCode(targetLang),
+		SourceLang: apiSourceCode(sourceLang),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode DeepL request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiURL+"/v2/translate", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build DeepL request: %w", err)
+	}
+	req.Header.Set("Authorization", "DeepL-Auth-Key "+p.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("DeepL request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("DeepL returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(snippet)))
+	}
+
+	var parsed translateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("failed to decode DeepL response: %w", err)
+	}
+
+	if len(parsed.Translations) != len(texts) {
+		return nil, fmt.Errorf("DeepL returned %d translations for %d texts", len(parsed.Translations), len(texts))
+	}
+
+	translations := make([]string, len(parsed.Translations))
+	for i, translation := range parsed.Translations {
+		translations[i] = translation.Text
+	}
+
+	p.logger.Debug("Translated texts with DeepL",
+		zap.Int("textCount", len(texts)),
+		zap.String("targetLang", targetLang))
+
+	return translations, nil
+}
+
+func (p *Provider) IsAvailable(ctx context.Context) bool {
+	return p.apiKey != ""
+}
+
+func (p *Provider) GetName() string {
+	return "deepl"
+}
+
+func (p *Provider) GetPriority() int {
+	return p.priority
+}
+
+// apiLanguageCode converts a language code to DeepL's uppercase form
+// ("en" -> "EN", "pt-br" -> "PT-BR")
+func apiLanguageCode(code string) string {
+	return strings.ToUpper(code)
+}
+
+// apiSourceCode converts the source language for the request; empty or "auto"
+// lets DeepL detect the language itself
+func apiSourceCode(code string) string {
+	if code == "" || strings.EqualFold(code, "auto") {
+		return ""
+	}
+	return apiLanguageCode(code)
+}
@@ -0,0 +1,154 @@
+// Package googletranslate implements a translation provider backed by the
+// Google Cloud Translation v2 API. It is an optional fallback behind an API
+// key configured under external_apis.google_translate.
+package googletranslate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/logger"
+)
+
+const (
+	defaultAPIURL = "https://translation.googleapis.com/language/translate/v2"
+	// defaultPriority places Google Cloud Translation behind Gemini and DeepL
+	defaultPriority = 3
+
+	requestTimeout = 30 * time.Second
+)
+
+type Provider struct {
+	apiKey   string
+	apiURL   string
+	priority int
+	client   *http.Client
+	logger   *logger.Logger
+}
+
+type Config struct {
+	APIKey   string `json:"api_key"`
+	APIURL   string `json:"api_url"`
+	Priority int    `json:"priority"`
+}
+
+func NewProvider(config *Config, log *logger.Logger) (*Provider, error) {
+	if config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required for the Google Cloud Translation provider")
+	}
+
+	apiURL := config.APIURL
+	if apiURL == "" {
+		apiURL = defaultAPIURL
+	}
+
+	priority := config.Priority
+	if priority <= 0 {
+		priority = defaultPriority
+	}
+
+	return &Provider{
+		apiKey:   config.APIKey,
+		apiURL:   apiURL,
+		priority: priority,
+		client:   &http.Client{Timeout: requestTimeout},
+		logger:   log,
+	}, nil
+}
+
+// translateRequest is the Translation v2 request body. Format "text" keeps
+// the API from HTML-escaping the output.
+type translateRequest struct {
+	Q      []string `json:"q"`
+	Target string   `json:"target"`
+	Source string   `json:"source,omitempty"`
+	Format string   `json:"format"`
+}
+
+// translateResponse is the Translation v2 response body
+type translateResponse struct {
+	Data struct {
+		Translations []struct {
+			TranslatedText string `json:"translatedText"`
+		} `json:"translations"`
+	} `json:"data"`
+}
+
+func (p *Provider) Translate(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]string, error) {
+	body, err := json.Marshal(translateRequest{
+		Q:      texts,
+		Target: targetLang,
+		Source: apiSourceCode(sourceLang),
+		Format: "text",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode Translation API request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiURL+"?key="+p.apiKey, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build Translation API request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Translation API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("Translation API returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(snippet)))
+	}
+
+	var parsed translateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("failed to decode Translation API response: %w", err)
+	}
+
+	if len(parsed.Data.Translations) != len(texts) {
+		return nil, fmt.Errorf("Translation API returned %d translations for %d texts",
+			len(parsed.Data.Translations), len(texts))
+	}
+
+	translations := make([]string, len(parsed.Data.Translations))
+	for i, translation := range parsed.Data.Translations {
+		translations[i] = translation.TranslatedText
+	}
+
+	p.logger.Debug("Translated texts with Google Cloud Translation",
+		zap.Int("textCount", len(texts)),
+		zap.String("targetLang", targetLang))
+
+	return translations, nil
+}
+
+func (p *Provider) IsAvailable(ctx context.Context) bool {
+	return p.apiKey != ""
+}
+
+func (p *Provider) GetName() string {
+	return "google_translate"
+}
+
+func (p *Provider) GetPriority() int {
+	return p.priority
+}
+
+// apiSourceCode converts the source language for the request; empty or "auto"
+// lets the API detect the language itself
+func apiSourceCode(code string) string {
+	if code == "" || strings.EqualFold(code, "auto") {
+		return ""
+	}
+	return code
+}
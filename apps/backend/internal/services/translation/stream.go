@@ -0,0 +1,97 @@
+package translation
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"app-backend/pkg/patterns"
+)
+
+const (
+	// streamBatchSize is how many segments are translated per model call when
+	// streaming; small batches keep the first results arriving quickly
+	streamBatchSize = 10
+	// streamFlushInterval bounds how long a partial batch waits before being
+	// translated
+	streamFlushInterval = 100 * time.Millisecond
+)
+
+// StreamResult carries one translated segment back to a streaming caller
+type StreamResult struct {
+	Index       int
+	Translation string
+	Err         error
+}
+
+// TranslateTextsStream translates the texts in small batches and delivers
+// each segment on the returned channel as its batch completes, so callers can
+// forward translations progressively instead of waiting for the full request.
+// The channel is closed once every segment has been delivered or the context
+// is cancelled.
+func (s *Service) TranslateTextsStream(ctx context.Context, texts []string, targetLang string, sourceLang string) (<-chan StreamResult, error) {
+	if err := s.validateRequestSize(texts); err != nil {
+		return nil, err
+	}
+
+	processor := patterns.NewBatchProcessor(patterns.BatchProcessorConfig{
+		BatchSize:     streamBatchSize,
+		FlushInterval: streamFlushInterval,
+		// A single worker processes batches in submission order, so segments
+		// stream back roughly in transcript order
+		MaxWorkers:   1,
+		InputBuffer:  len(texts),
+		ResultBuffer: len(texts),
+		Logger:       s.logger.Zap(),
+	}, func(batchCtx context.Context, items []patterns.BatchItem[string]) ([]patterns.BatchResult[string], error) {
+		batchTexts := make([]string, len(items))
+		for i, item := range items {
+			batchTexts[i] = item.Data
+		}
+
+		translations, err := s.TranslateTexts(batchCtx, batchTexts, targetLang, sourceLang)
+		if err != nil {
+			return nil, err
+		}
+
+		results := make([]patterns.BatchResult[string], len(items))
+		for i, item := range items {
+			results[i] = patterns.BatchResult[string]{ID: item.ID, Data: translations[i]}
+		}
+		return results, nil
+	})
+	processor.Start()
+
+	// The input buffer holds every segment, so submission cannot block
+	for i, text := range texts {
+		if err := processor.Submit(patterns.BatchItem[string]{ID: strconv.Itoa(i), Data: text}); err != nil {
+			processor.Stop()
+			return nil, err
+		}
+	}
+
+	out := make(chan StreamResult)
+	go func() {
+		defer close(out)
+		defer processor.Stop()
+
+		for received := 0; received < len(texts); received++ {
+			select {
+			case result := <-processor.Results():
+				index, err := strconv.Atoi(result.ID)
+				if err != nil {
+					continue
+				}
+				select {
+				case out <- StreamResult{Index: index, Translation: result.Data, Err: result.Error}:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
@@ -0,0 +1,140 @@
+package translation
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"app-backend/pkg/gemini"
+	"go.uber.org/zap"
+)
+
+// CheckedTranslation is a translation result annotated with whether the
+// model returned the source text unchanged after all retries
+type CheckedTranslation struct {
+	Text         string `json:"text"`
+	Untranslated bool   `json:"untranslated,omitempty"`
+}
+
+// SetModelTranslator overrides the model-backed translation path used by
+// TranslateTextsChecked, for alternate backends and tests
+func (s *Service) SetModelTranslator(fn TranslateFunc) {
+	s.modelFn = fn
+}
+
+// TranslateTextsChecked translates texts and verifies each output actually
+// differs from its input. Segments that come back unchanged are retried with
+// an explicit instruction, and segments still unchanged afterwards are
+// flagged Untranslated so clients can surface them.
+func (s *Service) TranslateTextsChecked(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]CheckedTranslation, error) {
+	if len(texts) == 0 {
+		return []CheckedTranslation{}, nil
+	}
+
+	translate := s.modelFn
+	if translate == nil {
+		translate = s.TranslateTexts
+	}
+
+	translations, err := translate(ctx, texts, targetLang, sourceLang)
+	if err != nil {
+		return nil, err
+	}
+
+	checked := make([]CheckedTranslation, len(texts))
+	for i := range texts {
+		if i < len(translations) {
+			checked[i].Text = translations[i]
+		}
+	}
+
+	// An unchanged output is expected when source and target match
+	if sameLanguage(targetLang, sourceLang) {
+		return checked, nil
+	}
+
+	unchanged := make([]int, 0)
+	for i, text := range texts {
+		if LooksUntranslated(text, checked[i].Text) {
+			unchanged = append(unchanged, i)
+		}
+	}
+
+	for attempt := 1; attempt <= s.untranslatedRetries && len(unchanged) > 0; attempt++ {
+		retryTexts := make([]string, len(unchanged))
+		for j, i := range unchanged {
+			retryTexts[j] = texts[i]
+		}
+
+		retryPrompt := gemini.BuildRetryTranslationPrompt(retryTexts, targetLang, sourceLang)
+		s.logger.Warn("Retrying segments the model returned untranslated",
+			zap.Int("count", len(unchanged)),
+			zap.Int("attempt", attempt),
+			zap.String("target_language", targetLang),
+			zap.Int("promptLength", len(retryPrompt)))
+
+		retried, err := translate(ctx, retryTexts, targetLang, sourceLang)
+		if err != nil {
+			// Keep the first-pass results; the flags below still tell the
+			// client which segments are untranslated
+			s.logger.Warn("Untranslated-segment retry failed", zap.Error(err))
+			break
+		}
+
+		still := make([]int, 0, len(unchanged))
+		for j, i := range unchanged {
+			if j < len(retried) && !LooksUntranslated(texts[i], retried[j]) {
+				checked[i].Text = retried[j]
+				continue
+			}
+			still = append(still, i)
+		}
+		unchanged = still
+	}
+
+	for _, i := range unchanged {
+		checked[i].Untranslated = true
+	}
+
+	return checked, nil
+}
+
+// LooksUntranslated reports whether a translation is effectively the source
+// text returned unchanged. Case, whitespace, and punctuation differences
+// alone do not count as a translation.
+func LooksUntranslated(source, translated string) bool {
+	normalized := normalizeComparable(source)
+	if normalized == "" {
+		return false
+	}
+	return normalizeComparable(translated) == normalized
+}
+
+// normalizeComparable lowercases the text and strips everything but letters
+// and digits so only the wording is compared
+func normalizeComparable(text string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// sameLanguage compares two language codes by their primary subtag, so
+// "en" matches "en-US". An empty source language never matches.
+func sameLanguage(targetLang, sourceLang string) bool {
+	if sourceLang == "" {
+		return false
+	}
+	return primarySubtag(targetLang) == primarySubtag(sourceLang)
+}
+
+// primarySubtag extracts the lowercase primary subtag from a language code
+func primarySubtag(code string) string {
+	if i := strings.IndexAny(code, "-_"); i >= 0 {
+		code = code[:i]
+	}
+	return strings.ToLower(code)
+}
@@ -0,0 +1,15 @@
+package usage
+
+// ServiceInterface defines the contract for the translation usage service
+type ServiceInterface interface {
+	// RecordTranslation persists one translation request for the user
+	RecordTranslation(userID uint, charCount int, targetLang, provider string)
+
+	// CheckQuota returns an AppError with status 429 when translating charCount
+	// more characters would exceed the user's daily or monthly quota
+	CheckQuota(userID uint, charCount int) error
+
+	// GetUsage reports the user's used and remaining characters for the current
+	// daily and monthly windows
+	GetUsage(userID uint) (*Usage, error)
+}
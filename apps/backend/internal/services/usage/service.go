@@ -0,0 +1,150 @@
+package usage
+
+import (
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+)
+
+// WindowUsage reports consumption against one quota window
+type WindowUsage struct {
+	CharsUsed int64
+	// CharLimit is the configured cap; 0 means unlimited
+	CharLimit int
+	// Remaining is the allowance left in the window; -1 when unlimited
+	Remaining int64
+}
+
+// Usage reports a user's translation consumption for the current windows
+type Usage struct {
+	Daily   WindowUsage
+	Monthly WindowUsage
+}
+
+// Config holds the quota limits enforced by the service
+type Config struct {
+	// DailyChars caps characters per UTC calendar day; 0 means unlimited
+	DailyChars int
+	// MonthlyChars caps characters per UTC calendar month; 0 means unlimited
+	MonthlyChars int
+}
+
+// Service tracks per-user translation usage and enforces character quotas
+type Service struct {
+	usageRepository repositories.TranslationUsageRepositoryInterface
+	config          Config
+	logger          *logger.Logger
+}
+
+// NewService creates a new translation usage service
+func NewService(usageRepository repositories.TranslationUsageRepositoryInterface, config Config, log *logger.Logger) *Service {
+	return &Service{
+		usageRepository: usageRepository,
+		config:          config,
+		logger:          log,
+	}
+}
+
+// RecordTranslation implements ServiceInterface. Recording failures are logged
+// rather than surfaced so a usage write never fails a served translation.
+func (s *Service) RecordTranslation(userID uint, charCount int, targetLang, provider string) {
+	record := &models.TranslationUsage{
+		UserID:     userID,
+		CharCount:  charCount,
+		TargetLang: targetLang,
+		Provider:   provider,
+	}
+
+	if err := s.usageRepository.Create(record); err != nil {
+		s.logger.Warn("Failed to record translation usage",
+			zap.Uint("user_id", userID),
+			zap.Int("char_count", charCount),
+			zap.Error(err))
+	}
+}
+
+// CheckQuota implements ServiceInterface
+func (s *Service) CheckQuota(userID uint, charCount int) error {
+	if s.config.DailyChars <= 0 && s.config.MonthlyChars <= 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+
+	if s.config.DailyChars > 0 {
+		used, err := s.usageRepository.SumCharsSince(userID, startOfDay(now))
+		if err != nil {
+			return errors.NewAppError("Failed to check translation quota", err, http.StatusInternalServerError)
+		}
+		if used+int64(charCount) > int64(s.config.DailyChars) {
+			return errors.NewAppError("Daily translation quota exceeded", nil, http.StatusTooManyRequests)
+		}
+	}
+
+	if s.config.MonthlyChars > 0 {
+		used, err := s.usageRepository.SumCharsSince(userID, startOfMonth(now))
+		if err != nil {
+			return errors.NewAppError("Failed to check translation quota", err, http.StatusInternalServerError)
+		}
+		if used+int64(charCount) > int64(s.config.MonthlyChars) {
+			return errors.NewAppError("Monthly translation quota exceeded", nil, http.StatusTooManyRequests)
+		}
+	}
+
+	return nil
+}
+
+// GetUsage implements ServiceInterface
+func (s *Service) GetUsage(userID uint) (*Usage, error) {
+	now := time.Now().UTC()
+
+	daily, err := s.windowUsage(userID, startOfDay(now), s.config.DailyChars)
+	if err != nil {
+		return nil, err
+	}
+
+	monthly, err := s.windowUsage(userID, startOfMonth(now), s.config.MonthlyChars)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Usage{Daily: daily, Monthly: monthly}, nil
+}
+
+// windowUsage aggregates consumption since the window start against its limit
+func (s *Service) windowUsage(userID uint, since time.Time, limit int) (WindowUsage, error) {
+	used, err := s.usageRepository.SumCharsSince(userID, since)
+	if err != nil {
+		return WindowUsage{}, errors.NewAppError("Failed to load translation usage", err, http.StatusInternalServerError)
+	}
+
+	window := WindowUsage{
+		CharsUsed: used,
+		CharLimit: limit,
+		Remaining: -1,
+	}
+	if limit > 0 {
+		window.Remaining = int64(limit) - used
+		if window.Remaining < 0 {
+			window.Remaining = 0
+		}
+	}
+
+	return window, nil
+}
+
+// startOfDay returns midnight UTC of the given time's day
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// startOfMonth returns the first instant of the given time's month in UTC
+func startOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
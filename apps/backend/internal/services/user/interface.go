@@ -14,4 +14,4 @@ type ServiceInterface interface {
 	DeleteUser(id uint) error
 	ListUsers(pagReq *types.PaginationRequest) (*types.PaginationResponse[models.User], error)
 	ChangePassword(userID uint, req *dto.ChangePasswordRequest) error
-}
\ No newline at end of file
+}
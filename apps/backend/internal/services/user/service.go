@@ -172,4 +172,4 @@ func (s *Service) ChangePassword(userID uint, req *dto.ChangePasswordRequest) er
 	}
 
 	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,27 @@
+package uservideo
+
+import (
+	"app-backend/internal/dto"
+	"app-backend/internal/types"
+)
+
+// ServiceInterface defines the contract for the saved-video / watch history
+// service
+type ServiceInterface interface {
+	// SetActivityRecorder wires the optional daily study statistics
+	// recorder; watch sessions count toward the user's streak once set
+	SetActivityRecorder(recorder ActivityRecorder)
+
+	// SaveVideo records a study session, creating the entry on first save
+	// and updating position, language pair and progress on later saves
+	SaveVideo(userID uint, req *dto.SaveUserVideoRequest) (*dto.UserVideoResponse, error)
+
+	// GetVideo returns one of the user's saved videos by YouTube video ID
+	GetVideo(userID uint, videoID string) (*dto.UserVideoResponse, error)
+
+	// ListVideos returns the user's watch history with pagination
+	ListVideos(userID uint, pagReq *types.PaginationRequest) (*types.PaginationResponse[dto.UserVideoResponse], error)
+
+	// DeleteVideo removes one of the user's saved videos
+	DeleteVideo(userID uint, videoID string) error
+}
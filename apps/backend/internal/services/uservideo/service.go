@@ -0,0 +1,167 @@
+package uservideo
+
+import (
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/types"
+)
+
+// maxStudySecondsPerSave caps the playback delta credited per progress
+// save, so a seek across the video does not count as study time
+const maxStudySecondsPerSave = 600
+
+// ActivityRecorder receives watch activity for the daily study statistics.
+// It is optional; a nil recorder disables the bookkeeping.
+type ActivityRecorder interface {
+	RecordVideoWatched(userID uint)
+	RecordStudyTime(userID uint, seconds int)
+}
+
+type Service struct {
+	userVideoRepo repositories.UserVideoRepositoryInterface
+	activity      ActivityRecorder
+	logger        *logger.Logger
+	// now is injectable for deterministic tests
+	now func() time.Time
+}
+
+func NewService(userVideoRepo repositories.UserVideoRepositoryInterface, logger *logger.Logger) ServiceInterface {
+	return &Service{
+		userVideoRepo: userVideoRepo,
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+func (s *Service) SetActivityRecorder(recorder ActivityRecorder) {
+	s.activity = recorder
+}
+
+func (s *Service) SaveVideo(userID uint, req *dto.SaveUserVideoRequest) (*dto.UserVideoResponse, error) {
+	video, err := s.userVideoRepo.GetByUserAndVideo(userID, req.VideoID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, errors.NewAppError("Failed to load saved video", err, http.StatusInternalServerError)
+	}
+
+	now := s.now().UTC()
+	if err == gorm.ErrRecordNotFound {
+		video = &models.UserVideo{
+			UserID:          userID,
+			VideoID:         req.VideoID,
+			Title:           req.Title,
+			LastPosition:    req.LastPosition,
+			SourceLanguage:  req.SourceLanguage,
+			TargetLanguage:  req.TargetLanguage,
+			ProgressPercent: req.ProgressPercent,
+			LastWatchedAt:   now,
+		}
+		if createErr := s.userVideoRepo.Create(video); createErr != nil {
+			return nil, errors.NewAppError("Failed to save video", createErr, http.StatusInternalServerError)
+		}
+
+		s.logger.Info("Video saved to watch history",
+			zap.Uint("user_id", userID),
+			zap.String("video_id", video.VideoID))
+
+		if s.activity != nil {
+			s.activity.RecordVideoWatched(userID)
+		}
+		return toUserVideoResponse(video), nil
+	}
+
+	previousPosition := video.LastPosition
+	previousWatch := video.LastWatchedAt
+
+	// Later saves update the session state; the title only changes when the
+	// client sends one so a position-only ping cannot blank it
+	if req.Title != "" {
+		video.Title = req.Title
+	}
+	video.LastPosition = req.LastPosition
+	video.SourceLanguage = req.SourceLanguage
+	video.TargetLanguage = req.TargetLanguage
+	video.ProgressPercent = req.ProgressPercent
+	video.LastWatchedAt = now
+
+	if updateErr := s.userVideoRepo.Update(video); updateErr != nil {
+		return nil, errors.NewAppError("Failed to update saved video", updateErr, http.StatusInternalServerError)
+	}
+
+	if s.activity != nil {
+		// The first session on a video each UTC day counts it watched again
+		if previousWatch.UTC().Format("2006-01-02") != now.Format("2006-01-02") {
+			s.activity.RecordVideoWatched(userID)
+		}
+		// Forward playback progress counts as study time, capped so seeks
+		// do not inflate it
+		if delta := int(req.LastPosition - previousPosition); delta > 0 {
+			if delta > maxStudySecondsPerSave {
+				delta = maxStudySecondsPerSave
+			}
+			s.activity.RecordStudyTime(userID, delta)
+		}
+	}
+	return toUserVideoResponse(video), nil
+}
+
+func (s *Service) GetVideo(userID uint, videoID string) (*dto.UserVideoResponse, error) {
+	video, err := s.userVideoRepo.GetByUserAndVideo(userID, videoID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewAppError("Saved video not found", nil, http.StatusNotFound)
+		}
+		return nil, errors.NewAppError("Failed to load saved video", err, http.StatusInternalServerError)
+	}
+	return toUserVideoResponse(video), nil
+}
+
+func (s *Service) ListVideos(userID uint, pagReq *types.PaginationRequest) (*types.PaginationResponse[dto.UserVideoResponse], error) {
+	videos, err := s.userVideoRepo.ListByUser(userID, pagReq)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to list saved videos", err, http.StatusInternalServerError)
+	}
+
+	responses := make([]dto.UserVideoResponse, len(videos.Data))
+	for i := range videos.Data {
+		responses[i] = *toUserVideoResponse(&videos.Data[i])
+	}
+
+	return &types.PaginationResponse[dto.UserVideoResponse]{
+		Data:       responses,
+		Pagination: videos.Pagination,
+	}, nil
+}
+
+func (s *Service) DeleteVideo(userID uint, videoID string) error {
+	err := s.userVideoRepo.DeleteByUserAndVideo(userID, videoID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.NewAppError("Saved video not found", nil, http.StatusNotFound)
+		}
+		return errors.NewAppError("Failed to delete saved video", err, http.StatusInternalServerError)
+	}
+	return nil
+}
+
+func toUserVideoResponse(video *models.UserVideo) *dto.UserVideoResponse {
+	return &dto.UserVideoResponse{
+		ID:              video.ID,
+		VideoID:         video.VideoID,
+		Title:           video.Title,
+		LastPosition:    video.LastPosition,
+		SourceLanguage:  video.SourceLanguage,
+		TargetLanguage:  video.TargetLanguage,
+		ProgressPercent: video.ProgressPercent,
+		LastWatchedAt:   video.LastWatchedAt,
+		CreatedAt:       video.CreatedAt,
+	}
+}
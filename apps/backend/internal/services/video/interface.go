@@ -1,33 +1,39 @@
 package video
 
 import (
-	"context"
 	"app-backend/internal/types"
+	"context"
 )
 
 // ServiceInterface defines the contract for the main video service facade
 type ServiceInterface interface {
 	// DetectProvider detects the video provider from URL or video ID
 	DetectProvider(videoURL string) (types.VideoProvider, string, error)
-	
+
+	// ResolveVideoURL parses a video URL or bare ID into its provider,
+	// video ID, and URL kind without calling any provider API
+	ResolveVideoURL(videoURL string) (*ParsedVideoURL, error)
+
 	// GetVideoInfo retrieves basic information about a video
 	GetVideoInfo(ctx context.Context, provider types.VideoProvider, videoID string) (*types.VideoInfo, error)
-	
+
 	// GetTranscript retrieves transcript for a video in specified language
 	GetTranscript(ctx context.Context, provider types.VideoProvider, videoID string, language string) (*types.Transcript, error)
-	
+
 	// GetAvailableLanguages returns list of available transcript languages
 	GetAvailableLanguages(ctx context.Context, provider types.VideoProvider, videoID string) ([]types.Language, error)
-	
+
 	// GetCapabilities returns what features are supported for this video
 	GetCapabilities(ctx context.Context, provider types.VideoProvider, videoID string) (*types.VideoCapabilities, error)
-	
-	// GetDualLanguageTranscript retrieves transcript and translates it
-	GetDualLanguageTranscript(ctx context.Context, provider types.VideoProvider, videoID string, sourceLang string, targetLang string) (*types.DualLanguageTranscript, error)
-	
+
+	// GetDualLanguageTranscript retrieves transcript and translates it.
+	// Owner corrections, keyed by 1-based segment index, are applied to the
+	// transcript before translation so edits carry into the translated text.
+	GetDualLanguageTranscript(ctx context.Context, provider types.VideoProvider, videoID string, sourceLang string, targetLang string, corrections map[int]string) (*types.DualLanguageTranscript, error)
+
 	// GetSupportedProviders returns list of supported providers
 	GetSupportedProviders() []types.VideoProvider
-	
+
 	// GetSupportedLanguages returns list of supported translation languages
 	GetSupportedLanguages() []types.Language
 }
@@ -36,19 +42,19 @@ type ServiceInterface interface {
 type ProviderServiceInterface interface {
 	// GetVideoInfo retrieves basic information about a video
 	GetVideoInfo(ctx context.Context, videoID string) (*types.VideoInfo, error)
-	
+
 	// GetTranscript retrieves transcript for a video in specified language
 	GetTranscript(ctx context.Context, videoID string, language string) (*types.Transcript, error)
-	
+
 	// GetAvailableLanguages returns list of available transcript languages
 	GetAvailableLanguages(ctx context.Context, videoID string) ([]types.Language, error)
-	
+
 	// GetCapabilities returns what features are supported for this video
 	GetCapabilities(ctx context.Context, videoID string) (*types.VideoCapabilities, error)
-	
+
 	// GetProvider returns the video provider this service handles
 	GetProvider() types.VideoProvider
-	
+
 	// ValidateVideoID checks if the video ID is valid for this provider
 	ValidateVideoID(videoID string) bool
 }
@@ -57,4 +63,4 @@ type ProviderServiceInterface interface {
 type ProviderFactory interface {
 	CreateService(provider types.VideoProvider) (ProviderServiceInterface, error)
 	GetSupportedProviders() []types.VideoProvider
-}
\ No newline at end of file
+}
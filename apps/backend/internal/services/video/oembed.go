@@ -0,0 +1,69 @@
+package video
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"app-backend/internal/types"
+)
+
+// defaultOEmbedEndpoint is YouTube's oEmbed endpoint; it returns basic video
+// metadata without an API key and does not count against the Data API quota
+const defaultOEmbedEndpoint = "https://www.youtube.com/oembed"
+
+// oembedResponse is the subset of the oEmbed payload we care about
+type oembedResponse struct {
+	Title        string `json:"title"`
+	AuthorName   string `json:"author_name"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+// SetOEmbedEndpoint overrides the oEmbed endpoint (tests, proxies)
+func (s *Service) SetOEmbedEndpoint(endpoint string) {
+	s.oembedEndpoint = endpoint
+}
+
+// getVideoInfoFromOEmbed fetches lightweight video metadata via oEmbed. The
+// payload has no duration or description, so those fields stay zero-valued.
+func (s *Service) getVideoInfoFromOEmbed(ctx context.Context, videoID string) (*types.VideoInfo, error) {
+	videoURL := fmt.Sprintf("https://www.youtube.com/watch?v=%s", videoID)
+	requestURL := fmt.Sprintf("%s?url=%s&format=json", s.oembedEndpoint, url.QueryEscape(videoURL))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create oEmbed request: %w", err)
+	}
+
+	resp, err := s.oembedClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("oEmbed request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oEmbed returned HTTP %d", resp.StatusCode)
+	}
+
+	var oembed oembedResponse
+	if err := json.NewDecoder(resp.Body).Decode(&oembed); err != nil {
+		return nil, fmt.Errorf("failed to decode oEmbed response: %w", err)
+	}
+
+	return &types.VideoInfo{
+		ID:           videoID,
+		Provider:     types.ProviderYouTube,
+		Title:        oembed.Title,
+		Author:       oembed.AuthorName,
+		ThumbnailURL: oembed.ThumbnailURL,
+		URL:          videoURL,
+	}, nil
+}
+
+// newOEmbedClient returns the HTTP client used for oEmbed lookups
+func newOEmbedClient() *http.Client {
+	return &http.Client{Timeout: 10 * time.Second}
+}
@@ -2,9 +2,13 @@ package video
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
-	"strings"
+	"net/http"
+	"sort"
+	"sync"
 
+	"app-backend/internal/services/languages"
 	"app-backend/internal/types"
 	"app-backend/pkg/gemini"
 	"app-backend/pkg/youtube"
@@ -13,9 +17,17 @@ import (
 
 // Service orchestrates video operations across different providers
 type Service struct {
-	providers   map[types.VideoProvider]ProviderServiceInterface
-	translator  *gemini.Service
-	logger      *zap.Logger
+	providers      map[types.VideoProvider]ProviderServiceInterface
+	translator     *gemini.Service
+	languages      languages.ServiceInterface
+	logger         *zap.Logger
+	oembedEndpoint string
+	oembedClient   *http.Client
+
+	// dualCache remembers translated dual-language transcripts per video and
+	// language pair; translation is the expensive part of the call
+	dualMu    sync.RWMutex
+	dualCache map[string]*types.DualLanguageTranscript
 }
 
 // Config holds configuration for the video service
@@ -28,8 +40,11 @@ type Config struct {
 // NewService creates a new video service with all providers
 func NewService(config *Config) (*Service, error) {
 	service := &Service{
-		providers: make(map[types.VideoProvider]ProviderServiceInterface),
-		logger:    config.Logger,
+		providers:      make(map[types.VideoProvider]ProviderServiceInterface),
+		languages:      languages.NewService(),
+		logger:         config.Logger,
+		oembedEndpoint: defaultOEmbedEndpoint,
+		oembedClient:   newOEmbedClient(),
 	}
 
 	// Initialize YouTube service
@@ -50,8 +65,11 @@ func NewService(config *Config) (*Service, error) {
 // NewVideoService creates a new video service with initialized services (for container injection)
 func NewVideoService(youtubeService *youtube.Service, geminiService *gemini.Service, logger *zap.Logger) ServiceInterface {
 	service := &Service{
-		providers: make(map[types.VideoProvider]ProviderServiceInterface),
-		logger:    logger,
+		providers:      make(map[types.VideoProvider]ProviderServiceInterface),
+		languages:      languages.NewService(),
+		logger:         logger,
+		oembedEndpoint: defaultOEmbedEndpoint,
+		oembedClient:   newOEmbedClient(),
 	}
 
 	if youtubeService != nil {
@@ -77,34 +95,43 @@ func (s *Service) Close() error {
 
 // DetectProvider detects the video provider from URL or video ID
 func (s *Service) DetectProvider(videoURL string) (types.VideoProvider, string, error) {
-	videoURL = strings.TrimSpace(videoURL)
-	
-	// Check if it's a YouTube URL or video ID
-	if s.isYouTubeURL(videoURL) {
-		videoID := s.extractYouTubeVideoID(videoURL)
-		if videoID != "" {
-			return types.ProviderYouTube, videoID, nil
-		}
-	}
-
-	// Check if it's a direct YouTube video ID
-	if provider, ok := s.providers[types.ProviderYouTube]; ok {
-		if provider.ValidateVideoID(videoURL) {
-			return types.ProviderYouTube, videoURL, nil
-		}
+	parsed, err := ParseVideoURL(videoURL)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %s", err, videoURL)
 	}
+	return parsed.Provider, parsed.VideoID, nil
+}
 
-	return "", "", fmt.Errorf("unsupported video provider or invalid URL: %s", videoURL)
+// ResolveVideoURL parses a video URL or bare ID into its provider, video ID,
+// and URL kind without touching any provider API
+func (s *Service) ResolveVideoURL(videoURL string) (*ParsedVideoURL, error) {
+	return ParseVideoURL(videoURL)
 }
 
-// GetVideoInfo retrieves video information
+// GetVideoInfo retrieves video information. For YouTube it falls back to the
+// oEmbed endpoint when the Data API is not configured or its call fails
+// (e.g. quota exhaustion); oEmbed carries no duration or description.
 func (s *Service) GetVideoInfo(ctx context.Context, provider types.VideoProvider, videoID string) (*types.VideoInfo, error) {
 	service, ok := s.providers[provider]
 	if !ok {
+		if provider == types.ProviderYouTube {
+			return s.getVideoInfoFromOEmbed(ctx, videoID)
+		}
 		return nil, fmt.Errorf("provider %s not supported", provider)
 	}
 
-	return service.GetVideoInfo(ctx, videoID)
+	info, err := service.GetVideoInfo(ctx, videoID)
+	if err != nil && provider == types.ProviderYouTube {
+		s.logger.Warn("Video info lookup failed, falling back to oEmbed",
+			zap.String("video_id", videoID),
+			zap.Error(err))
+		if fallback, oembedErr := s.getVideoInfoFromOEmbed(ctx, videoID); oembedErr == nil {
+			return fallback, nil
+		}
+		return nil, err
+	}
+
+	return info, err
 }
 
 // GetTranscript retrieves video transcript
@@ -117,12 +144,26 @@ func (s *Service) GetTranscript(ctx context.Context, provider types.VideoProvide
 	return service.GetTranscript(ctx, videoID, language)
 }
 
-// GetDualLanguageTranscript retrieves transcript and translates it
-func (s *Service) GetDualLanguageTranscript(ctx context.Context, provider types.VideoProvider, videoID string, sourceLang string, targetLang string) (*types.DualLanguageTranscript, error) {
+// GetDualLanguageTranscript retrieves transcript and translates it. Owner
+// corrections (1-based segment index to corrected text) are overlaid on the
+// transcript before translation
+func (s *Service) GetDualLanguageTranscript(ctx context.Context, provider types.VideoProvider, videoID string, sourceLang string, targetLang string, corrections map[int]string) (*types.DualLanguageTranscript, error) {
 	if s.translator == nil {
 		return nil, fmt.Errorf("translation service not available")
 	}
 
+	// Serve repeated requests for the same language pair from the cache;
+	// corrected transcripts get their own entries
+	cacheKey := fmt.Sprintf("%s|%s|%s%s", videoID, sourceLang, targetLang, correctionsCacheSuffix(corrections))
+	s.dualMu.RLock()
+	cached, ok := s.dualCache[cacheKey]
+	s.dualMu.RUnlock()
+	if ok {
+		result := *cached
+		result.Cached = true
+		return &result, nil
+	}
+
 	// Get original transcript
 	transcript, err := s.GetTranscript(ctx, provider, videoID, sourceLang)
 	if err != nil {
@@ -137,12 +178,14 @@ func (s *Service) GetDualLanguageTranscript(ctx context.Context, provider types.
 		}, nil
 	}
 
+	segments := applySegmentCorrections(transcript.Segments, corrections)
+
 	// Detect source language if not provided
 	detectedSourceLang := transcript.Language
-	if sourceLang == "" && len(transcript.Segments) > 0 {
+	if sourceLang == "" && len(segments) > 0 {
 		// Use first few segments to detect language
 		sampleText := ""
-		for i, segment := range transcript.Segments {
+		for i, segment := range segments {
 			if i >= 3 { // Use first 3 segments for detection
 				break
 			}
@@ -155,20 +198,66 @@ func (s *Service) GetDualLanguageTranscript(ctx context.Context, provider types.
 	}
 
 	// Translate segments
-	translations, err := s.translator.TranslateSegments(ctx, transcript.Segments, targetLang, detectedSourceLang)
+	translations, err := s.translator.TranslateSegments(ctx, segments, targetLang, detectedSourceLang)
 	if err != nil {
 		return nil, fmt.Errorf("failed to translate segments: %w", err)
 	}
 
-	return &types.DualLanguageTranscript{
+	result := &types.DualLanguageTranscript{
 		VideoID:      videoID,
 		Provider:     provider,
 		SourceLang:   detectedSourceLang,
 		TargetLang:   targetLang,
-		Segments:     transcript.Segments,
+		Segments:     segments,
 		Translations: translations,
-		Cached:       false, // TODO: implement caching
-	}, nil
+	}
+
+	s.dualMu.Lock()
+	if s.dualCache == nil {
+		s.dualCache = make(map[string]*types.DualLanguageTranscript)
+	}
+	s.dualCache[cacheKey] = result
+	s.dualMu.Unlock()
+
+	return result, nil
+}
+
+// applySegmentCorrections overlays owner edits onto the fetched segments.
+// Correction indices are 1-based, matching the segment indices the API
+// returns; out-of-range indices are ignored
+func applySegmentCorrections(segments []types.TranscriptSegment, corrections map[int]string) []types.TranscriptSegment {
+	if len(corrections) == 0 {
+		return segments
+	}
+
+	corrected := make([]types.TranscriptSegment, len(segments))
+	copy(corrected, segments)
+	for index, text := range corrections {
+		if index >= 1 && index <= len(corrected) {
+			corrected[index-1].Text = text
+		}
+	}
+	return corrected
+}
+
+// correctionsCacheSuffix fingerprints the applied corrections so corrected
+// translations never collide with uncorrected cache entries
+func correctionsCacheSuffix(corrections map[int]string) string {
+	if len(corrections) == 0 {
+		return ""
+	}
+
+	indices := make([]int, 0, len(corrections))
+	for index := range corrections {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+
+	hash := sha256.New()
+	for _, index := range indices {
+		fmt.Fprintf(hash, "%d\x00%s\x00", index, corrections[index])
+	}
+	return fmt.Sprintf("|c%x", hash.Sum(nil)[:8])
 }
 
 // GetAvailableLanguages returns available transcript languages
@@ -205,46 +294,5 @@ func (s *Service) GetSupportedLanguages() []types.Language {
 	if s.translator == nil {
 		return []types.Language{}
 	}
-	return s.translator.GetSupportedLanguages()
+	return s.languages.Supported()
 }
-
-// isYouTubeURL checks if the URL is a YouTube URL
-func (s *Service) isYouTubeURL(url string) bool {
-	return strings.Contains(url, "youtube.com") || 
-		   strings.Contains(url, "youtu.be") || 
-		   strings.Contains(url, "youtube-nocookie.com")
-}
-
-// extractYouTubeVideoID extracts video ID from YouTube URL
-func (s *Service) extractYouTubeVideoID(url string) string {
-	// Handle different YouTube URL formats
-	// https://www.youtube.com/watch?v=VIDEO_ID
-	// https://youtu.be/VIDEO_ID
-	// https://www.youtube.com/embed/VIDEO_ID
-	
-	if strings.Contains(url, "watch?v=") {
-		parts := strings.Split(url, "watch?v=")
-		if len(parts) > 1 {
-			videoID := strings.Split(parts[1], "&")[0]
-			return videoID
-		}
-	}
-	
-	if strings.Contains(url, "youtu.be/") {
-		parts := strings.Split(url, "youtu.be/")
-		if len(parts) > 1 {
-			videoID := strings.Split(parts[1], "?")[0]
-			return videoID
-		}
-	}
-	
-	if strings.Contains(url, "embed/") {
-		parts := strings.Split(url, "embed/")
-		if len(parts) > 1 {
-			videoID := strings.Split(parts[1], "?")[0]
-			return videoID
-		}
-	}
-	
-	return ""
-}
\ No newline at end of file
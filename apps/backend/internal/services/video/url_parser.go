@@ -0,0 +1,145 @@
+package video
+
+import (
+	goerrors "errors"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"app-backend/internal/types"
+)
+
+// Typed parse errors so callers can distinguish an unrecognized host from a
+// recognized one carrying no video ID (e.g. a playlist or channel URL)
+var (
+	// ErrInvalidVideoURL is returned when the input cannot be parsed as a URL
+	ErrInvalidVideoURL = goerrors.New("invalid video URL")
+	// ErrUnsupportedVideoHost is returned for URLs on hosts of no known provider
+	ErrUnsupportedVideoHost = goerrors.New("unsupported video provider")
+	// ErrNoVideoID is returned for provider URLs that carry no video ID
+	ErrNoVideoID = goerrors.New("no video ID in URL")
+)
+
+// VideoURLKind is the URL format a video reference arrived in
+type VideoURLKind string
+
+const (
+	// URLKindWatch is a standard watch URL (youtube.com/watch?v=ID)
+	URLKindWatch VideoURLKind = "watch"
+	// URLKindShorts is a Shorts URL (youtube.com/shorts/ID)
+	URLKindShorts VideoURLKind = "shorts"
+	// URLKindLive is a live-stream URL (youtube.com/live/ID)
+	URLKindLive VideoURLKind = "live"
+	// URLKindEmbed is an embed URL (youtube.com/embed/ID or /v/ID)
+	URLKindEmbed VideoURLKind = "embed"
+	// URLKindShare is a share short-link (youtu.be/ID)
+	URLKindShare VideoURLKind = "share"
+	// URLKindVideoID is a bare video ID without a URL around it
+	URLKindVideoID VideoURLKind = "video_id"
+)
+
+// ParsedVideoURL describes a recognized video reference
+type ParsedVideoURL struct {
+	Provider types.VideoProvider
+	VideoID  string
+	Kind     VideoURLKind
+}
+
+// youtubeVideoIDPattern matches the 11-character YouTube video ID alphabet
+var youtubeVideoIDPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{11}$`)
+
+// ParseVideoURL parses a video URL or bare video ID into its provider, ID,
+// and URL kind. All documented YouTube formats are covered: watch URLs
+// (including playlist-embedded ones with extra parameters), youtu.be share
+// links, Shorts, live streams, and embeds, on the youtube.com,
+// youtube-nocookie.com, and music/mobile subdomain hosts.
+func ParseVideoURL(raw string) (*ParsedVideoURL, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, ErrInvalidVideoURL
+	}
+
+	// A bare 11-character ID needs no URL parsing
+	if youtubeVideoIDPattern.MatchString(raw) {
+		return &ParsedVideoURL{
+			Provider: types.ProviderYouTube,
+			VideoID:  raw,
+			Kind:     URLKindVideoID,
+		}, nil
+	}
+
+	// Scheme-less inputs like "www.youtube.com/watch?v=..." parse as a bare
+	// path, so give them one first
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return nil, ErrInvalidVideoURL
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	host = strings.TrimPrefix(host, "www.")
+	host = strings.TrimPrefix(host, "m.")
+	host = strings.TrimPrefix(host, "music.")
+
+	switch host {
+	case "youtu.be":
+		return parsedYouTubeURL(firstPathSegment(parsed.Path), URLKindShare)
+	case "youtube.com", "youtube-nocookie.com":
+		return parseYouTubePath(parsed)
+	}
+
+	return nil, ErrUnsupportedVideoHost
+}
+
+// parseYouTubePath resolves the video ID from a youtube.com-style path
+func parseYouTubePath(parsed *url.URL) (*ParsedVideoURL, error) {
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(segments) == 0 || segments[0] == "" {
+		return nil, ErrNoVideoID
+	}
+
+	switch segments[0] {
+	case "watch":
+		// The v parameter survives playlist context and any extra
+		// parameters (list, index, t, si, feature)
+		return parsedYouTubeURL(parsed.Query().Get("v"), URLKindWatch)
+	case "shorts":
+		return parsedYouTubeURL(secondSegment(segments), URLKindShorts)
+	case "live":
+		return parsedYouTubeURL(secondSegment(segments), URLKindLive)
+	case "embed", "v":
+		return parsedYouTubeURL(secondSegment(segments), URLKindEmbed)
+	}
+
+	return nil, ErrNoVideoID
+}
+
+// parsedYouTubeURL validates the extracted ID and wraps it in a result
+func parsedYouTubeURL(videoID string, kind VideoURLKind) (*ParsedVideoURL, error) {
+	if !youtubeVideoIDPattern.MatchString(videoID) {
+		return nil, ErrNoVideoID
+	}
+	return &ParsedVideoURL{
+		Provider: types.ProviderYouTube,
+		VideoID:  videoID,
+		Kind:     kind,
+	}, nil
+}
+
+func firstPathSegment(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) == 0 {
+		return ""
+	}
+	return segments[0]
+}
+
+func secondSegment(segments []string) string {
+	if len(segments) < 2 {
+		return ""
+	}
+	return segments[1]
+}
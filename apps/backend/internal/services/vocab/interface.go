@@ -0,0 +1,50 @@
+package vocab
+
+import (
+	"app-backend/internal/dto"
+	"app-backend/internal/types"
+)
+
+// ExportFile is a rendered vocabulary export ready to be served as a
+// download
+type ExportFile struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+// ServiceInterface defines the contract for the vocabulary service
+type ServiceInterface interface {
+	// SetActivityRecorder wires the optional daily study statistics
+	// recorder; reviews count toward the user's streak once set
+	SetActivityRecorder(recorder ActivityRecorder)
+
+	// CreateItem saves a new vocabulary item for the user, due immediately
+	CreateItem(userID uint, req *dto.CreateVocabItemRequest) (*dto.VocabItemResponse, error)
+
+	// GetItem returns one of the user's vocabulary items
+	GetItem(userID uint, itemID uint) (*dto.VocabItemResponse, error)
+
+	// ListItems returns the user's vocabulary items with pagination
+	ListItems(userID uint, pagReq *types.PaginationRequest) (*types.PaginationResponse[dto.VocabItemResponse], error)
+
+	// UpdateItem edits the content fields of one of the user's items
+	UpdateItem(userID uint, itemID uint, req *dto.UpdateVocabItemRequest) (*dto.VocabItemResponse, error)
+
+	// DeleteItem removes one of the user's items
+	DeleteItem(userID uint, itemID uint) error
+
+	// GetReviewQueue returns the user's items due for review now
+	GetReviewQueue(userID uint, limit int) (*dto.ReviewQueueResponse, error)
+
+	// ReviewItem grades a review and reschedules the item using SM-2
+	ReviewItem(userID uint, itemID uint, quality int) (*dto.VocabItemResponse, error)
+
+	// ExportItems renders the user's whole vocabulary as a CSV or
+	// Anki-compatible TSV download
+	ExportItems(userID uint, format string) (*ExportFile, error)
+
+	// ImportItems parses a CSV or TSV payload and saves every new word,
+	// skipping terms the user already has
+	ImportItems(userID uint, data []byte) (*dto.ImportVocabResponse, error)
+}
@@ -0,0 +1,251 @@
+package vocab
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/types"
+)
+
+const (
+	// initialEaseFactor is the SM-2 starting ease for new items
+	initialEaseFactor = 2.5
+	// minEaseFactor is the SM-2 floor below which ease never drops
+	minEaseFactor = 1.3
+	// passingQuality is the lowest quality grade counted as a successful
+	// recall; anything below resets the repetition streak
+	passingQuality = 3
+	// defaultReviewLimit caps the review queue when no limit is given
+	defaultReviewLimit = 50
+)
+
+// ActivityRecorder receives graded reviews for the daily study statistics.
+// It is optional; a nil recorder disables the bookkeeping.
+type ActivityRecorder interface {
+	RecordVocabReviews(userID uint, reviews int)
+}
+
+type Service struct {
+	vocabRepo repositories.VocabRepositoryInterface
+	activity  ActivityRecorder
+	logger    *logger.Logger
+	// now is injectable for deterministic scheduling tests
+	now func() time.Time
+}
+
+func NewService(vocabRepo repositories.VocabRepositoryInterface, logger *logger.Logger) ServiceInterface {
+	return &Service{
+		vocabRepo: vocabRepo,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+func (s *Service) SetActivityRecorder(recorder ActivityRecorder) {
+	s.activity = recorder
+}
+
+func (s *Service) CreateItem(userID uint, req *dto.CreateVocabItemRequest) (*dto.VocabItemResponse, error) {
+	item := &models.VocabItem{
+		UserID:          userID,
+		Word:            req.Word,
+		Reading:         req.Reading,
+		Definition:      req.Definition,
+		ExampleSentence: req.ExampleSentence,
+		SourceVideoID:   req.SourceVideoID,
+		VideoTimestamp:  req.VideoTimestamp,
+		EaseFactor:      initialEaseFactor,
+		// New items are due immediately for their first review
+		NextReviewAt: s.now().UTC(),
+	}
+
+	if err := s.vocabRepo.Create(item); err != nil {
+		return nil, errors.NewAppError("Failed to save vocabulary item", err, http.StatusInternalServerError)
+	}
+
+	s.logger.Info("Vocabulary item created",
+		zap.Uint("user_id", userID),
+		zap.Uint("item_id", item.ID),
+		zap.String("word", item.Word))
+
+	return toVocabItemResponse(item), nil
+}
+
+func (s *Service) GetItem(userID uint, itemID uint) (*dto.VocabItemResponse, error) {
+	item, err := s.getOwnedItem(userID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	return toVocabItemResponse(item), nil
+}
+
+func (s *Service) ListItems(userID uint, pagReq *types.PaginationRequest) (*types.PaginationResponse[dto.VocabItemResponse], error) {
+	items, err := s.vocabRepo.ListByUser(userID, pagReq)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to list vocabulary items", err, http.StatusInternalServerError)
+	}
+
+	responses := make([]dto.VocabItemResponse, len(items.Data))
+	for i := range items.Data {
+		responses[i] = *toVocabItemResponse(&items.Data[i])
+	}
+
+	return &types.PaginationResponse[dto.VocabItemResponse]{
+		Data:       responses,
+		Pagination: items.Pagination,
+	}, nil
+}
+
+func (s *Service) UpdateItem(userID uint, itemID uint, req *dto.UpdateVocabItemRequest) (*dto.VocabItemResponse, error) {
+	item, err := s.getOwnedItem(userID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Word != nil {
+		item.Word = *req.Word
+	}
+	if req.Reading != nil {
+		item.Reading = *req.Reading
+	}
+	if req.Definition != nil {
+		item.Definition = *req.Definition
+	}
+	if req.ExampleSentence != nil {
+		item.ExampleSentence = *req.ExampleSentence
+	}
+
+	if err := s.vocabRepo.Update(item); err != nil {
+		return nil, errors.NewAppError("Failed to update vocabulary item", err, http.StatusInternalServerError)
+	}
+	return toVocabItemResponse(item), nil
+}
+
+func (s *Service) DeleteItem(userID uint, itemID uint) error {
+	err := s.vocabRepo.DeleteByUserAndID(userID, itemID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.NewAppError("Vocabulary item not found", nil, http.StatusNotFound)
+		}
+		return errors.NewAppError("Failed to delete vocabulary item", err, http.StatusInternalServerError)
+	}
+	return nil
+}
+
+func (s *Service) GetReviewQueue(userID uint, limit int) (*dto.ReviewQueueResponse, error) {
+	if limit <= 0 {
+		limit = defaultReviewLimit
+	}
+
+	items, err := s.vocabRepo.GetDueForReview(userID, s.now().UTC(), limit)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to load review queue", err, http.StatusInternalServerError)
+	}
+
+	responses := make([]dto.VocabItemResponse, len(items))
+	for i, item := range items {
+		responses[i] = *toVocabItemResponse(item)
+	}
+
+	return &dto.ReviewQueueResponse{
+		Items: responses,
+		Total: len(responses),
+	}, nil
+}
+
+func (s *Service) ReviewItem(userID uint, itemID uint, quality int) (*dto.VocabItemResponse, error) {
+	if quality < 0 || quality > 5 {
+		return nil, errors.NewAppError("Review quality must be between 0 and 5", nil, http.StatusBadRequest)
+	}
+
+	item, err := s.getOwnedItem(userID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	applyReview(item, quality, s.now().UTC())
+
+	if err := s.vocabRepo.Update(item); err != nil {
+		return nil, errors.NewAppError("Failed to record review", err, http.StatusInternalServerError)
+	}
+
+	s.logger.Debug("Vocabulary item reviewed",
+		zap.Uint("user_id", userID),
+		zap.Uint("item_id", itemID),
+		zap.Int("quality", quality),
+		zap.Int("interval_days", item.IntervalDays))
+
+	if s.activity != nil {
+		s.activity.RecordVocabReviews(userID, 1)
+	}
+
+	return toVocabItemResponse(item), nil
+}
+
+// getOwnedItem loads an item scoped to the user, mapping a missing row to a
+// 404 so one user cannot probe another's item IDs
+func (s *Service) getOwnedItem(userID uint, itemID uint) (*models.VocabItem, error) {
+	item, err := s.vocabRepo.GetByUserAndID(userID, itemID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewAppError("Vocabulary item not found", nil, http.StatusNotFound)
+		}
+		return nil, errors.NewAppError("Failed to load vocabulary item", err, http.StatusInternalServerError)
+	}
+	return item, nil
+}
+
+// applyReview updates an item's scheduling state with the SM-2 algorithm.
+// A failed recall (quality below 3) resets the repetition streak and brings
+// the item back the next day; successful recalls grow the interval
+// geometrically by the ease factor.
+func applyReview(item *models.VocabItem, quality int, now time.Time) {
+	if quality < passingQuality {
+		item.Repetitions = 0
+		item.IntervalDays = 1
+	} else {
+		switch item.Repetitions {
+		case 0:
+			item.IntervalDays = 1
+		case 1:
+			item.IntervalDays = 6
+		default:
+			item.IntervalDays = int(math.Round(float64(item.IntervalDays) * item.EaseFactor))
+		}
+		item.Repetitions++
+	}
+
+	// SM-2 ease adjustment; quality 4 keeps the ease unchanged
+	item.EaseFactor += 0.1 - float64(5-quality)*(0.08+float64(5-quality)*0.02)
+	if item.EaseFactor < minEaseFactor {
+		item.EaseFactor = minEaseFactor
+	}
+
+	item.NextReviewAt = now.AddDate(0, 0, item.IntervalDays)
+}
+
+func toVocabItemResponse(item *models.VocabItem) *dto.VocabItemResponse {
+	return &dto.VocabItemResponse{
+		ID:              item.ID,
+		Word:            item.Word,
+		Reading:         item.Reading,
+		Definition:      item.Definition,
+		ExampleSentence: item.ExampleSentence,
+		SourceVideoID:   item.SourceVideoID,
+		VideoTimestamp:  item.VideoTimestamp,
+		EaseFactor:      item.EaseFactor,
+		IntervalDays:    item.IntervalDays,
+		Repetitions:     item.Repetitions,
+		NextReviewAt:    item.NextReviewAt,
+		CreatedAt:       item.CreatedAt,
+	}
+}
@@ -0,0 +1,335 @@
+package vocab
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/models"
+)
+
+// Export formats
+const (
+	// FormatCSV is a plain CSV file with a header row
+	FormatCSV = "csv"
+	// FormatAnki is a tab-separated file with Anki import directives; the
+	// example column carries cloze markup so the deck works as cloze notes
+	FormatAnki = "anki"
+)
+
+const (
+	// maxImportRows caps how many rows one import request may carry
+	maxImportRows = 1000
+	// maxImportErrors caps how many per-row errors the response lists
+	maxImportErrors = 10
+)
+
+// exportHeader is the CSV column order; imports accept the same layout
+var exportHeader = []string{"term", "reading", "definition", "example", "source_video_url", "video_timestamp"}
+
+// clozePattern matches Anki cloze markup like {{c1::word}} or
+// {{c1::word::hint}} so imports can strip it back to plain text
+var clozePattern = regexp.MustCompile(`\{\{c\d+::(.*?)(?:::.*?)?\}\}`)
+
+// ExportItems renders the user's whole vocabulary in the requested format
+func (s *Service) ExportItems(userID uint, format string) (*ExportFile, error) {
+	items, err := s.vocabRepo.FindAllBy("user_id", userID)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to load vocabulary items", err, http.StatusInternalServerError)
+	}
+
+	switch format {
+	case FormatCSV:
+		return exportCSV(items)
+	case FormatAnki:
+		return exportAnki(items)
+	default:
+		return nil, errors.NewAppError("Unsupported export format; use csv or anki", nil, http.StatusBadRequest)
+	}
+}
+
+// exportCSV writes a comma-separated file with a header row
+func exportCSV(items []*models.VocabItem) (*ExportFile, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(exportHeader); err != nil {
+		return nil, errors.NewAppError("Failed to encode export", err, http.StatusInternalServerError)
+	}
+	for _, item := range items {
+		record := []string{
+			item.Word,
+			item.Reading,
+			item.Definition,
+			item.ExampleSentence,
+			sourceVideoURL(item),
+			timestampField(item),
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, errors.NewAppError("Failed to encode export", err, http.StatusInternalServerError)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, errors.NewAppError("Failed to encode export", err, http.StatusInternalServerError)
+	}
+
+	return &ExportFile{
+		FileName:    "vocabulary.csv",
+		ContentType: "text/csv; charset=utf-8",
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+// exportAnki writes a tab-separated file Anki imports directly. The leading
+// directives tell Anki's importer the separator, and the example column
+// carries the word wrapped in cloze markup.
+func exportAnki(items []*models.VocabItem) (*ExportFile, error) {
+	var buf bytes.Buffer
+	buf.WriteString("#separator:tab\n")
+	buf.WriteString("#html:false\n")
+
+	writer := csv.NewWriter(&buf)
+	writer.Comma = '\t'
+	for _, item := range items {
+		record := []string{
+			item.Word,
+			item.Reading,
+			item.Definition,
+			clozeExample(item.ExampleSentence, item.Word),
+			sourceVideoURL(item),
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, errors.NewAppError("Failed to encode export", err, http.StatusInternalServerError)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, errors.NewAppError("Failed to encode export", err, http.StatusInternalServerError)
+	}
+
+	return &ExportFile{
+		FileName:    "vocabulary.txt",
+		ContentType: "text/tab-separated-values; charset=utf-8",
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+// ImportItems parses a CSV or TSV payload and saves every new word for the
+// user. Rows whose term the user already saved are counted as duplicates
+// and skipped, as are repeated terms within the file.
+func (s *Service) ImportItems(userID uint, data []byte) (*dto.ImportVocabResponse, error) {
+	records, err := parseImportRecords(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > maxImportRows {
+		return nil, errors.NewAppError(
+			fmt.Sprintf("Import exceeds the %d row limit", maxImportRows), nil, http.StatusBadRequest)
+	}
+
+	existing, err := s.vocabRepo.FindAllBy("user_id", userID)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to load vocabulary items", err, http.StatusInternalServerError)
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, item := range existing {
+		seen[strings.ToLower(item.Word)] = true
+	}
+
+	result := &dto.ImportVocabResponse{TotalRows: len(records)}
+	for i, record := range records {
+		item, err := importedItem(userID, record, s.now().UTC())
+		if err != nil {
+			result.Failed++
+			if len(result.Errors) < maxImportErrors {
+				result.Errors = append(result.Errors, fmt.Sprintf("row %d: %v", i+1, err))
+			}
+			continue
+		}
+
+		key := strings.ToLower(item.Word)
+		if seen[key] {
+			result.Duplicates++
+			continue
+		}
+
+		if err := s.vocabRepo.Create(item); err != nil {
+			return nil, errors.NewAppError("Failed to save vocabulary item", err, http.StatusInternalServerError)
+		}
+		seen[key] = true
+		result.Imported++
+	}
+
+	s.logger.Info("Vocabulary import finished",
+		zap.Uint("user_id", userID),
+		zap.Int("imported", result.Imported),
+		zap.Int("duplicates", result.Duplicates),
+		zap.Int("failed", result.Failed))
+
+	return result, nil
+}
+
+// parseImportRecords reads CSV or TSV rows, skipping Anki directives and an
+// optional header row matching the export layout
+func parseImportRecords(data []byte) ([][]string, error) {
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+
+	// Strip Anki file directives like "#separator:tab" before parsing
+	var body []string
+	for _, line := range lines {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		body = append(body, line)
+	}
+
+	content := strings.Join(body, "\n")
+	if strings.TrimSpace(content) == "" {
+		return nil, errors.NewAppError("Import file is empty", nil, http.StatusBadRequest)
+	}
+
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.Comma = detectSeparator(content)
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.NewAppError("Import file is not valid CSV or TSV", err, http.StatusBadRequest)
+	}
+
+	// Drop a header row that matches the export layout
+	if len(records) > 0 && strings.EqualFold(strings.TrimSpace(records[0][0]), exportHeader[0]) {
+		records = records[1:]
+	}
+	return records, nil
+}
+
+// detectSeparator picks tab when the first line contains one, comma otherwise
+func detectSeparator(content string) rune {
+	firstLine := content
+	if idx := strings.IndexByte(content, '\n'); idx >= 0 {
+		firstLine = content[:idx]
+	}
+	if strings.ContainsRune(firstLine, '\t') {
+		return '\t'
+	}
+	return ','
+}
+
+// importedItem builds a vocabulary item from one import row in the export
+// column order; trailing columns are optional
+func importedItem(userID uint, record []string, now time.Time) (*models.VocabItem, error) {
+	word := strings.TrimSpace(field(record, 0))
+	if word == "" {
+		return nil, fmt.Errorf("missing term")
+	}
+	if len(word) > 200 {
+		return nil, fmt.Errorf("term exceeds 200 characters")
+	}
+
+	item := &models.VocabItem{
+		UserID:          userID,
+		Word:            word,
+		Reading:         strings.TrimSpace(field(record, 1)),
+		Definition:      strings.TrimSpace(field(record, 2)),
+		ExampleSentence: stripCloze(strings.TrimSpace(field(record, 3))),
+		EaseFactor:      initialEaseFactor,
+		// Imported items are due immediately for their first review
+		NextReviewAt: now,
+	}
+
+	videoID, timestamp, err := parseSourceVideo(field(record, 4), field(record, 5))
+	if err != nil {
+		return nil, err
+	}
+	item.SourceVideoID = videoID
+	item.VideoTimestamp = timestamp
+
+	return item, nil
+}
+
+// field returns a record column or empty when the row is too short
+func field(record []string, idx int) string {
+	if idx >= len(record) {
+		return ""
+	}
+	return record[idx]
+}
+
+// stripCloze removes Anki cloze markup, keeping the clozed text
+func stripCloze(text string) string {
+	return clozePattern.ReplaceAllString(text, "$1")
+}
+
+// parseSourceVideo extracts the video ID and timestamp from the source
+// columns; the source may be a watch URL from an export or a bare video ID
+func parseSourceVideo(source, timestamp string) (string, float64, error) {
+	source = strings.TrimSpace(source)
+
+	var seconds float64
+	if trimmed := strings.TrimSpace(timestamp); trimmed != "" {
+		parsed, err := strconv.ParseFloat(trimmed, 64)
+		if err != nil || parsed < 0 {
+			return "", 0, fmt.Errorf("invalid video timestamp %q", trimmed)
+		}
+		seconds = parsed
+	}
+
+	if source == "" {
+		return "", seconds, nil
+	}
+
+	if idx := strings.Index(source, "v="); idx >= 0 {
+		videoID := source[idx+2:]
+		if amp := strings.IndexByte(videoID, '&'); amp >= 0 {
+			videoID = videoID[:amp]
+		}
+		return videoID, seconds, nil
+	}
+	return source, seconds, nil
+}
+
+// sourceVideoURL builds the watch link for an item's source video, with the
+// timestamp so the link jumps to where the word occurred
+func sourceVideoURL(item *models.VocabItem) string {
+	if item.SourceVideoID == "" {
+		return ""
+	}
+	url := "https://www.youtube.com/watch?v=" + item.SourceVideoID
+	if item.VideoTimestamp > 0 {
+		url += fmt.Sprintf("&t=%ds", int(item.VideoTimestamp))
+	}
+	return url
+}
+
+// timestampField renders the timestamp column, empty when unset
+func timestampField(item *models.VocabItem) string {
+	if item.VideoTimestamp <= 0 {
+		return ""
+	}
+	return strconv.FormatFloat(item.VideoTimestamp, 'f', -1, 64)
+}
+
+// clozeExample wraps the first occurrence of the word in the example with
+// Anki cloze markup; examples that never mention the word stay unchanged
+func clozeExample(example, word string) string {
+	if example == "" || word == "" {
+		return example
+	}
+	idx := strings.Index(strings.ToLower(example), strings.ToLower(word))
+	if idx < 0 {
+		return example
+	}
+	match := example[idx : idx+len(word)]
+	return example[:idx] + "{{c1::" + match + "}}" + example[idx+len(word):]
+}
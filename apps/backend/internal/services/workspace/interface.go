@@ -0,0 +1,58 @@
+package workspace
+
+import (
+	"app-backend/internal/dto"
+	"app-backend/internal/models"
+)
+
+// ServiceInterface defines the contract for classroom workspace operations.
+// Role checks that depend only on the route (member vs. teacher endpoints)
+// live in the workspace authorization middleware; checks that depend on the
+// request payload, like who may invite which role, live here.
+type ServiceInterface interface {
+	// CreateWorkspace creates a workspace and makes the creator its owner
+	CreateWorkspace(userID uint, req *dto.CreateWorkspaceRequest) (*dto.WorkspaceResponse, error)
+
+	// ListWorkspaces returns the workspaces the user belongs to with their
+	// role in each
+	ListWorkspaces(userID uint) (*dto.WorkspaceListResponse, error)
+
+	// GetMembership returns the user's membership in a workspace. A
+	// non-member gets a not-found error so workspaces they do not belong
+	// to look the same as missing ones.
+	GetMembership(workspaceID uint, userID uint) (*models.WorkspaceMember, error)
+
+	// GetWorkspace returns a workspace annotated with the member's role
+	GetWorkspace(member *models.WorkspaceMember) (*dto.WorkspaceResponse, error)
+
+	// ListMembers returns a workspace's member list
+	ListMembers(workspaceID uint) (*dto.WorkspaceMembersResponse, error)
+
+	// InviteMember creates an email invitation. The owner may invite
+	// teachers and students; teachers may only invite students.
+	InviteMember(inviter *models.WorkspaceMember, req *dto.InviteWorkspaceMemberRequest) (*dto.WorkspaceInviteResponse, error)
+
+	// AcceptInvite redeems an invitation token for the authenticated user,
+	// whose account email must match the invited address
+	AcceptInvite(userID uint, email string, token string) (*dto.WorkspaceResponse, error)
+
+	// RemoveMember removes a member. The owner may remove anyone but
+	// themselves; teachers may only remove students.
+	RemoveMember(actor *models.WorkspaceMember, memberUserID uint) error
+
+	// ShareVideo adds a video to the workspace's curated list
+	ShareVideo(member *models.WorkspaceMember, req *dto.ShareWorkspaceVideoRequest) (*dto.WorkspaceVideoResponse, error)
+
+	// ListVideos returns the workspace's curated video list
+	ListVideos(workspaceID uint) (*dto.WorkspaceVideosResponse, error)
+
+	// RemoveVideo removes an entry from the curated video list
+	RemoveVideo(workspaceID uint, entryID uint) error
+
+	// ShareVocab copies items from the sharer's personal vocabulary into
+	// the workspace deck, skipping words the deck already has
+	ShareVocab(member *models.WorkspaceMember, req *dto.ShareWorkspaceVocabRequest) (*dto.ShareWorkspaceVocabResponse, error)
+
+	// ListVocab returns the workspace's shared vocabulary deck
+	ListVocab(workspaceID uint) (*dto.WorkspaceVocabResponse, error)
+}
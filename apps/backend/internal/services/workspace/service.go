@@ -0,0 +1,423 @@
+package workspace
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+)
+
+const (
+	// inviteTokenBytes is the entropy of an invitation token before hex
+	// encoding
+	inviteTokenBytes = 32
+	// inviteTTL is how long an invitation stays redeemable
+	inviteTTL = 7 * 24 * time.Hour
+)
+
+type Service struct {
+	workspaceRepo repositories.WorkspaceRepositoryInterface
+	memberRepo    repositories.WorkspaceMemberRepositoryInterface
+	inviteRepo    repositories.WorkspaceInviteRepositoryInterface
+	videoRepo     repositories.WorkspaceVideoRepositoryInterface
+	deckRepo      repositories.WorkspaceVocabRepositoryInterface
+	vocabRepo     repositories.VocabRepositoryInterface
+	userRepo      repositories.UserRepositoryInterface
+	logger        *logger.Logger
+	// now is injectable for deterministic expiry tests
+	now func() time.Time
+}
+
+func NewService(
+	workspaceRepo repositories.WorkspaceRepositoryInterface,
+	memberRepo repositories.WorkspaceMemberRepositoryInterface,
+	inviteRepo repositories.WorkspaceInviteRepositoryInterface,
+	videoRepo repositories.WorkspaceVideoRepositoryInterface,
+	deckRepo repositories.WorkspaceVocabRepositoryInterface,
+	vocabRepo repositories.VocabRepositoryInterface,
+	userRepo repositories.UserRepositoryInterface,
+	logger *logger.Logger,
+) ServiceInterface {
+	return &Service{
+		workspaceRepo: workspaceRepo,
+		memberRepo:    memberRepo,
+		inviteRepo:    inviteRepo,
+		videoRepo:     videoRepo,
+		deckRepo:      deckRepo,
+		vocabRepo:     vocabRepo,
+		userRepo:      userRepo,
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+func (s *Service) CreateWorkspace(userID uint, req *dto.CreateWorkspaceRequest) (*dto.WorkspaceResponse, error) {
+	workspace := &models.Workspace{
+		Name:        req.Name,
+		Description: req.Description,
+		OwnerID:     userID,
+	}
+	if err := s.workspaceRepo.Create(workspace); err != nil {
+		return nil, errors.NewAppError("Failed to create workspace", err, http.StatusInternalServerError)
+	}
+
+	member := &models.WorkspaceMember{
+		WorkspaceID: workspace.ID,
+		UserID:      userID,
+		Role:        models.WorkspaceRoleOwner,
+	}
+	if err := s.memberRepo.Create(member); err != nil {
+		return nil, errors.NewAppError("Failed to create workspace membership", err, http.StatusInternalServerError)
+	}
+
+	s.logger.Info("Workspace created",
+		zap.Uint("workspace_id", workspace.ID),
+		zap.Uint("owner_id", userID),
+		zap.String("name", workspace.Name))
+
+	return toWorkspaceResponse(workspace, models.WorkspaceRoleOwner), nil
+}
+
+func (s *Service) ListWorkspaces(userID uint) (*dto.WorkspaceListResponse, error) {
+	workspaces, err := s.workspaceRepo.ListByMember(userID)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to list workspaces", err, http.StatusInternalServerError)
+	}
+
+	responses := make([]dto.WorkspaceResponse, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		member, err := s.memberRepo.GetByWorkspaceAndUser(workspace.ID, userID)
+		if err != nil {
+			return nil, errors.NewAppError("Failed to load workspace membership", err, http.StatusInternalServerError)
+		}
+		responses = append(responses, *toWorkspaceResponse(workspace, member.Role))
+	}
+
+	return &dto.WorkspaceListResponse{
+		Workspaces: responses,
+		Total:      len(responses),
+	}, nil
+}
+
+func (s *Service) GetMembership(workspaceID uint, userID uint) (*models.WorkspaceMember, error) {
+	member, err := s.memberRepo.GetByWorkspaceAndUser(workspaceID, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			// A workspace the user does not belong to looks the same as a
+			// missing one
+			return nil, errors.NewAppError("Workspace not found", nil, http.StatusNotFound)
+		}
+		return nil, errors.NewAppError("Failed to load workspace membership", err, http.StatusInternalServerError)
+	}
+	return member, nil
+}
+
+func (s *Service) GetWorkspace(member *models.WorkspaceMember) (*dto.WorkspaceResponse, error) {
+	workspace, err := s.workspaceRepo.GetByID(member.WorkspaceID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewAppError("Workspace not found", nil, http.StatusNotFound)
+		}
+		return nil, errors.NewAppError("Failed to load workspace", err, http.StatusInternalServerError)
+	}
+	return toWorkspaceResponse(workspace, member.Role), nil
+}
+
+func (s *Service) ListMembers(workspaceID uint) (*dto.WorkspaceMembersResponse, error) {
+	members, err := s.memberRepo.ListByWorkspace(workspaceID)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to list workspace members", err, http.StatusInternalServerError)
+	}
+
+	responses := make([]dto.WorkspaceMemberResponse, len(members))
+	for i, member := range members {
+		responses[i] = dto.WorkspaceMemberResponse{
+			UserID:    member.UserID,
+			FirstName: member.User.FirstName,
+			LastName:  member.User.LastName,
+			Email:     member.User.Email,
+			Role:      member.Role,
+			JoinedAt:  member.CreatedAt,
+		}
+	}
+
+	return &dto.WorkspaceMembersResponse{
+		Members: responses,
+		Total:   len(responses),
+	}, nil
+}
+
+func (s *Service) InviteMember(inviter *models.WorkspaceMember, req *dto.InviteWorkspaceMemberRequest) (*dto.WorkspaceInviteResponse, error) {
+	if req.Role == models.WorkspaceRoleTeacher && inviter.Role != models.WorkspaceRoleOwner {
+		return nil, errors.NewAppError("Only the workspace owner can invite teachers", nil, http.StatusForbidden)
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+
+	// An existing account with this email must not already be a member
+	if user, err := s.userRepo.GetByEmail(email); err == nil {
+		if _, err := s.memberRepo.GetByWorkspaceAndUser(inviter.WorkspaceID, user.ID); err == nil {
+			return nil, errors.NewAppError("This user is already a workspace member", nil, http.StatusConflict)
+		}
+	}
+
+	if _, err := s.inviteRepo.GetPendingByWorkspaceAndEmail(inviter.WorkspaceID, email, s.now()); err == nil {
+		return nil, errors.NewAppError("This email already has a pending invitation", nil, http.StatusConflict)
+	}
+
+	secret := make([]byte, inviteTokenBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.NewAppError("Failed to generate invitation token", err, http.StatusInternalServerError)
+	}
+
+	invite := &models.WorkspaceInvite{
+		WorkspaceID: inviter.WorkspaceID,
+		Email:       email,
+		Role:        req.Role,
+		Token:       hex.EncodeToString(secret),
+		InvitedBy:   inviter.UserID,
+		ExpiresAt:   s.now().UTC().Add(inviteTTL),
+	}
+	if err := s.inviteRepo.Create(invite); err != nil {
+		return nil, errors.NewAppError("Failed to create invitation", err, http.StatusInternalServerError)
+	}
+
+	s.logger.Info("Workspace invitation created",
+		zap.Uint("workspace_id", inviter.WorkspaceID),
+		zap.Uint("invited_by", inviter.UserID),
+		zap.String("role", invite.Role))
+
+	return &dto.WorkspaceInviteResponse{
+		ID:          invite.ID,
+		WorkspaceID: invite.WorkspaceID,
+		Email:       invite.Email,
+		Role:        invite.Role,
+		// The token is only revealed here so it can be delivered to the
+		// invitee
+		Token:     invite.Token,
+		ExpiresAt: invite.ExpiresAt,
+	}, nil
+}
+
+func (s *Service) AcceptInvite(userID uint, email string, token string) (*dto.WorkspaceResponse, error) {
+	invite, err := s.inviteRepo.GetByToken(token)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewAppError("Invitation not found", nil, http.StatusNotFound)
+		}
+		return nil, errors.NewAppError("Failed to load invitation", err, http.StatusInternalServerError)
+	}
+
+	if invite.AcceptedAt != nil {
+		return nil, errors.NewAppError("This invitation has already been accepted", nil, http.StatusConflict)
+	}
+	if s.now().After(invite.ExpiresAt) {
+		return nil, errors.NewAppError("This invitation has expired", nil, http.StatusGone)
+	}
+	if !strings.EqualFold(invite.Email, email) {
+		return nil, errors.NewAppError("This invitation was issued to a different email address", nil, http.StatusForbidden)
+	}
+	if _, err := s.memberRepo.GetByWorkspaceAndUser(invite.WorkspaceID, userID); err == nil {
+		return nil, errors.NewAppError("You are already a member of this workspace", nil, http.StatusConflict)
+	}
+
+	member := &models.WorkspaceMember{
+		WorkspaceID: invite.WorkspaceID,
+		UserID:      userID,
+		Role:        invite.Role,
+	}
+	if err := s.memberRepo.Create(member); err != nil {
+		return nil, errors.NewAppError("Failed to join workspace", err, http.StatusInternalServerError)
+	}
+
+	acceptedAt := s.now().UTC()
+	invite.AcceptedAt = &acceptedAt
+	if err := s.inviteRepo.Update(invite); err != nil {
+		return nil, errors.NewAppError("Failed to mark invitation accepted", err, http.StatusInternalServerError)
+	}
+
+	s.logger.Info("Workspace invitation accepted",
+		zap.Uint("workspace_id", invite.WorkspaceID),
+		zap.Uint("user_id", userID),
+		zap.String("role", invite.Role))
+
+	return s.GetWorkspace(member)
+}
+
+func (s *Service) RemoveMember(actor *models.WorkspaceMember, memberUserID uint) error {
+	target, err := s.memberRepo.GetByWorkspaceAndUser(actor.WorkspaceID, memberUserID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.NewAppError("Workspace member not found", nil, http.StatusNotFound)
+		}
+		return errors.NewAppError("Failed to load workspace member", err, http.StatusInternalServerError)
+	}
+
+	if target.Role == models.WorkspaceRoleOwner {
+		return errors.NewAppError("The workspace owner cannot be removed", nil, http.StatusForbidden)
+	}
+	if actor.Role == models.WorkspaceRoleTeacher && target.Role != models.WorkspaceRoleStudent {
+		return errors.NewAppError("Teachers can only remove students", nil, http.StatusForbidden)
+	}
+
+	if err := s.memberRepo.Delete(target.ID); err != nil {
+		return errors.NewAppError("Failed to remove workspace member", err, http.StatusInternalServerError)
+	}
+
+	s.logger.Info("Workspace member removed",
+		zap.Uint("workspace_id", actor.WorkspaceID),
+		zap.Uint("removed_user_id", memberUserID),
+		zap.Uint("removed_by", actor.UserID))
+	return nil
+}
+
+func (s *Service) ShareVideo(member *models.WorkspaceMember, req *dto.ShareWorkspaceVideoRequest) (*dto.WorkspaceVideoResponse, error) {
+	if _, err := s.videoRepo.GetByWorkspaceAndVideo(member.WorkspaceID, req.VideoID); err == nil {
+		return nil, errors.NewAppError("This video is already in the workspace list", nil, http.StatusConflict)
+	}
+
+	entry := &models.WorkspaceVideo{
+		WorkspaceID: member.WorkspaceID,
+		VideoID:     req.VideoID,
+		Title:       req.Title,
+		Note:        req.Note,
+		AddedBy:     member.UserID,
+	}
+	if err := s.videoRepo.Create(entry); err != nil {
+		return nil, errors.NewAppError("Failed to share video", err, http.StatusInternalServerError)
+	}
+
+	return toWorkspaceVideoResponse(entry), nil
+}
+
+func (s *Service) ListVideos(workspaceID uint) (*dto.WorkspaceVideosResponse, error) {
+	entries, err := s.videoRepo.ListByWorkspace(workspaceID)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to list workspace videos", err, http.StatusInternalServerError)
+	}
+
+	responses := make([]dto.WorkspaceVideoResponse, len(entries))
+	for i, entry := range entries {
+		responses[i] = *toWorkspaceVideoResponse(entry)
+	}
+
+	return &dto.WorkspaceVideosResponse{
+		Videos: responses,
+		Total:  len(responses),
+	}, nil
+}
+
+func (s *Service) RemoveVideo(workspaceID uint, entryID uint) error {
+	entry, err := s.videoRepo.GetByWorkspaceAndID(workspaceID, entryID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.NewAppError("Workspace video not found", nil, http.StatusNotFound)
+		}
+		return errors.NewAppError("Failed to load workspace video", err, http.StatusInternalServerError)
+	}
+
+	if err := s.videoRepo.Delete(entry.ID); err != nil {
+		return errors.NewAppError("Failed to remove workspace video", err, http.StatusInternalServerError)
+	}
+	return nil
+}
+
+func (s *Service) ShareVocab(member *models.WorkspaceMember, req *dto.ShareWorkspaceVocabRequest) (*dto.ShareWorkspaceVocabResponse, error) {
+	result := &dto.ShareWorkspaceVocabResponse{}
+
+	for _, itemID := range req.ItemIDs {
+		item, err := s.vocabRepo.GetByUserAndID(member.UserID, itemID)
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				// Sharing is limited to the member's own vocabulary
+				return nil, errors.NewAppError("Vocabulary item not found", nil, http.StatusNotFound)
+			}
+			return nil, errors.NewAppError("Failed to load vocabulary item", err, http.StatusInternalServerError)
+		}
+
+		if _, err := s.deckRepo.GetByWorkspaceAndWord(member.WorkspaceID, item.Word); err == nil {
+			result.Duplicates++
+			continue
+		}
+
+		shared := &models.WorkspaceVocabItem{
+			WorkspaceID:     member.WorkspaceID,
+			Word:            item.Word,
+			Reading:         item.Reading,
+			Definition:      item.Definition,
+			ExampleSentence: item.ExampleSentence,
+			SourceVideoID:   item.SourceVideoID,
+			AddedBy:         member.UserID,
+		}
+		if err := s.deckRepo.Create(shared); err != nil {
+			return nil, errors.NewAppError("Failed to share vocabulary item", err, http.StatusInternalServerError)
+		}
+		result.Shared++
+	}
+
+	s.logger.Info("Vocabulary shared to workspace",
+		zap.Uint("workspace_id", member.WorkspaceID),
+		zap.Uint("user_id", member.UserID),
+		zap.Int("shared", result.Shared),
+		zap.Int("duplicates", result.Duplicates))
+
+	return result, nil
+}
+
+func (s *Service) ListVocab(workspaceID uint) (*dto.WorkspaceVocabResponse, error) {
+	items, err := s.deckRepo.ListByWorkspace(workspaceID)
+	if err != nil {
+		return nil, errors.NewAppError("Failed to list workspace vocabulary", err, http.StatusInternalServerError)
+	}
+
+	responses := make([]dto.WorkspaceVocabItemResponse, len(items))
+	for i, item := range items {
+		responses[i] = dto.WorkspaceVocabItemResponse{
+			ID:              item.ID,
+			Word:            item.Word,
+			Reading:         item.Reading,
+			Definition:      item.Definition,
+			ExampleSentence: item.ExampleSentence,
+			SourceVideoID:   item.SourceVideoID,
+			AddedBy:         item.AddedBy,
+			CreatedAt:       item.CreatedAt,
+		}
+	}
+
+	return &dto.WorkspaceVocabResponse{
+		Items: responses,
+		Total: len(responses),
+	}, nil
+}
+
+func toWorkspaceResponse(workspace *models.Workspace, role string) *dto.WorkspaceResponse {
+	return &dto.WorkspaceResponse{
+		ID:          workspace.ID,
+		Name:        workspace.Name,
+		Description: workspace.Description,
+		OwnerID:     workspace.OwnerID,
+		Role:        role,
+		CreatedAt:   workspace.CreatedAt,
+	}
+}
+
+func toWorkspaceVideoResponse(entry *models.WorkspaceVideo) *dto.WorkspaceVideoResponse {
+	return &dto.WorkspaceVideoResponse{
+		ID:        entry.ID,
+		VideoID:   entry.VideoID,
+		Title:     entry.Title,
+		Note:      entry.Note,
+		AddedBy:   entry.AddedBy,
+		CreatedAt: entry.CreatedAt,
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"errors"
 
 	"github.com/gin-gonic/gin"
@@ -44,12 +45,33 @@ func GetUserContext(c *gin.Context) (*UserContext, error) {
 	}, nil
 }
 
-// SetUserContext sets user context in gin.Context
+// SetUserContext sets user context in gin.Context and threads it into the
+// request's context.Context so non-HTTP layers can read it via ctxutil
 func SetUserContext(c *gin.Context, userCtx *UserContext) {
 	c.Set("user_id", userCtx.UserID)
 	c.Set("user_email", userCtx.Email)
 	c.Set("user_role", userCtx.Role)
 	c.Set("session_id", userCtx.SessionID)
+	c.Request = c.Request.WithContext(NewUserContext(c.Request.Context(), userCtx))
+}
+
+// userContextKey is a private type so only this package can write the value
+type userContextKey struct{}
+
+// NewUserContext returns a context carrying the given user context
+func NewUserContext(ctx context.Context, userCtx *UserContext) context.Context {
+	if userCtx == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, userContextKey{}, userCtx)
+}
+
+// UserFromContext returns the user context stored in the context, or false
+// when none is set. It accepts both a plain context.Context and a
+// *gin.Context, whose Value falls through to the request context.
+func UserFromContext(ctx context.Context) (*UserContext, bool) {
+	userCtx, ok := ctx.Value(userContextKey{}).(*UserContext)
+	return userCtx, ok
 }
 
 // IsAdmin checks if the user has admin role
@@ -75,4 +97,4 @@ func (uc *UserContext) HasRole(roles ...string) bool {
 // HasPermission checks if the user has admin or moderator permissions
 func (uc *UserContext) HasPermission() bool {
 	return uc.IsAdmin() || uc.IsModerator()
-}
\ No newline at end of file
+}
@@ -59,7 +59,7 @@ func (p *PaginationRequest) GetOrderBy() string {
 // NewPaginationResponse creates a new paginated response
 func NewPaginationResponse[T any](data []T, req *PaginationRequest, totalRecords int64) *PaginationResponse[T] {
 	totalPages := int((totalRecords + int64(req.PageSize) - 1) / int64(req.PageSize))
-	
+
 	return &PaginationResponse[T]{
 		Data: data,
 		Pagination: PaginationMetadata{
@@ -71,4 +71,4 @@ func NewPaginationResponse[T any](data []T, req *PaginationRequest, totalRecords
 			HasPrev:      req.Page > 1,
 		},
 	}
-}
\ No newline at end of file
+}
@@ -35,13 +35,14 @@ func (d *MillisecondDuration) UnmarshalJSON(data []byte) error {
 
 // VideoInfo represents basic information about a video
 type VideoInfo struct {
-	ID          string               `json:"id"`
-	Provider    VideoProvider        `json:"provider"`
-	Title       string               `json:"title"`
-	Description string               `json:"description"`
-	Duration    MillisecondDuration  `json:"duration"`
+	ID           string              `json:"id"`
+	Provider     VideoProvider       `json:"provider"`
+	Title        string              `json:"title"`
+	Author       string              `json:"author,omitempty"`
+	Description  string              `json:"description"`
+	Duration     MillisecondDuration `json:"duration"`
 	ThumbnailURL string              `json:"thumbnailUrl"`
-	URL         string               `json:"url"`
+	URL          string              `json:"url"`
 }
 
 // TranscriptSegment represents a single segment of transcript
@@ -64,31 +65,71 @@ type Transcript struct {
 
 // TranslatedSegment represents a translated transcript segment
 type TranslatedSegment struct {
-	Index         int    `json:"index"`
-	OriginalText  string `json:"originalText"`
+	Index          int    `json:"index"`
+	OriginalText   string `json:"originalText"`
 	TranslatedText string `json:"translatedText"`
 }
 
 // DualLanguageTranscript represents transcript in two languages
 type DualLanguageTranscript struct {
-	VideoID      string               `json:"videoId"`
-	Provider     VideoProvider        `json:"provider"`
-	SourceLang   string               `json:"sourceLang"`
-	TargetLang   string               `json:"targetLang"`
-	Segments     []TranscriptSegment  `json:"segments"`
-	Translations []TranslatedSegment  `json:"translations"`
-	Cached       bool                 `json:"cached"`
+	VideoID      string              `json:"videoId"`
+	Provider     VideoProvider       `json:"provider"`
+	SourceLang   string              `json:"sourceLang"`
+	TargetLang   string              `json:"targetLang"`
+	Segments     []TranscriptSegment `json:"segments"`
+	Translations []TranslatedSegment `json:"translations"`
+	Cached       bool                `json:"cached"`
 }
 
 // Language represents a supported language
 type Language struct {
 	Code string `json:"code"`
 	Name string `json:"name"`
+	// NativeName is the language's name in the language itself (endonym)
+	NativeName string `json:"nativeName,omitempty"`
+	// RTL marks languages written right-to-left so clients can set text
+	// direction without keeping their own script tables
+	RTL bool `json:"rtl,omitempty"`
 }
 
 // VideoCapabilities represents what features are available for a video
 type VideoCapabilities struct {
-	HasTranscript        bool       `json:"hasTranscript"`
-	AvailableLanguages   []Language `json:"availableLanguages"`
-	SupportsAutoGenerated bool       `json:"supportsAutoGenerated"`
-}
\ No newline at end of file
+	HasTranscript         bool                `json:"hasTranscript"`
+	AvailableLanguages    []Language          `json:"availableLanguages"`
+	SupportsAutoGenerated bool                `json:"supportsAutoGenerated"`
+	TranscriptEstimate    *TranscriptEstimate `json:"transcriptEstimate,omitempty"`
+}
+
+// TranscriptEstimate is a cheap estimate of transcript size derived from
+// video metadata, so clients can set progress expectations before fetching
+type TranscriptEstimate struct {
+	SegmentCount    int   `json:"segmentCount"`
+	DurationSeconds int64 `json:"durationSeconds"`
+}
+
+// DefaultEstimateSegmentSeconds is the assumed average caption segment
+// length used when no override is configured
+const DefaultEstimateSegmentSeconds = 4
+
+// EstimateTranscript derives a transcript size estimate from the video
+// duration without downloading any caption content; nil when the duration
+// is unknown
+func EstimateTranscript(duration time.Duration, segmentSeconds int) *TranscriptEstimate {
+	if duration <= 0 {
+		return nil
+	}
+	if segmentSeconds <= 0 {
+		segmentSeconds = DefaultEstimateSegmentSeconds
+	}
+
+	seconds := int64(duration / time.Second)
+	count := int((seconds + int64(segmentSeconds) - 1) / int64(segmentSeconds))
+	if count < 1 {
+		count = 1
+	}
+
+	return &TranscriptEstimate{
+		SegmentCount:    count,
+		DurationSeconds: seconds,
+	}
+}
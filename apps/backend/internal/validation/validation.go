@@ -0,0 +1,96 @@
+// Package validation centralizes request binding. The Bind helpers run gin's
+// go-playground validators plus the domain-specific ones registered below
+// and turn failures into the standardized field-level AppError, so handlers
+// stop shaping binding errors individually.
+package validation
+
+import (
+	"encoding/base64"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"app-backend/internal/errors"
+)
+
+// langCodePattern matches ISO 639 language codes with optional BCP-47
+// subtags, e.g. "en", "pt-BR", "zh-Hans"
+var langCodePattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// videoIDPattern matches bare platform video IDs, e.g. a YouTube ID
+var videoIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{5,64}$`)
+
+func init() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		_ = v.RegisterValidation("video_url", validateVideoURL)
+		_ = v.RegisterValidation("lang_code", validateLangCode)
+		_ = v.RegisterValidation("b64url", validateB64URLParam)
+	}
+}
+
+// BindJSON binds the JSON body into obj, returning a field-level AppError on
+// failure. Handlers pass the result straight to response.Error.
+func BindJSON(c *gin.Context, obj any) error {
+	if err := c.ShouldBindJSON(obj); err != nil {
+		return errors.HandleValidationError(err)
+	}
+	return nil
+}
+
+// BindQuery binds query parameters into obj, returning a field-level
+// AppError on failure
+func BindQuery(c *gin.Context, obj any) error {
+	if err := c.ShouldBindQuery(obj); err != nil {
+		return errors.HandleValidationError(err)
+	}
+	return nil
+}
+
+// BindURI binds path parameters into obj, returning a field-level AppError
+// on failure
+func BindURI(c *gin.Context, obj any) error {
+	if err := c.ShouldBindUri(obj); err != nil {
+		return errors.HandleValidationError(err)
+	}
+	return nil
+}
+
+// validateVideoURL accepts an http(s) video URL or a bare platform video ID,
+// the two forms the resolve endpoint recognizes
+func validateVideoURL(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	if strings.Contains(value, "://") {
+		parsed, err := url.Parse(value)
+		if err != nil {
+			return false
+		}
+		return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+	}
+	return videoIDPattern.MatchString(value)
+}
+
+// validateLangCode accepts ISO language codes with optional subtags; whether
+// a well-formed code is actually supported stays with the languages service
+func validateLangCode(fl validator.FieldLevel) bool {
+	return langCodePattern.MatchString(fl.Field().String())
+}
+
+// validateB64URLParam accepts path parameters in the encodings DecodeVideoURL
+// understands: base64url (padded or raw) or a percent-encoded value
+func validateB64URLParam(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	for _, encoding := range []*base64.Encoding{base64.URLEncoding, base64.RawURLEncoding} {
+		if decoded, err := encoding.DecodeString(value); err == nil {
+			if utf8.ValidString(string(decoded)) {
+				return true
+			}
+		}
+	}
+	_, err := url.QueryUnescape(value)
+	return err == nil
+}
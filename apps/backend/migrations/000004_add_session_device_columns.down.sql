@@ -0,0 +1,3 @@
+ALTER TABLE sessions DROP COLUMN IF EXISTS browser;
+ALTER TABLE sessions DROP COLUMN IF EXISTS os;
+ALTER TABLE sessions DROP COLUMN IF EXISTS location;
@@ -0,0 +1,4 @@
+-- Device and location enrichment parsed at login for friendly session labels
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS browser VARCHAR(64) DEFAULT '';
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS os VARCHAR(64) DEFAULT '';
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS location VARCHAR(128) DEFAULT '';
@@ -0,0 +1,7 @@
+DO $$
+BEGIN
+    IF to_regclass('vocab_items') IS NOT NULL THEN
+        ALTER TABLE vocab_items DROP COLUMN IF EXISTS reading;
+    END IF;
+END
+$$;
@@ -0,0 +1,11 @@
+-- Optional pronunciation aid (furigana, pinyin, phonetic transcription) so
+-- imported Anki decks keep their reading field. Guarded because vocab_items
+-- only exists here on databases provisioned by AutoMigrate; fresh databases
+-- get the table (with reading) from 000006.
+DO $$
+BEGIN
+    IF to_regclass('vocab_items') IS NOT NULL THEN
+        ALTER TABLE vocab_items ADD COLUMN IF NOT EXISTS reading VARCHAR(200) DEFAULT '';
+    END IF;
+END
+$$;
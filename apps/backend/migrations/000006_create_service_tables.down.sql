@@ -0,0 +1,29 @@
+-- Drop the service tables introduced by AutoMigrate catch-up
+DO $$
+DECLARE
+    tbl TEXT;
+BEGIN
+    FOREACH tbl IN ARRAY ARRAY[
+        'provider_outcomes', 'api_keys', 'translation_usage', 'vocab_items',
+        'user_videos', 'oauth_tokens', 'jobs', 'transcript_segment_index',
+        'transcript_corrections', 'admin_audit_logs', 'audit_logs'
+    ] LOOP
+        EXECUTE format('DROP TRIGGER IF EXISTS update_%I_updated_at ON %I', tbl, tbl);
+    END LOOP;
+END
+$$;
+
+DROP TABLE IF EXISTS audit_logs;
+DROP TABLE IF EXISTS admin_audit_logs;
+DROP TABLE IF EXISTS transcript_corrections;
+DROP TABLE IF EXISTS transcript_segment_index;
+DROP TABLE IF EXISTS jobs;
+DROP TABLE IF EXISTS oauth_tokens;
+DROP TABLE IF EXISTS user_videos;
+DROP TABLE IF EXISTS vocab_items;
+DROP TABLE IF EXISTS translation_usage;
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS provider_outcomes;
+
+ALTER TABLE video_transcript_cache DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE video_translation_cache DROP COLUMN IF EXISTS deleted_at;
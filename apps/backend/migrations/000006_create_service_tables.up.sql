@@ -0,0 +1,240 @@
+-- Catch-up migration: create every table that was previously managed by
+-- GORM AutoMigrate so the SQL migrations are the single source of truth for
+-- the schema. All statements are idempotent because existing deployments
+-- already have these tables from AutoMigrate.
+
+-- Transcript provider analytics
+CREATE TABLE IF NOT EXISTS provider_outcomes (
+    id BIGSERIAL PRIMARY KEY,
+    video_id VARCHAR(255) NOT NULL,
+    provider VARCHAR(50) NOT NULL,
+    served BOOLEAN DEFAULT false,
+    success BOOLEAN DEFAULT false,
+    error_code VARCHAR(100),
+    latency_ms BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_provider_outcomes_video_id ON provider_outcomes(video_id);
+CREATE INDEX IF NOT EXISTS idx_provider_outcomes_provider ON provider_outcomes(provider);
+CREATE INDEX IF NOT EXISTS idx_provider_outcomes_deleted_at ON provider_outcomes(deleted_at);
+
+-- Programmatic access keys (hash only; the raw key is shown once)
+CREATE TABLE IF NOT EXISTS api_keys (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    key_hash VARCHAR(255) NOT NULL,
+    prefix VARCHAR(50) NOT NULL,
+    scopes TEXT,
+    is_active BOOLEAN DEFAULT true,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_used TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_key_hash ON api_keys(key_hash);
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys(user_id);
+CREATE INDEX IF NOT EXISTS idx_api_keys_deleted_at ON api_keys(deleted_at);
+
+-- Per-user translation quota accounting
+CREATE TABLE IF NOT EXISTS translation_usage (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    char_count INTEGER NOT NULL,
+    target_lang VARCHAR(10) NOT NULL,
+    provider VARCHAR(50),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_translation_usage_user_id ON translation_usage(user_id);
+CREATE INDEX IF NOT EXISTS idx_translation_usage_created_at ON translation_usage(created_at);
+CREATE INDEX IF NOT EXISTS idx_translation_usage_deleted_at ON translation_usage(deleted_at);
+
+-- Saved vocabulary with SM-2 spaced-repetition state
+CREATE TABLE IF NOT EXISTS vocab_items (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    word VARCHAR(255) NOT NULL,
+    reading VARCHAR(200) DEFAULT '',
+    definition TEXT,
+    example_sentence TEXT,
+    source_video_id VARCHAR(255),
+    video_timestamp DOUBLE PRECISION,
+    ease_factor DOUBLE PRECISION DEFAULT 2.5,
+    interval_days INTEGER DEFAULT 0,
+    repetitions INTEGER DEFAULT 0,
+    next_review_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_vocab_items_user_id ON vocab_items(user_id);
+CREATE INDEX IF NOT EXISTS idx_vocab_items_next_review_at ON vocab_items(next_review_at);
+CREATE INDEX IF NOT EXISTS idx_vocab_items_deleted_at ON vocab_items(deleted_at);
+
+-- Per-user study progress per video
+CREATE TABLE IF NOT EXISTS user_videos (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    video_id VARCHAR(255) NOT NULL,
+    title VARCHAR(500),
+    last_position DOUBLE PRECISION,
+    source_language VARCHAR(10),
+    target_language VARCHAR(10),
+    progress_percent DOUBLE PRECISION,
+    last_watched_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_videos_user_video ON user_videos(user_id, video_id);
+CREATE INDEX IF NOT EXISTS idx_user_videos_last_watched_at ON user_videos(last_watched_at);
+CREATE INDEX IF NOT EXISTS idx_user_videos_deleted_at ON user_videos(deleted_at);
+
+-- Encrypted OAuth tokens per user and provider
+CREATE TABLE IF NOT EXISTS oauth_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider VARCHAR(50) NOT NULL,
+    ciphertext TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_oauth_tokens_user_provider ON oauth_tokens(user_id, provider);
+CREATE INDEX IF NOT EXISTS idx_oauth_tokens_deleted_at ON oauth_tokens(deleted_at);
+
+-- Background job queue
+CREATE TABLE IF NOT EXISTS jobs (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    type VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'queued',
+    payload TEXT,
+    result TEXT,
+    last_error TEXT,
+    attempts INTEGER DEFAULT 0,
+    max_attempts INTEGER DEFAULT 3,
+    next_run_at TIMESTAMP WITH TIME ZONE,
+    started_at TIMESTAMP WITH TIME ZONE,
+    finished_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_jobs_user_id ON jobs(user_id);
+CREATE INDEX IF NOT EXISTS idx_jobs_type ON jobs(type);
+CREATE INDEX IF NOT EXISTS idx_jobs_status ON jobs(status);
+CREATE INDEX IF NOT EXISTS idx_jobs_next_run_at ON jobs(next_run_at);
+CREATE INDEX IF NOT EXISTS idx_jobs_deleted_at ON jobs(deleted_at);
+
+-- Flattened transcript segments for cross-video search
+CREATE TABLE IF NOT EXISTS transcript_segment_index (
+    id BIGSERIAL PRIMARY KEY,
+    video_id VARCHAR(255) NOT NULL,
+    language VARCHAR(10) NOT NULL,
+    segment_index INTEGER NOT NULL,
+    text TEXT NOT NULL,
+    start_ms BIGINT,
+    duration_ms BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_transcript_segment_index_video_lang ON transcript_segment_index(video_id, language);
+CREATE INDEX IF NOT EXISTS idx_transcript_segment_index_deleted_at ON transcript_segment_index(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_transcript_segment_index_text_fts
+    ON transcript_segment_index USING GIN (to_tsvector('simple', text));
+
+-- Append-only owner edits to saved-video transcripts
+CREATE TABLE IF NOT EXISTS transcript_corrections (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    video_id VARCHAR(255) NOT NULL,
+    language VARCHAR(10),
+    segment_index INTEGER NOT NULL,
+    text TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_transcript_corrections_user_video ON transcript_corrections(user_id, video_id);
+CREATE INDEX IF NOT EXISTS idx_transcript_corrections_deleted_at ON transcript_corrections(deleted_at);
+
+-- Admin actions against user accounts
+CREATE TABLE IF NOT EXISTS admin_audit_logs (
+    id BIGSERIAL PRIMARY KEY,
+    actor_id BIGINT NOT NULL,
+    target_user_id BIGINT NOT NULL,
+    action VARCHAR(100) NOT NULL,
+    detail TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_audit_logs_actor_id ON admin_audit_logs(actor_id);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_logs_target_user_id ON admin_audit_logs(target_user_id);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_logs_deleted_at ON admin_audit_logs(deleted_at);
+
+-- Security-relevant events with request metadata
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id BIGSERIAL PRIMARY KEY,
+    actor_id BIGINT,
+    target_user_id BIGINT,
+    action VARCHAR(100) NOT NULL,
+    detail TEXT,
+    ip_address VARCHAR(64),
+    user_agent TEXT,
+    request_id VARCHAR(64),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor_id ON audit_logs(actor_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_target_user_id ON audit_logs(target_user_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action ON audit_logs(action);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_deleted_at ON audit_logs(deleted_at);
+
+-- AutoMigrate added soft-delete support to the cache tables after their
+-- original migration; bring migrated schemas up to date
+ALTER TABLE video_transcript_cache ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE video_translation_cache ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+-- Keep updated_at maintained by the shared trigger function from 000001
+DO $$
+DECLARE
+    tbl TEXT;
+BEGIN
+    FOREACH tbl IN ARRAY ARRAY[
+        'provider_outcomes', 'api_keys', 'translation_usage', 'vocab_items',
+        'user_videos', 'oauth_tokens', 'jobs', 'transcript_segment_index',
+        'transcript_corrections', 'admin_audit_logs', 'audit_logs'
+    ] LOOP
+        IF NOT EXISTS (
+            SELECT 1 FROM pg_trigger
+            WHERE tgname = 'update_' || tbl || '_updated_at'
+        ) THEN
+            EXECUTE format(
+                'CREATE TRIGGER update_%I_updated_at BEFORE UPDATE ON %I FOR EACH ROW EXECUTE FUNCTION update_updated_at_column()',
+                tbl, tbl
+            );
+        END IF;
+    END LOOP;
+END
+$$;
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_pronunciation_attempts_updated_at ON pronunciation_attempts;
+DROP TABLE IF EXISTS pronunciation_attempts;
@@ -0,0 +1,31 @@
+-- Scored pronunciation practice attempts, kept per user for progress graphs
+CREATE TABLE IF NOT EXISTS pronunciation_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    video_id VARCHAR(255),
+    language VARCHAR(10),
+    reference_text TEXT NOT NULL,
+    overall_score DOUBLE PRECISION NOT NULL,
+    word_scores TEXT,
+    provider VARCHAR(50),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_pronunciation_attempts_user_id ON pronunciation_attempts(user_id);
+CREATE INDEX IF NOT EXISTS idx_pronunciation_attempts_video_id ON pronunciation_attempts(video_id);
+CREATE INDEX IF NOT EXISTS idx_pronunciation_attempts_deleted_at ON pronunciation_attempts(deleted_at);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger
+        WHERE tgname = 'update_pronunciation_attempts_updated_at'
+    ) THEN
+        CREATE TRIGGER update_pronunciation_attempts_updated_at
+            BEFORE UPDATE ON pronunciation_attempts
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END
+$$;
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS update_quiz_submissions_updated_at ON quiz_submissions;
+DROP TABLE IF EXISTS quiz_submissions;
+
+DROP TRIGGER IF EXISTS update_quizzes_updated_at ON quizzes;
+DROP TABLE IF EXISTS quizzes;
@@ -0,0 +1,56 @@
+-- Generated practice quizzes and the graded answers users submit for them
+CREATE TABLE IF NOT EXISTS quizzes (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    video_id VARCHAR(255),
+    language VARCHAR(10),
+    seed BIGINT NOT NULL,
+    questions TEXT NOT NULL,
+    question_count INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_quizzes_user_id ON quizzes(user_id);
+CREATE INDEX IF NOT EXISTS idx_quizzes_video_id ON quizzes(video_id);
+CREATE INDEX IF NOT EXISTS idx_quizzes_deleted_at ON quizzes(deleted_at);
+
+CREATE TABLE IF NOT EXISTS quiz_submissions (
+    id BIGSERIAL PRIMARY KEY,
+    quiz_id BIGINT NOT NULL REFERENCES quizzes(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    answers TEXT,
+    score DOUBLE PRECISION NOT NULL,
+    correct_count INTEGER NOT NULL,
+    question_count INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_quiz_submissions_quiz_id ON quiz_submissions(quiz_id);
+CREATE INDEX IF NOT EXISTS idx_quiz_submissions_user_id ON quiz_submissions(user_id);
+CREATE INDEX IF NOT EXISTS idx_quiz_submissions_deleted_at ON quiz_submissions(deleted_at);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger
+        WHERE tgname = 'update_quizzes_updated_at'
+    ) THEN
+        CREATE TRIGGER update_quizzes_updated_at
+            BEFORE UPDATE ON quizzes
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger
+        WHERE tgname = 'update_quiz_submissions_updated_at'
+    ) THEN
+        CREATE TRIGGER update_quiz_submissions_updated_at
+            BEFORE UPDATE ON quiz_submissions
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END
+$$;
@@ -0,0 +1,14 @@
+DROP TRIGGER IF EXISTS update_workspace_vocab_items_updated_at ON workspace_vocab_items;
+DROP TABLE IF EXISTS workspace_vocab_items;
+
+DROP TRIGGER IF EXISTS update_workspace_videos_updated_at ON workspace_videos;
+DROP TABLE IF EXISTS workspace_videos;
+
+DROP TRIGGER IF EXISTS update_workspace_invites_updated_at ON workspace_invites;
+DROP TABLE IF EXISTS workspace_invites;
+
+DROP TRIGGER IF EXISTS update_workspace_members_updated_at ON workspace_members;
+DROP TABLE IF EXISTS workspace_members;
+
+DROP TRIGGER IF EXISTS update_workspaces_updated_at ON workspaces;
+DROP TABLE IF EXISTS workspaces;
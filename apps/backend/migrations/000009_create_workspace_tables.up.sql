@@ -0,0 +1,132 @@
+-- Classroom workspaces: membership with roles, email invitations, and the
+-- shared video lists and vocabulary decks curated within each workspace
+CREATE TABLE IF NOT EXISTS workspaces (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    owner_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_workspaces_owner_id ON workspaces(owner_id);
+CREATE INDEX IF NOT EXISTS idx_workspaces_deleted_at ON workspaces(deleted_at);
+
+CREATE TABLE IF NOT EXISTS workspace_members (
+    id BIGSERIAL PRIMARY KEY,
+    workspace_id BIGINT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workspace_members_workspace_user
+    ON workspace_members(workspace_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_workspace_members_user_id ON workspace_members(user_id);
+CREATE INDEX IF NOT EXISTS idx_workspace_members_deleted_at ON workspace_members(deleted_at);
+
+CREATE TABLE IF NOT EXISTS workspace_invites (
+    id BIGSERIAL PRIMARY KEY,
+    workspace_id BIGINT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    role VARCHAR(20) NOT NULL,
+    token VARCHAR(128) NOT NULL,
+    invited_by BIGINT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    accepted_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workspace_invites_token ON workspace_invites(token);
+CREATE INDEX IF NOT EXISTS idx_workspace_invites_workspace_id ON workspace_invites(workspace_id);
+CREATE INDEX IF NOT EXISTS idx_workspace_invites_email ON workspace_invites(email);
+CREATE INDEX IF NOT EXISTS idx_workspace_invites_deleted_at ON workspace_invites(deleted_at);
+
+CREATE TABLE IF NOT EXISTS workspace_videos (
+    id BIGSERIAL PRIMARY KEY,
+    workspace_id BIGINT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    video_id VARCHAR(255) NOT NULL,
+    title VARCHAR(500),
+    note TEXT,
+    added_by BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workspace_videos_workspace_video
+    ON workspace_videos(workspace_id, video_id);
+CREATE INDEX IF NOT EXISTS idx_workspace_videos_deleted_at ON workspace_videos(deleted_at);
+
+CREATE TABLE IF NOT EXISTS workspace_vocab_items (
+    id BIGSERIAL PRIMARY KEY,
+    workspace_id BIGINT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    word VARCHAR(255) NOT NULL,
+    reading VARCHAR(255),
+    definition TEXT,
+    example_sentence TEXT,
+    source_video_id VARCHAR(255),
+    added_by BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_workspace_vocab_items_workspace_id
+    ON workspace_vocab_items(workspace_id);
+CREATE INDEX IF NOT EXISTS idx_workspace_vocab_items_deleted_at
+    ON workspace_vocab_items(deleted_at);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger
+        WHERE tgname = 'update_workspaces_updated_at'
+    ) THEN
+        CREATE TRIGGER update_workspaces_updated_at
+            BEFORE UPDATE ON workspaces
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger
+        WHERE tgname = 'update_workspace_members_updated_at'
+    ) THEN
+        CREATE TRIGGER update_workspace_members_updated_at
+            BEFORE UPDATE ON workspace_members
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger
+        WHERE tgname = 'update_workspace_invites_updated_at'
+    ) THEN
+        CREATE TRIGGER update_workspace_invites_updated_at
+            BEFORE UPDATE ON workspace_invites
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger
+        WHERE tgname = 'update_workspace_videos_updated_at'
+    ) THEN
+        CREATE TRIGGER update_workspace_videos_updated_at
+            BEFORE UPDATE ON workspace_videos
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger
+        WHERE tgname = 'update_workspace_vocab_items_updated_at'
+    ) THEN
+        CREATE TRIGGER update_workspace_vocab_items_updated_at
+            BEFORE UPDATE ON workspace_vocab_items
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END
+$$;
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_daily_activities_updated_at ON daily_activities;
+DROP TABLE IF EXISTS daily_activities;
@@ -0,0 +1,31 @@
+-- Per-user per-day study activity aggregates behind the streak and
+-- statistics endpoint
+CREATE TABLE IF NOT EXISTS daily_activities (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    date VARCHAR(10) NOT NULL,
+    videos_watched INTEGER NOT NULL DEFAULT 0,
+    segments_translated INTEGER NOT NULL DEFAULT 0,
+    vocab_reviewed INTEGER NOT NULL DEFAULT 0,
+    study_seconds INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_daily_activities_user_date
+    ON daily_activities(user_id, date);
+CREATE INDEX IF NOT EXISTS idx_daily_activities_deleted_at ON daily_activities(deleted_at);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger
+        WHERE tgname = 'update_daily_activities_updated_at'
+    ) THEN
+        CREATE TRIGGER update_daily_activities_updated_at
+            BEFORE UPDATE ON daily_activities
+            FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END
+$$;
@@ -0,0 +1,8 @@
+// Package migrations embeds the versioned SQL migration files so the server
+// and migrate binaries can apply them without the files present on disk.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var Files embed.FS
@@ -0,0 +1,90 @@
+package gemini
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/generative-ai-go/genai"
+)
+
+// Chapter is one inferred topic boundary in a video transcript
+type Chapter struct {
+	Title string `json:"title"`
+	// StartSeconds is where the chapter begins in the video
+	StartSeconds float64 `json:"start_seconds"`
+}
+
+// BuildChaptersPrompt creates the constrained prompt for chapter detection.
+// The transcript is expected as one "[<seconds>s] text" line per segment;
+// the model is held to a fixed JSON shape so the response can be validated.
+func BuildChaptersPrompt(transcript string) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("You are segmenting a video transcript into chapters for player navigation. ")
+	prompt.WriteString("Each transcript line starts with the segment's start time in seconds, like [123s]. ")
+	prompt.WriteString("Find the points where the topic shifts and respond with a single JSON array and nothing else. ")
+	prompt.WriteString(`Each element is an object with "title" (a short chapter name in the transcript's language) and "start_seconds" (the time of a transcript line where the chapter begins). `)
+	prompt.WriteString("The first chapter must start at 0, chapters must be in ascending order, and a chapter should cover at least a minute unless the video is very short. ")
+	prompt.WriteString("Do not wrap the JSON in markdown or add any commentary.\n\nTranscript:\n")
+	prompt.WriteString(transcript)
+
+	return prompt.String()
+}
+
+// ParseChapters validates a model response against the chapter schema.
+// Markdown code fences the model sometimes adds despite instructions are
+// stripped before parsing.
+func ParseChapters(raw string) ([]Chapter, error) {
+	cleaned := strings.TrimSpace(raw)
+	cleaned = strings.TrimPrefix(cleaned, "```json")
+	cleaned = strings.TrimPrefix(cleaned, "```")
+	cleaned = strings.TrimSuffix(cleaned, "```")
+	cleaned = strings.TrimSpace(cleaned)
+
+	var chapters []Chapter
+	if err := json.Unmarshal([]byte(cleaned), &chapters); err != nil {
+		return nil, fmt.Errorf("chapters response is not valid JSON: %w", err)
+	}
+
+	if len(chapters) == 0 {
+		return nil, fmt.Errorf("chapters response is empty")
+	}
+	for i, chapter := range chapters {
+		if chapter.Title == "" {
+			return nil, fmt.Errorf("chapter %d has no title", i)
+		}
+		if i > 0 && chapter.StartSeconds <= chapters[i-1].StartSeconds {
+			return nil, fmt.Errorf("chapter %d is not in ascending order", i)
+		}
+	}
+
+	return chapters, nil
+}
+
+// DetectChapters asks the model to infer topic boundaries in a video
+// transcript, for videos whose description carries no chapter markers
+func (s *Service) DetectChapters(ctx context.Context, transcript string) ([]Chapter, error) {
+	if transcript == "" {
+		return nil, fmt.Errorf("transcript is required for chapter detection")
+	}
+
+	if err := s.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	prompt := BuildChaptersPrompt(transcript)
+
+	resp, err := s.genModel().GenerateContent(ctx, genai.Text(prompt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect chapters: %w", err)
+	}
+
+	text := ExtractText(resp)
+	if text == "" {
+		return nil, fmt.Errorf("no chapters generated")
+	}
+
+	return ParseChapters(text)
+}
@@ -0,0 +1,96 @@
+package gemini
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/generative-ai-go/genai"
+)
+
+// DictionaryEntry is the structured result of a contextual word lookup
+type DictionaryEntry struct {
+	Word         string   `json:"word"`
+	Definition   string   `json:"definition"`
+	PartOfSpeech string   `json:"part_of_speech"`
+	IPA          string   `json:"ipa"`
+	Examples     []string `json:"examples"`
+	Translation  string   `json:"translation,omitempty"`
+}
+
+// BuildDictionaryPrompt creates the constrained prompt for a word lookup. The
+// surrounding sentence disambiguates polysemous words ("bank", "run"), and
+// the model is held to a fixed JSON shape so the response can be validated.
+func BuildDictionaryPrompt(word string, sentence string, targetLang string) string {
+	var prompt strings.Builder
+
+	prompt.WriteString(fmt.Sprintf("Explain the word %q as it is used in this sentence: %q. ", word, sentence))
+	prompt.WriteString("Respond with a single JSON object and nothing else, using exactly these keys: ")
+	prompt.WriteString(`"word" (the word in its dictionary form), `)
+	prompt.WriteString(`"definition" (the meaning in this context, one or two sentences), `)
+	prompt.WriteString(`"part_of_speech" (noun, verb, adjective, adverb, or similar), `)
+	prompt.WriteString(`"ipa" (the IPA pronunciation without slashes), `)
+	prompt.WriteString(`"examples" (an array of 2 new example sentences using the word in the same sense)`)
+	if targetLang != "" {
+		prompt.WriteString(fmt.Sprintf(`, "translation" (the word translated to %s in this sense)`, targetLang))
+	}
+	prompt.WriteString(". Do not wrap the JSON in markdown or add any commentary.")
+
+	return prompt.String()
+}
+
+// ParseDictionaryEntry validates a model response against the dictionary
+// entry schema. Markdown code fences the model sometimes adds despite
+// instructions are stripped before parsing, and entries missing required
+// fields are rejected rather than surfaced half-empty.
+func ParseDictionaryEntry(raw string) (*DictionaryEntry, error) {
+	cleaned := strings.TrimSpace(raw)
+	cleaned = strings.TrimPrefix(cleaned, "```json")
+	cleaned = strings.TrimPrefix(cleaned, "```")
+	cleaned = strings.TrimSuffix(cleaned, "```")
+	cleaned = strings.TrimSpace(cleaned)
+
+	var entry DictionaryEntry
+	if err := json.Unmarshal([]byte(cleaned), &entry); err != nil {
+		return nil, fmt.Errorf("dictionary response is not valid JSON: %w", err)
+	}
+
+	if entry.Word == "" {
+		return nil, fmt.Errorf("dictionary response is missing the word")
+	}
+	if entry.Definition == "" {
+		return nil, fmt.Errorf("dictionary response is missing the definition")
+	}
+	if entry.PartOfSpeech == "" {
+		return nil, fmt.Errorf("dictionary response is missing the part of speech")
+	}
+
+	return &entry, nil
+}
+
+// LookupWord asks the model for a structured dictionary entry for a word in
+// the context of its surrounding transcript sentence
+func (s *Service) LookupWord(ctx context.Context, word string, sentence string, targetLang string) (*DictionaryEntry, error) {
+	if word == "" {
+		return nil, fmt.Errorf("word is required for lookup")
+	}
+
+	if err := s.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	prompt := BuildDictionaryPrompt(word, sentence, targetLang)
+
+	resp, err := s.genModel().GenerateContent(ctx, genai.Text(prompt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate dictionary entry: %w", err)
+	}
+
+	text := ExtractText(resp)
+	if text == "" {
+		return nil, fmt.Errorf("no dictionary entry generated")
+	}
+
+	return ParseDictionaryEntry(text)
+}
@@ -0,0 +1,89 @@
+package gemini
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DualTranslation pairs a word-for-word literal gloss with a natural
+// translation of the same segment
+type DualTranslation struct {
+	Literal string `json:"literal"`
+	Natural string `json:"natural"`
+}
+
+// BuildDualTranslationPrompt creates a structured prompt asking the model
+// for two translations per numbered segment: a literal gloss that mirrors
+// the source wording and a natural rendering in the target language
+func BuildDualTranslationPrompt(texts []string, targetLang string, sourceLang string) string {
+	var prompt strings.Builder
+
+	if sourceLang != "" {
+		prompt.WriteString(fmt.Sprintf("Translate the following numbered segments from %s to %s. ", sourceLang, targetLang))
+	} else {
+		prompt.WriteString(fmt.Sprintf("Translate the following numbered segments to %s. ", targetLang))
+	}
+
+	prompt.WriteString("For each segment provide two lines: ")
+	prompt.WriteString("'N|literal: <word-for-word translation preserving the source structure>' and ")
+	prompt.WriteString("'N|natural: <fluent translation as a native speaker would phrase it>'. ")
+	prompt.WriteString("Provide only these lines without any additional text:\n\n")
+
+	for i, text := range texts {
+		prompt.WriteString(fmt.Sprintf("%d: %s\n", i, text))
+	}
+
+	return prompt.String()
+}
+
+// ParseDualTranslations extracts the literal and natural translation pairs
+// from a structured dual-translation response. Segments the model did not
+// answer are left with empty fields so callers can fall back per segment.
+func ParseDualTranslations(response string, count int) []DualTranslation {
+	translations := make([]DualTranslation, count)
+
+	for _, line := range strings.Split(response, "\n") {
+		line = strings.TrimSpace(line)
+
+		for i := 0; i < count; i++ {
+			if text, ok := cutPrefix(line, fmt.Sprintf("%d|literal:", i)); ok {
+				translations[i].Literal = text
+				break
+			}
+			if text, ok := cutPrefix(line, fmt.Sprintf("%d|natural:", i)); ok {
+				translations[i].Natural = text
+				break
+			}
+		}
+	}
+
+	return translations
+}
+
+// cutPrefix returns the trimmed remainder of line after prefix when present
+func cutPrefix(line, prefix string) (string, bool) {
+	if !strings.HasPrefix(line, prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(line, prefix)), true
+}
+
+// TranslateTextsDual translates texts in dual mode, returning a literal and
+// a natural translation per segment from a single structured model call
+func (s *Service) TranslateTextsDual(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]DualTranslation, error) {
+	if len(texts) == 0 {
+		return []DualTranslation{}, nil
+	}
+
+	response, err := s.TranslateText(ctx, &TranslationRequest{
+		Text:       BuildDualTranslationPrompt(texts, targetLang, sourceLang),
+		TargetLang: targetLang,
+		SourceLang: sourceLang,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dual translation failed: %w", err)
+	}
+
+	return ParseDualTranslations(response.TranslatedText, len(texts)), nil
+}
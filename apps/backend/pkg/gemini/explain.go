@@ -0,0 +1,116 @@
+package gemini
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/generative-ai-go/genai"
+)
+
+// GrammarClause is one clause of the analyzed sentence
+type GrammarClause struct {
+	Text string `json:"text"`
+	// Role describes the clause's function, e.g. "main clause" or
+	// "relative clause modifying 'the man'"
+	Role string `json:"role"`
+}
+
+// GrammarTense explains the tense of one verb in the sentence
+type GrammarTense struct {
+	Verb        string `json:"verb"`
+	Tense       string `json:"tense"`
+	Explanation string `json:"explanation"`
+}
+
+// GrammarIdiom explains an idiom or fixed expression found in the sentence
+type GrammarIdiom struct {
+	Phrase  string `json:"phrase"`
+	Meaning string `json:"meaning"`
+}
+
+// GrammarExplanation is the structured grammar breakdown of one transcript
+// sentence, with translations into the learner's native language
+type GrammarExplanation struct {
+	Sentence           string          `json:"sentence"`
+	Clauses            []GrammarClause `json:"clauses"`
+	Tenses             []GrammarTense  `json:"tenses,omitempty"`
+	Idioms             []GrammarIdiom  `json:"idioms,omitempty"`
+	LiteralTranslation string          `json:"literal_translation"`
+	NaturalTranslation string          `json:"natural_translation"`
+}
+
+// BuildGrammarPrompt creates the constrained prompt for a grammar
+// breakdown. The model is held to a fixed JSON shape so the response can
+// be validated.
+func BuildGrammarPrompt(sentence string, nativeLang string) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("You are a grammar teacher for English learners. ")
+	prompt.WriteString(fmt.Sprintf("Analyze this sentence from a video transcript for a learner whose native language is %s: %q. ", nativeLang, sentence))
+	prompt.WriteString("Respond with a single JSON object and nothing else, using exactly these keys: ")
+	prompt.WriteString(`"sentence" (the analyzed sentence), `)
+	prompt.WriteString(`"clauses" (an array of objects with "text" and "role" describing each clause's grammatical function), `)
+	prompt.WriteString(`"tenses" (an array of objects with "verb", "tense", and "explanation" for each verb; empty if there are none worth explaining), `)
+	prompt.WriteString(`"idioms" (an array of objects with "phrase" and "meaning" for idioms and fixed expressions; empty if there are none), `)
+	prompt.WriteString(fmt.Sprintf(`"literal_translation" (a word-for-word translation into %s), `, nativeLang))
+	prompt.WriteString(fmt.Sprintf(`"natural_translation" (how a native %s speaker would actually say it)`, nativeLang))
+	prompt.WriteString(". Write all explanations in the learner's native language. Do not wrap the JSON in markdown or add any commentary.")
+
+	return prompt.String()
+}
+
+// ParseGrammarExplanation validates a model response against the grammar
+// breakdown schema. Markdown code fences the model sometimes adds despite
+// instructions are stripped before parsing.
+func ParseGrammarExplanation(raw string) (*GrammarExplanation, error) {
+	cleaned := strings.TrimSpace(raw)
+	cleaned = strings.TrimPrefix(cleaned, "```json")
+	cleaned = strings.TrimPrefix(cleaned, "```")
+	cleaned = strings.TrimSuffix(cleaned, "```")
+	cleaned = strings.TrimSpace(cleaned)
+
+	var explanation GrammarExplanation
+	if err := json.Unmarshal([]byte(cleaned), &explanation); err != nil {
+		return nil, fmt.Errorf("grammar response is not valid JSON: %w", err)
+	}
+
+	if len(explanation.Clauses) == 0 {
+		return nil, fmt.Errorf("grammar response has no clause breakdown")
+	}
+	if explanation.NaturalTranslation == "" {
+		return nil, fmt.Errorf("grammar response has no natural translation")
+	}
+
+	return &explanation, nil
+}
+
+// ExplainGrammar asks the model for a structured grammar breakdown of a
+// transcript sentence, explained in the learner's native language
+func (s *Service) ExplainGrammar(ctx context.Context, sentence string, nativeLang string) (*GrammarExplanation, error) {
+	if sentence == "" {
+		return nil, fmt.Errorf("sentence is required for a grammar breakdown")
+	}
+	if nativeLang == "" {
+		return nil, fmt.Errorf("native language is required for a grammar breakdown")
+	}
+
+	if err := s.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	prompt := BuildGrammarPrompt(sentence, nativeLang)
+
+	resp, err := s.genModel().GenerateContent(ctx, genai.Text(prompt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate grammar breakdown: %w", err)
+	}
+
+	text := ExtractText(resp)
+	if text == "" {
+		return nil, fmt.Errorf("no grammar breakdown generated")
+	}
+
+	return ParseGrammarExplanation(text)
+}
@@ -0,0 +1,84 @@
+package gemini
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"app-backend/internal/types"
+)
+
+// BuildGistPrompt creates the first-pass prompt that asks the model to
+// summarize a whole chunk before any segment is translated. Seeing the full
+// paragraph lets the second pass resolve pronouns and terminology that a
+// line-by-line translation would miss.
+func BuildGistPrompt(texts []string, targetLang string, sourceLang string) string {
+	var prompt strings.Builder
+
+	if sourceLang != "" {
+		prompt.WriteString(fmt.Sprintf("The following is a %s video transcript that will be translated to %s. ", sourceLang, targetLang))
+	} else {
+		prompt.WriteString(fmt.Sprintf("The following is a video transcript that will be translated to %s. ", targetLang))
+	}
+
+	prompt.WriteString("Summarize its topic, tone, and key terminology in 2-3 sentences. ")
+	prompt.WriteString("Provide only the summary without any additional text or formatting:\n\n")
+	prompt.WriteString(strings.Join(texts, "\n"))
+
+	return prompt.String()
+}
+
+// BuildGistSegmentContext creates the extra context for the second pass so
+// each segment is translated informed by the gist of the whole chunk
+func BuildGistSegmentContext(gist string) string {
+	return fmt.Sprintf("Use this summary of the full transcript to keep the translation coherent: %s", gist)
+}
+
+// TranslateSegmentsWithGist translates segments in two passes: the whole
+// chunk is first summarized, then each batch is translated with the summary
+// as context. This costs one extra API call per request and is therefore
+// opt-in.
+func (s *Service) TranslateSegmentsWithGist(ctx context.Context, segments []types.TranscriptSegment, targetLang string, sourceLang string) ([]types.TranslatedSegment, error) {
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("no segments to translate")
+	}
+
+	texts := make([]string, len(segments))
+	for i, segment := range segments {
+		texts[i] = segment.Text
+	}
+
+	gistResp, err := s.TranslateText(ctx, &TranslationRequest{
+		Text:       BuildGistPrompt(texts, targetLang, sourceLang),
+		TargetLang: targetLang,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gist pass failed: %w", err)
+	}
+
+	return s.translateSegmentsWithContext(ctx, segments, targetLang, sourceLang, BuildGistSegmentContext(gistResp.TranslatedText))
+}
+
+// translateSegmentsWithContext mirrors TranslateSegments but threads extra
+// prompt context into every chunk, alongside the per-chunk overlap context
+func (s *Service) translateSegmentsWithContext(ctx context.Context, segments []types.TranscriptSegment, targetLang string, sourceLang string, extraContext string) ([]types.TranslatedSegment, error) {
+	batchSize := 10
+	chunks := ChunkSegmentsWithOverlap(segments, batchSize, s.chunkOverlap)
+	var allTranslations []types.TranslatedSegment
+
+	for _, chunk := range chunks {
+		chunkContext := extraContext
+		if overlapContext := BuildOverlapContext(chunk.Context); overlapContext != "" {
+			chunkContext += " " + overlapContext
+		}
+
+		translations, err := s.translateBatchWithContext(ctx, chunk.Segments, targetLang, sourceLang, chunkContext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate batch: %w", err)
+		}
+
+		allTranslations = append(allTranslations, translations...)
+	}
+
+	return allTranslations, nil
+}
@@ -0,0 +1,26 @@
+package gemini
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BuildListTranslationPrompt creates the prompt for translating segments that
+// include numbered or bulleted list items. Tutorial transcripts often
+// enumerate steps, and without an explicit instruction the model tends to
+// renumber or merge them.
+func BuildListTranslationPrompt(texts []string, targetLang string, sourceLang string) string {
+	var prompt strings.Builder
+
+	prompt.WriteString(fmt.Sprintf("Translate the following lines to %s", targetLang))
+	if sourceLang != "" {
+		prompt.WriteString(fmt.Sprintf(" from %s", sourceLang))
+	}
+	prompt.WriteString(". ")
+	prompt.WriteString("Some lines are items of a numbered or bulleted list. ")
+	prompt.WriteString("Keep each line's numbering and bullet markers exactly as given; do not renumber, merge, or split list items. ")
+	prompt.WriteString("Return only the translated lines in order, one per line:\n\n")
+	prompt.WriteString(strings.Join(texts, "\n"))
+
+	return prompt.String()
+}
@@ -0,0 +1,115 @@
+package gemini
+
+import (
+	"github.com/google/generative-ai-go/genai"
+	"go.uber.org/zap"
+)
+
+// DefaultModelName is the generative model used when none is configured
+const DefaultModelName = "gemini-1.5-flash"
+
+// ModelParams holds the tunable generation parameters for the Gemini model.
+// Zero values for TopK, TopP, and MaxOutputTokens leave the model's own
+// defaults in place.
+type ModelParams struct {
+	Model           string
+	Temperature     float32
+	TopK            int32
+	TopP            float32
+	MaxOutputTokens int32
+	// SafetySettings maps harm category names to block threshold names, as
+	// accepted by ParseSafetySettings
+	SafetySettings map[string]string
+}
+
+// harmCategories maps config-friendly category names to genai constants
+var harmCategories = map[string]genai.HarmCategory{
+	"harassment":        genai.HarmCategoryHarassment,
+	"hate_speech":       genai.HarmCategoryHateSpeech,
+	"sexually_explicit": genai.HarmCategorySexuallyExplicit,
+	"dangerous_content": genai.HarmCategoryDangerousContent,
+}
+
+// harmThresholds maps config-friendly threshold names to genai constants
+var harmThresholds = map[string]genai.HarmBlockThreshold{
+	"block_none":             genai.HarmBlockNone,
+	"block_low_and_above":    genai.HarmBlockLowAndAbove,
+	"block_medium_and_above": genai.HarmBlockMediumAndAbove,
+	"block_only_high":        genai.HarmBlockOnlyHigh,
+}
+
+// ParseSafetySettings converts a category-to-threshold name map into genai
+// safety settings. Unknown categories or thresholds are logged and skipped,
+// so a typo in config degrades to the model's default rather than failing
+// startup.
+func ParseSafetySettings(settings map[string]string, logger *zap.Logger) []*genai.SafetySetting {
+	if len(settings) == 0 {
+		return nil
+	}
+
+	parsed := make([]*genai.SafetySetting, 0, len(settings))
+	for categoryName, thresholdName := range settings {
+		category, ok := harmCategories[categoryName]
+		if !ok {
+			logger.Warn("Unknown Gemini safety category ignored", zap.String("category", categoryName))
+			continue
+		}
+		threshold, ok := harmThresholds[thresholdName]
+		if !ok {
+			logger.Warn("Unknown Gemini safety threshold ignored",
+				zap.String("category", categoryName),
+				zap.String("threshold", thresholdName))
+			continue
+		}
+		parsed = append(parsed, &genai.SafetySetting{Category: category, Threshold: threshold})
+	}
+	return parsed
+}
+
+// genModel returns the current generative model; callers must treat it as
+// read-only since ApplyModelParams may swap it at any time
+func (s *Service) genModel() *genai.GenerativeModel {
+	s.modelMu.RLock()
+	defer s.modelMu.RUnlock()
+	return s.model
+}
+
+// ApplyModelParams rebuilds the generative model with the given parameters
+// and swaps it in for subsequent calls. It is safe to call while requests are
+// in flight, which lets config changes take effect on SIGHUP without a
+// restart. A service without a client ignores the call.
+func (s *Service) ApplyModelParams(params ModelParams) {
+	if s.client == nil {
+		return
+	}
+
+	modelName := params.Model
+	if modelName == "" {
+		modelName = DefaultModelName
+	}
+
+	model := s.client.GenerativeModel(modelName)
+	model.SetTemperature(params.Temperature)
+	if params.TopK > 0 {
+		model.SetTopK(params.TopK)
+	}
+	if params.TopP > 0 {
+		model.SetTopP(params.TopP)
+	}
+	if params.MaxOutputTokens > 0 {
+		model.SetMaxOutputTokens(params.MaxOutputTokens)
+	}
+	model.SafetySettings = ParseSafetySettings(params.SafetySettings, s.logger)
+
+	s.modelMu.Lock()
+	s.model = model
+	s.modelMu.Unlock()
+
+	s.logger.Info("Gemini model configured",
+		zap.String("model", modelName),
+		zap.Float32("temperature", params.Temperature),
+		zap.Int32("topK", params.TopK),
+		zap.Float32("topP", params.TopP),
+		zap.Int32("maxOutputTokens", params.MaxOutputTokens),
+		zap.Int("safetySettings", len(model.SafetySettings)))
+}
@@ -0,0 +1,72 @@
+package gemini
+
+import (
+	"fmt"
+	"strings"
+
+	"app-backend/internal/types"
+)
+
+// DefaultChunkOverlap is how many trailing segments of a chunk are carried
+// into the next chunk's prompt as read-only context
+const DefaultChunkOverlap = 2
+
+// SegmentChunk is one translation chunk together with the trailing segments
+// of the previous chunk. Context segments inform the prompt but are never
+// translated again, so each segment appears in exactly one chunk's output.
+type SegmentChunk struct {
+	Context  []types.TranscriptSegment
+	Segments []types.TranscriptSegment
+}
+
+// ChunkSegmentsWithOverlap splits segments into chunks of chunkSize, carrying
+// the last overlap segments of each chunk into the next one as context so
+// pronouns and terminology stay consistent across chunk boundaries
+func ChunkSegmentsWithOverlap(segments []types.TranscriptSegment, chunkSize int, overlap int) []SegmentChunk {
+	if chunkSize <= 0 {
+		chunkSize = 10
+	}
+	if overlap < 0 {
+		overlap = 0
+	}
+	if overlap >= chunkSize {
+		overlap = chunkSize - 1
+	}
+
+	var chunks []SegmentChunk
+	for start := 0; start < len(segments); start += chunkSize {
+		end := start + chunkSize
+		if end > len(segments) {
+			end = len(segments)
+		}
+
+		chunk := SegmentChunk{Segments: segments[start:end]}
+		if start > 0 && overlap > 0 {
+			contextStart := start - overlap
+			if contextStart < 0 {
+				contextStart = 0
+			}
+			chunk.Context = segments[contextStart:start]
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// BuildOverlapContext creates the extra prompt context carrying the previous
+// chunk's trailing segments, marked read-only so the model does not translate
+// them again
+func BuildOverlapContext(segments []types.TranscriptSegment) string {
+	if len(segments) == 0 {
+		return ""
+	}
+
+	texts := make([]string, len(segments))
+	for i, segment := range segments {
+		texts[i] = segment.Text
+	}
+
+	return fmt.Sprintf("The previous chunk ended with: %q. Use it only as context for pronouns and terminology; do not translate or repeat it.",
+		strings.Join(texts, " "))
+}
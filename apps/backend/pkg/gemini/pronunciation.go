@@ -0,0 +1,113 @@
+package gemini
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/generative-ai-go/genai"
+)
+
+// PronunciationWordScore is the per-word verdict of a pronunciation assessment
+type PronunciationWordScore struct {
+	Word  string  `json:"word"`
+	Score float64 `json:"score"`
+	// Feedback notes what was mispronounced, empty for clean words
+	Feedback string `json:"feedback,omitempty"`
+}
+
+// PronunciationAssessment is the structured result of comparing a spoken
+// audio clip against its reference text
+type PronunciationAssessment struct {
+	OverallScore float64                  `json:"overall_score"`
+	Words        []PronunciationWordScore `json:"words"`
+}
+
+// BuildPronunciationPrompt creates the constrained prompt for a
+// pronunciation assessment. The model is held to a fixed JSON shape so the
+// response can be validated.
+func BuildPronunciationPrompt(referenceText string, language string) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("You are a pronunciation coach. The attached audio clip is a learner reading this ")
+	if language != "" {
+		prompt.WriteString(fmt.Sprintf("%s ", language))
+	}
+	prompt.WriteString(fmt.Sprintf("text aloud: %q. ", referenceText))
+	prompt.WriteString("Compare the recording against the text and respond with a single JSON object and nothing else, using exactly these keys: ")
+	prompt.WriteString(`"overall_score" (a number from 0 to 100 for the whole clip), `)
+	prompt.WriteString(`"words" (an array with one entry per word of the text, in order, each an object with "word", "score" from 0 to 100, and "feedback" describing the mispronunciation, empty for well-pronounced words)`)
+	prompt.WriteString(". Score words that were skipped as 0. Do not wrap the JSON in markdown or add any commentary.")
+
+	return prompt.String()
+}
+
+// ParsePronunciationAssessment validates a model response against the
+// assessment schema. Markdown code fences the model sometimes adds despite
+// instructions are stripped before parsing, and out-of-range scores are
+// clamped rather than rejected.
+func ParsePronunciationAssessment(raw string) (*PronunciationAssessment, error) {
+	cleaned := strings.TrimSpace(raw)
+	cleaned = strings.TrimPrefix(cleaned, "```json")
+	cleaned = strings.TrimPrefix(cleaned, "```")
+	cleaned = strings.TrimSuffix(cleaned, "```")
+	cleaned = strings.TrimSpace(cleaned)
+
+	var assessment PronunciationAssessment
+	if err := json.Unmarshal([]byte(cleaned), &assessment); err != nil {
+		return nil, fmt.Errorf("assessment response is not valid JSON: %w", err)
+	}
+
+	if len(assessment.Words) == 0 {
+		return nil, fmt.Errorf("assessment response has no word scores")
+	}
+
+	assessment.OverallScore = clampScore(assessment.OverallScore)
+	for i := range assessment.Words {
+		assessment.Words[i].Score = clampScore(assessment.Words[i].Score)
+	}
+
+	return &assessment, nil
+}
+
+func clampScore(score float64) float64 {
+	if score < 0 {
+		return 0
+	}
+	if score > 100 {
+		return 100
+	}
+	return score
+}
+
+// AssessPronunciation asks the model to score a spoken audio clip against
+// its reference text, returning per-word scores
+func (s *Service) AssessPronunciation(ctx context.Context, audio []byte, mimeType string, referenceText string, language string) (*PronunciationAssessment, error) {
+	if len(audio) == 0 {
+		return nil, fmt.Errorf("audio clip is required for assessment")
+	}
+	if referenceText == "" {
+		return nil, fmt.Errorf("reference text is required for assessment")
+	}
+
+	if err := s.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	prompt := BuildPronunciationPrompt(referenceText, language)
+
+	resp, err := s.genModel().GenerateContent(ctx,
+		genai.Text(prompt),
+		genai.Blob{MIMEType: mimeType, Data: audio})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate pronunciation assessment: %w", err)
+	}
+
+	text := ExtractText(resp)
+	if text == "" {
+		return nil, fmt.Errorf("no pronunciation assessment generated")
+	}
+
+	return ParsePronunciationAssessment(text)
+}
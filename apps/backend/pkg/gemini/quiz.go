@@ -0,0 +1,131 @@
+package gemini
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/generative-ai-go/genai"
+)
+
+// Quiz question types the model is allowed to produce
+const (
+	QuizQuestionCloze          = "cloze"
+	QuizQuestionMultipleChoice = "multiple_choice"
+)
+
+// QuizQuestion is one generated practice question. Cloze questions blank a
+// word out of a transcript segment; multiple-choice questions carry their
+// distractors in Options.
+type QuizQuestion struct {
+	Type     string   `json:"type"`
+	Question string   `json:"question"`
+	Options  []string `json:"options,omitempty"`
+	Answer   string   `json:"answer"`
+}
+
+// BuildQuizPrompt creates the constrained prompt for quiz generation. The
+// seed is spelled out in the prompt so regenerating with the same transcript
+// material and seed yields the same questions.
+func BuildQuizPrompt(segments []string, language string, numQuestions int, seed int64) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("You are an English-learning quiz writer. Build a quiz from these ")
+	if language != "" {
+		prompt.WriteString(fmt.Sprintf("%s ", language))
+	}
+	prompt.WriteString("video transcript excerpts:\n")
+	for i, segment := range segments {
+		prompt.WriteString(fmt.Sprintf("%d. %s\n", i+1, segment))
+	}
+	prompt.WriteString(fmt.Sprintf("Write exactly %d questions, mixing fill-in-the-blank and multiple-choice. ", numQuestions))
+	prompt.WriteString(fmt.Sprintf("Make every choice (which excerpts to use, which words to blank, distractor wording, option order) deterministically from the seed %d so the same excerpts and seed always produce the identical quiz. ", seed))
+	prompt.WriteString("Respond with a single JSON array and nothing else. Each element is an object with exactly these keys: ")
+	prompt.WriteString(`"type" (either "cloze" or "multiple_choice"), `)
+	prompt.WriteString(`"question" (for cloze, the excerpt with the blanked word replaced by "____"; for multiple_choice, the question text), `)
+	prompt.WriteString(`"options" (for multiple_choice, an array of 4 answer choices including the correct one; omit for cloze), `)
+	prompt.WriteString(`"answer" (the correct answer, matching one of the options for multiple_choice)`)
+	prompt.WriteString(". Do not wrap the JSON in markdown or add any commentary.")
+
+	return prompt.String()
+}
+
+// ParseQuizQuestions validates a model response against the quiz schema.
+// Markdown code fences the model sometimes adds despite instructions are
+// stripped before parsing.
+func ParseQuizQuestions(raw string) ([]QuizQuestion, error) {
+	cleaned := strings.TrimSpace(raw)
+	cleaned = strings.TrimPrefix(cleaned, "```json")
+	cleaned = strings.TrimPrefix(cleaned, "```")
+	cleaned = strings.TrimSuffix(cleaned, "```")
+	cleaned = strings.TrimSpace(cleaned)
+
+	var questions []QuizQuestion
+	if err := json.Unmarshal([]byte(cleaned), &questions); err != nil {
+		return nil, fmt.Errorf("quiz response is not valid JSON: %w", err)
+	}
+	if len(questions) == 0 {
+		return nil, fmt.Errorf("quiz response has no questions")
+	}
+
+	for i, question := range questions {
+		if question.Question == "" || question.Answer == "" {
+			return nil, fmt.Errorf("quiz question %d is missing its question or answer", i+1)
+		}
+		switch question.Type {
+		case QuizQuestionCloze:
+			// Cloze answers are graded against the blank, options are noise
+			questions[i].Options = nil
+		case QuizQuestionMultipleChoice:
+			if len(question.Options) < 2 {
+				return nil, fmt.Errorf("quiz question %d has too few options", i+1)
+			}
+			if !containsFold(question.Options, question.Answer) {
+				return nil, fmt.Errorf("quiz question %d answer is not among its options", i+1)
+			}
+		default:
+			return nil, fmt.Errorf("quiz question %d has unknown type %q", i+1, question.Type)
+		}
+	}
+
+	return questions, nil
+}
+
+func containsFold(options []string, answer string) bool {
+	for _, option := range options {
+		if strings.EqualFold(strings.TrimSpace(option), strings.TrimSpace(answer)) {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateQuiz asks the model to build practice questions from transcript
+// excerpts, holding it to a fixed JSON shape and a deterministic seed
+func (s *Service) GenerateQuiz(ctx context.Context, segments []string, language string, numQuestions int, seed int64) ([]QuizQuestion, error) {
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("transcript excerpts are required for quiz generation")
+	}
+	if numQuestions <= 0 {
+		return nil, fmt.Errorf("question count must be positive")
+	}
+
+	if err := s.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	prompt := BuildQuizPrompt(segments, language, numQuestions, seed)
+
+	resp, err := s.genModel().GenerateContent(ctx, genai.Text(prompt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate quiz: %w", err)
+	}
+
+	text := ExtractText(resp)
+	if text == "" {
+		return nil, fmt.Errorf("no quiz generated")
+	}
+
+	return ParseQuizQuestions(text)
+}
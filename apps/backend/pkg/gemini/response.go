@@ -0,0 +1,26 @@
+package gemini
+
+import (
+	"strings"
+
+	"github.com/google/generative-ai-go/genai"
+)
+
+// ExtractText collects the text parts of a Gemini response. The SDK may
+// return several parts per candidate and parts that are not text at all
+// (blobs, function calls); text parts are concatenated and anything else is
+// ignored so it never leaks into a translation.
+func ExtractText(resp *genai.GenerateContentResponse) string {
+	if resp == nil || len(resp.Candidates) == 0 || resp.Candidates[0].Content == nil {
+		return ""
+	}
+
+	var builder strings.Builder
+	for _, part := range resp.Candidates[0].Content.Parts {
+		if text, ok := part.(genai.Text); ok {
+			builder.WriteString(string(text))
+		}
+	}
+
+	return strings.TrimSpace(builder.String())
+}
@@ -0,0 +1,71 @@
+package gemini
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/generative-ai-go/genai"
+)
+
+// BuildRestorationPrompt creates the prompt that asks the model to restore
+// punctuation and capitalization on raw ASR segments without changing their
+// wording or numbering, so the restored lines can be mapped back by index.
+func BuildRestorationPrompt(texts []string) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("The following are numbered segments of an automatically generated video transcript ")
+	prompt.WriteString("that lacks punctuation and capitalization. ")
+	prompt.WriteString("Restore proper punctuation and capitalization in each segment. ")
+	prompt.WriteString("Do not change, add, or remove any words, and keep the same numbering. ")
+	prompt.WriteString("Provide only the numbered segments without any additional text:\n\n")
+
+	for i, text := range texts {
+		prompt.WriteString(fmt.Sprintf("%d: %s\n", i, text))
+	}
+
+	return prompt.String()
+}
+
+// RestoreFormatting restores punctuation and capitalization on raw ASR
+// segment texts. Segments the model fails to return are passed through
+// unchanged so the result always matches the input length.
+func (s *Service) RestoreFormatting(ctx context.Context, texts []string) ([]string, error) {
+	if len(texts) == 0 {
+		return []string{}, nil
+	}
+
+	if err := s.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	prompt := BuildRestorationPrompt(texts)
+
+	resp, err := s.genModel().GenerateContent(ctx, genai.Text(prompt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore formatting: %w", err)
+	}
+
+	restoredText := ExtractText(resp)
+	if restoredText == "" {
+		return nil, fmt.Errorf("no restoration result")
+	}
+
+	restoredLines := strings.Split(restoredText, "\n")
+
+	restored := make([]string, len(texts))
+	for i, text := range texts {
+		restored[i] = text
+
+		for _, line := range restoredLines {
+			if strings.HasPrefix(line, fmt.Sprintf("%d:", i)) {
+				if candidate := strings.TrimSpace(strings.TrimPrefix(line, fmt.Sprintf("%d:", i))); candidate != "" {
+					restored[i] = candidate
+				}
+				break
+			}
+		}
+	}
+
+	return restored, nil
+}
@@ -0,0 +1,25 @@
+package gemini
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BuildRetryTranslationPrompt creates the prompt for re-translating segments
+// the model previously returned unchanged. The phrasing differs from the
+// regular translation prompt and spells the requirement out explicitly,
+// because for less-common target languages the model occasionally echoes the
+// source text back.
+func BuildRetryTranslationPrompt(texts []string, targetLang string, sourceLang string) string {
+	var prompt strings.Builder
+
+	if sourceLang != "" {
+		prompt.WriteString(fmt.Sprintf("The following lines are in %s. ", sourceLang))
+	}
+	prompt.WriteString(fmt.Sprintf("Rewrite every line in %s. ", targetLang))
+	prompt.WriteString("Do not repeat the original wording; every line must be fully translated, even if the result is an approximation. ")
+	prompt.WriteString("Return only the translated lines in order, one per line:\n\n")
+	prompt.WriteString(strings.Join(texts, "\n"))
+
+	return prompt.String()
+}
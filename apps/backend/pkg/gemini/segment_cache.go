@@ -0,0 +1,101 @@
+package gemini
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/types"
+	"app-backend/pkg/patterns"
+)
+
+// DefaultSegmentCacheSize is how many per-segment translations are kept when
+// no size is configured
+const DefaultSegmentCacheSize = 10000
+
+// SegmentCacheStats reports translation segment cache effectiveness
+type SegmentCacheStats struct {
+	Hits    int64 `json:"hits"`
+	Misses  int64 `json:"misses"`
+	Entries int   `json:"entries"`
+}
+
+// SetSegmentCacheSize enables the per-segment translation cache with the
+// given capacity. Identical subtitle lines ("[Music]", filler words, common
+// phrases) recur constantly across videos; caching their translations skips
+// the Gemini call entirely. A size of 0 or below disables the cache.
+func (s *Service) SetSegmentCacheSize(size int) {
+	if size <= 0 {
+		s.segmentCache = nil
+		return
+	}
+	s.segmentCache = patterns.NewLRUCache[string, string](size)
+}
+
+// GetSegmentCacheStats returns hit/miss counters and the current cache size
+func (s *Service) GetSegmentCacheStats() SegmentCacheStats {
+	stats := SegmentCacheStats{
+		Hits:   s.cacheHits.Load(),
+		Misses: s.cacheMisses.Load(),
+	}
+	if s.segmentCache != nil {
+		stats.Entries = s.segmentCache.Len()
+	}
+	return stats
+}
+
+// segmentCacheKey hashes a segment's text and language pair into a cache key
+func segmentCacheKey(text, sourceLang, targetLang string) string {
+	sum := sha256.Sum256([]byte(sourceLang + "\x00" + targetLang + "\x00" + text))
+	return hex.EncodeToString(sum[:])
+}
+
+// lookupCachedSegments splits segments into cached translations (placed
+// directly into results at their original position) and segments still
+// needing a Gemini call. The returned index slice maps each pending segment
+// back to its slot in results.
+func (s *Service) lookupCachedSegments(segments []types.TranscriptSegment, targetLang, sourceLang string, results []types.TranslatedSegment) (pending []types.TranscriptSegment, pendingIndex []int) {
+	for i, segment := range segments {
+		if s.segmentCache != nil {
+			if translated, ok := s.segmentCache.Get(segmentCacheKey(segment.Text, sourceLang, targetLang)); ok {
+				s.cacheHits.Add(1)
+				results[i] = types.TranslatedSegment{
+					Index:          segment.Index,
+					OriginalText:   segment.Text,
+					TranslatedText: translated,
+				}
+				continue
+			}
+			s.cacheMisses.Add(1)
+		}
+		pending = append(pending, segment)
+		pendingIndex = append(pendingIndex, i)
+	}
+	return pending, pendingIndex
+}
+
+// storeCachedSegments writes fresh translations back to the segment cache
+func (s *Service) storeCachedSegments(translations []types.TranslatedSegment, targetLang, sourceLang string) {
+	if s.segmentCache == nil {
+		return
+	}
+	for _, translation := range translations {
+		if translation.TranslatedText == "" {
+			continue
+		}
+		s.segmentCache.Put(segmentCacheKey(translation.OriginalText, sourceLang, targetLang), translation.TranslatedText)
+	}
+}
+
+// logSegmentCacheOutcome records per-call cache effectiveness
+func (s *Service) logSegmentCacheOutcome(total, pending int) {
+	if s.segmentCache == nil || total == pending {
+		return
+	}
+	s.logger.Debug("Translation segment cache hits",
+		zap.Int("segments", total),
+		zap.Int("cached", total-pending),
+		zap.Int64("total_hits", s.cacheHits.Load()),
+		zap.Int64("total_misses", s.cacheMisses.Load()))
+}
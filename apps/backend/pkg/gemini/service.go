@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"app-backend/internal/requestid"
 	"app-backend/internal/types"
+	"app-backend/pkg/patterns"
 	"github.com/google/generative-ai-go/genai"
 	"go.uber.org/zap"
 	"google.golang.org/api/option"
@@ -14,10 +18,58 @@ import (
 
 // Service implements translation functionality using Google Gemini
 type Service struct {
-	client   *genai.Client
-	model    *genai.GenerativeModel
-	logger   *zap.Logger
-	apiKey   string
+	client *genai.Client
+	// model is read through genModel and swapped by ApplyModelParams, so
+	// config changes can take effect without a restart
+	model        *genai.GenerativeModel
+	modelMu      sync.RWMutex
+	logger       *zap.Logger
+	apiKey       string
+	rateLimiter  *patterns.RateLimitedExecutor
+	chunkOverlap int
+	// segmentCache holds per-segment translations keyed by text and language
+	// pair so repeated lines skip the API; nil when disabled
+	segmentCache *patterns.LRUCache[string, string]
+	cacheHits    atomic.Int64
+	cacheMisses  atomic.Int64
+}
+
+// SetChunkOverlap sets how many trailing segments of a translation chunk are
+// carried into the next chunk's prompt as read-only context
+func (s *Service) SetChunkOverlap(overlap int) {
+	if overlap < 0 {
+		overlap = 0
+	}
+	s.chunkOverlap = overlap
+}
+
+// IsConfigured reports whether the service has a usable Gemini client.
+// NewService builds a client-less instance when the API key is missing, so
+// callers can check this instead of hitting a nil client at request time.
+func (s *Service) IsConfigured() bool {
+	return s.client != nil
+}
+
+// SetRateLimiter injects a rate limiter enforced before every Gemini API
+// call. The limiter is shared via the container, so every service drawing on
+// the Gemini quota consumes from the same budget.
+func (s *Service) SetRateLimiter(limiter patterns.RateLimiter) {
+	if limiter == nil {
+		s.rateLimiter = nil
+		return
+	}
+	s.rateLimiter = patterns.NewRateLimitedExecutor("gemini", limiter, s.logger)
+}
+
+// waitForRateLimit blocks until the configured rate allows another API call
+func (s *Service) waitForRateLimit(ctx context.Context) error {
+	if s.rateLimiter == nil {
+		return nil
+	}
+	if err := s.rateLimiter.Execute(ctx, func() error { return nil }); err != nil {
+		return fmt.Errorf("gemini rate limit wait cancelled: %w", err)
+	}
+	return nil
 }
 
 // Config holds configuration for Gemini service
@@ -37,10 +89,10 @@ type TranslationRequest struct {
 
 // TranslationResponse represents the response from translation
 type TranslationResponse struct {
-	OriginalText   string `json:"originalText"`
-	TranslatedText string `json:"translatedText"`
-	SourceLang     string `json:"sourceLang"`
-	TargetLang     string `json:"targetLang"`
+	OriginalText   string  `json:"originalText"`
+	TranslatedText string  `json:"translatedText"`
+	SourceLang     string  `json:"sourceLang"`
+	TargetLang     string  `json:"targetLang"`
 	Confidence     float64 `json:"confidence,omitempty"`
 }
 
@@ -58,21 +110,22 @@ func NewServiceWithConfig(config *Config) (*Service, error) {
 
 	modelName := config.ModelName
 	if modelName == "" {
-		modelName = "gemini-1.5-flash" // Default model
+		modelName = DefaultModelName
 	}
 
 	model := client.GenerativeModel(modelName)
-	
+
 	// Configure model for better translation performance
 	model.SetTemperature(0.1) // Low temperature for consistent translations
 	model.SetTopK(1)
 	model.SetTopP(0.1)
 
 	return &Service{
-		client: client,
-		model:  model,
-		logger: config.Logger,
-		apiKey: config.APIKey,
+		client:       client,
+		model:        model,
+		logger:       config.Logger,
+		apiKey:       config.APIKey,
+		chunkOverlap: DefaultChunkOverlap,
 	}, nil
 }
 
@@ -101,19 +154,20 @@ func NewService(apiKey string, logger *zap.Logger) *Service {
 		}
 	}
 
-	modelName := "gemini-1.5-flash" // Default model
+	modelName := DefaultModelName
 	model := client.GenerativeModel(modelName)
-	
+
 	// Configure model for better translation performance
 	model.SetTemperature(0.1) // Low temperature for consistent translations
 	model.SetTopK(1)
 	model.SetTopP(0.1)
 
 	return &Service{
-		client: client,
-		model:  model,
-		logger: logger,
-		apiKey: apiKey,
+		client:       client,
+		model:        model,
+		logger:       logger,
+		apiKey:       apiKey,
+		chunkOverlap: DefaultChunkOverlap,
 	}
 }
 
@@ -132,27 +186,33 @@ func (s *Service) TranslateText(ctx context.Context, req *TranslationRequest) (*
 		return nil, fmt.Errorf("target language is required")
 	}
 
+	if err := s.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
 	// Build the translation prompt
 	prompt := s.buildTranslationPrompt(req)
 
+	s.logger.Debug("Calling Gemini for translation",
+		zap.String("targetLang", req.TargetLang),
+		zap.String("request_id", requestid.FromContext(ctx)))
+
 	// Generate translation
-	resp, err := s.model.GenerateContent(ctx, genai.Text(prompt))
+	resp, err := s.genModel().GenerateContent(ctx, genai.Text(prompt))
 	if err != nil {
-		s.logger.Error("Failed to generate translation", 
+		s.logger.Error("Failed to generate translation",
 			zap.String("text", req.Text),
 			zap.String("targetLang", req.TargetLang),
+			zap.String("request_id", requestid.FromContext(ctx)),
 			zap.Error(err))
 		return nil, fmt.Errorf("failed to generate translation: %w", err)
 	}
 
-	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
+	translatedText := ExtractText(resp)
+	if translatedText == "" {
 		return nil, fmt.Errorf("no translation generated")
 	}
 
-	// Extract translated text
-	translatedText := fmt.Sprintf("%v", resp.Candidates[0].Content.Parts[0])
-	translatedText = strings.TrimSpace(translatedText)
-
 	return &TranslationResponse{
 		OriginalText:   req.Text,
 		TranslatedText: translatedText,
@@ -161,28 +221,33 @@ func (s *Service) TranslateText(ctx context.Context, req *TranslationRequest) (*
 	}, nil
 }
 
-// TranslateSegments translates multiple transcript segments efficiently
+// TranslateSegments translates multiple transcript segments efficiently.
+// Segments whose translation is already in the segment cache are filled from
+// it; only the remainder is sent to Gemini.
 func (s *Service) TranslateSegments(ctx context.Context, segments []types.TranscriptSegment, targetLang string, sourceLang string) ([]types.TranslatedSegment, error) {
 	if len(segments) == 0 {
 		return nil, fmt.Errorf("no segments to translate")
 	}
 
-	// Process segments in batches for efficiency
+	results := make([]types.TranslatedSegment, len(segments))
+	pending, pendingIndex := s.lookupCachedSegments(segments, targetLang, sourceLang, results)
+	s.logSegmentCacheOutcome(len(segments), len(pending))
+	if len(pending) == 0 {
+		return results, nil
+	}
+
+	// Process segments in overlapping chunks for efficiency; each chunk sees
+	// the trailing segments of the previous one as read-only context
 	batchSize := 10 // Adjust based on API limits and performance
+	chunks := ChunkSegmentsWithOverlap(pending, batchSize, s.chunkOverlap)
 	var allTranslations []types.TranslatedSegment
 
-	for i := 0; i < len(segments); i += batchSize {
-		end := i + batchSize
-		if end > len(segments) {
-			end = len(segments)
-		}
-
-		batch := segments[i:end]
-		translations, err := s.translateBatch(ctx, batch, targetLang, sourceLang)
+	for i, chunk := range chunks {
+		translations, err := s.translateBatchWithContext(ctx, chunk.Segments, targetLang, sourceLang, BuildOverlapContext(chunk.Context))
 		if err != nil {
-			s.logger.Error("Failed to translate batch", 
-				zap.Int("batchStart", i),
-				zap.Int("batchEnd", end),
+			s.logger.Error("Failed to translate batch",
+				zap.Int("chunk", i),
+				zap.Int("chunkSize", len(chunk.Segments)),
 				zap.Error(err))
 			return nil, fmt.Errorf("failed to translate batch: %w", err)
 		}
@@ -190,12 +255,21 @@ func (s *Service) TranslateSegments(ctx context.Context, segments []types.Transc
 		allTranslations = append(allTranslations, translations...)
 
 		// Add small delay between batches to respect rate limits
-		if end < len(segments) {
+		if i < len(chunks)-1 {
 			time.Sleep(100 * time.Millisecond)
 		}
 	}
 
-	return allTranslations, nil
+	s.storeCachedSegments(allTranslations, targetLang, sourceLang)
+
+	// Merge fresh translations back into their original positions
+	for i, translation := range allTranslations {
+		if i < len(pendingIndex) {
+			results[pendingIndex[i]] = translation
+		}
+	}
+
+	return results, nil
 }
 
 // DetectLanguage detects the language of the given text
@@ -204,25 +278,27 @@ func (s *Service) DetectLanguage(ctx context.Context, text string) (string, erro
 		return "", fmt.Errorf("text is required for language detection")
 	}
 
+	if err := s.waitForRateLimit(ctx); err != nil {
+		return "", err
+	}
+
 	prompt := fmt.Sprintf(`Detect the language of the following text and respond with only the ISO 639-1 language code (e.g., "en", "es", "fr", "de", "ja", "zh", etc.):
 
 Text: "%s"
 
 Response format: Just the 2-letter language code`, text)
 
-	resp, err := s.model.GenerateContent(ctx, genai.Text(prompt))
+	resp, err := s.genModel().GenerateContent(ctx, genai.Text(prompt))
 	if err != nil {
 		s.logger.Error("Failed to detect language", zap.String("text", text), zap.Error(err))
 		return "", fmt.Errorf("failed to detect language: %w", err)
 	}
 
-	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
+	language := strings.ToLower(ExtractText(resp))
+	if language == "" {
 		return "", fmt.Errorf("no language detection result")
 	}
 
-	language := fmt.Sprintf("%v", resp.Candidates[0].Content.Parts[0])
-	language = strings.TrimSpace(strings.ToLower(language))
-
 	// Validate that we got a reasonable language code
 	if len(language) != 2 {
 		return "", fmt.Errorf("invalid language code detected: %s", language)
@@ -231,8 +307,8 @@ Response format: Just the 2-letter language code`, text)
 	return language, nil
 }
 
-// translateBatch translates a batch of segments together for efficiency
-func (s *Service) translateBatch(ctx context.Context, segments []types.TranscriptSegment, targetLang string, sourceLang string) ([]types.TranslatedSegment, error) {
+// translateBatchWithContext translates a batch with optional extra prompt context
+func (s *Service) translateBatchWithContext(ctx context.Context, segments []types.TranscriptSegment, targetLang string, sourceLang string, extraContext string) ([]types.TranslatedSegment, error) {
 	// Build a combined prompt with all segments
 	var segmentTexts []string
 	for i, segment := range segments {
@@ -240,12 +316,17 @@ func (s *Service) translateBatch(ctx context.Context, segments []types.Transcrip
 	}
 
 	combinedText := strings.Join(segmentTexts, "\n")
-	
+
+	promptContext := "This is a video transcript with numbered segments. Maintain the same numbering in your translation."
+	if extraContext != "" {
+		promptContext += " " + extraContext
+	}
+
 	req := &TranslationRequest{
 		Text:       combinedText,
 		SourceLang: sourceLang,
 		TargetLang: targetLang,
-		Context:    "This is a video transcript with numbered segments. Maintain the same numbering in your translation.",
+		Context:    promptContext,
 	}
 
 	response, err := s.TranslateText(ctx, req)
@@ -259,7 +340,7 @@ func (s *Service) translateBatch(ctx context.Context, segments []types.Transcrip
 
 	for i, segment := range segments {
 		var translatedText string
-		
+
 		// Try to find the corresponding translated line
 		for _, line := range translatedLines {
 			if strings.HasPrefix(line, fmt.Sprintf("%d:", i)) {
@@ -308,29 +389,3 @@ func (s *Service) buildTranslationPrompt(req *TranslationRequest) string {
 
 	return prompt.String()
 }
-
-// GetSupportedLanguages returns a list of commonly supported languages
-func (s *Service) GetSupportedLanguages() []types.Language {
-	return []types.Language{
-		{Code: "en", Name: "English"},
-		{Code: "es", Name: "Spanish"},
-		{Code: "fr", Name: "French"},
-		{Code: "de", Name: "German"},
-		{Code: "it", Name: "Italian"},
-		{Code: "pt", Name: "Portuguese"},
-		{Code: "ru", Name: "Russian"},
-		{Code: "ja", Name: "Japanese"},
-		{Code: "ko", Name: "Korean"},
-		{Code: "zh", Name: "Chinese"},
-		{Code: "ar", Name: "Arabic"},
-		{Code: "hi", Name: "Hindi"},
-		{Code: "th", Name: "Thai"},
-		{Code: "vi", Name: "Vietnamese"},
-		{Code: "nl", Name: "Dutch"},
-		{Code: "sv", Name: "Swedish"},
-		{Code: "no", Name: "Norwegian"},
-		{Code: "da", Name: "Danish"},
-		{Code: "fi", Name: "Finnish"},
-		{Code: "pl", Name: "Polish"},
-	}
-}
\ No newline at end of file
@@ -0,0 +1,123 @@
+package gemini
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/generative-ai-go/genai"
+)
+
+// SummarySection is one timestamped chapter of a summarized transcript
+type SummarySection struct {
+	Title string `json:"title"`
+	// StartSeconds and EndSeconds bound the chapter within the video
+	StartSeconds float64 `json:"start_seconds"`
+	EndSeconds   float64 `json:"end_seconds"`
+	Summary      string  `json:"summary"`
+}
+
+// SummaryVocabularyItem is one key term from the video worth learning
+type SummaryVocabularyItem struct {
+	Term    string `json:"term"`
+	Meaning string `json:"meaning"`
+}
+
+// TranscriptSummary is the structured, chaptered summary of one video
+// transcript
+type TranscriptSummary struct {
+	Overview   string                  `json:"overview"`
+	MainIdeas  []string                `json:"main_ideas"`
+	Sections   []SummarySection        `json:"sections"`
+	Vocabulary []SummaryVocabularyItem `json:"key_vocabulary,omitempty"`
+}
+
+// summaryDepthGuidance maps a summary depth to instructions controlling how
+// much detail the model produces
+var summaryDepthGuidance = map[string]string{
+	"brief":    "Produce 3 to 5 sections with one-sentence summaries, at most 3 main ideas, and at most 5 vocabulary terms.",
+	"standard": "Produce one section per topic shift with two- to three-sentence summaries, up to 5 main ideas, and up to 10 vocabulary terms.",
+	"detailed": "Produce one section per topic shift with thorough summaries covering every argument and example, up to 8 main ideas, and up to 15 vocabulary terms.",
+}
+
+// BuildSummaryPrompt creates the constrained prompt for a chaptered
+// transcript summary. The transcript is expected as one "[<seconds>s] text"
+// line per segment; the model is held to a fixed JSON shape so the response
+// can be validated.
+func BuildSummaryPrompt(transcript string, lang string, depth string) string {
+	guidance, ok := summaryDepthGuidance[depth]
+	if !ok {
+		guidance = summaryDepthGuidance["standard"]
+	}
+
+	var prompt strings.Builder
+
+	prompt.WriteString("You are summarizing a video transcript for an English learner. ")
+	prompt.WriteString("Each transcript line starts with the segment's start time in seconds, like [123s]. ")
+	prompt.WriteString("Respond with a single JSON object and nothing else, using exactly these keys: ")
+	prompt.WriteString(`"overview" (two or three sentences describing the whole video), `)
+	prompt.WriteString(`"main_ideas" (an array of strings, one per key takeaway), `)
+	prompt.WriteString(`"sections" (an array of objects with "title", "start_seconds", "end_seconds", and "summary", splitting the video into chapters in order), `)
+	prompt.WriteString(`"key_vocabulary" (an array of objects with "term" and "meaning" for words and expressions worth learning from this video)`)
+	prompt.WriteString(". Take section timestamps from the transcript lines. ")
+	prompt.WriteString(guidance)
+	if lang != "" {
+		prompt.WriteString(fmt.Sprintf(" The transcript language is %s; write the summary in the same language.", lang))
+	}
+	prompt.WriteString(" Do not wrap the JSON in markdown or add any commentary.\n\nTranscript:\n")
+	prompt.WriteString(transcript)
+
+	return prompt.String()
+}
+
+// ParseTranscriptSummary validates a model response against the summary
+// schema. Markdown code fences the model sometimes adds despite instructions
+// are stripped before parsing.
+func ParseTranscriptSummary(raw string) (*TranscriptSummary, error) {
+	cleaned := strings.TrimSpace(raw)
+	cleaned = strings.TrimPrefix(cleaned, "```json")
+	cleaned = strings.TrimPrefix(cleaned, "```")
+	cleaned = strings.TrimSuffix(cleaned, "```")
+	cleaned = strings.TrimSpace(cleaned)
+
+	var summary TranscriptSummary
+	if err := json.Unmarshal([]byte(cleaned), &summary); err != nil {
+		return nil, fmt.Errorf("summary response is not valid JSON: %w", err)
+	}
+
+	if summary.Overview == "" {
+		return nil, fmt.Errorf("summary response has no overview")
+	}
+	if len(summary.Sections) == 0 {
+		return nil, fmt.Errorf("summary response has no sections")
+	}
+
+	return &summary, nil
+}
+
+// SummarizeTranscript asks the model for a chaptered summary of a video
+// transcript, with main ideas and key vocabulary
+func (s *Service) SummarizeTranscript(ctx context.Context, transcript string, lang string, depth string) (*TranscriptSummary, error) {
+	if transcript == "" {
+		return nil, fmt.Errorf("transcript is required for a summary")
+	}
+
+	if err := s.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	prompt := BuildSummaryPrompt(transcript, lang, depth)
+
+	resp, err := s.genModel().GenerateContent(ctx, genai.Text(prompt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate transcript summary: %w", err)
+	}
+
+	text := ExtractText(resp)
+	if text == "" {
+		return nil, fmt.Errorf("no transcript summary generated")
+	}
+
+	return ParseTranscriptSummary(text)
+}
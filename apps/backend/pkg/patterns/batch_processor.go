@@ -17,22 +17,26 @@ type BatchItem[T any] struct {
 
 // BatchResult represents the result of processing a batch item
 type BatchResult[R any] struct {
-	ID     string
-	Data   R
-	Error  error
+	ID    string
+	Data  R
+	Error error
 }
 
 // BatchProcessor processes items in batches for efficiency
 type BatchProcessor[T any, R any] struct {
-	config       BatchProcessorConfig
-	inputChan    chan BatchItem[T]
-	resultChan   chan BatchResult[R]
-	processorFn  func(ctx context.Context, items []BatchItem[T]) ([]BatchResult[R], error)
-	ctx          context.Context
-	cancel       context.CancelFunc
-	wg           sync.WaitGroup
-	metrics      *BatchProcessorMetrics
-	logger       *zap.Logger
+	config      BatchProcessorConfig
+	inputChan   chan BatchItem[T]
+	resultChan  chan BatchResult[R]
+	processorFn func(ctx context.Context, items []BatchItem[T]) ([]BatchResult[R], error)
+	ctx         context.Context
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+	metrics     *BatchProcessorMetrics
+	logger      *zap.Logger
+
+	// onDeadLetter is invoked with the original item whenever processing
+	// fails, so callers can persist failed items for later inspection or retry
+	onDeadLetter func(item BatchItem[T], err error)
 }
 
 // BatchProcessorConfig holds configuration for the batch processor
@@ -102,6 +106,12 @@ func NewBatchProcessor[T any, R any](
 	return bp
 }
 
+// SetDeadLetterHandler registers a callback invoked with every item that
+// fails processing. Must be called before Start.
+func (bp *BatchProcessor[T, R]) SetDeadLetterHandler(fn func(item BatchItem[T], err error)) {
+	bp.onDeadLetter = fn
+}
+
 // Start starts the batch processor workers
 func (bp *BatchProcessor[T, R]) Start() {
 	bp.logger.Info("Starting batch processor",
@@ -140,7 +150,7 @@ func (bp *BatchProcessor[T, R]) Stop() {
 	close(bp.inputChan)
 	bp.wg.Wait()
 	close(bp.resultChan)
-	
+
 	metrics := bp.GetMetrics()
 	bp.logger.Info("Batch processor stopped",
 		zap.Int64("total_items", metrics.TotalItemsProcessed),
@@ -165,7 +175,7 @@ func (bp *BatchProcessor[T, R]) GetMetrics() BatchProcessorMetrics {
 // worker processes batches of items
 func (bp *BatchProcessor[T, R]) worker(workerID int) {
 	defer bp.wg.Done()
-	
+
 	workerLogger := bp.logger.With(zap.Int("worker_id", workerID))
 	workerLogger.Debug("Batch processor worker started")
 	defer workerLogger.Debug("Batch processor worker stopped")
@@ -235,24 +245,34 @@ func (bp *BatchProcessor[T, R]) processBatch(logger *zap.Logger, batch []BatchIt
 	bp.updateMetrics(batchSize, processingTime, results, err)
 
 	if err != nil {
-		logger.Error("Batch processing failed", 
+		logger.Error("Batch processing failed",
 			zap.Int("batch_size", batchSize),
 			zap.Duration("processing_time", processingTime),
 			zap.Error(err))
-		
+
 		// Create error results for all items in batch
 		for _, item := range batch {
 			result := BatchResult[R]{
 				ID:    item.ID,
 				Error: err,
 			}
+			bp.deadLetter(item, err)
 			bp.sendResult(result)
 		}
 		return
 	}
 
-	// Send individual results
+	// Send individual results, dead-lettering any per-item failures
+	itemsByID := make(map[string]BatchItem[T], len(batch))
+	for _, item := range batch {
+		itemsByID[item.ID] = item
+	}
 	for _, result := range results {
+		if result.Error != nil {
+			if item, ok := itemsByID[result.ID]; ok {
+				bp.deadLetter(item, result.Error)
+			}
+		}
 		bp.sendResult(result)
 	}
 
@@ -262,6 +282,14 @@ func (bp *BatchProcessor[T, R]) processBatch(logger *zap.Logger, batch []BatchIt
 		zap.Duration("processing_time", processingTime))
 }
 
+// deadLetter hands a failed item to the configured dead-letter callback
+func (bp *BatchProcessor[T, R]) deadLetter(item BatchItem[T], err error) {
+	if bp.onDeadLetter == nil {
+		return
+	}
+	bp.onDeadLetter(item, err)
+}
+
 // sendResult sends a result to the result channel
 func (bp *BatchProcessor[T, R]) sendResult(result BatchResult[R]) {
 	select {
@@ -297,8 +325,8 @@ func (bp *BatchProcessor[T, R]) updateMetrics(batchSize int, processingTime time
 // AsyncBatchProcessor provides a higher-level async interface
 type AsyncBatchProcessor[T any, R any] struct {
 	*BatchProcessor[T, R]
-	pendingResults map[string]chan BatchResult[R]
-	resultsMu      sync.RWMutex
+	pendingResults  map[string]chan BatchResult[R]
+	resultsMu       sync.RWMutex
 	resultProcessor *WorkerPool[BatchResult[R], struct{}]
 }
 
@@ -308,7 +336,7 @@ func NewAsyncBatchProcessor[T any, R any](
 	processorFn func(ctx context.Context, items []BatchItem[T]) ([]BatchResult[R], error),
 ) *AsyncBatchProcessor[T, R] {
 	bp := NewBatchProcessor(config, processorFn)
-	
+
 	abp := &AsyncBatchProcessor[T, R]{
 		BatchProcessor: bp,
 		pendingResults: make(map[string]chan BatchResult[R]),
@@ -321,9 +349,9 @@ func NewAsyncBatchProcessor[T any, R any](
 		Timeout:     5 * time.Second,
 		Logger:      config.Logger,
 	}
-	
+
 	abp.resultProcessor = NewWorkerPool[BatchResult[R], struct{}](resultConfig)
-	
+
 	return abp
 }
 
@@ -331,19 +359,21 @@ func NewAsyncBatchProcessor[T any, R any](
 func (abp *AsyncBatchProcessor[T, R]) Start() {
 	abp.BatchProcessor.Start()
 	abp.resultProcessor.Start()
-	
+
 	// Start result router
 	go abp.routeResults()
 }
 
-// SubmitAsync submits an item and returns a channel for the result
+// SubmitAsync submits an item and returns a channel for the result.
+// The channel is buffered for the single result it will ever carry, so the
+// router can always complete its send without blocking or dropping.
 func (abp *AsyncBatchProcessor[T, R]) SubmitAsync(item BatchItem[T]) (<-chan BatchResult[R], error) {
 	resultChan := make(chan BatchResult[R], 1)
-	
+
 	abp.resultsMu.Lock()
 	abp.pendingResults[item.ID] = resultChan
 	abp.resultsMu.Unlock()
-	
+
 	err := abp.Submit(item)
 	if err != nil {
 		abp.resultsMu.Lock()
@@ -352,7 +382,7 @@ func (abp *AsyncBatchProcessor[T, R]) SubmitAsync(item BatchItem[T]) (<-chan Bat
 		close(resultChan)
 		return nil, err
 	}
-	
+
 	return resultChan, nil
 }
 
@@ -362,7 +392,7 @@ func (abp *AsyncBatchProcessor[T, R]) SubmitAndWait(ctx context.Context, item Ba
 	if err != nil {
 		return BatchResult[R]{}, err
 	}
-	
+
 	select {
 	case result := <-resultChan:
 		return result, nil
@@ -379,7 +409,7 @@ func (abp *AsyncBatchProcessor[T, R]) SubmitAndWait(ctx context.Context, item Ba
 func (abp *AsyncBatchProcessor[T, R]) Stop() {
 	abp.BatchProcessor.Stop()
 	abp.resultProcessor.Stop()
-	
+
 	// Close all pending result channels
 	abp.resultsMu.Lock()
 	for _, ch := range abp.pendingResults {
@@ -394,14 +424,13 @@ func (abp *AsyncBatchProcessor[T, R]) routeResults() {
 	for result := range abp.Results() {
 		abp.resultsMu.Lock()
 		if ch, exists := abp.pendingResults[result.ID]; exists {
-			select {
-			case ch <- result:
-			default:
-				abp.logger.Warn("Failed to send result to waiting channel", zap.String("item_id", result.ID))
-			}
+			// Each pending channel holds a single-slot buffer and is removed
+			// from the map before a second result for the same ID could be
+			// routed, so this send never blocks and never drops
+			ch <- result
 			close(ch)
 			delete(abp.pendingResults, result.ID)
 		}
 		abp.resultsMu.Unlock()
 	}
-}
\ No newline at end of file
+}
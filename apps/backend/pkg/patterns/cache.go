@@ -0,0 +1,330 @@
+package patterns
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// EvictionReason explains why an entry left a Cache
+type EvictionReason int
+
+const (
+	// EvictedCapacity means the entry was the least recently used one when
+	// its shard reached capacity
+	EvictedCapacity EvictionReason = iota
+	// EvictedExpired means the entry's TTL elapsed
+	EvictedExpired
+	// EvictedManually means the entry was removed with Delete
+	EvictedManually
+)
+
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictedCapacity:
+		return "CAPACITY"
+	case EvictedExpired:
+		return "EXPIRED"
+	case EvictedManually:
+		return "MANUAL"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// defaultCacheCapacity bounds caches created without an explicit MaxEntries
+const defaultCacheCapacity = 1024
+
+// CacheConfig holds configuration for a Cache
+type CacheConfig[K comparable, V any] struct {
+	// MaxEntries caps the number of stored entries; the least recently used
+	// entry is evicted beyond it. Zero or negative uses a default capacity.
+	MaxEntries int
+	// DefaultTTL is applied by Set; zero means entries never expire. SetWithTTL
+	// overrides it per entry.
+	DefaultTTL time.Duration
+	// OnEvict, when non-nil, is called for every entry that leaves the cache
+	// through capacity eviction, expiry or Delete. It runs outside the shard
+	// lock, so it may call back into the cache.
+	OnEvict func(key K, value V, reason EvictionReason)
+	// Shards splits the cache into independently locked shards for concurrent
+	// workloads; rounded up to a power of 2, zero or one keeps a single shard
+	Shards uint32
+	// CleanupInterval is how often the janitor sweeps expired entries; zero
+	// uses DefaultTTLCleanupInterval
+	CleanupInterval time.Duration
+}
+
+// Cache is a thread-safe in-memory cache combining LRU capacity eviction with
+// per-entry TTL expiry. It records hit/miss metrics and can be sharded to
+// reduce lock contention. Unlike LRUCache and TTLMap, which each cover one
+// eviction policy, Cache enforces both at once. Call Stop when the cache is
+// no longer needed to terminate its janitor.
+type Cache[K comparable, V any] struct {
+	config    CacheConfig[K, V]
+	shards    []*cacheShard[K, V]
+	shardMask uint32
+
+	hits      atomic.Int64
+	misses    atomic.Int64
+	evictions atomic.Int64
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// cacheShard is one independently locked segment of a Cache
+type cacheShard[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	entries  map[K]*list.Element
+}
+
+// cacheEntry is the key/value pair stored in a shard's recency list
+type cacheEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time // zero means the entry never expires
+}
+
+// expired reports whether the entry's TTL has elapsed at now
+func (e *cacheEntry[K, V]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && now.After(e.expiresAt)
+}
+
+// NewCache creates a cache from the given configuration and starts its
+// janitor
+func NewCache[K comparable, V any](config CacheConfig[K, V]) *Cache[K, V] {
+	if config.MaxEntries <= 0 {
+		config.MaxEntries = defaultCacheCapacity
+	}
+	if config.CleanupInterval <= 0 {
+		config.CleanupInterval = DefaultTTLCleanupInterval
+	}
+
+	shardCount := nextPowerOf2(config.Shards)
+
+	// Split the capacity across shards, rounding up so the total is never
+	// below the configured maximum
+	shardCapacity := (config.MaxEntries + int(shardCount) - 1) / int(shardCount)
+
+	c := &Cache[K, V]{
+		config:    config,
+		shards:    make([]*cacheShard[K, V], shardCount),
+		shardMask: shardCount - 1,
+		stopCh:    make(chan struct{}),
+	}
+	for i := range c.shards {
+		c.shards[i] = &cacheShard[K, V]{
+			capacity: shardCapacity,
+			order:    list.New(),
+			entries:  make(map[K]*list.Element),
+		}
+	}
+
+	go c.janitor(config.CleanupInterval)
+
+	return c
+}
+
+// getShard returns the shard responsible for the given key
+func (c *Cache[K, V]) getShard(key K) *cacheShard[K, V] {
+	return c.shards[hash(key)&c.shardMask]
+}
+
+// Set stores a value under the configured default TTL
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.config.DefaultTTL)
+}
+
+// SetWithTTL stores a value that expires after ttl; zero or negative ttl
+// means the entry never expires
+func (c *Cache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	shard := c.getShard(key)
+	shard.mu.Lock()
+
+	if element, ok := shard.entries[key]; ok {
+		entry := element.Value.(*cacheEntry[K, V])
+		entry.value = value
+		entry.expiresAt = expiresAt
+		shard.order.MoveToFront(element)
+		shard.mu.Unlock()
+		return
+	}
+
+	var evicted *cacheEntry[K, V]
+	if shard.order.Len() >= shard.capacity {
+		if oldest := shard.order.Back(); oldest != nil {
+			evicted = oldest.Value.(*cacheEntry[K, V])
+			shard.order.Remove(oldest)
+			delete(shard.entries, evicted.key)
+		}
+	}
+
+	shard.entries[key] = shard.order.PushFront(&cacheEntry[K, V]{
+		key:       key,
+		value:     value,
+		expiresAt: expiresAt,
+	})
+	shard.mu.Unlock()
+
+	if evicted != nil {
+		c.evictions.Add(1)
+		c.notifyEvict(evicted, EvictedCapacity)
+	}
+}
+
+// Get retrieves a value by key, marking it most recently used. Expired
+// entries are dropped and reported as misses.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	shard := c.getShard(key)
+	shard.mu.Lock()
+
+	element, ok := shard.entries[key]
+	if !ok {
+		shard.mu.Unlock()
+		c.misses.Add(1)
+		var zero V
+		return zero, false
+	}
+
+	entry := element.Value.(*cacheEntry[K, V])
+	if entry.expired(time.Now()) {
+		shard.order.Remove(element)
+		delete(shard.entries, key)
+		shard.mu.Unlock()
+
+		c.misses.Add(1)
+		c.evictions.Add(1)
+		c.notifyEvict(entry, EvictedExpired)
+		var zero V
+		return zero, false
+	}
+
+	shard.order.MoveToFront(element)
+	value := entry.value
+	shard.mu.Unlock()
+
+	c.hits.Add(1)
+	return value, true
+}
+
+// Delete removes a key, reporting whether an entry was present
+func (c *Cache[K, V]) Delete(key K) bool {
+	shard := c.getShard(key)
+	shard.mu.Lock()
+
+	element, ok := shard.entries[key]
+	if !ok {
+		shard.mu.Unlock()
+		return false
+	}
+	entry := element.Value.(*cacheEntry[K, V])
+	shard.order.Remove(element)
+	delete(shard.entries, key)
+	shard.mu.Unlock()
+
+	c.notifyEvict(entry, EvictedManually)
+	return true
+}
+
+// Len returns the number of stored entries, including any expired entries
+// the janitor has not swept yet
+func (c *Cache[K, V]) Len() int {
+	total := 0
+	for _, shard := range c.shards {
+		shard.mu.Lock()
+		total += shard.order.Len()
+		shard.mu.Unlock()
+	}
+	return total
+}
+
+// CacheMetrics is a point-in-time snapshot of cache effectiveness counters
+type CacheMetrics struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+	HitRate   float64
+}
+
+// Metrics returns a snapshot of the cache's hit/miss/eviction counters
+func (c *Cache[K, V]) Metrics() CacheMetrics {
+	hits := c.hits.Load()
+	misses := c.misses.Load()
+
+	hitRate := 0.0
+	if total := hits + misses; total > 0 {
+		hitRate = float64(hits) / float64(total)
+	}
+
+	return CacheMetrics{
+		Hits:      hits,
+		Misses:    misses,
+		Evictions: c.evictions.Load(),
+		HitRate:   hitRate,
+	}
+}
+
+// Stop terminates the janitor goroutine. The cache remains usable, but
+// expired entries are only dropped lazily on access afterwards.
+func (c *Cache[K, V]) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+	})
+}
+
+// notifyEvict invokes the eviction callback when one is configured
+func (c *Cache[K, V]) notifyEvict(entry *cacheEntry[K, V], reason EvictionReason) {
+	if c.config.OnEvict != nil {
+		c.config.OnEvict(entry.key, entry.value, reason)
+	}
+}
+
+// janitor periodically removes expired entries
+func (c *Cache[K, V]) janitor(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.sweep()
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
+// sweep removes all expired entries, invoking the eviction callback for each
+func (c *Cache[K, V]) sweep() {
+	now := time.Now()
+
+	for _, shard := range c.shards {
+		shard.mu.Lock()
+		expired := make([]*cacheEntry[K, V], 0)
+		for element := shard.order.Back(); element != nil; {
+			prev := element.Prev()
+			entry := element.Value.(*cacheEntry[K, V])
+			if entry.expired(now) {
+				shard.order.Remove(element)
+				delete(shard.entries, entry.key)
+				expired = append(expired, entry)
+			}
+			element = prev
+		}
+		shard.mu.Unlock()
+
+		for _, entry := range expired {
+			c.evictions.Add(1)
+			c.notifyEvict(entry, EvictedExpired)
+		}
+	}
+}
@@ -10,9 +10,9 @@ import (
 )
 
 var (
-	ErrCircuitBreakerOpen     = errors.New("circuit breaker is open")
-	ErrTooManyRequests        = errors.New("too many requests")
-	ErrCircuitBreakerTimeout  = errors.New("circuit breaker timeout")
+	ErrCircuitBreakerOpen    = errors.New("circuit breaker is open")
+	ErrTooManyRequests       = errors.New("too many requests")
+	ErrCircuitBreakerTimeout = errors.New("circuit breaker timeout")
 )
 
 // CircuitBreakerState represents the state of the circuit breaker
@@ -39,15 +39,15 @@ func (s CircuitBreakerState) String() string {
 
 // CircuitBreakerConfig holds configuration for the circuit breaker
 type CircuitBreakerConfig struct {
-	Name                   string        // Name for logging and metrics
-	MaxRequests            uint32        // Max requests allowed when half-open
-	Interval               time.Duration // Time window for failure counting
-	Timeout                time.Duration // Time to wait before transitioning from open to half-open
-	FailureThreshold       uint32        // Number of failures to trip the breaker
-	SuccessThreshold       uint32        // Number of successes needed to close from half-open
-	IsFailure              func(error) bool // Function to determine if error should count as failure
-	OnStateChange          func(name string, from, to CircuitBreakerState) // Callback for state changes
-	Logger                 *zap.Logger   // Logger instance
+	Name             string                                          // Name for logging and metrics
+	MaxRequests      uint32                                          // Max requests allowed when half-open
+	Interval         time.Duration                                   // Time window for failure counting
+	Timeout          time.Duration                                   // Time to wait before transitioning from open to half-open
+	FailureThreshold uint32                                          // Number of failures to trip the breaker
+	SuccessThreshold uint32                                          // Number of successes needed to close from half-open
+	IsFailure        func(error) bool                                // Function to determine if error should count as failure
+	OnStateChange    func(name string, from, to CircuitBreakerState) // Callback for state changes
+	Logger           *zap.Logger                                     // Logger instance
 }
 
 // CircuitBreaker implements the circuit breaker pattern
@@ -153,7 +153,7 @@ func (cb *CircuitBreaker) ExecuteWithFallback(ctx context.Context, fn func() err
 func (cb *CircuitBreaker) GetState() CircuitBreakerState {
 	cb.mutex.RLock()
 	defer cb.mutex.RUnlock()
-	
+
 	now := time.Now()
 	state, _ := cb.currentState(now)
 	return state
@@ -170,7 +170,7 @@ func (cb *CircuitBreaker) GetCounts() Counts {
 func (cb *CircuitBreaker) Reset() {
 	cb.mutex.Lock()
 	defer cb.mutex.Unlock()
-	
+
 	cb.changeState(StateClosed, time.Now())
 	cb.config.Logger.Info("Circuit breaker reset", zap.String("name", cb.config.Name))
 }
@@ -202,7 +202,7 @@ func (cb *CircuitBreaker) afterRequest(before uint64, success bool) {
 
 	now := time.Now()
 	state, generation := cb.currentState(now)
-	
+
 	// Ignore results from different generations
 	if generation != before {
 		return
@@ -293,21 +293,21 @@ func (cb *CircuitBreaker) changeState(state CircuitBreakerState, now time.Time)
 
 // IsCircuitBreakerError checks if an error is a circuit breaker error
 func IsCircuitBreakerError(err error) bool {
-	return errors.Is(err, ErrCircuitBreakerOpen) || 
-		   errors.Is(err, ErrTooManyRequests) || 
-		   errors.Is(err, ErrCircuitBreakerTimeout)
+	return errors.Is(err, ErrCircuitBreakerOpen) ||
+		errors.Is(err, ErrTooManyRequests) ||
+		errors.Is(err, ErrCircuitBreakerTimeout)
 }
 
 // CircuitBreakerMetrics provides metrics for monitoring
 type CircuitBreakerMetrics struct {
-	Name                   string
-	State                  string
-	TotalRequests          uint32
-	TotalSuccesses         uint32
-	TotalFailures          uint32
-	ConsecutiveSuccesses   uint32
-	ConsecutiveFailures    uint32
-	FailureRate            float64
+	Name                 string
+	State                string
+	TotalRequests        uint32
+	TotalSuccesses       uint32
+	TotalFailures        uint32
+	ConsecutiveSuccesses uint32
+	ConsecutiveFailures  uint32
+	FailureRate          float64
 }
 
 // GetMetrics returns current metrics for the circuit breaker
@@ -317,7 +317,7 @@ func (cb *CircuitBreaker) GetMetrics() CircuitBreakerMetrics {
 
 	counts := *cb.counts
 	var failureRate float64
-	
+
 	totalRequests := counts.TotalSuccesses + counts.TotalFailures
 	if totalRequests > 0 {
 		failureRate = float64(counts.TotalFailures) / float64(totalRequests)
@@ -331,6 +331,6 @@ func (cb *CircuitBreaker) GetMetrics() CircuitBreakerMetrics {
 		TotalFailures:        counts.TotalFailures,
 		ConsecutiveSuccesses: counts.ConsecutiveSuccesses,
 		ConsecutiveFailures:  counts.ConsecutiveFailures,
-		FailureRate:         failureRate,
+		FailureRate:          failureRate,
 	}
-}
\ No newline at end of file
+}
@@ -7,7 +7,7 @@ import (
 
 // ConcurrentMap provides a thread-safe map implementation
 type ConcurrentMap[K comparable, V any] struct {
-	shards   []*shard[K, V]
+	shards    []*shard[K, V]
 	shardMask uint32
 }
 
@@ -28,18 +28,18 @@ func NewConcurrentMap[K comparable, V any]() *ConcurrentMap[K, V] {
 func NewConcurrentMapWithShards[K comparable, V any](shardCount uint32) *ConcurrentMap[K, V] {
 	// Ensure shard count is power of 2 for efficient modulo
 	shardCount = nextPowerOf2(shardCount)
-	
+
 	cm := &ConcurrentMap[K, V]{
-		shards:   make([]*shard[K, V], shardCount),
+		shards:    make([]*shard[K, V], shardCount),
 		shardMask: shardCount - 1,
 	}
-	
+
 	for i := uint32(0); i < shardCount; i++ {
 		cm.shards[i] = &shard[K, V]{
 			items: make(map[K]V),
 		}
 	}
-	
+
 	return cm
 }
 
@@ -73,6 +73,18 @@ func (cm *ConcurrentMap[K, V]) Delete(key K) {
 	delete(shard.items, key)
 }
 
+// GetAndDelete atomically retrieves and removes a value by key
+func (cm *ConcurrentMap[K, V]) GetAndDelete(key K) (V, bool) {
+	shard := cm.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	value, ok := shard.items[key]
+	if ok {
+		delete(shard.items, key)
+	}
+	return value, ok
+}
+
 // Has checks if a key exists
 func (cm *ConcurrentMap[K, V]) Has(key K) bool {
 	_, ok := cm.Get(key)
@@ -143,11 +155,11 @@ func (cm *ConcurrentMap[K, V]) GetOrSet(key K, defaultValue V) (V, bool) {
 	shard := cm.getShard(key)
 	shard.mu.Lock()
 	defer shard.mu.Unlock()
-	
+
 	if value, ok := shard.items[key]; ok {
 		return value, false // Value existed
 	}
-	
+
 	shard.items[key] = defaultValue
 	return defaultValue, true // Value was set
 }
@@ -155,7 +167,7 @@ func (cm *ConcurrentMap[K, V]) GetOrSet(key K, defaultValue V) (V, bool) {
 // GetOrCompute gets a value or computes it if it doesn't exist
 func (cm *ConcurrentMap[K, V]) GetOrCompute(key K, computeFn func() V) V {
 	shard := cm.getShard(key)
-	
+
 	// First try with read lock
 	shard.mu.RLock()
 	if value, ok := shard.items[key]; ok {
@@ -163,16 +175,16 @@ func (cm *ConcurrentMap[K, V]) GetOrCompute(key K, computeFn func() V) V {
 		return value
 	}
 	shard.mu.RUnlock()
-	
+
 	// Upgrade to write lock
 	shard.mu.Lock()
 	defer shard.mu.Unlock()
-	
+
 	// Double-check after acquiring write lock
 	if value, ok := shard.items[key]; ok {
 		return value
 	}
-	
+
 	// Compute and set the value
 	value := computeFn()
 	shard.items[key] = value
@@ -196,14 +208,14 @@ func (cm *ConcurrentMap[K, V]) ForEach(fn func(K, V) bool) {
 // Filter returns a new map with items that match the predicate
 func (cm *ConcurrentMap[K, V]) Filter(predicate func(K, V) bool) *ConcurrentMap[K, V] {
 	result := NewConcurrentMapWithShards[K, V](uint32(len(cm.shards)))
-	
+
 	cm.ForEach(func(key K, value V) bool {
 		if predicate(key, value) {
 			result.Set(key, value)
 		}
 		return true
 	})
-	
+
 	return result
 }
 
@@ -212,7 +224,7 @@ func (cm *ConcurrentMap[K, V]) Update(key K, updateFn func(V) V) bool {
 	shard := cm.getShard(key)
 	shard.mu.Lock()
 	defer shard.mu.Unlock()
-	
+
 	if value, ok := shard.items[key]; ok {
 		shard.items[key] = updateFn(value)
 		return true
@@ -225,7 +237,7 @@ func (cm *ConcurrentMap[K, V]) CompareAndSwap(key K, oldValue, newValue V, compa
 	shard := cm.getShard(key)
 	shard.mu.Lock()
 	defer shard.mu.Unlock()
-	
+
 	if currentValue, ok := shard.items[key]; ok && compareFn(currentValue, oldValue) {
 		shard.items[key] = newValue
 		return true
@@ -258,4 +270,3 @@ func hash[K comparable](key K) uint32 {
 	}
 	return h
 }
-
@@ -0,0 +1,54 @@
+package patterns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// FirstSuccess runs all fns concurrently and returns the result of the first
+// one to succeed, cancelling the context passed to the rest. When every fn
+// fails, the individual errors are aggregated into a single error.
+func FirstSuccess[T any](ctx context.Context, fns []func(context.Context) (T, error)) (T, error) {
+	var zero T
+	if len(fns) == 0 {
+		return zero, fmt.Errorf("no functions provided")
+	}
+
+	raceCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type outcome struct {
+		index int
+		value T
+		err   error
+	}
+
+	// Buffered so losers can finish without leaking goroutines
+	results := make(chan outcome, len(fns))
+	for i, fn := range fns {
+		go func(index int, fn func(context.Context) (T, error)) {
+			value, err := fn(raceCtx)
+			results <- outcome{index: index, value: value, err: err}
+		}(i, fn)
+	}
+
+	errs := make([]error, len(fns))
+	for received := 0; received < len(fns); received++ {
+		select {
+		case <-ctx.Done():
+			// Return promptly on cancellation instead of waiting for
+			// functions that ignore their context; in-flight goroutines
+			// drain into the buffered channel
+			return zero, ctx.Err()
+		case result := <-results:
+			if result.err == nil {
+				cancel()
+				return result.value, nil
+			}
+			errs[result.index] = fmt.Errorf("fn %d: %w", result.index, result.err)
+		}
+	}
+
+	return zero, errors.Join(errs...)
+}
@@ -41,12 +41,12 @@ func (p *Pipeline[T]) AddStage(stage Stage[T]) *Pipeline[T] {
 // Execute executes the pipeline with the given input
 func (p *Pipeline[T]) Execute(ctx context.Context, input T) (T, error) {
 	current := input
-	
+
 	for i, stage := range p.stages {
 		p.logger.Debug("Executing pipeline stage",
 			zap.Int("stage_index", i),
 			zap.String("stage_name", stage.Name()))
-		
+
 		result, err := stage.Process(ctx, current)
 		if err != nil {
 			p.logger.Error("Pipeline stage failed",
@@ -55,9 +55,9 @@ func (p *Pipeline[T]) Execute(ctx context.Context, input T) (T, error) {
 				zap.Error(err))
 			return current, fmt.Errorf("stage %d (%s) failed: %w", i, stage.Name(), err)
 		}
-		
+
 		current = result
-		
+
 		// Check for context cancellation between stages
 		select {
 		case <-ctx.Done():
@@ -65,16 +65,16 @@ func (p *Pipeline[T]) Execute(ctx context.Context, input T) (T, error) {
 		default:
 		}
 	}
-	
+
 	p.logger.Debug("Pipeline execution completed", zap.Int("stages", len(p.stages)))
 	return current, nil
 }
 
 // ParallelPipeline executes multiple items through a pipeline concurrently
 type ParallelPipeline[T any] struct {
-	pipeline *Pipeline[T]
+	pipeline  *Pipeline[T]
 	semaphore *Semaphore
-	logger   *zap.Logger
+	logger    *zap.Logger
 }
 
 // NewParallelPipeline creates a new parallel pipeline
@@ -94,29 +94,29 @@ func (pp *ParallelPipeline[T]) ExecuteAll(ctx context.Context, inputs []T) ([]T,
 	if len(inputs) == 0 {
 		return []T{}, []error{}
 	}
-	
+
 	results := make([]T, len(inputs))
 	errors := make([]error, len(inputs))
 	var wg sync.WaitGroup
-	
+
 	for i, input := range inputs {
 		wg.Add(1)
 		go func(index int, item T) {
 			defer wg.Done()
-			
+
 			err := pp.semaphore.Acquire(ctx)
 			if err != nil {
 				errors[index] = err
 				return
 			}
 			defer pp.semaphore.Release()
-			
+
 			result, err := pp.pipeline.Execute(ctx, item)
 			results[index] = result
 			errors[index] = err
 		}(i, input)
 	}
-	
+
 	wg.Wait()
 	return results, errors
 }
@@ -196,7 +196,7 @@ func NewRetryStage[T any](stage Stage[T], maxRetries int, logger *zap.Logger) *R
 // Process implements Stage interface
 func (rs *RetryStage[T]) Process(ctx context.Context, input T) (T, error) {
 	var lastErr error
-	
+
 	for attempt := 0; attempt <= rs.maxRetries; attempt++ {
 		result, err := rs.stage.Process(ctx, input)
 		if err == nil {
@@ -207,16 +207,16 @@ func (rs *RetryStage[T]) Process(ctx context.Context, input T) (T, error) {
 			}
 			return result, nil
 		}
-		
+
 		lastErr = err
-		
+
 		if attempt < rs.maxRetries {
 			rs.logger.Warn("Stage failed, retrying",
 				zap.String("stage", rs.stage.Name()),
 				zap.Int("attempt", attempt),
 				zap.Error(err))
 		}
-		
+
 		// Check for context cancellation
 		select {
 		case <-ctx.Done():
@@ -224,12 +224,12 @@ func (rs *RetryStage[T]) Process(ctx context.Context, input T) (T, error) {
 		default:
 		}
 	}
-	
+
 	rs.logger.Error("Stage failed after all retries",
 		zap.String("stage", rs.stage.Name()),
 		zap.Int("max_retries", rs.maxRetries),
 		zap.Error(lastErr))
-	
+
 	return input, fmt.Errorf("stage %s failed after %d retries: %w", rs.stage.Name(), rs.maxRetries, lastErr)
 }
 
@@ -260,7 +260,7 @@ func (ts *TimedStage[T]) Process(ctx context.Context, input T) (T, error) {
 	start := time.Now()
 	result, err := ts.stage.Process(ctx, input)
 	duration := time.Since(start)
-	
+
 	if err != nil {
 		ts.logger.Error("Timed stage failed",
 			zap.String("stage", ts.stage.Name()),
@@ -271,7 +271,7 @@ func (ts *TimedStage[T]) Process(ctx context.Context, input T) (T, error) {
 			zap.String("stage", ts.stage.Name()),
 			zap.Duration("duration", duration))
 	}
-	
+
 	return result, err
 }
 
@@ -279,4 +279,3 @@ func (ts *TimedStage[T]) Process(ctx context.Context, input T) (T, error) {
 func (ts *TimedStage[T]) Name() string {
 	return fmt.Sprintf("timed-%s", ts.stage.Name())
 }
-
@@ -19,23 +19,23 @@ type RateLimiter interface {
 
 // RateLimiterStats provides statistics about the rate limiter
 type RateLimiterStats struct {
-	RequestsAllowed  int64
-	RequestsDenied   int64
-	CurrentTokens    int
-	RefillRate       float64
-	LastRefill       time.Time
+	RequestsAllowed int64
+	RequestsDenied  int64
+	CurrentTokens   int
+	RefillRate      float64
+	LastRefill      time.Time
 }
 
 // TokenBucketLimiter implements rate limiting using the token bucket algorithm
 type TokenBucketLimiter struct {
-	mu           sync.Mutex
-	capacity     int           // Maximum tokens in bucket
-	tokens       int           // Current tokens available
-	refillRate   time.Duration // Time between token refills
-	lastRefill   time.Time     // Last time tokens were added
-	allowed      int64         // Number of requests allowed
-	denied       int64         // Number of requests denied
-	logger       *zap.Logger
+	mu         sync.Mutex
+	capacity   int           // Maximum tokens in bucket
+	tokens     int           // Current tokens available
+	refillRate time.Duration // Time between token refills
+	lastRefill time.Time     // Last time tokens were added
+	allowed    int64         // Number of requests allowed
+	denied     int64         // Number of requests denied
+	logger     *zap.Logger
 }
 
 // NewTokenBucketLimiter creates a new token bucket rate limiter
@@ -135,7 +135,7 @@ func (tbl *TokenBucketLimiter) GetStats() RateLimiterStats {
 func (tbl *TokenBucketLimiter) refillTokens() {
 	now := time.Now()
 	elapsed := now.Sub(tbl.lastRefill)
-	
+
 	if elapsed >= tbl.refillRate {
 		tokensToAdd := int(elapsed / tbl.refillRate)
 		tbl.tokens = min(tbl.capacity, tbl.tokens+tokensToAdd)
@@ -145,13 +145,13 @@ func (tbl *TokenBucketLimiter) refillTokens() {
 
 // SlidingWindowLimiter implements rate limiting using sliding window algorithm
 type SlidingWindowLimiter struct {
-	mu         sync.Mutex
-	requests   []time.Time   // Request timestamps
-	window     time.Duration // Time window
-	limit      int           // Max requests in window
-	allowed    int64         // Number of requests allowed
-	denied     int64         // Number of requests denied
-	logger     *zap.Logger
+	mu       sync.Mutex
+	requests []time.Time   // Request timestamps
+	window   time.Duration // Time window
+	limit    int           // Max requests in window
+	allowed  int64         // Number of requests allowed
+	denied   int64         // Number of requests denied
+	logger   *zap.Logger
 }
 
 // NewSlidingWindowLimiter creates a new sliding window rate limiter
@@ -204,7 +204,7 @@ func (swl *SlidingWindowLimiter) Wait(ctx context.Context) error {
 			swl.mu.Unlock()
 			continue
 		}
-		
+
 		// Wait until the oldest request expires
 		oldestRequest := swl.requests[0]
 		waitTime := swl.window - time.Since(oldestRequest)
@@ -255,13 +255,13 @@ func (swl *SlidingWindowLimiter) GetStats() RateLimiterStats {
 // cleanOldRequests removes requests outside the time window
 func (swl *SlidingWindowLimiter) cleanOldRequests(now time.Time) {
 	cutoff := now.Add(-swl.window)
-	
+
 	// Find first request within window
 	i := 0
 	for i < len(swl.requests) && swl.requests[i].Before(cutoff) {
 		i++
 	}
-	
+
 	// Remove old requests
 	if i > 0 {
 		copy(swl.requests, swl.requests[i:])
@@ -324,4 +324,4 @@ func min(a, b int) int {
 		return a
 	}
 	return b
-}
\ No newline at end of file
+}
@@ -61,4 +61,4 @@ func (s *Semaphore) WithSemaphore(ctx context.Context, fn func() error) error {
 	}
 	defer s.Release()
 	return fn()
-}
\ No newline at end of file
+}
@@ -0,0 +1,52 @@
+package patterns
+
+import "sync"
+
+// SingleFlight coalesces concurrent calls that share a key: the first caller
+// executes the function while later callers for the same key block and
+// receive the same result. Once a call completes its key is forgotten, so
+// subsequent calls execute again. Joined callers share the executing caller's
+// outcome, including an error caused by that caller's context being canceled.
+type SingleFlight[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*flightCall[V]
+}
+
+// flightCall tracks one in-flight execution and its eventual result
+type flightCall[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// NewSingleFlight creates an empty deduplicator
+func NewSingleFlight[K comparable, V any]() *SingleFlight[K, V] {
+	return &SingleFlight[K, V]{
+		calls: make(map[K]*flightCall[V]),
+	}
+}
+
+// Do executes fn, making sure only one execution is in flight per key at a
+// time. The boolean reports whether the result was shared from another
+// caller's execution.
+func (sf *SingleFlight[K, V]) Do(key K, fn func() (V, error)) (V, bool, error) {
+	sf.mu.Lock()
+	if call, ok := sf.calls[key]; ok {
+		sf.mu.Unlock()
+		<-call.done
+		return call.value, true, call.err
+	}
+
+	call := &flightCall[V]{done: make(chan struct{})}
+	sf.calls[key] = call
+	sf.mu.Unlock()
+
+	call.value, call.err = fn()
+
+	sf.mu.Lock()
+	delete(sf.calls, key)
+	sf.mu.Unlock()
+	close(call.done)
+
+	return call.value, false, call.err
+}
@@ -0,0 +1,124 @@
+package patterns
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultTTLCleanupInterval is how often the janitor sweeps expired entries
+// when no interval is configured
+const DefaultTTLCleanupInterval = time.Minute
+
+// ttlEntry wraps a stored value with its expiration time
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// TTLMap is a thread-safe map whose entries expire after a per-entry TTL.
+// Expired entries are removed by a background janitor instead of on the hot
+// path, so writers do not pay cleanup costs under load. Entries are stored in
+// a sharded ConcurrentMap to keep lock contention low.
+type TTLMap[K comparable, V any] struct {
+	entries  *ConcurrentMap[K, ttlEntry[V]]
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewTTLMap creates a TTL map and starts its janitor. The janitor sweeps
+// expired entries every cleanupInterval; pass zero to use
+// DefaultTTLCleanupInterval. Call Stop when the map is no longer needed.
+func NewTTLMap[K comparable, V any](cleanupInterval time.Duration) *TTLMap[K, V] {
+	if cleanupInterval <= 0 {
+		cleanupInterval = DefaultTTLCleanupInterval
+	}
+
+	tm := &TTLMap[K, V]{
+		entries: NewConcurrentMap[K, ttlEntry[V]](),
+		stopCh:  make(chan struct{}),
+	}
+
+	go tm.janitor(cleanupInterval)
+
+	return tm
+}
+
+// Set stores a value that expires after ttl
+func (tm *TTLMap[K, V]) Set(key K, value V, ttl time.Duration) {
+	tm.entries.Set(key, ttlEntry[V]{
+		value:     value,
+		expiresAt: time.Now().Add(ttl),
+	})
+}
+
+// Get retrieves a value by key; expired entries are treated as missing
+func (tm *TTLMap[K, V]) Get(key K) (V, bool) {
+	entry, ok := tm.entries.Get(key)
+	if !ok || time.Now().After(entry.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// GetAndDelete atomically retrieves and removes a value, enforcing single-use
+// semantics. Expired entries are treated as missing.
+func (tm *TTLMap[K, V]) GetAndDelete(key K) (V, bool) {
+	entry, ok := tm.entries.GetAndDelete(key)
+	if !ok || time.Now().After(entry.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Delete removes a key regardless of expiration
+func (tm *TTLMap[K, V]) Delete(key K) {
+	tm.entries.Delete(key)
+}
+
+// Size returns the number of stored entries, including any expired entries
+// the janitor has not swept yet
+func (tm *TTLMap[K, V]) Size() int {
+	return tm.entries.Size()
+}
+
+// Stop terminates the janitor goroutine. The map remains usable, but expired
+// entries are only dropped lazily on access afterwards.
+func (tm *TTLMap[K, V]) Stop() {
+	tm.stopOnce.Do(func() {
+		close(tm.stopCh)
+	})
+}
+
+// janitor periodically removes expired entries
+func (tm *TTLMap[K, V]) janitor(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			tm.sweep()
+		case <-tm.stopCh:
+			return
+		}
+	}
+}
+
+// sweep removes all entries that have expired
+func (tm *TTLMap[K, V]) sweep() {
+	now := time.Now()
+
+	expired := make([]K, 0)
+	tm.entries.ForEach(func(key K, entry ttlEntry[V]) bool {
+		if now.After(entry.expiresAt) {
+			expired = append(expired, key)
+		}
+		return true
+	})
+
+	for _, key := range expired {
+		tm.entries.Delete(key)
+	}
+}
@@ -26,39 +26,39 @@ type Result[R any] struct {
 
 // WorkerPoolConfig holds configuration for the worker pool
 type WorkerPoolConfig struct {
-	WorkerCount    int           // Number of worker goroutines
-	QueueSize      int           // Size of job queue buffer
-	Timeout        time.Duration // Per-job timeout
-	EnableMetrics  bool          // Whether to collect metrics
-	Logger         *zap.Logger   // Logger instance
+	WorkerCount   int           // Number of worker goroutines
+	QueueSize     int           // Size of job queue buffer
+	Timeout       time.Duration // Per-job timeout
+	EnableMetrics bool          // Whether to collect metrics
+	Logger        *zap.Logger   // Logger instance
 }
 
 // WorkerPool implements a generic worker pool pattern
 type WorkerPool[T any, R any] struct {
-	config      WorkerPoolConfig
-	jobs        chan Job[T, R]
-	results     chan Result[R]
-	workers     []Worker[T, R]
-	wg          sync.WaitGroup
-	ctx         context.Context
-	cancel      context.CancelFunc
-	metrics     *WorkerPoolMetrics
-	once        sync.Once
+	config  WorkerPoolConfig
+	jobs    chan Job[T, R]
+	results chan Result[R]
+	workers []Worker[T, R]
+	wg      sync.WaitGroup
+	ctx     context.Context
+	cancel  context.CancelFunc
+	metrics *WorkerPoolMetrics
+	once    sync.Once
 }
 
 // Worker represents a single worker in the pool
 type Worker[T any, R any] struct {
-	ID      int
-	pool    *WorkerPool[T, R]
-	logger  *zap.Logger
+	ID     int
+	pool   *WorkerPool[T, R]
+	logger *zap.Logger
 }
 
 // WorkerPoolMetrics holds metrics for the worker pool
 type WorkerPoolMetrics struct {
-	mu                sync.RWMutex
-	JobsProcessed     int64
-	JobsSucceeded     int64
-	JobsFailed        int64
+	mu                    sync.RWMutex
+	JobsProcessed         int64
+	JobsSucceeded         int64
+	JobsFailed            int64
 	AverageProcessingTime time.Duration
 	totalProcessingTime   time.Duration
 }
@@ -109,7 +109,7 @@ func (wp *WorkerPool[T, R]) Start() {
 		for i := range wp.workers {
 			go wp.workers[i].run()
 		}
-		wp.config.Logger.Info("Worker pool started", 
+		wp.config.Logger.Info("Worker pool started",
 			zap.Int("worker_count", len(wp.workers)),
 			zap.Int("queue_size", wp.config.QueueSize))
 	})
@@ -163,7 +163,7 @@ func (wp *WorkerPool[T, R]) Stop() {
 	close(wp.jobs)
 	wp.wg.Wait()
 	close(wp.results)
-	
+
 	if wp.config.EnableMetrics {
 		metrics := wp.GetMetrics()
 		wp.config.Logger.Info("Worker pool stopped",
@@ -178,7 +178,7 @@ func (wp *WorkerPool[T, R]) Stop() {
 func (wp *WorkerPool[T, R]) GetMetrics() WorkerPoolMetrics {
 	wp.metrics.mu.RLock()
 	defer wp.metrics.mu.RUnlock()
-	
+
 	metrics := *wp.metrics
 	if metrics.JobsProcessed > 0 {
 		metrics.AverageProcessingTime = metrics.totalProcessingTime / time.Duration(metrics.JobsProcessed)
@@ -189,7 +189,7 @@ func (wp *WorkerPool[T, R]) GetMetrics() WorkerPoolMetrics {
 // run starts the worker's processing loop
 func (w *Worker[T, R]) run() {
 	defer w.pool.wg.Done()
-	
+
 	w.logger.Debug("Worker started")
 	defer w.logger.Debug("Worker stopped")
 
@@ -209,7 +209,7 @@ func (w *Worker[T, R]) run() {
 // processJob processes a single job
 func (w *Worker[T, R]) processJob(job Job[T, R]) {
 	start := time.Now()
-	
+
 	// Create timeout context for this job
 	ctx, cancel := context.WithTimeout(w.pool.ctx, w.pool.config.Timeout)
 	defer cancel()
@@ -218,9 +218,9 @@ func (w *Worker[T, R]) processJob(job Job[T, R]) {
 
 	// Process the job
 	data, err := job.Process(ctx, job.Data)
-	
+
 	processingTime := time.Since(start)
-	
+
 	result := Result[R]{
 		JobID:  job.ID,
 		Data:   data,
@@ -237,12 +237,12 @@ func (w *Worker[T, R]) processJob(job Job[T, R]) {
 	select {
 	case w.pool.results <- result:
 		if err != nil {
-			w.logger.Error("Job failed", 
-				zap.String("job_id", job.ID), 
+			w.logger.Error("Job failed",
+				zap.String("job_id", job.ID),
 				zap.Duration("processing_time", processingTime),
 				zap.Error(err))
 		} else {
-			w.logger.Debug("Job completed successfully", 
+			w.logger.Debug("Job completed successfully",
 				zap.String("job_id", job.ID),
 				zap.Duration("processing_time", processingTime))
 		}
@@ -256,10 +256,10 @@ func (w *Worker[T, R]) processJob(job Job[T, R]) {
 func (wp *WorkerPool[T, R]) updateMetrics(result Result[R]) {
 	wp.metrics.mu.Lock()
 	defer wp.metrics.mu.Unlock()
-	
+
 	wp.metrics.JobsProcessed++
 	wp.metrics.totalProcessingTime += result.Timing
-	
+
 	if result.Error != nil {
 		wp.metrics.JobsFailed++
 	} else {
@@ -289,10 +289,9 @@ func (m *WorkerPoolMetrics) GetJobsFailed() int64 {
 func (m *WorkerPoolMetrics) GetAverageProcessingTime() time.Duration {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
-	
+
 	if m.JobsProcessed == 0 {
 		return 0
 	}
 	return m.totalProcessingTime / time.Duration(m.JobsProcessed)
 }
-
@@ -0,0 +1,119 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// playlistPageSize is the maximum page size the PlaylistItems API accepts
+const playlistPageSize = 50
+
+var (
+	playlistIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{10,}$`)
+	channelIDPattern  = regexp.MustCompile(`^UC[A-Za-z0-9_-]{22}$`)
+)
+
+// ValidatePlaylistID checks if the ID looks like a YouTube playlist ID
+func (s *Service) ValidatePlaylistID(playlistID string) bool {
+	return playlistIDPattern.MatchString(playlistID)
+}
+
+// ListPlaylistVideoIDs returns the video IDs of a playlist in playlist order,
+// paging through the Data API. A positive maxVideos truncates the result;
+// zero means no limit.
+func (s *Service) ListPlaylistVideoIDs(ctx context.Context, playlistID string, maxVideos int) ([]string, error) {
+	if s.service == nil {
+		return nil, fmt.Errorf("YouTube API service not available - API key may be missing")
+	}
+	if !s.ValidatePlaylistID(playlistID) {
+		return nil, fmt.Errorf("invalid YouTube playlist ID: %s", playlistID)
+	}
+
+	var videoIDs []string
+	pageToken := ""
+
+	for {
+		if err := s.waitForRateLimit(ctx); err != nil {
+			return nil, err
+		}
+
+		s.recordQuota("playlistItems.list", costPlaylistItemsList)
+		call := s.service.PlaylistItems.List([]string{"contentDetails"}).
+			PlaylistId(playlistID).
+			MaxResults(playlistPageSize).
+			PageToken(pageToken)
+		response, err := call.Context(ctx).Do()
+		if err != nil {
+			s.logger.Error("Failed to list playlist items",
+				zap.String("playlistID", playlistID),
+				zap.Error(err))
+			return nil, fmt.Errorf("failed to list playlist items: %w", err)
+		}
+
+		for _, item := range response.Items {
+			if item.ContentDetails == nil || item.ContentDetails.VideoId == "" {
+				continue
+			}
+			videoIDs = append(videoIDs, item.ContentDetails.VideoId)
+			if maxVideos > 0 && len(videoIDs) >= maxVideos {
+				return videoIDs, nil
+			}
+		}
+
+		pageToken = response.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+
+	return videoIDs, nil
+}
+
+// ResolveUploadsPlaylistID resolves a channel reference - a "UC..." channel ID
+// or an "@handle" - to the channel's uploads playlist, which lists every
+// public video the channel has published
+func (s *Service) ResolveUploadsPlaylistID(ctx context.Context, channelRef string) (string, error) {
+	if s.service == nil {
+		return "", fmt.Errorf("YouTube API service not available - API key may be missing")
+	}
+
+	if err := s.waitForRateLimit(ctx); err != nil {
+		return "", err
+	}
+
+	call := s.service.Channels.List([]string{"contentDetails"})
+	switch {
+	case channelIDPattern.MatchString(channelRef):
+		call = call.Id(channelRef)
+	case strings.HasPrefix(channelRef, "@"):
+		call = call.ForHandle(channelRef)
+	default:
+		return "", fmt.Errorf("unsupported channel reference: %s", channelRef)
+	}
+
+	s.recordQuota("channels.list", costChannelsList)
+
+	response, err := call.Context(ctx).Do()
+	if err != nil {
+		s.logger.Error("Failed to look up channel",
+			zap.String("channelRef", channelRef),
+			zap.Error(err))
+		return "", fmt.Errorf("failed to look up channel: %w", err)
+	}
+
+	if len(response.Items) == 0 {
+		return "", fmt.Errorf("channel not found: %s", channelRef)
+	}
+
+	channel := response.Items[0]
+	if channel.ContentDetails == nil || channel.ContentDetails.RelatedPlaylists == nil ||
+		channel.ContentDetails.RelatedPlaylists.Uploads == "" {
+		return "", fmt.Errorf("channel has no uploads playlist: %s", channelRef)
+	}
+
+	return channel.ContentDetails.RelatedPlaylists.Uploads, nil
+}
@@ -0,0 +1,133 @@
+package youtube
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Data API quota unit costs per method, as billed by Google. Caption calls
+// are far more expensive than metadata listings.
+const (
+	costVideosList        = 1
+	costChannelsList      = 1
+	costPlaylistItemsList = 1
+	costCaptionsList      = 50
+	costCaptionsDownload  = 200
+)
+
+// QuotaSnapshot is a point-in-time view of the day's Data API quota usage
+type QuotaSnapshot struct {
+	Date      string           `json:"date"`
+	Budget    int64            `json:"budget"`
+	Used      int64            `json:"used"`
+	Remaining int64            `json:"remaining"`
+	ByMethod  map[string]int64 `json:"by_method"`
+}
+
+// QuotaTracker accounts for YouTube Data API quota units consumed by this
+// process. Usage is tallied per UTC day (quota resets at midnight Pacific in
+// reality, but a UTC day keeps the accounting simple and errs on the early
+// side) and per API method, so operators can see which calls are burning the
+// budget.
+type QuotaTracker struct {
+	budget int64
+	// lowFraction is the fraction of the budget below which LowBudget trips
+	lowFraction float64
+	logger      *zap.Logger
+	now         func() time.Time
+
+	mu       sync.Mutex
+	day      string
+	used     int64
+	byMethod map[string]int64
+	// lowLogged dedupes the low-budget warning to once per day
+	lowLogged bool
+}
+
+// NewQuotaTracker creates a tracker for a daily quota-unit budget. lowFraction
+// is the remaining fraction of the budget below which LowBudget reports true;
+// a non-positive fraction disables the low-budget signal.
+func NewQuotaTracker(budget int64, lowFraction float64, logger *zap.Logger) *QuotaTracker {
+	return &QuotaTracker{
+		budget:      budget,
+		lowFraction: lowFraction,
+		logger:      logger,
+		now:         time.Now,
+		byMethod:    make(map[string]int64),
+	}
+}
+
+// Record tallies one API call's quota cost against today's usage
+func (t *QuotaTracker) Record(method string, cost int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rollover()
+	t.used += cost
+	t.byMethod[method] += cost
+
+	if t.lowBudgetLocked() && !t.lowLogged {
+		t.lowLogged = true
+		t.logger.Warn("YouTube Data API quota budget is running low",
+			zap.Int64("used", t.used),
+			zap.Int64("budget", t.budget))
+	}
+}
+
+// Snapshot returns today's usage for the admin API
+func (t *QuotaTracker) Snapshot() QuotaSnapshot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rollover()
+
+	byMethod := make(map[string]int64, len(t.byMethod))
+	for method, units := range t.byMethod {
+		byMethod[method] = units
+	}
+
+	remaining := t.budget - t.used
+	if remaining < 0 {
+		remaining = 0
+	}
+	return QuotaSnapshot{
+		Date:      t.day,
+		Budget:    t.budget,
+		Used:      t.used,
+		Remaining: remaining,
+		ByMethod:  byMethod,
+	}
+}
+
+// LowBudget reports whether the remaining budget has dropped below the
+// configured fraction, signalling that quota-consuming providers should be
+// avoided where a scraper can serve instead
+func (t *QuotaTracker) LowBudget() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rollover()
+	return t.lowBudgetLocked()
+}
+
+func (t *QuotaTracker) lowBudgetLocked() bool {
+	if t.budget <= 0 || t.lowFraction <= 0 {
+		return false
+	}
+	return float64(t.budget-t.used) < float64(t.budget)*t.lowFraction
+}
+
+// rollover resets the tally when the UTC day has changed. Callers must hold
+// the mutex.
+func (t *QuotaTracker) rollover() {
+	day := t.now().UTC().Format("2006-01-02")
+	if day == t.day {
+		return
+	}
+	t.day = day
+	t.used = 0
+	t.byMethod = make(map[string]int64)
+	t.lowLogged = false
+}
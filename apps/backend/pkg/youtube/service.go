@@ -10,23 +10,77 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	"app-backend/internal/types"
+	"app-backend/internal/requestid"
 	oauthService "app-backend/internal/services/oauth"
+	"app-backend/internal/types"
+	"app-backend/pkg/patterns"
 	"go.uber.org/zap"
-	"google.golang.org/api/youtube/v3"
-	"google.golang.org/api/option"
 	"golang.org/x/oauth2"
+	"google.golang.org/api/option"
+	"google.golang.org/api/youtube/v3"
 )
 
 // Service implements video.ServiceInterface for YouTube
 type Service struct {
-	apiKey      string
-	service     *youtube.Service
-	httpClient  *http.Client
-	logger      *zap.Logger
+	apiKey       string
+	service      *youtube.Service
+	httpClient   *http.Client
+	logger       *zap.Logger
 	oauthService oauthService.ServiceInterface
+	rateLimiter  *patterns.RateLimitedExecutor
+	quota        *QuotaTracker
+
+	// Parallel TTML parsing knobs; zero values use package defaults
+	parseWorkers           int
+	parallelParseThreshold int
+
+	// Transcript size estimation; zero segment seconds uses the default
+	estimateSegmentSeconds int
+	estimateMu             sync.RWMutex
+	estimateCache          map[string]*types.TranscriptEstimate
+}
+
+// SetEstimateSegmentSeconds overrides the assumed average caption segment
+// length used for transcript size estimates
+func (s *Service) SetEstimateSegmentSeconds(seconds int) {
+	s.estimateSegmentSeconds = seconds
+}
+
+// SetRateLimiter injects a rate limiter enforced before every YouTube API
+// call. The limiter is shared via the container, so every service drawing on
+// the YouTube quota consumes from the same budget.
+func (s *Service) SetRateLimiter(limiter patterns.RateLimiter) {
+	if limiter == nil {
+		s.rateLimiter = nil
+		return
+	}
+	s.rateLimiter = patterns.NewRateLimitedExecutor("youtube", limiter, s.logger)
+}
+
+// SetQuotaTracker injects the Data API quota accounting; nil disables it
+func (s *Service) SetQuotaTracker(tracker *QuotaTracker) {
+	s.quota = tracker
+}
+
+// recordQuota tags an outgoing Data API call with its quota unit cost
+func (s *Service) recordQuota(method string, cost int64) {
+	if s.quota != nil {
+		s.quota.Record(method, cost)
+	}
+}
+
+// waitForRateLimit blocks until the configured rate allows another API call
+func (s *Service) waitForRateLimit(ctx context.Context) error {
+	if s.rateLimiter == nil {
+		return nil
+	}
+	if err := s.rateLimiter.Execute(ctx, func() error { return nil }); err != nil {
+		return fmt.Errorf("youtube rate limit wait cancelled: %w", err)
+	}
+	return nil
 }
 
 // NewService creates a new YouTube service instance
@@ -34,6 +88,15 @@ func NewService(apiKey string, logger *zap.Logger) *Service {
 	return NewServiceWithOAuth(apiKey, nil, logger)
 }
 
+// newHTTPClient creates an HTTP client that forwards the request ID from the
+// request context to outbound calls
+func newHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: requestid.NewTransport(nil),
+	}
+}
+
 // NewServiceWithOAuth creates a new YouTube service instance with OAuth support
 func NewServiceWithOAuth(apiKey string, oauthSvc oauthService.ServiceInterface, logger *zap.Logger) *Service {
 	ytService, err := youtube.NewService(context.Background(), option.WithAPIKey(apiKey))
@@ -42,7 +105,7 @@ func NewServiceWithOAuth(apiKey string, oauthSvc oauthService.ServiceInterface,
 		return &Service{
 			apiKey:       apiKey,
 			service:      nil, // Will cause graceful degradation
-			httpClient:   &http.Client{Timeout: 30 * time.Second},
+			httpClient:   newHTTPClient(30 * time.Second),
 			logger:       logger,
 			oauthService: oauthSvc,
 		}
@@ -51,7 +114,7 @@ func NewServiceWithOAuth(apiKey string, oauthSvc oauthService.ServiceInterface,
 	return &Service{
 		apiKey:       apiKey,
 		service:      ytService,
-		httpClient:   &http.Client{Timeout: 30 * time.Second},
+		httpClient:   newHTTPClient(30 * time.Second),
 		logger:       logger,
 		oauthService: oauthSvc,
 	}
@@ -75,6 +138,11 @@ func (s *Service) GetVideoInfo(ctx context.Context, videoID string) (*types.Vide
 		return nil, fmt.Errorf("invalid YouTube video ID: %s", videoID)
 	}
 
+	if err := s.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	s.recordQuota("videos.list", costVideosList)
 	call := s.service.Videos.List([]string{"snippet", "contentDetails"}).Id(videoID)
 	response, err := call.Context(ctx).Do()
 	if err != nil {
@@ -90,13 +158,13 @@ func (s *Service) GetVideoInfo(ctx context.Context, videoID string) (*types.Vide
 	duration, _ := parseISO8601Duration(video.ContentDetails.Duration)
 
 	return &types.VideoInfo{
-		ID:          videoID,
-		Provider:    types.ProviderYouTube,
-		Title:       video.Snippet.Title,
-		Description: video.Snippet.Description,
-		Duration:    types.MillisecondDuration(duration),
+		ID:           videoID,
+		Provider:     types.ProviderYouTube,
+		Title:        video.Snippet.Title,
+		Description:  video.Snippet.Description,
+		Duration:     types.MillisecondDuration(duration),
 		ThumbnailURL: video.Snippet.Thumbnails.High.Url,
-		URL:         fmt.Sprintf("https://www.youtube.com/watch?v=%s", videoID),
+		URL:          fmt.Sprintf("https://www.youtube.com/watch?v=%s", videoID),
 	}, nil
 }
 
@@ -106,7 +174,12 @@ func (s *Service) GetTranscript(ctx context.Context, videoID string, language st
 		return nil, fmt.Errorf("invalid YouTube video ID: %s", videoID)
 	}
 
+	if err := s.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
 	// First, get available captions
+	s.recordQuota("captions.list", costCaptionsList)
 	captionsCall := s.service.Captions.List([]string{"snippet"}, videoID)
 	captionsResponse, err := captionsCall.Context(ctx).Do()
 	if err != nil {
@@ -146,18 +219,18 @@ func (s *Service) GetTranscript(ctx context.Context, videoID string, language st
 	// Download the caption via API first
 	segments, err := s.downloadCaption(ctx, selectedCaption.Id)
 	if err != nil {
-		s.logger.Warn("API caption download failed, trying web scraping fallback", 
-			zap.String("videoID", videoID), 
-			zap.String("captionID", selectedCaption.Id), 
+		s.logger.Warn("API caption download failed, trying web scraping fallback",
+			zap.String("videoID", videoID),
+			zap.String("captionID", selectedCaption.Id),
 			zap.Error(err))
-		
+
 		// Try web scraping fallback when API fails (especially for 403 errors)
 		segments, err = s.scrapeTranscript(ctx, videoID, language)
 		if err != nil {
 			s.logger.Error("Both API and scraping methods failed", zap.String("videoID", videoID), zap.Error(err))
 			return nil, fmt.Errorf("failed to retrieve transcript: %w", err)
 		}
-		
+
 		s.logger.Info("Successfully retrieved transcript via web scraping", zap.String("videoID", videoID))
 	}
 
@@ -177,6 +250,11 @@ func (s *Service) GetAvailableLanguages(ctx context.Context, videoID string) ([]
 		return nil, fmt.Errorf("invalid YouTube video ID: %s", videoID)
 	}
 
+	if err := s.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	s.recordQuota("captions.list", costCaptionsList)
 	call := s.service.Captions.List([]string{"snippet"}, videoID)
 	response, err := call.Context(ctx).Do()
 	if err != nil {
@@ -203,6 +281,7 @@ func (s *Service) GetCapabilities(ctx context.Context, videoID string) (*types.V
 	}
 
 	hasAutoGenerated := false
+	s.recordQuota("captions.list", costCaptionsList)
 	call := s.service.Captions.List([]string{"snippet"}, videoID)
 	response, err := call.Context(ctx).Do()
 	if err == nil {
@@ -214,11 +293,49 @@ func (s *Service) GetCapabilities(ctx context.Context, videoID string) (*types.V
 		}
 	}
 
-	return &types.VideoCapabilities{
-		HasTranscript:        len(languages) > 0,
-		AvailableLanguages:   languages,
+	capabilities := &types.VideoCapabilities{
+		HasTranscript:         len(languages) > 0,
+		AvailableLanguages:    languages,
 		SupportsAutoGenerated: hasAutoGenerated,
-	}, nil
+	}
+
+	// Attach a size estimate when a transcript exists; failures only cost
+	// the estimate, not the capabilities
+	if capabilities.HasTranscript {
+		capabilities.TranscriptEstimate = s.transcriptEstimate(ctx, videoID)
+	}
+
+	return capabilities, nil
+}
+
+// transcriptEstimate derives a transcript size estimate from the video
+// duration, cached per video so repeated capability checks stay cheap
+func (s *Service) transcriptEstimate(ctx context.Context, videoID string) *types.TranscriptEstimate {
+	s.estimateMu.RLock()
+	estimate, ok := s.estimateCache[videoID]
+	s.estimateMu.RUnlock()
+	if ok {
+		return estimate
+	}
+
+	info, err := s.GetVideoInfo(ctx, videoID)
+	if err != nil {
+		s.logger.Debug("Failed to estimate transcript size",
+			zap.String("videoID", videoID),
+			zap.Error(err))
+		return nil
+	}
+
+	estimate = types.EstimateTranscript(time.Duration(info.Duration), s.estimateSegmentSeconds)
+	if estimate != nil {
+		s.estimateMu.Lock()
+		if s.estimateCache == nil {
+			s.estimateCache = make(map[string]*types.TranscriptEstimate)
+		}
+		s.estimateCache[videoID] = estimate
+		s.estimateMu.Unlock()
+	}
+	return estimate
 }
 
 // downloadCaption downloads and parses the caption content using OAuth2
@@ -242,7 +359,7 @@ func (s *Service) downloadCaption(ctx context.Context, captionID string) ([]type
 func (s *Service) downloadCaptionWithAuth(ctx context.Context, captionID string, token *oauth2.Token) ([]types.TranscriptSegment, error) {
 	// Create authenticated HTTP client
 	client := oauth2.NewClient(ctx, oauth2.StaticTokenSource(token))
-	
+
 	// Use authenticated client to create YouTube service
 	authService, err := youtube.NewService(ctx, option.WithHTTPClient(client))
 	if err != nil {
@@ -251,6 +368,7 @@ func (s *Service) downloadCaptionWithAuth(ctx context.Context, captionID string,
 	}
 
 	// Download caption content
+	s.recordQuota("captions.download", costCaptionsDownload)
 	call := authService.Captions.Download(captionID)
 	resp, err := call.Context(ctx).Download()
 	if err != nil {
@@ -266,16 +384,18 @@ func (s *Service) downloadCaptionWithAuth(ctx context.Context, captionID string,
 		return nil, fmt.Errorf("failed to read caption response: %w", err)
 	}
 
-	s.logger.Info("Successfully downloaded caption content", 
-		zap.String("captionID", captionID), 
+	s.logger.Info("Successfully downloaded caption content",
+		zap.String("captionID", captionID),
 		zap.Int("bodySize", len(body)))
 
 	// Parse the caption content (YouTube returns TTML format)
-	return s.parseTTML(body)
+	return s.ParseTTML(body)
 }
 
-// parseTTML parses TTML caption format from YouTube
-func (s *Service) parseTTML(data []byte) ([]types.TranscriptSegment, error) {
+// ParseTTML parses TTML caption format from YouTube. Documents with more
+// paragraphs than the parallel parse threshold are converted in parallel
+// chunks; see SetParseWorkers and SetParallelParseThreshold.
+func (s *Service) ParseTTML(data []byte) ([]types.TranscriptSegment, error) {
 	// First try to parse as XML (TTML format)
 	var ttml TTMLDocument
 	if err := xml.Unmarshal(data, &ttml); err != nil {
@@ -284,10 +404,31 @@ func (s *Service) parseTTML(data []byte) ([]types.TranscriptSegment, error) {
 		return s.parsePlainTextCaption(string(data)), nil
 	}
 
+	paragraphs := ttml.Body.Div.P
+	var segments []types.TranscriptSegment
+	if len(paragraphs) >= s.parseThreshold() {
+		segments = s.convertTTMLParagraphsParallel(paragraphs)
+	} else {
+		segments = s.convertTTMLParagraphs(paragraphs)
+	}
+
+	// If no segments found, the TTML structure might be different
+	if len(segments) == 0 {
+		s.logger.Warn("No segments found in TTML, trying alternative parsing")
+		return s.parsePlainTextCaption(string(data)), nil
+	}
+
+	s.logger.Info("Successfully parsed TTML captions", zap.Int("segments", len(segments)))
+	return segments, nil
+}
+
+// convertTTMLParagraphs converts TTML paragraphs to transcript segments,
+// skipping entries with unparseable timing or empty text
+func (s *Service) convertTTMLParagraphs(paragraphs []TTMLParagraph) []types.TranscriptSegment {
 	var segments []types.TranscriptSegment
 	segmentIndex := 0
-	
-	for _, p := range ttml.Body.Div.P {
+
+	for _, p := range paragraphs {
 		startTime, err := s.parseTimeCode(p.Begin)
 		if err != nil {
 			s.logger.Warn("Failed to parse start time", zap.String("time", p.Begin), zap.Error(err))
@@ -312,15 +453,8 @@ func (s *Service) parseTTML(data []byte) ([]types.TranscriptSegment, error) {
 			segmentIndex++
 		}
 	}
-	
-	// If no segments found, the TTML structure might be different
-	if len(segments) == 0 {
-		s.logger.Warn("No segments found in TTML, trying alternative parsing")
-		return s.parsePlainTextCaption(string(data)), nil
-	}
 
-	s.logger.Info("Successfully parsed TTML captions", zap.Int("segments", len(segments)))
-	return segments, nil
+	return segments
 }
 
 // parsePlainTextCaption parses plain text captions as fallback
@@ -335,7 +469,7 @@ func (s *Service) parsePlainTextCaption(text string) []types.TranscriptSegment {
 			// Create segments with estimated timing
 			startMs := int64(i * 3000) // 3 seconds per segment
 			endMs := startMs + 3000
-			
+
 			segments = append(segments, types.TranscriptSegment{
 				Text:      line,
 				StartTime: types.MillisecondDuration(startMs),
@@ -390,7 +524,7 @@ func (s *Service) parseTimeCode(timeStr string) (time.Duration, error) {
 			return 0, err
 		}
 
-		totalSeconds := float64(hours*3600 + minutes*60) + seconds
+		totalSeconds := float64(hours*3600+minutes*60) + seconds
 		return time.Duration(totalSeconds * float64(time.Second)), nil
 	} else if strings.HasSuffix(timeStr, "s") {
 		// Format: "1.5s"
@@ -410,11 +544,11 @@ func (s *Service) cleanCaptionText(text string) string {
 	// Remove XML tags
 	re := regexp.MustCompile(`<[^>]*>`)
 	text = re.ReplaceAllString(text, "")
-	
+
 	// Clean up whitespace
 	text = strings.TrimSpace(text)
 	text = regexp.MustCompile(`\s+`).ReplaceAllString(text, " ")
-	
+
 	return text
 }
 
@@ -423,7 +557,7 @@ func parseISO8601Duration(duration string) (time.Duration, error) {
 	// YouTube uses ISO 8601 duration format: PT4M13S
 	re := regexp.MustCompile(`PT(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?`)
 	matches := re.FindStringSubmatch(duration)
-	
+
 	if len(matches) == 0 {
 		return 0, fmt.Errorf("invalid duration format: %s", duration)
 	}
@@ -457,19 +591,17 @@ func parseISO8601Duration(duration string) (time.Duration, error) {
 // This is a fallback when the official API fails due to permissions
 func (s *Service) scrapeTranscript(ctx context.Context, videoID, language string) ([]types.TranscriptSegment, error) {
 	s.logger.Info("Starting transcript scraping", zap.String("videoID", videoID), zap.String("language", language))
-	
+
 	// Create HTTP client with timeout
-	client := &http.Client{
-		Timeout: 30 * time.Second,
-	}
-	
+	client := newHTTPClient(30 * time.Second)
+
 	// First, get the video page to extract transcript data
 	videoURL := fmt.Sprintf("https://www.youtube.com/watch?v=%s", videoID)
 	req, err := http.NewRequestWithContext(ctx, "GET", videoURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
-	
+
 	// Set headers to mimic a browser request (improved for better success)
 	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36")
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.7")
@@ -478,54 +610,54 @@ func (s *Service) scrapeTranscript(ctx context.Context, videoID, language string
 	req.Header.Set("DNT", "1")
 	req.Header.Set("Connection", "keep-alive")
 	req.Header.Set("Upgrade-Insecure-Requests", "1")
-	
+
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch video page: %w", err)
 	}
 	defer resp.Body.Close()
-	
+
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("failed to fetch video page, status: %d", resp.StatusCode)
 	}
-	
+
 	// Read the response body
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
-	
+
 	// Extract transcript data from the page
 	segments, err := s.extractTranscriptFromHTML(string(body), language)
 	if err != nil {
 		return nil, fmt.Errorf("failed to extract transcript from HTML: %w", err)
 	}
-	
-	s.logger.Info("Successfully scraped transcript", 
-		zap.String("videoID", videoID), 
+
+	s.logger.Info("Successfully scraped transcript",
+		zap.String("videoID", videoID),
 		zap.Int("segments", len(segments)))
-	
+
 	return segments, nil
 }
 
 // extractTranscriptFromHTML extracts transcript data from YouTube's HTML page with improved patterns
 func (s *Service) extractTranscriptFromHTML(html, language string) ([]types.TranscriptSegment, error) {
 	s.logger.Debug("Attempting to extract transcript from HTML", zap.Int("htmlLength", len(html)))
-	
+
 	// Check if we have any caption-related content
 	if strings.Contains(html, "captionTracks") {
 		s.logger.Debug("Found captionTracks in HTML")
 	} else {
 		s.logger.Warn("No captionTracks found in HTML - video may not have transcripts")
 	}
-	
+
 	var transcriptURL string
-	
+
 	// Comprehensive patterns to find transcript URLs - inspired by youtube-transcript-api
 	patterns := []string{
 		// Look for baseUrl in any context containing timedtext
 		`"baseUrl"\s*:\s*"([^"]*timedtext[^"]*)"`,
-		// Look for timedtext URLs directly 
+		// Look for timedtext URLs directly
 		`https://www\.youtube\.com/api/timedtext[^"'\s\)\]>]+`,
 		// Look in caption tracks context
 		`"captionTracks"[^}]*?"baseUrl"\s*:\s*"([^"]+)"`,
@@ -537,20 +669,20 @@ func (s *Service) extractTranscriptFromHTML(html, language string) ([]types.Tran
 		// Search in ytInitialPlayerResponse context
 		`ytInitialPlayerResponse[^}]*?"baseUrl"\s*:\s*"([^"]*timedtext[^"]*)"`,
 	}
-	
+
 	for i, pattern := range patterns {
 		s.logger.Debug("Trying pattern", zap.Int("patternIndex", i))
-		
+
 		re := regexp.MustCompile(pattern)
 		matches := re.FindStringSubmatch(html)
-		
+
 		if len(matches) >= 1 {
 			// Take the URL from the appropriate capture group
 			url := matches[0]
 			if len(matches) >= 2 && matches[1] != "" && strings.Contains(matches[1], "timedtext") {
 				url = matches[1]
 			}
-			
+
 			if strings.Contains(url, "timedtext") && strings.Contains(url, "youtube") {
 				s.logger.Debug("Pattern matched", zap.Int("patternIndex", i), zap.String("url", url[:min(len(url), 100)]))
 				transcriptURL = url
@@ -558,17 +690,17 @@ func (s *Service) extractTranscriptFromHTML(html, language string) ([]types.Tran
 			}
 		}
 	}
-	
+
 	if transcriptURL == "" {
 		s.logger.Error("No transcript URL found after all extraction methods")
 		return nil, fmt.Errorf("no transcript URL found in page HTML - this may indicate the video has no available transcripts")
 	}
-	
+
 	// Clean up and decode the URL
 	transcriptURL = s.cleanTranscriptURL(transcriptURL)
-	
+
 	s.logger.Info("Attempting to fetch transcript", zap.String("url", transcriptURL[:min(len(transcriptURL), 100)]))
-	
+
 	// Fetch the actual transcript data
 	return s.fetchTranscriptFromURL(transcriptURL)
 }
@@ -590,31 +722,31 @@ func (s *Service) cleanTranscriptURL(url string) string {
 	url = strings.ReplaceAll(url, "\\u003e", ">")
 	url = strings.ReplaceAll(url, "\\/", "/")
 	url = strings.ReplaceAll(url, "\\", "")
-	
+
 	return url
 }
 
 // fetchTranscriptFromURL fetches and parses transcript data from the YouTube transcript URL
 func (s *Service) fetchTranscriptFromURL(url string) ([]types.TranscriptSegment, error) {
-	client := &http.Client{Timeout: 15 * time.Second}
-	
+	client := newHTTPClient(15 * time.Second)
+
 	resp, err := client.Get(url)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch transcript: %w", err)
 	}
 	defer resp.Body.Close()
-	
+
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("failed to fetch transcript, status: %d", resp.StatusCode)
 	}
-	
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read transcript response: %w", err)
 	}
-	
+
 	s.logger.Debug("Raw transcript response", zap.Int("bodyLength", len(body)), zap.String("contentType", resp.Header.Get("Content-Type")))
-	
+
 	// Parse the XML transcript data
 	return s.parseTranscriptXML(string(body))
 }
@@ -622,7 +754,7 @@ func (s *Service) fetchTranscriptFromURL(url string) ([]types.TranscriptSegment,
 // parseTranscriptXML parses XML transcript data from YouTube
 func (s *Service) parseTranscriptXML(xmlData string) ([]types.TranscriptSegment, error) {
 	s.logger.Debug("Parsing transcript XML", zap.Int("xmlLength", len(xmlData)))
-	
+
 	// Enhanced patterns for parsing transcript XML based on youtube-transcript-api
 	patterns := []string{
 		// Standard format: <text start="0.0" dur="1.5">Hello world</text>
@@ -638,46 +770,46 @@ func (s *Service) parseTranscriptXML(xmlData string) ([]types.TranscriptSegment,
 		// WebVTT-style patterns
 		`<c t="([^"]+)" d="([^"]+)"[^>]*>([^<]*)</c>`,
 	}
-	
+
 	var segments []types.TranscriptSegment
-	
+
 	for i, pattern := range patterns {
 		s.logger.Debug("Trying XML pattern", zap.Int("patternIndex", i))
-		
+
 		re := regexp.MustCompile(pattern)
 		matches := re.FindAllStringSubmatch(xmlData, -1)
-		
+
 		if len(matches) > 0 {
 			s.logger.Debug("XML pattern matched", zap.Int("patternIndex", i), zap.Int("matches", len(matches)))
-			
+
 			for _, match := range matches {
 				if len(match) < 4 {
 					continue
 				}
-				
+
 				startTime, err := strconv.ParseFloat(match[1], 64)
 				if err != nil {
 					s.logger.Warn("Failed to parse start time", zap.String("time", match[1]))
 					continue
 				}
-				
+
 				duration, err := strconv.ParseFloat(match[2], 64)
 				if err != nil {
 					s.logger.Warn("Failed to parse duration", zap.String("duration", match[2]))
 					continue
 				}
-				
+
 				text := html.UnescapeString(match[3])
 				text = s.cleanCaptionText(text)
-				
+
 				if text == "" {
 					continue
 				}
-				
+
 				// Convert to MillisecondDuration
 				startTimeMs := types.MillisecondDuration(time.Duration(startTime * float64(time.Second)))
 				endTimeMs := types.MillisecondDuration(time.Duration((startTime + duration) * float64(time.Second)))
-				
+
 				segments = append(segments, types.TranscriptSegment{
 					Text:      text,
 					StartTime: startTimeMs,
@@ -685,18 +817,18 @@ func (s *Service) parseTranscriptXML(xmlData string) ([]types.TranscriptSegment,
 					Index:     len(segments),
 				})
 			}
-			
+
 			if len(segments) > 0 {
 				break // Successfully parsed with this pattern
 			}
 		}
 	}
-	
+
 	if len(segments) == 0 {
 		// Try parsing as plain text with timestamps if XML parsing fails
 		return s.parseAsPlainText(xmlData)
 	}
-	
+
 	s.logger.Info("Successfully parsed transcript XML", zap.Int("segments", len(segments)))
 	return segments, nil
 }
@@ -704,42 +836,42 @@ func (s *Service) parseTranscriptXML(xmlData string) ([]types.TranscriptSegment,
 // parseAsPlainText tries to parse transcript as plain text if XML parsing fails
 func (s *Service) parseAsPlainText(data string) ([]types.TranscriptSegment, error) {
 	s.logger.Debug("Attempting to parse as plain text")
-	
+
 	// If the data looks like it contains timing info, try to extract it
 	lines := strings.Split(data, "\n")
 	var segments []types.TranscriptSegment
-	
+
 	for i, line := range lines {
 		line = strings.TrimSpace(line)
 		if line == "" {
 			continue
 		}
-		
+
 		// Simple fallback: create segments with estimated timing
 		// This is a basic approach when we can't parse proper timing
 		startTime := float64(i * 3) // Assume 3 seconds per segment
 		duration := 3.0
-		
+
 		startTimeMs := types.MillisecondDuration(time.Duration(startTime * float64(time.Second)))
 		endTimeMs := types.MillisecondDuration(time.Duration((startTime + duration) * float64(time.Second)))
-		
+
 		segments = append(segments, types.TranscriptSegment{
 			Text:      line,
 			StartTime: startTimeMs,
 			EndTime:   endTimeMs,
 			Index:     len(segments),
 		})
-		
+
 		// Limit to prevent too many segments from malformed data
 		if len(segments) >= 100 {
 			break
 		}
 	}
-	
+
 	if len(segments) == 0 {
 		return nil, fmt.Errorf("no valid transcript segments extracted from any format")
 	}
-	
+
 	s.logger.Info("Parsed transcript as plain text", zap.Int("segments", len(segments)))
 	return segments, nil
 }
@@ -754,4 +886,4 @@ func getTrackKind(trackKind string) string {
 	default:
 		return "manual"
 	}
-}
\ No newline at end of file
+}
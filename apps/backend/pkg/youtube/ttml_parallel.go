@@ -0,0 +1,132 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/types"
+	"app-backend/pkg/patterns"
+)
+
+// DefaultParallelParseThreshold is the paragraph count above which TTML
+// documents are converted in parallel chunks; smaller documents stay serial
+const DefaultParallelParseThreshold = 1000
+
+// SetParseWorkers configures how many workers convert TTML paragraph chunks
+// in parallel; zero or negative values fall back to the number of CPUs
+func (s *Service) SetParseWorkers(workers int) {
+	s.parseWorkers = workers
+}
+
+// SetParallelParseThreshold overrides the paragraph count above which TTML
+// documents are parsed in parallel
+func (s *Service) SetParallelParseThreshold(threshold int) {
+	s.parallelParseThreshold = threshold
+}
+
+// parseThreshold returns the effective parallel parsing threshold
+func (s *Service) parseThreshold() int {
+	if s.parallelParseThreshold > 0 {
+		return s.parallelParseThreshold
+	}
+	return DefaultParallelParseThreshold
+}
+
+// parseWorkerCount returns the effective parallel parse worker count
+func (s *Service) parseWorkerCount() int {
+	if s.parseWorkers > 0 {
+		return s.parseWorkers
+	}
+	return runtime.NumCPU()
+}
+
+// ttmlChunk is a contiguous run of TTML paragraphs converted as one job
+type ttmlChunk struct {
+	index      int
+	paragraphs []TTMLParagraph
+}
+
+// ttmlChunkResult carries the converted segments of one chunk along with its
+// position so merged output preserves document order
+type ttmlChunkResult struct {
+	index    int
+	segments []types.TranscriptSegment
+}
+
+// convertTTMLParagraphsParallel converts paragraphs in chunks via a worker
+// pool, merging the chunk results in document order and re-indexing segments
+func (s *Service) convertTTMLParagraphsParallel(paragraphs []TTMLParagraph) []types.TranscriptSegment {
+	workers := s.parseWorkerCount()
+	chunks := chunkTTMLParagraphs(paragraphs, workers)
+	if len(chunks) <= 1 {
+		return s.convertTTMLParagraphs(paragraphs)
+	}
+
+	pool := patterns.NewWorkerPool[ttmlChunk, ttmlChunkResult](patterns.WorkerPoolConfig{
+		WorkerCount: workers,
+		QueueSize:   len(chunks),
+		Logger:      s.logger,
+	})
+	pool.Start()
+	defer pool.Stop()
+
+	for _, chunk := range chunks {
+		job := patterns.Job[ttmlChunk, ttmlChunkResult]{
+			ID:   fmt.Sprintf("ttml-chunk-%d", chunk.index),
+			Data: chunk,
+			Process: func(_ context.Context, c ttmlChunk) (ttmlChunkResult, error) {
+				return ttmlChunkResult{
+					index:    c.index,
+					segments: s.convertTTMLParagraphs(c.paragraphs),
+				}, nil
+			},
+		}
+		if err := pool.Submit(job); err != nil {
+			// Should not happen with a queue sized to the chunk count; fall
+			// back to serial parsing rather than dropping paragraphs
+			s.logger.Warn("Failed to submit TTML chunk, falling back to serial parsing",
+				zap.Error(err))
+			return s.convertTTMLParagraphs(paragraphs)
+		}
+	}
+
+	chunkSegments := make([][]types.TranscriptSegment, len(chunks))
+	for received := 0; received < len(chunks); received++ {
+		result := <-pool.Results()
+		chunkSegments[result.Data.index] = result.Data.segments
+	}
+
+	// Merge preserving document order and re-index across chunk boundaries
+	var segments []types.TranscriptSegment
+	for _, part := range chunkSegments {
+		segments = append(segments, part...)
+	}
+	for i := range segments {
+		segments[i].Index = i
+	}
+	return segments
+}
+
+// chunkTTMLParagraphs splits paragraphs into up to count contiguous chunks
+func chunkTTMLParagraphs(paragraphs []TTMLParagraph, count int) []ttmlChunk {
+	if count <= 0 {
+		count = 1
+	}
+
+	chunkSize := (len(paragraphs) + count - 1) / count
+	var chunks []ttmlChunk
+	for start := 0; start < len(paragraphs); start += chunkSize {
+		end := start + chunkSize
+		if end > len(paragraphs) {
+			end = len(paragraphs)
+		}
+		chunks = append(chunks, ttmlChunk{
+			index:      len(chunks),
+			paragraphs: paragraphs[start:end],
+		})
+	}
+	return chunks
+}
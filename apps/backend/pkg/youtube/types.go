@@ -19,7 +19,7 @@ type TTMLBody struct {
 
 // TTMLDiv represents a div element in TTML
 type TTMLDiv struct {
-	XMLName xml.Name     `xml:"div"`
+	XMLName xml.Name        `xml:"div"`
 	P       []TTMLParagraph `xml:"p"`
 }
 
@@ -37,10 +37,10 @@ func ExtractVideoID(url string) string {
 	// https://www.youtube.com/watch?v=VIDEO_ID
 	// https://youtu.be/VIDEO_ID
 	// https://www.youtube.com/embed/VIDEO_ID
-	
+
 	// TODO: Implement proper regex extraction
 	// For now, return as-is (simplified implementation)
-	
+
 	return url
 }
 
@@ -57,4 +57,4 @@ type TimestampedText struct {
 	Start time.Duration `json:"start"`
 	End   time.Duration `json:"end"`
 	Text  string        `json:"text"`
-}
\ No newline at end of file
+}
@@ -0,0 +1,236 @@
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/admin"
+	"app-backend/internal/types"
+	"app-backend/test/testutil"
+)
+
+// adminFixture bundles the service with the repositories the tests assert
+// against directly
+type adminFixture struct {
+	service     admin.ServiceInterface
+	db          *gorm.DB
+	sessionRepo repositories.SessionRepositoryInterface
+}
+
+func newAdminFixture(t *testing.T) *adminFixture {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t, &models.User{}, &models.Session{}, &models.AdminAuditLog{})
+	userRepo := repositories.NewUserRepository(db)
+	sessionRepo := repositories.NewSessionRepository(db)
+	auditRepo := repositories.NewAdminAuditLogRepository(db)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	return &adminFixture{
+		service:     admin.NewService(userRepo, sessionRepo, auditRepo, log),
+		db:          db,
+		sessionRepo: sessionRepo,
+	}
+}
+
+func (f *adminFixture) createUser(t *testing.T, email, role string) *models.User {
+	t.Helper()
+
+	user := &models.User{
+		FirstName: "Test",
+		LastName:  "User",
+		Email:     email,
+		Password:  "hashed",
+		IsActive:  true,
+		Role:      role,
+	}
+	if err := f.db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	return user
+}
+
+func (f *adminFixture) createSession(t *testing.T, userID uint, tokenHash string) {
+	t.Helper()
+
+	session := &models.Session{
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(time.Hour),
+		IsActive:  true,
+	}
+	if err := f.db.Create(session).Error; err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+}
+
+func (f *adminFixture) auditEntries(t *testing.T, targetUserID uint) []models.AdminAuditLog {
+	t.Helper()
+
+	var entries []models.AdminAuditLog
+	if err := f.db.Where("target_user_id = ?", targetUserID).Order("id ASC").Find(&entries).Error; err != nil {
+		t.Fatalf("Failed to load audit entries: %v", err)
+	}
+	return entries
+}
+
+func TestAdminUserManagement(t *testing.T) {
+	t.Run("updates a user's role and records an audit entry", func(t *testing.T) {
+		f := newAdminFixture(t)
+		actor := f.createUser(t, "admin@example.com", "admin")
+		target := f.createUser(t, "user@example.com", "user")
+
+		updated, err := f.service.UpdateUserRole(actor.ID, target.ID, "moderator")
+		if err != nil {
+			t.Fatalf("UpdateUserRole failed: %v", err)
+		}
+		if updated.Role != "moderator" {
+			t.Errorf("Expected role moderator, got %q", updated.Role)
+		}
+
+		entries := f.auditEntries(t, target.ID)
+		if len(entries) != 1 {
+			t.Fatalf("Expected 1 audit entry, got %d", len(entries))
+		}
+		if entries[0].Action != "role_update" || entries[0].ActorID != actor.ID {
+			t.Errorf("Unexpected audit entry: %+v", entries[0])
+		}
+	})
+
+	t.Run("admins cannot change their own role", func(t *testing.T) {
+		f := newAdminFixture(t)
+		actor := f.createUser(t, "admin@example.com", "admin")
+
+		if _, err := f.service.UpdateUserRole(actor.ID, actor.ID, "user"); !isStatus(err, http.StatusBadRequest) {
+			t.Errorf("Expected 400 for a self role change, got %v", err)
+		}
+		if entries := f.auditEntries(t, actor.ID); len(entries) != 0 {
+			t.Errorf("Expected no audit entries for a rejected action, got %d", len(entries))
+		}
+	})
+
+	t.Run("disabling an account revokes its sessions", func(t *testing.T) {
+		f := newAdminFixture(t)
+		actor := f.createUser(t, "admin@example.com", "admin")
+		target := f.createUser(t, "user@example.com", "user")
+		f.createSession(t, target.ID, "hash-1")
+		f.createSession(t, target.ID, "hash-2")
+
+		updated, err := f.service.SetUserActive(actor.ID, target.ID, false)
+		if err != nil {
+			t.Fatalf("SetUserActive failed: %v", err)
+		}
+		if updated.IsActive {
+			t.Error("Expected the account to be disabled")
+		}
+
+		sessions, err := f.sessionRepo.GetActiveSessionsByUserID(target.ID)
+		if err != nil {
+			t.Fatalf("GetActiveSessionsByUserID failed: %v", err)
+		}
+		if len(sessions) != 0 {
+			t.Errorf("Expected no active sessions after disabling, got %d", len(sessions))
+		}
+
+		entries := f.auditEntries(t, target.ID)
+		if len(entries) != 1 || entries[0].Action != "account_disable" {
+			t.Errorf("Expected an account_disable audit entry, got %+v", entries)
+		}
+	})
+
+	t.Run("admins cannot disable their own account", func(t *testing.T) {
+		f := newAdminFixture(t)
+		actor := f.createUser(t, "admin@example.com", "admin")
+
+		if _, err := f.service.SetUserActive(actor.ID, actor.ID, false); !isStatus(err, http.StatusBadRequest) {
+			t.Errorf("Expected 400 for a self disable, got %v", err)
+		}
+	})
+
+	t.Run("revokes all active sessions and reports the count", func(t *testing.T) {
+		f := newAdminFixture(t)
+		actor := f.createUser(t, "admin@example.com", "admin")
+		target := f.createUser(t, "user@example.com", "user")
+		f.createSession(t, target.ID, "hash-1")
+		f.createSession(t, target.ID, "hash-2")
+
+		revoked, err := f.service.RevokeUserSessions(actor.ID, target.ID)
+		if err != nil {
+			t.Fatalf("RevokeUserSessions failed: %v", err)
+		}
+		if revoked != 2 {
+			t.Errorf("Expected 2 revoked sessions, got %d", revoked)
+		}
+
+		sessions, err := f.sessionRepo.GetActiveSessionsByUserID(target.ID)
+		if err != nil {
+			t.Fatalf("GetActiveSessionsByUserID failed: %v", err)
+		}
+		if len(sessions) != 0 {
+			t.Errorf("Expected no active sessions after revocation, got %d", len(sessions))
+		}
+
+		entries := f.auditEntries(t, target.ID)
+		if len(entries) != 1 || entries[0].Action != "session_revoke" {
+			t.Errorf("Expected a session_revoke audit entry, got %+v", entries)
+		}
+	})
+
+	t.Run("returns the audit trail for an account", func(t *testing.T) {
+		f := newAdminFixture(t)
+		actor := f.createUser(t, "admin@example.com", "admin")
+		target := f.createUser(t, "user@example.com", "user")
+
+		if _, err := f.service.UpdateUserRole(actor.ID, target.ID, "moderator"); err != nil {
+			t.Fatalf("UpdateUserRole failed: %v", err)
+		}
+		if _, err := f.service.SetUserActive(actor.ID, target.ID, false); err != nil {
+			t.Fatalf("SetUserActive failed: %v", err)
+		}
+
+		entries, err := f.service.GetUserAuditLog(target.ID, &types.PaginationRequest{Page: 1, PageSize: 20})
+		if err != nil {
+			t.Fatalf("GetUserAuditLog failed: %v", err)
+		}
+		if len(entries.Data) != 2 {
+			t.Fatalf("Expected 2 audit entries, got %d", len(entries.Data))
+		}
+		for _, entry := range entries.Data {
+			if entry.ActorID != actor.ID || entry.TargetUserID != target.ID {
+				t.Errorf("Unexpected audit entry: %+v", entry)
+			}
+		}
+	})
+
+	t.Run("actions on an unknown user return 404", func(t *testing.T) {
+		f := newAdminFixture(t)
+		actor := f.createUser(t, "admin@example.com", "admin")
+
+		if _, err := f.service.UpdateUserRole(actor.ID, 999, "admin"); !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 for an unknown user, got %v", err)
+		}
+		if _, err := f.service.RevokeUserSessions(actor.ID, 999); !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 for an unknown user, got %v", err)
+		}
+		if _, err := f.service.GetUserAuditLog(999, &types.PaginationRequest{Page: 1, PageSize: 20}); !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 for an unknown user, got %v", err)
+		}
+	})
+}
+
+// isStatus reports whether err is an AppError with the given HTTP status
+func isStatus(err error, status int) bool {
+	appErr, ok := err.(*errors.AppError)
+	return ok && appErr.Status == status
+}
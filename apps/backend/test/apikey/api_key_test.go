@@ -0,0 +1,419 @@
+package apikey_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/middleware"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/apikey"
+	"app-backend/internal/types"
+	"app-backend/test/testutil"
+)
+
+func newTestLogger(t *testing.T) *logger.Logger {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return log
+}
+
+func newAPIKeyTestService(t *testing.T) (apikey.ServiceInterface, *gorm.DB, *models.User) {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t, &models.User{}, &models.APIKey{})
+
+	user := &models.User{
+		FirstName: "Key",
+		LastName:  "Owner",
+		Email:     "keys@example.com",
+		Password:  "hashed",
+		IsActive:  true,
+		Role:      "user",
+	}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	service := apikey.NewService(repositories.NewAPIKeyRepository(db), newTestLogger(t))
+	return service, db, user
+}
+
+func appErrorStatus(t *testing.T, err error) int {
+	t.Helper()
+
+	appErr, ok := err.(*errors.AppError)
+	if !ok {
+		t.Fatalf("Expected AppError, got: %v", err)
+	}
+	return appErr.Status
+}
+
+func TestGenerateKey(t *testing.T) {
+	service, db, user := newAPIKeyTestService(t)
+
+	response, err := service.GenerateKey(user.ID, &dto.CreateAPIKeyRequest{
+		Name:          "ci script",
+		Scopes:        []string{"translate"},
+		ExpiresInDays: 30,
+	})
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+
+	if !strings.HasPrefix(response.Key, "ela_") {
+		t.Errorf("Expected key with ela_ prefix, got %q", response.Key)
+	}
+	if !strings.HasPrefix(response.Key, response.APIKey.Prefix) {
+		t.Errorf("Expected stored prefix %q to match the key", response.APIKey.Prefix)
+	}
+	if response.APIKey.ExpiresAt == nil {
+		t.Error("Expected an expiry to be set")
+	}
+
+	// Only a hash of the key may be stored
+	var stored models.APIKey
+	if err := db.First(&stored, response.APIKey.ID).Error; err != nil {
+		t.Fatalf("Failed to load stored key: %v", err)
+	}
+	if stored.KeyHash == response.Key || strings.Contains(stored.KeyHash, response.Key) {
+		t.Error("Raw key must not be stored")
+	}
+	if stored.Scopes != "translate" {
+		t.Errorf("Unexpected stored scopes: %q", stored.Scopes)
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	t.Run("valid key authenticates and loads the owner", func(t *testing.T) {
+		service, _, user := newAPIKeyTestService(t)
+
+		response, err := service.GenerateKey(user.ID, &dto.CreateAPIKeyRequest{Name: "script"})
+		if err != nil {
+			t.Fatalf("GenerateKey failed: %v", err)
+		}
+
+		key, err := service.Authenticate(response.Key, "translate")
+		if err != nil {
+			t.Fatalf("Authenticate failed: %v", err)
+		}
+		if key.UserID != user.ID || key.User.Email != user.Email {
+			t.Errorf("Expected the owning user to be loaded, got %+v", key)
+		}
+	})
+
+	t.Run("unknown key is rejected with 401", func(t *testing.T) {
+		service, _, _ := newAPIKeyTestService(t)
+
+		_, err := service.Authenticate("ela_doesnotexist", "translate")
+		if err == nil {
+			t.Fatal("Expected an error for an unknown key")
+		}
+		if status := appErrorStatus(t, err); status != http.StatusUnauthorized {
+			t.Errorf("Expected status 401, got %d", status)
+		}
+	})
+
+	t.Run("expired key is rejected with 401", func(t *testing.T) {
+		service, db, user := newAPIKeyTestService(t)
+
+		response, err := service.GenerateKey(user.ID, &dto.CreateAPIKeyRequest{Name: "script", ExpiresInDays: 1})
+		if err != nil {
+			t.Fatalf("GenerateKey failed: %v", err)
+		}
+		if err := db.Model(&models.APIKey{}).Where("id = ?", response.APIKey.ID).
+			Update("expires_at", "2020-01-01 00:00:00").Error; err != nil {
+			t.Fatalf("Failed to backdate expiry: %v", err)
+		}
+
+		_, err = service.Authenticate(response.Key, "translate")
+		if err == nil {
+			t.Fatal("Expected an error for an expired key")
+		}
+		if status := appErrorStatus(t, err); status != http.StatusUnauthorized {
+			t.Errorf("Expected status 401, got %d", status)
+		}
+	})
+}
+
+func TestScopeEnforcement(t *testing.T) {
+	service, _, user := newAPIKeyTestService(t)
+
+	t.Run("scoped key only grants its scopes", func(t *testing.T) {
+		response, err := service.GenerateKey(user.ID, &dto.CreateAPIKeyRequest{
+			Name:   "translate only",
+			Scopes: []string{"translate"},
+		})
+		if err != nil {
+			t.Fatalf("GenerateKey failed: %v", err)
+		}
+
+		if _, err := service.Authenticate(response.Key, "translate"); err != nil {
+			t.Errorf("Expected the granted scope to authenticate: %v", err)
+		}
+
+		_, err = service.Authenticate(response.Key, "video")
+		if err == nil {
+			t.Fatal("Expected an error for an ungranted scope")
+		}
+		if status := appErrorStatus(t, err); status != http.StatusForbidden {
+			t.Errorf("Expected status 403, got %d", status)
+		}
+	})
+
+	t.Run("unscoped key grants every scope", func(t *testing.T) {
+		response, err := service.GenerateKey(user.ID, &dto.CreateAPIKeyRequest{Name: "unrestricted"})
+		if err != nil {
+			t.Fatalf("GenerateKey failed: %v", err)
+		}
+
+		for _, scope := range []string{"translate", "video"} {
+			if _, err := service.Authenticate(response.Key, scope); err != nil {
+				t.Errorf("Expected scope %q to authenticate: %v", scope, err)
+			}
+		}
+	})
+}
+
+func TestRevokeKey(t *testing.T) {
+	t.Run("revoked keys no longer authenticate", func(t *testing.T) {
+		service, _, user := newAPIKeyTestService(t)
+
+		response, err := service.GenerateKey(user.ID, &dto.CreateAPIKeyRequest{Name: "short-lived"})
+		if err != nil {
+			t.Fatalf("GenerateKey failed: %v", err)
+		}
+
+		if err := service.RevokeKey(user.ID, response.APIKey.ID); err != nil {
+			t.Fatalf("RevokeKey failed: %v", err)
+		}
+
+		_, err = service.Authenticate(response.Key, "translate")
+		if err == nil {
+			t.Fatal("Expected a revoked key to be rejected")
+		}
+		if status := appErrorStatus(t, err); status != http.StatusUnauthorized {
+			t.Errorf("Expected status 401, got %d", status)
+		}
+	})
+
+	t.Run("users cannot revoke another user's key", func(t *testing.T) {
+		service, _, user := newAPIKeyTestService(t)
+
+		response, err := service.GenerateKey(user.ID, &dto.CreateAPIKeyRequest{Name: "mine"})
+		if err != nil {
+			t.Fatalf("GenerateKey failed: %v", err)
+		}
+
+		err = service.RevokeKey(user.ID+1, response.APIKey.ID)
+		if err == nil {
+			t.Fatal("Expected an error when revoking another user's key")
+		}
+		if status := appErrorStatus(t, err); status != http.StatusNotFound {
+			t.Errorf("Expected status 404, got %d", status)
+		}
+	})
+}
+
+func TestAPIKeyAuthMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	requestWithKey := func(router *gin.Engine, key string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+		if key != "" {
+			req.Header.Set("X-API-Key", key)
+		}
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	service, _, user := newAPIKeyTestService(t)
+	response, err := service.GenerateKey(user.ID, &dto.CreateAPIKeyRequest{
+		Name:   "middleware key",
+		Scopes: []string{"translate"},
+	})
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+
+	log := newTestLogger(t)
+
+	t.Run("valid key sets the user context", func(t *testing.T) {
+		router := gin.New()
+		router.GET("/protected", middleware.APIKeyAuth(service, log, "translate"), func(c *gin.Context) {
+			userCtx, err := types.GetUserContext(c)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "no user context"})
+				return
+			}
+			c.JSON(http.StatusOK, gin.H{"user_id": userCtx.UserID})
+		})
+
+		recorder := requestWithKey(router, response.Key)
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+		}
+		if !strings.Contains(recorder.Body.String(), `"user_id":1`) {
+			t.Errorf("Expected the owner's user ID in the response, got %s", recorder.Body.String())
+		}
+	})
+
+	t.Run("missing header is rejected with 401", func(t *testing.T) {
+		router := gin.New()
+		router.GET("/protected", middleware.APIKeyAuth(service, log, "translate"), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		if recorder := requestWithKey(router, ""); recorder.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status 401 without a key, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("ungranted scope is rejected with 403", func(t *testing.T) {
+		router := gin.New()
+		router.GET("/protected", middleware.APIKeyAuth(service, log, "video"), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		if recorder := requestWithKey(router, response.Key); recorder.Code != http.StatusForbidden {
+			t.Errorf("Expected status 403 for an ungranted scope, got %d", recorder.Code)
+		}
+	})
+}
+
+func TestAcceptAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	service, _, user := newAPIKeyTestService(t)
+	response, err := service.GenerateKey(user.ID, &dto.CreateAPIKeyRequest{
+		Name:   "route key",
+		Scopes: []string{"video"},
+	})
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+
+	// Mirror the production wiring: AcceptAPIKey runs at the group level
+	// ahead of the JWT middlewares
+	authMiddleware := middleware.NewAuthMiddleware(nil, nil, service, newTestLogger(t))
+	router := gin.New()
+	group := router.Group("/video", authMiddleware.AcceptAPIKey("video"))
+	group.GET("/protected", authMiddleware.RequireAuth(), func(c *gin.Context) {
+		userCtx, err := types.GetUserContext(c)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "no user context"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"user_id": userCtx.UserID})
+	})
+	group.GET("/open", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	request := func(path string, key string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if key != "" {
+			req.Header.Set("X-API-Key", key)
+		}
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	t.Run("an API key satisfies RequireAuth", func(t *testing.T) {
+		recorder := request("/video/protected", response.Key)
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+		}
+		if !strings.Contains(recorder.Body.String(), `"user_id":1`) {
+			t.Errorf("Expected the owner's user ID in the response, got %s", recorder.Body.String())
+		}
+	})
+
+	t.Run("requests without credentials are still rejected", func(t *testing.T) {
+		if recorder := request("/video/protected", ""); recorder.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status 401 without credentials, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("public routes stay open without a key", func(t *testing.T) {
+		if recorder := request("/video/open", ""); recorder.Code != http.StatusOK {
+			t.Errorf("Expected status 200 without a key, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("an invalid key fails even on public routes", func(t *testing.T) {
+		if recorder := request("/video/open", "ela_doesnotexist"); recorder.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status 401 for an invalid key, got %d", recorder.Code)
+		}
+	})
+}
+
+func TestAcceptAPIKeyScopes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	service, _, user := newAPIKeyTestService(t)
+	videoKey, err := service.GenerateKey(user.ID, &dto.CreateAPIKeyRequest{
+		Name:   "video only",
+		Scopes: []string{"video"},
+	})
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	unscopedKey, err := service.GenerateKey(user.ID, &dto.CreateAPIKeyRequest{Name: "unrestricted"})
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+
+	// Each route group attaches its own scope, as the video and translation
+	// routes do in production
+	authMiddleware := middleware.NewAuthMiddleware(nil, nil, service, newTestLogger(t))
+	router := gin.New()
+	router.GET("/video", authMiddleware.AcceptAPIKey("video"), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	router.GET("/translate", authMiddleware.AcceptAPIKey("translate"), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	request := func(path string, key string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("X-API-Key", key)
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	t.Run("a key is accepted on the group its scope grants", func(t *testing.T) {
+		if recorder := request("/video", videoKey.Key); recorder.Code != http.StatusOK {
+			t.Errorf("Expected status 200 on the granted group, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("a key is rejected on groups outside its scope", func(t *testing.T) {
+		if recorder := request("/translate", videoKey.Key); recorder.Code != http.StatusForbidden {
+			t.Errorf("Expected status 403 on an ungranted group, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("an unscoped key works on every group", func(t *testing.T) {
+		for _, path := range []string{"/video", "/translate"} {
+			if recorder := request(path, unscopedKey.Key); recorder.Code != http.StatusOK {
+				t.Errorf("Expected status 200 on %s, got %d", path, recorder.Code)
+			}
+		}
+	})
+}
@@ -0,0 +1,159 @@
+package audit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	"app-backend/internal/middleware"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/audit"
+	"app-backend/internal/types"
+	"app-backend/test/testutil"
+)
+
+func newAuditService(t *testing.T) audit.ServiceInterface {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t, &models.AuditLog{})
+	repo := repositories.NewAuditLogRepository(db)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	return audit.NewService(repo, log)
+}
+
+func listEvents(t *testing.T, service audit.ServiceInterface, req *dto.ListAuditEventsRequest) []dto.AuditEventResponse {
+	t.Helper()
+
+	events, err := service.ListEvents(req, &types.PaginationRequest{Page: 1, PageSize: 50})
+	if err != nil {
+		t.Fatalf("ListEvents failed: %v", err)
+	}
+	return events.Data
+}
+
+func TestAuditService(t *testing.T) {
+	t.Run("records and lists events", func(t *testing.T) {
+		service := newAuditService(t)
+
+		service.Record(&audit.Event{
+			ActorID:   1,
+			Action:    audit.ActionLogin,
+			IPAddress: "192.0.2.1",
+			UserAgent: "test-agent",
+			RequestID: "req-1",
+		})
+		service.Record(&audit.Event{
+			ActorID:      2,
+			TargetUserID: 1,
+			Action:       audit.ActionRoleUpdate,
+			Detail:       "role set to admin",
+		})
+
+		events := listEvents(t, service, &dto.ListAuditEventsRequest{})
+		if len(events) != 2 {
+			t.Fatalf("Expected 2 events, got %d", len(events))
+		}
+	})
+
+	t.Run("filters by action, actor and target", func(t *testing.T) {
+		service := newAuditService(t)
+
+		service.Record(&audit.Event{ActorID: 1, Action: audit.ActionLogin})
+		service.Record(&audit.Event{ActorID: 1, Action: audit.ActionLogout})
+		service.Record(&audit.Event{ActorID: 2, TargetUserID: 1, Action: audit.ActionSessionRevoke})
+
+		byAction := listEvents(t, service, &dto.ListAuditEventsRequest{Action: audit.ActionLogin})
+		if len(byAction) != 1 || byAction[0].Action != audit.ActionLogin {
+			t.Errorf("Unexpected action filter result: %+v", byAction)
+		}
+
+		byActor := listEvents(t, service, &dto.ListAuditEventsRequest{ActorID: 1})
+		if len(byActor) != 2 {
+			t.Errorf("Expected 2 events for actor 1, got %d", len(byActor))
+		}
+
+		byTarget := listEvents(t, service, &dto.ListAuditEventsRequest{TargetUserID: 1})
+		if len(byTarget) != 1 || byTarget[0].Action != audit.ActionSessionRevoke {
+			t.Errorf("Unexpected target filter result: %+v", byTarget)
+		}
+	})
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("persists marked events with request metadata", func(t *testing.T) {
+		service := newAuditService(t)
+
+		router := gin.New()
+		router.Use(middleware.RequestID(), middleware.Audit(service))
+		router.POST("/login", func(c *gin.Context) {
+			middleware.MarkAuditEvent(c, audit.Event{Action: audit.ActionLogin, ActorID: 42})
+			c.Status(http.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/login", nil)
+		req.Header.Set("User-Agent", "test-agent")
+		req.Header.Set("X-Request-ID", "req-abc")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		events := listEvents(t, service, &dto.ListAuditEventsRequest{})
+		if len(events) != 1 {
+			t.Fatalf("Expected 1 event, got %d", len(events))
+		}
+		event := events[0]
+		if event.Action != audit.ActionLogin || event.ActorID != 42 {
+			t.Errorf("Unexpected event: %+v", event)
+		}
+		if event.UserAgent != "test-agent" || event.RequestID != "req-abc" {
+			t.Errorf("Expected request metadata to be captured, got %+v", event)
+		}
+		if event.IPAddress == "" {
+			t.Error("Expected the client IP to be captured")
+		}
+	})
+
+	t.Run("falls back to the authenticated user as actor", func(t *testing.T) {
+		service := newAuditService(t)
+
+		router := gin.New()
+		router.Use(middleware.RequestID(), middleware.Audit(service))
+		router.POST("/change-password", func(c *gin.Context) {
+			// Simulate the auth middleware having resolved the user
+			c.Set("user_id", uint(7))
+			middleware.MarkAuditEvent(c, audit.Event{Action: audit.ActionPasswordChange})
+			c.Status(http.StatusOK)
+		})
+
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/change-password", nil))
+
+		events := listEvents(t, service, &dto.ListAuditEventsRequest{})
+		if len(events) != 1 || events[0].ActorID != 7 {
+			t.Errorf("Expected the actor to fall back to the context user, got %+v", events)
+		}
+	})
+
+	t.Run("requests without marked events record nothing", func(t *testing.T) {
+		service := newAuditService(t)
+
+		router := gin.New()
+		router.Use(middleware.RequestID(), middleware.Audit(service))
+		router.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/ping", nil))
+
+		if events := listEvents(t, service, &dto.ListAuditEventsRequest{}); len(events) != 0 {
+			t.Errorf("Expected no events, got %d", len(events))
+		}
+	})
+}
@@ -0,0 +1,195 @@
+package chapters_test
+
+import (
+	"context"
+	goerrors "errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/services/chapters"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+	"app-backend/internal/types"
+	"app-backend/pkg/gemini"
+)
+
+// countingInferrer fakes the model backend and counts how often it is
+// called
+type countingInferrer struct {
+	calls int32
+	err   error
+}
+
+func (i *countingInferrer) infer(ctx context.Context, transcript string) ([]gemini.Chapter, error) {
+	atomic.AddInt32(&i.calls, 1)
+	if i.err != nil {
+		return nil, i.err
+	}
+	return []gemini.Chapter{
+		{Title: "Introduction", StartSeconds: 0},
+		{Title: "Main topic", StartSeconds: 60},
+	}, nil
+}
+
+func newChaptersService(t *testing.T, inferFn chapters.InferFunc) chapters.ServiceInterface {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return chapters.NewService(inferFn, log)
+}
+
+func isStatus(err error, status int) bool {
+	var appErr *appErrors.AppError
+	return goerrors.As(err, &appErr) && appErr.Status == status
+}
+
+func segments(texts ...string) []transcriptTypes.TranscriptSegment {
+	result := make([]transcriptTypes.TranscriptSegment, len(texts))
+	for i, text := range texts {
+		result[i] = transcriptTypes.TranscriptSegment{
+			Text:     text,
+			Start:    time.Duration(i) * 30 * time.Second,
+			Duration: 30 * time.Second,
+		}
+	}
+	return result
+}
+
+func TestFromDescription(t *testing.T) {
+	service := newChaptersService(t, nil)
+
+	t.Run("parses timestamp markers", func(t *testing.T) {
+		description := "My video about Go.\n\n0:00 Intro\n1:30 - Goroutines\n1:02:15 Channels\n\nThanks for watching!"
+
+		result := service.FromDescription(description, 2*time.Hour)
+		if len(result) != 3 {
+			t.Fatalf("Expected 3 chapters, got %+v", result)
+		}
+		if result[1].Title != "Goroutines" || result[1].StartSeconds != 90 {
+			t.Errorf("Unexpected second chapter: %+v", result[1])
+		}
+		if result[2].StartSeconds != 3735 {
+			t.Errorf("Expected h:mm:ss to parse, got %f", result[2].StartSeconds)
+		}
+	})
+
+	t.Run("closes each chapter at the next one", func(t *testing.T) {
+		result := service.FromDescription("0:00 One\n1:00 Two\n2:00 Three", 3*time.Minute)
+		if len(result) != 3 {
+			t.Fatalf("Expected 3 chapters, got %+v", result)
+		}
+		if result[0].EndSeconds != 60 || result[1].EndSeconds != 120 {
+			t.Errorf("Expected chapters to end at the next start, got %+v", result)
+		}
+		if result[2].EndSeconds != 180 {
+			t.Errorf("Expected the last chapter to end at the video duration, got %f", result[2].EndSeconds)
+		}
+	})
+
+	t.Run("a title before the timestamp also counts", func(t *testing.T) {
+		result := service.FromDescription("Intro - 0:00\nSetup - 1:00\nDemo - 2:00", 0)
+		if len(result) != 3 || result[1].Title != "Setup" {
+			t.Fatalf("Expected title-first markers to parse, got %+v", result)
+		}
+	})
+
+	t.Run("fewer than three markers are not a chapter list", func(t *testing.T) {
+		if result := service.FromDescription("0:00 Intro\n1:00 Outro", time.Hour); result != nil {
+			t.Errorf("Expected nil for two markers, got %+v", result)
+		}
+	})
+
+	t.Run("the first marker must be at zero", func(t *testing.T) {
+		if result := service.FromDescription("0:30 Intro\n1:00 Middle\n2:00 End", time.Hour); result != nil {
+			t.Errorf("Expected nil without a 0:00 marker, got %+v", result)
+		}
+	})
+
+	t.Run("markers out of order are rejected", func(t *testing.T) {
+		if result := service.FromDescription("0:00 Intro\n2:00 End\n1:00 Middle", time.Hour); result != nil {
+			t.Errorf("Expected nil for unordered markers, got %+v", result)
+		}
+	})
+}
+
+func TestInferFromTranscript(t *testing.T) {
+	ctx := context.Background()
+	provider := types.VideoProvider("youtube")
+
+	t.Run("infers chapters through the model backend", func(t *testing.T) {
+		service := newChaptersService(t, (&countingInferrer{}).infer)
+
+		result, cached, err := service.InferFromTranscript(ctx, provider, "video-1", 2*time.Minute, segments("hello", "world"))
+		if err != nil {
+			t.Fatalf("InferFromTranscript failed: %v", err)
+		}
+		if cached {
+			t.Error("First inference must not be a cache hit")
+		}
+		if len(result) != 2 || result[0].EndSeconds != 60 || result[1].EndSeconds != 120 {
+			t.Errorf("Unexpected chapters: %+v", result)
+		}
+	})
+
+	t.Run("repeated requests are served from the cache", func(t *testing.T) {
+		backend := &countingInferrer{}
+		service := newChaptersService(t, backend.infer)
+
+		if _, _, err := service.InferFromTranscript(ctx, provider, "video-1", 0, segments("hello")); err != nil {
+			t.Fatalf("InferFromTranscript failed: %v", err)
+		}
+		_, cached, err := service.InferFromTranscript(ctx, provider, "video-1", 0, segments("hello"))
+		if err != nil {
+			t.Fatalf("Second InferFromTranscript failed: %v", err)
+		}
+		if !cached {
+			t.Error("Repeated inference must be a cache hit")
+		}
+		if calls := atomic.LoadInt32(&backend.calls); calls != 1 {
+			t.Errorf("Expected 1 model call, got %d", calls)
+		}
+	})
+
+	t.Run("a changed transcript is segmented fresh", func(t *testing.T) {
+		backend := &countingInferrer{}
+		service := newChaptersService(t, backend.infer)
+
+		if _, _, err := service.InferFromTranscript(ctx, provider, "video-1", 0, segments("hello")); err != nil {
+			t.Fatalf("InferFromTranscript failed: %v", err)
+		}
+		_, cached, err := service.InferFromTranscript(ctx, provider, "video-1", 0, segments("hello", "a new segment"))
+		if err != nil {
+			t.Fatalf("Second InferFromTranscript failed: %v", err)
+		}
+		if cached {
+			t.Error("A changed transcript must not hit the cache")
+		}
+		if calls := atomic.LoadInt32(&backend.calls); calls != 2 {
+			t.Errorf("Expected 2 model calls, got %d", calls)
+		}
+	})
+
+	t.Run("no backend means no chapters, not an error", func(t *testing.T) {
+		service := newChaptersService(t, nil)
+
+		result, _, err := service.InferFromTranscript(ctx, provider, "video-1", 0, segments("hello"))
+		if err != nil || result != nil {
+			t.Errorf("Expected nil chapters without a backend, got %+v, %v", result, err)
+		}
+	})
+
+	t.Run("model failures surface as bad gateway", func(t *testing.T) {
+		backend := &countingInferrer{err: goerrors.New("model exploded")}
+		service := newChaptersService(t, backend.infer)
+
+		if _, _, err := service.InferFromTranscript(ctx, provider, "video-1", 0, segments("hello")); !isStatus(err, http.StatusBadGateway) {
+			t.Errorf("Expected 502 when the model fails, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package correction_test
+
+import (
+	goerrors "errors"
+	"net/http"
+	"testing"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/correction"
+	"app-backend/test/testutil"
+)
+
+func newCorrectionService(t *testing.T) correction.ServiceInterface {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t, &models.User{}, &models.UserVideo{}, &models.TranscriptCorrection{})
+	correctionRepo := repositories.NewTranscriptCorrectionRepository(db)
+	userVideoRepo := repositories.NewUserVideoRepository(db)
+
+	// The owner of user 1 has saved the video under test
+	if err := userVideoRepo.Create(&models.UserVideo{
+		UserID:  1,
+		VideoID: "dQw4w9WgXcQ",
+		Title:   "Never Gonna Give You Up",
+	}); err != nil {
+		t.Fatalf("Failed to seed saved video: %v", err)
+	}
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	return correction.NewCorrectionService(correctionRepo, userVideoRepo, log)
+}
+
+func editSegment(t *testing.T, service correction.ServiceInterface, index int, text string) *dto.TranscriptCorrectionResponse {
+	t.Helper()
+
+	response, err := service.EditSegment(1, "dQw4w9WgXcQ", index, &dto.EditTranscriptSegmentRequest{Text: text})
+	if err != nil {
+		t.Fatalf("EditSegment failed: %v", err)
+	}
+	return response
+}
+
+func isStatus(err error, status int) bool {
+	var appErr *errors.AppError
+	return goerrors.As(err, &appErr) && appErr.Status == status
+}
+
+func TestTranscriptCorrections(t *testing.T) {
+	t.Run("each edit creates the next version", func(t *testing.T) {
+		service := newCorrectionService(t)
+
+		first := editSegment(t, service, 3, "Never gonna give you up")
+		if first.Version != 1 {
+			t.Errorf("Expected version 1 for the first edit, got %d", first.Version)
+		}
+
+		second := editSegment(t, service, 7, "Never gonna let you down")
+		if second.Version != 2 {
+			t.Errorf("Expected version 2 for the second edit, got %d", second.Version)
+		}
+	})
+
+	t.Run("history lists edits oldest first", func(t *testing.T) {
+		service := newCorrectionService(t)
+
+		editSegment(t, service, 3, "first pass")
+		editSegment(t, service, 3, "second pass")
+
+		history, err := service.History(1, "dQw4w9WgXcQ")
+		if err != nil {
+			t.Fatalf("History failed: %v", err)
+		}
+		if history.CurrentVersion != 2 {
+			t.Errorf("Expected current version 2, got %d", history.CurrentVersion)
+		}
+		if len(history.Corrections) != 2 {
+			t.Fatalf("Expected 2 corrections, got %d", len(history.Corrections))
+		}
+		if history.Corrections[0].Text != "first pass" || history.Corrections[1].Text != "second pass" {
+			t.Errorf("Unexpected history order: %+v", history.Corrections)
+		}
+	})
+
+	t.Run("latest edit per segment wins", func(t *testing.T) {
+		service := newCorrectionService(t)
+
+		editSegment(t, service, 3, "first pass")
+		editSegment(t, service, 5, "other segment")
+		editSegment(t, service, 3, "second pass")
+
+		texts, version, err := service.CorrectionsFor(1, "dQw4w9WgXcQ")
+		if err != nil {
+			t.Fatalf("CorrectionsFor failed: %v", err)
+		}
+		if version != 3 {
+			t.Errorf("Expected version 3, got %d", version)
+		}
+		if texts[3] != "second pass" || texts[5] != "other segment" {
+			t.Errorf("Unexpected correction overlay: %v", texts)
+		}
+	})
+
+	t.Run("no corrections yields nil overlay", func(t *testing.T) {
+		service := newCorrectionService(t)
+
+		texts, version, err := service.CorrectionsFor(1, "dQw4w9WgXcQ")
+		if err != nil {
+			t.Fatalf("CorrectionsFor failed: %v", err)
+		}
+		if texts != nil || version != 0 {
+			t.Errorf("Expected nil overlay and version 0, got %v, %d", texts, version)
+		}
+	})
+
+	t.Run("only saved videos can be corrected", func(t *testing.T) {
+		service := newCorrectionService(t)
+
+		_, err := service.EditSegment(1, "notSavedVid", 1, &dto.EditTranscriptSegmentRequest{Text: "text"})
+		if !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 for an unsaved video, got %v", err)
+		}
+
+		// Another user cannot correct someone else's saved video
+		_, err = service.EditSegment(2, "dQw4w9WgXcQ", 1, &dto.EditTranscriptSegmentRequest{Text: "text"})
+		if !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 for a non-owner, got %v", err)
+		}
+	})
+
+	t.Run("invalid edits are rejected", func(t *testing.T) {
+		service := newCorrectionService(t)
+
+		_, err := service.EditSegment(1, "dQw4w9WgXcQ", 0, &dto.EditTranscriptSegmentRequest{Text: "text"})
+		if !isStatus(err, http.StatusBadRequest) {
+			t.Errorf("Expected 400 for index 0, got %v", err)
+		}
+
+		_, err = service.EditSegment(1, "dQw4w9WgXcQ", 1, &dto.EditTranscriptSegmentRequest{Text: "   "})
+		if !isStatus(err, http.StatusBadRequest) {
+			t.Errorf("Expected 400 for blank text, got %v", err)
+		}
+	})
+}
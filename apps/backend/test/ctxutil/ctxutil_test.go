@@ -0,0 +1,88 @@
+package ctxutil_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/ctxutil"
+	"app-backend/internal/requestid"
+	"app-backend/internal/types"
+)
+
+func TestUserAccessors(t *testing.T) {
+	userCtx := &types.UserContext{
+		UserID:    7,
+		Email:     "user@example.com",
+		Role:      "user",
+		SessionID: 3,
+	}
+
+	t.Run("plain context", func(t *testing.T) {
+		ctx := types.NewUserContext(context.Background(), userCtx)
+
+		if got := ctxutil.UserID(ctx); got != 7 {
+			t.Errorf("Expected user ID 7, got %d", got)
+		}
+		got, ok := ctxutil.User(ctx)
+		if !ok {
+			t.Fatal("Expected a user context")
+		}
+		if got.Email != userCtx.Email {
+			t.Errorf("Expected email %q, got %q", userCtx.Email, got.Email)
+		}
+	})
+
+	t.Run("gin context and its request context", func(t *testing.T) {
+		gin.SetMode(gin.TestMode)
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest("GET", "/", nil)
+
+		types.SetUserContext(c, userCtx)
+
+		if got := ctxutil.UserID(c); got != 7 {
+			t.Errorf("Expected user ID 7 via gin context, got %d", got)
+		}
+		if got := ctxutil.UserID(c.Request.Context()); got != 7 {
+			t.Errorf("Expected user ID 7 via request context, got %d", got)
+		}
+	})
+
+	t.Run("unauthenticated context", func(t *testing.T) {
+		if got := ctxutil.UserID(context.Background()); got != 0 {
+			t.Errorf("Expected zero user ID, got %d", got)
+		}
+		if _, ok := ctxutil.User(context.Background()); ok {
+			t.Error("Expected no user context")
+		}
+	})
+}
+
+func TestRequestIDAccessor(t *testing.T) {
+	t.Run("plain context", func(t *testing.T) {
+		ctx := requestid.NewContext(context.Background(), "req-123")
+
+		if got := ctxutil.RequestID(ctx); got != "req-123" {
+			t.Errorf("Expected request ID req-123, got %q", got)
+		}
+	})
+
+	t.Run("gin context key fallback", func(t *testing.T) {
+		gin.SetMode(gin.TestMode)
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest("GET", "/", nil)
+		c.Set("request_id", "req-456")
+
+		if got := ctxutil.RequestID(c); got != "req-456" {
+			t.Errorf("Expected request ID req-456, got %q", got)
+		}
+	})
+
+	t.Run("missing request ID", func(t *testing.T) {
+		if got := ctxutil.RequestID(context.Background()); got != "" {
+			t.Errorf("Expected empty request ID, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,206 @@
+package database_test
+
+import (
+	"strings"
+	"testing"
+
+	"app-backend/internal/database"
+	"app-backend/test/testutil"
+)
+
+// testMigrations is a small sqlite-compatible set exercising the runner;
+// the real embedded SQL is Postgres-specific and covered by LoadMigrations.
+func testMigrations() []database.Migration {
+	return []database.Migration{
+		{
+			Version: 1,
+			Name:    "create_widgets",
+			UpSQL:   "CREATE TABLE widgets (id INTEGER PRIMARY KEY, name TEXT NOT NULL)",
+			DownSQL: "DROP TABLE widgets",
+		},
+		{
+			Version: 2,
+			Name:    "add_widget_color",
+			UpSQL:   "ALTER TABLE widgets ADD COLUMN color TEXT",
+			DownSQL: "ALTER TABLE widgets DROP COLUMN color",
+		},
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	all, err := database.LoadMigrations()
+	if err != nil {
+		t.Fatalf("LoadMigrations failed: %v", err)
+	}
+	if len(all) < 6 {
+		t.Fatalf("Expected at least 6 embedded migrations, got %d", len(all))
+	}
+
+	for i, migration := range all {
+		if migration.UpSQL == "" {
+			t.Errorf("Migration %06d_%s has empty up SQL", migration.Version, migration.Name)
+		}
+		if i > 0 && all[i-1].Version >= migration.Version {
+			t.Errorf("Migrations out of order: %d before %d", all[i-1].Version, migration.Version)
+		}
+	}
+
+	if all[0].Version != 1 || all[0].Name != "create_users_table" {
+		t.Errorf("Unexpected first migration: %06d_%s", all[0].Version, all[0].Name)
+	}
+}
+
+func TestApply(t *testing.T) {
+	t.Run("applies pending migrations in order and records them", func(t *testing.T) {
+		db := testutil.SetupTestDB(t)
+
+		applied, err := database.Apply(db, testMigrations())
+		if err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		if applied != 2 {
+			t.Errorf("Expected 2 applied migrations, got %d", applied)
+		}
+
+		// Both statements ran: the second migration's column is usable
+		if err := db.Exec("INSERT INTO widgets (name, color) VALUES ('gear', 'red')").Error; err != nil {
+			t.Errorf("Migrated schema incomplete: %v", err)
+		}
+
+		history, err := database.Applied(db)
+		if err != nil {
+			t.Fatalf("Applied failed: %v", err)
+		}
+		if len(history) != 2 || history[0].Version != 1 || history[1].Version != 2 {
+			t.Errorf("Unexpected migration history: %+v", history)
+		}
+	})
+
+	t.Run("is idempotent once applied", func(t *testing.T) {
+		db := testutil.SetupTestDB(t)
+
+		if _, err := database.Apply(db, testMigrations()); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		applied, err := database.Apply(db, testMigrations())
+		if err != nil {
+			t.Fatalf("Second Apply failed: %v", err)
+		}
+		if applied != 0 {
+			t.Errorf("Expected no migrations on second run, got %d", applied)
+		}
+	})
+
+	t.Run("applies only what is missing", func(t *testing.T) {
+		db := testutil.SetupTestDB(t)
+		all := testMigrations()
+
+		if _, err := database.Apply(db, all[:1]); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		applied, err := database.Apply(db, all)
+		if err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		if applied != 1 {
+			t.Errorf("Expected 1 newly applied migration, got %d", applied)
+		}
+	})
+
+	t.Run("a failing migration is not recorded", func(t *testing.T) {
+		db := testutil.SetupTestDB(t)
+		broken := []database.Migration{{
+			Version: 1,
+			Name:    "broken",
+			UpSQL:   "CREATE TABLE definitely not valid sql",
+		}}
+
+		if _, err := database.Apply(db, broken); err == nil {
+			t.Fatal("Expected the broken migration to fail")
+		}
+
+		history, err := database.Applied(db)
+		if err != nil {
+			t.Fatalf("Applied failed: %v", err)
+		}
+		if len(history) != 0 {
+			t.Errorf("Failed migration was recorded: %+v", history)
+		}
+	})
+}
+
+func TestRevert(t *testing.T) {
+	t.Run("rolls back the most recent migration", func(t *testing.T) {
+		db := testutil.SetupTestDB(t)
+		all := testMigrations()
+
+		if _, err := database.Apply(db, all); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+
+		reverted, err := database.Revert(db, all)
+		if err != nil {
+			t.Fatalf("Revert failed: %v", err)
+		}
+		if reverted.Version != 2 {
+			t.Errorf("Expected version 2 to be reverted, got %d", reverted.Version)
+		}
+
+		// The color column is gone but the table remains
+		if err := db.Exec("INSERT INTO widgets (name, color) VALUES ('gear', 'red')").Error; err == nil {
+			t.Error("Expected the rolled-back column to be gone")
+		}
+		if err := db.Exec("INSERT INTO widgets (name) VALUES ('gear')").Error; err != nil {
+			t.Errorf("Table from earlier migration should survive: %v", err)
+		}
+
+		history, err := database.Applied(db)
+		if err != nil {
+			t.Fatalf("Applied failed: %v", err)
+		}
+		if len(history) != 1 || history[0].Version != 1 {
+			t.Errorf("Unexpected history after rollback: %+v", history)
+		}
+	})
+
+	t.Run("refuses when nothing is applied", func(t *testing.T) {
+		db := testutil.SetupTestDB(t)
+
+		if _, err := database.Revert(db, testMigrations()); err == nil {
+			t.Fatal("Expected an error with no applied migrations")
+		}
+	})
+
+	t.Run("refuses without a down file", func(t *testing.T) {
+		db := testutil.SetupTestDB(t)
+		all := []database.Migration{{
+			Version: 1,
+			Name:    "no_down",
+			UpSQL:   "CREATE TABLE one_way (id INTEGER PRIMARY KEY)",
+		}}
+
+		if _, err := database.Apply(db, all); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		_, err := database.Revert(db, all)
+		if err == nil || !strings.Contains(err.Error(), "no down file") {
+			t.Errorf("Expected a missing down file error, got %v", err)
+		}
+	})
+}
+
+func TestPendingTracksAppliedState(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+
+	pending, err := database.Pending(db)
+	if err != nil {
+		t.Fatalf("Pending failed: %v", err)
+	}
+	all, err := database.LoadMigrations()
+	if err != nil {
+		t.Fatalf("LoadMigrations failed: %v", err)
+	}
+	if len(pending) != len(all) {
+		t.Errorf("Expected every embedded migration pending on a fresh database, got %d of %d", len(pending), len(all))
+	}
+}
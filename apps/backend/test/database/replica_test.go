@@ -0,0 +1,145 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"app-backend/internal/database"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/test/testutil"
+
+	"gorm.io/gorm"
+)
+
+// setupPrimaryWithReplica opens two isolated databases, routes reads on the
+// primary to the replica, and seeds each with a distinguishable user so
+// tests can tell which connection served a query
+func setupPrimaryWithReplica(t *testing.T) (*gorm.DB, *gorm.DB) {
+	t.Helper()
+
+	primary := testutil.SetupTestDB(t, &models.User{})
+	replica := testutil.SetupTestDB(t, &models.User{})
+
+	if err := database.RegisterReplica(primary, replica); err != nil {
+		t.Fatalf("Failed to register replica: %v", err)
+	}
+	return primary, replica
+}
+
+func seedUser(t *testing.T, db *gorm.DB, email string) uint {
+	t.Helper()
+
+	user := &models.User{
+		FirstName: "Test",
+		LastName:  "User",
+		Email:     email,
+		Password:  "irrelevant",
+	}
+	// Write through the raw connection so seeding is not itself routed
+	if err := db.Session(&gorm.Session{SkipHooks: true}).Create(user).Error; err != nil {
+		t.Fatalf("Failed to seed user: %v", err)
+	}
+	return user.ID
+}
+
+func TestReplicaRouting(t *testing.T) {
+	t.Run("reads are served by the replica", func(t *testing.T) {
+		primary, replica := setupPrimaryWithReplica(t)
+		seedUser(t, replica, "replica@example.com")
+
+		var user models.User
+		if err := primary.First(&user).Error; err != nil {
+			t.Fatalf("Read failed: %v", err)
+		}
+		if user.Email != "replica@example.com" {
+			t.Errorf("Expected the replica's row, got %q", user.Email)
+		}
+	})
+
+	t.Run("writes go to the primary", func(t *testing.T) {
+		primary, replica := setupPrimaryWithReplica(t)
+
+		if err := primary.Create(&models.User{
+			FirstName: "Write",
+			LastName:  "Test",
+			Email:     "primary@example.com",
+			Password:  "irrelevant",
+		}).Error; err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+
+		var count int64
+		if err := replica.Model(&models.User{}).Count(&count).Error; err != nil {
+			t.Fatalf("Count failed: %v", err)
+		}
+		if count != 0 {
+			t.Errorf("Write leaked to the replica, found %d rows", count)
+		}
+	})
+
+	t.Run("WithPrimary pins reads to the primary", func(t *testing.T) {
+		primary, _ := setupPrimaryWithReplica(t)
+		seedUser(t, primary, "fresh-write@example.com")
+
+		var user models.User
+		ctx := database.WithPrimary(context.Background())
+		if err := primary.WithContext(ctx).First(&user).Error; err != nil {
+			t.Fatalf("Pinned read failed: %v", err)
+		}
+		if user.Email != "fresh-write@example.com" {
+			t.Errorf("Expected the primary's row, got %q", user.Email)
+		}
+	})
+
+	t.Run("transaction reads see the transaction's writes", func(t *testing.T) {
+		primary, _ := setupPrimaryWithReplica(t)
+
+		err := primary.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Create(&models.User{
+				FirstName: "Tx",
+				LastName:  "User",
+				Email:     "tx@example.com",
+				Password:  "irrelevant",
+			}).Error; err != nil {
+				return err
+			}
+
+			var user models.User
+			if err := tx.Where("email = ?", "tx@example.com").First(&user).Error; err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			t.Errorf("Transactional read-your-writes failed: %v", err)
+		}
+	})
+}
+
+func TestBaseRepositoryWithContext(t *testing.T) {
+	primary, replica := setupPrimaryWithReplica(t)
+	seedUser(t, replica, "replica@example.com")
+	id := seedUser(t, primary, "primary@example.com")
+
+	repo := repositories.NewBaseRepository[models.User](primary)
+
+	// Default reads observe replica state
+	user, err := repo.FindBy("email", "replica@example.com")
+	if err != nil {
+		t.Fatalf("FindBy failed: %v", err)
+	}
+	if user.Email != "replica@example.com" {
+		t.Errorf("Expected the replica's row, got %q", user.Email)
+	}
+
+	// Pinned reads observe the primary's fresh write
+	pinned := repo.WithContext(database.WithPrimary(context.Background()))
+	user, err = pinned.GetByID(id)
+	if err != nil {
+		t.Fatalf("Pinned GetByID failed: %v", err)
+	}
+	if user.Email != "primary@example.com" {
+		t.Errorf("Expected the primary's row, got %q", user.Email)
+	}
+}
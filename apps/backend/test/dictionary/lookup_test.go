@@ -0,0 +1,199 @@
+package dictionary_test
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/services/dictionary"
+	"app-backend/pkg/gemini"
+)
+
+// countingLookup fakes the model backend and counts how often it is called
+type countingLookup struct {
+	calls int32
+	err   error
+}
+
+func (l *countingLookup) lookup(ctx context.Context, word string, sentence string, targetLang string) (*gemini.DictionaryEntry, error) {
+	atomic.AddInt32(&l.calls, 1)
+	if l.err != nil {
+		return nil, l.err
+	}
+	entry := &gemini.DictionaryEntry{
+		Word:         strings.ToLower(word),
+		Definition:   fmt.Sprintf("meaning of %s in %q", word, sentence),
+		PartOfSpeech: "noun",
+		IPA:          "tɛst",
+		Examples:     []string{"First example.", "Second example."},
+	}
+	if targetLang != "" {
+		entry.Translation = "[" + targetLang + "] " + word
+	}
+	return entry, nil
+}
+
+func newLookupTestService(t *testing.T, backend *countingLookup) dictionary.ServiceInterface {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return dictionary.NewDictionaryService(backend.lookup, log)
+}
+
+func isStatus(err error, status int) bool {
+	var appErr *appErrors.AppError
+	return goerrors.As(err, &appErr) && appErr.Status == status
+}
+
+func TestDictionaryLookup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns a structured entry", func(t *testing.T) {
+		backend := &countingLookup{}
+		service := newLookupTestService(t, backend)
+
+		result, err := service.Lookup(ctx, &dto.DictionaryLookupRequest{
+			Word:       "bank",
+			Sentence:   "She sat on the bank of the river.",
+			TargetLang: "vi",
+		})
+		if err != nil {
+			t.Fatalf("Expected entry, got error: %v", err)
+		}
+		if result.Word != "bank" || result.PartOfSpeech != "noun" || result.IPA == "" {
+			t.Errorf("Unexpected entry: %+v", result)
+		}
+		if len(result.Examples) != 2 {
+			t.Errorf("Expected 2 examples, got %d", len(result.Examples))
+		}
+		if result.Translation != "[vi] bank" {
+			t.Errorf("Expected translation, got %q", result.Translation)
+		}
+		if result.Cached {
+			t.Error("Expected a fresh entry not to be marked cached")
+		}
+	})
+
+	t.Run("repeated lookups are served from the cache", func(t *testing.T) {
+		backend := &countingLookup{}
+		service := newLookupTestService(t, backend)
+
+		req := &dto.DictionaryLookupRequest{
+			Word:     "run",
+			Sentence: "He went for a run this morning.",
+		}
+		if _, err := service.Lookup(ctx, req); err != nil {
+			t.Fatalf("Expected entry, got error: %v", err)
+		}
+		again, err := service.Lookup(ctx, req)
+		if err != nil {
+			t.Fatalf("Expected cached entry, got error: %v", err)
+		}
+		if !again.Cached {
+			t.Error("Expected the repeated lookup to be marked cached")
+		}
+		if got := atomic.LoadInt32(&backend.calls); got != 1 {
+			t.Errorf("Expected 1 backend call, got %d", got)
+		}
+	})
+
+	t.Run("a different sentence is a different cache entry", func(t *testing.T) {
+		backend := &countingLookup{}
+		service := newLookupTestService(t, backend)
+
+		if _, err := service.Lookup(ctx, &dto.DictionaryLookupRequest{
+			Word:     "bank",
+			Sentence: "She sat on the bank of the river.",
+		}); err != nil {
+			t.Fatalf("Expected entry, got error: %v", err)
+		}
+		if _, err := service.Lookup(ctx, &dto.DictionaryLookupRequest{
+			Word:     "bank",
+			Sentence: "He deposited the check at the bank.",
+		}); err != nil {
+			t.Fatalf("Expected entry, got error: %v", err)
+		}
+		if got := atomic.LoadInt32(&backend.calls); got != 2 {
+			t.Errorf("Expected 2 backend calls, got %d", got)
+		}
+	})
+
+	t.Run("blank word is rejected", func(t *testing.T) {
+		service := newLookupTestService(t, &countingLookup{})
+
+		_, err := service.Lookup(ctx, &dto.DictionaryLookupRequest{
+			Word:     "   ",
+			Sentence: "Some sentence.",
+		})
+		if !isStatus(err, http.StatusBadRequest) {
+			t.Errorf("Expected 400 for a blank word, got %v", err)
+		}
+	})
+
+	t.Run("backend failure surfaces as 502", func(t *testing.T) {
+		backend := &countingLookup{err: fmt.Errorf("model unavailable")}
+		service := newLookupTestService(t, backend)
+
+		_, err := service.Lookup(ctx, &dto.DictionaryLookupRequest{
+			Word:     "bank",
+			Sentence: "She sat on the bank of the river.",
+		})
+		if !isStatus(err, http.StatusBadGateway) {
+			t.Errorf("Expected 502 for a backend failure, got %v", err)
+		}
+	})
+}
+
+func TestParseDictionaryEntry(t *testing.T) {
+	t.Run("strips markdown fences", func(t *testing.T) {
+		raw := "```json\n{\"word\":\"bank\",\"definition\":\"the land alongside a river\",\"part_of_speech\":\"noun\",\"ipa\":\"bæŋk\",\"examples\":[\"a\",\"b\"]}\n```"
+		entry, err := gemini.ParseDictionaryEntry(raw)
+		if err != nil {
+			t.Fatalf("Expected entry, got error: %v", err)
+		}
+		if entry.Word != "bank" || entry.PartOfSpeech != "noun" {
+			t.Errorf("Unexpected entry: %+v", entry)
+		}
+	})
+
+	t.Run("rejects entries missing required fields", func(t *testing.T) {
+		if _, err := gemini.ParseDictionaryEntry(`{"word":"bank","ipa":"bæŋk"}`); err == nil {
+			t.Error("Expected an error for a missing definition")
+		}
+	})
+
+	t.Run("rejects non-JSON responses", func(t *testing.T) {
+		if _, err := gemini.ParseDictionaryEntry("The word bank means..."); err == nil {
+			t.Error("Expected an error for a prose response")
+		}
+	})
+}
+
+func TestBuildDictionaryPrompt(t *testing.T) {
+	prompt := gemini.BuildDictionaryPrompt("bank", "She sat on the bank of the river.", "vi")
+
+	if !strings.Contains(prompt, `"bank"`) || !strings.Contains(prompt, "She sat on the bank of the river.") {
+		t.Error("Expected the prompt to carry the word and its sentence")
+	}
+	if !strings.Contains(prompt, "single JSON object") {
+		t.Error("Expected the prompt to constrain the output to JSON")
+	}
+	if !strings.Contains(prompt, "translated to vi") {
+		t.Error("Expected the prompt to ask for the translation")
+	}
+
+	withoutLang := gemini.BuildDictionaryPrompt("bank", "Some sentence.", "")
+	if strings.Contains(withoutLang, "translation") {
+		t.Error("Expected no translation key without a target language")
+	}
+}
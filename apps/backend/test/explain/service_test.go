@@ -0,0 +1,165 @@
+package explain_test
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/services/explain"
+	"app-backend/pkg/gemini"
+)
+
+// countingExplainer fakes the model backend and counts how often it is
+// called
+type countingExplainer struct {
+	calls int32
+	err   error
+}
+
+func (e *countingExplainer) explain(ctx context.Context, sentence string, nativeLang string) (*gemini.GrammarExplanation, error) {
+	atomic.AddInt32(&e.calls, 1)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return &gemini.GrammarExplanation{
+		Sentence: sentence,
+		Clauses: []gemini.GrammarClause{
+			{Text: sentence, Role: "main clause"},
+		},
+		Tenses: []gemini.GrammarTense{
+			{Verb: "is", Tense: "present simple", Explanation: "a general statement"},
+		},
+		LiteralTranslation: "[" + nativeLang + "] literal: " + sentence,
+		NaturalTranslation: "[" + nativeLang + "] natural: " + sentence,
+	}, nil
+}
+
+func newExplainTestService(t *testing.T, backend *countingExplainer, cfg explain.Config) explain.ServiceInterface {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return explain.NewService(backend.explain, cfg, log)
+}
+
+func isStatus(err error, status int) bool {
+	var appErr *appErrors.AppError
+	return goerrors.As(err, &appErr) && appErr.Status == status
+}
+
+func TestExplain(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns a structured breakdown", func(t *testing.T) {
+		service := newExplainTestService(t, &countingExplainer{}, explain.Config{})
+
+		resp, err := service.Explain(ctx, 1, &dto.ExplainRequest{Sentence: "She has been waiting", NativeLang: "vi"})
+		if err != nil {
+			t.Fatalf("Explain failed: %v", err)
+		}
+		if len(resp.Clauses) != 1 || resp.Clauses[0].Role != "main clause" {
+			t.Errorf("Unexpected clause breakdown: %+v", resp.Clauses)
+		}
+		if resp.NaturalTranslation == "" || resp.LiteralTranslation == "" {
+			t.Error("Expected both literal and natural translations")
+		}
+		if resp.Cached {
+			t.Error("First breakdown must not be marked cached")
+		}
+	})
+
+	t.Run("serves repeated sentences from the cache", func(t *testing.T) {
+		backend := &countingExplainer{}
+		service := newExplainTestService(t, backend, explain.Config{})
+
+		req := &dto.ExplainRequest{Sentence: "He gave up", NativeLang: "es"}
+		if _, err := service.Explain(ctx, 1, req); err != nil {
+			t.Fatalf("First explain failed: %v", err)
+		}
+		second, err := service.Explain(ctx, 2, req)
+		if err != nil {
+			t.Fatalf("Second explain failed: %v", err)
+		}
+		if !second.Cached {
+			t.Error("Repeated breakdown must be marked cached")
+		}
+		if calls := atomic.LoadInt32(&backend.calls); calls != 1 {
+			t.Errorf("Expected 1 backend call, got %d", calls)
+		}
+	})
+
+	t.Run("rate limits fresh breakdowns per user", func(t *testing.T) {
+		service := newExplainTestService(t, &countingExplainer{}, explain.Config{RequestsPerMinute: 2})
+
+		for i := 0; i < 2; i++ {
+			req := &dto.ExplainRequest{Sentence: fmt.Sprintf("Sentence number %d", i), NativeLang: "vi"}
+			if _, err := service.Explain(ctx, 1, req); err != nil {
+				t.Fatalf("Explain %d failed: %v", i, err)
+			}
+		}
+
+		_, err := service.Explain(ctx, 1, &dto.ExplainRequest{Sentence: "One too many", NativeLang: "vi"})
+		if !isStatus(err, http.StatusTooManyRequests) {
+			t.Errorf("Expected 429 over the limit, got %v", err)
+		}
+
+		// Another user has their own budget
+		if _, err := service.Explain(ctx, 2, &dto.ExplainRequest{Sentence: "One too many", NativeLang: "vi"}); err != nil {
+			t.Errorf("Expected another user to be allowed, got %v", err)
+		}
+
+		// Cached sentences never cost quota
+		if _, err := service.Explain(ctx, 1, &dto.ExplainRequest{Sentence: "Sentence number 0", NativeLang: "vi"}); err != nil {
+			t.Errorf("Expected a cached sentence to bypass the limit, got %v", err)
+		}
+	})
+
+	t.Run("rejects missing fields", func(t *testing.T) {
+		service := newExplainTestService(t, &countingExplainer{}, explain.Config{})
+
+		if _, err := service.Explain(ctx, 1, &dto.ExplainRequest{Sentence: "   ", NativeLang: "vi"}); !isStatus(err, http.StatusBadRequest) {
+			t.Errorf("Expected 400 for a missing sentence, got %v", err)
+		}
+		if _, err := service.Explain(ctx, 1, &dto.ExplainRequest{Sentence: "Hello", NativeLang: ""}); !isStatus(err, http.StatusBadRequest) {
+			t.Errorf("Expected 400 for a missing native language, got %v", err)
+		}
+	})
+
+	t.Run("maps backend failure to bad gateway", func(t *testing.T) {
+		backend := &countingExplainer{err: goerrors.New("model unavailable")}
+		service := newExplainTestService(t, backend, explain.Config{})
+
+		_, err := service.Explain(ctx, 1, &dto.ExplainRequest{Sentence: "Hello", NativeLang: "vi"})
+		if !isStatus(err, http.StatusBadGateway) {
+			t.Errorf("Expected 502 for backend failure, got %v", err)
+		}
+	})
+}
+
+func TestParseGrammarExplanation(t *testing.T) {
+	t.Run("strips markdown fences", func(t *testing.T) {
+		raw := "```json\n{\"sentence\": \"Hi\", \"clauses\": [{\"text\": \"Hi\", \"role\": \"interjection\"}], \"literal_translation\": \"x\", \"natural_translation\": \"y\"}\n```"
+		explanation, err := gemini.ParseGrammarExplanation(raw)
+		if err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		if explanation.NaturalTranslation != "y" {
+			t.Errorf("Unexpected parse result: %+v", explanation)
+		}
+	})
+
+	t.Run("rejects a breakdown without clauses", func(t *testing.T) {
+		raw := `{"sentence": "Hi", "clauses": [], "natural_translation": "y"}`
+		if _, err := gemini.ParseGrammarExplanation(raw); err == nil {
+			t.Error("Expected an error for a breakdown with no clauses")
+		}
+	})
+}
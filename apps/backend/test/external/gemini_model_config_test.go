@@ -0,0 +1,65 @@
+package external_test
+
+import (
+	"testing"
+
+	"github.com/google/generative-ai-go/genai"
+	"go.uber.org/zap"
+
+	"app-backend/pkg/gemini"
+)
+
+func TestParseSafetySettings(t *testing.T) {
+	t.Run("maps known categories and thresholds", func(t *testing.T) {
+		settings := gemini.ParseSafetySettings(map[string]string{
+			"harassment":        "block_none",
+			"dangerous_content": "block_only_high",
+		}, zap.NewNop())
+
+		if len(settings) != 2 {
+			t.Fatalf("Expected 2 safety settings, got %d", len(settings))
+		}
+		byCategory := make(map[genai.HarmCategory]genai.HarmBlockThreshold)
+		for _, setting := range settings {
+			byCategory[setting.Category] = setting.Threshold
+		}
+		if byCategory[genai.HarmCategoryHarassment] != genai.HarmBlockNone {
+			t.Error("Expected harassment to map to block_none")
+		}
+		if byCategory[genai.HarmCategoryDangerousContent] != genai.HarmBlockOnlyHigh {
+			t.Error("Expected dangerous_content to map to block_only_high")
+		}
+	})
+
+	t.Run("skips unknown categories and thresholds", func(t *testing.T) {
+		settings := gemini.ParseSafetySettings(map[string]string{
+			"harassment":  "block_everything",
+			"self_esteem": "block_none",
+			"hate_speech": "block_medium_and_above",
+		}, zap.NewNop())
+
+		if len(settings) != 1 {
+			t.Fatalf("Expected only the valid setting to survive, got %d", len(settings))
+		}
+		if settings[0].Category != genai.HarmCategoryHateSpeech {
+			t.Errorf("Expected hate_speech to survive, got %v", settings[0].Category)
+		}
+	})
+
+	t.Run("empty input yields no settings", func(t *testing.T) {
+		if settings := gemini.ParseSafetySettings(nil, zap.NewNop()); settings != nil {
+			t.Errorf("Expected nil settings, got %v", settings)
+		}
+	})
+}
+
+func TestApplyModelParamsWithoutClient(t *testing.T) {
+	// A service built without an API key has no client; applying parameters
+	// must be a no-op instead of a panic
+	service := gemini.NewService("", zap.NewNop())
+	service.ApplyModelParams(gemini.ModelParams{Model: "gemini-1.5-pro", Temperature: 0.5})
+
+	if service.IsConfigured() {
+		t.Error("Service without an API key should stay unconfigured")
+	}
+}
@@ -0,0 +1,70 @@
+package external_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"app-backend/pkg/gemini"
+	"app-backend/pkg/patterns"
+	"app-backend/pkg/youtube"
+)
+
+// exhaustedLimiter returns a token bucket with no tokens left and a refill
+// interval far beyond the test timeout
+func exhaustedLimiter(t *testing.T) *patterns.TokenBucketLimiter {
+	t.Helper()
+
+	limiter := patterns.NewTokenBucketLimiter(1, time.Hour, zap.NewNop())
+	if !limiter.Allow() {
+		t.Fatal("Fresh limiter should allow the first request")
+	}
+	return limiter
+}
+
+func TestGeminiServiceRateLimit(t *testing.T) {
+	service := gemini.NewService("", zap.NewNop())
+	service.SetRateLimiter(exhaustedLimiter(t))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := service.DetectLanguage(ctx, "hello world")
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("Call beyond the configured rate should fail")
+	}
+	if !strings.Contains(err.Error(), "rate limit") {
+		t.Errorf("Expected rate limit error, got: %v", err)
+	}
+	if elapsed < 40*time.Millisecond {
+		t.Errorf("Call should have been delayed until the context deadline, took %v", elapsed)
+	}
+}
+
+func TestYouTubeServiceRateLimit(t *testing.T) {
+	service := youtube.NewService("", zap.NewNop())
+	service.SetRateLimiter(exhaustedLimiter(t))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := service.GetAvailableLanguages(ctx, "dQw4w9WgXcQ")
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("Call beyond the configured rate should fail")
+	}
+	if !strings.Contains(err.Error(), "rate limit") {
+		t.Errorf("Expected rate limit error, got: %v", err)
+	}
+	if elapsed < 40*time.Millisecond {
+		t.Errorf("Call should have been delayed until the context deadline, took %v", elapsed)
+	}
+}
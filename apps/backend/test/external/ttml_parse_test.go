@@ -0,0 +1,106 @@
+package external_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"app-backend/pkg/youtube"
+)
+
+// buildTTMLDocument generates a synthetic TTML document with the given number
+// of caption paragraphs
+func buildTTMLDocument(paragraphCount int) []byte {
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="utf-8"?><tt xmlns="http://www.w3.org/ns/ttml"><body><div>`)
+	for i := 0; i < paragraphCount; i++ {
+		begin := float64(i) * 2.5
+		end := begin + 2.5
+		fmt.Fprintf(&sb, `<p begin="%.3fs" end="%.3fs">  segment <b>number</b> %d  </p>`, begin, end, i)
+	}
+	sb.WriteString(`</div></body></tt>`)
+	return []byte(sb.String())
+}
+
+func TestParseTTMLParallelMatchesSerial(t *testing.T) {
+	data := buildTTMLDocument(2500)
+
+	serialService := youtube.NewService("", zap.NewNop())
+	// Force the serial path regardless of document size
+	serialService.SetParallelParseThreshold(1000000)
+
+	serial, err := serialService.ParseTTML(data)
+	if err != nil {
+		t.Fatalf("Serial ParseTTML failed: %v", err)
+	}
+	if len(serial) != 2500 {
+		t.Fatalf("Expected 2500 serial segments, got %d", len(serial))
+	}
+
+	parallelService := youtube.NewService("", zap.NewNop())
+	parallelService.SetParallelParseThreshold(100)
+	parallelService.SetParseWorkers(4)
+
+	parallel, err := parallelService.ParseTTML(data)
+	if err != nil {
+		t.Fatalf("Parallel ParseTTML failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(serial, parallel) {
+		if len(serial) != len(parallel) {
+			t.Fatalf("Segment count mismatch: serial %d, parallel %d", len(serial), len(parallel))
+		}
+		for i := range serial {
+			if serial[i] != parallel[i] {
+				t.Fatalf("Segment %d mismatch: serial %+v, parallel %+v", i, serial[i], parallel[i])
+			}
+		}
+	}
+}
+
+func TestParseTTMLSmallDocumentStaysSerial(t *testing.T) {
+	data := buildTTMLDocument(10)
+
+	service := youtube.NewService("", zap.NewNop())
+	segments, err := service.ParseTTML(data)
+	if err != nil {
+		t.Fatalf("ParseTTML failed: %v", err)
+	}
+	if len(segments) != 10 {
+		t.Fatalf("Expected 10 segments, got %d", len(segments))
+	}
+	for i, segment := range segments {
+		if segment.Index != i {
+			t.Errorf("Expected segment index %d, got %d", i, segment.Index)
+		}
+	}
+}
+
+func BenchmarkParseTTMLSerial(b *testing.B) {
+	data := buildTTMLDocument(5000)
+	service := youtube.NewService("", zap.NewNop())
+	service.SetParallelParseThreshold(1000000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := service.ParseTTML(data); err != nil {
+			b.Fatalf("ParseTTML failed: %v", err)
+		}
+	}
+}
+
+func BenchmarkParseTTMLParallel(b *testing.B) {
+	data := buildTTMLDocument(5000)
+	service := youtube.NewService("", zap.NewNop())
+	service.SetParallelParseThreshold(100)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := service.ParseTTML(data); err != nil {
+			b.Fatalf("ParseTTML failed: %v", err)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package external_test
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"app-backend/pkg/youtube"
+)
+
+func TestQuotaTracker(t *testing.T) {
+	t.Run("tracks usage per method", func(t *testing.T) {
+		tracker := youtube.NewQuotaTracker(10000, 0.1, zap.NewNop())
+		tracker.Record("videos.list", 1)
+		tracker.Record("captions.list", 50)
+		tracker.Record("captions.list", 50)
+
+		snapshot := tracker.Snapshot()
+		if snapshot.Used != 101 {
+			t.Errorf("Expected 101 units used, got %d", snapshot.Used)
+		}
+		if snapshot.Remaining != 9899 {
+			t.Errorf("Expected 9899 units remaining, got %d", snapshot.Remaining)
+		}
+		if snapshot.ByMethod["captions.list"] != 100 {
+			t.Errorf("Expected 100 caption units, got %d", snapshot.ByMethod["captions.list"])
+		}
+		if snapshot.ByMethod["videos.list"] != 1 {
+			t.Errorf("Expected 1 video unit, got %d", snapshot.ByMethod["videos.list"])
+		}
+	})
+
+	t.Run("low budget trips below the configured fraction", func(t *testing.T) {
+		tracker := youtube.NewQuotaTracker(100, 0.1, zap.NewNop())
+		tracker.Record("captions.list", 89)
+		if tracker.LowBudget() {
+			t.Error("11 of 100 units remaining should not be low at a 0.1 fraction")
+		}
+
+		tracker.Record("videos.list", 2)
+		if !tracker.LowBudget() {
+			t.Error("9 of 100 units remaining should be low at a 0.1 fraction")
+		}
+	})
+
+	t.Run("remaining never goes negative", func(t *testing.T) {
+		tracker := youtube.NewQuotaTracker(50, 0.1, zap.NewNop())
+		tracker.Record("captions.list", 200)
+
+		if got := tracker.Snapshot().Remaining; got != 0 {
+			t.Errorf("Expected remaining to floor at 0, got %d", got)
+		}
+	})
+
+	t.Run("a zero fraction disables the low-budget signal", func(t *testing.T) {
+		tracker := youtube.NewQuotaTracker(100, 0, zap.NewNop())
+		tracker.Record("captions.list", 100)
+
+		if tracker.LowBudget() {
+			t.Error("LowBudget should stay false with the signal disabled")
+		}
+	})
+}
@@ -0,0 +1,298 @@
+package importer_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/importer"
+	"app-backend/internal/services/jobs"
+	"app-backend/internal/services/transcript"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+	"app-backend/test/testutil"
+)
+
+// fakePlaylistSource serves a fixed set of playlists and channels
+type fakePlaylistSource struct {
+	playlists map[string][]string
+	uploads   map[string]string
+}
+
+func (f *fakePlaylistSource) ListPlaylistVideoIDs(_ context.Context, playlistID string, maxVideos int) ([]string, error) {
+	videoIDs, ok := f.playlists[playlistID]
+	if !ok {
+		return nil, fmt.Errorf("playlist not found: %s", playlistID)
+	}
+	if maxVideos > 0 && len(videoIDs) > maxVideos {
+		videoIDs = videoIDs[:maxVideos]
+	}
+	return videoIDs, nil
+}
+
+func (f *fakePlaylistSource) ResolveUploadsPlaylistID(_ context.Context, channelRef string) (string, error) {
+	uploadsID, ok := f.uploads[channelRef]
+	if !ok {
+		return "", fmt.Errorf("channel not found: %s", channelRef)
+	}
+	return uploadsID, nil
+}
+
+// fakeTranscriptService fails fetches for the video IDs in failing
+type fakeTranscriptService struct {
+	mu      sync.Mutex
+	fetched []string
+	failing map[string]bool
+}
+
+func (f *fakeTranscriptService) GetTranscript(_ context.Context, req *transcriptTypes.TranscriptRequest) (*transcriptTypes.Transcript, error) {
+	f.mu.Lock()
+	f.fetched = append(f.fetched, req.VideoID)
+	f.mu.Unlock()
+
+	if f.failing[req.VideoID] {
+		return nil, fmt.Errorf("no transcript for %s", req.VideoID)
+	}
+	return &transcriptTypes.Transcript{VideoID: req.VideoID, Language: req.Language}, nil
+}
+
+func (f *fakeTranscriptService) GetTranscriptWithProvider(ctx context.Context, _ transcriptTypes.ProviderType, req *transcriptTypes.TranscriptRequest) (*transcriptTypes.Transcript, error) {
+	return f.GetTranscript(ctx, req)
+}
+
+func (f *fakeTranscriptService) GetAvailableProviders(_ context.Context) []transcriptTypes.ProviderType {
+	return nil
+}
+
+func (f *fakeTranscriptService) GetAvailableLanguages(_ context.Context, _ string) ([]transcriptTypes.CaptionLanguage, error) {
+	return nil, nil
+}
+
+func (f *fakeTranscriptService) RegisterProvider(_ transcript.ProviderInterface) error {
+	return nil
+}
+
+func (f *fakeTranscriptService) InvalidateTranscriptCache(_ context.Context, _ string) error {
+	return nil
+}
+
+func (f *fakeTranscriptService) fetchedIDs() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.fetched...)
+}
+
+// newImportService wires the importer onto a job queue backed by an
+// in-memory database; a single failed attempt is permanent so tests settle
+// deterministically
+func newImportService(t *testing.T, source *fakePlaylistSource, transcripts *fakeTranscriptService, cfg importer.Config) (importer.ServiceInterface, jobs.ServiceInterface) {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	db := testutil.SetupTestDB(t, &models.User{}, &models.Job{})
+	queue := jobs.NewService(repositories.NewJobRepository(db), jobs.Config{
+		PollInterval: 10 * time.Millisecond,
+		MaxAttempts:  1,
+		RetryBackoff: 10 * time.Millisecond,
+	}, log)
+
+	// A single worker lets a full five-item batch run without waiting for
+	// the flush interval
+	if cfg.MaxWorkers == 0 {
+		cfg.MaxWorkers = 1
+	}
+
+	service := importer.NewService(source, transcripts, queue, cfg, log)
+	t.Cleanup(service.Stop)
+
+	queue.Start()
+	t.Cleanup(queue.Stop)
+
+	return service, queue
+}
+
+// awaitJob polls until the import job reaches a final state and returns its
+// decoded import result
+func awaitJob(t *testing.T, queue jobs.ServiceInterface, userID uint, jobID uint) (*models.Job, *importer.ImportResult) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := queue.GetJob(userID, jobID)
+		if err != nil {
+			t.Fatalf("GetJob failed: %v", err)
+		}
+		if job.IsTerminal() {
+			result, err := importer.ParseResult(job)
+			if err != nil {
+				t.Fatalf("ParseResult failed: %v", err)
+			}
+			return job, result
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("Timed out waiting for import job to finish")
+	return nil, nil
+}
+
+func fiveVideos(prefix string) []string {
+	videoIDs := make([]string, 5)
+	for i := range videoIDs {
+		videoIDs[i] = fmt.Sprintf("%s-video-%d", prefix, i)
+	}
+	return videoIDs
+}
+
+func TestStartImport(t *testing.T) {
+	t.Run("imports every video of a playlist URL", func(t *testing.T) {
+		source := &fakePlaylistSource{playlists: map[string][]string{"PLcourse": fiveVideos("pl")}}
+		transcripts := &fakeTranscriptService{}
+		service, queue := newImportService(t, source, transcripts, importer.Config{})
+
+		job, err := service.StartImport(context.Background(), 1, "https://www.youtube.com/playlist?list=PLcourse", "en")
+		if err != nil {
+			t.Fatalf("StartImport failed: %v", err)
+		}
+		queued, err := importer.ParseResult(job)
+		if err != nil {
+			t.Fatalf("ParseResult failed: %v", err)
+		}
+		if queued.TotalVideos != 5 {
+			t.Fatalf("Expected 5 videos, got %d", queued.TotalVideos)
+		}
+
+		done, result := awaitJob(t, queue, 1, job.ID)
+		if done.Status != models.JobStatusCompleted {
+			t.Errorf("Expected completed job, got %s (%s)", done.Status, done.LastError)
+		}
+		if result.Status != importer.JobStatusCompleted {
+			t.Errorf("Expected completed import, got %s", result.Status)
+		}
+		if result.CompletedVideos != 5 || result.FailedVideos != 0 {
+			t.Errorf("Expected 5 completed and 0 failed, got %d and %d", result.CompletedVideos, result.FailedVideos)
+		}
+		if fetched := transcripts.fetchedIDs(); len(fetched) != 5 {
+			t.Errorf("Expected 5 transcript fetches, got %d", len(fetched))
+		}
+	})
+
+	t.Run("resolves a channel handle to its uploads playlist", func(t *testing.T) {
+		source := &fakePlaylistSource{
+			playlists: map[string][]string{"UUuploads": fiveVideos("ch")},
+			uploads:   map[string]string{"@teacher": "UUuploads"},
+		}
+		service, queue := newImportService(t, source, &fakeTranscriptService{}, importer.Config{})
+
+		job, err := service.StartImport(context.Background(), 1, "https://www.youtube.com/@teacher", "")
+		if err != nil {
+			t.Fatalf("StartImport failed: %v", err)
+		}
+
+		done, result := awaitJob(t, queue, 1, job.ID)
+		if result.PlaylistID != "UUuploads" {
+			t.Errorf("Expected uploads playlist UUuploads, got %s", result.PlaylistID)
+		}
+		if done.Status != models.JobStatusCompleted {
+			t.Errorf("Expected completed job, got %s", done.Status)
+		}
+	})
+
+	t.Run("failed videos are counted and reported per video", func(t *testing.T) {
+		videoIDs := fiveVideos("mix")
+		source := &fakePlaylistSource{playlists: map[string][]string{"PLmixed": videoIDs}}
+		transcripts := &fakeTranscriptService{failing: map[string]bool{videoIDs[1]: true, videoIDs[3]: true}}
+		service, queue := newImportService(t, source, transcripts, importer.Config{})
+
+		job, err := service.StartImport(context.Background(), 1, "https://www.youtube.com/playlist?list=PLmixed", "en")
+		if err != nil {
+			t.Fatalf("StartImport failed: %v", err)
+		}
+
+		done, result := awaitJob(t, queue, 1, job.ID)
+		if done.Status != models.JobStatusCompleted {
+			t.Errorf("Expected completed job, got %s (%s)", done.Status, done.LastError)
+		}
+		if result.Status != importer.JobStatusCompletedWithErrors {
+			t.Errorf("Expected completed_with_errors, got %s", result.Status)
+		}
+		if result.CompletedVideos != 3 || result.FailedVideos != 2 {
+			t.Errorf("Expected 3 completed and 2 failed, got %d and %d", result.CompletedVideos, result.FailedVideos)
+		}
+		for _, video := range result.Videos {
+			wantFailed := transcripts.failing[video.VideoID]
+			if wantFailed && (video.Status != importer.VideoStatusFailed || video.Error == "") {
+				t.Errorf("Expected video %s to fail with an error, got %q %q", video.VideoID, video.Status, video.Error)
+			}
+			if !wantFailed && video.Status != importer.VideoStatusCompleted {
+				t.Errorf("Expected video %s to complete, got %s", video.VideoID, video.Status)
+			}
+		}
+	})
+
+	t.Run("fails the job for retry when no transcript can be fetched", func(t *testing.T) {
+		videoIDs := fiveVideos("down")
+		failing := make(map[string]bool, len(videoIDs))
+		for _, videoID := range videoIDs {
+			failing[videoID] = true
+		}
+		source := &fakePlaylistSource{playlists: map[string][]string{"PLdown": videoIDs}}
+		service, queue := newImportService(t, source, &fakeTranscriptService{failing: failing}, importer.Config{})
+
+		job, err := service.StartImport(context.Background(), 1, "https://www.youtube.com/playlist?list=PLdown", "")
+		if err != nil {
+			t.Fatalf("StartImport failed: %v", err)
+		}
+
+		done, result := awaitJob(t, queue, 1, job.ID)
+		if done.Status != models.JobStatusFailed {
+			t.Errorf("Expected failed job, got %s", done.Status)
+		}
+		if !strings.Contains(done.LastError, "transcript fetches failed") {
+			t.Errorf("Expected last_error to mention failed fetches, got %q", done.LastError)
+		}
+		if result.FailedVideos != 5 {
+			t.Errorf("Expected 5 failed videos in the result, got %d", result.FailedVideos)
+		}
+	})
+
+	t.Run("caps enumeration at the configured maximum", func(t *testing.T) {
+		source := &fakePlaylistSource{playlists: map[string][]string{"PLbig": fiveVideos("big")}}
+		service, _ := newImportService(t, source, &fakeTranscriptService{}, importer.Config{MaxVideos: 2})
+
+		job, err := service.StartImport(context.Background(), 1, "https://www.youtube.com/playlist?list=PLbig", "")
+		if err != nil {
+			t.Fatalf("StartImport failed: %v", err)
+		}
+		result, err := importer.ParseResult(job)
+		if err != nil {
+			t.Fatalf("ParseResult failed: %v", err)
+		}
+		if result.TotalVideos != 2 {
+			t.Errorf("Expected import capped at 2 videos, got %d", result.TotalVideos)
+		}
+	})
+
+	t.Run("rejects unsupported URLs", func(t *testing.T) {
+		service, _ := newImportService(t, &fakePlaylistSource{}, &fakeTranscriptService{}, importer.Config{})
+
+		_, err := service.StartImport(context.Background(), 1, "https://example.com/watch?v=abc", "")
+		appErr, ok := err.(*errors.AppError)
+		if !ok {
+			t.Fatalf("Expected AppError, got %v", err)
+		}
+		if appErr.Status != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", appErr.Status)
+		}
+	})
+}
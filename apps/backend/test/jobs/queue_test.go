@@ -0,0 +1,213 @@
+package jobs_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/jobs"
+	"app-backend/test/testutil"
+)
+
+// newQueue builds a queue against an in-memory database with a fast poll
+// interval; handlers must be registered before startQueue
+func newQueue(t *testing.T, cfg jobs.Config) (jobs.ServiceInterface, repositories.JobRepositoryInterface) {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t, &models.User{}, &models.Job{})
+	repo := repositories.NewJobRepository(db)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = 10 * time.Millisecond
+	}
+	if cfg.RetryBackoff == 0 {
+		cfg.RetryBackoff = 10 * time.Millisecond
+	}
+
+	return jobs.NewService(repo, cfg, log), repo
+}
+
+// startQueue launches the queue and stops it when the test finishes
+func startQueue(t *testing.T, queue jobs.ServiceInterface) {
+	t.Helper()
+	queue.Start()
+	t.Cleanup(queue.Stop)
+}
+
+// awaitTerminal polls until the job reaches a final state
+func awaitTerminal(t *testing.T, queue jobs.ServiceInterface, userID, jobID uint) *models.Job {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := queue.GetJob(userID, jobID)
+		if err != nil {
+			t.Fatalf("GetJob failed: %v", err)
+		}
+		if job.IsTerminal() {
+			return job
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("Timed out waiting for job to finish")
+	return nil
+}
+
+func TestQueue(t *testing.T) {
+	t.Run("completes a job and stores its result", func(t *testing.T) {
+		queue, _ := newQueue(t, jobs.Config{})
+		queue.RegisterHandler("echo", func(_ context.Context, job *models.Job) (string, error) {
+			return job.Payload, nil
+		})
+		startQueue(t, queue)
+
+		job, err := queue.Enqueue(1, "echo", `{"hello":"world"}`)
+		if err != nil {
+			t.Fatalf("Enqueue failed: %v", err)
+		}
+		if job.Status != models.JobStatusQueued {
+			t.Errorf("Expected queued job, got %s", job.Status)
+		}
+
+		done := awaitTerminal(t, queue, 1, job.ID)
+		if done.Status != models.JobStatusCompleted {
+			t.Fatalf("Expected completed job, got %s (%s)", done.Status, done.LastError)
+		}
+		if done.Result != `{"hello":"world"}` {
+			t.Errorf("Expected payload echoed as result, got %q", done.Result)
+		}
+		if done.Attempts != 1 {
+			t.Errorf("Expected 1 attempt, got %d", done.Attempts)
+		}
+		if done.FinishedAt == nil {
+			t.Error("Expected finished_at to be set")
+		}
+	})
+
+	t.Run("retries failed attempts until one succeeds", func(t *testing.T) {
+		queue, _ := newQueue(t, jobs.Config{MaxAttempts: 3})
+		var attempts atomic.Int32
+		queue.RegisterHandler("flaky", func(_ context.Context, _ *models.Job) (string, error) {
+			if attempts.Add(1) < 3 {
+				return "", fmt.Errorf("transient failure")
+			}
+			return `{"ok":true}`, nil
+		})
+		startQueue(t, queue)
+
+		job, err := queue.Enqueue(1, "flaky", "{}")
+		if err != nil {
+			t.Fatalf("Enqueue failed: %v", err)
+		}
+
+		done := awaitTerminal(t, queue, 1, job.ID)
+		if done.Status != models.JobStatusCompleted {
+			t.Fatalf("Expected completed job, got %s (%s)", done.Status, done.LastError)
+		}
+		if done.Attempts != 3 {
+			t.Errorf("Expected 3 attempts, got %d", done.Attempts)
+		}
+	})
+
+	t.Run("fails permanently once attempts are exhausted", func(t *testing.T) {
+		queue, _ := newQueue(t, jobs.Config{MaxAttempts: 2})
+		queue.RegisterHandler("doomed", func(_ context.Context, _ *models.Job) (string, error) {
+			return "", fmt.Errorf("always broken")
+		})
+		startQueue(t, queue)
+
+		job, err := queue.Enqueue(1, "doomed", "{}")
+		if err != nil {
+			t.Fatalf("Enqueue failed: %v", err)
+		}
+
+		done := awaitTerminal(t, queue, 1, job.ID)
+		if done.Status != models.JobStatusFailed {
+			t.Fatalf("Expected failed job, got %s", done.Status)
+		}
+		if done.Attempts != 2 {
+			t.Errorf("Expected 2 attempts, got %d", done.Attempts)
+		}
+		if done.LastError == "" {
+			t.Error("Expected last_error to record the failure")
+		}
+	})
+
+	t.Run("jobs of an unregistered type fail without retries", func(t *testing.T) {
+		queue, _ := newQueue(t, jobs.Config{})
+		startQueue(t, queue)
+
+		job, err := queue.Enqueue(1, "unknown", "{}")
+		if err != nil {
+			t.Fatalf("Enqueue failed: %v", err)
+		}
+
+		done := awaitTerminal(t, queue, 1, job.ID)
+		if done.Status != models.JobStatusFailed {
+			t.Fatalf("Expected failed job, got %s", done.Status)
+		}
+		if done.Attempts != 1 {
+			t.Errorf("Expected 1 attempt, got %d", done.Attempts)
+		}
+	})
+
+	t.Run("requeues jobs left running by a previous shutdown", func(t *testing.T) {
+		queue, repo := newQueue(t, jobs.Config{})
+		queue.RegisterHandler("resume", func(_ context.Context, _ *models.Job) (string, error) {
+			return `{"resumed":true}`, nil
+		})
+
+		// Simulate a job interrupted mid-run before this process started
+		interrupted := &models.Job{
+			UserID:      1,
+			Type:        "resume",
+			Status:      models.JobStatusRunning,
+			Payload:     "{}",
+			MaxAttempts: 3,
+			NextRunAt:   time.Now().UTC(),
+		}
+		if err := repo.Create(interrupted); err != nil {
+			t.Fatalf("Failed to seed running job: %v", err)
+		}
+
+		startQueue(t, queue)
+
+		done := awaitTerminal(t, queue, 1, interrupted.ID)
+		if done.Status != models.JobStatusCompleted {
+			t.Errorf("Expected interrupted job to complete after restart, got %s", done.Status)
+		}
+	})
+}
+
+func TestGetJob(t *testing.T) {
+	t.Run("hides other users' jobs", func(t *testing.T) {
+		queue, _ := newQueue(t, jobs.Config{})
+		startQueue(t, queue)
+
+		job, err := queue.Enqueue(1, "unknown", "{}")
+		if err != nil {
+			t.Fatalf("Enqueue failed: %v", err)
+		}
+
+		_, err = queue.GetJob(2, job.ID)
+		appErr, ok := err.(*errors.AppError)
+		if !ok {
+			t.Fatalf("Expected AppError, got %v", err)
+		}
+		if appErr.Status != http.StatusNotFound {
+			t.Errorf("Expected status 404, got %d", appErr.Status)
+		}
+	})
+}
@@ -0,0 +1,149 @@
+package keyphrase_test
+
+import (
+	"testing"
+	"time"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	"app-backend/internal/services/keyphrase"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+)
+
+func newKeyphraseService(t *testing.T) keyphrase.ServiceInterface {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return keyphrase.NewService(log)
+}
+
+func segments(texts ...string) []transcriptTypes.TranscriptSegment {
+	result := make([]transcriptTypes.TranscriptSegment, len(texts))
+	for i, text := range texts {
+		result[i] = transcriptTypes.TranscriptSegment{
+			Text:     text,
+			Start:    time.Duration(i) * 10 * time.Second,
+			Duration: 10 * time.Second,
+		}
+	}
+	return result
+}
+
+func findLemma(phrases []dto.KeyPhraseCandidate, lemma string) *dto.KeyPhraseCandidate {
+	for i := range phrases {
+		if phrases[i].Lemma == lemma {
+			return &phrases[i]
+		}
+	}
+	return nil
+}
+
+func TestExtract(t *testing.T) {
+	service := newKeyphraseService(t)
+
+	t.Run("rare repeated words outrank common ones", func(t *testing.T) {
+		phrases := service.Extract(segments(
+			"The mitochondria produce energy",
+			"Every cell needs mitochondria",
+			"Mitochondria are small",
+		), 10)
+
+		if len(phrases) == 0 {
+			t.Fatal("Expected extracted phrases")
+		}
+		if phrases[0].Lemma != "mitochondria" {
+			t.Errorf("Expected 'mitochondria' first, got %q", phrases[0].Lemma)
+		}
+		if phrases[0].Count != 3 {
+			t.Errorf("Expected 3 occurrences, got %d", phrases[0].Count)
+		}
+
+		// "energy" is in the everyday ranking, so even at equal counts it
+		// must score below an unlisted word
+		if energy := findLemma(phrases, "energy"); energy != nil && energy.Score >= phrases[0].Score {
+			t.Errorf("Expected 'energy' to score below 'mitochondria', got %f >= %f", energy.Score, phrases[0].Score)
+		}
+	})
+
+	t.Run("stopwords never surface", func(t *testing.T) {
+		phrases := service.Extract(segments("the the the and and a a a something"), 10)
+
+		for _, phrase := range phrases {
+			if phrase.Lemma == "the" || phrase.Lemma == "and" {
+				t.Errorf("Stopword %q surfaced as a candidate", phrase.Lemma)
+			}
+		}
+	})
+
+	t.Run("inflected forms are grouped under one lemma", func(t *testing.T) {
+		phrases := service.Extract(segments(
+			"She negotiates every deal",
+			"They were negotiating all night",
+			"He negotiated a discount",
+		), 10)
+
+		candidate := findLemma(phrases, "negotiate")
+		if candidate == nil {
+			t.Fatalf("Expected a 'negotiate' candidate, got %+v", phrases)
+		}
+		if candidate.Count != 3 {
+			t.Errorf("Expected all 3 inflections grouped, got count %d", candidate.Count)
+		}
+	})
+
+	t.Run("recurring word pairs surface as collocations", func(t *testing.T) {
+		phrases := service.Extract(segments(
+			"You need to strike a balance here",
+			"It is hard to strike a balance",
+			"Again we strike a balance",
+		), 20)
+
+		candidate := findLemma(phrases, "strike balance")
+		if candidate == nil {
+			t.Fatalf("Expected a 'strike balance' collocation, got %+v", phrases)
+		}
+		if candidate.Count != 3 {
+			t.Errorf("Expected 3 occurrences of the collocation, got %d", candidate.Count)
+		}
+	})
+
+	t.Run("a pair seen once is not a collocation", func(t *testing.T) {
+		phrases := service.Extract(segments("A peculiar coincidence happened yesterday"), 20)
+
+		if candidate := findLemma(phrases, "peculiar coincidence"); candidate != nil {
+			t.Errorf("A one-off pair must not surface as a collocation: %+v", candidate)
+		}
+	})
+
+	t.Run("candidates carry their first occurrence", func(t *testing.T) {
+		phrases := service.Extract(segments(
+			"Nothing special here",
+			"The serendipity of it all",
+			"Pure serendipity again",
+		), 10)
+
+		candidate := findLemma(phrases, "serendipity")
+		if candidate == nil {
+			t.Fatal("Expected a 'serendipity' candidate")
+		}
+		if candidate.TimestampSeconds != 10 {
+			t.Errorf("Expected the first occurrence at 10s, got %f", candidate.TimestampSeconds)
+		}
+		if candidate.ExampleSentence != "The serendipity of it all" {
+			t.Errorf("Unexpected example sentence: %q", candidate.ExampleSentence)
+		}
+	})
+
+	t.Run("the limit caps the result count", func(t *testing.T) {
+		phrases := service.Extract(segments(
+			"Quantum entanglement defies classical intuition completely",
+		), 2)
+
+		if len(phrases) > 2 {
+			t.Errorf("Expected at most 2 candidates, got %d", len(phrases))
+		}
+	})
+}
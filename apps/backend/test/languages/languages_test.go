@@ -0,0 +1,134 @@
+package languages_test
+
+import (
+	"net/http"
+	"testing"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/services/languages"
+)
+
+func TestNormalize(t *testing.T) {
+	service := languages.NewService()
+
+	t.Run("canonical codes pass through", func(t *testing.T) {
+		for _, code := range []string{"en", "pt-BR", "zh-TW"} {
+			normalized, err := service.Normalize(code)
+			if err != nil {
+				t.Fatalf("Normalize(%q) failed: %v", code, err)
+			}
+			if normalized != code {
+				t.Errorf("Normalize(%q) = %q, want unchanged", code, normalized)
+			}
+		}
+	})
+
+	t.Run("casing is normalized to canonical BCP-47", func(t *testing.T) {
+		normalized, err := service.Normalize("PT-br")
+		if err != nil {
+			t.Fatalf("Normalize failed: %v", err)
+		}
+		if normalized != "pt-BR" {
+			t.Errorf("Expected pt-BR, got %q", normalized)
+		}
+	})
+
+	t.Run("regional variants fall back to the base language", func(t *testing.T) {
+		normalized, err := service.Normalize("pt-PT")
+		if err != nil {
+			t.Fatalf("Normalize failed: %v", err)
+		}
+		if normalized != "pt" {
+			t.Errorf("Expected pt, got %q", normalized)
+		}
+	})
+
+	t.Run("malformed codes are rejected with a 400", func(t *testing.T) {
+		_, err := service.Normalize("not a code")
+		appErr, ok := err.(*errors.AppError)
+		if !ok {
+			t.Fatalf("Expected *errors.AppError, got %T", err)
+		}
+		if appErr.Status != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", appErr.Status)
+		}
+	})
+
+	t.Run("valid but unsupported languages are rejected", func(t *testing.T) {
+		_, err := service.Normalize("sw")
+		appErr, ok := err.(*errors.AppError)
+		if !ok {
+			t.Fatalf("Expected *errors.AppError, got %T", err)
+		}
+		if appErr.Status != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", appErr.Status)
+		}
+	})
+}
+
+func TestCanonicalize(t *testing.T) {
+	service := languages.NewService()
+
+	// Canonicalize only checks well-formedness, so languages outside the
+	// supported set are fine
+	canonical, err := service.Canonicalize("SW")
+	if err != nil {
+		t.Fatalf("Canonicalize failed: %v", err)
+	}
+	if canonical != "sw" {
+		t.Errorf("Expected sw, got %q", canonical)
+	}
+
+	if _, err := service.Canonicalize("!!"); err == nil {
+		t.Error("Expected malformed code to be rejected")
+	}
+}
+
+func TestLanguageMetadata(t *testing.T) {
+	service := languages.NewService()
+
+	t.Run("RTL languages are flagged", func(t *testing.T) {
+		for _, code := range []string{"ar", "he", "fa"} {
+			info, err := service.Info(code)
+			if err != nil {
+				t.Fatalf("Info(%q) failed: %v", code, err)
+			}
+			if !info.RTL {
+				t.Errorf("Expected %q to be flagged RTL", code)
+			}
+		}
+
+		info, err := service.Info("en")
+		if err != nil {
+			t.Fatalf("Info failed: %v", err)
+		}
+		if info.RTL {
+			t.Error("English must not be flagged RTL")
+		}
+	})
+
+	t.Run("native names come from CLDR", func(t *testing.T) {
+		info, err := service.Info("ja")
+		if err != nil {
+			t.Fatalf("Info failed: %v", err)
+		}
+		if info.Name != "Japanese" {
+			t.Errorf("Expected English name Japanese, got %q", info.Name)
+		}
+		if info.NativeName != "日本語" {
+			t.Errorf("Expected native name 日本語, got %q", info.NativeName)
+		}
+	})
+
+	t.Run("supported list carries metadata for every entry", func(t *testing.T) {
+		supported := service.Supported()
+		if len(supported) == 0 {
+			t.Fatal("Expected a non-empty supported language list")
+		}
+		for _, lang := range supported {
+			if lang.Code == "" || lang.Name == "" || lang.NativeName == "" {
+				t.Errorf("Incomplete metadata for %+v", lang)
+			}
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newBodyLimitRouter(t *testing.T, defaultBytes int64, overrides map[string]int64) *gin.Engine {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	router := gin.New()
+	router.Use(middleware.ErrorHandler(log))
+	router.Use(middleware.BodyLimit(defaultBytes, overrides))
+	echo := func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "body too large"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"bytes": len(body)})
+	}
+	router.POST("/api/v1/small", echo)
+	router.POST("/api/v1/bulk/upload", echo)
+	return router
+}
+
+func postBody(router *gin.Engine, path string, size int) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(strings.Repeat("a", size)))
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestBodyLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("bodies under the limit pass through", func(t *testing.T) {
+		router := newBodyLimitRouter(t, 64, nil)
+
+		if recorder := postBody(router, "/api/v1/small", 32); recorder.Code != http.StatusOK {
+			t.Errorf("Expected 200 for a body under the limit, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("oversized bodies are rejected with a 413 AppError", func(t *testing.T) {
+		router := newBodyLimitRouter(t, 64, nil)
+
+		recorder := postBody(router, "/api/v1/small", 128)
+		if recorder.Code != http.StatusRequestEntityTooLarge {
+			t.Fatalf("Expected 413, got %d", recorder.Code)
+		}
+		if !strings.Contains(recorder.Body.String(), "Request body too large") {
+			t.Errorf("Expected the standard error shape, got %q", recorder.Body.String())
+		}
+	})
+
+	t.Run("route overrides use the longest matching prefix", func(t *testing.T) {
+		router := newBodyLimitRouter(t, 64, map[string]int64{"/api/v1/bulk": 1024})
+
+		if recorder := postBody(router, "/api/v1/bulk/upload", 512); recorder.Code != http.StatusOK {
+			t.Errorf("Expected the bulk override to admit 512 bytes, got %d", recorder.Code)
+		}
+		if recorder := postBody(router, "/api/v1/small", 512); recorder.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("Expected the default limit to reject 512 bytes, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("a non-positive limit disables the cap", func(t *testing.T) {
+		router := newBodyLimitRouter(t, 0, nil)
+
+		if recorder := postBody(router, "/api/v1/small", 1<<16); recorder.Code != http.StatusOK {
+			t.Errorf("Expected no cap with a zero limit, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("undeclared lengths are capped while reading", func(t *testing.T) {
+		router := newBodyLimitRouter(t, 64, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/small", strings.NewReader(strings.Repeat("a", 128)))
+		// Hide the length so only the read-time backstop can catch it
+		req.ContentLength = -1
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		if recorder.Code == http.StatusOK {
+			t.Error("Expected the read-time cap to reject an oversized chunked body")
+		}
+	})
+}
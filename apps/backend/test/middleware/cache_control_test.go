@@ -0,0 +1,71 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"app-backend/internal/config"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newCacheControlRouter(cfg *config.Config) *gin.Engine {
+	router := gin.New()
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/static", middleware.CacheControl(cfg, "static"), ok)
+	router.GET("/dynamic", middleware.CacheControl(cfg, "dynamic"), ok)
+	router.GET("/auth", middleware.CacheControl(cfg, "auth"), ok)
+	return router
+}
+
+func cacheControlHeader(router *gin.Engine, path string) string {
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	return recorder.Header().Get("Cache-Control")
+}
+
+func TestCacheControl(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("default values per endpoint type", func(t *testing.T) {
+		router := newCacheControlRouter(&config.Config{})
+
+		if got := cacheControlHeader(router, "/static"); got != "public, max-age=86400" {
+			t.Errorf("Unexpected static Cache-Control: %q", got)
+		}
+		if got := cacheControlHeader(router, "/dynamic"); got != "no-cache" {
+			t.Errorf("Unexpected dynamic Cache-Control: %q", got)
+		}
+		if got := cacheControlHeader(router, "/auth"); got != "no-store" {
+			t.Errorf("Unexpected auth Cache-Control: %q", got)
+		}
+	})
+
+	t.Run("configured values override the defaults", func(t *testing.T) {
+		cfg := &config.Config{CacheControl: config.CacheControlConfig{
+			"static": "public, max-age=300",
+		}}
+		router := newCacheControlRouter(cfg)
+
+		if got := cacheControlHeader(router, "/static"); got != "public, max-age=300" {
+			t.Errorf("Unexpected static Cache-Control: %q", got)
+		}
+		// Types absent from the config keep their defaults
+		if got := cacheControlHeader(router, "/auth"); got != "no-store" {
+			t.Errorf("Unexpected auth Cache-Control: %q", got)
+		}
+	})
+
+	t.Run("empty configured value sends no header", func(t *testing.T) {
+		cfg := &config.Config{CacheControl: config.CacheControlConfig{
+			"dynamic": "",
+		}}
+		router := newCacheControlRouter(cfg)
+
+		if got := cacheControlHeader(router, "/dynamic"); got != "" {
+			t.Errorf("Expected no Cache-Control header, got %q", got)
+		}
+	})
+}
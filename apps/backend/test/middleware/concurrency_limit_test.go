@@ -0,0 +1,133 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newConcurrencyRouter serves a slow request that signals on started and
+// holds its slot until release is closed, with a health endpoint alongside
+func newConcurrencyRouter(max int, maxWait time.Duration, started chan<- struct{}, release <-chan struct{}) *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.ConcurrencyLimit(max, maxWait))
+	router.GET("/slow", func(c *gin.Context) {
+		started <- struct{}{}
+		<-release
+		c.Status(http.StatusOK)
+	})
+	router.GET("/health", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func occupySlot(wg *sync.WaitGroup, router *gin.Engine) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/slow", nil))
+	}()
+}
+
+func requestPath(router *gin.Engine, path string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	return recorder
+}
+
+func TestConcurrencyLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("rejects requests over the cap with 503 and Retry-After", func(t *testing.T) {
+		started := make(chan struct{}, 3)
+		release := make(chan struct{})
+		router := newConcurrencyRouter(2, 0, started, release)
+
+		var wg sync.WaitGroup
+		occupySlot(&wg, router)
+		occupySlot(&wg, router)
+		<-started
+		<-started
+
+		recorder := requestPath(router, "/slow")
+		if recorder.Code != http.StatusServiceUnavailable {
+			t.Errorf("Expected status 503 over the cap, got %d", recorder.Code)
+		}
+		if recorder.Header().Get("Retry-After") == "" {
+			t.Error("Expected a Retry-After header on the 503 response")
+		}
+
+		close(release)
+		wg.Wait()
+
+		if recorder := requestPath(router, "/slow"); recorder.Code != http.StatusOK {
+			t.Errorf("Expected status 200 once slots free up, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("health stays reachable while saturated", func(t *testing.T) {
+		started := make(chan struct{}, 1)
+		release := make(chan struct{})
+		router := newConcurrencyRouter(1, 0, started, release)
+
+		var wg sync.WaitGroup
+		occupySlot(&wg, router)
+		<-started
+
+		if recorder := requestPath(router, "/health"); recorder.Code != http.StatusOK {
+			t.Errorf("Expected health to respond 200 while saturated, got %d", recorder.Code)
+		}
+
+		close(release)
+		wg.Wait()
+	})
+
+	t.Run("queued request is admitted when a slot frees within maxWait", func(t *testing.T) {
+		started := make(chan struct{}, 2)
+		release := make(chan struct{})
+		router := newConcurrencyRouter(1, 2*time.Second, started, release)
+
+		var wg sync.WaitGroup
+		occupySlot(&wg, router)
+		<-started
+
+		codes := make(chan int, 1)
+		go func() {
+			codes <- requestPath(router, "/slow").Code
+		}()
+
+		// Give the second request time to start queueing, then free the slot
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+
+		select {
+		case code := <-codes:
+			if code != http.StatusOK {
+				t.Errorf("Expected the queued request to succeed, got %d", code)
+			}
+		case <-time.After(3 * time.Second):
+			t.Error("Expected the queued request to complete")
+		}
+		wg.Wait()
+	})
+
+	t.Run("non-positive cap disables the limit", func(t *testing.T) {
+		router := gin.New()
+		router.Use(middleware.ConcurrencyLimit(0, 0))
+		router.GET("/ok", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		if recorder := requestPath(router, "/ok"); recorder.Code != http.StatusOK {
+			t.Errorf("Expected status 200 with the limit disabled, got %d", recorder.Code)
+		}
+	})
+}
@@ -0,0 +1,162 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"app-backend/internal/config"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+const extensionID = "abcdefghijklmnopabcdefghijklmnop"
+
+func newCORSRouter(cfg *config.Config) *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.CORS(cfg))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/api/v1/video/info", ok)
+	router.GET("/api/v1/user/profile", ok)
+	return router
+}
+
+func corsConfig() *config.Config {
+	return &config.Config{
+		App: config.AppConfig{Environment: "production"},
+		CORS: config.CORSConfig{
+			AllowedOrigins: []string{"https://app.example.com"},
+			ExtensionIDs:   []string{extensionID},
+		},
+	}
+}
+
+// getWithOrigin performs a simple cross-origin GET and returns the recorder
+func getWithOrigin(router *gin.Engine, path, origin string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, path, nil)
+	request.Header.Set("Origin", origin)
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestCORS(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("allowed origins are echoed back", func(t *testing.T) {
+		router := newCORSRouter(corsConfig())
+
+		recorder := getWithOrigin(router, "/api/v1/user/profile", "https://app.example.com")
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected 200 for an allowed origin, got %d", recorder.Code)
+		}
+		if got := recorder.Header().Get("Access-Control-Allow-Origin"); got != "https://app.example.com" {
+			t.Errorf("Expected the origin to be echoed, got %q", got)
+		}
+	})
+
+	t.Run("unknown origins are rejected", func(t *testing.T) {
+		router := newCORSRouter(corsConfig())
+
+		recorder := getWithOrigin(router, "/api/v1/user/profile", "https://evil.example.com")
+		if recorder.Code != http.StatusForbidden {
+			t.Errorf("Expected 403 for an unknown origin, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("allowlisted extension origins are accepted", func(t *testing.T) {
+		router := newCORSRouter(corsConfig())
+
+		recorder := getWithOrigin(router, "/api/v1/user/profile", "chrome-extension://"+extensionID)
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected 200 for an allowlisted extension, got %d", recorder.Code)
+		}
+		if got := recorder.Header().Get("Access-Control-Allow-Origin"); got != "chrome-extension://"+extensionID {
+			t.Errorf("Expected the extension origin to be echoed, got %q", got)
+		}
+	})
+
+	t.Run("other extensions are rejected", func(t *testing.T) {
+		router := newCORSRouter(corsConfig())
+
+		recorder := getWithOrigin(router, "/api/v1/user/profile", "chrome-extension://ponmlkjihgfedcbaponmlkjihgfedcba")
+		if recorder.Code != http.StatusForbidden {
+			t.Errorf("Expected 403 for an extension off the allowlist, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("extension IDs in the origin list do not count", func(t *testing.T) {
+		cfg := corsConfig()
+		cfg.CORS.ExtensionIDs = nil
+		cfg.CORS.AllowedOrigins = append(cfg.CORS.AllowedOrigins, "chrome-extension://"+extensionID)
+		router := newCORSRouter(cfg)
+
+		recorder := getWithOrigin(router, "/api/v1/user/profile", "chrome-extension://"+extensionID)
+		if recorder.Code != http.StatusForbidden {
+			t.Errorf("Expected extension origins to require the allowlist, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("a group can open its prefix to any origin", func(t *testing.T) {
+		cfg := corsConfig()
+		cfg.CORS.Groups = map[string]config.CORSGroupConfig{
+			"/api/v1/video": {AllowAllOrigins: true},
+		}
+		router := newCORSRouter(cfg)
+
+		if code := getWithOrigin(router, "/api/v1/video/info", "https://evil.example.com").Code; code != http.StatusOK {
+			t.Errorf("Expected the public group to accept any origin, got %d", code)
+		}
+		// The same origin stays rejected outside the group
+		if code := getWithOrigin(router, "/api/v1/user/profile", "https://evil.example.com").Code; code != http.StatusForbidden {
+			t.Errorf("Expected the global policy outside the group, got %d", code)
+		}
+	})
+
+	t.Run("a group can replace the origin list", func(t *testing.T) {
+		cfg := corsConfig()
+		cfg.CORS.Groups = map[string]config.CORSGroupConfig{
+			"/api/v1/user": {AllowedOrigins: []string{"https://admin.example.com"}},
+		}
+		router := newCORSRouter(cfg)
+
+		if code := getWithOrigin(router, "/api/v1/user/profile", "https://admin.example.com").Code; code != http.StatusOK {
+			t.Errorf("Expected the group origin to be accepted, got %d", code)
+		}
+		if code := getWithOrigin(router, "/api/v1/user/profile", "https://app.example.com").Code; code != http.StatusForbidden {
+			t.Errorf("Expected the group to replace the global origins, got %d", code)
+		}
+		// The extension allowlist still applies inside the group
+		if code := getWithOrigin(router, "/api/v1/user/profile", "chrome-extension://"+extensionID).Code; code != http.StatusOK {
+			t.Errorf("Expected the extension allowlist inside the group, got %d", code)
+		}
+	})
+
+	t.Run("preflight requests are answered", func(t *testing.T) {
+		router := newCORSRouter(corsConfig())
+
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodOptions, "/api/v1/user/profile", nil)
+		request.Header.Set("Origin", "https://app.example.com")
+		request.Header.Set("Access-Control-Request-Method", "GET")
+		router.ServeHTTP(recorder, request)
+
+		if recorder.Code != http.StatusNoContent {
+			t.Fatalf("Expected 204 for a preflight, got %d", recorder.Code)
+		}
+		if recorder.Header().Get("Access-Control-Allow-Methods") == "" {
+			t.Errorf("Expected allowed methods on the preflight response")
+		}
+	})
+
+	t.Run("development allows every origin", func(t *testing.T) {
+		cfg := corsConfig()
+		cfg.App.Environment = "development"
+		router := newCORSRouter(cfg)
+
+		if code := getWithOrigin(router, "/api/v1/user/profile", "https://evil.example.com").Code; code != http.StatusOK {
+			t.Errorf("Expected development to allow any origin, got %d", code)
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"app-backend/internal/config"
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newFeatureGateRouter(cfg *config.Config) *gin.Engine {
+	router := gin.New()
+	router.GET("/gated", middleware.FeatureGate(cfg, "gated"), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func TestFeatureGate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("disabled feature is gated with 404", func(t *testing.T) {
+		cfg := &config.Config{Features: config.FeaturesConfig{"gated": false}}
+		router := newFeatureGateRouter(cfg)
+
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/gated", nil))
+
+		if recorder.Code != http.StatusNotFound {
+			t.Errorf("Expected status 404 for disabled feature, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("enabled feature passes through", func(t *testing.T) {
+		cfg := &config.Config{Features: config.FeaturesConfig{"gated": true}}
+		router := newFeatureGateRouter(cfg)
+
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/gated", nil))
+
+		if recorder.Code != http.StatusOK {
+			t.Errorf("Expected status 200 for enabled feature, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("unlisted feature defaults to enabled", func(t *testing.T) {
+		router := newFeatureGateRouter(&config.Config{})
+
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/gated", nil))
+
+		if recorder.Code != http.StatusOK {
+			t.Errorf("Expected status 200 for unlisted feature, got %d", recorder.Code)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package middleware_test
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"app-backend/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newGzipRouter() *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.Gzip())
+	router.GET("/json", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": strings.Repeat("hello ", 100)})
+	})
+	router.GET("/events", func(c *gin.Context) {
+		c.Header("Content-Type", "text/event-stream")
+		c.String(http.StatusOK, "data: hello\n\n")
+	})
+	router.GET("/empty", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	return router
+}
+
+func gzipRequest(t *testing.T, router *gin.Engine, path string, acceptEncoding string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestGzip(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("compresses JSON for gzip-accepting clients", func(t *testing.T) {
+		recorder := gzipRequest(t, newGzipRouter(), "/json", "gzip, deflate")
+
+		if got := recorder.Header().Get("Content-Encoding"); got != "gzip" {
+			t.Fatalf("Expected gzip Content-Encoding, got %q", got)
+		}
+		reader, err := gzip.NewReader(recorder.Body)
+		if err != nil {
+			t.Fatalf("Response body is not valid gzip: %v", err)
+		}
+		body, err := io.ReadAll(reader)
+		if err != nil {
+			t.Fatalf("Failed to decompress body: %v", err)
+		}
+		if !strings.Contains(string(body), "hello") {
+			t.Errorf("Decompressed body lost its content: %q", body)
+		}
+	})
+
+	t.Run("clients without gzip support get plain responses", func(t *testing.T) {
+		recorder := gzipRequest(t, newGzipRouter(), "/json", "")
+
+		if got := recorder.Header().Get("Content-Encoding"); got != "" {
+			t.Errorf("Expected an uncompressed response, got Content-Encoding %q", got)
+		}
+		if !strings.Contains(recorder.Body.String(), "hello") {
+			t.Error("Expected the plain JSON body")
+		}
+	})
+
+	t.Run("event streams are never compressed", func(t *testing.T) {
+		recorder := gzipRequest(t, newGzipRouter(), "/events", "gzip")
+
+		if got := recorder.Header().Get("Content-Encoding"); got != "" {
+			t.Errorf("Expected the SSE response to stay uncompressed, got Content-Encoding %q", got)
+		}
+		if recorder.Body.String() != "data: hello\n\n" {
+			t.Errorf("SSE body was altered: %q", recorder.Body.String())
+		}
+	})
+
+	t.Run("bodyless statuses are left alone", func(t *testing.T) {
+		recorder := gzipRequest(t, newGzipRouter(), "/empty", "gzip")
+
+		if recorder.Code != http.StatusNoContent {
+			t.Fatalf("Expected 204, got %d", recorder.Code)
+		}
+		if got := recorder.Header().Get("Content-Encoding"); got != "" {
+			t.Errorf("Expected no Content-Encoding on a 204, got %q", got)
+		}
+		if recorder.Body.Len() != 0 {
+			t.Errorf("Expected an empty body, got %d bytes", recorder.Body.Len())
+		}
+	})
+}
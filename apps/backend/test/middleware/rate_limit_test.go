@@ -0,0 +1,171 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/logger"
+	"app-backend/internal/middleware"
+	"app-backend/internal/services/jwt"
+	internalTypes "app-backend/internal/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newRateLimitRouter(t *testing.T, requestsPerMinute int, burst int, userID uint, jwtSvc jwt.ServiceInterface) *gin.Engine {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	store := middleware.NewLocalRateLimitStore(requestsPerMinute, burst, log)
+	limiter := middleware.NewClientRateLimiter(store, jwtSvc, log)
+
+	router := gin.New()
+	if userID != 0 {
+		router.Use(func(c *gin.Context) {
+			internalTypes.SetUserContext(c, &internalTypes.UserContext{
+				UserID: userID,
+				Email:  "limit@example.com",
+				Role:   "user",
+			})
+			c.Next()
+		})
+	}
+	router.Use(limiter.Limit())
+	router.GET("/limited", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	router.GET("/health", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func limitedRequest(router *gin.Engine, path string, remoteAddr string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if remoteAddr != "" {
+		req.RemoteAddr = remoteAddr
+	}
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("requests over the burst are rejected with 429", func(t *testing.T) {
+		router := newRateLimitRouter(t, 1, 3, 0, nil)
+
+		for i := 0; i < 3; i++ {
+			if code := limitedRequest(router, "/limited", "10.0.0.1:1234").Code; code != http.StatusOK {
+				t.Fatalf("Expected request %d to pass, got %d", i+1, code)
+			}
+		}
+
+		recorder := limitedRequest(router, "/limited", "10.0.0.1:1234")
+		if recorder.Code != http.StatusTooManyRequests {
+			t.Errorf("Expected 429 over the limit, got %d", recorder.Code)
+		}
+		if recorder.Header().Get("Retry-After") == "" {
+			t.Error("Expected a Retry-After header on the 429 response")
+		}
+	})
+
+	t.Run("clients are limited independently by IP", func(t *testing.T) {
+		router := newRateLimitRouter(t, 1, 1, 0, nil)
+
+		if code := limitedRequest(router, "/limited", "10.0.0.1:1234").Code; code != http.StatusOK {
+			t.Fatalf("Expected the first client's request to pass, got %d", code)
+		}
+		if code := limitedRequest(router, "/limited", "10.0.0.1:1234").Code; code != http.StatusTooManyRequests {
+			t.Errorf("Expected the first client to be limited, got %d", code)
+		}
+		if code := limitedRequest(router, "/limited", "10.0.0.2:1234").Code; code != http.StatusOK {
+			t.Errorf("Expected a different client to have its own bucket, got %d", code)
+		}
+	})
+
+	t.Run("authenticated clients are keyed by user ID", func(t *testing.T) {
+		router := newRateLimitRouter(t, 1, 1, 7, nil)
+
+		if code := limitedRequest(router, "/limited", "10.0.0.1:1234").Code; code != http.StatusOK {
+			t.Fatalf("Expected the first request to pass, got %d", code)
+		}
+		// Same user from a different IP shares the bucket
+		if code := limitedRequest(router, "/limited", "10.0.0.2:1234").Code; code != http.StatusTooManyRequests {
+			t.Errorf("Expected the user's limit to follow them across IPs, got %d", code)
+		}
+	})
+
+	t.Run("users are resolved from the bearer token", func(t *testing.T) {
+		// The limiter runs before the route groups' auth middlewares, so no
+		// user context is set when it sees the request
+		cfg := &config.Config{}
+		cfg.JWT.Secret = "test-secret"
+		cfg.JWT.AccessTTLMinutes = 15
+		cfg.JWT.RefreshTTLHours = 24
+		jwtSvc := jwt.NewJWTService(cfg)
+		router := newRateLimitRouter(t, 1, 1, 0, jwtSvc)
+
+		token, err := jwtSvc.GenerateAccessToken(7, "limit@example.com", "user", 1)
+		if err != nil {
+			t.Fatalf("Failed to generate token: %v", err)
+		}
+
+		authedRequest := func(remoteAddr string) int {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/limited", nil)
+			req.RemoteAddr = remoteAddr
+			req.Header.Set("Authorization", "Bearer "+token)
+			router.ServeHTTP(recorder, req)
+			return recorder.Code
+		}
+
+		if code := authedRequest("10.0.0.1:1234"); code != http.StatusOK {
+			t.Fatalf("Expected the first request to pass, got %d", code)
+		}
+		// Same token from a different IP shares the bucket
+		if code := authedRequest("10.0.0.2:1234"); code != http.StatusTooManyRequests {
+			t.Errorf("Expected the token's limit to follow the user across IPs, got %d", code)
+		}
+		// An unauthenticated client from the second IP is keyed by IP and
+		// has its own bucket
+		if code := limitedRequest(router, "/limited", "10.0.0.2:1234").Code; code != http.StatusOK {
+			t.Errorf("Expected an anonymous client to have its own bucket, got %d", code)
+		}
+	})
+
+	t.Run("buckets refill over time", func(t *testing.T) {
+		// 1200 per minute refills a token every 50ms
+		router := newRateLimitRouter(t, 1200, 1, 0, nil)
+
+		if code := limitedRequest(router, "/limited", "10.0.0.1:1234").Code; code != http.StatusOK {
+			t.Fatalf("Expected the first request to pass, got %d", code)
+		}
+		if code := limitedRequest(router, "/limited", "10.0.0.1:1234").Code; code != http.StatusTooManyRequests {
+			t.Fatalf("Expected the second request to be limited, got %d", code)
+		}
+
+		time.Sleep(60 * time.Millisecond)
+		if code := limitedRequest(router, "/limited", "10.0.0.1:1234").Code; code != http.StatusOK {
+			t.Errorf("Expected the bucket to refill after the interval, got %d", code)
+		}
+	})
+
+	t.Run("health endpoint is exempt", func(t *testing.T) {
+		router := newRateLimitRouter(t, 1, 1, 0, nil)
+
+		for i := 0; i < 5; i++ {
+			if code := limitedRequest(router, "/health", "10.0.0.1:1234").Code; code != http.StatusOK {
+				t.Errorf("Expected health check %d to bypass the limit, got %d", i+1, code)
+			}
+		}
+	})
+}
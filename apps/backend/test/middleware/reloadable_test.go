@@ -0,0 +1,46 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/middleware"
+)
+
+func TestReloadableMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tagging := func(tag string) gin.HandlerFunc {
+		return func(c *gin.Context) {
+			c.Header("X-Config-Tag", tag)
+			c.Next()
+		}
+	}
+
+	reloadable := middleware.NewReloadable(tagging("before"))
+
+	router := gin.New()
+	router.Use(reloadable.Handler())
+	router.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	serve := func() *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	if got := serve().Header().Get("X-Config-Tag"); got != "before" {
+		t.Fatalf("Expected initial middleware to run, got tag %q", got)
+	}
+
+	// Swapping must take effect without re-registering routes
+	reloadable.Swap(tagging("after"))
+
+	if got := serve().Header().Get("X-Config-Tag"); got != "after" {
+		t.Errorf("Expected swapped middleware to run, got tag %q", got)
+	}
+}
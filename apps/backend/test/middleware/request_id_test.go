@@ -0,0 +1,122 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"app-backend/internal/middleware"
+	"app-backend/internal/requestid"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRequestIDContext(t *testing.T) {
+	t.Run("round-trips through the context", func(t *testing.T) {
+		ctx := requestid.NewContext(context.Background(), "req-123")
+
+		if got := requestid.FromContext(ctx); got != "req-123" {
+			t.Errorf("Expected req-123, got %q", got)
+		}
+	})
+
+	t.Run("empty context yields empty ID", func(t *testing.T) {
+		if got := requestid.FromContext(context.Background()); got != "" {
+			t.Errorf("Expected empty request ID, got %q", got)
+		}
+	})
+}
+
+func TestRequestIDTransport(t *testing.T) {
+	t.Run("outbound requests carry the request-ID header", func(t *testing.T) {
+		var received string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r.Header.Get(requestid.Header)
+		}))
+		defer server.Close()
+
+		client := &http.Client{Transport: requestid.NewTransport(nil)}
+
+		ctx := requestid.NewContext(context.Background(), "req-456")
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		resp.Body.Close()
+
+		if received != "req-456" {
+			t.Errorf("Expected outbound header req-456, got %q", received)
+		}
+	})
+
+	t.Run("no header is added without a request ID", func(t *testing.T) {
+		var received string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r.Header.Get(requestid.Header)
+		}))
+		defer server.Close()
+
+		client := &http.Client{Transport: requestid.NewTransport(nil)}
+
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		resp.Body.Close()
+
+		if received != "" {
+			t.Errorf("Expected no outbound header, got %q", received)
+		}
+	})
+}
+
+func TestRequestIDMiddlewarePropagation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("incoming request ID lands in the request context", func(t *testing.T) {
+		router := gin.New()
+		router.Use(middleware.RequestID())
+
+		var fromContext string
+		router.GET("/", func(c *gin.Context) {
+			fromContext = requestid.FromContext(c.Request.Context())
+			c.Status(http.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(requestid.Header, "req-789")
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		if fromContext != "req-789" {
+			t.Errorf("Expected req-789 in request context, got %q", fromContext)
+		}
+	})
+
+	t.Run("generated request ID is also propagated", func(t *testing.T) {
+		router := gin.New()
+		router.Use(middleware.RequestID())
+
+		var fromContext string
+		router.GET("/", func(c *gin.Context) {
+			fromContext = requestid.FromContext(c.Request.Context())
+			c.Status(http.StatusOK)
+		})
+
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		if fromContext == "" {
+			t.Error("Expected a generated request ID in the request context")
+		}
+		if fromContext != recorder.Header().Get(requestid.Header) {
+			t.Error("Expected the response header and context to carry the same request ID")
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"app-backend/internal/middleware"
+	internalTypes "app-backend/internal/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newStreamRouter serves a long-lived request that signals on started and
+// holds its stream slot until release is closed
+func newStreamRouter(limiter *middleware.StreamLimiter, userID uint, started chan<- struct{}, release <-chan struct{}) *gin.Engine {
+	router := gin.New()
+	if userID != 0 {
+		router.Use(func(c *gin.Context) {
+			internalTypes.SetUserContext(c, &internalTypes.UserContext{
+				UserID: userID,
+				Email:  "stream@example.com",
+				Role:   "user",
+			})
+			c.Next()
+		})
+	}
+	router.GET("/stream", limiter.Limit(), func(c *gin.Context) {
+		started <- struct{}{}
+		<-release
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func openStream(wg *sync.WaitGroup, router *gin.Engine) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/stream", nil))
+	}()
+}
+
+func requestStream(router *gin.Engine) int {
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/stream", nil))
+	return recorder.Code
+}
+
+func TestStreamLimiter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("rejects streams over the global cap with 503", func(t *testing.T) {
+		limiter := middleware.NewStreamLimiter(2, 10)
+		started := make(chan struct{}, 3)
+		release := make(chan struct{})
+		router := newStreamRouter(limiter, 0, started, release)
+
+		var wg sync.WaitGroup
+		openStream(&wg, router)
+		openStream(&wg, router)
+		<-started
+		<-started
+
+		if active := limiter.ActiveStreams(); active != 2 {
+			t.Fatalf("Expected 2 active streams, got %d", active)
+		}
+		if code := requestStream(router); code != http.StatusServiceUnavailable {
+			t.Errorf("Expected status 503 over the cap, got %d", code)
+		}
+
+		close(release)
+		wg.Wait()
+
+		if active := limiter.ActiveStreams(); active != 0 {
+			t.Errorf("Expected 0 active streams after release, got %d", active)
+		}
+		if code := requestStream(router); code != http.StatusOK {
+			t.Errorf("Expected status 200 once slots free up, got %d", code)
+		}
+	})
+
+	t.Run("caps streams per user without blocking other users", func(t *testing.T) {
+		limiter := middleware.NewStreamLimiter(10, 1)
+		started := make(chan struct{}, 1)
+		release := make(chan struct{})
+		firstUser := newStreamRouter(limiter, 1, started, release)
+
+		var wg sync.WaitGroup
+		openStream(&wg, firstUser)
+		<-started
+
+		if code := requestStream(firstUser); code != http.StatusServiceUnavailable {
+			t.Errorf("Expected status 503 for the same user over the cap, got %d", code)
+		}
+		if active := limiter.ActiveStreamsForUser(1); active != 1 {
+			t.Errorf("Expected 1 active stream for user 1, got %d", active)
+		}
+
+		otherStarted := make(chan struct{}, 1)
+		otherUser := newStreamRouter(limiter, 2, otherStarted, release)
+		openStream(&wg, otherUser)
+		select {
+		case <-otherStarted:
+		case <-time.After(time.Second):
+			t.Error("Expected another user's stream to be admitted")
+		}
+
+		close(release)
+		wg.Wait()
+	})
+}
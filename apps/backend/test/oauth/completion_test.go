@@ -0,0 +1,107 @@
+package oauth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/logger"
+	"app-backend/internal/services/oauth"
+)
+
+func newCompletionTestService(t *testing.T) oauth.ServiceInterface {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return oauth.NewYouTubeOAuthService(&config.Config{}, log)
+}
+
+func TestAwaitCompletion(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("callback resolves a waiting status request", func(t *testing.T) {
+		service := newCompletionTestService(t)
+		expiry := time.Now().Add(time.Hour)
+
+		done := make(chan struct{})
+		var result *oauth.CompletionResult
+		var awaitErr error
+
+		go func() {
+			defer close(done)
+			result, awaitErr = service.AwaitCompletion(ctx, "state-1", 2*time.Second)
+		}()
+
+		// Give the waiter a moment to register, then simulate the callback
+		time.Sleep(20 * time.Millisecond)
+		service.NotifyCompletion("state-1", oauth.CompletionResult{
+			Success:   true,
+			Message:   "YouTube authentication completed",
+			ExpiresAt: expiry,
+		})
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Waiting status request was not resolved by the callback")
+		}
+
+		if awaitErr != nil {
+			t.Fatalf("AwaitCompletion failed: %v", awaitErr)
+		}
+		if !result.Success {
+			t.Error("Expected a successful completion result")
+		}
+		if !result.ExpiresAt.Equal(expiry) {
+			t.Errorf("Expected expiry %v, got %v", expiry, result.ExpiresAt)
+		}
+	})
+
+	t.Run("completion before the waiter still resolves", func(t *testing.T) {
+		service := newCompletionTestService(t)
+
+		service.NotifyCompletion("state-2", oauth.CompletionResult{Success: true})
+
+		result, err := service.AwaitCompletion(ctx, "state-2", 100*time.Millisecond)
+		if err != nil {
+			t.Fatalf("AwaitCompletion failed: %v", err)
+		}
+		if !result.Success {
+			t.Error("Expected a successful completion result")
+		}
+	})
+
+	t.Run("timeout cleans up the waiter", func(t *testing.T) {
+		service := newCompletionTestService(t)
+
+		if _, err := service.AwaitCompletion(ctx, "state-3", 30*time.Millisecond); err == nil {
+			t.Fatal("Expected timeout error")
+		}
+
+		// A late callback must not block or panic; its result is kept for a retry
+		service.NotifyCompletion("state-3", oauth.CompletionResult{Success: true})
+
+		result, err := service.AwaitCompletion(ctx, "state-3", 100*time.Millisecond)
+		if err != nil {
+			t.Fatalf("Expected stored result after late callback, got: %v", err)
+		}
+		if !result.Success {
+			t.Error("Expected a successful completion result")
+		}
+	})
+
+	t.Run("cancelled context stops waiting", func(t *testing.T) {
+		service := newCompletionTestService(t)
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		if _, err := service.AwaitCompletion(cancelCtx, "state-4", time.Second); err == nil {
+			t.Fatal("Expected error for cancelled context")
+		}
+	})
+}
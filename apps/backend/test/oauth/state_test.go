@@ -0,0 +1,32 @@
+package oauth_test
+
+import (
+	"testing"
+)
+
+func TestStateStore(t *testing.T) {
+	t.Run("stored state validates exactly once", func(t *testing.T) {
+		service := newCompletionTestService(t)
+
+		service.StoreState("single-use-state", 42)
+
+		userID, ok := service.ValidateAndClearState("single-use-state")
+		if !ok {
+			t.Fatal("Expected stored state to validate")
+		}
+		if userID != 42 {
+			t.Errorf("Expected the initiating user's ID, got %d", userID)
+		}
+		if _, ok := service.ValidateAndClearState("single-use-state"); ok {
+			t.Error("Expected second validation of the same state to fail")
+		}
+	})
+
+	t.Run("unknown state fails validation", func(t *testing.T) {
+		service := newCompletionTestService(t)
+
+		if _, ok := service.ValidateAndClearState("never-stored"); ok {
+			t.Error("Expected unknown state to fail validation")
+		}
+	})
+}
@@ -0,0 +1,192 @@
+package oauth_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/oauth"
+	"app-backend/test/testutil"
+)
+
+func newDatabaseTokenStore(t *testing.T) (*oauth.DatabaseTokenStore, repositories.OAuthTokenRepositoryInterface) {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t, &models.User{}, &models.OAuthToken{})
+	repo := repositories.NewOAuthTokenRepository(db)
+
+	store, err := oauth.NewDatabaseTokenStore(repo, "youtube", "test-encryption-key")
+	if err != nil {
+		t.Fatalf("Failed to create token store: %v", err)
+	}
+	return store, repo
+}
+
+func testToken(accessToken string) *oauth2.Token {
+	return &oauth2.Token{
+		AccessToken:  accessToken,
+		RefreshToken: "refresh-" + accessToken,
+		TokenType:    "Bearer",
+		Expiry:       time.Now().Add(time.Hour).Truncate(time.Second),
+	}
+}
+
+func TestDatabaseTokenStore(t *testing.T) {
+	t.Run("saved tokens round-trip per user", func(t *testing.T) {
+		store, _ := newDatabaseTokenStore(t)
+
+		if err := store.Save(1, testToken("user-one-token")); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		if err := store.Save(2, testToken("user-two-token")); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+
+		loaded, err := store.Load(1)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if loaded.AccessToken != "user-one-token" {
+			t.Errorf("Expected user one's token, got %q", loaded.AccessToken)
+		}
+
+		loaded, err = store.Load(2)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if loaded.AccessToken != "user-two-token" {
+			t.Errorf("Expected user two's token, got %q", loaded.AccessToken)
+		}
+	})
+
+	t.Run("tokens are encrypted at rest", func(t *testing.T) {
+		store, repo := newDatabaseTokenStore(t)
+
+		if err := store.Save(1, testToken("super-secret-access-token")); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+
+		record, err := repo.GetByUserAndProvider(1, "youtube")
+		if err != nil {
+			t.Fatalf("Failed to read stored row: %v", err)
+		}
+		if strings.Contains(record.Ciphertext, "super-secret-access-token") {
+			t.Error("Expected the stored ciphertext not to contain the raw token")
+		}
+		if strings.Contains(record.Ciphertext, "Bearer") {
+			t.Error("Expected the stored ciphertext not to contain token JSON")
+		}
+	})
+
+	t.Run("saving again replaces the user's token", func(t *testing.T) {
+		store, _ := newDatabaseTokenStore(t)
+
+		if err := store.Save(1, testToken("first")); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		if err := store.Save(1, testToken("second")); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+
+		loaded, err := store.Load(1)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if loaded.AccessToken != "second" {
+			t.Errorf("Expected the replacement token, got %q", loaded.AccessToken)
+		}
+	})
+
+	t.Run("deleted tokens cannot be loaded and can be re-linked", func(t *testing.T) {
+		store, _ := newDatabaseTokenStore(t)
+
+		if err := store.Save(1, testToken("linked")); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		if err := store.Delete(1); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+		if _, err := store.Load(1); err == nil {
+			t.Fatal("Expected loading a deleted token to fail")
+		}
+
+		if err := store.Save(1, testToken("re-linked")); err != nil {
+			t.Fatalf("Re-linking after delete failed: %v", err)
+		}
+		loaded, err := store.Load(1)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if loaded.AccessToken != "re-linked" {
+			t.Errorf("Expected the re-linked token, got %q", loaded.AccessToken)
+		}
+	})
+
+	t.Run("anonymous users are rejected", func(t *testing.T) {
+		store, _ := newDatabaseTokenStore(t)
+
+		if err := store.Save(0, testToken("anonymous")); err == nil {
+			t.Error("Expected saving without a user to fail")
+		}
+		if _, err := store.Load(0); err == nil {
+			t.Error("Expected loading without a user to fail")
+		}
+	})
+
+	t.Run("a wrong encryption key cannot decrypt stored tokens", func(t *testing.T) {
+		db := testutil.SetupTestDB(t, &models.User{}, &models.OAuthToken{})
+		repo := repositories.NewOAuthTokenRepository(db)
+
+		store, err := oauth.NewDatabaseTokenStore(repo, "youtube", "correct-key")
+		if err != nil {
+			t.Fatalf("Failed to create token store: %v", err)
+		}
+		if err := store.Save(1, testToken("secret")); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+
+		wrongKey, err := oauth.NewDatabaseTokenStore(repo, "youtube", "wrong-key")
+		if err != nil {
+			t.Fatalf("Failed to create token store: %v", err)
+		}
+		if _, err := wrongKey.Load(1); err == nil {
+			t.Error("Expected decryption with the wrong key to fail")
+		}
+	})
+
+	t.Run("an empty encryption key is rejected", func(t *testing.T) {
+		if _, err := oauth.NewDatabaseTokenStore(nil, "youtube", ""); err == nil {
+			t.Error("Expected an empty encryption key to be rejected")
+		}
+	})
+}
+
+func TestFileTokenStore(t *testing.T) {
+	t.Run("all users share the single file token", func(t *testing.T) {
+		store := oauth.NewFileTokenStore(filepath.Join(t.TempDir(), "token.json"))
+
+		if err := store.Save(1, testToken("shared")); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+
+		loaded, err := store.Load(2)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if loaded.AccessToken != "shared" {
+			t.Errorf("Expected the shared token, got %q", loaded.AccessToken)
+		}
+
+		if err := store.Delete(1); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+		if _, err := store.Load(1); err == nil {
+			t.Error("Expected loading after delete to fail")
+		}
+	})
+}
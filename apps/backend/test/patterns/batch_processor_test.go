@@ -0,0 +1,111 @@
+package patterns_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"app-backend/pkg/patterns"
+	"go.uber.org/zap"
+)
+
+func TestAsyncBatchProcessor(t *testing.T) {
+	logger := zap.NewNop()
+
+	doubler := func(ctx context.Context, items []patterns.BatchItem[int]) ([]patterns.BatchResult[int], error) {
+		results := make([]patterns.BatchResult[int], 0, len(items))
+		for _, item := range items {
+			results = append(results, patterns.BatchResult[int]{
+				ID:   item.ID,
+				Data: item.Data * 2,
+			})
+		}
+		return results, nil
+	}
+
+	t.Run("submit and wait returns the routed result", func(t *testing.T) {
+		config := patterns.BatchProcessorConfig{
+			BatchSize:     4,
+			FlushInterval: 20 * time.Millisecond,
+			MaxWorkers:    2,
+			Logger:        logger,
+		}
+
+		processor := patterns.NewAsyncBatchProcessor(config, doubler)
+		processor.Start()
+		defer processor.Stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		result, err := processor.SubmitAndWait(ctx, patterns.BatchItem[int]{ID: "item", Data: 21})
+		if err != nil {
+			t.Fatalf("SubmitAndWait failed: %v", err)
+		}
+		if result.Error != nil {
+			t.Fatalf("Item failed: %v", result.Error)
+		}
+		if result.Data != 42 {
+			t.Errorf("Expected result 42, got %d", result.Data)
+		}
+	})
+
+	t.Run("no result is dropped under concurrent load", func(t *testing.T) {
+		numItems := 200
+
+		// A small result buffer keeps the router under pressure while the
+		// waiters race it for the pending-result map
+		config := patterns.BatchProcessorConfig{
+			BatchSize:     8,
+			FlushInterval: 10 * time.Millisecond,
+			MaxWorkers:    4,
+			InputBuffer:   numItems,
+			ResultBuffer:  4,
+			Logger:        logger,
+		}
+
+		processor := patterns.NewAsyncBatchProcessor(config, doubler)
+		processor.Start()
+		defer processor.Stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		var wg sync.WaitGroup
+		errs := make([]error, numItems)
+		values := make([]int, numItems)
+
+		for i := 0; i < numItems; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				result, err := processor.SubmitAndWait(ctx, patterns.BatchItem[int]{
+					ID:   fmt.Sprintf("item-%d", i),
+					Data: i,
+				})
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				if result.Error != nil {
+					errs[i] = result.Error
+					return
+				}
+				values[i] = result.Data
+			}(i)
+		}
+		wg.Wait()
+
+		for i := 0; i < numItems; i++ {
+			if errs[i] != nil {
+				t.Fatalf("Item %d was dropped or failed: %v", i, errs[i])
+			}
+			if values[i] != i*2 {
+				t.Errorf("Item %d: expected %d, got %d", i, i*2, values[i])
+			}
+		}
+	})
+}
@@ -0,0 +1,204 @@
+package patterns_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"app-backend/pkg/patterns"
+)
+
+func TestCache(t *testing.T) {
+	t.Run("stores and retrieves values", func(t *testing.T) {
+		cache := patterns.NewCache[string, int](patterns.CacheConfig[string, int]{MaxEntries: 4})
+		defer cache.Stop()
+
+		cache.Set("a", 1)
+		cache.Set("b", 2)
+
+		if value, ok := cache.Get("a"); !ok || value != 1 {
+			t.Errorf("Expected (1, true), got (%d, %v)", value, ok)
+		}
+		if _, ok := cache.Get("missing"); ok {
+			t.Error("Expected a miss for an absent key")
+		}
+		if cache.Len() != 2 {
+			t.Errorf("Expected 2 entries, got %d", cache.Len())
+		}
+	})
+
+	t.Run("evicts the least recently used entry at capacity", func(t *testing.T) {
+		cache := patterns.NewCache[string, int](patterns.CacheConfig[string, int]{MaxEntries: 2})
+		defer cache.Stop()
+
+		cache.Set("a", 1)
+		cache.Set("b", 2)
+		// Touch "a" so "b" becomes the eviction candidate
+		cache.Get("a")
+		cache.Set("c", 3)
+
+		if _, ok := cache.Get("b"); ok {
+			t.Error("Expected the least recently used entry to be evicted")
+		}
+		if _, ok := cache.Get("a"); !ok {
+			t.Error("Expected the recently used entry to survive")
+		}
+	})
+
+	t.Run("expired entries are misses", func(t *testing.T) {
+		cache := patterns.NewCache[string, int](patterns.CacheConfig[string, int]{
+			MaxEntries: 4,
+			DefaultTTL: 10 * time.Millisecond,
+		})
+		defer cache.Stop()
+
+		cache.Set("short", 1)
+		cache.SetWithTTL("long", 2, time.Minute)
+
+		if _, ok := cache.Get("short"); !ok {
+			t.Error("Expected the entry to be served before its TTL elapses")
+		}
+
+		time.Sleep(20 * time.Millisecond)
+
+		if _, ok := cache.Get("short"); ok {
+			t.Error("Expected the expired entry to be a miss")
+		}
+		if _, ok := cache.Get("long"); !ok {
+			t.Error("Expected the per-entry TTL to override the default")
+		}
+	})
+
+	t.Run("eviction callback reports the reason", func(t *testing.T) {
+		type evictionRecord struct {
+			key    string
+			reason patterns.EvictionReason
+		}
+
+		var mu sync.Mutex
+		var evicted []evictionRecord
+		cache := patterns.NewCache[string, int](patterns.CacheConfig[string, int]{
+			MaxEntries: 3,
+			OnEvict: func(key string, value int, reason patterns.EvictionReason) {
+				mu.Lock()
+				defer mu.Unlock()
+				evicted = append(evicted, evictionRecord{key: key, reason: reason})
+			},
+		})
+		defer cache.Stop()
+
+		cache.Set("a", 1)
+		cache.Set("b", 2)
+		cache.Set("c", 3)
+		cache.SetWithTTL("d", 4, time.Nanosecond) // evicts "a" for capacity
+		cache.Get("d")                            // expired on access
+		cache.Delete("b")
+
+		mu.Lock()
+		defer mu.Unlock()
+		if len(evicted) != 3 {
+			t.Fatalf("Expected 3 evictions, got %+v", evicted)
+		}
+		want := []evictionRecord{
+			{key: "a", reason: patterns.EvictedCapacity},
+			{key: "d", reason: patterns.EvictedExpired},
+			{key: "b", reason: patterns.EvictedManually},
+		}
+		for i, record := range want {
+			if evicted[i] != record {
+				t.Errorf("Eviction %d: expected %+v, got %+v", i, record, evicted[i])
+			}
+		}
+	})
+
+	t.Run("the janitor sweeps expired entries", func(t *testing.T) {
+		var mu sync.Mutex
+		swept := 0
+		cache := patterns.NewCache[string, int](patterns.CacheConfig[string, int]{
+			MaxEntries:      8,
+			DefaultTTL:      time.Millisecond,
+			CleanupInterval: 5 * time.Millisecond,
+			OnEvict: func(key string, value int, reason patterns.EvictionReason) {
+				if reason == patterns.EvictedExpired {
+					mu.Lock()
+					swept++
+					mu.Unlock()
+				}
+			},
+		})
+		defer cache.Stop()
+
+		cache.Set("a", 1)
+		cache.Set("b", 2)
+
+		deadline := time.Now().Add(time.Second)
+		for {
+			mu.Lock()
+			done := swept == 2
+			mu.Unlock()
+			if done {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("Janitor did not sweep both entries, swept %d", swept)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+
+		if cache.Len() != 0 {
+			t.Errorf("Expected an empty cache after the sweep, got %d entries", cache.Len())
+		}
+	})
+
+	t.Run("tracks hit and miss metrics", func(t *testing.T) {
+		cache := patterns.NewCache[string, int](patterns.CacheConfig[string, int]{MaxEntries: 4})
+		defer cache.Stop()
+
+		cache.Set("a", 1)
+		cache.Get("a")
+		cache.Get("a")
+		cache.Get("missing")
+		cache.Set("b", 2)
+		cache.Set("c", 3)
+		cache.Set("d", 4)
+		cache.Set("e", 5) // evicts for capacity
+
+		metrics := cache.Metrics()
+		if metrics.Hits != 2 || metrics.Misses != 1 {
+			t.Errorf("Expected 2 hits and 1 miss, got %+v", metrics)
+		}
+		if metrics.Evictions != 1 {
+			t.Errorf("Expected 1 eviction, got %d", metrics.Evictions)
+		}
+		if metrics.HitRate < 0.66 || metrics.HitRate > 0.67 {
+			t.Errorf("Expected a hit rate around 2/3, got %v", metrics.HitRate)
+		}
+	})
+
+	t.Run("sharded caches stay within total capacity", func(t *testing.T) {
+		cache := patterns.NewCache[string, int](patterns.CacheConfig[string, int]{
+			MaxEntries: 64,
+			Shards:     8,
+		})
+		defer cache.Stop()
+
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					key := fmt.Sprintf("key-%d-%d", g, i)
+					cache.Set(key, i)
+					cache.Get(key)
+				}
+			}(g)
+		}
+		wg.Wait()
+
+		if cache.Len() > 64 {
+			t.Errorf("Expected at most 64 entries, got %d", cache.Len())
+		}
+	})
+}
@@ -115,7 +115,7 @@ func TestCircuitBreaker(t *testing.T) {
 		})
 
 		fallbackCalled := false
-		err := cb.ExecuteWithFallback(ctx, 
+		err := cb.ExecuteWithFallback(ctx,
 			func() error {
 				return fmt.Errorf("main function")
 			},
@@ -159,15 +159,15 @@ func TestCircuitBreaker(t *testing.T) {
 		}
 
 		metrics := cb.GetMetrics()
-		
+
 		if metrics.TotalSuccesses != 3 {
 			t.Errorf("Expected 3 successes, got %d", metrics.TotalSuccesses)
 		}
-		
+
 		if metrics.TotalFailures != 2 {
 			t.Errorf("Expected 2 failures, got %d", metrics.TotalFailures)
 		}
-		
+
 		expectedRate := 2.0 / 5.0 // 2 failures out of 5 total
 		if metrics.FailureRate != expectedRate {
 			t.Errorf("Expected failure rate %.2f, got %.2f", expectedRate, metrics.FailureRate)
@@ -234,4 +234,4 @@ func BenchmarkCircuitBreaker(b *testing.B) {
 			})
 		}
 	})
-}
\ No newline at end of file
+}
@@ -171,14 +171,14 @@ func TestConcurrentMap(t *testing.T) {
 
 	t.Run("size and collections", func(t *testing.T) {
 		cm := patterns.NewConcurrentMap[string, int]()
-		
+
 		if cm.Size() != 0 {
 			t.Errorf("Expected size 0, got %d", cm.Size())
 		}
 
 		cm.Set("a", 1)
 		cm.Set("b", 2)
-		
+
 		if cm.Size() != 2 {
 			t.Errorf("Expected size 2, got %d", cm.Size())
 		}
@@ -245,27 +245,27 @@ func TestConcurrentMap(t *testing.T) {
 		const opsPerGoroutine = 100
 
 		var wg sync.WaitGroup
-		
+
 		// Multiple goroutines doing concurrent operations
 		for i := 0; i < numGoroutines; i++ {
 			wg.Add(1)
 			go func(id int) {
 				defer wg.Done()
-				
+
 				for j := 0; j < opsPerGoroutine; j++ {
 					key := id*opsPerGoroutine + j
-					
+
 					// Set
 					cm.Set(key, key*2)
-					
+
 					// Get
 					cm.Get(key)
-					
+
 					// Update
 					cm.Update(key, func(oldValue int) int {
 						return oldValue + 1
 					})
-					
+
 					// GetOrSet
 					cm.GetOrSet(key+10000, key)
 				}
@@ -283,7 +283,7 @@ func TestConcurrentMap(t *testing.T) {
 
 func BenchmarkConcurrentMap(b *testing.B) {
 	cm := patterns.NewConcurrentMap[int, int]()
-	
+
 	b.Run("Set", func(b *testing.B) {
 		b.RunParallel(func(pb *testing.PB) {
 			i := 0
@@ -293,13 +293,13 @@ func BenchmarkConcurrentMap(b *testing.B) {
 			}
 		})
 	})
-	
+
 	b.Run("Get", func(b *testing.B) {
 		// Pre-populate
 		for i := 0; i < 1000; i++ {
 			cm.Set(i, i*2)
 		}
-		
+
 		b.ResetTimer()
 		b.RunParallel(func(pb *testing.PB) {
 			i := 0
@@ -309,4 +309,4 @@ func BenchmarkConcurrentMap(b *testing.B) {
 			}
 		})
 	})
-}
\ No newline at end of file
+}
@@ -0,0 +1,123 @@
+package patterns_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"app-backend/pkg/patterns"
+)
+
+func TestFirstSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("first success cancels the rest", func(t *testing.T) {
+		cancelled := make(chan struct{})
+
+		fns := []func(context.Context) (string, error){
+			func(fnCtx context.Context) (string, error) {
+				// Slow function that should be cancelled by the winner
+				select {
+				case <-fnCtx.Done():
+					close(cancelled)
+					return "", fnCtx.Err()
+				case <-time.After(5 * time.Second):
+					return "slow", nil
+				}
+			},
+			func(fnCtx context.Context) (string, error) {
+				return "fast", nil
+			},
+		}
+
+		result, err := patterns.FirstSuccess(ctx, fns)
+		if err != nil {
+			t.Fatalf("Expected first success, got error: %v", err)
+		}
+		if result != "fast" {
+			t.Errorf("Expected result from fast function, got %q", result)
+		}
+
+		select {
+		case <-cancelled:
+		case <-time.After(time.Second):
+			t.Error("Expected slow function to be cancelled")
+		}
+	})
+
+	t.Run("all failures are aggregated", func(t *testing.T) {
+		fns := []func(context.Context) (int, error){
+			func(fnCtx context.Context) (int, error) {
+				return 0, fmt.Errorf("first failure")
+			},
+			func(fnCtx context.Context) (int, error) {
+				return 0, fmt.Errorf("second failure")
+			},
+		}
+
+		_, err := patterns.FirstSuccess(ctx, fns)
+		if err == nil {
+			t.Fatal("Expected aggregated error when all functions fail")
+		}
+		if !strings.Contains(err.Error(), "first failure") || !strings.Contains(err.Error(), "second failure") {
+			t.Errorf("Expected both failures in aggregated error, got: %v", err)
+		}
+	})
+
+	t.Run("failures before a success are ignored", func(t *testing.T) {
+		fns := []func(context.Context) (string, error){
+			func(fnCtx context.Context) (string, error) {
+				return "", fmt.Errorf("early failure")
+			},
+			func(fnCtx context.Context) (string, error) {
+				time.Sleep(10 * time.Millisecond)
+				return "eventual", nil
+			},
+		}
+
+		result, err := patterns.FirstSuccess(ctx, fns)
+		if err != nil {
+			t.Fatalf("Expected eventual success, got error: %v", err)
+		}
+		if result != "eventual" {
+			t.Errorf("Expected %q, got %q", "eventual", result)
+		}
+	})
+
+	t.Run("cancellation returns promptly even when functions block", func(t *testing.T) {
+		blockCtx, cancel := context.WithCancel(ctx)
+		release := make(chan struct{})
+		t.Cleanup(func() { close(release) })
+
+		fns := []func(context.Context) (string, error){
+			func(fnCtx context.Context) (string, error) {
+				// Blocks without watching its context
+				<-release
+				return "", fmt.Errorf("too late")
+			},
+		}
+
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+		}()
+
+		start := time.Now()
+		_, err := patterns.FirstSuccess(blockCtx, fns)
+		if err != context.Canceled {
+			t.Fatalf("Expected context.Canceled, got: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("Expected prompt return after cancellation, took %v", elapsed)
+		}
+	})
+
+	t.Run("empty function list errors", func(t *testing.T) {
+		_, err := patterns.FirstSuccess[string](ctx, nil)
+		if err == nil {
+			t.Fatal("Expected error for empty function list")
+		}
+	})
+}
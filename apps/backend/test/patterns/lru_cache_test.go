@@ -0,0 +1,85 @@
+package patterns_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"app-backend/pkg/patterns"
+)
+
+func TestLRUCache(t *testing.T) {
+	t.Run("stores and retrieves values", func(t *testing.T) {
+		cache := patterns.NewLRUCache[string, int](3)
+
+		cache.Put("a", 1)
+		cache.Put("b", 2)
+
+		if value, ok := cache.Get("a"); !ok || value != 1 {
+			t.Errorf("Expected (1, true), got (%d, %v)", value, ok)
+		}
+		if _, ok := cache.Get("missing"); ok {
+			t.Error("Expected a miss for an absent key")
+		}
+		if cache.Len() != 2 {
+			t.Errorf("Expected 2 entries, got %d", cache.Len())
+		}
+	})
+
+	t.Run("evicts the least recently used entry at capacity", func(t *testing.T) {
+		cache := patterns.NewLRUCache[string, int](2)
+
+		cache.Put("a", 1)
+		cache.Put("b", 2)
+		// Touch "a" so "b" becomes the eviction candidate
+		cache.Get("a")
+		cache.Put("c", 3)
+
+		if _, ok := cache.Get("b"); ok {
+			t.Error("Expected the least recently used entry to be evicted")
+		}
+		if _, ok := cache.Get("a"); !ok {
+			t.Error("Expected the recently used entry to survive")
+		}
+		if cache.Len() != 2 {
+			t.Errorf("Expected the cache to stay at capacity 2, got %d", cache.Len())
+		}
+	})
+
+	t.Run("updating an existing key does not evict", func(t *testing.T) {
+		cache := patterns.NewLRUCache[string, int](2)
+
+		cache.Put("a", 1)
+		cache.Put("b", 2)
+		cache.Put("a", 10)
+
+		if value, ok := cache.Get("a"); !ok || value != 10 {
+			t.Errorf("Expected the updated value 10, got (%d, %v)", value, ok)
+		}
+		if _, ok := cache.Get("b"); !ok {
+			t.Error("Expected the other entry to survive an update")
+		}
+	})
+
+	t.Run("is safe under concurrent access", func(t *testing.T) {
+		cache := patterns.NewLRUCache[string, int](64)
+
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					key := fmt.Sprintf("key-%d", (g*200+i)%100)
+					cache.Put(key, i)
+					cache.Get(key)
+				}
+			}(g)
+		}
+		wg.Wait()
+
+		if cache.Len() > 64 {
+			t.Errorf("Expected at most 64 entries, got %d", cache.Len())
+		}
+	})
+}
@@ -109,7 +109,7 @@ func TestTokenBucketLimiter(t *testing.T) {
 
 		// Reset should restore all tokens
 		limiter.Reset()
-		
+
 		if !limiter.Allow() {
 			t.Error("Should have tokens after reset")
 		}
@@ -176,7 +176,7 @@ func TestRateLimitedExecutor(t *testing.T) {
 		executor := patterns.NewRateLimitedExecutor("test", limiter, logger)
 
 		executed := 0
-		
+
 		// First two executions should succeed immediately
 		for i := 0; i < 2; i++ {
 			err := executor.TryExecute(func() error {
@@ -215,12 +215,12 @@ func TestRateLimitedExecutor(t *testing.T) {
 
 		executed := false
 		start := time.Now()
-		
+
 		err := executor.Execute(ctx, func() error {
 			executed = true
 			return nil
 		})
-		
+
 		elapsed := time.Since(start)
 
 		if err != nil {
@@ -259,4 +259,4 @@ func BenchmarkSlidingWindowLimiter(b *testing.B) {
 			limiter.Allow()
 		}
 	})
-}
\ No newline at end of file
+}
@@ -0,0 +1,132 @@
+package patterns_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"app-backend/pkg/patterns"
+)
+
+func TestSingleFlight(t *testing.T) {
+	t.Run("concurrent callers share one execution", func(t *testing.T) {
+		flights := patterns.NewSingleFlight[string, int]()
+		release := make(chan struct{})
+		var executions int32
+
+		var wg sync.WaitGroup
+		var sharedCount int32
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				value, shared, err := flights.Do("key", func() (int, error) {
+					atomic.AddInt32(&executions, 1)
+					<-release
+					return 42, nil
+				})
+				if err != nil {
+					t.Errorf("Do failed: %v", err)
+				}
+				if value != 42 {
+					t.Errorf("Expected the shared value 42, got %d", value)
+				}
+				if shared {
+					atomic.AddInt32(&sharedCount, 1)
+				}
+			}()
+		}
+
+		// Let the goroutines pile up on the key before releasing the call.
+		// Callers arriving after completion would execute again, so shared
+		// can legitimately be below 7; executions is the invariant.
+		close(release)
+		wg.Wait()
+
+		if got := atomic.LoadInt32(&executions); got != int32(8-sharedCount) {
+			t.Errorf("Expected %d executions, got %d", 8-sharedCount, got)
+		}
+	})
+
+	t.Run("errors are shared with joined callers", func(t *testing.T) {
+		flights := patterns.NewSingleFlight[string, int]()
+		release := make(chan struct{})
+		failed := errors.New("provider down")
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		started := make(chan struct{})
+		go func() {
+			defer wg.Done()
+			_, _, err := flights.Do("key", func() (int, error) {
+				close(started)
+				<-release
+				return 0, failed
+			})
+			if !errors.Is(err, failed) {
+				t.Errorf("Expected the execution error, got %v", err)
+			}
+		}()
+
+		<-started
+		joined := make(chan error, 1)
+		go func() {
+			_, _, err := flights.Do("key", func() (int, error) {
+				t.Error("Joined caller should not execute")
+				return 0, nil
+			})
+			joined <- err
+		}()
+		// Give the second caller time to join before the call completes
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		if err := <-joined; !errors.Is(err, failed) {
+			t.Errorf("Expected the shared error, got %v", err)
+		}
+	})
+
+	t.Run("sequential calls execute again", func(t *testing.T) {
+		flights := patterns.NewSingleFlight[string, int]()
+		executions := 0
+
+		for i := 0; i < 3; i++ {
+			value, shared, err := flights.Do("key", func() (int, error) {
+				executions++
+				return executions, nil
+			})
+			if err != nil || shared {
+				t.Fatalf("Expected a fresh execution, got (%d, %v, %v)", value, shared, err)
+			}
+			if value != i+1 {
+				t.Errorf("Expected execution %d, got %d", i+1, value)
+			}
+		}
+	})
+
+	t.Run("different keys do not block each other", func(t *testing.T) {
+		flights := patterns.NewSingleFlight[string, string]()
+		release := make(chan struct{})
+		started := make(chan struct{})
+
+		go func() {
+			flights.Do("blocked", func() (string, error) {
+				close(started)
+				<-release
+				return "", nil
+			})
+		}()
+		<-started
+		defer close(release)
+
+		value, shared, err := flights.Do("free", func() (string, error) {
+			return "independent", nil
+		})
+		if err != nil || shared || value != "independent" {
+			t.Errorf("Expected an independent execution, got (%q, %v, %v)", value, shared, err)
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package patterns_test
+
+import (
+	"testing"
+	"time"
+
+	"app-backend/pkg/patterns"
+)
+
+func TestTTLMap(t *testing.T) {
+	t.Run("entries expire after their TTL", func(t *testing.T) {
+		tm := patterns.NewTTLMap[string, int](time.Hour)
+		defer tm.Stop()
+
+		tm.Set("key", 42, 30*time.Millisecond)
+
+		if value, ok := tm.Get("key"); !ok || value != 42 {
+			t.Fatalf("Expected (42, true) before expiry, got (%d, %v)", value, ok)
+		}
+
+		time.Sleep(50 * time.Millisecond)
+
+		if _, ok := tm.Get("key"); ok {
+			t.Error("Expected expired entry to be treated as missing")
+		}
+	})
+
+	t.Run("GetAndDelete enforces single use", func(t *testing.T) {
+		tm := patterns.NewTTLMap[string, string](time.Hour)
+		defer tm.Stop()
+
+		tm.Set("state", "value", time.Minute)
+
+		if value, ok := tm.GetAndDelete("state"); !ok || value != "value" {
+			t.Fatalf("Expected (value, true) on first use, got (%q, %v)", value, ok)
+		}
+		if _, ok := tm.GetAndDelete("state"); ok {
+			t.Error("Expected second use of the same key to miss")
+		}
+	})
+
+	t.Run("GetAndDelete misses on expired entries", func(t *testing.T) {
+		tm := patterns.NewTTLMap[string, int](time.Hour)
+		defer tm.Stop()
+
+		tm.Set("key", 1, 10*time.Millisecond)
+		time.Sleep(30 * time.Millisecond)
+
+		if _, ok := tm.GetAndDelete("key"); ok {
+			t.Error("Expected expired entry to be treated as missing")
+		}
+	})
+
+	t.Run("janitor sweeps expired entries", func(t *testing.T) {
+		tm := patterns.NewTTLMap[string, int](10 * time.Millisecond)
+		defer tm.Stop()
+
+		tm.Set("short", 1, 10*time.Millisecond)
+		tm.Set("long", 2, time.Hour)
+
+		deadline := time.Now().Add(time.Second)
+		for tm.Size() > 1 && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		if size := tm.Size(); size != 1 {
+			t.Errorf("Expected janitor to sweep the expired entry, size is %d", size)
+		}
+		if _, ok := tm.Get("long"); !ok {
+			t.Error("Expected unexpired entry to survive the sweep")
+		}
+	})
+}
@@ -37,7 +37,7 @@ func TestWorkerPool(t *testing.T) {
 				},
 			},
 			{
-				ID:   "job2", 
+				ID:   "job2",
 				Data: "world",
 				Process: func(ctx context.Context, data string) (string, error) {
 					return strings.ToUpper(data), nil
@@ -55,7 +55,7 @@ func TestWorkerPool(t *testing.T) {
 		// Collect results
 		results := make(map[string]string)
 		timeout := time.After(10 * time.Second)
-		
+
 		for len(results) < len(jobs) {
 			select {
 			case result := <-pool.Results():
@@ -74,7 +74,7 @@ func TestWorkerPool(t *testing.T) {
 			"job1": "HELLO",
 			"job2": "WORLD",
 		}
-		
+
 		for jobID, expected := range expected {
 			if actual := results[jobID]; actual != expected {
 				t.Errorf("Job %s: expected %s, got %s", jobID, expected, actual)
@@ -143,7 +143,7 @@ func TestWorkerPool(t *testing.T) {
 
 		// Submit many jobs
 		numJobs := 50
-		
+
 		for i := 0; i < numJobs; i++ {
 			job := patterns.Job[int, int]{
 				ID:   fmt.Sprintf("job-%d", i),
@@ -164,7 +164,7 @@ func TestWorkerPool(t *testing.T) {
 		// Collect all results
 		results := make([]patterns.Result[int], 0, numJobs)
 		timeout := time.After(30 * time.Second)
-		
+
 		for len(results) < numJobs {
 			select {
 			case result := <-pool.Results():
@@ -205,7 +205,7 @@ func BenchmarkWorkerPool(b *testing.B) {
 	}()
 
 	b.ResetTimer()
-	
+
 	for i := 0; i < b.N; i++ {
 		job := patterns.Job[int, int]{
 			ID:   fmt.Sprintf("bench-%d", i),
@@ -214,10 +214,10 @@ func BenchmarkWorkerPool(b *testing.B) {
 				return data * 2, nil
 			},
 		}
-		
+
 		_ = pool.Submit(job)
 	}
-	
+
 	// Wait for all jobs to complete
 	<-done
-}
\ No newline at end of file
+}
@@ -0,0 +1,314 @@
+package privacy_test
+
+import (
+	"archive/zip"
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/jobs"
+	"app-backend/internal/services/privacy"
+	"app-backend/test/testutil"
+)
+
+// testEnv bundles the privacy service with its backing database and queue
+type testEnv struct {
+	db        *gorm.DB
+	queue     jobs.ServiceInterface
+	service   privacy.ServiceInterface
+	exportDir string
+}
+
+// newTestEnv builds a privacy service against an in-memory database with a
+// fast-polling queue; the queue is not started so tests control when jobs run
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t,
+		&models.User{},
+		&models.Session{},
+		&models.UserVideo{},
+		&models.VocabItem{},
+		&models.TranslationUsage{},
+		&models.OAuthToken{},
+		&models.APIKey{},
+		&models.Job{},
+	)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	queue := jobs.NewService(repositories.NewJobRepository(db), jobs.Config{
+		PollInterval: 10 * time.Millisecond,
+		RetryBackoff: 10 * time.Millisecond,
+	}, log)
+
+	exportDir := t.TempDir()
+	service := privacy.NewService(privacy.Repositories{
+		Users:       repositories.NewUserRepository(db),
+		Sessions:    repositories.NewSessionRepository(db),
+		SavedVideos: repositories.NewUserVideoRepository(db),
+		Vocab:       repositories.NewVocabRepository(db),
+		Usage:       repositories.NewTranslationUsageRepository(db),
+		OAuthTokens: repositories.NewOAuthTokenRepository(db),
+		APIKeys:     repositories.NewAPIKeyRepository(db),
+		Jobs:        repositories.NewJobRepository(db),
+	}, queue, privacy.Config{
+		ExportDir:      exportDir,
+		PurgeRetention: 30 * 24 * time.Hour,
+	}, log)
+	t.Cleanup(service.Stop)
+
+	return &testEnv{db: db, queue: queue, service: service, exportDir: exportDir}
+}
+
+// startQueue launches the queue and stops it when the test finishes
+func (e *testEnv) startQueue(t *testing.T) {
+	t.Helper()
+	e.queue.Start()
+	t.Cleanup(e.queue.Stop)
+}
+
+// awaitTerminal polls until the job reaches a final state
+func (e *testEnv) awaitTerminal(t *testing.T, userID, jobID uint) *models.Job {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := e.queue.GetJob(userID, jobID)
+		if err != nil {
+			t.Fatalf("GetJob failed: %v", err)
+		}
+		if job.IsTerminal() {
+			return job
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("job %d did not finish in time", jobID)
+	return nil
+}
+
+// seedUser creates a user with a session, a saved video, vocabulary and a
+// usage record
+func (e *testEnv) seedUser(t *testing.T, email string) *models.User {
+	t.Helper()
+
+	user := &models.User{FirstName: "Test", LastName: "User", Email: email, Password: "secret-hash"}
+	if err := e.db.Create(user).Error; err != nil {
+		t.Fatalf("failed to seed user: %v", err)
+	}
+
+	rows := []interface{}{
+		&models.Session{UserID: user.ID, TokenHash: "hash-" + email, ExpiresAt: time.Now().Add(time.Hour), IsActive: true},
+		&models.UserVideo{UserID: user.ID, VideoID: "video-1", Title: "Video"},
+		&models.VocabItem{UserID: user.ID, Word: "serendipity"},
+		&models.VocabItem{UserID: user.ID, Word: "ephemeral"},
+		&models.TranslationUsage{UserID: user.ID, CharCount: 120, TargetLang: "vi"},
+	}
+	for _, row := range rows {
+		if err := e.db.Create(row).Error; err != nil {
+			t.Fatalf("failed to seed user data: %v", err)
+		}
+	}
+	return user
+}
+
+// softDeleteAt soft-deletes a user and backdates the deletion timestamp
+func (e *testEnv) softDeleteAt(t *testing.T, userID uint, deletedAt time.Time) {
+	t.Helper()
+
+	if err := e.db.Delete(&models.User{}, userID).Error; err != nil {
+		t.Fatalf("failed to soft delete user: %v", err)
+	}
+	err := e.db.Model(&models.User{}).Unscoped().
+		Where("id = ?", userID).
+		Update("deleted_at", deletedAt).Error
+	if err != nil {
+		t.Fatalf("failed to backdate deletion: %v", err)
+	}
+}
+
+func TestExport(t *testing.T) {
+	t.Run("archive contains every data category", func(t *testing.T) {
+		env := newTestEnv(t)
+		env.startQueue(t)
+		user := env.seedUser(t, "exporter@example.com")
+
+		job, err := env.service.StartExport(user.ID)
+		if err != nil {
+			t.Fatalf("StartExport failed: %v", err)
+		}
+
+		finished := env.awaitTerminal(t, user.ID, job.ID)
+		if finished.Status != models.JobStatusCompleted {
+			t.Fatalf("Expected completed export, got %s (%s)", finished.Status, finished.LastError)
+		}
+
+		var result privacy.ExportResult
+		if err := json.Unmarshal([]byte(finished.Result), &result); err != nil {
+			t.Fatalf("invalid export result: %v", err)
+		}
+		if result.Sessions != 1 || result.SavedVideos != 1 || result.VocabItems != 2 || result.UsageRecords != 1 {
+			t.Errorf("Unexpected counts in result: %+v", result)
+		}
+
+		file, err := env.service.OpenExport(user.ID, job.ID)
+		if err != nil {
+			t.Fatalf("OpenExport failed: %v", err)
+		}
+
+		reader, err := zip.OpenReader(file.Path)
+		if err != nil {
+			t.Fatalf("failed to open archive: %v", err)
+		}
+		defer reader.Close()
+
+		entries := make(map[string]string, len(reader.File))
+		for _, entry := range reader.File {
+			content, err := entry.Open()
+			if err != nil {
+				t.Fatalf("failed to open %s: %v", entry.Name, err)
+			}
+			data, err := io.ReadAll(content)
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", entry.Name, err)
+			}
+			content.Close()
+			entries[entry.Name] = string(data)
+		}
+
+		for _, name := range []string{"manifest.json", "profile.json", "sessions.json", "saved_videos.json", "vocabulary.json", "usage.json"} {
+			if _, ok := entries[name]; !ok {
+				t.Errorf("Expected %s in archive", name)
+			}
+		}
+		if !strings.Contains(entries["profile.json"], "exporter@example.com") {
+			t.Error("Expected profile.json to contain the user's email")
+		}
+		if strings.Contains(entries["profile.json"], "secret-hash") {
+			t.Error("Expected the password hash to stay out of the archive")
+		}
+		if !strings.Contains(entries["vocabulary.json"], "serendipity") {
+			t.Error("Expected vocabulary.json to contain saved words")
+		}
+	})
+
+	t.Run("download is refused while the export runs", func(t *testing.T) {
+		env := newTestEnv(t)
+		user := env.seedUser(t, "pending@example.com")
+
+		// The queue is never started, so the job stays queued
+		job, err := env.service.StartExport(user.ID)
+		if err != nil {
+			t.Fatalf("StartExport failed: %v", err)
+		}
+
+		_, err = env.service.OpenExport(user.ID, job.ID)
+		var appErr *errors.AppError
+		if !goerrors.As(err, &appErr) || appErr.Status != 409 {
+			t.Fatalf("Expected 409 for a pending export, got %v", err)
+		}
+	})
+
+	t.Run("users cannot open each other's exports", func(t *testing.T) {
+		env := newTestEnv(t)
+		env.startQueue(t)
+		owner := env.seedUser(t, "owner@example.com")
+		other := env.seedUser(t, "other@example.com")
+
+		job, err := env.service.StartExport(owner.ID)
+		if err != nil {
+			t.Fatalf("StartExport failed: %v", err)
+		}
+		env.awaitTerminal(t, owner.ID, job.ID)
+
+		if _, err := env.service.OpenExport(other.ID, job.ID); err == nil {
+			t.Fatal("Expected opening another user's export to fail")
+		}
+	})
+}
+
+func TestPurge(t *testing.T) {
+	env := newTestEnv(t)
+	env.startQueue(t)
+
+	expired := env.seedUser(t, "expired@example.com")
+	recent := env.seedUser(t, "recent@example.com")
+	active := env.seedUser(t, "active@example.com")
+
+	env.softDeleteAt(t, expired.ID, time.Now().Add(-40*24*time.Hour))
+	env.softDeleteAt(t, recent.ID, time.Now().Add(-24*time.Hour))
+
+	// A leftover export archive of the expired account should disappear too
+	archive := filepath.Join(env.exportDir, fmt.Sprintf("user-%d-export-7.zip", expired.ID))
+	if err := os.WriteFile(archive, []byte("zip"), 0o644); err != nil {
+		t.Fatalf("failed to create archive: %v", err)
+	}
+
+	job, err := env.queue.Enqueue(0, privacy.JobTypeAccountPurge, "{}")
+	if err != nil {
+		t.Fatalf("failed to enqueue purge: %v", err)
+	}
+
+	finished := env.awaitTerminal(t, 0, job.ID)
+	if finished.Status != models.JobStatusCompleted {
+		t.Fatalf("Expected completed purge, got %s (%s)", finished.Status, finished.LastError)
+	}
+
+	var result privacy.PurgeResult
+	if err := json.Unmarshal([]byte(finished.Result), &result); err != nil {
+		t.Fatalf("invalid purge result: %v", err)
+	}
+	if result.PurgedAccounts != 1 {
+		t.Errorf("Expected 1 purged account, got %d", result.PurgedAccounts)
+	}
+
+	var userCount int64
+	if err := env.db.Model(&models.User{}).Unscoped().Where("id = ?", expired.ID).Count(&userCount).Error; err != nil {
+		t.Fatalf("failed to count users: %v", err)
+	}
+	if userCount != 0 {
+		t.Error("Expected the expired account row to be gone")
+	}
+
+	for _, model := range []interface{}{&models.Session{}, &models.UserVideo{}, &models.VocabItem{}, &models.TranslationUsage{}} {
+		var count int64
+		if err := env.db.Model(model).Unscoped().Where("user_id = ?", expired.ID).Count(&count).Error; err != nil {
+			t.Fatalf("failed to count rows: %v", err)
+		}
+		if count != 0 {
+			t.Errorf("Expected no %T rows for the purged account, got %d", model, count)
+		}
+	}
+
+	if _, err := os.Stat(archive); !os.IsNotExist(err) {
+		t.Error("Expected the purged account's export archive to be removed")
+	}
+
+	// The recently deleted account stays until its retention window passes
+	var recentCount int64
+	if err := env.db.Model(&models.User{}).Unscoped().Where("id = ?", recent.ID).Count(&recentCount).Error; err != nil {
+		t.Fatalf("failed to count users: %v", err)
+	}
+	if recentCount != 1 {
+		t.Error("Expected the recently deleted account to survive the purge")
+	}
+
+	if _, err := repositories.NewUserRepository(env.db).GetByID(active.ID); err != nil {
+		t.Errorf("Expected the active account to be untouched: %v", err)
+	}
+}
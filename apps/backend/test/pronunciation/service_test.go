@@ -0,0 +1,176 @@
+package pronunciation_test
+
+import (
+	"context"
+	goerrors "errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/pronunciation"
+	"app-backend/internal/types"
+	"app-backend/pkg/gemini"
+	"app-backend/test/testutil"
+)
+
+// fakeAssessor fakes the speech-assessment backend, scoring each word of the
+// reference text with a fixed score
+type fakeAssessor struct {
+	err      error
+	lastMIME string
+}
+
+func (f *fakeAssessor) assess(ctx context.Context, audio []byte, mimeType string, referenceText string, language string) (*gemini.PronunciationAssessment, error) {
+	f.lastMIME = mimeType
+	if f.err != nil {
+		return nil, f.err
+	}
+	words := strings.Fields(referenceText)
+	assessment := &gemini.PronunciationAssessment{OverallScore: 80}
+	for _, word := range words {
+		assessment.Words = append(assessment.Words, gemini.PronunciationWordScore{Word: word, Score: 80})
+	}
+	return assessment, nil
+}
+
+func newPronunciationService(t *testing.T, backend *fakeAssessor, cfg pronunciation.Config) pronunciation.ServiceInterface {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t, &models.User{}, &models.PronunciationAttempt{})
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return pronunciation.NewService(backend.assess, repositories.NewPronunciationAttemptRepository(db), cfg, log)
+}
+
+func isStatus(err error, status int) bool {
+	var appErr *appErrors.AppError
+	return goerrors.As(err, &appErr) && appErr.Status == status
+}
+
+func webmClip() pronunciation.Clip {
+	return pronunciation.Clip{Data: []byte("fake-audio"), MIMEType: "audio/webm;codecs=opus"}
+}
+
+func TestAssess(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("scores clip and stores the attempt", func(t *testing.T) {
+		backend := &fakeAssessor{}
+		service := newPronunciationService(t, backend, pronunciation.Config{})
+
+		resp, err := service.Assess(ctx, 1, webmClip(), &dto.AssessPronunciationRequest{
+			Text:     "Hello there",
+			Language: "en",
+			VideoID:  "video-1",
+		})
+		if err != nil {
+			t.Fatalf("Assess failed: %v", err)
+		}
+		if resp.AttemptID == 0 {
+			t.Error("Expected the stored attempt ID on the response")
+		}
+		if resp.OverallScore != 80 {
+			t.Errorf("Expected overall score 80, got %v", resp.OverallScore)
+		}
+		if len(resp.Words) != 2 || resp.Words[0].Word != "Hello" {
+			t.Fatalf("Expected per-word scores for both words, got %+v", resp.Words)
+		}
+		if resp.Provider != "gemini" {
+			t.Errorf("Expected default provider gemini, got %q", resp.Provider)
+		}
+		if backend.lastMIME != "audio/webm" {
+			t.Errorf("Expected codec parameters stripped from MIME type, got %q", backend.lastMIME)
+		}
+
+		attempts, err := service.ListAttempts(1, &types.PaginationRequest{Page: 1, PageSize: 10, SortBy: "created_at", SortDir: "desc"})
+		if err != nil {
+			t.Fatalf("ListAttempts failed: %v", err)
+		}
+		if len(attempts.Data) != 1 {
+			t.Fatalf("Expected 1 stored attempt, got %d", len(attempts.Data))
+		}
+		attempt := attempts.Data[0]
+		if attempt.VideoID != "video-1" || attempt.OverallScore != 80 {
+			t.Errorf("Stored attempt does not match the assessment: %+v", attempt)
+		}
+		if len(attempt.Words) != 2 {
+			t.Errorf("Expected per-word scores decoded from the stored attempt, got %+v", attempt.Words)
+		}
+	})
+
+	t.Run("rejects missing reference text", func(t *testing.T) {
+		service := newPronunciationService(t, &fakeAssessor{}, pronunciation.Config{})
+
+		_, err := service.Assess(ctx, 1, webmClip(), &dto.AssessPronunciationRequest{Text: "   "})
+		if !isStatus(err, http.StatusBadRequest) {
+			t.Errorf("Expected 400 for missing text, got %v", err)
+		}
+	})
+
+	t.Run("rejects empty clip", func(t *testing.T) {
+		service := newPronunciationService(t, &fakeAssessor{}, pronunciation.Config{})
+
+		_, err := service.Assess(ctx, 1, pronunciation.Clip{MIMEType: "audio/webm"}, &dto.AssessPronunciationRequest{Text: "Hello"})
+		if !isStatus(err, http.StatusBadRequest) {
+			t.Errorf("Expected 400 for empty clip, got %v", err)
+		}
+	})
+
+	t.Run("rejects unsupported audio format", func(t *testing.T) {
+		service := newPronunciationService(t, &fakeAssessor{}, pronunciation.Config{})
+
+		clip := pronunciation.Clip{Data: []byte("nope"), MIMEType: "text/plain"}
+		_, err := service.Assess(ctx, 1, clip, &dto.AssessPronunciationRequest{Text: "Hello"})
+		if !isStatus(err, http.StatusBadRequest) {
+			t.Errorf("Expected 400 for unsupported format, got %v", err)
+		}
+	})
+
+	t.Run("rejects oversize clip", func(t *testing.T) {
+		service := newPronunciationService(t, &fakeAssessor{}, pronunciation.Config{MaxAudioBytes: 4})
+
+		_, err := service.Assess(ctx, 1, webmClip(), &dto.AssessPronunciationRequest{Text: "Hello"})
+		if !isStatus(err, http.StatusRequestEntityTooLarge) {
+			t.Errorf("Expected 413 for oversize clip, got %v", err)
+		}
+	})
+
+	t.Run("maps backend failure to bad gateway", func(t *testing.T) {
+		backend := &fakeAssessor{err: goerrors.New("model unavailable")}
+		service := newPronunciationService(t, backend, pronunciation.Config{})
+
+		_, err := service.Assess(ctx, 1, webmClip(), &dto.AssessPronunciationRequest{Text: "Hello"})
+		if !isStatus(err, http.StatusBadGateway) {
+			t.Errorf("Expected 502 for backend failure, got %v", err)
+		}
+	})
+}
+
+func TestParsePronunciationAssessment(t *testing.T) {
+	t.Run("strips markdown fences and clamps scores", func(t *testing.T) {
+		raw := "```json\n{\"overall_score\": 120, \"words\": [{\"word\": \"hi\", \"score\": -5}]}\n```"
+		assessment, err := gemini.ParsePronunciationAssessment(raw)
+		if err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		if assessment.OverallScore != 100 {
+			t.Errorf("Expected overall score clamped to 100, got %v", assessment.OverallScore)
+		}
+		if assessment.Words[0].Score != 0 {
+			t.Errorf("Expected word score clamped to 0, got %v", assessment.Words[0].Score)
+		}
+	})
+
+	t.Run("rejects responses without word scores", func(t *testing.T) {
+		if _, err := gemini.ParsePronunciationAssessment(`{"overall_score": 50, "words": []}`); err == nil {
+			t.Error("Expected an error for a response with no word scores")
+		}
+	})
+}
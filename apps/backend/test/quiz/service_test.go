@@ -0,0 +1,275 @@
+package quiz_test
+
+import (
+	"context"
+	"encoding/json"
+	goerrors "errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"app-backend/internal/dto"
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/quiz"
+	"app-backend/internal/types"
+	"app-backend/pkg/gemini"
+	"app-backend/test/testutil"
+)
+
+// fakeTranscriptSource serves a canned transcript for any video
+type fakeTranscriptSource struct {
+	transcript *types.Transcript
+	err        error
+}
+
+func (f *fakeTranscriptSource) DetectProvider(videoURL string) (types.VideoProvider, string, error) {
+	return types.ProviderYouTube, videoURL, nil
+}
+
+func (f *fakeTranscriptSource) GetTranscript(ctx context.Context, provider types.VideoProvider, videoID string, language string) (*types.Transcript, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.transcript, nil
+}
+
+// fakeGenerator fakes the model backend, recording what it was asked for
+type fakeGenerator struct {
+	err          error
+	lastSegments []string
+	lastSeed     int64
+	questions    []gemini.QuizQuestion
+}
+
+func (f *fakeGenerator) generate(ctx context.Context, segments []string, language string, numQuestions int, seed int64) ([]gemini.QuizQuestion, error) {
+	f.lastSegments = segments
+	f.lastSeed = seed
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.questions, nil
+}
+
+func sampleQuestions() []gemini.QuizQuestion {
+	return []gemini.QuizQuestion{
+		{Type: gemini.QuizQuestionCloze, Question: "We ____ the plan together", Answer: "reviewed"},
+		{Type: gemini.QuizQuestionMultipleChoice, Question: "What did they review?", Options: []string{"the plan", "the bill", "the map", "the menu"}, Answer: "the plan"},
+	}
+}
+
+func sampleTranscript() *types.Transcript {
+	return &types.Transcript{
+		VideoID:  "quiz-video",
+		Provider: types.ProviderYouTube,
+		Language: "en",
+		Segments: []types.TranscriptSegment{
+			{Index: 1, Text: "We reviewed the plan together"},
+			{Index: 2, Text: "ok"},
+			{Index: 3, Text: "Then we walked through every step"},
+			{Index: 4, Text: "Everyone agreed on the schedule quickly"},
+		},
+		Available: true,
+	}
+}
+
+func newQuizService(t *testing.T, source *fakeTranscriptSource, backend *fakeGenerator) quiz.ServiceInterface {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t, &models.User{}, &models.Quiz{}, &models.QuizSubmission{})
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return quiz.NewService(source, backend.generate, repositories.NewQuizRepository(db), repositories.NewQuizSubmissionRepository(db), log)
+}
+
+func isStatus(err error, status int) bool {
+	var appErr *appErrors.AppError
+	return goerrors.As(err, &appErr) && appErr.Status == status
+}
+
+func TestGenerateQuiz(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stores the quiz and hides answers from the response", func(t *testing.T) {
+		backend := &fakeGenerator{questions: sampleQuestions()}
+		service := newQuizService(t, &fakeTranscriptSource{transcript: sampleTranscript()}, backend)
+
+		resp, err := service.Generate(ctx, 1, &dto.GenerateQuizRequest{VideoURL: "quiz-video", Seed: 42})
+		if err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+		if resp.QuizID == 0 {
+			t.Error("Expected the stored quiz ID on the response")
+		}
+		if resp.Seed != 42 {
+			t.Errorf("Expected the requested seed echoed back, got %d", resp.Seed)
+		}
+		if len(resp.Questions) != 2 {
+			t.Fatalf("Expected 2 questions, got %d", len(resp.Questions))
+		}
+		if resp.Questions[0].Type != gemini.QuizQuestionCloze || len(resp.Questions[1].Options) != 4 {
+			t.Errorf("Unexpected question shapes: %+v", resp.Questions)
+		}
+		encoded, err := json.Marshal(resp)
+		if err != nil {
+			t.Fatalf("Failed to encode response: %v", err)
+		}
+		if strings.Contains(string(encoded), `"reviewed"`) {
+			t.Error("Question response must not carry the cloze answer")
+		}
+		if backend.lastSeed != 42 {
+			t.Errorf("Expected the seed forwarded to the backend, got %d", backend.lastSeed)
+		}
+		// The two-word segment is too short to blank a word from
+		if len(backend.lastSegments) != 3 {
+			t.Errorf("Expected 3 usable segments in the prompt, got %d", len(backend.lastSegments))
+		}
+	})
+
+	t.Run("selects the same segments for the same seed", func(t *testing.T) {
+		backend := &fakeGenerator{questions: sampleQuestions()}
+		service := newQuizService(t, &fakeTranscriptSource{transcript: sampleTranscript()}, backend)
+
+		if _, err := service.Generate(ctx, 1, &dto.GenerateQuizRequest{VideoURL: "quiz-video", Seed: 7}); err != nil {
+			t.Fatalf("First generate failed: %v", err)
+		}
+		first := backend.lastSegments
+
+		if _, err := service.Generate(ctx, 1, &dto.GenerateQuizRequest{VideoURL: "quiz-video", Seed: 7}); err != nil {
+			t.Fatalf("Second generate failed: %v", err)
+		}
+		if !reflect.DeepEqual(first, backend.lastSegments) {
+			t.Errorf("Expected identical segment selection for the same seed, got %v then %v", first, backend.lastSegments)
+		}
+	})
+
+	t.Run("derives a stable default seed", func(t *testing.T) {
+		backend := &fakeGenerator{questions: sampleQuestions()}
+		service := newQuizService(t, &fakeTranscriptSource{transcript: sampleTranscript()}, backend)
+
+		first, err := service.Generate(ctx, 1, &dto.GenerateQuizRequest{VideoURL: "quiz-video"})
+		if err != nil {
+			t.Fatalf("First generate failed: %v", err)
+		}
+		if first.Seed == 0 {
+			t.Fatal("Expected a derived seed when none was requested")
+		}
+		second, err := service.Generate(ctx, 1, &dto.GenerateQuizRequest{VideoURL: "quiz-video"})
+		if err != nil {
+			t.Fatalf("Second generate failed: %v", err)
+		}
+		if first.Seed != second.Seed {
+			t.Errorf("Expected the same derived seed for the same video, got %d then %d", first.Seed, second.Seed)
+		}
+	})
+
+	t.Run("rejects transcripts with no usable segments", func(t *testing.T) {
+		transcript := sampleTranscript()
+		transcript.Segments = []types.TranscriptSegment{{Index: 1, Text: "too short"}}
+		service := newQuizService(t, &fakeTranscriptSource{transcript: transcript}, &fakeGenerator{questions: sampleQuestions()})
+
+		_, err := service.Generate(ctx, 1, &dto.GenerateQuizRequest{VideoURL: "quiz-video"})
+		if !isStatus(err, http.StatusUnprocessableEntity) {
+			t.Errorf("Expected 422 for an unusable transcript, got %v", err)
+		}
+	})
+
+	t.Run("maps backend failure to bad gateway", func(t *testing.T) {
+		backend := &fakeGenerator{err: goerrors.New("model unavailable")}
+		service := newQuizService(t, &fakeTranscriptSource{transcript: sampleTranscript()}, backend)
+
+		_, err := service.Generate(ctx, 1, &dto.GenerateQuizRequest{VideoURL: "quiz-video"})
+		if !isStatus(err, http.StatusBadGateway) {
+			t.Errorf("Expected 502 for backend failure, got %v", err)
+		}
+	})
+}
+
+func TestSubmitQuiz(t *testing.T) {
+	ctx := context.Background()
+
+	generated := func(t *testing.T, service quiz.ServiceInterface, userID uint) *dto.GenerateQuizResponse {
+		t.Helper()
+		resp, err := service.Generate(ctx, userID, &dto.GenerateQuizRequest{VideoURL: "quiz-video", Seed: 42})
+		if err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+		return resp
+	}
+
+	t.Run("grades answers and records the submission", func(t *testing.T) {
+		service := newQuizService(t, &fakeTranscriptSource{transcript: sampleTranscript()}, &fakeGenerator{questions: sampleQuestions()})
+		quizResp := generated(t, service, 1)
+
+		result, err := service.Submit(1, quizResp.QuizID, &dto.SubmitQuizAnswersRequest{
+			Answers: []string{" Reviewed ", "the bill"},
+		})
+		if err != nil {
+			t.Fatalf("Submit failed: %v", err)
+		}
+		if result.CorrectCount != 1 || result.QuestionCount != 2 {
+			t.Errorf("Expected 1 of 2 correct, got %d of %d", result.CorrectCount, result.QuestionCount)
+		}
+		if result.Score != 50 {
+			t.Errorf("Expected score 50, got %v", result.Score)
+		}
+		if !result.Results[0].Correct || result.Results[1].Correct {
+			t.Errorf("Unexpected per-question grading: %+v", result.Results)
+		}
+		if result.Results[1].Answer != "the plan" {
+			t.Errorf("Expected the correct answer revealed after grading, got %q", result.Results[1].Answer)
+		}
+	})
+
+	t.Run("rejects an answer count mismatch", func(t *testing.T) {
+		service := newQuizService(t, &fakeTranscriptSource{transcript: sampleTranscript()}, &fakeGenerator{questions: sampleQuestions()})
+		quizResp := generated(t, service, 1)
+
+		_, err := service.Submit(1, quizResp.QuizID, &dto.SubmitQuizAnswersRequest{Answers: []string{"reviewed"}})
+		if !isStatus(err, http.StatusBadRequest) {
+			t.Errorf("Expected 400 for an answer count mismatch, got %v", err)
+		}
+	})
+
+	t.Run("hides other users' quizzes", func(t *testing.T) {
+		service := newQuizService(t, &fakeTranscriptSource{transcript: sampleTranscript()}, &fakeGenerator{questions: sampleQuestions()})
+		quizResp := generated(t, service, 1)
+
+		_, err := service.Submit(2, quizResp.QuizID, &dto.SubmitQuizAnswersRequest{Answers: []string{"a", "b"}})
+		if !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 for another user's quiz, got %v", err)
+		}
+	})
+}
+
+func TestParseQuizQuestions(t *testing.T) {
+	t.Run("strips markdown fences", func(t *testing.T) {
+		raw := "```json\n[{\"type\": \"cloze\", \"question\": \"We ____ it\", \"answer\": \"did\"}]\n```"
+		questions, err := gemini.ParseQuizQuestions(raw)
+		if err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		if len(questions) != 1 || questions[0].Answer != "did" {
+			t.Errorf("Unexpected parse result: %+v", questions)
+		}
+	})
+
+	t.Run("rejects a multiple-choice answer missing from its options", func(t *testing.T) {
+		raw := `[{"type": "multiple_choice", "question": "Pick", "options": ["a", "b"], "answer": "c"}]`
+		if _, err := gemini.ParseQuizQuestions(raw); err == nil {
+			t.Error("Expected an error when the answer is not among the options")
+		}
+	})
+
+	t.Run("rejects unknown question types", func(t *testing.T) {
+		raw := `[{"type": "essay", "question": "Discuss", "answer": "n/a"}]`
+		if _, err := gemini.ParseQuizQuestions(raw); err == nil {
+			t.Error("Expected an error for an unknown question type")
+		}
+	})
+}
@@ -0,0 +1,238 @@
+package realtime_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"app-backend/internal/config"
+	realtimeHandler "app-backend/internal/handlers/realtime"
+	"app-backend/internal/logger"
+	"app-backend/internal/middleware"
+	"app-backend/internal/services/jwt"
+	"app-backend/internal/services/realtime"
+	"app-backend/internal/types"
+)
+
+const readWait = 500 * time.Millisecond
+
+// newHubServer starts a test server whose /ws route trusts the "user" query
+// parameter as the authenticated user, so the hub's room behavior can be
+// exercised without minting tokens
+func newHubServer(t *testing.T) (*realtime.Hub, *httptest.Server) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	hub := realtime.NewHub(log)
+	handler := realtimeHandler.NewRealtimeHandler(hub, log)
+
+	router := gin.New()
+	router.GET("/ws", func(c *gin.Context) {
+		userID, _ := strconv.Atoi(c.Query("user"))
+		types.SetUserContext(c, &types.UserContext{UserID: uint(userID), Role: "user"})
+	}, handler.Connect)
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return hub, server
+}
+
+func dial(t *testing.T, server *httptest.Server, userID uint) *websocket.Conn {
+	t.Helper()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?user=" + strconv.Itoa(int(userID))
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("Failed to dial websocket: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func readEvent(t *testing.T, conn *websocket.Conn) *realtime.Event {
+	t.Helper()
+
+	conn.SetReadDeadline(time.Now().Add(readWait))
+	_, raw, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("Expected an event, got read error: %v", err)
+	}
+	var event realtime.Event
+	if err := json.Unmarshal(raw, &event); err != nil {
+		t.Fatalf("Failed to decode event: %v", err)
+	}
+	return &event
+}
+
+func expectNoEvent(t *testing.T, conn *websocket.Conn) {
+	t.Helper()
+
+	conn.SetReadDeadline(time.Now().Add(readWait))
+	if _, raw, err := conn.ReadMessage(); err == nil {
+		t.Errorf("Expected no event, got %s", raw)
+	}
+}
+
+// waitForClients polls until the user's room reaches the expected size, so
+// tests do not race the connection handshake
+func waitForClients(t *testing.T, hub *realtime.Hub, userID uint, want int) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if hub.ClientCount(userID) == want {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("Expected %d clients for user %d, got %d", want, userID, hub.ClientCount(userID))
+}
+
+func TestSessionSyncHub(t *testing.T) {
+	t.Run("server broadcasts reach all of the user's clients only", func(t *testing.T) {
+		hub, server := newHubServer(t)
+		popup := dial(t, server, 1)
+		dashboard := dial(t, server, 1)
+		other := dial(t, server, 2)
+		waitForClients(t, hub, 1, 2)
+		waitForClients(t, hub, 2, 1)
+
+		payload, _ := json.Marshal(map[string]string{"word": "serendipity"})
+		hub.Broadcast(1, &realtime.Event{Type: realtime.EventVocabSaved, Payload: payload})
+
+		for _, conn := range []*websocket.Conn{popup, dashboard} {
+			event := readEvent(t, conn)
+			if event.Type != realtime.EventVocabSaved {
+				t.Errorf("Expected vocab_saved event, got %q", event.Type)
+			}
+			if event.SentAt.IsZero() {
+				t.Error("Expected the event to be timestamped")
+			}
+		}
+		expectNoEvent(t, other)
+	})
+
+	t.Run("client events relay to the user's other clients but not the sender", func(t *testing.T) {
+		hub, server := newHubServer(t)
+		extension := dial(t, server, 1)
+		dashboard := dial(t, server, 1)
+		waitForClients(t, hub, 1, 2)
+
+		message := `{"type":"playback_position","payload":{"videoId":"abc","position":42.5}}`
+		if err := extension.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+			t.Fatalf("Failed to send event: %v", err)
+		}
+
+		event := readEvent(t, dashboard)
+		if event.Type != realtime.EventPlaybackPosition {
+			t.Errorf("Expected playback_position event, got %q", event.Type)
+		}
+		expectNoEvent(t, extension)
+	})
+
+	t.Run("clients cannot forge server-published events", func(t *testing.T) {
+		hub, server := newHubServer(t)
+		extension := dial(t, server, 1)
+		dashboard := dial(t, server, 1)
+		waitForClients(t, hub, 1, 2)
+
+		forged := `{"type":"vocab_saved","payload":{"word":"forged"}}`
+		if err := extension.WriteMessage(websocket.TextMessage, []byte(forged)); err != nil {
+			t.Fatalf("Failed to send event: %v", err)
+		}
+		expectNoEvent(t, dashboard)
+	})
+
+	t.Run("disconnected clients leave the room", func(t *testing.T) {
+		hub, server := newHubServer(t)
+		conn := dial(t, server, 1)
+		waitForClients(t, hub, 1, 1)
+
+		conn.Close()
+		waitForClients(t, hub, 1, 0)
+	})
+}
+
+func TestRequireAuthUpgrade(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{}
+	cfg.JWT.Secret = "test-secret"
+	cfg.JWT.AccessTTLMinutes = 15
+	cfg.JWT.RefreshTTLHours = 24
+	jwtSvc := jwt.NewJWTService(cfg)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	authMiddleware := middleware.NewAuthMiddleware(jwtSvc, nil, nil, log)
+
+	router := gin.New()
+	router.GET("/ws", authMiddleware.RequireAuthUpgrade(), func(c *gin.Context) {
+		userCtx, err := types.GetUserContext(c)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "no user context"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"user_id": userCtx.UserID})
+	})
+
+	token, err := jwtSvc.GenerateAccessToken(7, "user@example.com", "user", 1)
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+
+	t.Run("accepts the token from the query parameter", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/ws?token="+token, nil)
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("Expected 200, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("accepts the token from the Authorization header", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/ws", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("Expected 200, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("rejects requests without a token", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/ws", nil)
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("Expected 401, got %d", w.Code)
+		}
+	})
+
+	t.Run("rejects invalid tokens", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/ws?token=not-a-token", nil)
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("Expected 401, got %d", w.Code)
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package repositories_test
+
+import (
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/test/testutil"
+)
+
+func seedOutcome(t *testing.T, db *gorm.DB, repo repositories.ProviderOutcomeRepositoryInterface, provider string, served, success bool, latencyMs int64, createdAt time.Time) {
+	t.Helper()
+
+	outcome := &models.ProviderOutcome{
+		VideoID:   "video-1",
+		Provider:  provider,
+		Served:    served,
+		Success:   success,
+		LatencyMs: latencyMs,
+	}
+	if !success {
+		outcome.ErrorCode = "INTERNAL_SERVER_ERROR"
+	}
+	if err := repo.Create(outcome); err != nil {
+		t.Fatalf("failed to seed outcome: %v", err)
+	}
+	// Backdate after create since GORM sets CreatedAt on insert
+	if err := db.Model(outcome).UpdateColumn("created_at", createdAt).Error; err != nil {
+		t.Fatalf("failed to backdate outcome: %v", err)
+	}
+}
+
+func TestProviderOutcomeRepository_AggregateSince(t *testing.T) {
+	db := testutil.SetupTestDB(t, &models.ProviderOutcome{})
+	repo := repositories.NewProviderOutcomeRepository(db)
+
+	now := time.Now()
+	recent := now.Add(-1 * time.Hour)
+	old := now.Add(-48 * time.Hour)
+
+	seedOutcome(t, db, repo, "innertube", true, true, 100, recent)
+	seedOutcome(t, db, repo, "innertube", false, true, 300, recent)
+	seedOutcome(t, db, repo, "innertube", false, false, 200, recent)
+	seedOutcome(t, db, repo, "youtube-api", true, true, 500, recent)
+	// Outside the aggregation window
+	seedOutcome(t, db, repo, "innertube", true, false, 900, old)
+
+	stats, err := repo.AggregateSince(now.Add(-24 * time.Hour))
+	if err != nil {
+		t.Fatalf("AggregateSince failed: %v", err)
+	}
+
+	if len(stats) != 2 {
+		t.Fatalf("expected stats for 2 providers, got %d", len(stats))
+	}
+
+	// Results are ordered by provider name
+	innertube := stats[0]
+	if innertube.Provider != "innertube" {
+		t.Fatalf("expected first provider to be innertube, got %s", innertube.Provider)
+	}
+	if innertube.Attempts != 3 {
+		t.Errorf("expected 3 innertube attempts, got %d", innertube.Attempts)
+	}
+	if innertube.Successes != 2 {
+		t.Errorf("expected 2 innertube successes, got %d", innertube.Successes)
+	}
+	if innertube.Served != 1 {
+		t.Errorf("expected 1 served innertube outcome, got %d", innertube.Served)
+	}
+	wantRate := float64(2) / float64(3)
+	if innertube.SuccessRate < wantRate-0.001 || innertube.SuccessRate > wantRate+0.001 {
+		t.Errorf("expected innertube success rate %.3f, got %.3f", wantRate, innertube.SuccessRate)
+	}
+	if innertube.AvgLatencyMs < 199 || innertube.AvgLatencyMs > 201 {
+		t.Errorf("expected innertube avg latency ~200ms, got %.1f", innertube.AvgLatencyMs)
+	}
+
+	youtubeAPI := stats[1]
+	if youtubeAPI.Provider != "youtube-api" {
+		t.Fatalf("expected second provider to be youtube-api, got %s", youtubeAPI.Provider)
+	}
+	if youtubeAPI.Attempts != 1 || youtubeAPI.Successes != 1 || youtubeAPI.Served != 1 {
+		t.Errorf("unexpected youtube-api aggregation: %+v", youtubeAPI)
+	}
+	if youtubeAPI.SuccessRate != 1.0 {
+		t.Errorf("expected youtube-api success rate 1.0, got %.3f", youtubeAPI.SuccessRate)
+	}
+}
+
+func TestProviderOutcomeRepository_AggregateSince_Empty(t *testing.T) {
+	db := testutil.SetupTestDB(t, &models.ProviderOutcome{})
+	repo := repositories.NewProviderOutcomeRepository(db)
+
+	stats, err := repo.AggregateSince(time.Now().Add(-24 * time.Hour))
+	if err != nil {
+		t.Fatalf("AggregateSince failed: %v", err)
+	}
+	if len(stats) != 0 {
+		t.Errorf("expected no stats for empty table, got %d", len(stats))
+	}
+}
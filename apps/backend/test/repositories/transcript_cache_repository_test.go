@@ -0,0 +1,104 @@
+package repositories_test
+
+import (
+	"testing"
+	"time"
+
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/test/testutil"
+)
+
+func seedTranscriptCache(t *testing.T, repo repositories.TranscriptCacheRepositoryInterface, videoID, language string, expiresAt time.Time) {
+	t.Helper()
+
+	record := &models.VideoTranscriptCache{
+		VideoID:   videoID,
+		Provider:  "youtube",
+		Language:  language,
+		Content:   `{"video_id":"` + videoID + `"}`,
+		ExpiresAt: expiresAt,
+	}
+	if err := repo.Create(record); err != nil {
+		t.Fatalf("failed to seed cache entry: %v", err)
+	}
+}
+
+func TestTranscriptCacheRepository(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	t.Run("GetLatest returns the unexpired entry", func(t *testing.T) {
+		db := testutil.SetupTestDB(t, &models.VideoTranscriptCache{})
+		repo := repositories.NewTranscriptCacheRepository(db)
+
+		seedTranscriptCache(t, repo, "video-1", "en", future)
+
+		record, err := repo.GetLatest("video-1", "en")
+		if err != nil {
+			t.Fatalf("GetLatest failed: %v", err)
+		}
+		if record == nil || record.VideoID != "video-1" {
+			t.Fatalf("expected the seeded entry, got %+v", record)
+		}
+	})
+
+	t.Run("GetLatest skips expired entries", func(t *testing.T) {
+		db := testutil.SetupTestDB(t, &models.VideoTranscriptCache{})
+		repo := repositories.NewTranscriptCacheRepository(db)
+
+		seedTranscriptCache(t, repo, "video-1", "en", past)
+
+		record, err := repo.GetLatest("video-1", "en")
+		if err != nil {
+			t.Fatalf("GetLatest failed: %v", err)
+		}
+		if record != nil {
+			t.Errorf("expected no entry for an expired record, got %+v", record)
+		}
+	})
+
+	t.Run("DeleteByVideo removes every language for the video", func(t *testing.T) {
+		db := testutil.SetupTestDB(t, &models.VideoTranscriptCache{})
+		repo := repositories.NewTranscriptCacheRepository(db)
+
+		seedTranscriptCache(t, repo, "video-1", "en", future)
+		seedTranscriptCache(t, repo, "video-1", "de", future)
+		seedTranscriptCache(t, repo, "video-2", "en", future)
+
+		removed, err := repo.DeleteByVideo("video-1")
+		if err != nil {
+			t.Fatalf("DeleteByVideo failed: %v", err)
+		}
+		if removed != 2 {
+			t.Errorf("expected 2 removed entries, got %d", removed)
+		}
+
+		if record, _ := repo.GetLatest("video-1", "en"); record != nil {
+			t.Error("expected video-1 entries to be gone")
+		}
+		if record, _ := repo.GetLatest("video-2", "en"); record == nil {
+			t.Error("expected video-2 entries to survive")
+		}
+	})
+
+	t.Run("DeleteExpired removes only entries past their expiry", func(t *testing.T) {
+		db := testutil.SetupTestDB(t, &models.VideoTranscriptCache{})
+		repo := repositories.NewTranscriptCacheRepository(db)
+
+		seedTranscriptCache(t, repo, "video-1", "en", past)
+		seedTranscriptCache(t, repo, "video-2", "en", future)
+
+		removed, err := repo.DeleteExpired()
+		if err != nil {
+			t.Fatalf("DeleteExpired failed: %v", err)
+		}
+		if removed != 1 {
+			t.Errorf("expected 1 removed entry, got %d", removed)
+		}
+
+		if record, _ := repo.GetLatest("video-2", "en"); record == nil {
+			t.Error("expected the unexpired entry to survive")
+		}
+	})
+}
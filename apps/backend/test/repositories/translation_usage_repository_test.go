@@ -0,0 +1,81 @@
+package repositories_test
+
+import (
+	"testing"
+	"time"
+
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/test/testutil"
+
+	"gorm.io/gorm"
+)
+
+func seedTranslationUsage(t *testing.T, db *gorm.DB, userID uint, charCount int, createdAt time.Time) {
+	t.Helper()
+
+	record := &models.TranslationUsage{
+		UserID:     userID,
+		CharCount:  charCount,
+		TargetLang: "vi",
+		Provider:   "gemini",
+	}
+	if err := db.Create(record).Error; err != nil {
+		t.Fatalf("failed to seed usage record: %v", err)
+	}
+	// Backdate the record; GORM sets created_at itself on insert
+	if err := db.Model(record).UpdateColumn("created_at", createdAt).Error; err != nil {
+		t.Fatalf("failed to backdate usage record: %v", err)
+	}
+}
+
+func TestTranslationUsageRepository(t *testing.T) {
+	now := time.Now().UTC()
+
+	t.Run("SumCharsSince sums only the user's records in the window", func(t *testing.T) {
+		db := testutil.SetupTestDB(t, &models.TranslationUsage{})
+		repo := repositories.NewTranslationUsageRepository(db)
+
+		seedTranslationUsage(t, db, 1, 10, now)
+		seedTranslationUsage(t, db, 1, 20, now)
+		seedTranslationUsage(t, db, 1, 100, now.Add(-2*time.Hour))
+		seedTranslationUsage(t, db, 2, 999, now)
+
+		total, err := repo.SumCharsSince(1, now.Add(-time.Hour))
+		if err != nil {
+			t.Fatalf("SumCharsSince failed: %v", err)
+		}
+		if total != 30 {
+			t.Errorf("Expected 30 chars in the window, got %d", total)
+		}
+	})
+
+	t.Run("SumCharsSince includes older records with a wider window", func(t *testing.T) {
+		db := testutil.SetupTestDB(t, &models.TranslationUsage{})
+		repo := repositories.NewTranslationUsageRepository(db)
+
+		seedTranslationUsage(t, db, 1, 10, now)
+		seedTranslationUsage(t, db, 1, 100, now.Add(-2*time.Hour))
+
+		total, err := repo.SumCharsSince(1, now.Add(-24*time.Hour))
+		if err != nil {
+			t.Fatalf("SumCharsSince failed: %v", err)
+		}
+		if total != 110 {
+			t.Errorf("Expected 110 chars over 24h, got %d", total)
+		}
+	})
+
+	t.Run("SumCharsSince returns zero without records", func(t *testing.T) {
+		db := testutil.SetupTestDB(t, &models.TranslationUsage{})
+		repo := repositories.NewTranslationUsageRepository(db)
+
+		total, err := repo.SumCharsSince(1, now.Add(-time.Hour))
+		if err != nil {
+			t.Fatalf("SumCharsSince failed: %v", err)
+		}
+		if total != 0 {
+			t.Errorf("Expected 0 chars for an unknown user, got %d", total)
+		}
+	})
+}
@@ -0,0 +1,152 @@
+package response_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/requestid"
+	"app-backend/internal/response"
+)
+
+// errorBody mirrors the AppError JSON contract every handler error response
+// must satisfy
+type errorBody struct {
+	ID      string            `json:"id"`
+	Code    string            `json:"code"`
+	Message string            `json:"message"`
+	Details string            `json:"details"`
+	Fields  map[string]string `json:"fields"`
+	TraceID string            `json:"trace_id"`
+	Status  int               `json:"status"`
+}
+
+// serveError runs handler behind a request-ID-injecting middleware and
+// decodes the response body against the error contract
+func serveError(t *testing.T, traceID string, reqBody string, handler gin.HandlerFunc) (int, errorBody) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Request = c.Request.WithContext(requestid.NewContext(c.Request.Context(), traceID))
+		c.Next()
+	})
+	router.POST("/test", handler)
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(reqBody))
+	router.ServeHTTP(recorder, req)
+
+	var body errorBody
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Response body is not valid JSON: %v (body %q)", err, recorder.Body.String())
+	}
+	return recorder.Code, body
+}
+
+// checkContract asserts the invariant parts of the error shape: an ID, a
+// code, a message, a status matching the HTTP status, and the trace ID from
+// the request context
+func checkContract(t *testing.T, httpStatus int, body errorBody, traceID string) {
+	t.Helper()
+	if body.ID == "" {
+		t.Error("Error response must carry an id")
+	}
+	if body.Code == "" {
+		t.Error("Error response must carry a code")
+	}
+	if body.Message == "" {
+		t.Error("Error response must carry a message")
+	}
+	if body.Status != httpStatus {
+		t.Errorf("Body status %d must match HTTP status %d", body.Status, httpStatus)
+	}
+	if body.TraceID != traceID {
+		t.Errorf("Expected trace_id %q, got %q", traceID, body.TraceID)
+	}
+}
+
+func TestErrorResponseContract(t *testing.T) {
+	t.Run("app errors are written as-is", func(t *testing.T) {
+		status, body := serveError(t, "trace-1", "", func(c *gin.Context) {
+			response.Error(c, errors.NewAppError("Quota exceeded", nil, http.StatusTooManyRequests))
+		})
+		checkContract(t, status, body, "trace-1")
+		if status != http.StatusTooManyRequests {
+			t.Errorf("Expected status 429, got %d", status)
+		}
+		if body.Message != "Quota exceeded" {
+			t.Errorf("Unexpected message %q", body.Message)
+		}
+	})
+
+	t.Run("unknown errors become an opaque 500", func(t *testing.T) {
+		status, body := serveError(t, "trace-2", "", func(c *gin.Context) {
+			response.Error(c, http.ErrBodyNotAllowed)
+		})
+		checkContract(t, status, body, "trace-2")
+		if status != http.StatusInternalServerError {
+			t.Errorf("Expected status 500, got %d", status)
+		}
+		if body.Details != "" {
+			t.Errorf("Internal error details must not leak, got %q", body.Details)
+		}
+	})
+
+	t.Run("status and message map to a code", func(t *testing.T) {
+		status, body := serveError(t, "trace-3", "", func(c *gin.Context) {
+			response.ErrorMessage(c, http.StatusNotFound, "Job not found")
+		})
+		checkContract(t, status, body, "trace-3")
+		if body.Code != errors.ErrCodeNotFound {
+			t.Errorf("Expected code %q, got %q", errors.ErrCodeNotFound, body.Code)
+		}
+	})
+
+	t.Run("details are carried alongside the message", func(t *testing.T) {
+		status, body := serveError(t, "trace-4", "", func(c *gin.Context) {
+			response.ErrorWithDetails(c, http.StatusBadRequest, "Invalid request data", "unexpected EOF")
+		})
+		checkContract(t, status, body, "trace-4")
+		if body.Details != "unexpected EOF" {
+			t.Errorf("Expected binding details, got %q", body.Details)
+		}
+	})
+
+	t.Run("missing request ID leaves trace_id empty", func(t *testing.T) {
+		status, body := serveError(t, "", "", func(c *gin.Context) {
+			response.NotFound(c, "Video")
+		})
+		checkContract(t, status, body, "")
+		if status != http.StatusNotFound {
+			t.Errorf("Expected status 404, got %d", status)
+		}
+	})
+
+	t.Run("validation errors expose per-field messages", func(t *testing.T) {
+		type payload struct {
+			Email string `json:"email" binding:"required"`
+		}
+		status, body := serveError(t, "trace-5", "{}", func(c *gin.Context) {
+			var req payload
+			if err := c.ShouldBindJSON(&req); err != nil {
+				response.ValidationError(c, err)
+				return
+			}
+			c.Status(http.StatusOK)
+		})
+		checkContract(t, status, body, "trace-5")
+		if status != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", status)
+		}
+		if len(body.Fields) == 0 {
+			t.Error("Expected per-field validation messages")
+		}
+	})
+}
@@ -0,0 +1,142 @@
+package search_test
+
+import (
+	"testing"
+	"time"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/search"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+	"app-backend/test/testutil"
+)
+
+func newSearchService(t *testing.T) search.ServiceInterface {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t, &models.TranscriptSegmentIndex{})
+	repo := repositories.NewTranscriptSearchRepository(db)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	return search.NewService(repo, log)
+}
+
+func indexTranscript(t *testing.T, service search.ServiceInterface, videoID, language string, texts ...string) {
+	t.Helper()
+
+	segments := make([]transcriptTypes.TranscriptSegment, len(texts))
+	for i, text := range texts {
+		segments[i] = transcriptTypes.TranscriptSegment{
+			Text:     text,
+			Start:    time.Duration(i*5) * time.Second,
+			Duration: 5 * time.Second,
+		}
+	}
+	if err := service.IndexTranscript(videoID, language, segments); err != nil {
+		t.Fatalf("IndexTranscript failed: %v", err)
+	}
+}
+
+func searchFor(t *testing.T, service search.ServiceInterface, query string) *dto.SearchTranscriptsResponse {
+	t.Helper()
+
+	results, err := service.SearchTranscripts(&dto.SearchTranscriptsRequest{Query: query})
+	if err != nil {
+		t.Fatalf("SearchTranscripts failed: %v", err)
+	}
+	return results
+}
+
+func TestTranscriptSearch(t *testing.T) {
+	t.Run("finds a phrase across videos with timestamps", func(t *testing.T) {
+		service := newSearchService(t)
+		indexTranscript(t, service, "video-1", "en",
+			"hello and welcome back",
+			"today we talk about idioms",
+			"to bite the bullet means to endure")
+		indexTranscript(t, service, "video-2", "en",
+			"sometimes you just have to bite the bullet")
+
+		results := searchFor(t, service, "bite the bullet")
+		if results.Total != 2 {
+			t.Fatalf("Expected 2 matches, got %d: %+v", results.Total, results.Matches)
+		}
+
+		first := results.Matches[0]
+		if first.VideoID != "video-1" {
+			t.Errorf("Expected video-1 first, got %s", first.VideoID)
+		}
+		// The matching segment is the third one, starting at 10s
+		if time.Duration(first.StartTime) != 10*time.Second {
+			t.Errorf("Expected match at 10s, got %v", time.Duration(first.StartTime))
+		}
+		if time.Duration(first.EndTime) != 15*time.Second {
+			t.Errorf("Expected match ending at 15s, got %v", time.Duration(first.EndTime))
+		}
+	})
+
+	t.Run("matching is case-insensitive", func(t *testing.T) {
+		service := newSearchService(t)
+		indexTranscript(t, service, "video-1", "en", "Bite The Bullet")
+
+		if results := searchFor(t, service, "bite the bullet"); results.Total != 1 {
+			t.Errorf("Expected a case-insensitive match, got %d", results.Total)
+		}
+	})
+
+	t.Run("re-indexing replaces previous segments", func(t *testing.T) {
+		service := newSearchService(t)
+		indexTranscript(t, service, "video-1", "en", "the old transcript text")
+		indexTranscript(t, service, "video-1", "en", "a corrected transcript text")
+
+		if results := searchFor(t, service, "old transcript"); results.Total != 0 {
+			t.Errorf("Expected stale segments to be gone, got %d matches", results.Total)
+		}
+		if results := searchFor(t, service, "corrected transcript"); results.Total != 1 {
+			t.Errorf("Expected the re-indexed segment, got %d matches", results.Total)
+		}
+	})
+
+	t.Run("removing a video drops its segments", func(t *testing.T) {
+		service := newSearchService(t)
+		indexTranscript(t, service, "video-1", "en", "soon to be removed")
+
+		if err := service.RemoveVideo("video-1"); err != nil {
+			t.Fatalf("RemoveVideo failed: %v", err)
+		}
+		if results := searchFor(t, service, "soon to be"); results.Total != 0 {
+			t.Errorf("Expected no matches after removal, got %d", results.Total)
+		}
+	})
+
+	t.Run("respects the result limit", func(t *testing.T) {
+		service := newSearchService(t)
+		indexTranscript(t, service, "video-1", "en",
+			"repeat after me", "repeat after me", "repeat after me")
+
+		results, err := service.SearchTranscripts(&dto.SearchTranscriptsRequest{Query: "repeat after", Limit: 2})
+		if err != nil {
+			t.Fatalf("SearchTranscripts failed: %v", err)
+		}
+		if results.Total != 2 {
+			t.Errorf("Expected the limit to cap results at 2, got %d", results.Total)
+		}
+	})
+
+	t.Run("rejects queries that are too short", func(t *testing.T) {
+		service := newSearchService(t)
+
+		_, err := service.SearchTranscripts(&dto.SearchTranscriptsRequest{Query: " a "})
+		appErr, ok := err.(*errors.AppError)
+		if !ok || appErr.Status != 400 {
+			t.Errorf("Expected a 400 AppError for a short query, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,168 @@
+package sessioninfo_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"app-backend/internal/config"
+	"app-backend/internal/logger"
+	"app-backend/internal/services/sessioninfo"
+)
+
+func newEnrichmentService(t *testing.T, cfg *config.SessionGeoConfig) sessioninfo.ServiceInterface {
+	t.Helper()
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return sessioninfo.NewService(cfg, log)
+}
+
+func TestParseUserAgent(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		browser   string
+		os        string
+	}{
+		{
+			name:      "chrome on macos",
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			browser:   "Chrome",
+			os:        "macOS",
+		},
+		{
+			name:      "firefox on windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:121.0) Gecko/20100101 Firefox/121.0",
+			browser:   "Firefox",
+			os:        "Windows",
+		},
+		{
+			name:      "edge is not reported as chrome",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.0.0",
+			browser:   "Edge",
+			os:        "Windows",
+		},
+		{
+			name:      "safari on ios",
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1",
+			browser:   "Safari",
+			os:        "iOS",
+		},
+		{
+			name:      "unknown agent stays empty",
+			userAgent: "curl/8.4.0",
+			browser:   "",
+			os:        "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed := sessioninfo.ParseUserAgent(tt.userAgent)
+			if parsed.Browser != tt.browser {
+				t.Errorf("Expected browser %q, got %q", tt.browser, parsed.Browser)
+			}
+			if parsed.OS != tt.os {
+				t.Errorf("Expected OS %q, got %q", tt.os, parsed.OS)
+			}
+		})
+	}
+}
+
+func TestEnrichmentLabel(t *testing.T) {
+	tests := []struct {
+		name       string
+		enrichment sessioninfo.Enrichment
+		label      string
+	}{
+		{
+			name:       "browser, os and location",
+			enrichment: sessioninfo.Enrichment{Browser: "Chrome", OS: "macOS", Location: "Hanoi, Vietnam"},
+			label:      "Chrome on macOS, Hanoi, Vietnam",
+		},
+		{
+			name:       "no location",
+			enrichment: sessioninfo.Enrichment{Browser: "Firefox", OS: "Windows"},
+			label:      "Firefox on Windows",
+		},
+		{
+			name:       "nothing parsed",
+			enrichment: sessioninfo.Enrichment{},
+			label:      "Unknown device",
+		},
+		{
+			name:       "location only",
+			enrichment: sessioninfo.Enrichment{Location: "Hanoi"},
+			label:      "Unknown device, Hanoi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.enrichment.Label(); got != tt.label {
+				t.Errorf("Expected label %q, got %q", tt.label, got)
+			}
+		})
+	}
+}
+
+func TestEnrichLocation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("private addresses are labeled without a lookup", func(t *testing.T) {
+		service := newEnrichmentService(t, &config.SessionGeoConfig{Enabled: true, Endpoint: "http://never-called.invalid"})
+
+		for _, ip := range []string{"127.0.0.1", "192.168.1.10", "10.0.0.5"} {
+			enrichment := service.Enrich(ctx, ip, "")
+			if enrichment.Location != "Local network" {
+				t.Errorf("Expected Local network for %s, got %q", ip, enrichment.Location)
+			}
+		}
+	})
+
+	t.Run("disabled lookups leave the location empty", func(t *testing.T) {
+		service := newEnrichmentService(t, &config.SessionGeoConfig{})
+
+		if enrichment := service.Enrich(ctx, "8.8.8.8", ""); enrichment.Location != "" {
+			t.Errorf("Expected empty location, got %q", enrichment.Location)
+		}
+	})
+
+	t.Run("public addresses resolve through the endpoint and cache", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"city": "Hanoi", "country": "Vietnam"}`))
+		}))
+		defer server.Close()
+
+		service := newEnrichmentService(t, &config.SessionGeoConfig{Enabled: true, Endpoint: server.URL})
+
+		for i := 0; i < 2; i++ {
+			enrichment := service.Enrich(ctx, "203.0.113.7", "")
+			if enrichment.Location != "Hanoi, Vietnam" {
+				t.Fatalf("Expected Hanoi, Vietnam, got %q", enrichment.Location)
+			}
+		}
+		if requests != 1 {
+			t.Errorf("Expected 1 lookup thanks to caching, got %d", requests)
+		}
+	})
+
+	t.Run("failed lookups degrade to an empty location", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		service := newEnrichmentService(t, &config.SessionGeoConfig{Enabled: true, Endpoint: server.URL})
+
+		if enrichment := service.Enrich(ctx, "203.0.113.8", ""); enrichment.Location != "" {
+			t.Errorf("Expected empty location on failure, got %q", enrichment.Location)
+		}
+	})
+}
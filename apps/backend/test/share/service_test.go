@@ -0,0 +1,151 @@
+package share_test
+
+import (
+	goerrors "errors"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/services/share"
+	"app-backend/internal/types"
+)
+
+func newShareService(t *testing.T, defaultTTL, maxTTL time.Duration) share.ServiceInterface {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	return share.NewService("test-secret", defaultTTL, maxTTL, bcrypt.MinCost, log)
+}
+
+// isStatus reports whether err is an AppError with the given HTTP status
+func isStatus(err error, status int) bool {
+	var appErr *errors.AppError
+	return goerrors.As(err, &appErr) && appErr.Status == status
+}
+
+func params() share.CreateLinkParams {
+	return share.CreateLinkParams{
+		Provider:   types.VideoProvider("youtube"),
+		VideoID:    "dQw4w9WgXcQ",
+		SourceLang: "en",
+		TargetLang: "vi",
+	}
+}
+
+func TestShareLinks(t *testing.T) {
+	t.Run("tokens round-trip their payload", func(t *testing.T) {
+		service := newShareService(t, time.Hour, 24*time.Hour)
+
+		token, expiresAt, err := service.CreateLink(params())
+		if err != nil {
+			t.Fatalf("CreateLink failed: %v", err)
+		}
+		if remaining := time.Until(expiresAt); remaining < 55*time.Minute || remaining > time.Hour {
+			t.Errorf("Expected the default TTL, got expiry in %s", remaining)
+		}
+
+		payload, err := service.ResolveToken(token, "")
+		if err != nil {
+			t.Fatalf("ResolveToken failed: %v", err)
+		}
+		if payload.VideoID != "dQw4w9WgXcQ" || payload.TargetLang != "vi" || payload.SourceLang != "en" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+	})
+
+	t.Run("the requested TTL is clamped to the cap", func(t *testing.T) {
+		service := newShareService(t, time.Hour, 2*time.Hour)
+
+		p := params()
+		p.TTL = 100 * time.Hour
+		_, expiresAt, err := service.CreateLink(p)
+		if err != nil {
+			t.Fatalf("CreateLink failed: %v", err)
+		}
+		if time.Until(expiresAt) > 2*time.Hour {
+			t.Errorf("Expected the expiry to be clamped to 2h, got %s", time.Until(expiresAt))
+		}
+	})
+
+	t.Run("tampered tokens look like missing ones", func(t *testing.T) {
+		service := newShareService(t, time.Hour, 24*time.Hour)
+
+		token, _, err := service.CreateLink(params())
+		if err != nil {
+			t.Fatalf("CreateLink failed: %v", err)
+		}
+
+		// Re-encoding a different payload without re-signing must fail
+		encodedPayload, signature, _ := strings.Cut(token, ".")
+		tampered := encodedPayload[:len(encodedPayload)-2] + "xx" + "." + signature
+		if _, err := service.ResolveToken(tampered, ""); !isStatus(err, 404) {
+			t.Errorf("Expected 404 for a tampered token, got %v", err)
+		}
+		if _, err := service.ResolveToken("not-a-token", ""); !isStatus(err, 404) {
+			t.Errorf("Expected 404 for a malformed token, got %v", err)
+		}
+	})
+
+	t.Run("expired links are gone", func(t *testing.T) {
+		service := newShareService(t, time.Hour, 24*time.Hour)
+
+		p := params()
+		p.TTL = time.Millisecond
+		token, _, err := service.CreateLink(p)
+		if err != nil {
+			t.Fatalf("CreateLink failed: %v", err)
+		}
+
+		time.Sleep(5 * time.Millisecond)
+		if _, err := service.ResolveToken(token, ""); !isStatus(err, 410) {
+			t.Errorf("Expected 410 for an expired link, got %v", err)
+		}
+	})
+
+	t.Run("password-gated links require the password", func(t *testing.T) {
+		service := newShareService(t, time.Hour, 24*time.Hour)
+
+		p := params()
+		p.Password = "letmein"
+		token, _, err := service.CreateLink(p)
+		if err != nil {
+			t.Fatalf("CreateLink failed: %v", err)
+		}
+
+		if _, err := service.ResolveToken(token, ""); !isStatus(err, 401) {
+			t.Errorf("Expected 401 without the password, got %v", err)
+		}
+		if _, err := service.ResolveToken(token, "wrong"); !isStatus(err, 401) {
+			t.Errorf("Expected 401 for a wrong password, got %v", err)
+		}
+		if _, err := service.ResolveToken(token, "letmein"); err != nil {
+			t.Errorf("Expected the correct password to pass, got %v", err)
+		}
+	})
+
+	t.Run("tokens from another secret are rejected", func(t *testing.T) {
+		log, err := logger.New("development")
+		if err != nil {
+			t.Fatalf("Failed to create logger: %v", err)
+		}
+		other := share.NewService("other-secret", time.Hour, 24*time.Hour, bcrypt.MinCost, log)
+
+		token, _, err := other.CreateLink(params())
+		if err != nil {
+			t.Fatalf("CreateLink failed: %v", err)
+		}
+
+		service := newShareService(t, time.Hour, 24*time.Hour)
+		if _, err := service.ResolveToken(token, ""); !isStatus(err, 404) {
+			t.Errorf("Expected 404 for a foreign token, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,203 @@
+package stats_test
+
+import (
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/stats"
+	"app-backend/internal/services/uservideo"
+	"app-backend/internal/services/vocab"
+	"app-backend/test/testutil"
+)
+
+const dateLayout = "2006-01-02"
+
+func newStatsService(t *testing.T) (stats.ServiceInterface, repositories.DailyActivityRepositoryInterface, *gorm.DB) {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t, &models.User{}, &models.DailyActivity{}, &models.VocabItem{}, &models.UserVideo{})
+	repo := repositories.NewDailyActivityRepository(db)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	return stats.NewService(repo, log), repo, db
+}
+
+// daysAgo returns the UTC date string for n days before today
+func daysAgo(n int) string {
+	return time.Now().UTC().AddDate(0, 0, -n).Format(dateLayout)
+}
+
+// seedActivity marks a day active with one vocab review
+func seedActivity(t *testing.T, repo repositories.DailyActivityRepositoryInterface, userID uint, date string) {
+	t.Helper()
+
+	if err := repo.AddActivity(userID, date, &models.DailyActivity{VocabReviewed: 1}); err != nil {
+		t.Fatalf("AddActivity failed: %v", err)
+	}
+}
+
+func TestStatsService(t *testing.T) {
+	t.Run("activity accumulates into one row per day", func(t *testing.T) {
+		service, _, _ := newStatsService(t)
+
+		service.RecordVideoWatched(1)
+		service.RecordVideoWatched(1)
+		service.RecordTranslatedSegments(1, 25)
+		service.RecordVocabReviews(1, 3)
+		service.RecordStudyTime(1, 150)
+
+		report, err := service.GetStats(1, 7)
+		if err != nil {
+			t.Fatalf("GetStats failed: %v", err)
+		}
+
+		today := report.Days[len(report.Days)-1]
+		if today.VideosWatched != 2 || today.SegmentsTranslated != 25 || today.VocabReviewed != 3 {
+			t.Errorf("Unexpected counts for today: %+v", today)
+		}
+		if today.MinutesStudied != 2 {
+			t.Errorf("Expected 150 seconds to round down to 2 minutes, got %d", today.MinutesStudied)
+		}
+		if report.StreakDays != 1 {
+			t.Errorf("Expected a one-day streak, got %d", report.StreakDays)
+		}
+	})
+
+	t.Run("the range is zero-filled and totalled", func(t *testing.T) {
+		service, repo, _ := newStatsService(t)
+		seedActivity(t, repo, 1, daysAgo(2))
+
+		report, err := service.GetStats(1, 7)
+		if err != nil {
+			t.Fatalf("GetStats failed: %v", err)
+		}
+
+		if report.RangeDays != 7 || len(report.Days) != 7 {
+			t.Fatalf("Expected 7 zero-filled days, got %d", len(report.Days))
+		}
+		if report.Days[0].Date != daysAgo(6) || report.Days[6].Date != daysAgo(0) {
+			t.Errorf("Expected oldest-first days, got %s .. %s", report.Days[0].Date, report.Days[6].Date)
+		}
+		if report.TotalVocabReviewed != 1 {
+			t.Errorf("Expected totals over the range, got %+v", report)
+		}
+	})
+
+	t.Run("a streak survives an inactive today", func(t *testing.T) {
+		service, repo, _ := newStatsService(t)
+		seedActivity(t, repo, 1, daysAgo(1))
+		seedActivity(t, repo, 1, daysAgo(2))
+		seedActivity(t, repo, 1, daysAgo(3))
+
+		report, err := service.GetStats(1, 7)
+		if err != nil {
+			t.Fatalf("GetStats failed: %v", err)
+		}
+		if report.StreakDays != 3 {
+			t.Errorf("Expected a three-day streak with today still open, got %d", report.StreakDays)
+		}
+	})
+
+	t.Run("a missed day breaks the streak", func(t *testing.T) {
+		service, repo, _ := newStatsService(t)
+		seedActivity(t, repo, 1, daysAgo(0))
+		seedActivity(t, repo, 1, daysAgo(2))
+
+		report, err := service.GetStats(1, 7)
+		if err != nil {
+			t.Fatalf("GetStats failed: %v", err)
+		}
+		if report.StreakDays != 1 {
+			t.Errorf("Expected the gap to cut the streak to one day, got %d", report.StreakDays)
+		}
+	})
+
+	t.Run("users only see their own activity", func(t *testing.T) {
+		service, repo, _ := newStatsService(t)
+		seedActivity(t, repo, 2, daysAgo(0))
+
+		report, err := service.GetStats(1, 7)
+		if err != nil {
+			t.Fatalf("GetStats failed: %v", err)
+		}
+		if report.TotalVocabReviewed != 0 || report.StreakDays != 0 {
+			t.Errorf("Expected no activity for another user, got %+v", report)
+		}
+	})
+}
+
+func TestActivityRecorders(t *testing.T) {
+	t.Run("vocab reviews count toward the streak", func(t *testing.T) {
+		service, _, db := newStatsService(t)
+
+		log, err := logger.New("development")
+		if err != nil {
+			t.Fatalf("Failed to create logger: %v", err)
+		}
+		vocabSvc := vocab.NewService(repositories.NewVocabRepository(db), log)
+		vocabSvc.SetActivityRecorder(service)
+
+		item, err := vocabSvc.CreateItem(1, &dto.CreateVocabItemRequest{Word: "streak", Definition: "a run of days"})
+		if err != nil {
+			t.Fatalf("CreateItem failed: %v", err)
+		}
+		if _, err := vocabSvc.ReviewItem(1, item.ID, 5); err != nil {
+			t.Fatalf("ReviewItem failed: %v", err)
+		}
+
+		report, err := service.GetStats(1, 7)
+		if err != nil {
+			t.Fatalf("GetStats failed: %v", err)
+		}
+		if report.TotalVocabReviewed != 1 {
+			t.Errorf("Expected the review to be recorded, got %+v", report)
+		}
+	})
+
+	t.Run("watch sessions record videos and study time", func(t *testing.T) {
+		service, _, db := newStatsService(t)
+
+		log, err := logger.New("development")
+		if err != nil {
+			t.Fatalf("Failed to create logger: %v", err)
+		}
+		videoSvc := uservideo.NewService(repositories.NewUserVideoRepository(db), log)
+		videoSvc.SetActivityRecorder(service)
+
+		save := func(position float64) {
+			if _, err := videoSvc.SaveVideo(1, &dto.SaveUserVideoRequest{
+				VideoID:      "dQw4w9WgXcQ",
+				Title:        "Listening practice",
+				LastPosition: position,
+			}); err != nil {
+				t.Fatalf("SaveVideo failed: %v", err)
+			}
+		}
+
+		save(0)
+		save(120)
+		// A second same-day session must not count the video again
+		save(180)
+
+		report, err := service.GetStats(1, 7)
+		if err != nil {
+			t.Fatalf("GetStats failed: %v", err)
+		}
+		if report.TotalVideosWatched != 1 {
+			t.Errorf("Expected one video watched today, got %d", report.TotalVideosWatched)
+		}
+		if report.TotalMinutesStudied != 3 {
+			t.Errorf("Expected 180 seconds of study time, got %d minutes", report.TotalMinutesStudied)
+		}
+	})
+}
@@ -0,0 +1,254 @@
+package subtitle_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/jobs"
+	"app-backend/internal/services/subtitle"
+	"app-backend/internal/types"
+	"app-backend/test/testutil"
+)
+
+// fakeDualSource serves a fixed dual-language transcript
+type fakeDualSource struct {
+	dual *types.DualLanguageTranscript
+	err  error
+}
+
+func (f *fakeDualSource) DetectProvider(videoURL string) (types.VideoProvider, string, error) {
+	if videoURL == "" {
+		return "", "", fmt.Errorf("empty video URL")
+	}
+	return types.ProviderYouTube, videoURL, nil
+}
+
+func (f *fakeDualSource) GetDualLanguageTranscript(_ context.Context, _ types.VideoProvider, videoID string, sourceLang string, targetLang string, _ map[int]string) (*types.DualLanguageTranscript, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.dual, nil
+}
+
+func twoSegmentDual() *types.DualLanguageTranscript {
+	return &types.DualLanguageTranscript{
+		VideoID:    "sub-video",
+		Provider:   types.ProviderYouTube,
+		SourceLang: "en",
+		TargetLang: "es",
+		Segments: []types.TranscriptSegment{
+			{Index: 1, StartTime: 0, EndTime: types.MillisecondDuration(2 * time.Second), Text: "Hello there"},
+			{Index: 2, StartTime: types.MillisecondDuration(2 * time.Second), EndTime: types.MillisecondDuration(5 * time.Second), Text: "Welcome back"},
+		},
+		Translations: []types.TranslatedSegment{
+			{Index: 1, OriginalText: "Hello there", TranslatedText: "Hola"},
+			{Index: 2, OriginalText: "Welcome back", TranslatedText: "Bienvenido"},
+		},
+	}
+}
+
+// newSubtitleService wires the generator onto a job queue backed by an
+// in-memory database; a single failed attempt is permanent so tests settle
+// deterministically
+func newSubtitleService(t *testing.T, source *fakeDualSource) (subtitle.ServiceInterface, jobs.ServiceInterface) {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	db := testutil.SetupTestDB(t, &models.User{}, &models.Job{})
+	queue := jobs.NewService(repositories.NewJobRepository(db), jobs.Config{
+		PollInterval: 10 * time.Millisecond,
+		MaxAttempts:  1,
+		RetryBackoff: 10 * time.Millisecond,
+	}, log)
+
+	service := subtitle.NewService(source, queue, log)
+
+	queue.Start()
+	t.Cleanup(queue.Stop)
+
+	return service, queue
+}
+
+// awaitJob polls until the job reaches a final state
+func awaitJob(t *testing.T, queue jobs.ServiceInterface, userID uint, jobID uint) *models.Job {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := queue.GetJob(userID, jobID)
+		if err != nil {
+			t.Fatalf("GetJob failed: %v", err)
+		}
+		if job.IsTerminal() {
+			return job
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("Timed out waiting for subtitle job to finish")
+	return nil
+}
+
+func appErrorStatus(t *testing.T, err error) int {
+	t.Helper()
+	appErr, ok := err.(*errors.AppError)
+	if !ok {
+		t.Fatalf("Expected an AppError, got %T: %v", err, err)
+	}
+	return appErr.Status
+}
+
+func TestStartGeneration(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("renders a bilingual SRT file", func(t *testing.T) {
+		service, queue := newSubtitleService(t, &fakeDualSource{dual: twoSegmentDual()})
+
+		job, err := service.StartGeneration(ctx, 1, "sub-video", "en", "es", "srt", subtitle.Style{})
+		if err != nil {
+			t.Fatalf("StartGeneration failed: %v", err)
+		}
+
+		finished := awaitJob(t, queue, 1, job.ID)
+		if finished.Status != models.JobStatusCompleted {
+			t.Fatalf("Expected completed job, got %s (%s)", finished.Status, finished.LastError)
+		}
+
+		file, err := service.GetFile(1, job.ID)
+		if err != nil {
+			t.Fatalf("GetFile failed: %v", err)
+		}
+		if file.Filename != "sub-video.en-es.srt" {
+			t.Errorf("Unexpected filename %q", file.Filename)
+		}
+
+		content := string(file.Content)
+		if !strings.Contains(content, "00:00:00,000 --> 00:00:02,000") {
+			t.Errorf("Expected SRT cue timing, got:\n%s", content)
+		}
+		if !strings.Contains(content, "Hello there\n<font color=\"#FFD700\">Hola</font>") {
+			t.Errorf("Expected the translation under the original line, got:\n%s", content)
+		}
+	})
+
+	t.Run("renders an ASS file with the configured styling", func(t *testing.T) {
+		service, queue := newSubtitleService(t, &fakeDualSource{dual: twoSegmentDual()})
+
+		job, err := service.StartGeneration(ctx, 1, "sub-video", "en", "es", "ass", subtitle.Style{
+			TranslationColor: "#00FF80",
+			TranslationSize:  28,
+		})
+		if err != nil {
+			t.Fatalf("StartGeneration failed: %v", err)
+		}
+
+		awaitJob(t, queue, 1, job.ID)
+		file, err := service.GetFile(1, job.ID)
+		if err != nil {
+			t.Fatalf("GetFile failed: %v", err)
+		}
+
+		content := string(file.Content)
+		// #00FF80 renders as ASS blue-green-red &H0080FF00
+		if !strings.Contains(content, "Style: Translation,Arial,28,&H0080FF00") {
+			t.Errorf("Expected the translation style with color and size, got:\n%s", content)
+		}
+		if !strings.Contains(content, "Dialogue: 0,0:00:00.00,0:00:02.00,Original,,0,0,0,,Hello there") {
+			t.Errorf("Expected the original dialogue line, got:\n%s", content)
+		}
+		if !strings.Contains(content, "Dialogue: 0,0:00:00.00,0:00:02.00,Translation,,0,0,0,,Hola") {
+			t.Errorf("Expected the translation dialogue line, got:\n%s", content)
+		}
+	})
+
+	t.Run("rejects an unknown format", func(t *testing.T) {
+		service, _ := newSubtitleService(t, &fakeDualSource{dual: twoSegmentDual()})
+
+		_, err := service.StartGeneration(ctx, 1, "sub-video", "en", "es", "mkv", subtitle.Style{})
+		if status := appErrorStatus(t, err); status != http.StatusBadRequest {
+			t.Errorf("Expected 400 for an unknown format, got %d", status)
+		}
+	})
+
+	t.Run("rejects a malformed translation color", func(t *testing.T) {
+		service, _ := newSubtitleService(t, &fakeDualSource{dual: twoSegmentDual()})
+
+		_, err := service.StartGeneration(ctx, 1, "sub-video", "en", "es", "srt", subtitle.Style{
+			TranslationColor: "gold",
+		})
+		if status := appErrorStatus(t, err); status != http.StatusBadRequest {
+			t.Errorf("Expected 400 for a malformed color, got %d", status)
+		}
+	})
+
+	t.Run("failed transcript fetches fail the job", func(t *testing.T) {
+		service, queue := newSubtitleService(t, &fakeDualSource{err: fmt.Errorf("no transcript")})
+
+		job, err := service.StartGeneration(ctx, 1, "sub-video", "en", "es", "srt", subtitle.Style{})
+		if err != nil {
+			t.Fatalf("StartGeneration failed: %v", err)
+		}
+
+		finished := awaitJob(t, queue, 1, job.ID)
+		if finished.Status != models.JobStatusFailed {
+			t.Fatalf("Expected failed job, got %s", finished.Status)
+		}
+
+		_, err = service.GetFile(1, job.ID)
+		if status := appErrorStatus(t, err); status != http.StatusBadGateway {
+			t.Errorf("Expected 502 for a failed generation, got %d", status)
+		}
+	})
+}
+
+func TestGetFile(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("refuses a job that is not finished", func(t *testing.T) {
+		// No workers poll the queue, so the job stays queued
+		log, err := logger.New("development")
+		if err != nil {
+			t.Fatalf("Failed to create logger: %v", err)
+		}
+		db := testutil.SetupTestDB(t, &models.User{}, &models.Job{})
+		queue := jobs.NewService(repositories.NewJobRepository(db), jobs.Config{
+			PollInterval: time.Hour,
+		}, log)
+		service := subtitle.NewService(&fakeDualSource{dual: twoSegmentDual()}, queue, log)
+
+		job, err := service.StartGeneration(ctx, 1, "sub-video", "en", "es", "srt", subtitle.Style{})
+		if err != nil {
+			t.Fatalf("StartGeneration failed: %v", err)
+		}
+
+		_, err = service.GetFile(1, job.ID)
+		if status := appErrorStatus(t, err); status != http.StatusConflict {
+			t.Errorf("Expected 409 while the job is still queued, got %d", status)
+		}
+	})
+
+	t.Run("refuses a job of another type", func(t *testing.T) {
+		service, queue := newSubtitleService(t, &fakeDualSource{dual: twoSegmentDual()})
+
+		other, err := queue.Enqueue(1, "some_other_job", "{}")
+		if err != nil {
+			t.Fatalf("Enqueue failed: %v", err)
+		}
+
+		_, err = service.GetFile(1, other.ID)
+		if status := appErrorStatus(t, err); status != http.StatusNotFound {
+			t.Errorf("Expected 404 for a non-subtitle job, got %d", status)
+		}
+	})
+}
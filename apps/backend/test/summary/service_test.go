@@ -0,0 +1,188 @@
+package summary_test
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/services/summary"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+	"app-backend/internal/types"
+	"app-backend/pkg/gemini"
+)
+
+// countingSummarizer fakes the model backend and counts how often it is
+// called
+type countingSummarizer struct {
+	calls int32
+	err   error
+}
+
+func (s *countingSummarizer) summarize(ctx context.Context, transcript string, lang string, depth string) (*gemini.TranscriptSummary, error) {
+	atomic.AddInt32(&s.calls, 1)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &gemini.TranscriptSummary{
+		Overview:  "[" + depth + "] overview",
+		MainIdeas: []string{"idea one", "idea two"},
+		Sections: []gemini.SummarySection{
+			{Title: "Intro", StartSeconds: 0, EndSeconds: 30, Summary: "the opening"},
+			{Title: "Main point", StartSeconds: 30, EndSeconds: 90, Summary: "the argument"},
+		},
+		Vocabulary: []gemini.SummaryVocabularyItem{
+			{Term: "take away", Meaning: "the main lesson"},
+		},
+	}, nil
+}
+
+func newSummaryTestService(t *testing.T, backend *countingSummarizer, cfg summary.Config) summary.ServiceInterface {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return summary.NewService(backend.summarize, cfg, log)
+}
+
+func isStatus(err error, status int) bool {
+	var appErr *appErrors.AppError
+	return goerrors.As(err, &appErr) && appErr.Status == status
+}
+
+func segments(texts ...string) []transcriptTypes.TranscriptSegment {
+	result := make([]transcriptTypes.TranscriptSegment, len(texts))
+	for i, text := range texts {
+		result[i] = transcriptTypes.TranscriptSegment{
+			Text:     text,
+			Start:    time.Duration(i) * 10 * time.Second,
+			Duration: 10 * time.Second,
+		}
+	}
+	return result
+}
+
+func TestSummarize(t *testing.T) {
+	ctx := context.Background()
+	provider := types.VideoProvider("youtube")
+
+	t.Run("returns a chaptered summary", func(t *testing.T) {
+		service := newSummaryTestService(t, &countingSummarizer{}, summary.Config{})
+
+		resp, err := service.Summarize(ctx, 1, provider, "video-1", "en", "", segments("hello", "world"))
+		if err != nil {
+			t.Fatalf("Summarize failed: %v", err)
+		}
+		if resp.Overview == "" || len(resp.Sections) != 2 || len(resp.MainIdeas) != 2 {
+			t.Errorf("Unexpected summary: %+v", resp)
+		}
+		if resp.Depth != "standard" {
+			t.Errorf("Expected the default depth, got %q", resp.Depth)
+		}
+		if resp.Sections[1].StartSeconds != 30 {
+			t.Errorf("Expected section timestamps to carry through, got %+v", resp.Sections[1])
+		}
+		if resp.Cached {
+			t.Error("First summary must not be marked cached")
+		}
+	})
+
+	t.Run("serves repeated requests from the cache", func(t *testing.T) {
+		backend := &countingSummarizer{}
+		service := newSummaryTestService(t, backend, summary.Config{})
+
+		if _, err := service.Summarize(ctx, 1, provider, "video-1", "en", "brief", segments("hello")); err != nil {
+			t.Fatalf("Summarize failed: %v", err)
+		}
+		resp, err := service.Summarize(ctx, 2, provider, "video-1", "en", "brief", segments("hello"))
+		if err != nil {
+			t.Fatalf("Second Summarize failed: %v", err)
+		}
+		if !resp.Cached {
+			t.Error("Repeated summary must be marked cached")
+		}
+		if calls := atomic.LoadInt32(&backend.calls); calls != 1 {
+			t.Errorf("Expected 1 model call, got %d", calls)
+		}
+	})
+
+	t.Run("a changed transcript is summarized fresh", func(t *testing.T) {
+		backend := &countingSummarizer{}
+		service := newSummaryTestService(t, backend, summary.Config{})
+
+		if _, err := service.Summarize(ctx, 1, provider, "video-1", "en", "brief", segments("hello")); err != nil {
+			t.Fatalf("Summarize failed: %v", err)
+		}
+		resp, err := service.Summarize(ctx, 1, provider, "video-1", "en", "brief", segments("hello", "a new segment"))
+		if err != nil {
+			t.Fatalf("Second Summarize failed: %v", err)
+		}
+		if resp.Cached {
+			t.Error("A changed transcript must not hit the cache")
+		}
+		if calls := atomic.LoadInt32(&backend.calls); calls != 2 {
+			t.Errorf("Expected 2 model calls, got %d", calls)
+		}
+	})
+
+	t.Run("depth is part of the cache key", func(t *testing.T) {
+		backend := &countingSummarizer{}
+		service := newSummaryTestService(t, backend, summary.Config{})
+
+		if _, err := service.Summarize(ctx, 1, provider, "video-1", "en", "brief", segments("hello")); err != nil {
+			t.Fatalf("Summarize failed: %v", err)
+		}
+		resp, err := service.Summarize(ctx, 1, provider, "video-1", "en", "detailed", segments("hello"))
+		if err != nil {
+			t.Fatalf("Second Summarize failed: %v", err)
+		}
+		if resp.Cached || resp.Depth != "detailed" {
+			t.Errorf("Expected a fresh detailed summary, got %+v", resp)
+		}
+		if calls := atomic.LoadInt32(&backend.calls); calls != 2 {
+			t.Errorf("Expected 2 model calls, got %d", calls)
+		}
+	})
+
+	t.Run("fresh summaries are rate limited per user", func(t *testing.T) {
+		service := newSummaryTestService(t, &countingSummarizer{}, summary.Config{RequestsPerMinute: 2})
+
+		for i := 0; i < 2; i++ {
+			if _, err := service.Summarize(ctx, 1, provider, fmt.Sprintf("video-%d", i), "en", "", segments("hello")); err != nil {
+				t.Fatalf("Summarize %d failed: %v", i, err)
+			}
+		}
+		if _, err := service.Summarize(ctx, 1, provider, "video-9", "en", "", segments("hello")); !isStatus(err, http.StatusTooManyRequests) {
+			t.Errorf("Expected 429 past the limit, got %v", err)
+		}
+
+		// Another user has their own budget
+		if _, err := service.Summarize(ctx, 2, provider, "video-9", "en", "", segments("hello")); err != nil {
+			t.Errorf("Expected another user to pass, got %v", err)
+		}
+	})
+
+	t.Run("an empty transcript is rejected", func(t *testing.T) {
+		service := newSummaryTestService(t, &countingSummarizer{}, summary.Config{})
+
+		if _, err := service.Summarize(ctx, 1, provider, "video-1", "en", "", nil); !isStatus(err, http.StatusBadRequest) {
+			t.Errorf("Expected 400 for an empty transcript, got %v", err)
+		}
+	})
+
+	t.Run("model failures surface as bad gateway", func(t *testing.T) {
+		backend := &countingSummarizer{err: goerrors.New("model exploded")}
+		service := newSummaryTestService(t, backend, summary.Config{})
+
+		if _, err := service.Summarize(ctx, 1, provider, "video-1", "en", "", segments("hello")); !isStatus(err, http.StatusBadGateway) {
+			t.Errorf("Expected 502 when the model fails, got %v", err)
+		}
+	})
+}
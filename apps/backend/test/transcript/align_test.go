@@ -0,0 +1,110 @@
+package transcript_test
+
+import (
+	"testing"
+	"time"
+
+	"app-backend/internal/services/transcript"
+	"app-backend/internal/services/transcript/types"
+)
+
+func seg(startMs, durationMs int, text string) types.TranscriptSegment {
+	return types.TranscriptSegment{
+		Text:     text,
+		Start:    time.Duration(startMs) * time.Millisecond,
+		Duration: time.Duration(durationMs) * time.Millisecond,
+	}
+}
+
+func TestAlignByTime(t *testing.T) {
+	t.Run("exactly matching segments pair one-to-one", func(t *testing.T) {
+		a := []types.TranscriptSegment{seg(0, 1000, "a1"), seg(1000, 1000, "a2")}
+		b := []types.TranscriptSegment{seg(0, 1000, "b1"), seg(1000, 1000, "b2")}
+
+		pairs := transcript.AlignByTimeWithTolerance(a, b, 0)
+
+		if len(pairs) != 2 {
+			t.Fatalf("Expected 2 pairs, got %d", len(pairs))
+		}
+		for i, pair := range pairs {
+			if len(pair.A) != 1 || len(pair.B) != 1 {
+				t.Fatalf("Pair %d: expected one segment per side, got a=%d b=%d", i, len(pair.A), len(pair.B))
+			}
+		}
+		if pairs[0].A[0].Text != "a1" || pairs[0].B[0].Text != "b1" {
+			t.Errorf("Expected a1/b1 in the first pair, got %s/%s", pairs[0].A[0].Text, pairs[0].B[0].Text)
+		}
+	})
+
+	t.Run("partially overlapping segments pair up", func(t *testing.T) {
+		a := []types.TranscriptSegment{seg(0, 1000, "a1")}
+		b := []types.TranscriptSegment{seg(600, 1000, "b1")}
+
+		pairs := transcript.AlignByTime(a, b)
+
+		if len(pairs) != 1 {
+			t.Fatalf("Expected 1 pair, got %d", len(pairs))
+		}
+		if len(pairs[0].A) != 1 || len(pairs[0].B) != 1 {
+			t.Errorf("Expected one segment per side, got a=%d b=%d", len(pairs[0].A), len(pairs[0].B))
+		}
+	})
+
+	t.Run("one segment spanning several maps one-to-many", func(t *testing.T) {
+		a := []types.TranscriptSegment{seg(0, 3000, "a1")}
+		b := []types.TranscriptSegment{seg(0, 1000, "b1"), seg(1000, 1000, "b2"), seg(2000, 1000, "b3")}
+
+		pairs := transcript.AlignByTimeWithTolerance(a, b, 0)
+
+		if len(pairs) != 1 {
+			t.Fatalf("Expected 1 pair, got %d", len(pairs))
+		}
+		if len(pairs[0].A) != 1 || len(pairs[0].B) != 3 {
+			t.Errorf("Expected 1 A segment against 3 B segments, got a=%d b=%d", len(pairs[0].A), len(pairs[0].B))
+		}
+	})
+
+	t.Run("segments without a counterpart become single-sided pairs", func(t *testing.T) {
+		a := []types.TranscriptSegment{seg(0, 1000, "a1"), seg(5000, 1000, "a2")}
+		b := []types.TranscriptSegment{seg(0, 1000, "b1")}
+
+		pairs := transcript.AlignByTimeWithTolerance(a, b, 0)
+
+		if len(pairs) != 2 {
+			t.Fatalf("Expected 2 pairs, got %d", len(pairs))
+		}
+		gap := pairs[1]
+		if len(gap.A) != 1 || gap.A[0].Text != "a2" || len(gap.B) != 0 {
+			t.Errorf("Expected a2 alone in the gap pair, got a=%v b=%v", gap.A, gap.B)
+		}
+	})
+
+	t.Run("tolerance bridges slightly offset timings", func(t *testing.T) {
+		a := []types.TranscriptSegment{seg(0, 1000, "a1")}
+		b := []types.TranscriptSegment{seg(1100, 1000, "b1")}
+
+		if pairs := transcript.AlignByTimeWithTolerance(a, b, 0); len(pairs) != 2 {
+			t.Fatalf("Expected disjoint segments without tolerance, got %d pairs", len(pairs))
+		}
+		if pairs := transcript.AlignByTimeWithTolerance(a, b, 200*time.Millisecond); len(pairs) != 1 {
+			t.Fatalf("Expected tolerance to bridge the 100ms gap, got %d pairs", len(pairs))
+		}
+	})
+
+	t.Run("empty tracks align to single-sided pairs", func(t *testing.T) {
+		b := []types.TranscriptSegment{seg(0, 1000, "b1")}
+
+		pairs := transcript.AlignByTime(nil, b)
+
+		if len(pairs) != 1 {
+			t.Fatalf("Expected 1 pair, got %d", len(pairs))
+		}
+		if len(pairs[0].A) != 0 || len(pairs[0].B) != 1 {
+			t.Errorf("Expected an empty A side, got a=%d b=%d", len(pairs[0].A), len(pairs[0].B))
+		}
+
+		if pairs := transcript.AlignByTime(nil, nil); len(pairs) != 0 {
+			t.Errorf("Expected no pairs for empty inputs, got %d", len(pairs))
+		}
+	})
+}
@@ -0,0 +1,241 @@
+package transcript_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/services/transcript/cache"
+	"app-backend/internal/services/transcript/types"
+)
+
+func newTestTranscript(provider string) *types.Transcript {
+	return &types.Transcript{
+		VideoID:  "test-video",
+		Language: "en",
+		Provider: provider,
+		Segments: []types.TranscriptSegment{
+			{Text: "hello", Start: 0, Duration: time.Second},
+		},
+		CreatedAt: time.Now(),
+	}
+}
+
+func newTestLogger(t *testing.T) *logger.Logger {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return log
+}
+
+func TestMemoryCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("miss fetches and stores", func(t *testing.T) {
+		c := cache.NewMemoryCache(cache.Config{
+			MaxAge:     time.Minute,
+			StaleAfter: time.Minute,
+		}, newTestLogger(t))
+
+		var fetches int32
+		fetch := func(ctx context.Context) (*types.Transcript, error) {
+			atomic.AddInt32(&fetches, 1)
+			return newTestTranscript("fetch"), nil
+		}
+
+		transcript, cached, err := c.GetOrFetch(ctx, cache.Key("test-video", "en"), fetch)
+		if err != nil {
+			t.Fatalf("GetOrFetch failed: %v", err)
+		}
+		if cached {
+			t.Error("First call should not be served from cache")
+		}
+		if transcript == nil || transcript.VideoID != "test-video" {
+			t.Error("Expected fetched transcript")
+		}
+
+		// Second call should hit the cache without fetching
+		_, cached, err = c.GetOrFetch(ctx, cache.Key("test-video", "en"), fetch)
+		if err != nil {
+			t.Fatalf("GetOrFetch failed: %v", err)
+		}
+		if !cached {
+			t.Error("Second call should be served from cache")
+		}
+		if got := atomic.LoadInt32(&fetches); got != 1 {
+			t.Errorf("Expected 1 fetch, got %d", got)
+		}
+	})
+
+	t.Run("stale entry served while background refresh updates cache", func(t *testing.T) {
+		c := cache.NewMemoryCache(cache.Config{
+			MaxAge:     time.Minute,
+			StaleAfter: 30 * time.Millisecond,
+		}, newTestLogger(t))
+
+		key := cache.Key("test-video", "en")
+		c.Set(key, newTestTranscript("original"))
+
+		// Let the entry pass the soft staleness threshold
+		time.Sleep(50 * time.Millisecond)
+
+		refreshed := make(chan struct{})
+		fetch := func(ctx context.Context) (*types.Transcript, error) {
+			defer close(refreshed)
+			return newTestTranscript("refreshed"), nil
+		}
+
+		transcript, cached, err := c.GetOrFetch(ctx, key, fetch)
+		if err != nil {
+			t.Fatalf("GetOrFetch failed: %v", err)
+		}
+		if !cached {
+			t.Error("Stale entry should still be served from cache")
+		}
+		if transcript.Provider != "original" {
+			t.Errorf("Expected stale transcript to be served, got provider %s", transcript.Provider)
+		}
+
+		// Background refresh should replace the entry
+		select {
+		case <-refreshed:
+		case <-time.After(2 * time.Second):
+			t.Fatal("Background refresh did not run")
+		}
+
+		// Give Set a moment to complete after fetch returns
+		deadline := time.Now().Add(time.Second)
+		for {
+			transcript, cached, err = c.GetOrFetch(ctx, key, func(ctx context.Context) (*types.Transcript, error) {
+				return nil, fmt.Errorf("unexpected fetch")
+			})
+			if err != nil {
+				t.Fatalf("GetOrFetch failed: %v", err)
+			}
+			if cached && transcript.Provider == "refreshed" {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("Cache was not updated by background refresh, got provider %s", transcript.Provider)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	})
+
+	t.Run("invalidate drops every language for the video", func(t *testing.T) {
+		c := cache.NewMemoryCache(cache.Config{
+			MaxAge:     time.Minute,
+			StaleAfter: time.Minute,
+		}, newTestLogger(t))
+
+		c.Set(cache.Key("test-video", "en"), newTestTranscript("original"))
+		c.Set(cache.Key("test-video", "de"), newTestTranscript("original"))
+		c.Set(cache.Key("other-video", "en"), newTestTranscript("original"))
+
+		if err := c.Invalidate(ctx, "test-video"); err != nil {
+			t.Fatalf("Invalidate failed: %v", err)
+		}
+
+		for _, lang := range []string{"en", "de"} {
+			_, cached, err := c.GetOrFetch(ctx, cache.Key("test-video", lang), func(ctx context.Context) (*types.Transcript, error) {
+				return newTestTranscript("refetched"), nil
+			})
+			if err != nil {
+				t.Fatalf("GetOrFetch failed: %v", err)
+			}
+			if cached {
+				t.Errorf("Expected a cache miss for language %s after invalidation", lang)
+			}
+		}
+
+		_, cached, err := c.GetOrFetch(ctx, cache.Key("other-video", "en"), func(ctx context.Context) (*types.Transcript, error) {
+			return nil, fmt.Errorf("unexpected fetch")
+		})
+		if err != nil {
+			t.Fatalf("GetOrFetch failed: %v", err)
+		}
+		if !cached {
+			t.Error("Expected other videos to stay cached after invalidation")
+		}
+	})
+
+	t.Run("expired entry is served stale when the fetch fails", func(t *testing.T) {
+		c := cache.NewMemoryCache(cache.Config{
+			MaxAge:            30 * time.Millisecond,
+			StaleAfter:        10 * time.Millisecond,
+			ServeStaleOnError: true,
+		}, newTestLogger(t))
+
+		key := cache.Key("test-video", "en")
+		c.Set(key, newTestTranscript("original"))
+
+		// Let the entry pass the hard limit
+		time.Sleep(50 * time.Millisecond)
+
+		transcript, cached, err := c.GetOrFetch(ctx, key, func(ctx context.Context) (*types.Transcript, error) {
+			return nil, fmt.Errorf("all providers failed")
+		})
+		if err != nil {
+			t.Fatalf("Expected the stale entry instead of an error, got: %v", err)
+		}
+		if !cached {
+			t.Error("Expected the stale result to be reported as cached")
+		}
+		if !transcript.Stale {
+			t.Error("Expected the served transcript to be marked Stale")
+		}
+		if transcript.Provider != "original" {
+			t.Errorf("Expected the original cached transcript, got provider %s", transcript.Provider)
+		}
+	})
+
+	t.Run("fetch failures surface when the stale policy is disabled", func(t *testing.T) {
+		c := cache.NewMemoryCache(cache.Config{
+			MaxAge:     30 * time.Millisecond,
+			StaleAfter: 10 * time.Millisecond,
+		}, newTestLogger(t))
+
+		key := cache.Key("test-video", "en")
+		c.Set(key, newTestTranscript("original"))
+		time.Sleep(50 * time.Millisecond)
+
+		_, _, err := c.GetOrFetch(ctx, key, func(ctx context.Context) (*types.Transcript, error) {
+			return nil, fmt.Errorf("all providers failed")
+		})
+		if err == nil {
+			t.Fatal("Expected the fetch error to surface with the policy disabled")
+		}
+	})
+
+	t.Run("entry past max age is refetched synchronously", func(t *testing.T) {
+		c := cache.NewMemoryCache(cache.Config{
+			MaxAge:     30 * time.Millisecond,
+			StaleAfter: 10 * time.Millisecond,
+		}, newTestLogger(t))
+
+		key := cache.Key("test-video", "en")
+		c.Set(key, newTestTranscript("original"))
+
+		// Let the entry pass the hard limit
+		time.Sleep(50 * time.Millisecond)
+
+		transcript, cached, err := c.GetOrFetch(ctx, key, func(ctx context.Context) (*types.Transcript, error) {
+			return newTestTranscript("fresh"), nil
+		})
+		if err != nil {
+			t.Fatalf("GetOrFetch failed: %v", err)
+		}
+		if cached {
+			t.Error("Expired entry should not be served from cache")
+		}
+		if transcript.Provider != "fresh" {
+			t.Errorf("Expected fresh transcript, got provider %s", transcript.Provider)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package transcript_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	"app-backend/internal/services/transcript/types"
+)
+
+// blockingProvider blocks until its release channel is closed, ignoring the
+// request context, and counts how often it was called
+type blockingProvider struct {
+	release chan struct{}
+	calls   int32
+}
+
+func (p *blockingProvider) GetTranscript(ctx context.Context, req *types.TranscriptRequest) (*types.Transcript, error) {
+	atomic.AddInt32(&p.calls, 1)
+	<-p.release
+	return nil, errors.New("released without result")
+}
+
+func (p *blockingProvider) GetVideoID(url string) (string, error) { return url, nil }
+func (p *blockingProvider) IsAvailable(ctx context.Context) bool  { return true }
+func (p *blockingProvider) GetProviderType() types.ProviderType {
+	return types.ProviderType("blocking")
+}
+func (p *blockingProvider) GetPriority() int { return 0 }
+
+func newCancellationTestService(t *testing.T) (*transcript.Service, *blockingProvider) {
+	t.Helper()
+
+	// Cache disabled so GetTranscript exercises the provider loop directly
+	service, err := transcript.NewService(&config.Config{}, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+
+	provider := &blockingProvider{release: make(chan struct{})}
+	t.Cleanup(func() { close(provider.release) })
+	if err := service.RegisterProvider(provider); err != nil {
+		t.Fatalf("Failed to register mock provider: %v", err)
+	}
+	return service, provider
+}
+
+func TestGetTranscriptCancellation(t *testing.T) {
+	t.Run("cancellation mid-fetch returns promptly with the context error", func(t *testing.T) {
+		service, provider := newCancellationTestService(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+		}()
+
+		start := time.Now()
+		_, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:            "blocked-video",
+			Language:           "en",
+			PreferredProviders: []string{"blocking"},
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Expected context.Canceled, got: %v", err)
+		}
+		// The provider call itself ignores the context; the service must
+		// still return without waiting for it
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("Expected prompt return after cancellation, took %v", elapsed)
+		}
+		if got := atomic.LoadInt32(&provider.calls); got != 1 {
+			t.Errorf("Expected the blocking provider to be called once, got %d", got)
+		}
+	})
+
+	t.Run("already-cancelled context never reaches a provider", func(t *testing.T) {
+		service, provider := newCancellationTestService(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:            "blocked-video",
+			Language:           "en",
+			PreferredProviders: []string{"blocking"},
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Expected context.Canceled, got: %v", err)
+		}
+		if got := atomic.LoadInt32(&provider.calls); got != 0 {
+			t.Errorf("Expected no provider calls with a cancelled context, got %d", got)
+		}
+	})
+}
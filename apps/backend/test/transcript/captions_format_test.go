@@ -0,0 +1,86 @@
+package transcript_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"app-backend/internal/services/transcript/captions"
+	"app-backend/internal/services/transcript/types"
+)
+
+func formatTestSegments() []types.TranscriptSegment {
+	return []types.TranscriptSegment{
+		{Text: "hello world", Start: 0, Duration: 1500 * time.Millisecond},
+		{Text: "second cue", Start: 61 * time.Second, Duration: 2 * time.Second},
+		{Text: "after an hour", Start: time.Hour + 2*time.Minute + 3*time.Second + 456*time.Millisecond, Duration: time.Second},
+	}
+}
+
+func TestFormatTimestamp(t *testing.T) {
+	cases := []struct {
+		duration time.Duration
+		sep      byte
+		want     string
+	}{
+		{0, ',', "00:00:00,000"},
+		{1500 * time.Millisecond, ',', "00:00:01,500"},
+		{61 * time.Second, '.', "00:01:01.000"},
+		{time.Hour + 2*time.Minute + 3*time.Second + 456*time.Millisecond, ',', "01:02:03,456"},
+		{-time.Second, '.', "00:00:00.000"},
+	}
+
+	for _, tc := range cases {
+		if got := captions.FormatTimestamp(tc.duration, tc.sep); got != tc.want {
+			t.Errorf("FormatTimestamp(%v, %q) = %q, want %q", tc.duration, tc.sep, got, tc.want)
+		}
+	}
+}
+
+func TestFormatSRT(t *testing.T) {
+	output := captions.FormatSRT(formatTestSegments())
+
+	if !strings.Contains(output, "1\n00:00:00,000 --> 00:00:01,500\nhello world") {
+		t.Errorf("Expected a numbered SRT cue with comma timestamps, got:\n%s", output)
+	}
+	if !strings.Contains(output, "3\n01:02:03,456 --> 01:02:04,456\nafter an hour") {
+		t.Errorf("Expected hour rollover in the third cue, got:\n%s", output)
+	}
+
+	t.Run("round-trips through the SRT parser", func(t *testing.T) {
+		parsed, err := captions.ParseSRT(output)
+		if err != nil {
+			t.Fatalf("ParseSRT failed: %v", err)
+		}
+		if len(parsed) != 3 {
+			t.Fatalf("Expected 3 parsed segments, got %d", len(parsed))
+		}
+		if parsed[1].Text != "second cue" || parsed[1].Start != 61*time.Second {
+			t.Errorf("Unexpected round-tripped segment: %+v", parsed[1])
+		}
+	})
+}
+
+func TestFormatVTT(t *testing.T) {
+	output := captions.FormatVTT(formatTestSegments())
+
+	if !strings.HasPrefix(output, "WEBVTT\n\n") {
+		t.Errorf("Expected the WEBVTT header, got:\n%s", output)
+	}
+	if !strings.Contains(output, "00:00:00.000 --> 00:00:01.500\nhello world") {
+		t.Errorf("Expected a VTT cue with dot timestamps, got:\n%s", output)
+	}
+
+	t.Run("round-trips through the VTT parser", func(t *testing.T) {
+		parsed, err := captions.ParseVTT(output)
+		if err != nil {
+			t.Fatalf("ParseVTT failed: %v", err)
+		}
+		if len(parsed) != 3 {
+			t.Fatalf("Expected 3 parsed segments, got %d", len(parsed))
+		}
+		if parsed[2].Text != "after an hour" {
+			t.Errorf("Unexpected round-tripped segment: %+v", parsed[2])
+		}
+	})
+}
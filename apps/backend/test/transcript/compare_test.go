@@ -0,0 +1,160 @@
+package transcript_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	"app-backend/internal/services/transcript/types"
+)
+
+// compareSegments builds a simple three-segment transcript shifted by drift
+func compareSegments(drift time.Duration, texts ...string) []types.TranscriptSegment {
+	segments := make([]types.TranscriptSegment, len(texts))
+	for i, text := range texts {
+		segments[i] = types.TranscriptSegment{
+			Text:     text,
+			Start:    time.Duration(i)*2*time.Second + drift,
+			Duration: 2 * time.Second,
+		}
+	}
+	return segments
+}
+
+func compareTranscript(provider string, segments []types.TranscriptSegment) *types.Transcript {
+	return &types.Transcript{
+		VideoID:  "compare-video",
+		Language: "en",
+		Segments: segments,
+		Provider: provider,
+	}
+}
+
+func newCompareService(t *testing.T, providers ...*stubProvider) *transcript.Service {
+	t.Helper()
+
+	service, err := transcript.NewService(&config.Config{}, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+	for _, provider := range providers {
+		if err := service.RegisterProvider(provider); err != nil {
+			t.Fatalf("Failed to register provider %s: %v", provider.name, err)
+		}
+	}
+	return service
+}
+
+func findComparison(t *testing.T, report *transcript.ComparisonReport, provider string) transcript.ProviderComparison {
+	t.Helper()
+	for _, comparison := range report.Providers {
+		if comparison.Provider == provider {
+			return comparison
+		}
+	}
+	t.Fatalf("Provider %s missing from report", provider)
+	return transcript.ProviderComparison{}
+}
+
+func TestCompareProviders(t *testing.T) {
+	ctx := context.Background()
+	req := &types.TranscriptRequest{VideoID: "compare-video", Language: "en"}
+
+	t.Run("identical transcripts diff cleanly", func(t *testing.T) {
+		segments := compareSegments(0, "hello there", "how are you", "goodbye now")
+		first := &stubProvider{name: "first", priority: 1, result: func(ctx context.Context) (*types.Transcript, error) {
+			return compareTranscript("first", segments), nil
+		}}
+		second := &stubProvider{name: "second", priority: 2, result: func(ctx context.Context) (*types.Transcript, error) {
+			return compareTranscript("second", segments), nil
+		}}
+
+		report, err := newCompareService(t, first, second).CompareProviders(ctx, req)
+		if err != nil {
+			t.Fatalf("CompareProviders failed: %v", err)
+		}
+		if len(report.Providers) != 2 {
+			t.Fatalf("Expected 2 provider entries, got %d", len(report.Providers))
+		}
+
+		comparison := findComparison(t, report, "second")
+		if !comparison.Success {
+			t.Fatalf("Expected success, got error %q", comparison.Error)
+		}
+		if comparison.CoveragePercent < 99.9 {
+			t.Errorf("Expected full coverage, got %.2f%%", comparison.CoveragePercent)
+		}
+		if comparison.AvgTimingDriftMs != 0 {
+			t.Errorf("Expected zero drift, got %.2fms", comparison.AvgTimingDriftMs)
+		}
+		if comparison.TextSimilarity < 0.999 {
+			t.Errorf("Expected full text similarity, got %.3f", comparison.TextSimilarity)
+		}
+	})
+
+	t.Run("timing drift and text differences are measured", func(t *testing.T) {
+		reference := compareSegments(0, "hello there", "how are you", "goodbye now")
+		drifted := compareSegments(500*time.Millisecond, "hello there", "how are you", "farewell friend")
+		first := &stubProvider{name: "first", priority: 1, result: func(ctx context.Context) (*types.Transcript, error) {
+			return compareTranscript("first", reference), nil
+		}}
+		second := &stubProvider{name: "second", priority: 2, result: func(ctx context.Context) (*types.Transcript, error) {
+			return compareTranscript("second", drifted), nil
+		}}
+
+		report, err := newCompareService(t, first, second).CompareProviders(ctx, req)
+		if err != nil {
+			t.Fatalf("CompareProviders failed: %v", err)
+		}
+
+		comparison := findComparison(t, report, "second")
+		if comparison.AvgTimingDriftMs != 500 {
+			t.Errorf("Expected 500ms drift, got %.2fms", comparison.AvgTimingDriftMs)
+		}
+		if comparison.TextSimilarity >= 1 || comparison.TextSimilarity <= 0 {
+			t.Errorf("Expected partial text similarity, got %.3f", comparison.TextSimilarity)
+		}
+		if comparison.CoveragePercent >= 100 {
+			t.Errorf("Expected reduced coverage from drift, got %.2f%%", comparison.CoveragePercent)
+		}
+	})
+
+	t.Run("failing providers appear with their error", func(t *testing.T) {
+		segments := compareSegments(0, "hello there")
+		working := &stubProvider{name: "working", priority: 1, result: func(ctx context.Context) (*types.Transcript, error) {
+			return compareTranscript("working", segments), nil
+		}}
+		broken := &stubProvider{name: "broken", priority: 2, result: func(ctx context.Context) (*types.Transcript, error) {
+			return nil, fmt.Errorf("upstream rejected the request")
+		}}
+
+		report, err := newCompareService(t, working, broken).CompareProviders(ctx, req)
+		if err != nil {
+			t.Fatalf("CompareProviders failed: %v", err)
+		}
+
+		if report.Reference != "working" {
+			t.Errorf("Expected working provider as reference, got %q", report.Reference)
+		}
+		comparison := findComparison(t, report, "broken")
+		if comparison.Success {
+			t.Error("Broken provider must not be marked successful")
+		}
+		if comparison.Error == "" {
+			t.Error("Expected the provider error in the report")
+		}
+	})
+
+	t.Run("all providers failing is an error", func(t *testing.T) {
+		broken := &stubProvider{name: "broken", priority: 2, result: func(ctx context.Context) (*types.Transcript, error) {
+			return nil, fmt.Errorf("upstream rejected the request")
+		}}
+
+		if _, err := newCompareService(t, broken).CompareProviders(ctx, req); err == nil {
+			t.Error("Expected an error when no provider returns a transcript")
+		}
+	})
+}
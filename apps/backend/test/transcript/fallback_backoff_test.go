@@ -0,0 +1,144 @@
+package transcript_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	transcriptErrors "app-backend/internal/services/transcript/errors"
+	"app-backend/internal/services/transcript/types"
+)
+
+// stubProvider runs a canned result function and records when it was called
+type stubProvider struct {
+	name     string
+	priority int
+	result   func(ctx context.Context) (*types.Transcript, error)
+	calls    int32
+	calledAt int64 // UnixNano of the first call
+}
+
+func (p *stubProvider) GetTranscript(ctx context.Context, req *types.TranscriptRequest) (*types.Transcript, error) {
+	if atomic.AddInt32(&p.calls, 1) == 1 {
+		atomic.StoreInt64(&p.calledAt, time.Now().UnixNano())
+	}
+	return p.result(ctx)
+}
+
+func (p *stubProvider) GetVideoID(url string) (string, error) { return url, nil }
+func (p *stubProvider) IsAvailable(ctx context.Context) bool  { return true }
+func (p *stubProvider) GetProviderType() types.ProviderType   { return types.ProviderType(p.name) }
+func (p *stubProvider) GetPriority() int                      { return p.priority }
+
+func newBackoffTestService(t *testing.T, backoff string, first, second *stubProvider) *transcript.Service {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Transcript.FallbackBackoff = backoff
+
+	service, err := transcript.NewService(cfg, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+	for _, provider := range []*stubProvider{first, second} {
+		if err := service.RegisterProvider(provider); err != nil {
+			t.Fatalf("Failed to register provider %s: %v", provider.name, err)
+		}
+	}
+	return service
+}
+
+func backoffTestRequest() *types.TranscriptRequest {
+	return &types.TranscriptRequest{
+		VideoID:            "backoff-video",
+		Language:           "en",
+		PreferredProviders: []string{"failing", "succeeding"},
+	}
+}
+
+func TestFallbackBackoff(t *testing.T) {
+	ctx := context.Background()
+
+	succeeding := func() *stubProvider {
+		return &stubProvider{
+			name: "succeeding",
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return newTestTranscript("succeeding"), nil
+			},
+		}
+	}
+
+	t.Run("the next attempt is delayed after a real failure", func(t *testing.T) {
+		failing := &stubProvider{
+			name: "failing",
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return nil, errors.New("upstream rejected the request")
+			},
+		}
+		winner := succeeding()
+		service := newBackoffTestService(t, "200ms", failing, winner)
+
+		if _, err := service.GetTranscript(ctx, backoffTestRequest()); err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+
+		// Jitter keeps the delay in [backoff/2, backoff*3/2)
+		delta := time.Duration(atomic.LoadInt64(&winner.calledAt) - atomic.LoadInt64(&failing.calledAt))
+		if delta < 100*time.Millisecond {
+			t.Errorf("Expected at least 100ms between attempts, got %v", delta)
+		}
+	})
+
+	t.Run("the delay is skipped after a fast not-available failure", func(t *testing.T) {
+		failing := &stubProvider{
+			name: "failing",
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return nil, transcriptErrors.ErrProviderNotAvailable
+			},
+		}
+		winner := succeeding()
+		service := newBackoffTestService(t, "2s", failing, winner)
+
+		if _, err := service.GetTranscript(ctx, backoffTestRequest()); err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+
+		delta := time.Duration(atomic.LoadInt64(&winner.calledAt) - atomic.LoadInt64(&failing.calledAt))
+		if delta >= time.Second {
+			t.Errorf("Expected no backoff after a not-available failure, got %v", delta)
+		}
+	})
+
+	t.Run("cancellation during the backoff wait returns promptly", func(t *testing.T) {
+		failing := &stubProvider{
+			name: "failing",
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return nil, errors.New("upstream rejected the request")
+			},
+		}
+		waiting := succeeding()
+		service := newBackoffTestService(t, "30s", failing, waiting)
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			cancel()
+		}()
+
+		start := time.Now()
+		_, err := service.GetTranscript(cancelCtx, backoffTestRequest())
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Expected context.Canceled, got: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 3*time.Second {
+			t.Errorf("Expected prompt return after cancellation, took %v", elapsed)
+		}
+		if got := atomic.LoadInt32(&waiting.calls); got != 0 {
+			t.Errorf("Expected the second provider to never run, got %d calls", got)
+		}
+	})
+}
@@ -0,0 +1,178 @@
+package transcript_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"app-backend/internal/services/transcript/cache"
+	"app-backend/internal/services/transcript/types"
+)
+
+// fakeStore is a toggleable in-memory StoreInterface standing in for the
+// database
+type fakeStore struct {
+	mu      sync.Mutex
+	failing bool
+	entries map[string]*types.Transcript
+	puts    int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{entries: make(map[string]*types.Transcript)}
+}
+
+func (s *fakeStore) setFailing(failing bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failing = failing
+}
+
+func (s *fakeStore) Get(ctx context.Context, key string) (*types.Transcript, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failing {
+		return nil, fmt.Errorf("store unavailable")
+	}
+	return s.entries[key], nil
+}
+
+func (s *fakeStore) Put(ctx context.Context, key string, transcript *types.Transcript) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failing {
+		return fmt.Errorf("store unavailable")
+	}
+	s.entries[key] = transcript
+	s.puts++
+	return nil
+}
+
+func (s *fakeStore) Delete(ctx context.Context, videoID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failing {
+		return fmt.Errorf("store unavailable")
+	}
+	for key := range s.entries {
+		if strings.HasPrefix(key, videoID+":") {
+			delete(s.entries, key)
+		}
+	}
+	return nil
+}
+
+func (s *fakeStore) putCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.puts
+}
+
+func newFallbackCache(t *testing.T, store *fakeStore) *cache.FallbackCache {
+	t.Helper()
+
+	memory := cache.NewMemoryCache(cache.Config{
+		MaxAge:     time.Minute,
+		StaleAfter: time.Minute,
+	}, newTestLogger(t))
+	return cache.NewFallbackCache(store, memory, newTestLogger(t))
+}
+
+func TestFallbackCache(t *testing.T) {
+	ctx := context.Background()
+
+	noFetch := func(ctx context.Context) (*types.Transcript, error) {
+		return nil, fmt.Errorf("unexpected fetch")
+	}
+
+	t.Run("healthy store serves reads and receives write-throughs", func(t *testing.T) {
+		store := newFakeStore()
+		c := newFallbackCache(t, store)
+
+		fetched := false
+		transcript, cached, err := c.GetOrFetch(ctx, cache.Key("test-video", "en"), func(ctx context.Context) (*types.Transcript, error) {
+			fetched = true
+			return newTestTranscript("fetch"), nil
+		})
+		if err != nil {
+			t.Fatalf("GetOrFetch failed: %v", err)
+		}
+		if cached || !fetched {
+			t.Error("First call should fetch")
+		}
+		if transcript.VideoID != "test-video" {
+			t.Error("Expected fetched transcript")
+		}
+		if store.putCount() != 1 {
+			t.Errorf("Expected 1 write-through, got %d", store.putCount())
+		}
+
+		// A second call must be served by the store
+		_, cached, err = c.GetOrFetch(ctx, cache.Key("test-video", "en"), noFetch)
+		if err != nil {
+			t.Fatalf("GetOrFetch failed: %v", err)
+		}
+		if !cached {
+			t.Error("Second call should be served from the store")
+		}
+	})
+
+	t.Run("store outage falls back to memory for cached reads", func(t *testing.T) {
+		store := newFakeStore()
+		c := newFallbackCache(t, store)
+
+		// Warm both layers, then take the store down
+		if _, _, err := c.GetOrFetch(ctx, cache.Key("test-video", "en"), func(ctx context.Context) (*types.Transcript, error) {
+			return newTestTranscript("fetch"), nil
+		}); err != nil {
+			t.Fatalf("GetOrFetch failed: %v", err)
+		}
+		store.setFailing(true)
+
+		transcript, cached, err := c.GetOrFetch(ctx, cache.Key("test-video", "en"), noFetch)
+		if err != nil {
+			t.Fatalf("Expected cached read to survive store outage, got %v", err)
+		}
+		if !cached {
+			t.Error("Expected degraded read to be served from memory")
+		}
+		if transcript.VideoID != "test-video" {
+			t.Error("Expected cached transcript during outage")
+		}
+	})
+
+	t.Run("writes during an outage are queued and flushed on recovery", func(t *testing.T) {
+		store := newFakeStore()
+		c := newFallbackCache(t, store)
+		store.setFailing(true)
+
+		// A fresh fetch during the outage serves the caller and queues the write
+		transcript, _, err := c.GetOrFetch(ctx, cache.Key("test-video", "en"), func(ctx context.Context) (*types.Transcript, error) {
+			return newTestTranscript("fetch"), nil
+		})
+		if err != nil {
+			t.Fatalf("Expected fetch to succeed during outage, got %v", err)
+		}
+		if transcript.VideoID != "test-video" {
+			t.Error("Expected fetched transcript during outage")
+		}
+		if c.PendingWrites() != 1 {
+			t.Fatalf("Expected 1 queued write, got %d", c.PendingWrites())
+		}
+
+		// Recovery flushes the queue on the next successful store operation
+		store.setFailing(false)
+		if _, _, err := c.GetOrFetch(ctx, cache.Key("test-video", "en"), noFetch); err != nil {
+			t.Fatalf("GetOrFetch failed after recovery: %v", err)
+		}
+		if c.PendingWrites() != 0 {
+			t.Errorf("Expected queue to be flushed, got %d pending writes", c.PendingWrites())
+		}
+		if store.putCount() != 1 {
+			t.Errorf("Expected the queued write to reach the store, got %d puts", store.putCount())
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package transcript_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	"app-backend/internal/services/transcript/types"
+)
+
+// probeProvider reports a switchable availability for prober tests
+type probeProvider struct {
+	name        string
+	unavailable atomic.Bool
+	checks      atomic.Int32
+}
+
+func (p *probeProvider) GetTranscript(ctx context.Context, req *types.TranscriptRequest) (*types.Transcript, error) {
+	return nil, nil
+}
+
+func (p *probeProvider) GetVideoID(url string) (string, error) { return url, nil }
+
+func (p *probeProvider) IsAvailable(ctx context.Context) bool {
+	p.checks.Add(1)
+	return !p.unavailable.Load()
+}
+
+func (p *probeProvider) GetProviderType() types.ProviderType { return types.ProviderType(p.name) }
+func (p *probeProvider) GetPriority() int                    { return 0 }
+
+func newProberTestService(t *testing.T, providers ...*probeProvider) *transcript.Service {
+	t.Helper()
+
+	service, err := transcript.NewService(&config.Config{}, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+
+	// Disable the default providers so the prober only exercises the
+	// stubs; their availability checks would hit the network
+	service.ApplyProviderConfig([]config.TranscriptProviderConfig{
+		{Type: string(types.ProviderYTTranscript), Enabled: false},
+		{Type: string(types.ProviderKkdaiYouTube), Enabled: false},
+		{Type: string(types.ProviderInnertube), Enabled: false},
+	})
+
+	for _, provider := range providers {
+		if err := service.RegisterProvider(provider); err != nil {
+			t.Fatalf("Failed to register provider %s: %v", provider.name, err)
+		}
+	}
+	return service
+}
+
+// waitForProbes polls until every provider has been checked at least min
+// times or the timeout elapses
+func waitForProbes(t *testing.T, providers []*probeProvider, min int32) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		done := true
+		for _, provider := range providers {
+			if provider.checks.Load() < min {
+				done = false
+				break
+			}
+		}
+		if done {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatal("Timed out waiting for probes")
+}
+
+func statsFor(t *testing.T, prober *transcript.HealthProber, name string) transcript.ProviderHealthStats {
+	t.Helper()
+
+	for _, stats := range prober.Snapshot() {
+		if stats.Provider == name {
+			return stats
+		}
+	}
+	t.Fatalf("No stats recorded for provider %q", name)
+	return transcript.ProviderHealthStats{}
+}
+
+func TestHealthProber(t *testing.T) {
+	t.Run("records rolling statistics per provider", func(t *testing.T) {
+		healthy := &probeProvider{name: "healthy"}
+		failing := &probeProvider{name: "failing"}
+		failing.unavailable.Store(true)
+		service := newProberTestService(t, healthy, failing)
+
+		prober := service.NewHealthProber(10 * time.Millisecond)
+		prober.Start()
+		defer prober.Stop()
+
+		waitForProbes(t, []*probeProvider{healthy, failing}, 3)
+
+		healthyStats := statsFor(t, prober, "healthy")
+		if !healthyStats.Healthy || healthyStats.SuccessRate != 1 {
+			t.Errorf("Expected a fully healthy provider, got %+v", healthyStats)
+		}
+		if healthyStats.TotalProbes < 3 {
+			t.Errorf("Expected at least 3 probes, got %d", healthyStats.TotalProbes)
+		}
+		if healthyStats.LastCheckedAt.IsZero() {
+			t.Error("Expected a last-checked timestamp")
+		}
+
+		failingStats := statsFor(t, prober, "failing")
+		if failingStats.Healthy || failingStats.SuccessRate != 0 {
+			t.Errorf("Expected a failing provider, got %+v", failingStats)
+		}
+		if failingStats.ConsecutiveFailures < 3 {
+			t.Errorf("Expected consecutive failures to accumulate, got %d", failingStats.ConsecutiveFailures)
+		}
+	})
+
+	t.Run("repeated failures trip the provider's breaker", func(t *testing.T) {
+		failing := &probeProvider{name: "failing"}
+		failing.unavailable.Store(true)
+		service := newProberTestService(t, failing)
+
+		prober := service.NewHealthProber(10 * time.Millisecond)
+		prober.Start()
+		defer prober.Stop()
+
+		waitForProbes(t, []*probeProvider{failing}, 3)
+
+		stats := statsFor(t, prober, "failing")
+		if stats.State != "OPEN" {
+			t.Errorf("Expected an open breaker after repeated failures, got %q", stats.State)
+		}
+	})
+
+	t.Run("recovery is reflected in the snapshot", func(t *testing.T) {
+		flappy := &probeProvider{name: "flappy"}
+		flappy.unavailable.Store(true)
+		service := newProberTestService(t, flappy)
+
+		prober := service.NewHealthProber(10 * time.Millisecond)
+		prober.Start()
+		defer prober.Stop()
+
+		waitForProbes(t, []*probeProvider{flappy}, 2)
+		flappy.unavailable.Store(false)
+
+		deadline := time.Now().Add(5 * time.Second)
+		for time.Now().Before(deadline) {
+			if statsFor(t, prober, "flappy").Healthy {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		if stats := statsFor(t, prober, "flappy"); !stats.Healthy {
+			t.Errorf("Expected the provider to recover, got %+v", stats)
+		}
+	})
+
+	t.Run("stop halts probing", func(t *testing.T) {
+		provider := &probeProvider{name: "stopped"}
+		service := newProberTestService(t, provider)
+
+		prober := service.NewHealthProber(10 * time.Millisecond)
+		prober.Start()
+		waitForProbes(t, []*probeProvider{provider}, 1)
+		prober.Stop()
+
+		checks := provider.checks.Load()
+		time.Sleep(50 * time.Millisecond)
+		if provider.checks.Load() != checks {
+			t.Error("Expected no probes after Stop")
+		}
+	})
+}
@@ -0,0 +1,260 @@
+package transcript_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	transcripterrors "app-backend/internal/services/transcript/errors"
+	"app-backend/internal/services/transcript/providers/innertube"
+	"app-backend/internal/services/transcript/types"
+)
+
+// captionTestServers hosts a fake player endpoint, caption track endpoint,
+// and legacy transcript endpoint so track selection can be exercised without
+// touching YouTube
+type captionTestServers struct {
+	player      *httptest.Server
+	tracks      *httptest.Server
+	legacy      *httptest.Server
+	legacyCalls int32
+	// lastTrackQuery records the query string of the last track download
+	lastTrackQuery atomic.Value
+}
+
+func newCaptionTestServers(t *testing.T, playerJSON func(trackBase string) string) *captionTestServers {
+	t.Helper()
+
+	servers := &captionTestServers{}
+
+	servers.tracks = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		servers.lastTrackQuery.Store(r.URL.RawQuery)
+		lang := r.URL.Query().Get("track")
+		fmt.Fprintf(w, `{"events":[{"tStartMs":0,"dDurationMs":1500,"segs":[{"utf8":"segment in %s"}]}]}`, lang)
+	}))
+	t.Cleanup(servers.tracks.Close)
+
+	servers.player = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, playerJSON(servers.tracks.URL))
+	}))
+	t.Cleanup(servers.player.Close)
+
+	servers.legacy = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&servers.legacyCalls, 1)
+		w.Write([]byte(innertubeTranscriptJSON))
+	}))
+	t.Cleanup(servers.legacy.Close)
+
+	return servers
+}
+
+func (s *captionTestServers) newProvider(t *testing.T) *innertube.Provider {
+	t.Helper()
+
+	titles := newTitleStubServer(t)
+	return innertube.NewProvider(&innertube.Config{
+		Timeout:             2,
+		Endpoint:            s.legacy.URL,
+		PlayerEndpoint:      s.player.URL,
+		WatchEndpoint:       titles.URL + "/watch",
+		OEmbedEndpoint:      titles.URL + "/oembed",
+		ParseRetries:        1,
+		ParseRetryBackoffMs: 1,
+	}, newTestLogger(t))
+}
+
+func TestInnertubeLanguageSelection(t *testing.T) {
+	ctx := context.Background()
+
+	multiTrackPlayerJSON := func(trackBase string) string {
+		return fmt.Sprintf(`{"captions":{"playerCaptionsTracklistRenderer":{
+			"captionTracks":[
+				{"baseUrl":"%s?track=en-manual","name":{"simpleText":"English"},"languageCode":"en","isTranslatable":true},
+				{"baseUrl":"%s?track=en-asr","name":{"simpleText":"English (auto-generated)"},"languageCode":"en","kind":"asr"},
+				{"baseUrl":"%s?track=es","name":{"simpleText":"Spanish"},"languageCode":"es","isTranslatable":true}
+			],
+			"translationLanguages":[{"languageCode":"vi","languageName":{"simpleText":"Vietnamese"}}]
+		}}}`, trackBase, trackBase, trackBase)
+	}
+
+	t.Run("serves the requested language's track", func(t *testing.T) {
+		servers := newCaptionTestServers(t, multiTrackPlayerJSON)
+		provider := servers.newProvider(t)
+
+		result, err := provider.GetTranscript(ctx, &types.TranscriptRequest{VideoID: "dQw4w9WgXcQ", Language: "es"})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if result.Language != "es" {
+			t.Errorf("Expected language es, got %q", result.Language)
+		}
+		if len(result.Segments) != 1 || result.Segments[0].Text != "segment in es" {
+			t.Errorf("Unexpected segments: %+v", result.Segments)
+		}
+		if result.Source != types.SourceManual {
+			t.Errorf("Expected a manual track, got %q", result.Source)
+		}
+		if calls := atomic.LoadInt32(&servers.legacyCalls); calls != 0 {
+			t.Errorf("Legacy transcript endpoint should not be called, got %d calls", calls)
+		}
+	})
+
+	t.Run("prefers a manual track over an auto-generated one", func(t *testing.T) {
+		servers := newCaptionTestServers(t, multiTrackPlayerJSON)
+		provider := servers.newProvider(t)
+
+		result, err := provider.GetTranscript(ctx, &types.TranscriptRequest{VideoID: "dQw4w9WgXcQ", Language: "en"})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if result.Segments[0].Text != "segment in en-manual" {
+			t.Errorf("Expected the manual track, got %q", result.Segments[0].Text)
+		}
+		if result.Source != types.SourceManual {
+			t.Errorf("Expected a manual track, got %q", result.Source)
+		}
+	})
+
+	t.Run("falls back to the auto-generated track", func(t *testing.T) {
+		servers := newCaptionTestServers(t, func(trackBase string) string {
+			return fmt.Sprintf(`{"captions":{"playerCaptionsTracklistRenderer":{
+				"captionTracks":[{"baseUrl":"%s?track=de-asr","name":{"simpleText":"German (auto-generated)"},"languageCode":"de","kind":"asr"}]
+			}}}`, trackBase)
+		})
+		provider := servers.newProvider(t)
+
+		result, err := provider.GetTranscript(ctx, &types.TranscriptRequest{VideoID: "dQw4w9WgXcQ", Language: "de"})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if result.Source != types.SourceAutoGenerated {
+			t.Errorf("Expected an auto-generated track, got %q", result.Source)
+		}
+	})
+
+	t.Run("matches a regional variant by base language", func(t *testing.T) {
+		servers := newCaptionTestServers(t, func(trackBase string) string {
+			return fmt.Sprintf(`{"captions":{"playerCaptionsTracklistRenderer":{
+				"captionTracks":[{"baseUrl":"%s?track=pt-BR","name":{"simpleText":"Portuguese (Brazil)"},"languageCode":"pt-BR"}]
+			}}}`, trackBase)
+		})
+		provider := servers.newProvider(t)
+
+		result, err := provider.GetTranscript(ctx, &types.TranscriptRequest{VideoID: "dQw4w9WgXcQ", Language: "pt"})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if result.Language != "pt-BR" {
+			t.Errorf("Expected the regional variant pt-BR, got %q", result.Language)
+		}
+	})
+
+	t.Run("uses a translation track for unoffered languages", func(t *testing.T) {
+		servers := newCaptionTestServers(t, multiTrackPlayerJSON)
+		provider := servers.newProvider(t)
+
+		result, err := provider.GetTranscript(ctx, &types.TranscriptRequest{VideoID: "dQw4w9WgXcQ", Language: "vi"})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if result.Language != "vi" {
+			t.Errorf("Expected language vi, got %q", result.Language)
+		}
+		if result.TranslatedFrom != "en" {
+			t.Errorf("Expected translation from en, got %q", result.TranslatedFrom)
+		}
+
+		query, _ := servers.lastTrackQuery.Load().(string)
+		if !strings.Contains(query, "tlang=vi") {
+			t.Errorf("Expected the track request to carry tlang=vi, got %q", query)
+		}
+	})
+
+	t.Run("reports an unavailable language without falling back", func(t *testing.T) {
+		servers := newCaptionTestServers(t, multiTrackPlayerJSON)
+		provider := servers.newProvider(t)
+
+		_, err := provider.GetTranscript(ctx, &types.TranscriptRequest{VideoID: "dQw4w9WgXcQ", Language: "zz"})
+		if err != transcripterrors.ErrLanguageNotAvailable {
+			t.Fatalf("Expected ErrLanguageNotAvailable, got %v", err)
+		}
+		if calls := atomic.LoadInt32(&servers.legacyCalls); calls != 0 {
+			t.Errorf("Legacy transcript endpoint should not be called, got %d calls", calls)
+		}
+	})
+
+	t.Run("falls back to the legacy flow when the player endpoint fails", func(t *testing.T) {
+		servers := newCaptionTestServers(t, multiTrackPlayerJSON)
+		servers.player.Close()
+		provider := servers.newProvider(t)
+
+		result, err := provider.GetTranscript(ctx, &types.TranscriptRequest{VideoID: "dQw4w9WgXcQ", Language: "en"})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if len(result.Segments) != 1 || result.Segments[0].Text != "hello world" {
+			t.Errorf("Expected the legacy transcript, got %+v", result.Segments)
+		}
+		if calls := atomic.LoadInt32(&servers.legacyCalls); calls != 1 {
+			t.Errorf("Expected 1 legacy call, got %d", calls)
+		}
+	})
+}
+
+func TestInnertubeGetAvailableLanguages(t *testing.T) {
+	servers := newCaptionTestServers(t, func(trackBase string) string {
+		return fmt.Sprintf(`{"captions":{"playerCaptionsTracklistRenderer":{
+			"captionTracks":[
+				{"baseUrl":"%s?track=en","name":{"simpleText":"English"},"languageCode":"en"},
+				{"baseUrl":"%s?track=ja","name":{"runs":[{"text":"Japanese "},{"text":"(auto-generated)"}]},"languageCode":"ja","kind":"asr"}
+			]
+		}}}`, trackBase, trackBase)
+	})
+	provider := servers.newProvider(t)
+
+	languages, err := provider.GetAvailableLanguages(context.Background(), "dQw4w9WgXcQ")
+	if err != nil {
+		t.Fatalf("GetAvailableLanguages failed: %v", err)
+	}
+	if len(languages) != 2 {
+		t.Fatalf("Expected 2 caption languages, got %d", len(languages))
+	}
+	if languages[0].Code != "en" || languages[0].Name != "English" || languages[0].AutoGenerated {
+		t.Errorf("Unexpected first language: %+v", languages[0])
+	}
+	if languages[1].Code != "ja" || languages[1].Name != "Japanese (auto-generated)" || !languages[1].AutoGenerated {
+		t.Errorf("Unexpected second language: %+v", languages[1])
+	}
+}
+
+func TestServiceGetAvailableLanguages(t *testing.T) {
+	servers := newCaptionTestServers(t, func(trackBase string) string {
+		return fmt.Sprintf(`{"captions":{"playerCaptionsTracklistRenderer":{
+			"captionTracks":[{"baseUrl":"%s?track=en","name":{"simpleText":"English"},"languageCode":"en"}]
+		}}}`, trackBase)
+	})
+
+	service, err := transcript.NewService(&config.Config{}, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+	// Replace the built-in innertube provider with one pointed at the fake
+	// player endpoint; the other built-ins do not implement the listing
+	if err := service.RegisterProvider(servers.newProvider(t)); err != nil {
+		t.Fatalf("Failed to register provider: %v", err)
+	}
+
+	languages, err := service.GetAvailableLanguages(context.Background(), "dQw4w9WgXcQ")
+	if err != nil {
+		t.Fatalf("GetAvailableLanguages failed: %v", err)
+	}
+	if len(languages) != 1 || languages[0].Code != "en" {
+		t.Errorf("Unexpected languages: %+v", languages)
+	}
+}
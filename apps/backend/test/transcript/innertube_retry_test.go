@@ -0,0 +1,135 @@
+package transcript_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"app-backend/internal/services/transcript/providers/innertube"
+	"app-backend/internal/services/transcript/types"
+)
+
+const innertubeTranscriptJSON = `{
+	"actions": [{
+		"updateEngagementPanelAction": {
+			"content": {
+				"transcriptRenderer": {
+					"body": {
+						"transcriptBodyRenderer": {
+							"cueGroups": [{
+								"transcriptCueGroupRenderer": {
+									"cues": [{
+										"transcriptCueRenderer": {
+											"cue": {"simpleText": "hello world"},
+											"startOffsetMs": "0",
+											"durationMs": "1500"
+										}
+									}]
+								}
+							}]
+						}
+					}
+				}
+			}
+		}
+	}]
+}`
+
+// newTitleStubServer hosts fake watch and oEmbed endpoints so title
+// resolution never leaves the test process
+func newTitleStubServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/watch", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<html><head><title>Stub Video - YouTube</title></head></html>"))
+	})
+	mux.HandleFunc("/oembed", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"title": "Stub Video", "author_name": "Stub Channel"}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newInnertubeTestProvider(t *testing.T, endpoint string) *innertube.Provider {
+	t.Helper()
+
+	titles := newTitleStubServer(t)
+	return innertube.NewProvider(&innertube.Config{
+		Timeout:             2,
+		Endpoint:            endpoint,
+		WatchEndpoint:       titles.URL + "/watch",
+		OEmbedEndpoint:      titles.URL + "/oembed",
+		ParseRetries:        2,
+		ParseRetryBackoffMs: 1,
+	}, newTestLogger(t))
+}
+
+func TestInnertubeParseRetry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("retries when the first response is not JSON", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) == 1 {
+				w.Write([]byte("<html>Something went wrong</html>"))
+				return
+			}
+			w.Write([]byte(innertubeTranscriptJSON))
+		}))
+		defer server.Close()
+
+		provider := newInnertubeTestProvider(t, server.URL)
+
+		transcript, err := provider.GetTranscript(ctx, &types.TranscriptRequest{VideoID: "dQw4w9WgXcQ"})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if atomic.LoadInt32(&requests) != 2 {
+			t.Errorf("Expected 2 transcript requests, got %d", requests)
+		}
+		if len(transcript.Segments) != 1 || transcript.Segments[0].Text != "hello world" {
+			t.Errorf("Unexpected segments: %+v", transcript.Segments)
+		}
+	})
+
+	t.Run("gives up after the configured number of retries", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.Write([]byte("not json"))
+		}))
+		defer server.Close()
+
+		provider := newInnertubeTestProvider(t, server.URL)
+
+		if _, err := provider.GetTranscript(ctx, &types.TranscriptRequest{VideoID: "dQw4w9WgXcQ"}); err == nil {
+			t.Fatal("Expected an error for a persistently invalid body")
+		}
+		// Initial attempt plus two retries
+		if atomic.LoadInt32(&requests) != 3 {
+			t.Errorf("Expected 3 transcript requests, got %d", requests)
+		}
+	})
+
+	t.Run("HTTP errors are not retried", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		provider := newInnertubeTestProvider(t, server.URL)
+
+		if _, err := provider.GetTranscript(ctx, &types.TranscriptRequest{VideoID: "dQw4w9WgXcQ"}); err == nil {
+			t.Fatal("Expected an error for an HTTP failure")
+		}
+		if atomic.LoadInt32(&requests) != 1 {
+			t.Errorf("Expected a single transcript request, got %d", requests)
+		}
+	})
+}
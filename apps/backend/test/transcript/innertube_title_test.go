@@ -0,0 +1,56 @@
+package transcript_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"app-backend/internal/services/transcript/providers/innertube"
+	"app-backend/internal/services/transcript/types"
+)
+
+func TestInnertubeTitleOEmbedFallback(t *testing.T) {
+	ctx := context.Background()
+
+	var oembedRequests int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/watch", func(w http.ResponseWriter, r *http.Request) {
+		// A watch page whose markup matches neither title regex
+		w.Write([]byte("<html><head></head><body>consent required</body></html>"))
+	})
+	mux.HandleFunc("/oembed", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&oembedRequests, 1)
+		w.Write([]byte(`{"title": "Learn English in 10 Minutes", "author_name": "English Channel"}`))
+	})
+	mux.HandleFunc("/transcript", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(innertubeTranscriptJSON))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider := innertube.NewProvider(&innertube.Config{
+		Timeout:        2,
+		Endpoint:       server.URL + "/transcript",
+		WatchEndpoint:  server.URL + "/watch",
+		OEmbedEndpoint: server.URL + "/oembed",
+	}, newTestLogger(t))
+
+	transcript, err := provider.GetTranscript(ctx, &types.TranscriptRequest{VideoID: "dQw4w9WgXcQ"})
+	if err != nil {
+		t.Fatalf("GetTranscript failed: %v", err)
+	}
+	if transcript.Title != "Learn English in 10 Minutes" {
+		t.Errorf("Expected the oEmbed title, got %q", transcript.Title)
+	}
+
+	// The resolved title must be cached: a second fetch for the same video
+	// must not hit oEmbed again
+	if _, err := provider.GetTranscript(ctx, &types.TranscriptRequest{VideoID: "dQw4w9WgXcQ"}); err != nil {
+		t.Fatalf("GetTranscript failed: %v", err)
+	}
+	if atomic.LoadInt32(&oembedRequests) != 1 {
+		t.Errorf("Expected a single oEmbed request, got %d", oembedRequests)
+	}
+}
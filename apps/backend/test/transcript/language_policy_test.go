@@ -0,0 +1,154 @@
+package transcript_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	transcriptErrors "app-backend/internal/services/transcript/errors"
+	"app-backend/internal/services/transcript/types"
+)
+
+// singleLanguageProvider is a mock provider that only offers transcripts in
+// one fixed language, regardless of the requested one
+type singleLanguageProvider struct {
+	language string
+}
+
+func (p *singleLanguageProvider) GetTranscript(ctx context.Context, req *types.TranscriptRequest) (*types.Transcript, error) {
+	return &types.Transcript{
+		VideoID:  "policy-video",
+		Language: p.language,
+		Provider: "mock",
+		Segments: []types.TranscriptSegment{
+			{Text: "hola", Start: 0, Duration: time.Second},
+			{Text: "mundo", Start: time.Second, Duration: time.Second},
+		},
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (p *singleLanguageProvider) GetVideoID(url string) (string, error) { return url, nil }
+func (p *singleLanguageProvider) IsAvailable(ctx context.Context) bool  { return true }
+func (p *singleLanguageProvider) GetProviderType() types.ProviderType {
+	return types.ProviderType("mock")
+}
+func (p *singleLanguageProvider) GetPriority() int { return 0 }
+
+// upperCaseTranslator fakes translation by upper-casing the input texts
+type upperCaseTranslator struct{}
+
+func (tr *upperCaseTranslator) TranslateTexts(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]string, error) {
+	translated := make([]string, len(texts))
+	for i, text := range texts {
+		translated[i] = strings.ToUpper(text)
+	}
+	return translated, nil
+}
+
+func newPolicyTestService(t *testing.T) *transcript.Service {
+	t.Helper()
+
+	service, err := transcript.NewService(&config.Config{}, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+	if err := service.RegisterProvider(&singleLanguageProvider{language: "es"}); err != nil {
+		t.Fatalf("Failed to register mock provider: %v", err)
+	}
+	return service
+}
+
+func TestLanguageMissingPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fail returns typed error", func(t *testing.T) {
+		service := newPolicyTestService(t)
+
+		_, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:           "policy-video",
+			Language:          "en",
+			OnLanguageMissing: types.LanguagePolicyFail,
+		})
+		if err != transcriptErrors.ErrLanguageNotAvailable {
+			t.Fatalf("Expected ErrLanguageNotAvailable, got %v", err)
+		}
+	})
+
+	t.Run("fail is the default policy", func(t *testing.T) {
+		service := newPolicyTestService(t)
+
+		_, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:  "policy-video",
+			Language: "en",
+		})
+		if err != transcriptErrors.ErrLanguageNotAvailable {
+			t.Fatalf("Expected ErrLanguageNotAvailable, got %v", err)
+		}
+	})
+
+	t.Run("fallback_any returns available language marked as fallback", func(t *testing.T) {
+		service := newPolicyTestService(t)
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:           "policy-video",
+			Language:          "en",
+			OnLanguageMissing: types.LanguagePolicyFallbackAny,
+		})
+		if err != nil {
+			t.Fatalf("Expected fallback transcript, got error: %v", err)
+		}
+		if result.Language != "es" {
+			t.Errorf("Expected fallback language es, got %s", result.Language)
+		}
+		if !result.LanguageFallback {
+			t.Error("Expected transcript to be marked as a language fallback")
+		}
+		if result.Segments[0].Text != "hola" {
+			t.Errorf("Expected untranslated text, got %q", result.Segments[0].Text)
+		}
+	})
+
+	t.Run("auto_translate translates to requested language", func(t *testing.T) {
+		service := newPolicyTestService(t)
+		service.SetTranslator(&upperCaseTranslator{})
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:           "policy-video",
+			Language:          "en",
+			OnLanguageMissing: types.LanguagePolicyAutoTranslate,
+		})
+		if err != nil {
+			t.Fatalf("Expected translated transcript, got error: %v", err)
+		}
+		if result.Language != "en" {
+			t.Errorf("Expected language en, got %s", result.Language)
+		}
+		if result.TranslatedFrom != "es" {
+			t.Errorf("Expected translated_from es, got %s", result.TranslatedFrom)
+		}
+		if result.Segments[0].Text != "HOLA" || result.Segments[1].Text != "MUNDO" {
+			t.Errorf("Expected translated segments, got %q and %q",
+				result.Segments[0].Text, result.Segments[1].Text)
+		}
+	})
+
+	t.Run("matching language bypasses policy", func(t *testing.T) {
+		service := newPolicyTestService(t)
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:           "policy-video",
+			Language:          "es-MX",
+			OnLanguageMissing: types.LanguagePolicyFail,
+		})
+		if err != nil {
+			t.Fatalf("Expected transcript for matching language, got error: %v", err)
+		}
+		if result.LanguageFallback {
+			t.Error("Expected transcript not to be marked as a fallback")
+		}
+	})
+}
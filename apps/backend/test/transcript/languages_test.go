@@ -0,0 +1,129 @@
+package transcript_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	"app-backend/internal/services/transcript/types"
+)
+
+// listingProvider is a transcript provider stub whose only purpose is to
+// report a fixed caption track listing (or a failure)
+type listingProvider struct {
+	name      string
+	priority  int
+	languages []types.CaptionLanguage
+	err       error
+}
+
+func (p *listingProvider) GetTranscript(ctx context.Context, req *types.TranscriptRequest) (*types.Transcript, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (p *listingProvider) GetAvailableLanguages(ctx context.Context, videoID string) ([]types.CaptionLanguage, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.languages, nil
+}
+
+func (p *listingProvider) GetVideoID(url string) (string, error) { return url, nil }
+func (p *listingProvider) IsAvailable(ctx context.Context) bool  { return true }
+func (p *listingProvider) GetProviderType() types.ProviderType {
+	return types.ProviderType(p.name)
+}
+func (p *listingProvider) GetPriority() int { return p.priority }
+
+func newListingService(t *testing.T, providers ...*listingProvider) transcript.ServiceInterface {
+	t.Helper()
+
+	service, err := transcript.NewService(&config.Config{}, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+	// Replace the built-in providers so only the stubs answer
+	for _, builtin := range []types.ProviderType{
+		types.ProviderYTTranscript, types.ProviderKkdaiYouTube, types.ProviderInnertube,
+	} {
+		if err := service.RegisterProvider(&listingProvider{name: string(builtin), priority: 99,
+			err: fmt.Errorf("listing disabled")}); err != nil {
+			t.Fatalf("Failed to replace provider %s: %v", builtin, err)
+		}
+	}
+	for _, provider := range providers {
+		if err := service.RegisterProvider(provider); err != nil {
+			t.Fatalf("Failed to register provider: %v", err)
+		}
+	}
+	return service
+}
+
+func TestGetAvailableLanguagesAggregation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("merges and dedupes listings across providers", func(t *testing.T) {
+		service := newListingService(t,
+			&listingProvider{name: "first", priority: 1, languages: []types.CaptionLanguage{
+				{Code: "en", Name: "English (auto-generated)", AutoGenerated: true},
+				{Code: "es", Name: "Spanish"},
+			}},
+			&listingProvider{name: "second", priority: 2, languages: []types.CaptionLanguage{
+				{Code: "en", Name: "English"},
+				{Code: "ja", Name: "Japanese"},
+			}},
+		)
+
+		languages, err := service.GetAvailableLanguages(ctx, "video")
+		if err != nil {
+			t.Fatalf("GetAvailableLanguages failed: %v", err)
+		}
+		if len(languages) != 3 {
+			t.Fatalf("Expected 3 deduplicated languages, got %+v", languages)
+		}
+
+		// The manual English listing replaces the auto-generated one
+		byCode := make(map[string]types.CaptionLanguage)
+		for _, language := range languages {
+			byCode[language.Code] = language
+		}
+		if en := byCode["en"]; en.AutoGenerated || en.Name != "English" {
+			t.Errorf("Expected the manual English track to win, got %+v", en)
+		}
+		if _, ok := byCode["es"]; !ok {
+			t.Error("Expected Spanish from the first provider")
+		}
+		if _, ok := byCode["ja"]; !ok {
+			t.Error("Expected Japanese from the second provider")
+		}
+	})
+
+	t.Run("failing providers are skipped", func(t *testing.T) {
+		service := newListingService(t,
+			&listingProvider{name: "broken", priority: 1, err: fmt.Errorf("listing failed")},
+			&listingProvider{name: "working", priority: 2, languages: []types.CaptionLanguage{
+				{Code: "de", Name: "German"},
+			}},
+		)
+
+		languages, err := service.GetAvailableLanguages(ctx, "video")
+		if err != nil {
+			t.Fatalf("GetAvailableLanguages failed: %v", err)
+		}
+		if len(languages) != 1 || languages[0].Code != "de" {
+			t.Errorf("Expected only the working provider's listing, got %+v", languages)
+		}
+	})
+
+	t.Run("surfaces the error when no provider answers", func(t *testing.T) {
+		service := newListingService(t,
+			&listingProvider{name: "broken", priority: 1, err: fmt.Errorf("listing failed")},
+		)
+
+		if _, err := service.GetAvailableLanguages(ctx, "video"); err == nil {
+			t.Fatal("Expected an error when every provider fails")
+		}
+	})
+}
@@ -0,0 +1,198 @@
+package transcript_test
+
+import (
+	"context"
+	goerrors "errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"app-backend/internal/services/transcript/errors"
+	"app-backend/internal/services/transcript/providers/local"
+	"app-backend/internal/services/transcript/types"
+)
+
+const localTestSRT = `1
+00:00:00,000 --> 00:00:01,500
+hello world
+
+2
+00:00:01,500 --> 00:00:03,000
+how are you
+`
+
+const localTestVTT = `WEBVTT
+
+NOTE generated for tests
+
+00:00.000 --> 00:01.500
+<c>hola</c> mundo
+
+cue-2
+00:01.500 --> 00:03.000 align:start
+que tal
+`
+
+func newLocalTestProvider(t *testing.T, files map[string]string) *local.Provider {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("Failed to write fixture %s: %v", name, err)
+		}
+	}
+
+	provider, err := local.NewProvider(&local.Config{Directory: dir}, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create local provider: %v", err)
+	}
+	return provider
+}
+
+func TestLocalProvider(t *testing.T) {
+	t.Run("parses an SRT file with a language suffix", func(t *testing.T) {
+		provider := newLocalTestProvider(t, map[string]string{
+			"dQw4w9WgXcQ.en.srt": localTestSRT,
+		})
+
+		transcript, err := provider.GetTranscript(context.Background(), &types.TranscriptRequest{
+			VideoID:  "dQw4w9WgXcQ",
+			Language: "en",
+		})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+
+		if transcript.Language != "en" {
+			t.Errorf("Expected language en, got %q", transcript.Language)
+		}
+		if transcript.Provider != string(types.ProviderLocal) {
+			t.Errorf("Expected provider local, got %q", transcript.Provider)
+		}
+		if len(transcript.Segments) != 2 {
+			t.Fatalf("Expected 2 segments, got %d", len(transcript.Segments))
+		}
+		if transcript.Segments[0].Text != "hello world" {
+			t.Errorf("Unexpected first segment text: %q", transcript.Segments[0].Text)
+		}
+		if transcript.Segments[0].Duration != 1500*time.Millisecond {
+			t.Errorf("Unexpected first segment duration: %v", transcript.Segments[0].Duration)
+		}
+		if transcript.Segments[1].Start != 1500*time.Millisecond {
+			t.Errorf("Unexpected second segment start: %v", transcript.Segments[1].Start)
+		}
+	})
+
+	t.Run("parses a VTT file and strips markup and cue settings", func(t *testing.T) {
+		provider := newLocalTestProvider(t, map[string]string{
+			"dQw4w9WgXcQ.es.vtt": localTestVTT,
+		})
+
+		transcript, err := provider.GetTranscript(context.Background(), &types.TranscriptRequest{
+			VideoID:  "dQw4w9WgXcQ",
+			Language: "es",
+		})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+
+		if len(transcript.Segments) != 2 {
+			t.Fatalf("Expected 2 segments, got %d", len(transcript.Segments))
+		}
+		if transcript.Segments[0].Text != "hola mundo" {
+			t.Errorf("Expected VTT tags to be stripped, got %q", transcript.Segments[0].Text)
+		}
+		if transcript.Segments[1].Text != "que tal" {
+			t.Errorf("Unexpected second segment text: %q", transcript.Segments[1].Text)
+		}
+		if transcript.Segments[1].Start != 1500*time.Millisecond {
+			t.Errorf("Expected cue settings to be ignored, got start %v", transcript.Segments[1].Start)
+		}
+	})
+
+	t.Run("falls back to a file without a language suffix", func(t *testing.T) {
+		provider := newLocalTestProvider(t, map[string]string{
+			"dQw4w9WgXcQ.srt": localTestSRT,
+		})
+
+		transcript, err := provider.GetTranscript(context.Background(), &types.TranscriptRequest{
+			VideoID:  "dQw4w9WgXcQ",
+			Language: "es",
+		})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if transcript.Language != "es" {
+			t.Errorf("Expected requested language to label the file, got %q", transcript.Language)
+		}
+	})
+
+	t.Run("falls back to another language for the video", func(t *testing.T) {
+		provider := newLocalTestProvider(t, map[string]string{
+			"dQw4w9WgXcQ.de.srt": localTestSRT,
+		})
+
+		transcript, err := provider.GetTranscript(context.Background(), &types.TranscriptRequest{
+			VideoID:  "dQw4w9WgXcQ",
+			Language: "es",
+		})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if transcript.Language != "de" {
+			t.Errorf("Expected the available language, got %q", transcript.Language)
+		}
+	})
+
+	t.Run("missing video returns transcript not found", func(t *testing.T) {
+		provider := newLocalTestProvider(t, map[string]string{
+			"dQw4w9WgXcQ.en.srt": localTestSRT,
+		})
+
+		_, err := provider.GetTranscript(context.Background(), &types.TranscriptRequest{
+			VideoID: "jNQXAC9IVRw",
+		})
+		if !goerrors.Is(err, errors.ErrTranscriptNotFound) {
+			t.Errorf("Expected ErrTranscriptNotFound, got %v", err)
+		}
+	})
+
+	t.Run("resolves the video ID from a URL", func(t *testing.T) {
+		provider := newLocalTestProvider(t, map[string]string{
+			"dQw4w9WgXcQ.en.srt": localTestSRT,
+		})
+
+		transcript, err := provider.GetTranscript(context.Background(), &types.TranscriptRequest{
+			VideoURL: "https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+		})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if transcript.VideoID != "dQw4w9WgXcQ" {
+			t.Errorf("Unexpected video ID: %q", transcript.VideoID)
+		}
+	})
+
+	t.Run("availability tracks the directory", func(t *testing.T) {
+		provider := newLocalTestProvider(t, nil)
+		if !provider.IsAvailable(context.Background()) {
+			t.Error("Expected provider with an existing directory to be available")
+		}
+
+		missing, err := local.NewProvider(&local.Config{Directory: "/nonexistent/captions"}, newTestLogger(t))
+		if err != nil {
+			t.Fatalf("Failed to create provider: %v", err)
+		}
+		if missing.IsAvailable(context.Background()) {
+			t.Error("Expected provider with a missing directory to be unavailable")
+		}
+	})
+
+	t.Run("directory is required", func(t *testing.T) {
+		if _, err := local.NewProvider(&local.Config{}, newTestLogger(t)); err == nil {
+			t.Error("Expected an error when no directory is configured")
+		}
+	})
+}
@@ -0,0 +1,225 @@
+package transcript_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	transcriptErrors "app-backend/internal/services/transcript/errors"
+	"app-backend/internal/services/transcript/types"
+)
+
+func newBreakerTestService(t *testing.T, threshold int, cooldown string, providers ...*stubProvider) *transcript.Service {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Transcript.Breaker.Enabled = true
+	cfg.Transcript.Breaker.FailureThreshold = threshold
+	cfg.Transcript.Breaker.Cooldown = cooldown
+
+	service, err := transcript.NewService(cfg, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+	for _, provider := range providers {
+		if err := service.RegisterProvider(provider); err != nil {
+			t.Fatalf("Failed to register provider %s: %v", provider.name, err)
+		}
+	}
+	return service
+}
+
+// gatedProvider is a stubProvider whose availability can be toggled so a
+// fallback can be kept out of the race while another breaker is tripped
+type gatedProvider struct {
+	stubProvider
+	available atomic.Bool
+}
+
+func (p *gatedProvider) IsAvailable(ctx context.Context) bool { return p.available.Load() }
+
+func breakerTestRequest(providers ...string) *types.TranscriptRequest {
+	return &types.TranscriptRequest{
+		VideoID:            "breaker-video",
+		Language:           "en",
+		PreferredProviders: providers,
+	}
+}
+
+func TestProviderCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("a repeatedly failing provider is skipped after the threshold", func(t *testing.T) {
+		failing := &stubProvider{
+			name: "failing",
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return nil, errors.New("upstream answered 403")
+			},
+		}
+		service := newBreakerTestService(t, 2, "1h", failing)
+
+		for i := 0; i < 5; i++ {
+			if _, err := service.GetTranscript(ctx, breakerTestRequest("failing")); err == nil {
+				t.Fatal("Expected GetTranscript to fail")
+			}
+		}
+
+		if calls := atomic.LoadInt32(&failing.calls); calls != 2 {
+			t.Errorf("Expected the provider to be skipped after 2 failures, got %d calls", calls)
+		}
+	})
+
+	t.Run("requests fall through to the next provider while tripped", func(t *testing.T) {
+		failing := &stubProvider{
+			name: "failing",
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return nil, errors.New("upstream answered 403")
+			},
+		}
+		fallback := &gatedProvider{stubProvider: stubProvider{
+			name: "fallback",
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return newTestTranscript("fallback"), nil
+			},
+		}}
+
+		cfg := &config.Config{}
+		cfg.Transcript.Breaker.Enabled = true
+		cfg.Transcript.Breaker.FailureThreshold = 2
+		cfg.Transcript.Breaker.Cooldown = "1h"
+		service, err := transcript.NewService(cfg, newTestLogger(t))
+		if err != nil {
+			t.Fatalf("Failed to create transcript service: %v", err)
+		}
+		if err := service.RegisterProvider(failing); err != nil {
+			t.Fatalf("Failed to register provider: %v", err)
+		}
+		if err := service.RegisterProvider(fallback); err != nil {
+			t.Fatalf("Failed to register provider: %v", err)
+		}
+
+		// Trip the failing provider's breaker while the fallback is offline
+		for i := 0; i < 2; i++ {
+			if _, err := service.GetTranscript(ctx, breakerTestRequest("failing")); err == nil {
+				t.Fatal("Expected GetTranscript to fail")
+			}
+		}
+
+		tripped := atomic.LoadInt32(&failing.calls)
+		fallback.available.Store(true)
+		for i := 0; i < 3; i++ {
+			transcript, err := service.GetTranscript(ctx, breakerTestRequest("failing", "fallback"))
+			if err != nil {
+				t.Fatalf("GetTranscript failed: %v", err)
+			}
+			if len(transcript.Segments) == 0 {
+				t.Fatal("Expected a transcript from the fallback provider")
+			}
+		}
+
+		if calls := atomic.LoadInt32(&failing.calls); calls != tripped {
+			t.Errorf("Expected the tripped provider to be skipped, got %d extra calls", calls-tripped)
+		}
+	})
+
+	t.Run("the provider is retried after the cooldown", func(t *testing.T) {
+		failing := &stubProvider{
+			name: "failing",
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return nil, errors.New("upstream answered 403")
+			},
+		}
+		service := newBreakerTestService(t, 2, "50ms", failing)
+
+		for i := 0; i < 3; i++ {
+			if _, err := service.GetTranscript(ctx, breakerTestRequest("failing")); err == nil {
+				t.Fatal("Expected GetTranscript to fail")
+			}
+		}
+		tripped := atomic.LoadInt32(&failing.calls)
+
+		time.Sleep(60 * time.Millisecond)
+		if _, err := service.GetTranscript(ctx, breakerTestRequest("failing")); err == nil {
+			t.Fatal("Expected GetTranscript to fail")
+		}
+
+		if calls := atomic.LoadInt32(&failing.calls); calls != tripped+1 {
+			t.Errorf("Expected one retry after the cooldown, got %d extra calls", calls-tripped)
+		}
+	})
+
+	t.Run("missing transcripts do not trip the breaker", func(t *testing.T) {
+		notFound := &stubProvider{
+			name: "not-found",
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return nil, transcriptErrors.ErrTranscriptNotFound
+			},
+		}
+		service := newBreakerTestService(t, 2, "1h", notFound)
+
+		for i := 0; i < 5; i++ {
+			if _, err := service.GetTranscript(ctx, breakerTestRequest("not-found")); err == nil {
+				t.Fatal("Expected GetTranscript to fail")
+			}
+		}
+
+		if calls := atomic.LoadInt32(&notFound.calls); calls != 5 {
+			t.Errorf("Expected every request to reach the provider, got %d calls", calls)
+		}
+	})
+
+	t.Run("disabled breakers retry on every request", func(t *testing.T) {
+		failing := &stubProvider{
+			name: "failing",
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return nil, errors.New("upstream answered 403")
+			},
+		}
+		disabled, err := transcript.NewService(&config.Config{}, newTestLogger(t))
+		if err != nil {
+			t.Fatalf("Failed to create transcript service: %v", err)
+		}
+		if err := disabled.RegisterProvider(failing); err != nil {
+			t.Fatalf("Failed to register provider: %v", err)
+		}
+
+		for i := 0; i < 5; i++ {
+			if _, err := disabled.GetTranscript(ctx, breakerTestRequest("failing")); err == nil {
+				t.Fatal("Expected GetTranscript to fail")
+			}
+		}
+
+		if calls := atomic.LoadInt32(&failing.calls); calls != 5 {
+			t.Errorf("Expected every request to reach the provider, got %d calls", calls)
+		}
+	})
+
+	t.Run("breaker metrics are exposed per provider", func(t *testing.T) {
+		failing := &stubProvider{
+			name: "failing",
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return nil, errors.New("upstream answered 403")
+			},
+		}
+		service := newBreakerTestService(t, 2, "1h", failing)
+
+		for i := 0; i < 3; i++ {
+			_, _ = service.GetTranscript(ctx, breakerTestRequest("failing"))
+		}
+
+		metrics := service.ProviderBreakerMetrics()
+		if len(metrics) != 1 {
+			t.Fatalf("Expected metrics for one provider, got %d", len(metrics))
+		}
+		if metrics[0].Name != "transcript-failing" {
+			t.Errorf("Unexpected breaker name %q", metrics[0].Name)
+		}
+		if metrics[0].State != "OPEN" {
+			t.Errorf("Expected an open breaker, got %q", metrics[0].State)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package transcript_test
+
+import (
+	"context"
+	goerrors "errors"
+	"sync/atomic"
+	"testing"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	transcriptErrors "app-backend/internal/services/transcript/errors"
+	"app-backend/internal/services/transcript/types"
+)
+
+func newOverrideTestService(t *testing.T, providers ...*stubProvider) *transcript.Service {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Transcript.Cache.Enabled = true
+	cfg.Transcript.Cache.MaxAge = "1m"
+	cfg.Transcript.Cache.StaleAfter = "1m"
+
+	service, err := transcript.NewService(cfg, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+	for _, provider := range providers {
+		if err := service.RegisterProvider(provider); err != nil {
+			t.Fatalf("Failed to register provider %s: %v", provider.name, err)
+		}
+	}
+	return service
+}
+
+func TestProviderOverride(t *testing.T) {
+	ctx := context.Background()
+
+	newProviders := func() (*stubProvider, *stubProvider) {
+		primary := &stubProvider{
+			name:     "primary",
+			priority: 1,
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return newTestTranscript("primary"), nil
+			},
+		}
+		secondary := &stubProvider{
+			name:     "secondary",
+			priority: 2,
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return newTestTranscript("secondary"), nil
+			},
+		}
+		return primary, secondary
+	}
+
+	t.Run("forced provider is used regardless of priority", func(t *testing.T) {
+		primary, secondary := newProviders()
+		service := newOverrideTestService(t, primary, secondary)
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:       "override-video",
+			Language:      "en",
+			ForceProvider: "secondary",
+		})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if result.Provider != "secondary" {
+			t.Errorf("Expected the forced provider's transcript, got %q", result.Provider)
+		}
+		if calls := atomic.LoadInt32(&primary.calls); calls != 0 {
+			t.Errorf("Expected the higher-priority provider to be skipped, got %d calls", calls)
+		}
+	})
+
+	t.Run("forced requests bypass the cache", func(t *testing.T) {
+		primary, secondary := newProviders()
+		service := newOverrideTestService(t, primary, secondary)
+
+		for i := 0; i < 2; i++ {
+			result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+				VideoID:       "override-video",
+				Language:      "en",
+				ForceProvider: "secondary",
+			})
+			if err != nil {
+				t.Fatalf("GetTranscript failed: %v", err)
+			}
+			if result.FromCache {
+				t.Error("A forced request should never be served from cache")
+			}
+		}
+		if calls := atomic.LoadInt32(&secondary.calls); calls != 2 {
+			t.Errorf("Expected the forced provider to be exercised twice, got %d calls", calls)
+		}
+	})
+
+	t.Run("unknown forced provider is rejected", func(t *testing.T) {
+		primary, secondary := newProviders()
+		service := newOverrideTestService(t, primary, secondary)
+
+		_, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:       "override-video",
+			Language:      "en",
+			ForceProvider: "no-such-provider",
+		})
+		if !goerrors.Is(err, transcriptErrors.ErrProviderNotAvailable) {
+			t.Fatalf("Expected ErrProviderNotAvailable, got %v", err)
+		}
+		if calls := atomic.LoadInt32(&primary.calls); calls != 0 {
+			t.Errorf("Expected no provider calls for an unknown override, got %d", calls)
+		}
+	})
+
+	t.Run("cache hits are marked for the debug headers", func(t *testing.T) {
+		primary, secondary := newProviders()
+		service := newOverrideTestService(t, primary, secondary)
+		req := &types.TranscriptRequest{VideoID: "override-video", Language: "en"}
+
+		first, err := service.GetTranscript(ctx, req)
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if first.FromCache {
+			t.Error("First serve should not be marked as a cache hit")
+		}
+
+		second, err := service.GetTranscript(ctx, req)
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if !second.FromCache {
+			t.Error("Second serve should be marked as a cache hit")
+		}
+		total := atomic.LoadInt32(&primary.calls) + atomic.LoadInt32(&secondary.calls)
+		if total != 1 {
+			t.Errorf("Expected a single provider call across both serves, got %d", total)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package transcript_test
+
+import (
+	"context"
+	"testing"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	"app-backend/internal/services/transcript/types"
+)
+
+func toggleConfig(providerType string, enabled bool) []config.TranscriptProviderConfig {
+	return []config.TranscriptProviderConfig{
+		{Type: providerType, Enabled: enabled},
+	}
+}
+
+func TestApplyProviderConfig(t *testing.T) {
+	ctx := context.Background()
+
+	newToggleService := func(t *testing.T, providers ...*stubProvider) *transcript.Service {
+		t.Helper()
+
+		service, err := transcript.NewService(&config.Config{}, newTestLogger(t))
+		if err != nil {
+			t.Fatalf("Failed to create transcript service: %v", err)
+		}
+		for _, provider := range providers {
+			if err := service.RegisterProvider(provider); err != nil {
+				t.Fatalf("Failed to register provider %s: %v", provider.name, err)
+			}
+		}
+		return service
+	}
+
+	hasProvider := func(service *transcript.Service, name string) bool {
+		for _, providerType := range service.GetAvailableProviders(ctx) {
+			if providerType == types.ProviderType(name) {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("disabling a provider removes it from the available set", func(t *testing.T) {
+		provider := &stubProvider{name: "toggled"}
+		service := newToggleService(t, provider)
+
+		if !hasProvider(service, "toggled") {
+			t.Fatal("Provider should be available after registration")
+		}
+
+		service.ApplyProviderConfig(toggleConfig("toggled", false))
+		if hasProvider(service, "toggled") {
+			t.Error("Disabled provider should not be available")
+		}
+	})
+
+	t.Run("re-enabling a provider needs no re-registration", func(t *testing.T) {
+		provider := &stubProvider{name: "toggled"}
+		service := newToggleService(t, provider)
+
+		service.ApplyProviderConfig(toggleConfig("toggled", false))
+		service.ApplyProviderConfig(toggleConfig("toggled", true))
+
+		if !hasProvider(service, "toggled") {
+			t.Error("Re-enabled provider should be available again")
+		}
+	})
+
+	t.Run("unknown providers in the config are ignored", func(t *testing.T) {
+		provider := &stubProvider{name: "toggled"}
+		service := newToggleService(t, provider)
+
+		service.ApplyProviderConfig(toggleConfig("never-registered", false))
+		if !hasProvider(service, "toggled") {
+			t.Error("Toggling an unknown provider must not affect registered ones")
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package transcript_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	"app-backend/internal/services/transcript/types"
+)
+
+func newQuotaTestService(t *testing.T, quotaLow func() bool, providers ...*stubProvider) *transcript.Service {
+	t.Helper()
+
+	// Stagger provider launches so the priority order is observable; without
+	// a backoff all providers race and the winner is arbitrary
+	cfg := &config.Config{}
+	cfg.Transcript.FallbackBackoff = "50ms"
+
+	service, err := transcript.NewService(cfg, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+	for _, provider := range providers {
+		if err := service.RegisterProvider(provider); err != nil {
+			t.Fatalf("Failed to register provider %s: %v", provider.name, err)
+		}
+	}
+	service.SetQuotaLowCheck(quotaLow)
+	return service
+}
+
+// TestQuotaDemotion verifies that the official API provider is ordered
+// behind the scrapers once the Data API budget signal trips, so scraping
+// absorbs the traffic instead of burning the remaining quota.
+func TestQuotaDemotion(t *testing.T) {
+	ctx := context.Background()
+
+	newProviders := func() (*stubProvider, *stubProvider) {
+		api := &stubProvider{
+			name:     string(types.ProviderYouTubeAPI),
+			priority: 1,
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return newTestTranscript(string(types.ProviderYouTubeAPI)), nil
+			},
+		}
+		scraper := &stubProvider{
+			name:     string(types.ProviderInnertube),
+			priority: 2,
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return newTestTranscript(string(types.ProviderInnertube)), nil
+			},
+		}
+		return api, scraper
+	}
+
+	request := func() *types.TranscriptRequest {
+		return &types.TranscriptRequest{
+			VideoID:            "quota-video",
+			Language:           "en",
+			PreferredProviders: []string{string(types.ProviderYouTubeAPI), string(types.ProviderInnertube)},
+		}
+	}
+
+	t.Run("the API provider keeps serving while the budget holds", func(t *testing.T) {
+		api, scraper := newProviders()
+		service := newQuotaTestService(t, func() bool { return false }, api, scraper)
+
+		result, err := service.GetTranscript(ctx, request())
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if result.Provider != string(types.ProviderYouTubeAPI) {
+			t.Errorf("Expected the API provider to serve, got %q", result.Provider)
+		}
+	})
+
+	t.Run("a low budget puts the scraper first", func(t *testing.T) {
+		api, scraper := newProviders()
+		service := newQuotaTestService(t, func() bool { return true }, api, scraper)
+
+		result, err := service.GetTranscript(ctx, request())
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if result.Provider != string(types.ProviderInnertube) {
+			t.Errorf("Expected the scraper to serve, got %q", result.Provider)
+		}
+		if calls := atomic.LoadInt32(&api.calls); calls != 0 {
+			t.Errorf("Expected the API provider to be skipped, got %d calls", calls)
+		}
+	})
+}
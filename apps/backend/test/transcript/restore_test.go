@@ -0,0 +1,187 @@
+package transcript_test
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	"app-backend/internal/services/transcript/types"
+	"app-backend/pkg/gemini"
+)
+
+// asrProvider is a mock provider returning an all-lowercase ASR transcript
+type asrProvider struct {
+	source string
+}
+
+func (p *asrProvider) GetTranscript(ctx context.Context, req *types.TranscriptRequest) (*types.Transcript, error) {
+	return &types.Transcript{
+		VideoID:  "asr-video",
+		Language: "en",
+		Provider: "mock",
+		Source:   p.source,
+		Segments: []types.TranscriptSegment{
+			{Text: "hello and welcome back", Start: 0, Duration: time.Second},
+			{Text: "today we talk about go", Start: time.Second, Duration: time.Second},
+		},
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (p *asrProvider) GetVideoID(url string) (string, error) { return url, nil }
+func (p *asrProvider) IsAvailable(ctx context.Context) bool  { return true }
+func (p *asrProvider) GetProviderType() types.ProviderType   { return types.ProviderType("mock") }
+func (p *asrProvider) GetPriority() int                      { return 0 }
+
+// countingFormatter fakes restoration by title-casing texts and counts calls
+type countingFormatter struct {
+	calls int32
+}
+
+func (f *countingFormatter) RestoreFormatting(ctx context.Context, texts []string) ([]string, error) {
+	atomic.AddInt32(&f.calls, 1)
+	restored := make([]string, len(texts))
+	for i, text := range texts {
+		restored[i] = strings.ToUpper(text[:1]) + text[1:] + "."
+	}
+	return restored, nil
+}
+
+func newRestoreTestService(t *testing.T, source string) (*transcript.Service, *countingFormatter) {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Transcript.Cache.Enabled = true
+	cfg.Transcript.Cache.MaxAge = "1m"
+	cfg.Transcript.Cache.StaleAfter = "1m"
+
+	service, err := transcript.NewService(cfg, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+	if err := service.RegisterProvider(&asrProvider{source: source}); err != nil {
+		t.Fatalf("Failed to register mock provider: %v", err)
+	}
+
+	formatter := &countingFormatter{}
+	service.SetFormatter(formatter)
+	return service, formatter
+}
+
+func TestRestoreFormatting(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("restores ASR transcripts and caches the restored version", func(t *testing.T) {
+		service, formatter := newRestoreTestService(t, types.SourceAutoGenerated)
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:           "asr-video",
+			Language:          "en",
+			RestoreFormatting: true,
+		})
+		if err != nil {
+			t.Fatalf("Expected restored transcript, got error: %v", err)
+		}
+		if !result.FormattingRestored {
+			t.Error("Expected transcript to be marked as formatting-restored")
+		}
+		if result.Segments[0].Text != "Hello and welcome back." {
+			t.Errorf("Expected restored text, got %q", result.Segments[0].Text)
+		}
+
+		// A repeated request must be served from the cache without a second
+		// restoration pass
+		again, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:           "asr-video",
+			Language:          "en",
+			RestoreFormatting: true,
+		})
+		if err != nil {
+			t.Fatalf("Expected cached transcript, got error: %v", err)
+		}
+		if again.Segments[0].Text != "Hello and welcome back." {
+			t.Errorf("Expected cached restored text, got %q", again.Segments[0].Text)
+		}
+		if got := atomic.LoadInt32(&formatter.calls); got != 1 {
+			t.Errorf("Expected 1 restoration call, got %d", got)
+		}
+	})
+
+	t.Run("flag off leaves the transcript untouched", func(t *testing.T) {
+		service, formatter := newRestoreTestService(t, types.SourceAutoGenerated)
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:  "asr-video",
+			Language: "en",
+		})
+		if err != nil {
+			t.Fatalf("Expected transcript, got error: %v", err)
+		}
+		if result.FormattingRestored {
+			t.Error("Expected transcript not to be marked as formatting-restored")
+		}
+		if result.Segments[0].Text != "hello and welcome back" {
+			t.Errorf("Expected raw text, got %q", result.Segments[0].Text)
+		}
+		if got := atomic.LoadInt32(&formatter.calls); got != 0 {
+			t.Errorf("Expected no restoration calls, got %d", got)
+		}
+	})
+
+	t.Run("manual caption tracks are not restored", func(t *testing.T) {
+		service, formatter := newRestoreTestService(t, types.SourceManual)
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:           "asr-video",
+			Language:          "en",
+			RestoreFormatting: true,
+		})
+		if err != nil {
+			t.Fatalf("Expected transcript, got error: %v", err)
+		}
+		if result.FormattingRestored {
+			t.Error("Expected manual transcript not to be marked as formatting-restored")
+		}
+		if got := atomic.LoadInt32(&formatter.calls); got != 0 {
+			t.Errorf("Expected no restoration calls, got %d", got)
+		}
+	})
+
+	t.Run("missing formatter degrades to the raw transcript", func(t *testing.T) {
+		service, _ := newRestoreTestService(t, types.SourceAutoGenerated)
+		service.SetFormatter(nil)
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:           "asr-video",
+			Language:          "en",
+			RestoreFormatting: true,
+		})
+		if err != nil {
+			t.Fatalf("Expected raw transcript, got error: %v", err)
+		}
+		if result.FormattingRestored {
+			t.Error("Expected transcript not to be marked as formatting-restored")
+		}
+		if result.Segments[0].Text != "hello and welcome back" {
+			t.Errorf("Expected raw text, got %q", result.Segments[0].Text)
+		}
+	})
+}
+
+func TestBuildRestorationPrompt(t *testing.T) {
+	prompt := gemini.BuildRestorationPrompt([]string{"hello world", "how are you"})
+
+	if !strings.Contains(prompt, "punctuation and capitalization") {
+		t.Error("Expected the prompt to ask for punctuation and capitalization")
+	}
+	if !strings.Contains(prompt, "Do not change, add, or remove any words") {
+		t.Error("Expected the prompt to forbid rewording")
+	}
+	if !strings.Contains(prompt, "0: hello world") || !strings.Contains(prompt, "1: how are you") {
+		t.Error("Expected the prompt to number each segment")
+	}
+}
@@ -0,0 +1,152 @@
+package transcript_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	"app-backend/internal/services/transcript/types"
+)
+
+func newScoringTestService(t *testing.T, pinned []string, providers ...*stubProvider) *transcript.Service {
+	t.Helper()
+
+	// Stagger provider launches so the adaptive order is observable
+	cfg := &config.Config{}
+	cfg.Transcript.FallbackBackoff = "50ms"
+	cfg.Transcript.Scoring.Enabled = true
+	cfg.Transcript.Scoring.Window = 10
+	cfg.Transcript.Scoring.Pinned = pinned
+
+	service, err := transcript.NewService(cfg, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+	for _, provider := range providers {
+		if err := service.RegisterProvider(provider); err != nil {
+			t.Fatalf("Failed to register provider %s: %v", provider.name, err)
+		}
+	}
+	return service
+}
+
+func scoringTestRequest() *types.TranscriptRequest {
+	return &types.TranscriptRequest{
+		VideoID:            "scoring-video",
+		Language:           "en",
+		PreferredProviders: []string{},
+	}
+}
+
+func TestProviderScorer(t *testing.T) {
+	t.Run("unseen providers score neutrally", func(t *testing.T) {
+		scorer := transcript.NewProviderScorer(10)
+		if got := scorer.Score("unseen"); got != 0.5 {
+			t.Errorf("Expected a neutral 0.5 score, got %v", got)
+		}
+	})
+
+	t.Run("failures drag a provider below a succeeding one", func(t *testing.T) {
+		scorer := transcript.NewProviderScorer(10)
+		for i := 0; i < 5; i++ {
+			scorer.Record("flaky", false, 100*time.Millisecond, nil)
+			scorer.Record("steady", true, 100*time.Millisecond, newTestTranscript("steady"))
+		}
+
+		if flaky, steady := scorer.Score("flaky"), scorer.Score("steady"); flaky >= steady {
+			t.Errorf("Expected the failing provider to score lower: flaky=%v steady=%v", flaky, steady)
+		}
+	})
+
+	t.Run("degenerate segments lower the quality component", func(t *testing.T) {
+		scorer := transcript.NewProviderScorer(10)
+
+		empty := newTestTranscript("sloppy")
+		empty.Segments = []types.TranscriptSegment{{Text: "", Start: 0, Duration: 0}}
+		scorer.Record("sloppy", true, 100*time.Millisecond, empty)
+		scorer.Record("clean", true, 100*time.Millisecond, newTestTranscript("clean"))
+
+		if sloppy, clean := scorer.Score("sloppy"), scorer.Score("clean"); sloppy >= clean {
+			t.Errorf("Expected degenerate segments to score lower: sloppy=%v clean=%v", sloppy, clean)
+		}
+	})
+
+	t.Run("old samples fall out of the window", func(t *testing.T) {
+		scorer := transcript.NewProviderScorer(3)
+		for i := 0; i < 10; i++ {
+			scorer.Record("recovered", false, 100*time.Millisecond, nil)
+		}
+		for i := 0; i < 3; i++ {
+			scorer.Record("recovered", true, 100*time.Millisecond, newTestTranscript("recovered"))
+		}
+
+		if got := scorer.Score("recovered"); got < 0.6 {
+			t.Errorf("Expected the old failures to age out, got score %v", got)
+		}
+	})
+}
+
+func TestAdaptiveProviderOrder(t *testing.T) {
+	ctx := context.Background()
+
+	newProviders := func() (*stubProvider, *stubProvider) {
+		flaky := &stubProvider{
+			name:     "flaky",
+			priority: 1,
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return nil, errors.New("upstream rejected the request")
+			},
+		}
+		steady := &stubProvider{
+			name:     "steady",
+			priority: 2,
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				return newTestTranscript("steady"), nil
+			},
+		}
+		return flaky, steady
+	}
+
+	t.Run("a failing provider is reordered behind a succeeding one", func(t *testing.T) {
+		flaky, steady := newProviders()
+		service := newScoringTestService(t, nil, flaky, steady)
+
+		// The first request walks the static order and records the outcomes
+		if _, err := service.GetTranscript(ctx, scoringTestRequest()); err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if calls := atomic.LoadInt32(&flaky.calls); calls != 1 {
+			t.Fatalf("Expected the flaky provider to be tried first once, got %d calls", calls)
+		}
+
+		// Now the steady provider should be tried first and serve alone
+		if _, err := service.GetTranscript(ctx, scoringTestRequest()); err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if calls := atomic.LoadInt32(&flaky.calls); calls != 1 {
+			t.Errorf("Expected the flaky provider to be demoted, got %d calls", calls)
+		}
+		if calls := atomic.LoadInt32(&steady.calls); calls != 2 {
+			t.Errorf("Expected the steady provider to serve both requests, got %d calls", calls)
+		}
+	})
+
+	t.Run("pinned providers keep their static priority", func(t *testing.T) {
+		flaky, steady := newProviders()
+		service := newScoringTestService(t, []string{"flaky"}, flaky, steady)
+
+		for i := 0; i < 2; i++ {
+			if _, err := service.GetTranscript(ctx, scoringTestRequest()); err != nil {
+				t.Fatalf("GetTranscript failed: %v", err)
+			}
+		}
+
+		if calls := atomic.LoadInt32(&flaky.calls); calls != 2 {
+			t.Errorf("Expected the pinned provider to stay first, got %d calls", calls)
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package transcript_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	"app-backend/internal/services/transcript/types"
+)
+
+// cueProvider is a mock provider returning a fixed set of caption cues
+type cueProvider struct {
+	segments []types.TranscriptSegment
+}
+
+func (p *cueProvider) GetTranscript(ctx context.Context, req *types.TranscriptRequest) (*types.Transcript, error) {
+	segments := make([]types.TranscriptSegment, len(p.segments))
+	copy(segments, p.segments)
+	return &types.Transcript{
+		VideoID:   "cue-video",
+		Language:  "en",
+		Provider:  "mock",
+		Segments:  segments,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (p *cueProvider) GetVideoID(url string) (string, error) { return url, nil }
+func (p *cueProvider) IsAvailable(ctx context.Context) bool  { return true }
+func (p *cueProvider) GetProviderType() types.ProviderType   { return types.ProviderType("mock") }
+func (p *cueProvider) GetPriority() int                      { return 0 }
+
+func newSegmentationTestService(t *testing.T, segments []types.TranscriptSegment) *transcript.Service {
+	t.Helper()
+
+	cfg := &config.Config{}
+	service, err := transcript.NewService(cfg, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+	if err := service.RegisterProvider(&cueProvider{segments: segments}); err != nil {
+		t.Fatalf("Failed to register mock provider: %v", err)
+	}
+	return service
+}
+
+func TestSentenceSegmentation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("merges cues up to terminal punctuation with aggregated timing", func(t *testing.T) {
+		service := newSegmentationTestService(t, []types.TranscriptSegment{
+			{Text: "Hello and welcome", Start: 0, Duration: 2 * time.Second},
+			{Text: "back to the channel.", Start: 2 * time.Second, Duration: 2 * time.Second},
+			{Text: "Today we talk about Go.", Start: 4 * time.Second, Duration: 3 * time.Second},
+		})
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:      "cue-video",
+			Language:     "en",
+			Segmentation: types.SegmentationSentence,
+		})
+		if err != nil {
+			t.Fatalf("Expected segmented transcript, got error: %v", err)
+		}
+		if !result.SentenceSegmented {
+			t.Error("Expected transcript to be marked as sentence-segmented")
+		}
+		if len(result.Segments) != 2 {
+			t.Fatalf("Expected 2 sentences, got %d", len(result.Segments))
+		}
+		first := result.Segments[0]
+		if first.Text != "Hello and welcome back to the channel." {
+			t.Errorf("Expected merged sentence, got %q", first.Text)
+		}
+		if first.Start != 0 || first.Duration != 4*time.Second {
+			t.Errorf("Expected aggregated timing 0s..4s, got start=%v duration=%v", first.Start, first.Duration)
+		}
+	})
+
+	t.Run("pause gaps break unpunctuated ASR cues", func(t *testing.T) {
+		service := newSegmentationTestService(t, []types.TranscriptSegment{
+			{Text: "so this is the first thought", Start: 0, Duration: 2 * time.Second},
+			{Text: "it keeps going", Start: 2 * time.Second, Duration: time.Second},
+			// A three-second silence before the next cue marks a boundary
+			{Text: "and now a new one", Start: 6 * time.Second, Duration: 2 * time.Second},
+		})
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:      "cue-video",
+			Language:     "en",
+			Segmentation: types.SegmentationSentence,
+		})
+		if err != nil {
+			t.Fatalf("Expected segmented transcript, got error: %v", err)
+		}
+		if len(result.Segments) != 2 {
+			t.Fatalf("Expected 2 sentences, got %d", len(result.Segments))
+		}
+		if result.Segments[0].Text != "so this is the first thought it keeps going" {
+			t.Errorf("Expected cues merged across the short gap, got %q", result.Segments[0].Text)
+		}
+		if result.Segments[1].Start != 6*time.Second {
+			t.Errorf("Expected second sentence to start at 6s, got %v", result.Segments[1].Start)
+		}
+	})
+
+	t.Run("default segmentation leaves cues untouched", func(t *testing.T) {
+		service := newSegmentationTestService(t, []types.TranscriptSegment{
+			{Text: "Hello and welcome", Start: 0, Duration: 2 * time.Second},
+			{Text: "back to the channel.", Start: 2 * time.Second, Duration: 2 * time.Second},
+		})
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:  "cue-video",
+			Language: "en",
+		})
+		if err != nil {
+			t.Fatalf("Expected transcript, got error: %v", err)
+		}
+		if result.SentenceSegmented {
+			t.Error("Expected transcript not to be marked as sentence-segmented")
+		}
+		if len(result.Segments) != 2 {
+			t.Errorf("Expected the original 2 cues, got %d segments", len(result.Segments))
+		}
+	})
+
+	t.Run("closing quotes after punctuation still end the sentence", func(t *testing.T) {
+		service := newSegmentationTestService(t, []types.TranscriptSegment{
+			{Text: "She said \"hello.\"", Start: 0, Duration: time.Second},
+			{Text: "Then she left.", Start: time.Second, Duration: time.Second},
+		})
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:      "cue-video",
+			Language:     "en",
+			Segmentation: types.SegmentationSentence,
+		})
+		if err != nil {
+			t.Fatalf("Expected segmented transcript, got error: %v", err)
+		}
+		if len(result.Segments) != 2 {
+			t.Fatalf("Expected 2 sentences, got %d", len(result.Segments))
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package transcript_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	"app-backend/internal/services/transcript/types"
+)
+
+// coalescedProvider serves a fixed transcript once its release channel is closed
+// and counts how often it was called
+type coalescedProvider struct {
+	release chan struct{}
+	calls   int32
+}
+
+func (p *coalescedProvider) GetTranscript(ctx context.Context, req *types.TranscriptRequest) (*types.Transcript, error) {
+	atomic.AddInt32(&p.calls, 1)
+	<-p.release
+	return &types.Transcript{
+		VideoID:  req.VideoID,
+		Language: req.Language,
+		Provider: "gated",
+		Segments: []types.TranscriptSegment{{Text: "shared fetch"}},
+	}, nil
+}
+
+func (p *coalescedProvider) GetVideoID(url string) (string, error) { return url, nil }
+func (p *coalescedProvider) IsAvailable(ctx context.Context) bool  { return true }
+func (p *coalescedProvider) GetProviderType() types.ProviderType {
+	return types.ProviderType("gated")
+}
+func (p *coalescedProvider) GetPriority() int { return 0 }
+
+func TestGetTranscriptCoalescing(t *testing.T) {
+	t.Run("concurrent identical requests share one provider call", func(t *testing.T) {
+		// Cache disabled so only the in-flight coalescing deduplicates
+		service, err := transcript.NewService(&config.Config{}, newTestLogger(t))
+		if err != nil {
+			t.Fatalf("Failed to create transcript service: %v", err)
+		}
+		provider := &coalescedProvider{release: make(chan struct{})}
+		if err := service.RegisterProvider(provider); err != nil {
+			t.Fatalf("Failed to register mock provider: %v", err)
+		}
+
+		req := &types.TranscriptRequest{
+			VideoID:            "coalesced-video",
+			Language:           "en",
+			PreferredProviders: []string{"gated"},
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				result, err := service.GetTranscript(context.Background(), req)
+				if err != nil {
+					t.Errorf("GetTranscript failed: %v", err)
+					return
+				}
+				if result.VideoID != "coalesced-video" {
+					t.Errorf("Unexpected transcript %+v", result)
+				}
+			}()
+		}
+
+		// Give the goroutines time to join the in-flight fetch, then let it
+		// complete
+		time.Sleep(50 * time.Millisecond)
+		close(provider.release)
+		wg.Wait()
+
+		if calls := atomic.LoadInt32(&provider.calls); calls != 1 {
+			t.Errorf("Expected 1 provider call for 5 concurrent requests, got %d", calls)
+		}
+	})
+
+	t.Run("requests for different videos are fetched independently", func(t *testing.T) {
+		service, err := transcript.NewService(&config.Config{}, newTestLogger(t))
+		if err != nil {
+			t.Fatalf("Failed to create transcript service: %v", err)
+		}
+		provider := &coalescedProvider{release: make(chan struct{})}
+		close(provider.release)
+		if err := service.RegisterProvider(provider); err != nil {
+			t.Fatalf("Failed to register mock provider: %v", err)
+		}
+
+		for _, videoID := range []string{"video-a", "video-b"} {
+			if _, err := service.GetTranscript(context.Background(), &types.TranscriptRequest{
+				VideoID:            videoID,
+				Language:           "en",
+				PreferredProviders: []string{"gated"},
+			}); err != nil {
+				t.Fatalf("GetTranscript failed for %s: %v", videoID, err)
+			}
+		}
+
+		if calls := atomic.LoadInt32(&provider.calls); calls != 2 {
+			t.Errorf("Expected one provider call per video, got %d", calls)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package transcript_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	"app-backend/internal/services/transcript/types"
+)
+
+func newStaleTestService(t *testing.T, provider *stubProvider) *transcript.Service {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Transcript.Cache.Enabled = true
+	cfg.Transcript.Cache.MaxAge = "40ms"
+	cfg.Transcript.Cache.StaleAfter = "20ms"
+	cfg.Transcript.Cache.ServeStaleOnError = true
+
+	service, err := transcript.NewService(cfg, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+	if err := service.RegisterProvider(provider); err != nil {
+		t.Fatalf("Failed to register provider: %v", err)
+	}
+	return service
+}
+
+func TestServeStaleOnError(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stale cached transcript is served when all providers fail", func(t *testing.T) {
+		var failing int32
+		provider := &stubProvider{
+			name: "flaky",
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				if atomic.LoadInt32(&failing) == 1 {
+					return nil, errors.New("upstream rejected the request")
+				}
+				return newTestTranscript("flaky"), nil
+			},
+		}
+		service := newStaleTestService(t, provider)
+
+		req := &types.TranscriptRequest{
+			VideoID:            "stale-video",
+			Language:           "en",
+			PreferredProviders: []string{"flaky"},
+		}
+
+		// Seed the cache while the provider is healthy
+		seeded, err := service.GetTranscript(ctx, req)
+		if err != nil {
+			t.Fatalf("GetTranscript failed while healthy: %v", err)
+		}
+		if seeded.Stale {
+			t.Error("Expected a fresh transcript not to be marked Stale")
+		}
+
+		// Let the entry expire past the hard limit, then break the provider
+		time.Sleep(60 * time.Millisecond)
+		atomic.StoreInt32(&failing, 1)
+
+		stale, err := service.GetTranscript(ctx, req)
+		if err != nil {
+			t.Fatalf("Expected the stale transcript instead of an error, got: %v", err)
+		}
+		if !stale.Stale {
+			t.Error("Expected the served transcript to be marked Stale")
+		}
+		if stale.Provider != "flaky" {
+			t.Errorf("Expected the cached transcript, got provider %s", stale.Provider)
+		}
+	})
+}
@@ -0,0 +1,231 @@
+package transcript_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"app-backend/internal/services/transcript/providers/innertube"
+	"app-backend/internal/services/transcript/providers/timedtext"
+	"app-backend/internal/services/transcript/types"
+)
+
+// asrTimedtextJSON is a json3 payload in the shape of an ASR track: one seg
+// per word with an offset into the cue, plus a newline-only filler event
+const asrTimedtextJSON = `{
+	"events": [
+		{
+			"tStartMs": 0,
+			"dDurationMs": 2000,
+			"segs": [
+				{"utf8": "hello"},
+				{"utf8": " there", "tOffsetMs": 600},
+				{"utf8": " world", "tOffsetMs": 1200}
+			]
+		},
+		{"tStartMs": 1990, "dDurationMs": 10, "segs": [{"utf8": "\n"}]},
+		{
+			"tStartMs": 2000,
+			"dDurationMs": 1500,
+			"segs": [
+				{"utf8": "second"},
+				{"utf8": " cue", "tOffsetMs": 700}
+			]
+		}
+	]
+}`
+
+// manualTimedtextJSON is a json3 payload in the shape of a manual track: one
+// untimed seg per cue, so no word timing is available
+const manualTimedtextJSON = `{
+	"events": [
+		{"tStartMs": 0, "dDurationMs": 2000, "segs": [{"utf8": "hello there world"}]},
+		{"tStartMs": 2000, "dDurationMs": 1500, "segs": [{"utf8": "second cue"}]}
+	]
+}`
+
+func TestParseJSON3(t *testing.T) {
+	t.Run("extracts word timing from ASR tracks", func(t *testing.T) {
+		segments, err := timedtext.ParseJSON3([]byte(asrTimedtextJSON))
+		if err != nil {
+			t.Fatalf("ParseJSON3 failed: %v", err)
+		}
+		if len(segments) != 2 {
+			t.Fatalf("Expected 2 segments (filler skipped), got %d", len(segments))
+		}
+
+		first := segments[0]
+		if first.Text != "hello there world" {
+			t.Errorf("Unexpected segment text: %q", first.Text)
+		}
+		if len(first.Words) != 3 {
+			t.Fatalf("Expected 3 words, got %d", len(first.Words))
+		}
+		if first.Words[1].Text != "there" || first.Words[1].Start != 600*time.Millisecond {
+			t.Errorf("Unexpected second word: %+v", first.Words[1])
+		}
+		// Word durations close at the next word, the last at the cue end
+		if first.Words[0].Duration != 600*time.Millisecond {
+			t.Errorf("Expected first word to last 600ms, got %v", first.Words[0].Duration)
+		}
+		if first.Words[2].Duration != 800*time.Millisecond {
+			t.Errorf("Expected last word to close at cue end, got %v", first.Words[2].Duration)
+		}
+	})
+
+	t.Run("manual tracks yield segments without words", func(t *testing.T) {
+		segments, err := timedtext.ParseJSON3([]byte(manualTimedtextJSON))
+		if err != nil {
+			t.Fatalf("ParseJSON3 failed: %v", err)
+		}
+		if len(segments) != 2 {
+			t.Fatalf("Expected 2 segments, got %d", len(segments))
+		}
+		for i, segment := range segments {
+			if len(segment.Words) != 0 {
+				t.Errorf("Segment %d should carry no word timing, got %+v", i, segment.Words)
+			}
+		}
+	})
+
+	t.Run("rejects invalid JSON", func(t *testing.T) {
+		if _, err := timedtext.ParseJSON3([]byte("<html>error</html>")); err == nil {
+			t.Fatal("Expected an error for a non-JSON payload")
+		}
+	})
+}
+
+func TestAttachWords(t *testing.T) {
+	timed, err := timedtext.ParseJSON3([]byte(asrTimedtextJSON))
+	if err != nil {
+		t.Fatalf("ParseJSON3 failed: %v", err)
+	}
+
+	t.Run("matches segments to cues by start time", func(t *testing.T) {
+		// Provider segments drift slightly from the timedtext cues
+		segments := []types.TranscriptSegment{
+			{Text: "hello there world", Start: 100 * time.Millisecond, Duration: 1900 * time.Millisecond},
+			{Text: "second cue", Start: 2050 * time.Millisecond, Duration: 1450 * time.Millisecond},
+		}
+
+		attached := timedtext.AttachWords(segments, timed)
+		if attached != 2 {
+			t.Fatalf("Expected words on 2 segments, got %d", attached)
+		}
+		if len(segments[0].Words) != 3 || len(segments[1].Words) != 2 {
+			t.Errorf("Unexpected word counts: %d and %d", len(segments[0].Words), len(segments[1].Words))
+		}
+	})
+
+	t.Run("leaves unmatched segments untouched", func(t *testing.T) {
+		segments := []types.TranscriptSegment{
+			{Text: "way past the track", Start: time.Hour},
+		}
+
+		if attached := timedtext.AttachWords(segments, timed); attached != 0 {
+			t.Fatalf("Expected no attachments, got %d", attached)
+		}
+		if len(segments[0].Words) != 0 {
+			t.Errorf("Unmatched segment should stay word-free, got %+v", segments[0].Words)
+		}
+	})
+}
+
+func TestFetchWordTimings(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("falls back to the ASR track when the default has no word timing", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			if r.URL.Query().Get("kind") == "asr" {
+				w.Write([]byte(asrTimedtextJSON))
+				return
+			}
+			w.Write([]byte(manualTimedtextJSON))
+		}))
+		defer server.Close()
+
+		client := timedtext.NewClient(server.Client(), server.URL, newTestLogger(t))
+
+		segments, err := client.FetchWordTimings(ctx, "dQw4w9WgXcQ", "en")
+		if err != nil {
+			t.Fatalf("FetchWordTimings failed: %v", err)
+		}
+		if atomic.LoadInt32(&requests) != 2 {
+			t.Errorf("Expected 2 track requests, got %d", requests)
+		}
+		if len(segments) != 2 || len(segments[0].Words) != 3 {
+			t.Errorf("Expected ASR segments with word timing, got %+v", segments)
+		}
+	})
+
+	t.Run("fails when no track exists", func(t *testing.T) {
+		// The endpoint answers 200 with an empty body for unknown tracks
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+
+		client := timedtext.NewClient(server.Client(), server.URL, newTestLogger(t))
+
+		if _, err := client.FetchWordTimings(ctx, "dQw4w9WgXcQ", "en"); err == nil {
+			t.Fatal("Expected an error when no track exists")
+		}
+	})
+}
+
+func TestInnertubeWordGranularity(t *testing.T) {
+	ctx := context.Background()
+
+	// One server plays both roles: the innertube transcript endpoint (POST)
+	// and the timedtext endpoint (GET)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Write([]byte(innertubeTranscriptJSON))
+			return
+		}
+		if r.URL.Query().Get("kind") == "asr" {
+			w.Write([]byte(asrTimedtextJSON))
+			return
+		}
+		w.Write([]byte(manualTimedtextJSON))
+	}))
+	defer server.Close()
+
+	titles := newTitleStubServer(t)
+	provider := innertube.NewProvider(&innertube.Config{
+		Timeout:           2,
+		Endpoint:          server.URL,
+		TimedtextEndpoint: server.URL,
+		WatchEndpoint:     titles.URL + "/watch",
+		OEmbedEndpoint:    titles.URL + "/oembed",
+	}, newTestLogger(t))
+
+	t.Run("attaches word timing for word granularity", func(t *testing.T) {
+		transcript, err := provider.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:     "dQw4w9WgXcQ",
+			Granularity: types.GranularityWord,
+		})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if len(transcript.Segments) != 1 {
+			t.Fatalf("Expected 1 segment, got %d", len(transcript.Segments))
+		}
+		if len(transcript.Segments[0].Words) != 3 {
+			t.Errorf("Expected 3 timed words, got %+v", transcript.Segments[0].Words)
+		}
+	})
+
+	t.Run("default granularity returns no words", func(t *testing.T) {
+		transcript, err := provider.GetTranscript(ctx, &types.TranscriptRequest{VideoID: "dQw4w9WgXcQ"})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if len(transcript.Segments[0].Words) != 0 {
+			t.Errorf("Expected no word timing by default, got %+v", transcript.Segments[0].Words)
+		}
+	})
+}
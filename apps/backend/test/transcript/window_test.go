@@ -0,0 +1,206 @@
+package transcript_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"app-backend/internal/config"
+	"app-backend/internal/services/transcript"
+	"app-backend/internal/services/transcript/types"
+)
+
+// tenCues returns ten one-second cues starting at 0s, 1s, ... 9s
+func tenCues() []types.TranscriptSegment {
+	segments := make([]types.TranscriptSegment, 10)
+	for i := range segments {
+		segments[i] = types.TranscriptSegment{
+			Text:     "cue",
+			Start:    time.Duration(i) * time.Second,
+			Duration: time.Second,
+		}
+	}
+	return segments
+}
+
+func newWindowTestService(t *testing.T) *transcript.Service {
+	t.Helper()
+
+	service, err := transcript.NewService(&config.Config{}, newTestLogger(t))
+	if err != nil {
+		t.Fatalf("Failed to create transcript service: %v", err)
+	}
+	if err := service.RegisterProvider(&cueProvider{segments: tenCues()}); err != nil {
+		t.Fatalf("Failed to register mock provider: %v", err)
+	}
+	return service
+}
+
+func TestSegmentWindow(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("time range keeps overlapping segments", func(t *testing.T) {
+		service := newWindowTestService(t)
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:  "cue-video",
+			Language: "en",
+			From:     2 * time.Second,
+			To:       5 * time.Second,
+		})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if len(result.Segments) != 3 {
+			t.Fatalf("Expected 3 segments in [2s, 5s), got %d", len(result.Segments))
+		}
+		if result.Segments[0].Start != 2*time.Second {
+			t.Errorf("Expected first segment at 2s, got %v", result.Segments[0].Start)
+		}
+		if result.TotalSegments != 3 {
+			t.Errorf("Expected TotalSegments 3, got %d", result.TotalSegments)
+		}
+	})
+
+	t.Run("from without to runs to the end", func(t *testing.T) {
+		service := newWindowTestService(t)
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:  "cue-video",
+			Language: "en",
+			From:     8 * time.Second,
+		})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if len(result.Segments) != 2 {
+			t.Errorf("Expected the last 2 segments, got %d", len(result.Segments))
+		}
+	})
+
+	t.Run("offset and limit paginate after the range filter", func(t *testing.T) {
+		service := newWindowTestService(t)
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:  "cue-video",
+			Language: "en",
+			From:     2 * time.Second,
+			Offset:   3,
+			Limit:    2,
+		})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if len(result.Segments) != 2 {
+			t.Fatalf("Expected a 2-segment page, got %d", len(result.Segments))
+		}
+		if result.Segments[0].Start != 5*time.Second {
+			t.Errorf("Expected the page to start at 5s, got %v", result.Segments[0].Start)
+		}
+		// Eight segments survive the range filter before pagination
+		if result.TotalSegments != 8 {
+			t.Errorf("Expected TotalSegments 8, got %d", result.TotalSegments)
+		}
+	})
+
+	t.Run("offset past the end returns an empty page", func(t *testing.T) {
+		service := newWindowTestService(t)
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:  "cue-video",
+			Language: "en",
+			Offset:   50,
+		})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if len(result.Segments) != 0 {
+			t.Errorf("Expected no segments past the end, got %d", len(result.Segments))
+		}
+		if result.TotalSegments != 10 {
+			t.Errorf("Expected TotalSegments 10, got %d", result.TotalSegments)
+		}
+	})
+
+	t.Run("no window parameters return the full transcript", func(t *testing.T) {
+		service := newWindowTestService(t)
+
+		result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:  "cue-video",
+			Language: "en",
+		})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if len(result.Segments) != 10 {
+			t.Errorf("Expected all 10 segments, got %d", len(result.Segments))
+		}
+		if result.TotalSegments != 0 {
+			t.Errorf("Expected TotalSegments unset without a window, got %d", result.TotalSegments)
+		}
+	})
+
+	t.Run("windowed requests reuse one cached fetch", func(t *testing.T) {
+		cues := tenCues()
+		provider := &stubProvider{
+			name: "counting",
+			result: func(ctx context.Context) (*types.Transcript, error) {
+				segments := make([]types.TranscriptSegment, len(cues))
+				copy(segments, cues)
+				return &types.Transcript{
+					VideoID:   "window-video",
+					Language:  "en",
+					Provider:  "counting",
+					Segments:  segments,
+					CreatedAt: time.Now(),
+				}, nil
+			},
+		}
+
+		cfg := &config.Config{}
+		cfg.Transcript.Cache.Enabled = true
+		cfg.Transcript.Cache.MaxAge = "1m"
+		cfg.Transcript.Cache.StaleAfter = "1m"
+
+		service, err := transcript.NewService(cfg, newTestLogger(t))
+		if err != nil {
+			t.Fatalf("Failed to create transcript service: %v", err)
+		}
+		if err := service.RegisterProvider(provider); err != nil {
+			t.Fatalf("Failed to register provider: %v", err)
+		}
+
+		for offset := 0; offset < 10; offset += 5 {
+			result, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+				VideoID:            "window-video",
+				Language:           "en",
+				PreferredProviders: []string{"counting"},
+				Offset:             offset,
+				Limit:              5,
+			})
+			if err != nil {
+				t.Fatalf("GetTranscript failed at offset %d: %v", offset, err)
+			}
+			if len(result.Segments) != 5 {
+				t.Fatalf("Expected a 5-segment page at offset %d, got %d", offset, len(result.Segments))
+			}
+		}
+
+		// A full, unwindowed read still sees every segment of the cached entry
+		full, err := service.GetTranscript(ctx, &types.TranscriptRequest{
+			VideoID:            "window-video",
+			Language:           "en",
+			PreferredProviders: []string{"counting"},
+		})
+		if err != nil {
+			t.Fatalf("GetTranscript failed: %v", err)
+		}
+		if len(full.Segments) != 10 {
+			t.Errorf("Expected the cached transcript to remain complete, got %d segments", len(full.Segments))
+		}
+		if got := atomic.LoadInt32(&provider.calls); got != 1 {
+			t.Errorf("Expected a single provider fetch across windowed reads, got %d", got)
+		}
+	})
+}
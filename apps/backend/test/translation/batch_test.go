@@ -0,0 +1,190 @@
+package translation_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/services/translation"
+)
+
+func newBatchTestTranslator(t *testing.T, fn translation.TranslateFunc) translation.BatchTranslatorInterface {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	bt := translation.NewBatchTranslator(fn, log)
+	t.Cleanup(bt.Stop)
+	return bt
+}
+
+// prefixTranslate translates every text deterministically and fails texts
+// containing "boom"
+func prefixTranslate(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]string, error) {
+	translations := make([]string, len(texts))
+	for i, text := range texts {
+		if strings.Contains(text, "boom") {
+			return nil, fmt.Errorf("cannot translate %q", text)
+		}
+		translations[i] = "[" + strings.ToUpper(targetLang) + "] " + text
+	}
+	return translations, nil
+}
+
+func TestBatchTranslator(t *testing.T) {
+	t.Run("job completes with per-segment results and full progress", func(t *testing.T) {
+		bt := newBatchTestTranslator(t, prefixTranslate)
+
+		jobID, err := bt.StartBatch([]string{"one", "two", "three"}, "es", "en")
+		if err != nil {
+			t.Fatalf("StartBatch failed: %v", err)
+		}
+		if jobID == "" {
+			t.Fatal("Expected a non-empty job ID")
+		}
+
+		waitFor(t, func() bool {
+			snapshot, ok := bt.Job(jobID)
+			return ok && snapshot.Status == translation.BatchJobCompleted
+		}, "Job never completed")
+
+		snapshot, _ := bt.Job(jobID)
+		if snapshot.Total != 3 || snapshot.Completed != 3 || snapshot.Failed != 0 {
+			t.Errorf("Expected 3/3 completed with no failures, got %d/%d with %d failed",
+				snapshot.Completed, snapshot.Total, snapshot.Failed)
+		}
+		if snapshot.ProgressPercent != 100 {
+			t.Errorf("Expected 100%% progress, got %v", snapshot.ProgressPercent)
+		}
+		for i, want := range []string{"[ES] one", "[ES] two", "[ES] three"} {
+			if snapshot.Results[i].Translation != want {
+				t.Errorf("Segment %d: expected %q, got %q", i, want, snapshot.Results[i].Translation)
+			}
+		}
+	})
+
+	t.Run("failed segments are counted and carry an error", func(t *testing.T) {
+		bt := newBatchTestTranslator(t, prefixTranslate)
+
+		jobID, err := bt.StartBatch([]string{"fine", "boom"}, "es", "")
+		if err != nil {
+			t.Fatalf("StartBatch failed: %v", err)
+		}
+
+		waitFor(t, func() bool {
+			snapshot, ok := bt.Job(jobID)
+			return ok && snapshot.Status == translation.BatchJobCompleted
+		}, "Job never completed")
+
+		snapshot, _ := bt.Job(jobID)
+		if snapshot.Failed != 1 {
+			t.Errorf("Expected 1 failed segment, got %d", snapshot.Failed)
+		}
+		if snapshot.Results[0].Error != "" || snapshot.Results[0].Translation != "[ES] fine" {
+			t.Errorf("Expected the first segment to succeed, got %+v", snapshot.Results[0])
+		}
+		if snapshot.Results[1].Error == "" {
+			t.Error("Expected the failed segment to carry an error message")
+		}
+		if snapshot.ProgressPercent != 100 {
+			t.Errorf("Expected failed segments to still count toward progress, got %v", snapshot.ProgressPercent)
+		}
+	})
+
+	t.Run("subscribers receive every segment and a terminal update", func(t *testing.T) {
+		bt := newBatchTestTranslator(t, prefixTranslate)
+
+		jobID, err := bt.StartBatch([]string{"a", "b", "c"}, "fr", "")
+		if err != nil {
+			t.Fatalf("StartBatch failed: %v", err)
+		}
+
+		updates, cancel, ok := bt.Subscribe(jobID)
+		if !ok {
+			t.Fatal("Expected subscription to an existing job to succeed")
+		}
+		defer cancel()
+
+		seen := make(map[int]string)
+		finished := false
+		for update := range updates {
+			if update.Finished {
+				finished = true
+				if update.ProgressPercent != 100 {
+					t.Errorf("Expected 100%% progress in the terminal update, got %v", update.ProgressPercent)
+				}
+				break
+			}
+			seen[update.Segment.Index] = update.Segment.Translation
+		}
+
+		if !finished {
+			t.Fatal("Expected a terminal update before the channel closed")
+		}
+		if len(seen) != 3 {
+			t.Fatalf("Expected updates for 3 segments, got %d", len(seen))
+		}
+		if seen[1] != "[FR] b" {
+			t.Errorf("Expected segment 1 to be %q, got %q", "[FR] b", seen[1])
+		}
+	})
+
+	t.Run("subscribing to a completed job replays all segments", func(t *testing.T) {
+		bt := newBatchTestTranslator(t, prefixTranslate)
+
+		jobID, err := bt.StartBatch([]string{"x", "y"}, "de", "")
+		if err != nil {
+			t.Fatalf("StartBatch failed: %v", err)
+		}
+		waitFor(t, func() bool {
+			snapshot, ok := bt.Job(jobID)
+			return ok && snapshot.Status == translation.BatchJobCompleted
+		}, "Job never completed")
+
+		updates, cancel, ok := bt.Subscribe(jobID)
+		if !ok {
+			t.Fatal("Expected subscription to a completed job to succeed")
+		}
+		defer cancel()
+
+		segments := 0
+		finished := false
+		for update := range updates {
+			if update.Finished {
+				finished = true
+				continue
+			}
+			segments++
+		}
+		if segments != 2 || !finished {
+			t.Errorf("Expected 2 replayed segments and a terminal update, got %d (finished=%v)", segments, finished)
+		}
+	})
+
+	t.Run("unknown job IDs are reported as missing", func(t *testing.T) {
+		bt := newBatchTestTranslator(t, prefixTranslate)
+
+		if _, ok := bt.Job("missing"); ok {
+			t.Error("Expected lookup of an unknown job ID to fail")
+		}
+		if _, _, ok := bt.Subscribe("missing"); ok {
+			t.Error("Expected subscription to an unknown job ID to fail")
+		}
+	})
+
+	t.Run("start validates required fields", func(t *testing.T) {
+		bt := newBatchTestTranslator(t, prefixTranslate)
+
+		if _, err := bt.StartBatch(nil, "es", ""); err == nil {
+			t.Error("Expected starting a batch without texts to fail")
+		}
+		if _, err := bt.StartBatch([]string{"hello"}, "", ""); err == nil {
+			t.Error("Expected starting a batch without a target language to fail")
+		}
+	})
+}
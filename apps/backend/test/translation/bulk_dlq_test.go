@@ -0,0 +1,130 @@
+package translation_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/services/translation"
+)
+
+// flakyTranslate fails the first attempt for each text and succeeds afterwards
+type flakyTranslate struct {
+	mu       sync.Mutex
+	attempts map[string]int
+}
+
+func (f *flakyTranslate) translate(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	translations := make([]string, len(texts))
+	for i, text := range texts {
+		f.attempts[text]++
+		if f.attempts[text] == 1 {
+			return nil, fmt.Errorf("transient failure for %q", text)
+		}
+		translations[i] = "[ES] " + text
+	}
+	return translations, nil
+}
+
+func newBulkTestTranslator(t *testing.T, fn translation.TranslateFunc) *translation.BulkTranslator {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	bt := translation.NewBulkTranslator(fn, log)
+	t.Cleanup(bt.Stop)
+	return bt
+}
+
+func waitFor(t *testing.T, condition func() bool, message string) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if condition() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal(message)
+}
+
+func TestBulkTranslatorDeadLetterQueue(t *testing.T) {
+	t.Run("failed items land in the dead-letter queue", func(t *testing.T) {
+		flaky := &flakyTranslate{attempts: make(map[string]int)}
+		bt := newBulkTestTranslator(t, flaky.translate)
+
+		if err := bt.Submit(translation.BulkItem{ID: "job-1", Text: "hello", TargetLang: "es"}); err != nil {
+			t.Fatalf("Submit failed: %v", err)
+		}
+
+		waitFor(t, func() bool { return len(bt.DeadLetters()) == 1 }, "Item never reached the dead-letter queue")
+
+		deadLetters := bt.DeadLetters()
+		if deadLetters[0].Item.ID != "job-1" {
+			t.Errorf("Expected dead letter for job-1, got %q", deadLetters[0].Item.ID)
+		}
+		if deadLetters[0].Reason == "" {
+			t.Error("Expected dead letter to carry a failure reason")
+		}
+	})
+
+	t.Run("dead-lettered items succeed when re-submitted", func(t *testing.T) {
+		flaky := &flakyTranslate{attempts: make(map[string]int)}
+		bt := newBulkTestTranslator(t, flaky.translate)
+
+		if err := bt.Submit(translation.BulkItem{ID: "job-2", Text: "world", TargetLang: "es"}); err != nil {
+			t.Fatalf("Submit failed: %v", err)
+		}
+		waitFor(t, func() bool { return len(bt.DeadLetters()) == 1 }, "Item never reached the dead-letter queue")
+
+		if err := bt.Retry("job-2"); err != nil {
+			t.Fatalf("Retry failed: %v", err)
+		}
+
+		waitFor(t, func() bool {
+			_, ok := bt.Result("job-2")
+			return ok
+		}, "Re-submitted item never completed")
+
+		if result, _ := bt.Result("job-2"); result != "[ES] world" {
+			t.Errorf("Expected translated result, got %q", result)
+		}
+		if len(bt.DeadLetters()) != 0 {
+			t.Error("Expected the dead-letter queue to be empty after a successful retry")
+		}
+	})
+
+	t.Run("retrying an unknown job ID fails", func(t *testing.T) {
+		flaky := &flakyTranslate{attempts: make(map[string]int)}
+		bt := newBulkTestTranslator(t, flaky.translate)
+
+		if err := bt.Retry("missing"); err == nil {
+			t.Error("Expected retry of an unknown job ID to fail")
+		}
+	})
+
+	t.Run("submit validates required fields", func(t *testing.T) {
+		flaky := &flakyTranslate{attempts: make(map[string]int)}
+		bt := newBulkTestTranslator(t, flaky.translate)
+
+		if err := bt.Submit(translation.BulkItem{Text: "hello", TargetLang: "es"}); err == nil {
+			t.Error("Expected submit without an ID to fail")
+		}
+		if err := bt.Submit(translation.BulkItem{ID: "job-3", TargetLang: "es"}); err == nil {
+			t.Error("Expected submit without text to fail")
+		}
+		if err := bt.Submit(translation.BulkItem{ID: "job-4", Text: "hello"}); err == nil {
+			t.Error("Expected submit without a target language to fail")
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package translation_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"app-backend/pkg/gemini"
+)
+
+func TestDualTranslationPrompt(t *testing.T) {
+	texts := []string{"the early bird", "catches the worm"}
+
+	prompt := gemini.BuildDualTranslationPrompt(texts, "es", "en")
+
+	if !strings.Contains(prompt, "literal") || !strings.Contains(prompt, "natural") {
+		t.Error("Expected the prompt to ask for literal and natural renderings")
+	}
+	if !strings.Contains(prompt, "0: the early bird") || !strings.Contains(prompt, "1: catches the worm") {
+		t.Error("Expected the prompt to number each segment")
+	}
+}
+
+func TestParseDualTranslations(t *testing.T) {
+	t.Run("parses literal and natural pairs from a structured response", func(t *testing.T) {
+		response := "0|literal: el pájaro temprano\n" +
+			"0|natural: a quien madruga\n" +
+			"1|literal: atrapa el gusano\n" +
+			"1|natural: dios le ayuda\n"
+
+		pairs := gemini.ParseDualTranslations(response, 2)
+
+		if len(pairs) != 2 {
+			t.Fatalf("Expected 2 pairs, got %d", len(pairs))
+		}
+		if pairs[0].Literal != "el pájaro temprano" || pairs[0].Natural != "a quien madruga" {
+			t.Errorf("Unexpected first pair: %+v", pairs[0])
+		}
+		if pairs[1].Literal != "atrapa el gusano" || pairs[1].Natural != "dios le ayuda" {
+			t.Errorf("Unexpected second pair: %+v", pairs[1])
+		}
+	})
+
+	t.Run("unanswered segments are left empty", func(t *testing.T) {
+		pairs := gemini.ParseDualTranslations("0|literal: algo\n", 2)
+
+		if pairs[0].Literal != "algo" || pairs[0].Natural != "" {
+			t.Errorf("Unexpected first pair: %+v", pairs[0])
+		}
+		if pairs[1].Literal != "" || pairs[1].Natural != "" {
+			t.Errorf("Expected empty second pair, got %+v", pairs[1])
+		}
+	})
+}
+
+func TestTranslateTextsDual(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("both renderings are populated per segment", func(t *testing.T) {
+		service := newMemoryTestService(t)
+
+		pairs, err := service.TranslateTextsDual(ctx, []string{"hello", "world"}, "es", "en")
+		if err != nil {
+			t.Fatalf("TranslateTextsDual failed: %v", err)
+		}
+		if len(pairs) != 2 {
+			t.Fatalf("Expected 2 pairs, got %d", len(pairs))
+		}
+		for i, pair := range pairs {
+			if pair.Literal == "" || pair.Natural == "" {
+				t.Errorf("Pair %d: expected both renderings populated, got %+v", i, pair)
+			}
+			if pair.Literal == pair.Natural {
+				t.Errorf("Pair %d: expected distinct renderings, got %+v", i, pair)
+			}
+		}
+	})
+
+	t.Run("both renderings are cached in the translation memory", func(t *testing.T) {
+		service := newMemoryTestService(t)
+
+		first, err := service.TranslateTextsDual(ctx, []string{"hello"}, "es", "en")
+		if err != nil {
+			t.Fatalf("TranslateTextsDual failed: %v", err)
+		}
+
+		// A repeat request must be served with identical pairs from memory
+		second, err := service.TranslateTextsDual(ctx, []string{"hello"}, "es", "en")
+		if err != nil {
+			t.Fatalf("TranslateTextsDual failed: %v", err)
+		}
+		if first[0] != second[0] {
+			t.Errorf("Expected cached pair %+v, got %+v", first[0], second[0])
+		}
+
+		literals, naturals := 0, 0
+		for _, entry := range service.ExportMemory() {
+			if strings.HasSuffix(entry.TargetLang, "|literal") {
+				literals++
+			}
+			if strings.HasSuffix(entry.TargetLang, "|natural") {
+				naturals++
+			}
+		}
+		if literals != 1 || naturals != 1 {
+			t.Errorf("Expected one literal and one natural memory entry, got %d and %d", literals, naturals)
+		}
+	})
+}
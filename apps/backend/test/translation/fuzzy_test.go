@@ -0,0 +1,148 @@
+package translation_test
+
+import (
+	"context"
+	"testing"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/services/translation"
+)
+
+// newFuzzyTestService builds a translation service with the given fuzzy
+// matching threshold and a pre-seeded translation memory
+func newFuzzyTestService(t *testing.T, threshold float64, seed []translation.TranslationMemoryEntry) *translation.Service {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	service, err := translation.NewService(&translation.Config{
+		GeminiAPIKey:        "test-key",
+		Logger:              log,
+		FuzzyMatchThreshold: threshold,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create translation service: %v", err)
+	}
+
+	if len(seed) > 0 {
+		if _, _, err := service.ImportMemory(seed); err != nil {
+			t.Fatalf("Failed to seed translation memory: %v", err)
+		}
+	}
+	return service
+}
+
+func TestFuzzyMemoryMatching(t *testing.T) {
+	ctx := context.Background()
+	seed := []translation.TranslationMemoryEntry{
+		{SourceLang: "es", TargetLang: "en", SourceText: "buenos dias a todos", TargetText: "good morning everyone"},
+		{SourceLang: "es", TargetLang: "en", SourceText: "hasta luego", TargetText: "see you later"},
+	}
+
+	t.Run("near-identical segments are served from memory", func(t *testing.T) {
+		service := newFuzzyTestService(t, 0.8, seed)
+
+		// One substituted character in a 19-character segment is well above
+		// the 0.8 threshold
+		translations, matches, err := service.TranslateTextsWithMatches(ctx, []string{"buenos dias a todas"}, "en", "es")
+		if err != nil {
+			t.Fatalf("TranslateTextsWithMatches failed: %v", err)
+		}
+		if translations[0] != "good morning everyone" {
+			t.Errorf("Expected the fuzzy memory match, got %q", translations[0])
+		}
+		if len(matches) != 1 {
+			t.Fatalf("Expected 1 reported match, got %d", len(matches))
+		}
+		if matches[0].Index != 0 || matches[0].SourceText != "buenos dias a todos" {
+			t.Errorf("Unexpected match %+v", matches[0])
+		}
+		if matches[0].Similarity >= 1 || matches[0].Similarity < 0.8 {
+			t.Errorf("Expected a similarity in [0.8, 1), got %v", matches[0].Similarity)
+		}
+	})
+
+	t.Run("exact hits are reported with similarity 1", func(t *testing.T) {
+		service := newFuzzyTestService(t, 0.8, seed)
+
+		_, matches, err := service.TranslateTextsWithMatches(ctx, []string{"hasta luego"}, "en", "es")
+		if err != nil {
+			t.Fatalf("TranslateTextsWithMatches failed: %v", err)
+		}
+		if len(matches) != 1 || matches[0].Similarity != 1 {
+			t.Fatalf("Expected one exact match with similarity 1, got %+v", matches)
+		}
+	})
+
+	t.Run("matching is insensitive to casing and spacing", func(t *testing.T) {
+		service := newFuzzyTestService(t, 0.8, seed)
+
+		translations, matches, err := service.TranslateTextsWithMatches(ctx, []string{"  Hasta   LUEGO "}, "en", "es")
+		if err != nil {
+			t.Fatalf("TranslateTextsWithMatches failed: %v", err)
+		}
+		if translations[0] != "see you later" {
+			t.Errorf("Expected the memory match despite casing, got %q", translations[0])
+		}
+		if len(matches) != 1 || matches[0].Similarity != 1 {
+			t.Fatalf("Expected a full-similarity match, got %+v", matches)
+		}
+	})
+
+	t.Run("dissimilar segments fall through to the provider", func(t *testing.T) {
+		service := newFuzzyTestService(t, 0.8, seed)
+
+		_, matches, err := service.TranslateTextsWithMatches(ctx, []string{"donde esta la biblioteca"}, "en", "es")
+		if err != nil {
+			t.Fatalf("TranslateTextsWithMatches failed: %v", err)
+		}
+		if len(matches) != 0 {
+			t.Errorf("Expected no memory matches, got %+v", matches)
+		}
+	})
+
+	t.Run("negative threshold disables fuzzy matching", func(t *testing.T) {
+		service := newFuzzyTestService(t, -1, seed)
+
+		_, matches, err := service.TranslateTextsWithMatches(ctx, []string{"buenos dias a todas"}, "en", "es")
+		if err != nil {
+			t.Fatalf("TranslateTextsWithMatches failed: %v", err)
+		}
+		if len(matches) != 0 {
+			t.Errorf("Expected no matches with fuzzy matching disabled, got %+v", matches)
+		}
+	})
+
+	t.Run("matches only come from the requested language pair", func(t *testing.T) {
+		service := newFuzzyTestService(t, 0.8, seed)
+
+		_, matches, err := service.TranslateTextsWithMatches(ctx, []string{"buenos dias a todas"}, "fr", "es")
+		if err != nil {
+			t.Fatalf("TranslateTextsWithMatches failed: %v", err)
+		}
+		if len(matches) != 0 {
+			t.Errorf("Expected no matches for a different language pair, got %+v", matches)
+		}
+	})
+
+	t.Run("the most similar stored segment wins", func(t *testing.T) {
+		service := newFuzzyTestService(t, 0.5, []translation.TranslationMemoryEntry{
+			{SourceLang: "es", TargetLang: "en", SourceText: "como estas hoy", TargetText: "how are you today"},
+			{SourceLang: "es", TargetLang: "en", SourceText: "como estas", TargetText: "how are you"},
+		})
+
+		translations, matches, err := service.TranslateTextsWithMatches(ctx, []string{"como esta"}, "en", "es")
+		if err != nil {
+			t.Fatalf("TranslateTextsWithMatches failed: %v", err)
+		}
+		if translations[0] != "how are you" {
+			t.Errorf("Expected the closer segment's translation, got %q", translations[0])
+		}
+		if len(matches) != 1 || matches[0].SourceText != "como estas" {
+			t.Fatalf("Expected the closer segment to win, got %+v", matches)
+		}
+	})
+}
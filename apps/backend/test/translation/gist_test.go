@@ -0,0 +1,89 @@
+package translation_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"app-backend/pkg/gemini"
+)
+
+func TestGistPrompts(t *testing.T) {
+	t.Run("gist prompt covers the whole chunk", func(t *testing.T) {
+		texts := []string{"First we mix the batter.", "Then it rests overnight.", "Finally we bake it."}
+
+		prompt := gemini.BuildGistPrompt(texts, "es", "en")
+
+		for _, text := range texts {
+			if !strings.Contains(prompt, text) {
+				t.Errorf("Expected gist prompt to contain %q", text)
+			}
+		}
+		if !strings.Contains(prompt, "en") || !strings.Contains(prompt, "es") {
+			t.Error("Expected gist prompt to name the source and target languages")
+		}
+		if !strings.Contains(prompt, "Summarize") {
+			t.Errorf("Expected gist prompt to ask for a summary, got %q", prompt)
+		}
+	})
+
+	t.Run("gist prompt omits unknown source language", func(t *testing.T) {
+		prompt := gemini.BuildGistPrompt([]string{"hello"}, "es", "")
+
+		if strings.Contains(prompt, "is a  video") {
+			t.Errorf("Expected prompt without a source language placeholder, got %q", prompt)
+		}
+	})
+
+	t.Run("segment context embeds the gist", func(t *testing.T) {
+		gistContext := gemini.BuildGistSegmentContext("A cooking tutorial about sourdough.")
+
+		if !strings.Contains(gistContext, "A cooking tutorial about sourdough.") {
+			t.Errorf("Expected segment context to embed the gist, got %q", gistContext)
+		}
+	})
+}
+
+func TestTranslateTextsWithGist(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("all segments are translated", func(t *testing.T) {
+		service := newMemoryTestService(t)
+		texts := []string{"one", "two", "three"}
+
+		translations, err := service.TranslateTextsWithGist(ctx, texts, "es", "en")
+		if err != nil {
+			t.Fatalf("TranslateTextsWithGist failed: %v", err)
+		}
+		if len(translations) != len(texts) {
+			t.Fatalf("Expected %d translations, got %d", len(texts), len(translations))
+		}
+		for i, translation := range translations {
+			if translation == "" {
+				t.Errorf("Expected segment %d to be translated", i)
+			}
+			if !strings.Contains(translation, texts[i]) {
+				t.Errorf("Expected translation %q to carry the source text %q", translation, texts[i])
+			}
+		}
+	})
+
+	t.Run("request size caps still apply", func(t *testing.T) {
+		service := newLimitsTestService(t, 2, 1000)
+
+		_, err := service.TranslateTextsWithGist(ctx, []string{"a", "b", "c"}, "es", "en")
+		assertTooLarge(t, err)
+	})
+
+	t.Run("empty input returns no translations", func(t *testing.T) {
+		service := newMemoryTestService(t)
+
+		translations, err := service.TranslateTextsWithGist(ctx, nil, "es", "en")
+		if err != nil {
+			t.Fatalf("TranslateTextsWithGist failed: %v", err)
+		}
+		if len(translations) != 0 {
+			t.Errorf("Expected no translations, got %d", len(translations))
+		}
+	})
+}
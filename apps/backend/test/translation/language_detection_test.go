@@ -0,0 +1,55 @@
+package translation_test
+
+import (
+	"testing"
+
+	"app-backend/internal/services/translation"
+)
+
+func TestConsensusLanguage(t *testing.T) {
+	t.Run("consistent samples are accepted", func(t *testing.T) {
+		samples := []string{"en", "en", "en"}
+
+		if got := translation.ConsensusLanguage(samples, 0.6); got != "en" {
+			t.Errorf("Expected en, got %s", got)
+		}
+	})
+
+	t.Run("majority above threshold is accepted", func(t *testing.T) {
+		samples := []string{"en", "en", "es"}
+
+		if got := translation.ConsensusLanguage(samples, 0.6); got != "en" {
+			t.Errorf("Expected en, got %s", got)
+		}
+	})
+
+	t.Run("conflicting samples return undetermined", func(t *testing.T) {
+		samples := []string{"en", "es", "fr"}
+
+		if got := translation.ConsensusLanguage(samples, 0.6); got != translation.LanguageUndetermined {
+			t.Errorf("Expected %s, got %s", translation.LanguageUndetermined, got)
+		}
+	})
+
+	t.Run("split samples below threshold return undetermined", func(t *testing.T) {
+		samples := []string{"en", "en", "es", "es"}
+
+		if got := translation.ConsensusLanguage(samples, 0.6); got != translation.LanguageUndetermined {
+			t.Errorf("Expected %s, got %s", translation.LanguageUndetermined, got)
+		}
+	})
+
+	t.Run("empty samples return undetermined", func(t *testing.T) {
+		if got := translation.ConsensusLanguage(nil, 0.6); got != translation.LanguageUndetermined {
+			t.Errorf("Expected %s, got %s", translation.LanguageUndetermined, got)
+		}
+	})
+
+	t.Run("lower threshold accepts a plurality", func(t *testing.T) {
+		samples := []string{"en", "en", "es", "fr"}
+
+		if got := translation.ConsensusLanguage(samples, 0.5); got != "en" {
+			t.Errorf("Expected en, got %s", got)
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package translation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"app-backend/internal/services/translation"
+)
+
+func TestLatencyRecorder(t *testing.T) {
+	t.Run("batch latency is spread across segments", func(t *testing.T) {
+		recorder := translation.NewLatencyRecorder()
+
+		recorder.RecordModelBatch(900*time.Millisecond, 9)
+
+		stats := recorder.Snapshot().ModelCalls
+		if stats.Count != 9 {
+			t.Fatalf("Expected 9 samples, got %d", stats.Count)
+		}
+		if stats.Avg != 100*time.Millisecond {
+			t.Errorf("Expected 100ms per segment, got %v", stats.Avg)
+		}
+		if stats.P50 != 100*time.Millisecond || stats.P99 != 100*time.Millisecond {
+			t.Errorf("Expected uniform percentiles of 100ms, got p50=%v p99=%v", stats.P50, stats.P99)
+		}
+	})
+
+	t.Run("percentiles reflect mixed batch timings", func(t *testing.T) {
+		recorder := translation.NewLatencyRecorder()
+
+		// 90 fast segments and 10 slow ones
+		recorder.RecordModelBatch(900*time.Millisecond, 90)  // 10ms each
+		recorder.RecordModelBatch(5000*time.Millisecond, 10) // 500ms each
+
+		stats := recorder.Snapshot().ModelCalls
+		if stats.Count != 100 {
+			t.Fatalf("Expected 100 samples, got %d", stats.Count)
+		}
+		if stats.P50 != 10*time.Millisecond {
+			t.Errorf("Expected p50 of 10ms, got %v", stats.P50)
+		}
+		if stats.P99 != 500*time.Millisecond {
+			t.Errorf("Expected p99 of 500ms, got %v", stats.P99)
+		}
+		if stats.P90 > stats.P99 {
+			t.Errorf("Expected p90 <= p99, got p90=%v p99=%v", stats.P90, stats.P99)
+		}
+	})
+
+	t.Run("cache hits and model calls are tracked separately", func(t *testing.T) {
+		recorder := translation.NewLatencyRecorder()
+
+		recorder.RecordCacheHits(1*time.Millisecond, 10)
+		recorder.RecordModelBatch(2000*time.Millisecond, 10)
+
+		snapshot := recorder.Snapshot()
+		if snapshot.CacheHits.Count != 10 || snapshot.ModelCalls.Count != 10 {
+			t.Fatalf("Expected 10 samples per source, got cache=%d model=%d",
+				snapshot.CacheHits.Count, snapshot.ModelCalls.Count)
+		}
+		if snapshot.CacheHits.P50 >= snapshot.ModelCalls.P50 {
+			t.Errorf("Expected cache hit latency below model call latency, got cache=%v model=%v",
+				snapshot.CacheHits.P50, snapshot.ModelCalls.P50)
+		}
+	})
+
+	t.Run("empty recorder reports zero stats", func(t *testing.T) {
+		recorder := translation.NewLatencyRecorder()
+
+		stats := recorder.Snapshot().ModelCalls
+		if stats.Count != 0 || stats.Avg != 0 || stats.P99 != 0 {
+			t.Errorf("Expected zero stats for empty recorder, got %+v", stats)
+		}
+	})
+
+	t.Run("zero segments are ignored", func(t *testing.T) {
+		recorder := translation.NewLatencyRecorder()
+
+		recorder.RecordModelBatch(time.Second, 0)
+
+		if count := recorder.Snapshot().ModelCalls.Count; count != 0 {
+			t.Errorf("Expected no samples for an empty batch, got %d", count)
+		}
+	})
+}
+
+func TestServiceLatencyMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("translations populate model call metrics", func(t *testing.T) {
+		service := newMemoryTestService(t)
+
+		if _, err := service.TranslateTexts(ctx, []string{"one", "two"}, "es", "en"); err != nil {
+			t.Fatalf("TranslateTexts failed: %v", err)
+		}
+
+		snapshot := service.LatencyMetrics()
+		if snapshot.ModelCalls.Count != 2 {
+			t.Errorf("Expected 2 model call samples, got %d", snapshot.ModelCalls.Count)
+		}
+
+		// A repeat request is served from memory and lands in cache hit metrics
+		if _, err := service.TranslateTexts(ctx, []string{"one", "two"}, "es", "en"); err != nil {
+			t.Fatalf("TranslateTexts failed: %v", err)
+		}
+
+		snapshot = service.LatencyMetrics()
+		if snapshot.CacheHits.Count != 2 {
+			t.Errorf("Expected 2 cache hit samples, got %d", snapshot.CacheHits.Count)
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package translation_test
+
+import (
+	"context"
+	goerrors "errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	appErrors "app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/services/translation"
+)
+
+func newLimitsTestService(t *testing.T, maxSegments, maxChars int) *translation.Service {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	service, err := translation.NewService(&translation.Config{
+		GeminiAPIKey:          "test-key",
+		Logger:                log,
+		MaxSegmentsPerRequest: maxSegments,
+		MaxCharsPerRequest:    maxChars,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create translation service: %v", err)
+	}
+	return service
+}
+
+func assertTooLarge(t *testing.T, err error) *appErrors.AppError {
+	t.Helper()
+
+	if err == nil {
+		t.Fatal("Expected a request size error, got nil")
+	}
+	var appErr *appErrors.AppError
+	if !goerrors.As(err, &appErr) {
+		t.Fatalf("Expected *errors.AppError, got %T: %v", err, err)
+	}
+	if appErr.Status != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected status %d, got %d", http.StatusRequestEntityTooLarge, appErr.Status)
+	}
+	return appErr
+}
+
+func TestTranslationRequestLimits(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requests over the segment cap are rejected", func(t *testing.T) {
+		service := newLimitsTestService(t, 3, 1000)
+
+		_, err := service.TranslateTexts(ctx, []string{"a", "b", "c", "d"}, "en", "es")
+		appErr := assertTooLarge(t, err)
+		if !strings.Contains(appErr.Message, "segments") {
+			t.Errorf("Expected message to mention segments, got %q", appErr.Message)
+		}
+		if !strings.Contains(appErr.Message, "smaller chunks") {
+			t.Errorf("Expected message to suggest chunked submission, got %q", appErr.Message)
+		}
+	})
+
+	t.Run("requests over the character cap are rejected", func(t *testing.T) {
+		service := newLimitsTestService(t, 100, 20)
+
+		_, err := service.TranslateTexts(ctx, []string{strings.Repeat("x", 21)}, "en", "es")
+		appErr := assertTooLarge(t, err)
+		if !strings.Contains(appErr.Message, "characters") {
+			t.Errorf("Expected message to mention characters, got %q", appErr.Message)
+		}
+	})
+
+	t.Run("requests exactly at the limits succeed", func(t *testing.T) {
+		service := newLimitsTestService(t, 2, 10)
+
+		translations, err := service.TranslateTexts(ctx, []string{"hello", "world"}, "en", "es")
+		if err != nil {
+			t.Fatalf("TranslateTexts failed at the limit: %v", err)
+		}
+		if len(translations) != 2 {
+			t.Fatalf("Expected 2 translations, got %d", len(translations))
+		}
+	})
+
+	t.Run("zero config falls back to defaults", func(t *testing.T) {
+		service := newLimitsTestService(t, 0, 0)
+
+		texts := make([]string, 10)
+		for i := range texts {
+			texts[i] = "hello"
+		}
+		if _, err := service.TranslateTexts(ctx, texts, "en", "es"); err != nil {
+			t.Fatalf("TranslateTexts failed with default limits: %v", err)
+		}
+	})
+}
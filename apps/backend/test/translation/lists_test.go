@@ -0,0 +1,123 @@
+package translation_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/services/translation"
+	"app-backend/pkg/gemini"
+)
+
+func newListTestService(t *testing.T) *translation.Service {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	service, err := translation.NewService(&translation.Config{
+		GeminiAPIKey: "test-key",
+		Logger:       log,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create translation service: %v", err)
+	}
+	return service
+}
+
+func TestSplitListMarker(t *testing.T) {
+	cases := []struct {
+		name   string
+		text   string
+		marker string
+		body   string
+		ok     bool
+	}{
+		{"numbered with dot", "1. Mix the flour", "1.", "Mix the flour", true},
+		{"numbered with parenthesis", "2) Knead the dough", "2)", "Knead the dough", true},
+		{"parenthesized number", "(3) Let it rest", "(3)", "Let it rest", true},
+		{"dash bullet", "- add a pinch of salt", "-", "add a pinch of salt", true},
+		{"unicode bullet", "• preheat the oven", "•", "preheat the oven", true},
+		{"indented item keeps its indentation", "  1. Step one", "  1.", "Step one", true},
+		{"plain sentence", "Welcome back everyone", "", "", false},
+		{"year is not a list marker", "1990. was a great year", "", "", false},
+		{"number without a space", "1.5 cups of water", "", "", false},
+		{"marker without a body", "1.", "", "", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			marker, body, ok := translation.SplitListMarker(tc.text)
+			if ok != tc.ok {
+				t.Fatalf("SplitListMarker(%q) ok = %v, want %v", tc.text, ok, tc.ok)
+			}
+			if marker != tc.marker || body != tc.body {
+				t.Errorf("SplitListMarker(%q) = (%q, %q), want (%q, %q)", tc.text, marker, body, tc.marker, tc.body)
+			}
+		})
+	}
+}
+
+func TestTranslateTextsPreservesListStructure(t *testing.T) {
+	t.Run("numbering survives translation", func(t *testing.T) {
+		service := newListTestService(t)
+
+		texts := []string{
+			"Today we bake bread",
+			"1. Mix the flour",
+			"2. Knead the dough",
+			"3. Let it rest",
+		}
+		translations, err := service.TranslateTexts(context.Background(), texts, "es", "en")
+		if err != nil {
+			t.Fatalf("TranslateTexts failed: %v", err)
+		}
+		if len(translations) != len(texts) {
+			t.Fatalf("Expected %d translations, got %d", len(texts), len(translations))
+		}
+
+		for i, want := range []string{"", "1.", "2.", "3."} {
+			if want == "" {
+				if strings.HasPrefix(translations[i], "1.") {
+					t.Errorf("Expected no marker on the intro segment, got %q", translations[i])
+				}
+				continue
+			}
+			if !strings.HasPrefix(translations[i], want+" ") {
+				t.Errorf("Expected translation %d to keep marker %q, got %q", i, want, translations[i])
+			}
+		}
+	})
+
+	t.Run("bullets survive translation", func(t *testing.T) {
+		service := newListTestService(t)
+
+		translations, err := service.TranslateTexts(context.Background(), []string{"- add a pinch of salt"}, "es", "en")
+		if err != nil {
+			t.Fatalf("TranslateTexts failed: %v", err)
+		}
+		if !strings.HasPrefix(translations[0], "- ") {
+			t.Errorf("Expected the bullet to be preserved, got %q", translations[0])
+		}
+		if !strings.Contains(translations[0], "add a pinch of salt") {
+			t.Errorf("Expected the item body to be translated, got %q", translations[0])
+		}
+	})
+}
+
+func TestBuildListTranslationPrompt(t *testing.T) {
+	prompt := gemini.BuildListTranslationPrompt([]string{"Mix the flour"}, "es", "en")
+
+	if !strings.Contains(prompt, "es") || !strings.Contains(prompt, "en") {
+		t.Error("Expected list prompt to name the source and target languages")
+	}
+	if !strings.Contains(prompt, "do not renumber") {
+		t.Errorf("Expected an explicit instruction against renumbering, got %q", prompt)
+	}
+	if !strings.Contains(prompt, "Mix the flour") {
+		t.Error("Expected list prompt to contain the segment text")
+	}
+}
@@ -0,0 +1,119 @@
+package translation_test
+
+import (
+	"context"
+	"testing"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/services/translation"
+)
+
+func newMemoryTestService(t *testing.T) *translation.Service {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	service, err := translation.NewService(&translation.Config{
+		GeminiAPIKey: "test-key",
+		Logger:       log,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create translation service: %v", err)
+	}
+	return service
+}
+
+func TestTranslationMemory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("imported entries are served from memory", func(t *testing.T) {
+		service := newMemoryTestService(t)
+
+		imported, skipped, err := service.ImportMemory([]translation.TranslationMemoryEntry{
+			{SourceLang: "es", TargetLang: "en", SourceText: "hola", TargetText: "hello"},
+		})
+		if err != nil {
+			t.Fatalf("Import failed: %v", err)
+		}
+		if imported != 1 || skipped != 0 {
+			t.Fatalf("Expected 1 imported and 0 skipped, got %d and %d", imported, skipped)
+		}
+
+		translations, err := service.TranslateTexts(ctx, []string{"hola"}, "en", "es")
+		if err != nil {
+			t.Fatalf("TranslateTexts failed: %v", err)
+		}
+		if translations[0] != "hello" {
+			t.Errorf("Expected cached translation %q, got %q", "hello", translations[0])
+		}
+	})
+
+	t.Run("export and re-import round-trips", func(t *testing.T) {
+		service := newMemoryTestService(t)
+
+		originals, err := service.TranslateTexts(ctx, []string{"hola", "adios"}, "en", "es")
+		if err != nil {
+			t.Fatalf("TranslateTexts failed: %v", err)
+		}
+
+		exported := service.ExportMemory()
+		if len(exported) != 2 {
+			t.Fatalf("Expected 2 exported entries, got %d", len(exported))
+		}
+
+		// Re-import into a fresh service and verify translations are served
+		// from the imported memory
+		fresh := newMemoryTestService(t)
+		imported, skipped, err := fresh.ImportMemory(exported)
+		if err != nil {
+			t.Fatalf("Import failed: %v", err)
+		}
+		if imported != 2 || skipped != 0 {
+			t.Fatalf("Expected 2 imported and 0 skipped, got %d and %d", imported, skipped)
+		}
+
+		translations, err := fresh.TranslateTexts(ctx, []string{"hola", "adios"}, "en", "es")
+		if err != nil {
+			t.Fatalf("TranslateTexts failed: %v", err)
+		}
+		for i, translated := range translations {
+			if translated != originals[i] {
+				t.Errorf("Expected round-tripped translation %q, got %q", originals[i], translated)
+			}
+		}
+	})
+
+	t.Run("re-import dedupes against existing entries", func(t *testing.T) {
+		service := newMemoryTestService(t)
+
+		entries := []translation.TranslationMemoryEntry{
+			{SourceLang: "es", TargetLang: "en", SourceText: "hola", TargetText: "hello"},
+			{SourceLang: "es", TargetLang: "en", SourceText: "adios", TargetText: "goodbye"},
+		}
+		if _, _, err := service.ImportMemory(entries); err != nil {
+			t.Fatalf("Import failed: %v", err)
+		}
+
+		imported, skipped, err := service.ImportMemory(entries)
+		if err != nil {
+			t.Fatalf("Re-import failed: %v", err)
+		}
+		if imported != 0 || skipped != 2 {
+			t.Errorf("Expected 0 imported and 2 skipped, got %d and %d", imported, skipped)
+		}
+	})
+
+	t.Run("import rejects invalid entries", func(t *testing.T) {
+		service := newMemoryTestService(t)
+
+		_, _, err := service.ImportMemory([]translation.TranslationMemoryEntry{
+			{SourceLang: "es", TargetLang: "en", SourceText: "hola", TargetText: ""},
+		})
+		if err == nil {
+			t.Fatal("Expected error for entry with empty targetText")
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package translation_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"app-backend/internal/types"
+	"app-backend/pkg/gemini"
+)
+
+func makeSegments(count int) []types.TranscriptSegment {
+	segments := make([]types.TranscriptSegment, count)
+	for i := range segments {
+		segments[i] = types.TranscriptSegment{Index: i, Text: fmt.Sprintf("segment %d", i)}
+	}
+	return segments
+}
+
+func TestChunkSegmentsWithOverlap(t *testing.T) {
+	t.Run("trailing segments carry into the next chunk as context", func(t *testing.T) {
+		chunks := gemini.ChunkSegmentsWithOverlap(makeSegments(7), 3, 2)
+
+		if len(chunks) != 3 {
+			t.Fatalf("Expected 3 chunks, got %d", len(chunks))
+		}
+		if len(chunks[0].Context) != 0 {
+			t.Errorf("Expected no context for the first chunk, got %d segments", len(chunks[0].Context))
+		}
+		if len(chunks[1].Context) != 2 ||
+			chunks[1].Context[0].Text != "segment 1" || chunks[1].Context[1].Text != "segment 2" {
+			t.Errorf("Expected chunk 1 context to be the trailing segments of chunk 0, got %v", chunks[1].Context)
+		}
+		if len(chunks[2].Context) != 2 ||
+			chunks[2].Context[0].Text != "segment 4" || chunks[2].Context[1].Text != "segment 5" {
+			t.Errorf("Expected chunk 2 context to be the trailing segments of chunk 1, got %v", chunks[2].Context)
+		}
+	})
+
+	t.Run("context segments are not duplicated in the chunk output", func(t *testing.T) {
+		segments := makeSegments(7)
+		chunks := gemini.ChunkSegmentsWithOverlap(segments, 3, 2)
+
+		seen := make(map[int]int)
+		total := 0
+		for _, chunk := range chunks {
+			for _, segment := range chunk.Segments {
+				seen[segment.Index]++
+				total++
+			}
+		}
+
+		if total != len(segments) {
+			t.Fatalf("Expected %d segments across chunks, got %d", len(segments), total)
+		}
+		for index, count := range seen {
+			if count != 1 {
+				t.Errorf("Expected segment %d to appear in exactly one chunk, got %d", index, count)
+			}
+		}
+	})
+
+	t.Run("zero overlap produces plain chunks", func(t *testing.T) {
+		chunks := gemini.ChunkSegmentsWithOverlap(makeSegments(6), 3, 0)
+
+		for i, chunk := range chunks {
+			if len(chunk.Context) != 0 {
+				t.Errorf("Chunk %d: expected no context with zero overlap, got %d segments", i, len(chunk.Context))
+			}
+		}
+	})
+
+	t.Run("overlap is clamped below the chunk size", func(t *testing.T) {
+		chunks := gemini.ChunkSegmentsWithOverlap(makeSegments(6), 3, 10)
+
+		if len(chunks) != 2 {
+			t.Fatalf("Expected 2 chunks, got %d", len(chunks))
+		}
+		if len(chunks[1].Context) != 2 {
+			t.Errorf("Expected overlap clamped to 2, got %d context segments", len(chunks[1].Context))
+		}
+	})
+}
+
+func TestBuildOverlapContext(t *testing.T) {
+	t.Run("names the previous segments as read-only context", func(t *testing.T) {
+		segments := []types.TranscriptSegment{
+			{Text: "she opened the door"},
+			{Text: "and stepped outside"},
+		}
+
+		context := gemini.BuildOverlapContext(segments)
+
+		if !strings.Contains(context, "she opened the door and stepped outside") {
+			t.Errorf("Expected overlap context to carry the previous texts, got %q", context)
+		}
+		if !strings.Contains(context, "do not translate or repeat") {
+			t.Errorf("Expected overlap context to be marked read-only, got %q", context)
+		}
+	})
+
+	t.Run("empty context produces no prompt text", func(t *testing.T) {
+		if context := gemini.BuildOverlapContext(nil); context != "" {
+			t.Errorf("Expected empty overlap context, got %q", context)
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package translation_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"app-backend/internal/ctxutil"
+)
+
+func TestProviderOverride(t *testing.T) {
+	t.Run("override picks the named provider over a higher-priority one", func(t *testing.T) {
+		service := newProviderTestService(t)
+		preferred := &fakeProvider{name: "preferred", priority: 0}
+		fallback := &fakeProvider{name: "fallback", priority: 5}
+		for _, provider := range []*fakeProvider{preferred, fallback} {
+			if err := service.RegisterProvider(provider); err != nil {
+				t.Fatalf("RegisterProvider failed: %v", err)
+			}
+		}
+
+		ctx := ctxutil.WithProviderOverride(context.Background(), "fallback")
+		translations, err := service.TranslateTexts(ctx, []string{"hello"}, "vi", "en")
+		if err != nil {
+			t.Fatalf("TranslateTexts failed: %v", err)
+		}
+		if translations[0] != "fallback:hello" {
+			t.Errorf("Expected the forced provider's result, got %q", translations[0])
+		}
+		if preferred.calls != 0 {
+			t.Errorf("Expected the preferred provider to be skipped, got %d calls", preferred.calls)
+		}
+	})
+
+	t.Run("forced provider failure does not fall back", func(t *testing.T) {
+		service := newProviderTestService(t)
+		broken := &fakeProvider{name: "broken", priority: 5, err: fmt.Errorf("upstream down")}
+		if err := service.RegisterProvider(broken); err != nil {
+			t.Fatalf("RegisterProvider failed: %v", err)
+		}
+
+		ctx := ctxutil.WithProviderOverride(context.Background(), "broken")
+		_, err := service.TranslateTexts(ctx, []string{"hello"}, "vi", "en")
+		if err == nil {
+			t.Fatal("Expected the forced provider's failure to surface")
+		}
+		if !strings.Contains(err.Error(), "broken") {
+			t.Errorf("Expected the error to name the forced provider, got %v", err)
+		}
+	})
+
+	t.Run("unregistered override is rejected", func(t *testing.T) {
+		service := newProviderTestService(t)
+
+		ctx := ctxutil.WithProviderOverride(context.Background(), "no-such-provider")
+		_, err := service.TranslateTexts(ctx, []string{"hello"}, "vi", "en")
+		if err == nil || !strings.Contains(err.Error(), "not registered") {
+			t.Fatalf("Expected an unregistered-provider error, got %v", err)
+		}
+	})
+
+	t.Run("usage record reports the serving provider", func(t *testing.T) {
+		service := newProviderTestService(t)
+		preferred := &fakeProvider{name: "preferred", priority: 0}
+		if err := service.RegisterProvider(preferred); err != nil {
+			t.Fatalf("RegisterProvider failed: %v", err)
+		}
+
+		ctx, usage := ctxutil.WithProviderUsage(context.Background())
+		if _, err := service.TranslateTexts(ctx, []string{"hello"}, "vi", "en"); err != nil {
+			t.Fatalf("TranslateTexts failed: %v", err)
+		}
+		if usage.Provider != "preferred" {
+			t.Errorf("Expected the usage record to name the provider, got %q", usage.Provider)
+		}
+		if usage.FromCache {
+			t.Error("A fresh translation should not be marked as a cache hit")
+		}
+	})
+
+	t.Run("usage record marks memory-served requests as cache hits", func(t *testing.T) {
+		service := newProviderTestService(t)
+		preferred := &fakeProvider{name: "preferred", priority: 0}
+		if err := service.RegisterProvider(preferred); err != nil {
+			t.Fatalf("RegisterProvider failed: %v", err)
+		}
+
+		if _, err := service.TranslateTexts(context.Background(), []string{"hello"}, "vi", "en"); err != nil {
+			t.Fatalf("TranslateTexts failed: %v", err)
+		}
+
+		ctx, usage := ctxutil.WithProviderUsage(context.Background())
+		if _, err := service.TranslateTexts(ctx, []string{"hello"}, "vi", "en"); err != nil {
+			t.Fatalf("TranslateTexts failed: %v", err)
+		}
+		if !usage.FromCache {
+			t.Error("A fully memory-served request should be marked as a cache hit")
+		}
+		if preferred.calls != 1 {
+			t.Errorf("Expected a single provider call across both requests, got %d", preferred.calls)
+		}
+	})
+}
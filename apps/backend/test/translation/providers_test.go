@@ -0,0 +1,279 @@
+package translation_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/services/translation"
+	"app-backend/internal/services/translation/providers/deepl"
+	"app-backend/internal/services/translation/providers/googletranslate"
+)
+
+// fakeProvider is a configurable registry entry for fallback tests
+type fakeProvider struct {
+	name        string
+	priority    int
+	unavailable bool
+	err         error
+	calls       int
+}
+
+func (f *fakeProvider) Translate(ctx context.Context, texts []string, targetLang string, sourceLang string) ([]string, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	translations := make([]string, len(texts))
+	for i, text := range texts {
+		translations[i] = fmt.Sprintf("%s:%s", f.name, text)
+	}
+	return translations, nil
+}
+
+func (f *fakeProvider) IsAvailable(ctx context.Context) bool {
+	return !f.unavailable
+}
+
+func (f *fakeProvider) GetName() string {
+	return f.name
+}
+
+func (f *fakeProvider) GetPriority() int {
+	return f.priority
+}
+
+func newProviderTestService(t *testing.T) *translation.Service {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	service, err := translation.NewService(&translation.Config{
+		GeminiAPIKey: "test-key",
+		Logger:       log,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create translation service: %v", err)
+	}
+	return service
+}
+
+func TestProviderRegistry(t *testing.T) {
+	t.Run("higher-priority provider serves the request", func(t *testing.T) {
+		service := newProviderTestService(t)
+		preferred := &fakeProvider{name: "preferred", priority: 0}
+		if err := service.RegisterProvider(preferred); err != nil {
+			t.Fatalf("RegisterProvider failed: %v", err)
+		}
+
+		translations, err := service.TranslateTexts(context.Background(), []string{"hello"}, "vi", "en")
+		if err != nil {
+			t.Fatalf("TranslateTexts failed: %v", err)
+		}
+		if translations[0] != "preferred:hello" {
+			t.Errorf("Expected the preferred provider's result, got %q", translations[0])
+		}
+	})
+
+	t.Run("falls back to the next provider on failure", func(t *testing.T) {
+		service := newProviderTestService(t)
+		failing := &fakeProvider{name: "failing", priority: 0, err: fmt.Errorf("upstream down")}
+		if err := service.RegisterProvider(failing); err != nil {
+			t.Fatalf("RegisterProvider failed: %v", err)
+		}
+
+		translations, err := service.TranslateTexts(context.Background(), []string{"hello"}, "vi", "en")
+		if err != nil {
+			t.Fatalf("Expected fallback to gemini, got error: %v", err)
+		}
+		if failing.calls != 1 {
+			t.Errorf("Expected the failing provider to be tried once, got %d calls", failing.calls)
+		}
+		if !strings.HasPrefix(translations[0], "[VI]") {
+			t.Errorf("Expected the gemini fallback result, got %q", translations[0])
+		}
+	})
+
+	t.Run("unavailable providers are skipped", func(t *testing.T) {
+		service := newProviderTestService(t)
+		offline := &fakeProvider{name: "offline", priority: 0, unavailable: true}
+		if err := service.RegisterProvider(offline); err != nil {
+			t.Fatalf("RegisterProvider failed: %v", err)
+		}
+
+		translations, err := service.TranslateTexts(context.Background(), []string{"hello"}, "vi", "en")
+		if err != nil {
+			t.Fatalf("TranslateTexts failed: %v", err)
+		}
+		if offline.calls != 0 {
+			t.Errorf("Expected the unavailable provider to be skipped, got %d calls", offline.calls)
+		}
+		if !strings.HasPrefix(translations[0], "[VI]") {
+			t.Errorf("Expected the gemini result, got %q", translations[0])
+		}
+	})
+
+	t.Run("GetAvailableProviders lists providers in priority order", func(t *testing.T) {
+		service := newProviderTestService(t)
+		if err := service.RegisterProvider(&fakeProvider{name: "secondary", priority: 5}); err != nil {
+			t.Fatalf("RegisterProvider failed: %v", err)
+		}
+		if err := service.RegisterProvider(&fakeProvider{name: "offline", priority: 2, unavailable: true}); err != nil {
+			t.Fatalf("RegisterProvider failed: %v", err)
+		}
+
+		providers := service.GetAvailableProviders(context.Background())
+		expected := []string{"gemini", "secondary"}
+		if !reflect.DeepEqual(providers, expected) {
+			t.Errorf("Expected providers %v, got %v", expected, providers)
+		}
+	})
+}
+
+func TestDeepLProvider(t *testing.T) {
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	t.Run("translates through the DeepL API", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v2/translate" {
+				t.Errorf("Unexpected path %q", r.URL.Path)
+			}
+			if auth := r.Header.Get("Authorization"); auth != "DeepL-Auth-Key test-key" {
+				t.Errorf("Unexpected Authorization header %q", auth)
+			}
+
+			var req struct {
+				Text       []string `json:"text"`
+				TargetLang string   `json:"target_lang"`
+				SourceLang string   `json:"source_lang"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("Failed to decode request: %v", err)
+			}
+			if req.TargetLang != "VI" || req.SourceLang != "EN" {
+				t.Errorf("Unexpected language pair: %s -> %s", req.SourceLang, req.TargetLang)
+			}
+
+			translations := make([]map[string]string, len(req.Text))
+			for i, text := range req.Text {
+				translations[i] = map[string]string{"text": "vi:" + text}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"translations": translations})
+		}))
+		defer server.Close()
+
+		provider, err := deepl.NewProvider(&deepl.Config{APIKey: "test-key", APIURL: server.URL}, log)
+		if err != nil {
+			t.Fatalf("NewProvider failed: %v", err)
+		}
+
+		translations, err := provider.Translate(context.Background(), []string{"hello", "world"}, "vi", "en")
+		if err != nil {
+			t.Fatalf("Translate failed: %v", err)
+		}
+		if len(translations) != 2 || translations[0] != "vi:hello" || translations[1] != "vi:world" {
+			t.Errorf("Unexpected translations: %v", translations)
+		}
+	})
+
+	t.Run("API errors are surfaced", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"invalid key"}`)
+		}))
+		defer server.Close()
+
+		provider, err := deepl.NewProvider(&deepl.Config{APIKey: "bad-key", APIURL: server.URL}, log)
+		if err != nil {
+			t.Fatalf("NewProvider failed: %v", err)
+		}
+
+		if _, err := provider.Translate(context.Background(), []string{"hello"}, "vi", "en"); err == nil {
+			t.Error("Expected an error for a non-200 response")
+		}
+	})
+
+	t.Run("requires an API key", func(t *testing.T) {
+		if _, err := deepl.NewProvider(&deepl.Config{}, log); err == nil {
+			t.Error("Expected an error without an API key")
+		}
+	})
+}
+
+func TestGoogleTranslateProvider(t *testing.T) {
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	t.Run("translates through the Translation API", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if key := r.URL.Query().Get("key"); key != "test-key" {
+				t.Errorf("Unexpected API key %q", key)
+			}
+
+			var req struct {
+				Q      []string `json:"q"`
+				Target string   `json:"target"`
+				Format string   `json:"format"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("Failed to decode request: %v", err)
+			}
+			if req.Target != "vi" || req.Format != "text" {
+				t.Errorf("Unexpected request: target=%q format=%q", req.Target, req.Format)
+			}
+
+			translations := make([]map[string]string, len(req.Q))
+			for i, text := range req.Q {
+				translations[i] = map[string]string{"translatedText": "vi:" + text}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{"translations": translations},
+			})
+		}))
+		defer server.Close()
+
+		provider, err := googletranslate.NewProvider(&googletranslate.Config{APIKey: "test-key", APIURL: server.URL}, log)
+		if err != nil {
+			t.Fatalf("NewProvider failed: %v", err)
+		}
+
+		translations, err := provider.Translate(context.Background(), []string{"hello"}, "vi", "en")
+		if err != nil {
+			t.Fatalf("Translate failed: %v", err)
+		}
+		if len(translations) != 1 || translations[0] != "vi:hello" {
+			t.Errorf("Unexpected translations: %v", translations)
+		}
+	})
+
+	t.Run("API errors are surfaced", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"error":{"message":"invalid key"}}`)
+		}))
+		defer server.Close()
+
+		provider, err := googletranslate.NewProvider(&googletranslate.Config{APIKey: "bad-key", APIURL: server.URL}, log)
+		if err != nil {
+			t.Fatalf("NewProvider failed: %v", err)
+		}
+
+		if _, err := provider.Translate(context.Background(), []string{"hello"}, "vi", "en"); err == nil {
+			t.Error("Expected an error for a non-200 response")
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package translation_test
+
+import (
+	"testing"
+
+	"app-backend/pkg/gemini"
+
+	"github.com/google/generative-ai-go/genai"
+)
+
+func responseWithParts(parts ...genai.Part) *genai.GenerateContentResponse {
+	return &genai.GenerateContentResponse{
+		Candidates: []*genai.Candidate{
+			{Content: &genai.Content{Parts: parts}},
+		},
+	}
+}
+
+func TestExtractText(t *testing.T) {
+	t.Run("single text part", func(t *testing.T) {
+		resp := responseWithParts(genai.Text("hola mundo"))
+
+		if got := gemini.ExtractText(resp); got != "hola mundo" {
+			t.Errorf("Expected %q, got %q", "hola mundo", got)
+		}
+	})
+
+	t.Run("multiple text parts are concatenated", func(t *testing.T) {
+		resp := responseWithParts(genai.Text("hola "), genai.Text("mundo"))
+
+		if got := gemini.ExtractText(resp); got != "hola mundo" {
+			t.Errorf("Expected %q, got %q", "hola mundo", got)
+		}
+	})
+
+	t.Run("non-text parts are ignored", func(t *testing.T) {
+		resp := responseWithParts(
+			genai.Text("hola"),
+			genai.Blob{MIMEType: "image/png", Data: []byte{1, 2, 3}},
+			genai.FunctionCall{Name: "lookup"},
+			genai.Text(" mundo"),
+		)
+
+		if got := gemini.ExtractText(resp); got != "hola mundo" {
+			t.Errorf("Expected non-text parts to be skipped, got %q", got)
+		}
+	})
+
+	t.Run("surrounding whitespace is trimmed", func(t *testing.T) {
+		resp := responseWithParts(genai.Text("  es\n"))
+
+		if got := gemini.ExtractText(resp); got != "es" {
+			t.Errorf("Expected trimmed text, got %q", got)
+		}
+	})
+
+	t.Run("empty and nil responses yield empty text", func(t *testing.T) {
+		if got := gemini.ExtractText(nil); got != "" {
+			t.Errorf("Expected empty text for nil response, got %q", got)
+		}
+		if got := gemini.ExtractText(&genai.GenerateContentResponse{}); got != "" {
+			t.Errorf("Expected empty text for empty response, got %q", got)
+		}
+		if got := gemini.ExtractText(responseWithParts(genai.Blob{MIMEType: "image/png"})); got != "" {
+			t.Errorf("Expected empty text for non-text-only response, got %q", got)
+		}
+	})
+}
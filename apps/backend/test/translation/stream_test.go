@@ -0,0 +1,135 @@
+package translation_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/services/translation"
+)
+
+func newStreamTestService(t *testing.T) *translation.Service {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	service, err := translation.NewService(&translation.Config{
+		GeminiAPIKey: "test-key",
+		Logger:       log,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create translation service: %v", err)
+	}
+	return service
+}
+
+func collectStream(t *testing.T, results <-chan translation.StreamResult) []translation.StreamResult {
+	t.Helper()
+
+	var collected []translation.StreamResult
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case result, ok := <-results:
+			if !ok {
+				return collected
+			}
+			collected = append(collected, result)
+		case <-timeout:
+			t.Fatalf("Timed out waiting for stream results, got %d so far", len(collected))
+		}
+	}
+}
+
+func TestTranslateTextsStream(t *testing.T) {
+	t.Run("delivers every segment", func(t *testing.T) {
+		service := newStreamTestService(t)
+
+		texts := make([]string, 25)
+		for i := range texts {
+			texts[i] = fmt.Sprintf("segment %d", i)
+		}
+
+		results, err := service.TranslateTextsStream(context.Background(), texts, "vi", "en")
+		if err != nil {
+			t.Fatalf("TranslateTextsStream failed: %v", err)
+		}
+
+		collected := collectStream(t, results)
+		if len(collected) != len(texts) {
+			t.Fatalf("Expected %d results, got %d", len(texts), len(collected))
+		}
+
+		seen := make(map[int]bool, len(collected))
+		for _, result := range collected {
+			if result.Err != nil {
+				t.Errorf("Segment %d failed: %v", result.Index, result.Err)
+			}
+			if seen[result.Index] {
+				t.Errorf("Segment %d delivered twice", result.Index)
+			}
+			seen[result.Index] = true
+			if !strings.Contains(result.Translation, texts[result.Index]) {
+				t.Errorf("Segment %d translation %q does not match source %q",
+					result.Index, result.Translation, texts[result.Index])
+			}
+		}
+		for i := range texts {
+			if !seen[i] {
+				t.Errorf("Segment %d was never delivered", i)
+			}
+		}
+	})
+
+	t.Run("segments arrive in transcript order", func(t *testing.T) {
+		service := newStreamTestService(t)
+
+		texts := make([]string, 30)
+		for i := range texts {
+			texts[i] = fmt.Sprintf("segment %d", i)
+		}
+
+		results, err := service.TranslateTextsStream(context.Background(), texts, "vi", "en")
+		if err != nil {
+			t.Fatalf("TranslateTextsStream failed: %v", err)
+		}
+
+		collected := collectStream(t, results)
+		for i := 1; i < len(collected); i++ {
+			if collected[i].Index <= collected[i-1].Index {
+				t.Fatalf("Results out of order at position %d: %d after %d",
+					i, collected[i].Index, collected[i-1].Index)
+			}
+		}
+	})
+
+	t.Run("cancellation closes the stream", func(t *testing.T) {
+		service := newStreamTestService(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		results, err := service.TranslateTextsStream(ctx, []string{"one", "two", "three"}, "vi", "en")
+		if err != nil {
+			t.Fatalf("TranslateTextsStream failed: %v", err)
+		}
+
+		cancel()
+
+		timeout := time.After(10 * time.Second)
+		for {
+			select {
+			case _, ok := <-results:
+				if !ok {
+					return
+				}
+			case <-timeout:
+				t.Fatal("Stream was not closed after cancellation")
+			}
+		}
+	})
+}
@@ -0,0 +1,175 @@
+package translation_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/services/translation"
+	"app-backend/pkg/gemini"
+)
+
+func newUntranslatedTestService(t *testing.T, retries int) *translation.Service {
+	t.Helper()
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	service, err := translation.NewService(&translation.Config{
+		GeminiAPIKey:        "test-key",
+		Logger:              log,
+		UntranslatedRetries: retries,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create translation service: %v", err)
+	}
+	return service
+}
+
+func TestLooksUntranslated(t *testing.T) {
+	cases := []struct {
+		name       string
+		source     string
+		translated string
+		want       bool
+	}{
+		{"identical output", "hello world", "hello world", true},
+		{"only case and punctuation differ", "hello, world!", "Hello world", true},
+		{"only whitespace differs", "hello world", "hello  world", true},
+		{"actually translated", "hello world", "hola mundo", false},
+		{"empty source", "", "", false},
+		{"punctuation-only source", "...", "...", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := translation.LooksUntranslated(tc.source, tc.translated); got != tc.want {
+				t.Errorf("LooksUntranslated(%q, %q) = %v, want %v", tc.source, tc.translated, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTranslateTextsChecked(t *testing.T) {
+	t.Run("unchanged output is retried once and flagged", func(t *testing.T) {
+		service := newUntranslatedTestService(t, 1)
+
+		calls := 0
+		service.SetModelTranslator(func(ctx context.Context, texts []string, targetLang, sourceLang string) ([]string, error) {
+			calls++
+			// Echo the input back unchanged, like a model that refuses the
+			// target language
+			return append([]string{}, texts...), nil
+		})
+
+		checked, err := service.TranslateTextsChecked(context.Background(), []string{"hello world"}, "gd", "en")
+		if err != nil {
+			t.Fatalf("TranslateTextsChecked failed: %v", err)
+		}
+
+		if calls != 2 {
+			t.Errorf("Expected the initial call plus one retry, got %d calls", calls)
+		}
+		if len(checked) != 1 {
+			t.Fatalf("Expected 1 result, got %d", len(checked))
+		}
+		if !checked[0].Untranslated {
+			t.Error("Expected the unchanged segment to be flagged Untranslated")
+		}
+		if checked[0].Text != "hello world" {
+			t.Errorf("Expected the unchanged text to be kept, got %q", checked[0].Text)
+		}
+	})
+
+	t.Run("retry only resubmits the unchanged segments", func(t *testing.T) {
+		service := newUntranslatedTestService(t, 1)
+
+		var retryBatch []string
+		calls := 0
+		service.SetModelTranslator(func(ctx context.Context, texts []string, targetLang, sourceLang string) ([]string, error) {
+			calls++
+			if calls == 1 {
+				// Translate the first segment, echo the second back
+				return []string{"hola mundo", texts[1]}, nil
+			}
+			retryBatch = append([]string{}, texts...)
+			return []string{"buenos dias"}, nil
+		})
+
+		checked, err := service.TranslateTextsChecked(context.Background(), []string{"hello world", "good morning"}, "es", "en")
+		if err != nil {
+			t.Fatalf("TranslateTextsChecked failed: %v", err)
+		}
+
+		if len(retryBatch) != 1 || retryBatch[0] != "good morning" {
+			t.Errorf("Expected only the unchanged segment to be retried, got %v", retryBatch)
+		}
+		if checked[0].Text != "hola mundo" || checked[0].Untranslated {
+			t.Errorf("Unexpected first result: %+v", checked[0])
+		}
+		if checked[1].Text != "buenos dias" || checked[1].Untranslated {
+			t.Errorf("Expected the retry to resolve the second segment, got %+v", checked[1])
+		}
+	})
+
+	t.Run("negative retry config disables the retry", func(t *testing.T) {
+		service := newUntranslatedTestService(t, -1)
+
+		calls := 0
+		service.SetModelTranslator(func(ctx context.Context, texts []string, targetLang, sourceLang string) ([]string, error) {
+			calls++
+			return append([]string{}, texts...), nil
+		})
+
+		checked, err := service.TranslateTextsChecked(context.Background(), []string{"hello"}, "gd", "en")
+		if err != nil {
+			t.Fatalf("TranslateTextsChecked failed: %v", err)
+		}
+
+		if calls != 1 {
+			t.Errorf("Expected a single call with retries disabled, got %d", calls)
+		}
+		if !checked[0].Untranslated {
+			t.Error("Expected the unchanged segment to be flagged Untranslated")
+		}
+	})
+
+	t.Run("matching source and target language is not flagged", func(t *testing.T) {
+		service := newUntranslatedTestService(t, 1)
+
+		calls := 0
+		service.SetModelTranslator(func(ctx context.Context, texts []string, targetLang, sourceLang string) ([]string, error) {
+			calls++
+			return append([]string{}, texts...), nil
+		})
+
+		checked, err := service.TranslateTextsChecked(context.Background(), []string{"hello"}, "en", "en-US")
+		if err != nil {
+			t.Fatalf("TranslateTextsChecked failed: %v", err)
+		}
+
+		if calls != 1 {
+			t.Errorf("Expected no retry for a same-language request, got %d calls", calls)
+		}
+		if checked[0].Untranslated {
+			t.Error("Expected no Untranslated flag for a same-language request")
+		}
+	})
+}
+
+func TestBuildRetryTranslationPrompt(t *testing.T) {
+	prompt := gemini.BuildRetryTranslationPrompt([]string{"hello world"}, "gd", "en")
+
+	if !strings.Contains(prompt, "gd") || !strings.Contains(prompt, "en") {
+		t.Error("Expected retry prompt to name the source and target languages")
+	}
+	if !strings.Contains(prompt, "Do not repeat the original wording") {
+		t.Errorf("Expected an explicit instruction against echoing the source, got %q", prompt)
+	}
+	if !strings.Contains(prompt, "hello world") {
+		t.Error("Expected retry prompt to contain the segment text")
+	}
+}
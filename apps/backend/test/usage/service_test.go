@@ -0,0 +1,132 @@
+package usage_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/usage"
+	"app-backend/test/testutil"
+
+	"gorm.io/gorm"
+)
+
+func newUsageService(t *testing.T, config usage.Config) (*usage.Service, *gorm.DB) {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t, &models.TranslationUsage{})
+	repo := repositories.NewTranslationUsageRepository(db)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	return usage.NewService(repo, config, log), db
+}
+
+// backdateUsage moves every usage record for the user to the given time
+func backdateUsage(t *testing.T, db *gorm.DB, userID uint, createdAt time.Time) {
+	t.Helper()
+
+	err := db.Model(&models.TranslationUsage{}).
+		Where("user_id = ?", userID).
+		UpdateColumn("created_at", createdAt).Error
+	if err != nil {
+		t.Fatalf("failed to backdate usage records: %v", err)
+	}
+}
+
+func TestUsageService(t *testing.T) {
+	t.Run("requests within the quota pass", func(t *testing.T) {
+		service, _ := newUsageService(t, usage.Config{DailyChars: 100})
+
+		service.RecordTranslation(1, 50, "vi", "gemini")
+
+		if err := service.CheckQuota(1, 40); err != nil {
+			t.Errorf("Expected request within quota to pass, got %v", err)
+		}
+	})
+
+	t.Run("daily quota rejects with 429", func(t *testing.T) {
+		service, _ := newUsageService(t, usage.Config{DailyChars: 100})
+
+		service.RecordTranslation(1, 80, "vi", "gemini")
+
+		err := service.CheckQuota(1, 30)
+		appErr, ok := err.(*errors.AppError)
+		if !ok {
+			t.Fatalf("Expected an AppError, got %v", err)
+		}
+		if appErr.Status != http.StatusTooManyRequests {
+			t.Errorf("Expected status 429, got %d", appErr.Status)
+		}
+	})
+
+	t.Run("monthly quota rejects with 429", func(t *testing.T) {
+		service, _ := newUsageService(t, usage.Config{MonthlyChars: 100})
+
+		service.RecordTranslation(1, 80, "vi", "gemini")
+
+		err := service.CheckQuota(1, 30)
+		appErr, ok := err.(*errors.AppError)
+		if !ok {
+			t.Fatalf("Expected an AppError, got %v", err)
+		}
+		if appErr.Status != http.StatusTooManyRequests {
+			t.Errorf("Expected status 429, got %d", appErr.Status)
+		}
+	})
+
+	t.Run("usage before the daily window does not count", func(t *testing.T) {
+		service, db := newUsageService(t, usage.Config{DailyChars: 100})
+
+		service.RecordTranslation(1, 90, "vi", "gemini")
+		backdateUsage(t, db, 1, time.Now().UTC().Add(-25*time.Hour))
+
+		if err := service.CheckQuota(1, 40); err != nil {
+			t.Errorf("Expected yesterday's usage to be ignored, got %v", err)
+		}
+	})
+
+	t.Run("quotas are tracked per user", func(t *testing.T) {
+		service, _ := newUsageService(t, usage.Config{DailyChars: 100})
+
+		service.RecordTranslation(1, 100, "vi", "gemini")
+
+		if err := service.CheckQuota(2, 50); err != nil {
+			t.Errorf("Expected another user's quota to be untouched, got %v", err)
+		}
+	})
+
+	t.Run("unconfigured quotas never reject", func(t *testing.T) {
+		service, _ := newUsageService(t, usage.Config{})
+
+		service.RecordTranslation(1, 1000000, "vi", "gemini")
+
+		if err := service.CheckQuota(1, 1000000); err != nil {
+			t.Errorf("Expected no rejection without limits, got %v", err)
+		}
+	})
+
+	t.Run("GetUsage reports used and remaining characters", func(t *testing.T) {
+		service, _ := newUsageService(t, usage.Config{DailyChars: 100})
+
+		service.RecordTranslation(1, 40, "vi", "gemini")
+
+		report, err := service.GetUsage(1)
+		if err != nil {
+			t.Fatalf("GetUsage failed: %v", err)
+		}
+		if report.Daily.CharsUsed != 40 || report.Daily.CharLimit != 100 || report.Daily.Remaining != 60 {
+			t.Errorf("Unexpected daily window: %+v", report.Daily)
+		}
+		if report.Monthly.CharLimit != 0 || report.Monthly.Remaining != -1 {
+			t.Errorf("Expected unlimited monthly window, got %+v", report.Monthly)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package uservideo_test
+
+import (
+	"net/http"
+	"testing"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/uservideo"
+	"app-backend/internal/types"
+	"app-backend/test/testutil"
+)
+
+func newUserVideoService(t *testing.T) uservideo.ServiceInterface {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t, &models.User{}, &models.UserVideo{})
+	repo := repositories.NewUserVideoRepository(db)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	return uservideo.NewService(repo, log)
+}
+
+func saveVideo(t *testing.T, service uservideo.ServiceInterface, userID uint, req *dto.SaveUserVideoRequest) *dto.UserVideoResponse {
+	t.Helper()
+
+	video, err := service.SaveVideo(userID, req)
+	if err != nil {
+		t.Fatalf("SaveVideo failed: %v", err)
+	}
+	return video
+}
+
+func TestUserVideoService(t *testing.T) {
+	t.Run("saves and restores a study session", func(t *testing.T) {
+		service := newUserVideoService(t)
+
+		saveVideo(t, service, 1, &dto.SaveUserVideoRequest{
+			VideoID:         "dQw4w9WgXcQ",
+			Title:           "Never Gonna Give You Up",
+			LastPosition:    93.5,
+			SourceLanguage:  "en",
+			TargetLanguage:  "vi",
+			ProgressPercent: 44,
+		})
+
+		video, err := service.GetVideo(1, "dQw4w9WgXcQ")
+		if err != nil {
+			t.Fatalf("GetVideo failed: %v", err)
+		}
+		if video.LastPosition != 93.5 || video.SourceLanguage != "en" || video.TargetLanguage != "vi" {
+			t.Errorf("Unexpected session state: %+v", video)
+		}
+		if video.LastWatchedAt.IsZero() {
+			t.Error("Expected LastWatchedAt to be set")
+		}
+	})
+
+	t.Run("saving the same video again updates it in place", func(t *testing.T) {
+		service := newUserVideoService(t)
+
+		first := saveVideo(t, service, 1, &dto.SaveUserVideoRequest{
+			VideoID:         "dQw4w9WgXcQ",
+			Title:           "Never Gonna Give You Up",
+			LastPosition:    10,
+			ProgressPercent: 5,
+		})
+
+		// A position-only ping without a title keeps the stored one
+		second := saveVideo(t, service, 1, &dto.SaveUserVideoRequest{
+			VideoID:         "dQw4w9WgXcQ",
+			LastPosition:    120,
+			ProgressPercent: 57,
+		})
+
+		if second.ID != first.ID {
+			t.Errorf("Expected the same entry to be updated, got IDs %d and %d", first.ID, second.ID)
+		}
+		if second.LastPosition != 120 || second.ProgressPercent != 57 {
+			t.Errorf("Unexpected updated state: %+v", second)
+		}
+		if second.Title != "Never Gonna Give You Up" {
+			t.Errorf("Expected the title to survive a title-less save, got %q", second.Title)
+		}
+
+		videos, err := service.ListVideos(1, &types.PaginationRequest{Page: 1, PageSize: 20})
+		if err != nil {
+			t.Fatalf("ListVideos failed: %v", err)
+		}
+		if len(videos.Data) != 1 {
+			t.Errorf("Expected a single history entry, got %d", len(videos.Data))
+		}
+	})
+
+	t.Run("history is scoped to the owning user", func(t *testing.T) {
+		service := newUserVideoService(t)
+
+		saveVideo(t, service, 1, &dto.SaveUserVideoRequest{VideoID: "dQw4w9WgXcQ"})
+
+		if _, err := service.GetVideo(2, "dQw4w9WgXcQ"); !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 for another user's video, got %v", err)
+		}
+		if err := service.DeleteVideo(2, "dQw4w9WgXcQ"); !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 deleting another user's video, got %v", err)
+		}
+
+		videos, err := service.ListVideos(2, &types.PaginationRequest{Page: 1, PageSize: 20})
+		if err != nil {
+			t.Fatalf("ListVideos failed: %v", err)
+		}
+		if len(videos.Data) != 0 {
+			t.Errorf("Expected an empty history for user 2, got %d entries", len(videos.Data))
+		}
+	})
+
+	t.Run("deletes a saved video", func(t *testing.T) {
+		service := newUserVideoService(t)
+
+		saveVideo(t, service, 1, &dto.SaveUserVideoRequest{VideoID: "dQw4w9WgXcQ"})
+
+		if err := service.DeleteVideo(1, "dQw4w9WgXcQ"); err != nil {
+			t.Fatalf("DeleteVideo failed: %v", err)
+		}
+		if _, err := service.GetVideo(1, "dQw4w9WgXcQ"); !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 after deletion, got %v", err)
+		}
+	})
+}
+
+// isStatus reports whether err is an AppError with the given HTTP status
+func isStatus(err error, status int) bool {
+	appErr, ok := err.(*errors.AppError)
+	return ok && appErr.Status == status
+}
@@ -0,0 +1,172 @@
+package validation_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/response"
+	"app-backend/internal/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errorBody is the slice of the AppError shape these tests care about
+type errorBody struct {
+	Code   string            `json:"code"`
+	Fields map[string]string `json:"fields"`
+}
+
+func newValidationRouter() *gin.Engine {
+	router := gin.New()
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+
+	router.POST("/resolve", func(c *gin.Context) {
+		var req dto.ResolveVideoRequest
+		if err := validation.BindJSON(c, &req); err != nil {
+			response.Error(c, err)
+			return
+		}
+		ok(c)
+	})
+	router.POST("/translate", func(c *gin.Context) {
+		var req dto.TranslateTextsRequest
+		if err := validation.BindJSON(c, &req); err != nil {
+			response.Error(c, err)
+			return
+		}
+		ok(c)
+	})
+	router.GET("/video/:videoUrl", func(c *gin.Context) {
+		var req dto.VideoInfoRequest
+		if err := validation.BindURI(c, &req); err != nil {
+			response.Error(c, err)
+			return
+		}
+		ok(c)
+	})
+	return router
+}
+
+func request(router *gin.Engine, method, path, body string) (*httptest.ResponseRecorder, errorBody) {
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	router.ServeHTTP(recorder, req)
+
+	var parsed errorBody
+	_ = json.Unmarshal(recorder.Body.Bytes(), &parsed)
+	return recorder, parsed
+}
+
+func TestBindHelpers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := newValidationRouter()
+
+	t.Run("validation failures carry field-level messages", func(t *testing.T) {
+		recorder, body := request(router, http.MethodPost, "/resolve", `{}`)
+		if recorder.Code != http.StatusBadRequest {
+			t.Fatalf("Expected 400, got %d", recorder.Code)
+		}
+		if body.Code != "VALIDATION_ERROR" {
+			t.Errorf("Expected the validation error code, got %q", body.Code)
+		}
+		if body.Fields["videourl"] == "" {
+			t.Errorf("Expected a message for the missing field, got %+v", body.Fields)
+		}
+	})
+
+	t.Run("malformed JSON is a plain bad request", func(t *testing.T) {
+		recorder, body := request(router, http.MethodPost, "/resolve", `{not json`)
+		if recorder.Code != http.StatusBadRequest {
+			t.Fatalf("Expected 400, got %d", recorder.Code)
+		}
+		if len(body.Fields) != 0 {
+			t.Errorf("Expected no field messages for a syntax error, got %+v", body.Fields)
+		}
+	})
+}
+
+func TestVideoURLValidator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := newValidationRouter()
+
+	cases := []struct {
+		name  string
+		value string
+		code  int
+	}{
+		{"full URL", "https://www.youtube.com/watch?v=dQw4w9WgXcQ", http.StatusOK},
+		{"bare video ID", "dQw4w9WgXcQ", http.StatusOK},
+		{"unsupported scheme", "ftp://example.com/video", http.StatusBadRequest},
+		{"not a URL or ID", "not a video!", http.StatusBadRequest},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			payload, _ := json.Marshal(gin.H{"videoUrl": tc.value})
+			recorder, body := request(router, http.MethodPost, "/resolve", string(payload))
+			if recorder.Code != tc.code {
+				t.Errorf("Expected %d for %q, got %d (%+v)", tc.code, tc.value, recorder.Code, body)
+			}
+		})
+	}
+}
+
+func TestLangCodeValidator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := newValidationRouter()
+
+	cases := []struct {
+		name  string
+		value string
+		code  int
+	}{
+		{"two-letter code", "en", http.StatusOK},
+		{"code with region subtag", "pt-BR", http.StatusOK},
+		{"language name", "english", http.StatusBadRequest},
+		{"injection noise", "en;drop", http.StatusBadRequest},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			payload, _ := json.Marshal(gin.H{"texts": []string{"hello"}, "targetLang": tc.value})
+			recorder, body := request(router, http.MethodPost, "/translate", string(payload))
+			if recorder.Code != tc.code {
+				t.Errorf("Expected %d for %q, got %d (%+v)", tc.code, tc.value, recorder.Code, body)
+			}
+		})
+	}
+}
+
+func TestB64URLParamValidator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := newValidationRouter()
+
+	t.Run("base64url-encoded params pass", func(t *testing.T) {
+		encoded := base64.RawURLEncoding.EncodeToString([]byte("https://youtu.be/dQw4w9WgXcQ"))
+		recorder, body := request(router, http.MethodGet, "/video/"+encoded, "")
+		if recorder.Code != http.StatusOK {
+			t.Errorf("Expected 200 for a base64url param, got %d (%+v)", recorder.Code, body)
+		}
+	})
+
+	t.Run("raw video IDs pass", func(t *testing.T) {
+		recorder, body := request(router, http.MethodGet, "/video/dQw4w9WgXcQ", "")
+		if recorder.Code != http.StatusOK {
+			t.Errorf("Expected 200 for a raw param, got %d (%+v)", recorder.Code, body)
+		}
+	})
+
+	t.Run("broken percent-encoding is rejected", func(t *testing.T) {
+		// The route decodes /video/%25zz to the literal param "%zz"
+		recorder, body := request(router, http.MethodGet, "/video/%25zz", "")
+		if recorder.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for broken encoding, got %d (%+v)", recorder.Code, body)
+		}
+	})
+}
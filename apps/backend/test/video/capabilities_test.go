@@ -0,0 +1,92 @@
+package video_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/types"
+)
+
+func TestEstimateTranscript(t *testing.T) {
+	t.Run("estimate is derived from the duration", func(t *testing.T) {
+		estimate := types.EstimateTranscript(10*time.Minute, 4)
+
+		if estimate == nil {
+			t.Fatal("Expected an estimate for a known duration")
+		}
+		if estimate.DurationSeconds != 600 {
+			t.Errorf("Expected 600 seconds, got %d", estimate.DurationSeconds)
+		}
+		if estimate.SegmentCount != 150 {
+			t.Errorf("Expected 150 segments, got %d", estimate.SegmentCount)
+		}
+	})
+
+	t.Run("segment count rounds up", func(t *testing.T) {
+		estimate := types.EstimateTranscript(10*time.Second, 4)
+
+		if estimate == nil {
+			t.Fatal("Expected an estimate")
+		}
+		if estimate.SegmentCount != 3 {
+			t.Errorf("Expected 3 segments for 10s at 4s each, got %d", estimate.SegmentCount)
+		}
+	})
+
+	t.Run("zero segment seconds uses the default", func(t *testing.T) {
+		estimate := types.EstimateTranscript(time.Minute, 0)
+
+		if estimate == nil {
+			t.Fatal("Expected an estimate")
+		}
+		if estimate.SegmentCount != 60/types.DefaultEstimateSegmentSeconds {
+			t.Errorf("Unexpected segment count: %d", estimate.SegmentCount)
+		}
+	})
+
+	t.Run("unknown duration yields no estimate", func(t *testing.T) {
+		if estimate := types.EstimateTranscript(0, 4); estimate != nil {
+			t.Errorf("Expected nil estimate for zero duration, got %+v", estimate)
+		}
+	})
+
+	t.Run("short videos still estimate one segment", func(t *testing.T) {
+		estimate := types.EstimateTranscript(500*time.Millisecond, 4)
+
+		if estimate == nil || estimate.SegmentCount != 1 {
+			t.Errorf("Expected a single-segment estimate, got %+v", estimate)
+		}
+	})
+}
+
+func TestCapabilitiesResponseEstimate(t *testing.T) {
+	t.Run("estimate is included when available", func(t *testing.T) {
+		caps := types.VideoCapabilities{
+			HasTranscript:      true,
+			TranscriptEstimate: types.EstimateTranscript(2*time.Minute, 4),
+		}
+
+		response := dto.ConvertToVideoCapabilitiesResponse(caps)
+		if response.TranscriptEstimate == nil {
+			t.Fatal("Expected the estimate to be carried into the response")
+		}
+		if response.TranscriptEstimate.SegmentCount != 30 {
+			t.Errorf("Unexpected segment count: %d", response.TranscriptEstimate.SegmentCount)
+		}
+	})
+
+	t.Run("estimate is omitted from JSON when unavailable", func(t *testing.T) {
+		response := dto.ConvertToVideoCapabilitiesResponse(types.VideoCapabilities{HasTranscript: true})
+
+		body, err := json.Marshal(response)
+		if err != nil {
+			t.Fatalf("Failed to marshal response: %v", err)
+		}
+		if strings.Contains(string(body), "transcriptEstimate") {
+			t.Errorf("Expected transcriptEstimate to be omitted, got %s", body)
+		}
+	})
+}
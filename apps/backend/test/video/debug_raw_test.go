@@ -0,0 +1,174 @@
+package video_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/config"
+	"app-backend/internal/dto"
+	"app-backend/internal/handlers/video"
+	"app-backend/internal/logger"
+	"app-backend/internal/middleware"
+	"app-backend/internal/services/jwt"
+	"app-backend/internal/services/transcript"
+	transcriptTypes "app-backend/internal/services/transcript/types"
+)
+
+// fakeTranscriptService returns a canned transcript, attaching raw content
+// only when the request asks for it. The last request is kept so tests can
+// assert what the handler passed down.
+type fakeTranscriptService struct {
+	lastReq *transcriptTypes.TranscriptRequest
+}
+
+func (f *fakeTranscriptService) GetTranscript(ctx context.Context, req *transcriptTypes.TranscriptRequest) (*transcriptTypes.Transcript, error) {
+	f.lastReq = req
+	t := &transcriptTypes.Transcript{
+		VideoID:  "dQw4w9WgXcQ",
+		Language: "en",
+		Provider: string(transcriptTypes.ProviderInnertube),
+		Segments: []transcriptTypes.TranscriptSegment{
+			{Text: "hello", Start: 0, Duration: time.Second},
+		},
+		CreatedAt: time.Now(),
+	}
+	if req.IncludeRaw {
+		t.RawContent = []byte(`{"actions":[]}`)
+	}
+	return t, nil
+}
+
+func (f *fakeTranscriptService) GetTranscriptWithProvider(ctx context.Context, provider transcriptTypes.ProviderType, req *transcriptTypes.TranscriptRequest) (*transcriptTypes.Transcript, error) {
+	return f.GetTranscript(ctx, req)
+}
+
+func (f *fakeTranscriptService) GetAvailableProviders(ctx context.Context) []transcriptTypes.ProviderType {
+	return []transcriptTypes.ProviderType{transcriptTypes.ProviderInnertube}
+}
+
+func (f *fakeTranscriptService) GetAvailableLanguages(ctx context.Context, videoID string) ([]transcriptTypes.CaptionLanguage, error) {
+	return nil, nil
+}
+
+func (f *fakeTranscriptService) RegisterProvider(provider transcript.ProviderInterface) error {
+	return nil
+}
+
+func (f *fakeTranscriptService) InvalidateTranscriptCache(ctx context.Context, videoID string) error {
+	return nil
+}
+
+// setupTranscriptRouter builds the transcript route behind the real optional
+// auth middleware, mirroring the production wiring. The returned mintToken
+// issues a Bearer token for a user with the given role; passing an empty
+// token to a request leaves it unauthenticated.
+func setupTranscriptRouter(t *testing.T) (*gin.Engine, *fakeTranscriptService, func(role string) string) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	cfg := &config.Config{}
+	cfg.JWT.Secret = "test-secret"
+	cfg.JWT.AccessTTLMinutes = 15
+	cfg.JWT.RefreshTTLHours = 24
+	jwtSvc := jwt.NewJWTService(cfg)
+	authMiddleware := middleware.NewAuthMiddleware(jwtSvc, nil, nil, log)
+
+	transcriptService := &fakeTranscriptService{}
+	handler := video.NewVideoHandler(nil, transcriptService, nil, nil, nil, nil, nil, nil, log)
+
+	router := gin.New()
+	router.GET("/video/:videoUrl/transcript", authMiddleware.OptionalAuth(), handler.GetTranscript)
+
+	mintToken := func(role string) string {
+		token, err := jwtSvc.GenerateAccessToken(1, "test@example.com", role, 1)
+		if err != nil {
+			t.Fatalf("Failed to generate token: %v", err)
+		}
+		return token
+	}
+	return router, transcriptService, mintToken
+}
+
+func getTranscriptResponse(t *testing.T, router *gin.Engine, path string, token string) dto.GetTranscriptResponse {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp dto.GetTranscriptResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	return resp
+}
+
+func TestGetTranscriptDebugRaw(t *testing.T) {
+	t.Run("admin requesting debugRaw receives raw payload", func(t *testing.T) {
+		router, _, mintToken := setupTranscriptRouter(t)
+
+		resp := getTranscriptResponse(t, router, "/video/dQw4w9WgXcQ/transcript?debugRaw=true", mintToken("admin"))
+
+		if resp.Raw == "" {
+			t.Fatal("Expected raw field for admin with debugRaw=true")
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(resp.Raw)
+		if err != nil {
+			t.Fatalf("Raw field should be valid base64: %v", err)
+		}
+		if string(decoded) != `{"actions":[]}` {
+			t.Errorf("Unexpected raw payload: %s", decoded)
+		}
+	})
+
+	t.Run("non-admin requesting debugRaw gets no raw payload", func(t *testing.T) {
+		router, _, mintToken := setupTranscriptRouter(t)
+
+		resp := getTranscriptResponse(t, router, "/video/dQw4w9WgXcQ/transcript?debugRaw=true", mintToken("user"))
+
+		if resp.Raw != "" {
+			t.Error("Raw field must not be present for non-admin users")
+		}
+	})
+
+	t.Run("unauthenticated request gets no raw payload", func(t *testing.T) {
+		router, _, _ := setupTranscriptRouter(t)
+
+		resp := getTranscriptResponse(t, router, "/video/dQw4w9WgXcQ/transcript?debugRaw=true", "")
+
+		if resp.Raw != "" {
+			t.Error("Raw field must not be present without authentication")
+		}
+	})
+
+	t.Run("admin without debugRaw gets no raw payload", func(t *testing.T) {
+		router, _, mintToken := setupTranscriptRouter(t)
+
+		resp := getTranscriptResponse(t, router, "/video/dQw4w9WgXcQ/transcript", mintToken("admin"))
+
+		if resp.Raw != "" {
+			t.Error("Raw field must not be present without debugRaw=true")
+		}
+	})
+}
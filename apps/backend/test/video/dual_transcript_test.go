@@ -0,0 +1,178 @@
+package video_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/dto"
+	videohandler "app-backend/internal/handlers/video"
+	"app-backend/internal/logger"
+	videoservice "app-backend/internal/services/video"
+	internalTypes "app-backend/internal/types"
+)
+
+// fakeVideoService serves a canned dual-language transcript
+type fakeVideoService struct {
+	segmentCount int
+	dualCalls    int
+}
+
+func (f *fakeVideoService) DetectProvider(videoURL string) (internalTypes.VideoProvider, string, error) {
+	return internalTypes.ProviderYouTube, "dQw4w9WgXcQ", nil
+}
+
+func (f *fakeVideoService) ResolveVideoURL(videoURL string) (*videoservice.ParsedVideoURL, error) {
+	return &videoservice.ParsedVideoURL{
+		Provider: internalTypes.ProviderYouTube,
+		VideoID:  "dQw4w9WgXcQ",
+		Kind:     videoservice.URLKindWatch,
+	}, nil
+}
+
+func (f *fakeVideoService) GetVideoInfo(ctx context.Context, provider internalTypes.VideoProvider, videoID string) (*internalTypes.VideoInfo, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeVideoService) GetTranscript(ctx context.Context, provider internalTypes.VideoProvider, videoID string, language string) (*internalTypes.Transcript, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeVideoService) GetAvailableLanguages(ctx context.Context, provider internalTypes.VideoProvider, videoID string) ([]internalTypes.Language, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeVideoService) GetCapabilities(ctx context.Context, provider internalTypes.VideoProvider, videoID string) (*internalTypes.VideoCapabilities, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeVideoService) GetDualLanguageTranscript(ctx context.Context, provider internalTypes.VideoProvider, videoID string, sourceLang string, targetLang string, corrections map[int]string) (*internalTypes.DualLanguageTranscript, error) {
+	f.dualCalls++
+
+	segments := make([]internalTypes.TranscriptSegment, f.segmentCount)
+	translations := make([]internalTypes.TranslatedSegment, f.segmentCount)
+	for i := range segments {
+		segments[i] = internalTypes.TranscriptSegment{
+			StartTime: internalTypes.MillisecondDuration(i * 1000),
+			EndTime:   internalTypes.MillisecondDuration((i + 1) * 1000),
+			Text:      fmt.Sprintf("segment %d", i+1),
+			Index:     i + 1,
+		}
+		translations[i] = internalTypes.TranslatedSegment{
+			Index:          i + 1,
+			OriginalText:   segments[i].Text,
+			TranslatedText: fmt.Sprintf("[%s] segment %d", targetLang, i+1),
+		}
+	}
+
+	return &internalTypes.DualLanguageTranscript{
+		VideoID:      videoID,
+		Provider:     provider,
+		SourceLang:   "en",
+		TargetLang:   targetLang,
+		Segments:     segments,
+		Translations: translations,
+	}, nil
+}
+
+func (f *fakeVideoService) GetSupportedProviders() []internalTypes.VideoProvider {
+	return []internalTypes.VideoProvider{internalTypes.ProviderYouTube}
+}
+
+func (f *fakeVideoService) GetSupportedLanguages() []internalTypes.Language {
+	return nil
+}
+
+func setupDualRouter(t *testing.T, service *fakeVideoService) *gin.Engine {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	handler := videohandler.NewVideoHandler(service, nil, nil, nil, nil, nil, nil, nil, log)
+
+	router := gin.New()
+	router.GET("/video/:videoUrl/transcript/dual", handler.GetDualTranscript)
+	return router
+}
+
+func requestDual(t *testing.T, router *gin.Engine, query string) (*httptest.ResponseRecorder, *dto.GetDualTranscriptResponse) {
+	t.Helper()
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/video/dQw4w9WgXcQ/transcript/dual"+query, nil))
+
+	if recorder.Code != http.StatusOK {
+		return recorder, nil
+	}
+	var response dto.GetDualTranscriptResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	return recorder, &response
+}
+
+func TestGetDualTranscript(t *testing.T) {
+	t.Run("pairs original segments with translations", func(t *testing.T) {
+		router := setupDualRouter(t, &fakeVideoService{segmentCount: 3})
+
+		recorder, response := requestDual(t, router, "?targetLang=vi")
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+		}
+
+		if response.SourceLang != "en" || response.TargetLang != "vi" {
+			t.Errorf("Unexpected language pair: %s -> %s", response.SourceLang, response.TargetLang)
+		}
+		if len(response.Segments) != 3 {
+			t.Fatalf("Expected 3 segments, got %d", len(response.Segments))
+		}
+		if response.Segments[0].Text != "segment 1" {
+			t.Errorf("Unexpected original text: %q", response.Segments[0].Text)
+		}
+		if response.Segments[0].TranslatedText != "[vi] segment 1" {
+			t.Errorf("Unexpected translated text: %q", response.Segments[0].TranslatedText)
+		}
+	})
+
+	t.Run("paginates segments", func(t *testing.T) {
+		router := setupDualRouter(t, &fakeVideoService{segmentCount: 5})
+
+		recorder, response := requestDual(t, router, "?targetLang=vi&page=2&pageSize=2")
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+		}
+
+		if len(response.Segments) != 2 {
+			t.Fatalf("Expected 2 segments on page 2, got %d", len(response.Segments))
+		}
+		if response.Segments[0].Index != 3 || response.Segments[1].Index != 4 {
+			t.Errorf("Expected segments 3 and 4, got %d and %d", response.Segments[0].Index, response.Segments[1].Index)
+		}
+		if response.Pagination.TotalRecords != 5 || response.Pagination.TotalPages != 3 {
+			t.Errorf("Unexpected pagination metadata: %+v", response.Pagination)
+		}
+		if !response.Pagination.HasNext || !response.Pagination.HasPrev {
+			t.Errorf("Expected both next and previous pages, got %+v", response.Pagination)
+		}
+	})
+
+	t.Run("missing targetLang is rejected", func(t *testing.T) {
+		router := setupDualRouter(t, &fakeVideoService{segmentCount: 1})
+
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/video/dQw4w9WgXcQ/transcript/dual", nil))
+		if recorder.Code != http.StatusBadRequest {
+			t.Errorf("Expected status 400 without targetLang, got %d", recorder.Code)
+		}
+	})
+}
@@ -0,0 +1,127 @@
+package video_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/dto"
+	videohandler "app-backend/internal/handlers/video"
+	"app-backend/internal/logger"
+)
+
+func setupExportRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	handler := videohandler.NewVideoHandler(&fakeVideoService{segmentCount: 2}, &fakeTranscriptService{}, nil, nil, nil, nil, nil, nil, log)
+
+	router := gin.New()
+	router.GET("/video/:videoUrl/transcript/export", handler.ExportTranscript)
+	return router
+}
+
+func requestExport(t *testing.T, router *gin.Engine, query string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/video/dQw4w9WgXcQ/transcript/export"+query, nil))
+	return recorder
+}
+
+func TestExportTranscript(t *testing.T) {
+	t.Run("defaults to SRT with an attachment header", func(t *testing.T) {
+		recorder := requestExport(t, setupExportRouter(t), "")
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+		}
+
+		if ct := recorder.Header().Get("Content-Type"); ct != "application/x-subrip" {
+			t.Errorf("Unexpected Content-Type %q", ct)
+		}
+		if cd := recorder.Header().Get("Content-Disposition"); cd != `attachment; filename="dQw4w9WgXcQ.en.srt"` {
+			t.Errorf("Unexpected Content-Disposition %q", cd)
+		}
+
+		body := recorder.Body.String()
+		if !strings.Contains(body, "1\n00:00:00,000 --> 00:00:01,000\nhello") {
+			t.Errorf("Expected an SRT cue, got:\n%s", body)
+		}
+	})
+
+	t.Run("exports WebVTT", func(t *testing.T) {
+		recorder := requestExport(t, setupExportRouter(t), "?format=vtt")
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d", recorder.Code)
+		}
+
+		body := recorder.Body.String()
+		if !strings.HasPrefix(body, "WEBVTT\n\n") {
+			t.Errorf("Expected the WEBVTT header, got:\n%s", body)
+		}
+		if !strings.Contains(body, "00:00:00.000 --> 00:00:01.000\nhello") {
+			t.Errorf("Expected a VTT cue with dot timestamps, got:\n%s", body)
+		}
+	})
+
+	t.Run("exports plain text", func(t *testing.T) {
+		recorder := requestExport(t, setupExportRouter(t), "?format=txt")
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d", recorder.Code)
+		}
+		if body := recorder.Body.String(); body != "hello\n" {
+			t.Errorf("Expected the plain text transcript, got %q", body)
+		}
+	})
+
+	t.Run("exports JSON with millisecond timestamps", func(t *testing.T) {
+		recorder := requestExport(t, setupExportRouter(t), "?format=json")
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d", recorder.Code)
+		}
+
+		var export dto.ExportTranscriptResponse
+		if err := json.Unmarshal(recorder.Body.Bytes(), &export); err != nil {
+			t.Fatalf("Failed to decode export: %v", err)
+		}
+		if export.VideoID != "dQw4w9WgXcQ" || export.Language != "en" {
+			t.Errorf("Unexpected export metadata: %+v", export)
+		}
+		if len(export.Segments) != 1 || export.Segments[0].Text != "hello" {
+			t.Fatalf("Unexpected segments: %+v", export.Segments)
+		}
+	})
+
+	t.Run("dual-language export pairs translations under originals", func(t *testing.T) {
+		recorder := requestExport(t, setupExportRouter(t), "?format=srt&targetLang=vi")
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+		}
+
+		if cd := recorder.Header().Get("Content-Disposition"); cd != `attachment; filename="dQw4w9WgXcQ.en-vi.srt"` {
+			t.Errorf("Unexpected Content-Disposition %q", cd)
+		}
+
+		body := recorder.Body.String()
+		if !strings.Contains(body, "segment 1\n[vi] segment 1") {
+			t.Errorf("Expected the translation under the original line, got:\n%s", body)
+		}
+	})
+
+	t.Run("rejects unknown formats", func(t *testing.T) {
+		recorder := requestExport(t, setupExportRouter(t), "?format=doc")
+		if recorder.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for an unsupported format, got %d", recorder.Code)
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package video_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"app-backend/internal/services/video"
+	internalTypes "app-backend/internal/types"
+)
+
+func TestGetVideoInfoOEmbedFallback(t *testing.T) {
+	ctx := context.Background()
+
+	newOEmbedService := func(t *testing.T, endpoint string) *video.Service {
+		t.Helper()
+
+		// No YouTube API key: the Data API provider is not registered
+		service, err := video.NewService(&video.Config{Logger: zap.NewNop()})
+		if err != nil {
+			t.Fatalf("Failed to create video service: %v", err)
+		}
+		service.SetOEmbedEndpoint(endpoint)
+		return service
+	}
+
+	t.Run("oEmbed supplies metadata when the Data API is not configured", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("format") != "json" {
+				t.Errorf("Expected format=json, got %q", r.URL.Query().Get("format"))
+			}
+			w.Write([]byte(`{
+				"title": "Daily English Conversation",
+				"author_name": "English Channel",
+				"thumbnail_url": "https://i.ytimg.com/vi/dQw4w9WgXcQ/hqdefault.jpg"
+			}`))
+		}))
+		defer server.Close()
+
+		service := newOEmbedService(t, server.URL)
+
+		info, err := service.GetVideoInfo(ctx, internalTypes.ProviderYouTube, "dQw4w9WgXcQ")
+		if err != nil {
+			t.Fatalf("GetVideoInfo failed: %v", err)
+		}
+		if info.Title != "Daily English Conversation" {
+			t.Errorf("Unexpected title: %q", info.Title)
+		}
+		if info.Author != "English Channel" {
+			t.Errorf("Unexpected author: %q", info.Author)
+		}
+		if info.ThumbnailURL != "https://i.ytimg.com/vi/dQw4w9WgXcQ/hqdefault.jpg" {
+			t.Errorf("Unexpected thumbnail: %q", info.ThumbnailURL)
+		}
+		if info.Duration != 0 {
+			t.Errorf("Expected absent duration, got %v", info.Duration)
+		}
+		if info.ID != "dQw4w9WgXcQ" || info.Provider != internalTypes.ProviderYouTube {
+			t.Errorf("Unexpected identity fields: %+v", info)
+		}
+	})
+
+	t.Run("oEmbed errors surface when there is no provider to serve the request", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		service := newOEmbedService(t, server.URL)
+
+		if _, err := service.GetVideoInfo(ctx, internalTypes.ProviderYouTube, "missing12345"); err == nil {
+			t.Fatal("Expected an error when oEmbed cannot resolve the video")
+		}
+	})
+
+	t.Run("non-YouTube providers do not fall back to oEmbed", func(t *testing.T) {
+		service := newOEmbedService(t, "http://127.0.0.1:0")
+
+		if _, err := service.GetVideoInfo(ctx, internalTypes.ProviderVimeo, "12345"); err == nil {
+			t.Fatal("Expected an unsupported provider error")
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package video_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requestTranscript sends a transcript request through the router with an
+// optional Bearer token and X-Provider-Override header
+func requestTranscript(t *testing.T, router *gin.Engine, token string, override string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/video/dQw4w9WgXcQ/transcript", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if override != "" {
+		req.Header.Set("X-Provider-Override", override)
+	}
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	return w
+}
+
+func TestTranscriptProviderOverride(t *testing.T) {
+	t.Run("admin's X-Provider-Override header forces the provider", func(t *testing.T) {
+		router, transcriptService, mintToken := setupTranscriptRouter(t)
+
+		recorder := requestTranscript(t, router, mintToken("admin"), "innertube")
+
+		if transcriptService.lastReq == nil || transcriptService.lastReq.ForceProvider != "innertube" {
+			t.Errorf("Expected the override to reach the transcript service, got %+v", transcriptService.lastReq)
+		}
+		if used := recorder.Header().Get("X-Provider-Used"); used == "" {
+			t.Error("Expected an X-Provider-Used debug header")
+		}
+	})
+
+	t.Run("non-admin override is ignored", func(t *testing.T) {
+		router, transcriptService, mintToken := setupTranscriptRouter(t)
+
+		requestTranscript(t, router, mintToken("user"), "innertube")
+
+		if transcriptService.lastReq == nil || transcriptService.lastReq.ForceProvider != "" {
+			t.Errorf("Expected no forced provider for a non-admin, got %+v", transcriptService.lastReq)
+		}
+	})
+
+	t.Run("unauthenticated override is ignored", func(t *testing.T) {
+		router, transcriptService, _ := setupTranscriptRouter(t)
+
+		requestTranscript(t, router, "", "innertube")
+
+		if transcriptService.lastReq == nil || transcriptService.lastReq.ForceProvider != "" {
+			t.Errorf("Expected no forced provider without authentication, got %+v", transcriptService.lastReq)
+		}
+	})
+}
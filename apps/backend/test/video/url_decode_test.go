@@ -0,0 +1,106 @@
+package video_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	videohandler "app-backend/internal/handlers/video"
+	"app-backend/internal/logger"
+)
+
+func TestDecodeVideoURL(t *testing.T) {
+	videoURL := "https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=42s"
+
+	t.Run("base64url with padding", func(t *testing.T) {
+		encoded := base64.URLEncoding.EncodeToString([]byte(videoURL))
+
+		decoded, err := videohandler.DecodeVideoURL(encoded)
+		if err != nil {
+			t.Fatalf("DecodeVideoURL failed: %v", err)
+		}
+		if decoded != videoURL {
+			t.Errorf("Expected %q, got %q", videoURL, decoded)
+		}
+	})
+
+	t.Run("base64url without padding", func(t *testing.T) {
+		encoded := base64.RawURLEncoding.EncodeToString([]byte(videoURL))
+
+		decoded, err := videohandler.DecodeVideoURL(encoded)
+		if err != nil {
+			t.Fatalf("DecodeVideoURL failed: %v", err)
+		}
+		if decoded != videoURL {
+			t.Errorf("Expected %q, got %q", videoURL, decoded)
+		}
+	})
+
+	t.Run("raw URL passes through", func(t *testing.T) {
+		decoded, err := videohandler.DecodeVideoURL(videoURL)
+		if err != nil {
+			t.Fatalf("DecodeVideoURL failed: %v", err)
+		}
+		if decoded != videoURL {
+			t.Errorf("Expected %q, got %q", videoURL, decoded)
+		}
+	})
+
+	t.Run("percent-encoded URL is unescaped", func(t *testing.T) {
+		decoded, err := videohandler.DecodeVideoURL("https%3A%2F%2Fwww.youtube.com%2Fwatch%3Fv%3DdQw4w9WgXcQ")
+		if err != nil {
+			t.Fatalf("DecodeVideoURL failed: %v", err)
+		}
+		if decoded != "https://www.youtube.com/watch?v=dQw4w9WgXcQ" {
+			t.Errorf("Unexpected decoded URL: %q", decoded)
+		}
+	})
+
+	t.Run("plain video ID is not mangled", func(t *testing.T) {
+		// A bare video ID is valid base64 but must be passed through as-is
+		decoded, err := videohandler.DecodeVideoURL("dQw4w9WgXcQ")
+		if err != nil {
+			t.Fatalf("DecodeVideoURL failed: %v", err)
+		}
+		if decoded != "dQw4w9WgXcQ" {
+			t.Errorf("Expected the raw ID, got %q", decoded)
+		}
+	})
+
+	t.Run("malformed percent encoding is rejected", func(t *testing.T) {
+		if _, err := videohandler.DecodeVideoURL("%zz"); err == nil {
+			t.Error("Expected an error for malformed input")
+		}
+	})
+
+	t.Run("empty input is rejected", func(t *testing.T) {
+		if _, err := videohandler.DecodeVideoURL(""); err == nil {
+			t.Error("Expected an error for empty input")
+		}
+	})
+}
+
+func TestGetVideoInfoMalformedURL(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	handler := videohandler.NewVideoHandler(nil, nil, nil, nil, nil, nil, nil, nil, log)
+	router := gin.New()
+	router.GET("/video/:videoUrl", handler.GetVideoInfo)
+
+	recorder := httptest.NewRecorder()
+	// %25zz arrives at the handler as the literal "%zz"
+	req := httptest.NewRequest(http.MethodGet, "/video/%25zz", nil)
+	router.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for a malformed URL, got %d", recorder.Code)
+	}
+}
@@ -0,0 +1,233 @@
+package video_test
+
+import (
+	"bytes"
+	"encoding/json"
+	goerrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/dto"
+	videohandler "app-backend/internal/handlers/video"
+	"app-backend/internal/logger"
+	videoservice "app-backend/internal/services/video"
+	"app-backend/internal/types"
+)
+
+func TestParseVideoURL(t *testing.T) {
+	cases := []struct {
+		name    string
+		input   string
+		videoID string
+		kind    videoservice.VideoURLKind
+	}{
+		{
+			name:    "standard watch URL",
+			input:   "https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+			videoID: "dQw4w9WgXcQ",
+			kind:    videoservice.URLKindWatch,
+		},
+		{
+			name:    "playlist watch URL with extra params",
+			input:   "https://www.youtube.com/watch?v=dQw4w9WgXcQ&list=PLx0sYbCqOb8TBPRdmBHs5Iftvv9TPboYG&index=2&t=30s",
+			videoID: "dQw4w9WgXcQ",
+			kind:    videoservice.URLKindWatch,
+		},
+		{
+			name:    "shorts URL",
+			input:   "https://www.youtube.com/shorts/abc123DEF_-",
+			videoID: "abc123DEF_-",
+			kind:    videoservice.URLKindShorts,
+		},
+		{
+			name:    "live-stream URL",
+			input:   "https://www.youtube.com/live/abc123DEF_-?feature=share",
+			videoID: "abc123DEF_-",
+			kind:    videoservice.URLKindLive,
+		},
+		{
+			name:    "embed URL",
+			input:   "https://www.youtube.com/embed/dQw4w9WgXcQ",
+			videoID: "dQw4w9WgXcQ",
+			kind:    videoservice.URLKindEmbed,
+		},
+		{
+			name:    "legacy /v/ URL",
+			input:   "https://www.youtube.com/v/dQw4w9WgXcQ",
+			videoID: "dQw4w9WgXcQ",
+			kind:    videoservice.URLKindEmbed,
+		},
+		{
+			name:    "share short-link with tracking param",
+			input:   "https://youtu.be/dQw4w9WgXcQ?si=AbCdEfGh123",
+			videoID: "dQw4w9WgXcQ",
+			kind:    videoservice.URLKindShare,
+		},
+		{
+			name:    "nocookie embed host",
+			input:   "https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ",
+			videoID: "dQw4w9WgXcQ",
+			kind:    videoservice.URLKindEmbed,
+		},
+		{
+			name:    "mobile subdomain",
+			input:   "https://m.youtube.com/watch?v=dQw4w9WgXcQ",
+			videoID: "dQw4w9WgXcQ",
+			kind:    videoservice.URLKindWatch,
+		},
+		{
+			name:    "music subdomain",
+			input:   "https://music.youtube.com/watch?v=dQw4w9WgXcQ&feature=share",
+			videoID: "dQw4w9WgXcQ",
+			kind:    videoservice.URLKindWatch,
+		},
+		{
+			name:    "scheme-less URL",
+			input:   "www.youtube.com/shorts/dQw4w9WgXcQ",
+			videoID: "dQw4w9WgXcQ",
+			kind:    videoservice.URLKindShorts,
+		},
+		{
+			name:    "bare video ID",
+			input:   "dQw4w9WgXcQ",
+			videoID: "dQw4w9WgXcQ",
+			kind:    videoservice.URLKindVideoID,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parsed, err := videoservice.ParseVideoURL(tc.input)
+			if err != nil {
+				t.Fatalf("ParseVideoURL(%q) failed: %v", tc.input, err)
+			}
+			if parsed.Provider != types.ProviderYouTube {
+				t.Errorf("Expected provider youtube, got %q", parsed.Provider)
+			}
+			if parsed.VideoID != tc.videoID {
+				t.Errorf("Expected video ID %q, got %q", tc.videoID, parsed.VideoID)
+			}
+			if parsed.Kind != tc.kind {
+				t.Errorf("Expected kind %q, got %q", tc.kind, parsed.Kind)
+			}
+		})
+	}
+}
+
+func TestParseVideoURLErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		want  error
+	}{
+		{
+			name:  "empty input",
+			input: "",
+			want:  videoservice.ErrInvalidVideoURL,
+		},
+		{
+			name:  "unparseable input",
+			input: "ht tp://%%%",
+			want:  videoservice.ErrInvalidVideoURL,
+		},
+		{
+			name:  "unsupported host",
+			input: "https://vimeo.com/123456789",
+			want:  videoservice.ErrUnsupportedVideoHost,
+		},
+		{
+			name:  "playlist URL without a video",
+			input: "https://www.youtube.com/playlist?list=PLx0sYbCqOb8TBPRdmBHs5Iftvv9TPboYG",
+			want:  videoservice.ErrNoVideoID,
+		},
+		{
+			name:  "watch URL missing v param",
+			input: "https://www.youtube.com/watch?list=PLx0sYbCqOb8TBPRdmBHs5Iftvv9TPboYG",
+			want:  videoservice.ErrNoVideoID,
+		},
+		{
+			name:  "shorts URL with malformed ID",
+			input: "https://www.youtube.com/shorts/tooshort",
+			want:  videoservice.ErrNoVideoID,
+		},
+		{
+			name:  "channel URL",
+			input: "https://www.youtube.com/@somechannel",
+			want:  videoservice.ErrNoVideoID,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := videoservice.ParseVideoURL(tc.input)
+			if !goerrors.Is(err, tc.want) {
+				t.Errorf("ParseVideoURL(%q) = %v, expected %v", tc.input, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestResolveVideoEndpoint(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	service := videoservice.NewVideoService(nil, nil, log.Zap())
+	handler := videohandler.NewVideoHandler(service, nil, nil, nil, nil, nil, nil, nil, log)
+	router := gin.New()
+	router.POST("/video/resolve", handler.ResolveVideo)
+
+	resolve := func(t *testing.T, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		payload, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("Failed to marshal request: %v", err)
+		}
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/video/resolve", bytes.NewReader(payload))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	t.Run("resolves a shorts URL", func(t *testing.T) {
+		recorder := resolve(t, dto.ResolveVideoRequest{VideoURL: "https://www.youtube.com/shorts/dQw4w9WgXcQ"})
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+		}
+
+		var resp dto.ResolveVideoResponse
+		if err := json.Unmarshal(recorder.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if resp.Provider != types.ProviderYouTube {
+			t.Errorf("Expected provider youtube, got %q", resp.Provider)
+		}
+		if resp.VideoID != "dQw4w9WgXcQ" {
+			t.Errorf("Expected video ID dQw4w9WgXcQ, got %q", resp.VideoID)
+		}
+		if resp.Type != string(videoservice.URLKindShorts) {
+			t.Errorf("Expected type shorts, got %q", resp.Type)
+		}
+	})
+
+	t.Run("rejects an unsupported host", func(t *testing.T) {
+		recorder := resolve(t, dto.ResolveVideoRequest{VideoURL: "https://vimeo.com/123456789"})
+		if recorder.Code != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("rejects a missing body field", func(t *testing.T) {
+		recorder := resolve(t, map[string]string{})
+		if recorder.Code != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", recorder.Code)
+		}
+	})
+}
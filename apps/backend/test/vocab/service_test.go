@@ -0,0 +1,230 @@
+package vocab_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/vocab"
+	"app-backend/internal/types"
+	"app-backend/test/testutil"
+
+	"gorm.io/gorm"
+)
+
+func newVocabService(t *testing.T) (vocab.ServiceInterface, *gorm.DB) {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t, &models.User{}, &models.VocabItem{})
+	repo := repositories.NewVocabRepository(db)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	return vocab.NewService(repo, log), db
+}
+
+func createItem(t *testing.T, service vocab.ServiceInterface, userID uint, word string) *dto.VocabItemResponse {
+	t.Helper()
+
+	item, err := service.CreateItem(userID, &dto.CreateVocabItemRequest{
+		Word:           word,
+		Definition:     "a definition of " + word,
+		SourceVideoID:  "dQw4w9WgXcQ",
+		VideoTimestamp: 42.5,
+	})
+	if err != nil {
+		t.Fatalf("CreateItem failed: %v", err)
+	}
+	return item
+}
+
+// review grades an item and fails the test on error
+func review(t *testing.T, service vocab.ServiceInterface, userID uint, itemID uint, quality int) *dto.VocabItemResponse {
+	t.Helper()
+
+	item, err := service.ReviewItem(userID, itemID, quality)
+	if err != nil {
+		t.Fatalf("ReviewItem failed: %v", err)
+	}
+	return item
+}
+
+func TestVocabService(t *testing.T) {
+	t.Run("new items are due immediately", func(t *testing.T) {
+		service, _ := newVocabService(t)
+		created := createItem(t, service, 1, "ubiquitous")
+
+		if created.EaseFactor != 2.5 {
+			t.Errorf("Expected initial ease factor 2.5, got %v", created.EaseFactor)
+		}
+
+		queue, err := service.GetReviewQueue(1, 0)
+		if err != nil {
+			t.Fatalf("GetReviewQueue failed: %v", err)
+		}
+		if queue.Total != 1 || queue.Items[0].Word != "ubiquitous" {
+			t.Errorf("Expected the new item in the review queue, got %+v", queue)
+		}
+	})
+
+	t.Run("successful reviews grow the interval", func(t *testing.T) {
+		service, _ := newVocabService(t)
+		created := createItem(t, service, 1, "ephemeral")
+
+		first := review(t, service, 1, created.ID, 5)
+		if first.IntervalDays != 1 || first.Repetitions != 1 {
+			t.Errorf("Expected 1-day interval after the first pass, got %+v", first)
+		}
+
+		second := review(t, service, 1, created.ID, 5)
+		if second.IntervalDays != 6 || second.Repetitions != 2 {
+			t.Errorf("Expected 6-day interval after the second pass, got %+v", second)
+		}
+
+		third := review(t, service, 1, created.ID, 5)
+		if third.IntervalDays <= 6 {
+			t.Errorf("Expected the third interval to grow past 6 days, got %d", third.IntervalDays)
+		}
+		wantNext := time.Now().UTC().AddDate(0, 0, third.IntervalDays)
+		if diff := third.NextReviewAt.Sub(wantNext); diff < -time.Minute || diff > time.Minute {
+			t.Errorf("Expected next review around %v, got %v", wantNext, third.NextReviewAt)
+		}
+	})
+
+	t.Run("failed reviews reset the streak", func(t *testing.T) {
+		service, _ := newVocabService(t)
+		created := createItem(t, service, 1, "serendipity")
+
+		review(t, service, 1, created.ID, 5)
+		review(t, service, 1, created.ID, 5)
+		failed := review(t, service, 1, created.ID, 1)
+
+		if failed.Repetitions != 0 || failed.IntervalDays != 1 {
+			t.Errorf("Expected a failed review to reset the streak, got %+v", failed)
+		}
+		if failed.EaseFactor >= 2.5 {
+			t.Errorf("Expected the ease factor to drop below 2.5, got %v", failed.EaseFactor)
+		}
+	})
+
+	t.Run("ease factor never drops below the SM-2 floor", func(t *testing.T) {
+		service, _ := newVocabService(t)
+		created := createItem(t, service, 1, "obstinate")
+
+		var last *dto.VocabItemResponse
+		for i := 0; i < 10; i++ {
+			last = review(t, service, 1, created.ID, 0)
+		}
+		if last.EaseFactor < 1.3 {
+			t.Errorf("Expected ease factor floor of 1.3, got %v", last.EaseFactor)
+		}
+	})
+
+	t.Run("reviewed items leave the queue until due again", func(t *testing.T) {
+		service, db := newVocabService(t)
+		created := createItem(t, service, 1, "gregarious")
+		review(t, service, 1, created.ID, 4)
+
+		queue, err := service.GetReviewQueue(1, 0)
+		if err != nil {
+			t.Fatalf("GetReviewQueue failed: %v", err)
+		}
+		if queue.Total != 0 {
+			t.Errorf("Expected an empty queue after review, got %d items", queue.Total)
+		}
+
+		// Backdate the schedule to simulate the interval elapsing
+		err = db.Model(&models.VocabItem{}).
+			Where("id = ?", created.ID).
+			UpdateColumn("next_review_at", time.Now().UTC().Add(-time.Hour)).Error
+		if err != nil {
+			t.Fatalf("Failed to backdate review time: %v", err)
+		}
+
+		queue, err = service.GetReviewQueue(1, 0)
+		if err != nil {
+			t.Fatalf("GetReviewQueue failed: %v", err)
+		}
+		if queue.Total != 1 {
+			t.Errorf("Expected the item back in the queue once due, got %d items", queue.Total)
+		}
+	})
+
+	t.Run("items are scoped to their owner", func(t *testing.T) {
+		service, _ := newVocabService(t)
+		created := createItem(t, service, 1, "parochial")
+
+		if _, err := service.GetItem(2, created.ID); !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 for another user's item, got %v", err)
+		}
+		if _, err := service.ReviewItem(2, created.ID, 5); !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 reviewing another user's item, got %v", err)
+		}
+		if err := service.DeleteItem(2, created.ID); !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 deleting another user's item, got %v", err)
+		}
+
+		queue, err := service.GetReviewQueue(2, 0)
+		if err != nil {
+			t.Fatalf("GetReviewQueue failed: %v", err)
+		}
+		if queue.Total != 0 {
+			t.Errorf("Expected user 2's queue to be empty, got %d items", queue.Total)
+		}
+	})
+
+	t.Run("update edits content without touching the schedule", func(t *testing.T) {
+		service, _ := newVocabService(t)
+		created := createItem(t, service, 1, "laconic")
+		reviewed := review(t, service, 1, created.ID, 5)
+
+		newDefinition := "using very few words"
+		updated, err := service.UpdateItem(1, created.ID, &dto.UpdateVocabItemRequest{
+			Definition: &newDefinition,
+		})
+		if err != nil {
+			t.Fatalf("UpdateItem failed: %v", err)
+		}
+		if updated.Definition != newDefinition {
+			t.Errorf("Expected the definition to change, got %q", updated.Definition)
+		}
+		if updated.Word != "laconic" {
+			t.Errorf("Expected untouched fields to keep their values, got %q", updated.Word)
+		}
+		if !updated.NextReviewAt.Equal(reviewed.NextReviewAt) || updated.Repetitions != reviewed.Repetitions {
+			t.Errorf("Expected the schedule to be unchanged by an edit, got %+v", updated)
+		}
+	})
+
+	t.Run("deleted items disappear from listings", func(t *testing.T) {
+		service, _ := newVocabService(t)
+		created := createItem(t, service, 1, "transient")
+		createItem(t, service, 1, "perennial")
+
+		if err := service.DeleteItem(1, created.ID); err != nil {
+			t.Fatalf("DeleteItem failed: %v", err)
+		}
+
+		items, err := service.ListItems(1, &types.PaginationRequest{Page: 1, PageSize: 20, SortBy: "created_at", SortDir: "desc"})
+		if err != nil {
+			t.Fatalf("ListItems failed: %v", err)
+		}
+		if len(items.Data) != 1 || items.Data[0].Word != "perennial" {
+			t.Errorf("Expected only the remaining item, got %+v", items.Data)
+		}
+	})
+}
+
+// isStatus reports whether err is an AppError with the given status
+func isStatus(err error, status int) bool {
+	appErr, ok := err.(*errors.AppError)
+	return ok && appErr.Status == status
+}
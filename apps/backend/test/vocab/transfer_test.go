@@ -0,0 +1,207 @@
+package vocab_test
+
+import (
+	"strings"
+	"testing"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/services/vocab"
+	"app-backend/internal/types"
+)
+
+// listAll fetches the first page of a user's vocabulary
+func listAll(t *testing.T, service vocab.ServiceInterface, userID uint) []dto.VocabItemResponse {
+	t.Helper()
+
+	items, err := service.ListItems(userID, &types.PaginationRequest{Page: 1, PageSize: 50})
+	if err != nil {
+		t.Fatalf("ListItems failed: %v", err)
+	}
+	return items.Data
+}
+
+// exportLines splits an export payload into lines, dropping the trailing
+// empty line
+func exportLines(t *testing.T, file *vocab.ExportFile) []string {
+	t.Helper()
+	return strings.Split(strings.TrimRight(string(file.Data), "\n"), "\n")
+}
+
+func TestExportItems(t *testing.T) {
+	t.Run("csv carries every field and the timestamped video link", func(t *testing.T) {
+		service, _ := newVocabService(t)
+		createItem(t, service, 1, "serendipity")
+
+		file, err := service.ExportItems(1, vocab.FormatCSV)
+		if err != nil {
+			t.Fatalf("ExportItems failed: %v", err)
+		}
+		if file.FileName != "vocabulary.csv" {
+			t.Errorf("Unexpected file name %q", file.FileName)
+		}
+
+		lines := exportLines(t, file)
+		if len(lines) != 2 {
+			t.Fatalf("Expected header and one row, got %d lines", len(lines))
+		}
+		if lines[0] != "term,reading,definition,example,source_video_url,video_timestamp" {
+			t.Errorf("Unexpected header %q", lines[0])
+		}
+		if !strings.Contains(lines[1], "https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=42s") {
+			t.Errorf("Expected a timestamped video link, got %q", lines[1])
+		}
+	})
+
+	t.Run("anki output is tab separated with cloze markup", func(t *testing.T) {
+		service, _ := newVocabService(t)
+		if _, err := service.CreateItem(1, &dto.CreateVocabItemRequest{
+			Word:            "ephemeral",
+			Reading:         "ih-FEM-er-uhl",
+			Definition:      "lasting a very short time",
+			ExampleSentence: "Fame is often ephemeral.",
+		}); err != nil {
+			t.Fatalf("CreateItem failed: %v", err)
+		}
+
+		file, err := service.ExportItems(1, vocab.FormatAnki)
+		if err != nil {
+			t.Fatalf("ExportItems failed: %v", err)
+		}
+
+		lines := exportLines(t, file)
+		if lines[0] != "#separator:tab" {
+			t.Errorf("Expected Anki separator directive, got %q", lines[0])
+		}
+
+		row := lines[len(lines)-1]
+		fields := strings.Split(row, "\t")
+		if len(fields) != 5 {
+			t.Fatalf("Expected 5 tab-separated fields, got %d in %q", len(fields), row)
+		}
+		if fields[1] != "ih-FEM-er-uhl" {
+			t.Errorf("Expected the reading in column 2, got %q", fields[1])
+		}
+		if fields[3] != "Fame is often {{c1::ephemeral}}." {
+			t.Errorf("Expected cloze markup in the example, got %q", fields[3])
+		}
+	})
+
+	t.Run("unknown format is rejected", func(t *testing.T) {
+		service, _ := newVocabService(t)
+
+		_, err := service.ExportItems(1, "xlsx")
+		assertAppError(t, err, 400)
+	})
+}
+
+func TestImportItems(t *testing.T) {
+	t.Run("csv rows are saved and duplicates skipped", func(t *testing.T) {
+		service, _ := newVocabService(t)
+		createItem(t, service, 1, "serendipity")
+
+		payload := strings.Join([]string{
+			"term,reading,definition,example,source_video_url,video_timestamp",
+			`serendipity,,already saved,,,`,
+			`ephemeral,,lasting a very short time,Fame is ephemeral.,https://www.youtube.com/watch?v=abc123&t=10s,10`,
+			`ephemeral,,a repeat within the file,,,`,
+		}, "\n")
+
+		result, err := service.ImportItems(1, []byte(payload))
+		if err != nil {
+			t.Fatalf("ImportItems failed: %v", err)
+		}
+		if result.TotalRows != 3 || result.Imported != 1 || result.Duplicates != 2 || result.Failed != 0 {
+			t.Fatalf("Unexpected import summary: %+v", result)
+		}
+
+		items := listAll(t, service, 1)
+		var imported *dto.VocabItemResponse
+		for i := range items {
+			if items[i].Word == "ephemeral" {
+				imported = &items[i]
+			}
+		}
+		if imported == nil {
+			t.Fatal("Expected the imported word to be listed")
+		}
+		if imported.SourceVideoID != "abc123" {
+			t.Errorf("Expected the video ID parsed from the link, got %q", imported.SourceVideoID)
+		}
+		if imported.VideoTimestamp != 10 {
+			t.Errorf("Expected timestamp 10, got %v", imported.VideoTimestamp)
+		}
+	})
+
+	t.Run("anki exports round-trip", func(t *testing.T) {
+		source, _ := newVocabService(t)
+		if _, err := source.CreateItem(1, &dto.CreateVocabItemRequest{
+			Word:            "ubiquitous",
+			Reading:         "yoo-BIK-wi-tuhs",
+			ExampleSentence: "Phones are ubiquitous now.",
+		}); err != nil {
+			t.Fatalf("CreateItem failed: %v", err)
+		}
+		file, err := source.ExportItems(1, vocab.FormatAnki)
+		if err != nil {
+			t.Fatalf("ExportItems failed: %v", err)
+		}
+
+		target, _ := newVocabService(t)
+		result, err := target.ImportItems(2, file.Data)
+		if err != nil {
+			t.Fatalf("ImportItems failed: %v", err)
+		}
+		if result.Imported != 1 {
+			t.Fatalf("Expected 1 imported row, got %+v", result)
+		}
+
+		items := listAll(t, target, 2)
+		if len(items) != 1 {
+			t.Fatalf("Expected 1 item, got %d", len(items))
+		}
+		item := items[0]
+		if item.Reading != "yoo-BIK-wi-tuhs" {
+			t.Errorf("Expected the reading to survive the round-trip, got %q", item.Reading)
+		}
+		if item.ExampleSentence != "Phones are ubiquitous now." {
+			t.Errorf("Expected cloze markup stripped on import, got %q", item.ExampleSentence)
+		}
+	})
+
+	t.Run("malformed rows are reported without aborting the import", func(t *testing.T) {
+		service, _ := newVocabService(t)
+
+		payload := ",missing term\nvalid,,a definition,,,\n"
+		result, err := service.ImportItems(1, []byte(payload))
+		if err != nil {
+			t.Fatalf("ImportItems failed: %v", err)
+		}
+		if result.Imported != 1 || result.Failed != 1 {
+			t.Fatalf("Unexpected import summary: %+v", result)
+		}
+		if len(result.Errors) != 1 || !strings.Contains(result.Errors[0], "row 1") {
+			t.Errorf("Expected a row 1 error, got %v", result.Errors)
+		}
+	})
+
+	t.Run("empty payloads are rejected", func(t *testing.T) {
+		service, _ := newVocabService(t)
+
+		_, err := service.ImportItems(1, []byte("#separator:tab\n\n"))
+		assertAppError(t, err, 400)
+	})
+}
+
+// assertAppError fails unless err is an AppError with the given status
+func assertAppError(t *testing.T, err error, status int) {
+	t.Helper()
+
+	appErr, ok := err.(*errors.AppError)
+	if !ok {
+		t.Fatalf("Expected an AppError, got %v", err)
+	}
+	if appErr.Status != status {
+		t.Fatalf("Expected status %d, got %d", status, appErr.Status)
+	}
+}
@@ -0,0 +1,118 @@
+package workspace_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"app-backend/internal/logger"
+	"app-backend/internal/middleware"
+	"app-backend/internal/models"
+	"app-backend/internal/services/workspace"
+	"app-backend/internal/types"
+)
+
+// newAuthTestRouter builds a router whose /workspaces/:workspaceId/... routes
+// run the workspace authorization middleware. Authentication is stubbed by
+// setting the user context for the given user directly.
+func newAuthTestRouter(t *testing.T, service workspace.ServiceInterface, userID uint) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	workspaceAuth := middleware.NewWorkspaceAuthMiddleware(service, log)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		types.SetUserContext(c, &types.UserContext{UserID: userID, Email: "member@example.com", Role: "user"})
+		c.Next()
+	})
+
+	scoped := router.Group("/workspaces/:workspaceId")
+	scoped.GET("/role", workspaceAuth.RequireWorkspaceRole(), func(c *gin.Context) {
+		member, err := middleware.GetWorkspaceMembership(c)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"role": member.Role})
+	})
+	scoped.POST("/curated", workspaceAuth.RequireWorkspaceRole(models.WorkspaceRoleOwner, models.WorkspaceRoleTeacher), func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "ok"})
+	})
+
+	return router
+}
+
+func TestWorkspaceAuthMiddleware(t *testing.T) {
+	service, db := newWorkspaceService(t)
+	teacher := createUser(t, db, "teacher@example.com")
+	student := createUser(t, db, "student@example.com")
+	outsider := createUser(t, db, "outsider@example.com")
+
+	ws := createWorkspace(t, service, teacher.ID)
+	owner, err := service.GetMembership(ws.ID, teacher.ID)
+	if err != nil {
+		t.Fatalf("GetMembership failed: %v", err)
+	}
+	join(t, service, owner, student, models.WorkspaceRoleStudent)
+
+	get := func(router *gin.Engine, method string, path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(method, path, nil)
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+	wsPath := "/workspaces/" + strconv.FormatUint(uint64(ws.ID), 10)
+
+	t.Run("members pass and the handler sees their membership", func(t *testing.T) {
+		router := newAuthTestRouter(t, service, student.ID)
+
+		recorder := get(router, http.MethodGet, wsPath+"/role")
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected 200 for a member, got %d: %s", recorder.Code, recorder.Body.String())
+		}
+		if !strings.Contains(recorder.Body.String(), models.WorkspaceRoleStudent) {
+			t.Errorf("Expected the student role in the response, got %s", recorder.Body.String())
+		}
+	})
+
+	t.Run("students are blocked from curator endpoints", func(t *testing.T) {
+		router := newAuthTestRouter(t, service, student.ID)
+
+		if recorder := get(router, http.MethodPost, wsPath+"/curated"); recorder.Code != http.StatusForbidden {
+			t.Errorf("Expected 403 for a student, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("the owner reaches curator endpoints", func(t *testing.T) {
+		router := newAuthTestRouter(t, service, teacher.ID)
+
+		if recorder := get(router, http.MethodPost, wsPath+"/curated"); recorder.Code != http.StatusOK {
+			t.Errorf("Expected 200 for the owner, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("non-members get 404", func(t *testing.T) {
+		router := newAuthTestRouter(t, service, outsider.ID)
+
+		if recorder := get(router, http.MethodGet, wsPath+"/role"); recorder.Code != http.StatusNotFound {
+			t.Errorf("Expected 404 for a non-member, got %d", recorder.Code)
+		}
+	})
+
+	t.Run("malformed workspace IDs get 400", func(t *testing.T) {
+		router := newAuthTestRouter(t, service, student.ID)
+
+		if recorder := get(router, http.MethodGet, "/workspaces/nope/role"); recorder.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for a malformed ID, got %d", recorder.Code)
+		}
+	})
+}
@@ -0,0 +1,386 @@
+package workspace_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"app-backend/internal/dto"
+	"app-backend/internal/errors"
+	"app-backend/internal/logger"
+	"app-backend/internal/models"
+	"app-backend/internal/repositories"
+	"app-backend/internal/services/vocab"
+	"app-backend/internal/services/workspace"
+	"app-backend/test/testutil"
+)
+
+func newWorkspaceService(t *testing.T) (workspace.ServiceInterface, *gorm.DB) {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t,
+		&models.User{},
+		&models.VocabItem{},
+		&models.Workspace{},
+		&models.WorkspaceMember{},
+		&models.WorkspaceInvite{},
+		&models.WorkspaceVideo{},
+		&models.WorkspaceVocabItem{},
+	)
+
+	log, err := logger.New("development")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	service := workspace.NewService(
+		repositories.NewWorkspaceRepository(db),
+		repositories.NewWorkspaceMemberRepository(db),
+		repositories.NewWorkspaceInviteRepository(db),
+		repositories.NewWorkspaceVideoRepository(db),
+		repositories.NewWorkspaceVocabRepository(db),
+		repositories.NewVocabRepository(db),
+		repositories.NewUserRepository(db),
+		log,
+	)
+	return service, db
+}
+
+func createUser(t *testing.T, db *gorm.DB, email string) *models.User {
+	t.Helper()
+
+	user := &models.User{
+		FirstName: "Test",
+		LastName:  "User",
+		Email:     email,
+		Password:  "hashed",
+	}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	return user
+}
+
+func createWorkspace(t *testing.T, service workspace.ServiceInterface, ownerID uint) *dto.WorkspaceResponse {
+	t.Helper()
+
+	ws, err := service.CreateWorkspace(ownerID, &dto.CreateWorkspaceRequest{
+		Name:        "English 101",
+		Description: "Beginner classroom",
+	})
+	if err != nil {
+		t.Fatalf("CreateWorkspace failed: %v", err)
+	}
+	return ws
+}
+
+// join invites the user by email with the given role and accepts the invite
+func join(t *testing.T, service workspace.ServiceInterface, inviter *models.WorkspaceMember, user *models.User, role string) *models.WorkspaceMember {
+	t.Helper()
+
+	invite, err := service.InviteMember(inviter, &dto.InviteWorkspaceMemberRequest{
+		Email: user.Email,
+		Role:  role,
+	})
+	if err != nil {
+		t.Fatalf("InviteMember failed: %v", err)
+	}
+	if _, err := service.AcceptInvite(user.ID, user.Email, invite.Token); err != nil {
+		t.Fatalf("AcceptInvite failed: %v", err)
+	}
+
+	member, err := service.GetMembership(inviter.WorkspaceID, user.ID)
+	if err != nil {
+		t.Fatalf("GetMembership failed: %v", err)
+	}
+	return member
+}
+
+// isStatus reports whether err is an AppError with the given status
+func isStatus(err error, status int) bool {
+	appErr, ok := err.(*errors.AppError)
+	return ok && appErr.Status == status
+}
+
+func TestWorkspaceService(t *testing.T) {
+	t.Run("creating a workspace makes the creator its owner", func(t *testing.T) {
+		service, db := newWorkspaceService(t)
+		teacher := createUser(t, db, "teacher@example.com")
+
+		ws := createWorkspace(t, service, teacher.ID)
+		if ws.Role != models.WorkspaceRoleOwner {
+			t.Errorf("Expected owner role on the created workspace, got %q", ws.Role)
+		}
+
+		member, err := service.GetMembership(ws.ID, teacher.ID)
+		if err != nil {
+			t.Fatalf("GetMembership failed: %v", err)
+		}
+		if member.Role != models.WorkspaceRoleOwner {
+			t.Errorf("Expected owner membership, got %q", member.Role)
+		}
+
+		list, err := service.ListWorkspaces(teacher.ID)
+		if err != nil {
+			t.Fatalf("ListWorkspaces failed: %v", err)
+		}
+		if list.Total != 1 || list.Workspaces[0].Role != models.WorkspaceRoleOwner {
+			t.Errorf("Expected one owned workspace, got %+v", list)
+		}
+	})
+
+	t.Run("students join through an email invitation", func(t *testing.T) {
+		service, db := newWorkspaceService(t)
+		teacher := createUser(t, db, "teacher@example.com")
+		student := createUser(t, db, "student@example.com")
+
+		ws := createWorkspace(t, service, teacher.ID)
+		owner, _ := service.GetMembership(ws.ID, teacher.ID)
+
+		member := join(t, service, owner, student, models.WorkspaceRoleStudent)
+		if member.Role != models.WorkspaceRoleStudent {
+			t.Errorf("Expected student role after accepting, got %q", member.Role)
+		}
+
+		members, err := service.ListMembers(ws.ID)
+		if err != nil {
+			t.Fatalf("ListMembers failed: %v", err)
+		}
+		if members.Total != 2 {
+			t.Errorf("Expected owner and student in the member list, got %d", members.Total)
+		}
+	})
+
+	t.Run("invitation tokens are single use", func(t *testing.T) {
+		service, db := newWorkspaceService(t)
+		teacher := createUser(t, db, "teacher@example.com")
+		student := createUser(t, db, "student@example.com")
+
+		ws := createWorkspace(t, service, teacher.ID)
+		owner, _ := service.GetMembership(ws.ID, teacher.ID)
+
+		invite, err := service.InviteMember(owner, &dto.InviteWorkspaceMemberRequest{
+			Email: student.Email,
+			Role:  models.WorkspaceRoleStudent,
+		})
+		if err != nil {
+			t.Fatalf("InviteMember failed: %v", err)
+		}
+
+		if _, err := service.AcceptInvite(student.ID, student.Email, invite.Token); err != nil {
+			t.Fatalf("AcceptInvite failed: %v", err)
+		}
+		if _, err := service.AcceptInvite(student.ID, student.Email, invite.Token); !isStatus(err, http.StatusConflict) {
+			t.Errorf("Expected 409 on a second acceptance, got %v", err)
+		}
+	})
+
+	t.Run("invitations are bound to the invited email", func(t *testing.T) {
+		service, db := newWorkspaceService(t)
+		teacher := createUser(t, db, "teacher@example.com")
+		createUser(t, db, "student@example.com")
+		other := createUser(t, db, "other@example.com")
+
+		ws := createWorkspace(t, service, teacher.ID)
+		owner, _ := service.GetMembership(ws.ID, teacher.ID)
+
+		invite, err := service.InviteMember(owner, &dto.InviteWorkspaceMemberRequest{
+			Email: "student@example.com",
+			Role:  models.WorkspaceRoleStudent,
+		})
+		if err != nil {
+			t.Fatalf("InviteMember failed: %v", err)
+		}
+
+		if _, err := service.AcceptInvite(other.ID, other.Email, invite.Token); !isStatus(err, http.StatusForbidden) {
+			t.Errorf("Expected 403 for a mismatched email, got %v", err)
+		}
+	})
+
+	t.Run("expired invitations are rejected", func(t *testing.T) {
+		service, db := newWorkspaceService(t)
+		teacher := createUser(t, db, "teacher@example.com")
+		student := createUser(t, db, "student@example.com")
+
+		ws := createWorkspace(t, service, teacher.ID)
+		owner, _ := service.GetMembership(ws.ID, teacher.ID)
+
+		invite, err := service.InviteMember(owner, &dto.InviteWorkspaceMemberRequest{
+			Email: student.Email,
+			Role:  models.WorkspaceRoleStudent,
+		})
+		if err != nil {
+			t.Fatalf("InviteMember failed: %v", err)
+		}
+
+		expired := time.Now().Add(-time.Hour)
+		if err := db.Model(&models.WorkspaceInvite{}).Where("id = ?", invite.ID).Update("expires_at", expired).Error; err != nil {
+			t.Fatalf("Failed to expire invite: %v", err)
+		}
+
+		if _, err := service.AcceptInvite(student.ID, student.Email, invite.Token); !isStatus(err, http.StatusGone) {
+			t.Errorf("Expected 410 for an expired invitation, got %v", err)
+		}
+	})
+
+	t.Run("only the owner can invite teachers", func(t *testing.T) {
+		service, db := newWorkspaceService(t)
+		owner := createUser(t, db, "owner@example.com")
+		assistant := createUser(t, db, "assistant@example.com")
+
+		ws := createWorkspace(t, service, owner.ID)
+		ownerMember, _ := service.GetMembership(ws.ID, owner.ID)
+		teacherMember := join(t, service, ownerMember, assistant, models.WorkspaceRoleTeacher)
+
+		_, err := service.InviteMember(teacherMember, &dto.InviteWorkspaceMemberRequest{
+			Email: "colleague@example.com",
+			Role:  models.WorkspaceRoleTeacher,
+		})
+		if !isStatus(err, http.StatusForbidden) {
+			t.Errorf("Expected 403 for a teacher inviting a teacher, got %v", err)
+		}
+
+		// Inviting students stays open to teachers
+		if _, err := service.InviteMember(teacherMember, &dto.InviteWorkspaceMemberRequest{
+			Email: "pupil@example.com",
+			Role:  models.WorkspaceRoleStudent,
+		}); err != nil {
+			t.Errorf("Expected teachers to invite students, got %v", err)
+		}
+	})
+
+	t.Run("a non-member sees the workspace as missing", func(t *testing.T) {
+		service, db := newWorkspaceService(t)
+		teacher := createUser(t, db, "teacher@example.com")
+		outsider := createUser(t, db, "outsider@example.com")
+
+		ws := createWorkspace(t, service, teacher.ID)
+
+		if _, err := service.GetMembership(ws.ID, outsider.ID); !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 for a non-member, got %v", err)
+		}
+	})
+
+	t.Run("removing members respects roles", func(t *testing.T) {
+		service, db := newWorkspaceService(t)
+		owner := createUser(t, db, "owner@example.com")
+		assistant := createUser(t, db, "assistant@example.com")
+		student := createUser(t, db, "student@example.com")
+
+		ws := createWorkspace(t, service, owner.ID)
+		ownerMember, _ := service.GetMembership(ws.ID, owner.ID)
+		teacherMember := join(t, service, ownerMember, assistant, models.WorkspaceRoleTeacher)
+		join(t, service, ownerMember, student, models.WorkspaceRoleStudent)
+
+		if err := service.RemoveMember(teacherMember, owner.ID); !isStatus(err, http.StatusForbidden) {
+			t.Errorf("Expected 403 removing the owner, got %v", err)
+		}
+		if err := service.RemoveMember(teacherMember, student.ID); err != nil {
+			t.Errorf("Expected teachers to remove students, got %v", err)
+		}
+		if err := service.RemoveMember(ownerMember, assistant.ID); err != nil {
+			t.Errorf("Expected the owner to remove teachers, got %v", err)
+		}
+	})
+
+	t.Run("shared videos are deduplicated per workspace", func(t *testing.T) {
+		service, db := newWorkspaceService(t)
+		teacher := createUser(t, db, "teacher@example.com")
+
+		ws := createWorkspace(t, service, teacher.ID)
+		owner, _ := service.GetMembership(ws.ID, teacher.ID)
+
+		req := &dto.ShareWorkspaceVideoRequest{
+			VideoID: "dQw4w9WgXcQ",
+			Title:   "Listening practice",
+			Note:    "Watch before Friday",
+		}
+		if _, err := service.ShareVideo(owner, req); err != nil {
+			t.Fatalf("ShareVideo failed: %v", err)
+		}
+		if _, err := service.ShareVideo(owner, req); !isStatus(err, http.StatusConflict) {
+			t.Errorf("Expected 409 for a duplicate video, got %v", err)
+		}
+
+		videos, err := service.ListVideos(ws.ID)
+		if err != nil {
+			t.Fatalf("ListVideos failed: %v", err)
+		}
+		if videos.Total != 1 {
+			t.Errorf("Expected one curated video, got %d", videos.Total)
+		}
+	})
+
+	t.Run("sharing vocabulary copies items into the deck", func(t *testing.T) {
+		service, db := newWorkspaceService(t)
+		teacher := createUser(t, db, "teacher@example.com")
+
+		ws := createWorkspace(t, service, teacher.ID)
+		owner, _ := service.GetMembership(ws.ID, teacher.ID)
+
+		log, err := logger.New("development")
+		if err != nil {
+			t.Fatalf("Failed to create logger: %v", err)
+		}
+		vocabSvc := vocab.NewService(repositories.NewVocabRepository(db), log)
+
+		first, err := vocabSvc.CreateItem(teacher.ID, &dto.CreateVocabItemRequest{Word: "serendipity", Definition: "a happy accident"})
+		if err != nil {
+			t.Fatalf("CreateItem failed: %v", err)
+		}
+		second, err := vocabSvc.CreateItem(teacher.ID, &dto.CreateVocabItemRequest{Word: "ephemeral", Definition: "short-lived"})
+		if err != nil {
+			t.Fatalf("CreateItem failed: %v", err)
+		}
+
+		result, err := service.ShareVocab(owner, &dto.ShareWorkspaceVocabRequest{ItemIDs: []uint{first.ID, second.ID}})
+		if err != nil {
+			t.Fatalf("ShareVocab failed: %v", err)
+		}
+		if result.Shared != 2 || result.Duplicates != 0 {
+			t.Errorf("Expected both items shared, got %+v", result)
+		}
+
+		// Re-sharing the same words only counts duplicates
+		result, err = service.ShareVocab(owner, &dto.ShareWorkspaceVocabRequest{ItemIDs: []uint{first.ID}})
+		if err != nil {
+			t.Fatalf("ShareVocab failed: %v", err)
+		}
+		if result.Shared != 0 || result.Duplicates != 1 {
+			t.Errorf("Expected a duplicate-only share, got %+v", result)
+		}
+
+		deck, err := service.ListVocab(ws.ID)
+		if err != nil {
+			t.Fatalf("ListVocab failed: %v", err)
+		}
+		if deck.Total != 2 {
+			t.Errorf("Expected two deck items, got %d", deck.Total)
+		}
+	})
+
+	t.Run("only the sharer's own vocabulary can be shared", func(t *testing.T) {
+		service, db := newWorkspaceService(t)
+		teacher := createUser(t, db, "teacher@example.com")
+		other := createUser(t, db, "other@example.com")
+
+		ws := createWorkspace(t, service, teacher.ID)
+		owner, _ := service.GetMembership(ws.ID, teacher.ID)
+
+		log, err := logger.New("development")
+		if err != nil {
+			t.Fatalf("Failed to create logger: %v", err)
+		}
+		vocabSvc := vocab.NewService(repositories.NewVocabRepository(db), log)
+		item, err := vocabSvc.CreateItem(other.ID, &dto.CreateVocabItemRequest{Word: "private", Definition: "not yours"})
+		if err != nil {
+			t.Fatalf("CreateItem failed: %v", err)
+		}
+
+		if _, err := service.ShareVocab(owner, &dto.ShareWorkspaceVocabRequest{ItemIDs: []uint{item.ID}}); !isStatus(err, http.StatusNotFound) {
+			t.Errorf("Expected 404 sharing another user's item, got %v", err)
+		}
+	})
+}